@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AccountDetails represents the portal-level settings returned by the
+// account info API
+type AccountDetails struct {
+	PortalID              int64    `json:"portalId"`
+	AccountType           string   `json:"accountType,omitempty"`
+	TimeZone              string   `json:"timeZone,omitempty"`
+	CompanyCurrency       string   `json:"companyCurrency,omitempty"`
+	AdditionalCurrencies  []string `json:"additionalCurrencies,omitempty"`
+	UTCOffset             string   `json:"utcOffset,omitempty"`
+	UTCOffsetMilliseconds int64    `json:"utcOffsetMilliseconds,omitempty"`
+	UIDomain              string   `json:"uiDomain,omitempty"`
+	DataHostingLocation   string   `json:"dataHostingLocation,omitempty"`
+}
+
+// GetAccountDetails retrieves the portal ID, time zone, currency and data
+// hosting location for the account the token belongs to
+func (c *Client) GetAccountDetails(ctx context.Context) (*AccountDetails, error) {
+	url := fmt.Sprintf("%s/account-info/v3/details", c.BaseURL)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AccountDetails
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse account details response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ActingUser is the HubSpot user who performed an activity
+type ActingUser struct {
+	UserID    int64  `json:"userId"`
+	UserEmail string `json:"userEmail,omitempty"`
+}
+
+// AuditLog is one change made in the portal, such as a setting updated or a
+// record deleted
+type AuditLog struct {
+	ID             string      `json:"id"`
+	Category       string      `json:"category"`
+	SubCategory    string      `json:"subCategory,omitempty"`
+	Action         string      `json:"action"`
+	TargetObjectID string      `json:"targetObjectId,omitempty"`
+	OccurredAt     string      `json:"occurredAt"`
+	ActingUser     *ActingUser `json:"actingUser,omitempty"`
+}
+
+// AuditLogList represents a paginated list of audit logs
+type AuditLogList struct {
+	Results []AuditLog `json:"results"`
+	Paging  *Paging    `json:"paging,omitempty"`
+}
+
+// LoginActivity is one attempt by a user to log in to the portal
+type LoginActivity struct {
+	ID             string `json:"id"`
+	LoginAt        string `json:"loginAt"`
+	UserID         int64  `json:"userId"`
+	Email          string `json:"email,omitempty"`
+	LoginSucceeded bool   `json:"loginSucceeded"`
+	IPAddress      string `json:"ipAddress,omitempty"`
+	Location       string `json:"location,omitempty"`
+	UserAgent      string `json:"userAgent,omitempty"`
+	CountryCode    string `json:"countryCode,omitempty"`
+	RegionCode     string `json:"regionCode,omitempty"`
+}
+
+// LoginActivityList represents a paginated list of login activity
+type LoginActivityList struct {
+	Results []LoginActivity `json:"results"`
+	Paging  *Paging         `json:"paging,omitempty"`
+}
+
+// SecurityActivity is one security-related event in the portal, such as a
+// user exporting data or changing two-factor authentication
+type SecurityActivity struct {
+	ID          string      `json:"id"`
+	Type        string      `json:"type"`
+	UserID      int64       `json:"userId"`
+	CreatedAt   string      `json:"createdAt"`
+	ObjectID    string      `json:"objectId,omitempty"`
+	InfoURL     string      `json:"infoUrl,omitempty"`
+	ActingUser  *ActingUser `json:"actingUser,omitempty"`
+	IPAddress   string      `json:"ipAddress,omitempty"`
+	Location    string      `json:"location,omitempty"`
+	UserAgent   string      `json:"userAgent,omitempty"`
+	CountryCode string      `json:"countryCode,omitempty"`
+	RegionCode  string      `json:"regionCode,omitempty"`
+}
+
+// SecurityActivityList represents a paginated list of security activity
+type SecurityActivityList struct {
+	Results []SecurityActivity `json:"results"`
+	Paging  *Paging            `json:"paging,omitempty"`
+}
+
+// ActivityQuery selects the account activity the activity lists return
+type ActivityQuery struct {
+	// UserID narrows the activity to one HubSpot user
+	UserID string
+	// OccurredAfter and OccurredBefore bound when the activity happened;
+	// zero times are left unbounded
+	OccurredAfter  time.Time
+	OccurredBefore time.Time
+}
+
+// params returns the query parameters of an activity list request
+func (q ActivityQuery) params(userParam string, opts ListOptions) map[string]string {
+	params := map[string]string{
+		userParam: q.UserID,
+		"after":   opts.After,
+	}
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if !q.OccurredAfter.IsZero() {
+		params["occurredAfter"] = q.OccurredAfter.UTC().Format(time.RFC3339)
+	}
+	if !q.OccurredBefore.IsZero() {
+		params["occurredBefore"] = q.OccurredBefore.UTC().Format(time.RFC3339)
+	}
+	return params
+}
+
+// ListAuditLogs retrieves the portal's audit logs, newest first
+func (c *Client) ListAuditLogs(ctx context.Context, query ActivityQuery, opts ListOptions) (*AuditLogList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]AuditLog, *Paging, error) {
+			page, err := c.ListAuditLogs(ctx, query, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &AuditLogList{Results: results, Paging: paging}, nil
+	}
+
+	url := buildURL(fmt.Sprintf("%s/account-info/v3/activity/audit-logs", c.BaseURL), query.params("actingUserId", opts))
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AuditLogList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse audit logs response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListLoginActivity retrieves the portal's login history, newest first
+func (c *Client) ListLoginActivity(ctx context.Context, query ActivityQuery, opts ListOptions) (*LoginActivityList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]LoginActivity, *Paging, error) {
+			page, err := c.ListLoginActivity(ctx, query, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &LoginActivityList{Results: results, Paging: paging}, nil
+	}
+
+	url := buildURL(fmt.Sprintf("%s/account-info/v3/activity/login", c.BaseURL), query.params("userId", opts))
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LoginActivityList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse login activity response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListSecurityActivity retrieves the portal's security activity, newest
+// first
+func (c *Client) ListSecurityActivity(ctx context.Context, query ActivityQuery, opts ListOptions) (*SecurityActivityList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]SecurityActivity, *Paging, error) {
+			page, err := c.ListSecurityActivity(ctx, query, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &SecurityActivityList{Results: results, Paging: paging}, nil
+	}
+
+	url := buildURL(fmt.Sprintf("%s/account-info/v3/activity/security", c.BaseURL), query.params("userId", opts))
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SecurityActivityList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse security activity response: %w", err)
+	}
+
+	return &result, nil
+}
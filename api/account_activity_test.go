@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListAuditLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account-info/v3/activity/audit-logs", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "42", q.Get("actingUserId"))
+		assert.Equal(t, "2024-01-01T00:00:00Z", q.Get("occurredAfter"))
+		assert.Empty(t, q.Get("occurredBefore"))
+		assert.Equal(t, "50", q.Get("limit"))
+
+		w.Write([]byte(`{
+			"results": [
+				{
+					"id": "a1",
+					"category": "CRM_OBJECT",
+					"subCategory": "DELETED",
+					"action": "DELETE",
+					"targetObjectId": "123",
+					"occurredAt": "2024-02-01T10:00:00Z",
+					"actingUser": {"userId": 42, "userEmail": "ada@example.com"}
+				}
+			],
+			"paging": {"next": {"after": "a2"}}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListAuditLogs(context.Background(), ActivityQuery{
+		UserID:        "42",
+		OccurredAfter: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, ListOptions{Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	log := result.Results[0]
+	assert.Equal(t, "CRM_OBJECT", log.Category)
+	assert.Equal(t, "DELETE", log.Action)
+	require.NotNil(t, log.ActingUser)
+	assert.Equal(t, "ada@example.com", log.ActingUser.UserEmail)
+	assert.Equal(t, "a2", result.Paging.Next.After)
+}
+
+func TestClient_ListLoginActivity(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account-info/v3/activity/login", r.URL.Path)
+		assert.Equal(t, "42", r.URL.Query().Get("userId"))
+		pages++
+		if r.URL.Query().Get("after") == "" {
+			w.Write([]byte(`{"results": [{"id": "l1", "loginAt": "2024-02-01T10:00:00Z", "userId": 42, "email": "ada@example.com", "loginSucceeded": true, "ipAddress": "203.0.113.7", "location": "Dublin, Ireland"}], "paging": {"next": {"after": "l2"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "l2", "loginAt": "2024-01-31T10:00:00Z", "userId": 42, "loginSucceeded": false}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListLoginActivity(context.Background(), ActivityQuery{UserID: "42"}, ListOptions{All: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, pages)
+	require.Len(t, result.Results, 2)
+	assert.True(t, result.Results[0].LoginSucceeded)
+	assert.Equal(t, "203.0.113.7", result.Results[0].IPAddress)
+	assert.False(t, result.Results[1].LoginSucceeded)
+	assert.Nil(t, result.Paging)
+}
+
+func TestClient_ListSecurityActivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account-info/v3/activity/security", r.URL.Path)
+		assert.Equal(t, "2024-03-01T00:00:00Z", r.URL.Query().Get("occurredBefore"))
+
+		w.Write([]byte(`{"results": [{"id": "s1", "type": "EXPORT_STARTED", "userId": 42, "createdAt": "2024-02-01T10:00:00Z", "objectId": "9", "infoUrl": "https://app.hubspot.com/exports"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListSecurityActivity(context.Background(), ActivityQuery{
+		OccurredBefore: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}, ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "EXPORT_STARTED", result.Results[0].Type)
+	assert.Equal(t, int64(42), result.Results[0].UserID)
+}
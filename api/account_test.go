@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetAccountDetails(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/account-info/v3/details", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{
+				"portalId": 1234567,
+				"accountType": "STANDARD",
+				"timeZone": "Europe/Berlin",
+				"companyCurrency": "EUR",
+				"additionalCurrencies": ["USD"],
+				"utcOffset": "+01:00",
+				"utcOffsetMilliseconds": 3600000,
+				"uiDomain": "app-eu1.hubspot.com",
+				"dataHostingLocation": "eu1"
+			}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		details, err := client.GetAccountDetails(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1234567), details.PortalID)
+		assert.Equal(t, "Europe/Berlin", details.TimeZone)
+		assert.Equal(t, "EUR", details.CompanyCurrency)
+		assert.Equal(t, []string{"USD"}, details.AdditionalCurrencies)
+		assert.Equal(t, "eu1", details.DataHostingLocation)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"status": "error", "message": "missing scopes", "category": "MISSING_SCOPES"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		_, err := client.GetAccountDetails(context.Background())
+		require.Error(t, err)
+		assert.True(t, IsForbidden(err))
+	})
+}
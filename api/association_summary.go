@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxSummaryNames is how many associated record names a summary keeps
+const maxSummaryNames = 5
+
+// graphQLObjectNames are the GraphQL names of the object types whose
+// associations can be summarized
+var graphQLObjectNames = map[ObjectType]string{
+	ObjectTypeContacts:  "contact",
+	ObjectTypeCompanies: "company",
+	ObjectTypeDeals:     "deal",
+	ObjectTypeTickets:   "ticket",
+}
+
+// AssociationQuery names an associated object type to summarize
+type AssociationQuery struct {
+	ToType ObjectType
+	// NameProperty, when set, is read from up to a few associated records
+	// to name them; otherwise only the count is summarized
+	NameProperty string
+}
+
+// AssociationSummary is how many records of one type a record is
+// associated with, and the names of the first few
+type AssociationSummary struct {
+	Total int      `json:"total"`
+	Names []string `json:"names,omitempty"`
+}
+
+// AssociationSummaries maps record IDs to their summaries by associated
+// object type
+type AssociationSummaries map[string]map[ObjectType]AssociationSummary
+
+// associationField is the GraphQL field for fromType's associations with
+// toType, e.g. company_collection__contact_to_company
+func associationField(fromType, toType ObjectType) (string, error) {
+	from, ok := graphQLObjectNames[fromType]
+	if !ok {
+		return "", fmt.Errorf("associations of %s cannot be summarized", fromType)
+	}
+	to, ok := graphQLObjectNames[toType]
+	if !ok {
+		return "", fmt.Errorf("associations with %s cannot be summarized", toType)
+	}
+	return fmt.Sprintf("%s_collection__%s_to_%s", to, from, to), nil
+}
+
+// associationSummaryQuery builds one GraphQL query that summarizes the
+// associations of every record in ids
+func associationSummaryQuery(fromType ObjectType, ids []string, queries []AssociationQuery) (string, error) {
+	from, ok := graphQLObjectNames[fromType]
+	if !ok {
+		return "", fmt.Errorf("associations of %s cannot be summarized", fromType)
+	}
+	for _, id := range ids {
+		if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+			return "", fmt.Errorf("invalid record ID %q", id)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "query {\n  CRM {\n    %s_collection(filter: {hs_object_id__in: [%s]}, limit: %d) {\n      items {\n        hs_object_id\n        associations {\n",
+		from, strings.Join(ids, ", "), len(ids))
+	for _, q := range queries {
+		field, err := associationField(fromType, q.ToType)
+		if err != nil {
+			return "", err
+		}
+		if q.NameProperty != "" {
+			fmt.Fprintf(&b, "          %s(limit: %d) { total items { %s } }\n", field, maxSummaryNames, q.NameProperty)
+		} else {
+			fmt.Fprintf(&b, "          %s(limit: 1) { total }\n", field)
+		}
+	}
+	b.WriteString("        }\n      }\n    }\n  }\n}\n")
+	return b.String(), nil
+}
+
+// SummarizeAssociations summarizes the associations of up to MaxBatchSize
+// records with one GraphQL query, instead of a request per record
+func (c *Client) SummarizeAssociations(ctx context.Context, fromType ObjectType, ids []string, queries []AssociationQuery) (AssociationSummaries, error) {
+	if len(ids) > MaxBatchSize {
+		return nil, fmt.Errorf("at most %d records can be summarized at once", MaxBatchSize)
+	}
+	summaries := make(AssociationSummaries, len(ids))
+	if len(ids) == 0 {
+		return summaries, nil
+	}
+
+	query, err := associationSummaryQuery(fromType, ids, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.ExecuteGraphQL(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HasErrors() {
+		return nil, fmt.Errorf("GraphQL query failed: %s", resp.ErrorMessages())
+	}
+
+	var data struct {
+		CRM map[string]struct {
+			Items []struct {
+				ID           json.Number `json:"hs_object_id"`
+				Associations map[string]struct {
+					Total int                      `json:"total"`
+					Items []map[string]interface{} `json:"items"`
+				} `json:"associations"`
+			} `json:"items"`
+		} `json:"CRM"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL response: %w", err)
+	}
+
+	for _, collection := range data.CRM {
+		for _, item := range collection.Items {
+			byType := make(map[ObjectType]AssociationSummary, len(queries))
+			for _, q := range queries {
+				field, _ := associationField(fromType, q.ToType)
+				assoc := item.Associations[field]
+				summary := AssociationSummary{Total: assoc.Total}
+				if q.NameProperty != "" {
+					for _, rec := range assoc.Items {
+						obj := CRMObject{Properties: rec}
+						if name := obj.GetProperty(q.NameProperty); name != "" {
+							summary.Names = append(summary.Names, name)
+						}
+					}
+				}
+				byType[q.ToType] = summary
+			}
+			summaries[item.ID.String()] = byType
+		}
+	}
+
+	return summaries, nil
+}
+
+// SummarizeAssociationsREST summarizes associations like
+// SummarizeAssociations, using the REST associations API: one request per
+// record and associated type, plus a batch read for names. It works with
+// tokens that lack the GraphQL scope.
+func (c *Client) SummarizeAssociationsREST(ctx context.Context, fromType ObjectType, ids []string, queries []AssociationQuery) (AssociationSummaries, error) {
+	summaries := make(AssociationSummaries, len(ids))
+	for _, id := range ids {
+		summaries[id] = make(map[ObjectType]AssociationSummary, len(queries))
+	}
+
+	for _, q := range queries {
+		// The first few associated IDs of each record, to be named
+		firstIDs := make(map[string][]string)
+		var toRead []string
+		seen := make(map[string]bool)
+
+		for _, id := range ids {
+			list, err := c.ListAssociations(ctx, fromType, id, q.ToType, ListOptions{All: true})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s associations of %s: %w", q.ToType, id, err)
+			}
+			summaries[id][q.ToType] = AssociationSummary{Total: len(list.Results)}
+			if q.NameProperty == "" {
+				continue
+			}
+			for i, a := range list.Results {
+				if i == maxSummaryNames {
+					break
+				}
+				toID := a.ToObjectID.String()
+				firstIDs[id] = append(firstIDs[id], toID)
+				if !seen[toID] {
+					seen[toID] = true
+					toRead = append(toRead, toID)
+				}
+			}
+		}
+		if len(toRead) == 0 {
+			continue
+		}
+
+		names := make(map[string]string, len(toRead))
+		sort.Strings(toRead)
+		for start := 0; start < len(toRead); start += MaxBatchSize {
+			end := start + MaxBatchSize
+			if end > len(toRead) {
+				end = len(toRead)
+			}
+			batch, err := c.BatchReadObjects(ctx, q.ToType, toRead[start:end], []string{q.NameProperty})
+			if err != nil {
+				return nil, fmt.Errorf("failed to read associated %s: %w", q.ToType, err)
+			}
+			for i := range batch.Results {
+				names[batch.Results[i].ID] = batch.Results[i].GetProperty(q.NameProperty)
+			}
+		}
+
+		for id, toIDs := range firstIDs {
+			summary := summaries[id][q.ToType]
+			for _, toID := range toIDs {
+				if name := names[toID]; name != "" {
+					summary.Names = append(summary.Names, name)
+				}
+			}
+			summaries[id][q.ToType] = summary
+		}
+	}
+
+	return summaries, nil
+}
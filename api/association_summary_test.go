@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var summaryQueries = []AssociationQuery{
+	{ToType: ObjectTypeCompanies, NameProperty: "name"},
+	{ToType: ObjectTypeDeals},
+}
+
+func TestAssociationSummaryQuery(t *testing.T) {
+	query, err := associationSummaryQuery(ObjectTypeContacts, []string{"101", "102"}, summaryQueries)
+	require.NoError(t, err)
+	assert.Contains(t, query, "contact_collection(filter: {hs_object_id__in: [101, 102]}, limit: 2)")
+	assert.Contains(t, query, "company_collection__contact_to_company(limit: 5) { total items { name } }")
+	assert.Contains(t, query, "deal_collection__contact_to_deal(limit: 1) { total }")
+
+	_, err = associationSummaryQuery(ObjectTypeContacts, []string{"101) { x"}, summaryQueries)
+	assert.ErrorContains(t, err, "invalid record ID")
+
+	_, err = associationSummaryQuery(ObjectTypeNotes, []string{"101"}, summaryQueries)
+	assert.ErrorContains(t, err, "associations of notes cannot be summarized")
+
+	_, err = associationSummaryQuery(ObjectTypeContacts, []string{"101"}, []AssociationQuery{{ToType: ObjectTypeNotes}})
+	assert.ErrorContains(t, err, "associations with notes cannot be summarized")
+}
+
+func TestClient_SummarizeAssociations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collector/graphql", r.URL.Path)
+
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "hs_object_id__in: [101, 102]")
+
+		w.Write([]byte(`{"data": {"CRM": {"contact_collection": {"items": [
+			{"hs_object_id": 101, "associations": {
+				"company_collection__contact_to_company": {"total": 2, "items": [{"name": "Acme"}, {"name": "Globex"}]},
+				"deal_collection__contact_to_deal": {"total": 3}
+			}},
+			{"hs_object_id": 102, "associations": {
+				"company_collection__contact_to_company": {"total": 0, "items": []},
+				"deal_collection__contact_to_deal": {"total": 0}
+			}}
+		]}}}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	summaries, err := client.SummarizeAssociations(context.Background(), ObjectTypeContacts, []string{"101", "102"}, summaryQueries)
+	require.NoError(t, err)
+	assert.Equal(t, AssociationSummary{Total: 2, Names: []string{"Acme", "Globex"}}, summaries["101"][ObjectTypeCompanies])
+	assert.Equal(t, AssociationSummary{Total: 3}, summaries["101"][ObjectTypeDeals])
+	assert.Equal(t, AssociationSummary{}, summaries["102"][ObjectTypeCompanies])
+}
+
+func TestClient_SummarizeAssociations_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"errors": [{"message": "Field 'deal_collection__contact_to_deal' is undefined"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	_, err := client.SummarizeAssociations(context.Background(), ObjectTypeContacts, []string{"101"}, summaryQueries)
+	assert.ErrorContains(t, err, "GraphQL query failed: Field 'deal_collection__contact_to_deal' is undefined")
+
+	summaries, err := client.SummarizeAssociations(context.Background(), ObjectTypeContacts, nil, summaryQueries)
+	require.NoError(t, err)
+	assert.Empty(t, summaries)
+}
+
+func TestClient_SummarizeAssociationsREST(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v4/objects/contacts/101/associations/companies":
+			w.Write([]byte(`{"results": [{"toObjectId": 9001}, {"toObjectId": 9002}]}`))
+		case "/crm/v4/objects/contacts/102/associations/companies":
+			w.Write([]byte(`{"results": [{"toObjectId": 9001}]}`))
+		case "/crm/v4/objects/contacts/101/associations/deals":
+			w.Write([]byte(`{"results": [{"toObjectId": 1}, {"toObjectId": 2}, {"toObjectId": 3}]}`))
+		case "/crm/v4/objects/contacts/102/associations/deals":
+			w.Write([]byte(`{"results": []}`))
+		case "/crm/v3/objects/companies/batch/read":
+			var req batchReadRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Len(t, req.Inputs, 2)
+			assert.Equal(t, []string{"name"}, req.Properties)
+			w.Write([]byte(`{"results": [
+				{"id": "9001", "properties": {"name": "Acme"}},
+				{"id": "9002", "properties": {"name": "Globex"}}
+			]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	summaries, err := client.SummarizeAssociationsREST(context.Background(), ObjectTypeContacts, []string{"101", "102"}, summaryQueries)
+	require.NoError(t, err)
+	assert.Equal(t, AssociationSummary{Total: 2, Names: []string{"Acme", "Globex"}}, summaries["101"][ObjectTypeCompanies])
+	assert.Equal(t, AssociationSummary{Total: 1, Names: []string{"Acme"}}, summaries["102"][ObjectTypeCompanies])
+	assert.Equal(t, AssociationSummary{Total: 3}, summaries["101"][ObjectTypeDeals])
+	assert.Equal(t, AssociationSummary{Total: 0}, summaries["102"][ObjectTypeDeals])
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -32,7 +33,21 @@ type AssociationList struct {
 
 // ListAssociations retrieves associations from one object to another type
 // Uses CRM v4 associations API
-func (c *Client) ListAssociations(fromType ObjectType, fromID string, toType ObjectType, opts ListOptions) (*AssociationList, error) {
+func (c *Client) ListAssociations(ctx context.Context, fromType ObjectType, fromID string, toType ObjectType, opts ListOptions) (*AssociationList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Association, *Paging, error) {
+			page, err := c.ListAssociations(ctx, fromType, fromID, toType, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &AssociationList{Results: results, Paging: paging}, nil
+	}
+
 	if fromID == "" {
 		return nil, fmt.Errorf("from object ID is required")
 	}
@@ -51,7 +66,7 @@ func (c *Client) ListAssociations(fromType ObjectType, fromID string, toType Obj
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -64,32 +79,103 @@ func (c *Client) ListAssociations(fromType ObjectType, fromID string, toType Obj
 	return &result, nil
 }
 
+// Association categories
+const (
+	AssociationCategoryHubSpot     = "HUBSPOT_DEFINED"
+	AssociationCategoryUser        = "USER_DEFINED"
+	AssociationCategoryIntegration = "INTEGRATOR_DEFINED"
+)
+
+// AssociationSpec names an association type (and so its label) when
+// creating or removing associations
+type AssociationSpec struct {
+	Category string `json:"associationCategory"`
+	TypeID   int    `json:"associationTypeId"`
+}
+
+// Spec returns the AssociationSpec identifying t
+func (t AssociationType) Spec() AssociationSpec {
+	return AssociationSpec{Category: t.Category, TypeID: t.TypeID}
+}
+
+// ObjectAssociation links a record being created to an existing record
+type ObjectAssociation struct {
+	To    objectRef         `json:"to"`
+	Types []AssociationSpec `json:"types"`
+}
+
+// NewObjectAssociation returns an association to the record toID with the
+// given types
+func NewObjectAssociation(toID string, types ...AssociationSpec) ObjectAssociation {
+	return ObjectAssociation{To: objectRef{ID: toID}, Types: types}
+}
+
+// engagementAssociationTypes holds HubSpot's default association type IDs
+// from each engagement type to the records it is most often logged against
+var engagementAssociationTypes = map[ObjectType]map[ObjectType]int{
+	ObjectTypeNotes:    {ObjectTypeContacts: 202, ObjectTypeCompanies: 190, ObjectTypeDeals: 214, ObjectTypeTickets: 228},
+	ObjectTypeCalls:    {ObjectTypeContacts: 194, ObjectTypeCompanies: 182, ObjectTypeDeals: 206, ObjectTypeTickets: 220},
+	ObjectTypeEmails:   {ObjectTypeContacts: 198, ObjectTypeCompanies: 186, ObjectTypeDeals: 210, ObjectTypeTickets: 224},
+	ObjectTypeMeetings: {ObjectTypeContacts: 200, ObjectTypeCompanies: 188, ObjectTypeDeals: 212, ObjectTypeTickets: 226},
+	ObjectTypeTasks:    {ObjectTypeContacts: 204, ObjectTypeCompanies: 192, ObjectTypeDeals: 216, ObjectTypeTickets: 230},
+}
+
+// commerceAssociationTypes holds HubSpot's default association type IDs
+// from quotes and line items to the records a quote is built from
+var commerceAssociationTypes = map[ObjectType]map[ObjectType]int{
+	ObjectTypeQuotes:    {ObjectTypeDeals: 64, ObjectTypeLineItems: 67, ObjectTypeContacts: 69, ObjectTypeCompanies: 71, ObjectTypeQuoteTemplates: 286},
+	ObjectTypeLineItems: {ObjectTypeDeals: 20, ObjectTypeQuotes: 68},
+}
+
+// AssociationTypeQuoteSigner is the HubSpot-defined association type from a
+// quote to a contact who signs it when e-signature is enabled
+const AssociationTypeQuoteSigner = 702
+
+// DefaultAssociationType returns the HubSpot-defined association type from
+// an engagement (note, call, email, meeting, or task) to a contact, company,
+// deal, or ticket, or from a quote or line item to the records it belongs to
+func DefaultAssociationType(fromType, toType ObjectType) (AssociationSpec, bool) {
+	typeID, ok := engagementAssociationTypes[fromType][toType]
+	if !ok {
+		typeID, ok = commerceAssociationTypes[fromType][toType]
+	}
+	if !ok {
+		return AssociationSpec{}, false
+	}
+	return AssociationSpec{Category: AssociationCategoryHubSpot, TypeID: typeID}, true
+}
+
 // CreateAssociation creates an association between two objects
 // Uses CRM v4 associations API
-func (c *Client) CreateAssociation(fromType ObjectType, fromID string, toType ObjectType, toID string, associationTypeID int) error {
+func (c *Client) CreateAssociation(ctx context.Context, fromType ObjectType, fromID string, toType ObjectType, toID string, associationTypeID int) error {
+	return c.CreateAssociationWithTypes(ctx, fromType, fromID, toType, toID, []AssociationSpec{
+		{Category: AssociationCategoryHubSpot, TypeID: associationTypeID},
+	})
+}
+
+// CreateAssociationWithTypes associates two objects with one or more
+// association types, such as custom labels
+// Uses CRM v4 associations API
+func (c *Client) CreateAssociationWithTypes(ctx context.Context, fromType ObjectType, fromID string, toType ObjectType, toID string, types []AssociationSpec) error {
 	if fromID == "" {
 		return fmt.Errorf("from object ID is required")
 	}
 	if toID == "" {
 		return fmt.Errorf("to object ID is required")
 	}
+	if len(types) == 0 {
+		return fmt.Errorf("at least one association type is required")
+	}
 
 	url := fmt.Sprintf("%s/crm/v4/objects/%s/%s/associations/%s/%s", c.BaseURL, fromType, fromID, toType, toID)
 
-	payload := []map[string]interface{}{
-		{
-			"associationCategory": "HUBSPOT_DEFINED",
-			"associationTypeId":   associationTypeID,
-		},
-	}
-
-	_, err := c.put(url, payload)
+	_, err := c.put(ctx, url, types)
 	return err
 }
 
 // DeleteAssociation removes an association between two objects
 // Uses CRM v4 associations API
-func (c *Client) DeleteAssociation(fromType ObjectType, fromID string, toType ObjectType, toID string) error {
+func (c *Client) DeleteAssociation(ctx context.Context, fromType ObjectType, fromID string, toType ObjectType, toID string) error {
 	if fromID == "" {
 		return fmt.Errorf("from object ID is required")
 	}
@@ -99,6 +185,253 @@ func (c *Client) DeleteAssociation(fromType ObjectType, fromID string, toType Ob
 
 	url := fmt.Sprintf("%s/crm/v4/objects/%s/%s/associations/%s/%s", c.BaseURL, fromType, fromID, toType, toID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
+	return err
+}
+
+// RemoveAssociationLabel removes specific association types (labels) between
+// two objects, leaving any other types in place
+func (c *Client) RemoveAssociationLabel(ctx context.Context, fromType ObjectType, fromID string, toType ObjectType, toID string, types []AssociationSpec) error {
+	if fromID == "" {
+		return fmt.Errorf("from object ID is required")
+	}
+	if toID == "" {
+		return fmt.Errorf("to object ID is required")
+	}
+
+	return c.BatchRemoveAssociationLabels(ctx, fromType, toType, []AssociationPair{{FromID: fromID, ToID: toID}}, types)
+}
+
+// AssociationLabelList represents the association types defined between two
+// object types
+type AssociationLabelList struct {
+	Results []AssociationType `json:"results"`
+}
+
+// ListAssociationLabels retrieves the association types, including custom
+// labels, defined from one object type to another
+func (c *Client) ListAssociationLabels(ctx context.Context, fromType, toType ObjectType) (*AssociationLabelList, error) {
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/labels", c.BaseURL, fromType, toType)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AssociationLabelList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse association labels response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateAssociationLabel defines a custom association label between two
+// object types. name is the internal name; inverseLabel, if set, makes the
+// label paired (e.g. Manager / Employee).
+func (c *Client) CreateAssociationLabel(ctx context.Context, fromType, toType ObjectType, label, name, inverseLabel string) (*AssociationLabelList, error) {
+	if label == "" {
+		return nil, fmt.Errorf("label is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("label name is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/labels", c.BaseURL, fromType, toType)
+
+	payload := map[string]interface{}{
+		"label": label,
+		"name":  name,
+	}
+	if inverseLabel != "" {
+		payload["inverseLabel"] = inverseLabel
+	}
+
+	body, err := c.post(ctx, url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AssociationLabelList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse association labels response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteAssociationLabel deletes a custom association label by type ID
+func (c *Client) DeleteAssociationLabel(ctx context.Context, fromType, toType ObjectType, typeID int) error {
+	if typeID <= 0 {
+		return fmt.Errorf("association type ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/labels/%d", c.BaseURL, fromType, toType, typeID)
+
+	_, err := c.delete(ctx, url)
 	return err
 }
+
+// AssociationPair identifies two objects to associate or disassociate
+type AssociationPair struct {
+	FromID string
+	ToID   string
+}
+
+// AssociationBatchResult represents the response from a batch association
+// request. As with BatchResult, a 207 response carries per-pair Errors.
+type AssociationBatchResult struct {
+	Status    string                   `json:"status"`
+	Results   []map[string]interface{} `json:"results"`
+	Errors    []BatchError             `json:"errors,omitempty"`
+	NumErrors int                      `json:"numErrors,omitempty"`
+}
+
+type objectRef struct {
+	ID string `json:"id"`
+}
+
+type associationBatchInput struct {
+	From  objectRef         `json:"from"`
+	To    objectRef         `json:"to"`
+	Types []AssociationSpec `json:"types,omitempty"`
+}
+
+type associationArchiveInput struct {
+	From objectRef   `json:"from"`
+	To   []objectRef `json:"to"`
+}
+
+// BatchCreateAssociations associates up to MaxBatchSize pairs of objects in
+// one request. With no types, each pair gets the default (unlabeled)
+// association.
+func (c *Client) BatchCreateAssociations(ctx context.Context, fromType, toType ObjectType, pairs []AssociationPair, types []AssociationSpec) (*AssociationBatchResult, error) {
+	if err := validateAssociationPairs(pairs); err != nil {
+		return nil, err
+	}
+
+	endpoint := "batch/create"
+	if len(types) == 0 {
+		endpoint = "batch/associate/default"
+	}
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/%s", c.BaseURL, fromType, toType, endpoint)
+
+	inputs := make([]associationBatchInput, 0, len(pairs))
+	for _, p := range pairs {
+		inputs = append(inputs, associationBatchInput{From: objectRef{p.FromID}, To: objectRef{p.ToID}, Types: types})
+	}
+
+	body, err := c.post(ctx, url, map[string]interface{}{"inputs": inputs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result AssociationBatchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// BatchDeleteAssociations removes every association between up to
+// MaxBatchSize pairs of objects in one request
+func (c *Client) BatchDeleteAssociations(ctx context.Context, fromType, toType ObjectType, pairs []AssociationPair) error {
+	if err := validateAssociationPairs(pairs); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/batch/archive", c.BaseURL, fromType, toType)
+
+	// Group by source object: the archive endpoint takes a list of targets per source
+	var inputs []associationArchiveInput
+	index := make(map[string]int)
+	for _, p := range pairs {
+		i, ok := index[p.FromID]
+		if !ok {
+			i = len(inputs)
+			index[p.FromID] = i
+			inputs = append(inputs, associationArchiveInput{From: objectRef{p.FromID}})
+		}
+		inputs[i].To = append(inputs[i].To, objectRef{p.ToID})
+	}
+
+	_, err := c.post(ctx, url, map[string]interface{}{"inputs": inputs})
+	return err
+}
+
+// BatchRemoveAssociationLabels removes specific association types from up to
+// MaxBatchSize pairs of objects, leaving the pairs otherwise associated
+func (c *Client) BatchRemoveAssociationLabels(ctx context.Context, fromType, toType ObjectType, pairs []AssociationPair, types []AssociationSpec) error {
+	if err := validateAssociationPairs(pairs); err != nil {
+		return err
+	}
+	if len(types) == 0 {
+		return fmt.Errorf("at least one association type is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/batch/labels/archive", c.BaseURL, fromType, toType)
+
+	inputs := make([]associationBatchInput, 0, len(pairs))
+	for _, p := range pairs {
+		inputs = append(inputs, associationBatchInput{From: objectRef{p.FromID}, To: objectRef{p.ToID}, Types: types})
+	}
+
+	_, err := c.post(ctx, url, map[string]interface{}{"inputs": inputs})
+	return err
+}
+
+// BatchReadAssociations retrieves the toType associations of up to
+// MaxBatchSize objects in one request, keyed by object ID. Objects without
+// associations are left out.
+func (c *Client) BatchReadAssociations(ctx context.Context, fromType, toType ObjectType, ids []string) (map[string][]Association, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one object ID is required")
+	}
+	if len(ids) > MaxBatchSize {
+		return nil, fmt.Errorf("batch size %d exceeds the maximum of %d", len(ids), MaxBatchSize)
+	}
+
+	url := fmt.Sprintf("%s/crm/v4/associations/%s/%s/batch/read", c.BaseURL, fromType, toType)
+
+	inputs := make([]objectRef, 0, len(ids))
+	for _, id := range ids {
+		inputs = append(inputs, objectRef{id})
+	}
+
+	body, err := c.post(ctx, url, map[string]interface{}{"inputs": inputs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Results []struct {
+			From objectRef     `json:"from"`
+			To   []Association `json:"to"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse associations response: %w", err)
+	}
+
+	associations := make(map[string][]Association, len(result.Results))
+	for _, r := range result.Results {
+		associations[r.From.ID] = append(associations[r.From.ID], r.To...)
+	}
+	return associations, nil
+}
+
+func validateAssociationPairs(pairs []AssociationPair) error {
+	if len(pairs) == 0 {
+		return fmt.Errorf("at least one association pair is required")
+	}
+	if len(pairs) > MaxBatchSize {
+		return fmt.Errorf("batch size %d exceeds the maximum of %d", len(pairs), MaxBatchSize)
+	}
+	for i, p := range pairs {
+		if p.FromID == "" || p.ToID == "" {
+			return fmt.Errorf("association pair %d is missing an object ID", i)
+		}
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -44,7 +46,7 @@ func TestClient_ListAssociations(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListAssociations(ObjectTypeContacts, "12345", ObjectTypeNotes, ListOptions{})
+		result, err := client.ListAssociations(context.Background(), ObjectTypeContacts, "12345", ObjectTypeNotes, ListOptions{})
 		require.NoError(t, err)
 		require.Len(t, result.Results, 1)
 		assert.Equal(t, "98765", result.Results[0].ToObjectID.String())
@@ -66,7 +68,7 @@ func TestClient_ListAssociations(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListAssociations(ObjectTypeContacts, "12345", ObjectTypeNotes, ListOptions{})
+		result, err := client.ListAssociations(context.Background(), ObjectTypeContacts, "12345", ObjectTypeNotes, ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -88,7 +90,7 @@ func TestClient_ListAssociations(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListAssociations(ObjectTypeContacts, "12345", ObjectTypeNotes, ListOptions{})
+		result, err := client.ListAssociations(context.Background(), ObjectTypeContacts, "12345", ObjectTypeNotes, ListOptions{})
 		require.NoError(t, err)
 
 		// Re-marshalling produces valid JSON; json.Number preserves the
@@ -103,9 +105,225 @@ func TestClient_ListAssociations(t *testing.T) {
 
 	t.Run("empty from ID returns error", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		result, err := client.ListAssociations(ObjectTypeContacts, "", ObjectTypeNotes, ListOptions{})
+		result, err := client.ListAssociations(context.Background(), ObjectTypeContacts, "", ObjectTypeNotes, ListOptions{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "from object ID is required")
 		assert.Nil(t, result)
 	})
 }
+
+func TestClient_CreateAssociationWithTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v4/objects/contacts/1/associations/companies/2", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `[{"associationCategory":"USER_DEFINED","associationTypeId":36}]`, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	err := client.CreateAssociationWithTypes(context.Background(), ObjectTypeContacts, "1", ObjectTypeCompanies, "2", []AssociationSpec{
+		{Category: AssociationCategoryUser, TypeID: 36},
+	})
+	require.NoError(t, err)
+
+	err = client.CreateAssociationWithTypes(context.Background(), ObjectTypeContacts, "1", ObjectTypeCompanies, "2", nil)
+	assert.Error(t, err)
+}
+
+func TestClient_AssociationLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/crm/v4/associations/contacts/companies/labels":
+			w.Write([]byte(`{"results": [
+				{"category": "HUBSPOT_DEFINED", "typeId": 1, "label": null},
+				{"category": "USER_DEFINED", "typeId": 36, "label": "Decision maker"}
+			]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/crm/v4/associations/contacts/companies/labels":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"label":"Champion","name":"champion"}`, string(body))
+			w.Write([]byte(`{"results": [{"category": "USER_DEFINED", "typeId": 40, "label": "Champion"}]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/crm/v4/associations/contacts/companies/labels/40":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	labels, err := client.ListAssociationLabels(context.Background(), ObjectTypeContacts, ObjectTypeCompanies)
+	require.NoError(t, err)
+	require.Len(t, labels.Results, 2)
+	assert.Equal(t, "", labels.Results[0].Label)
+	assert.Equal(t, AssociationSpec{Category: "USER_DEFINED", TypeID: 36}, labels.Results[1].Spec())
+
+	created, err := client.CreateAssociationLabel(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, "Champion", "champion", "")
+	require.NoError(t, err)
+	assert.Equal(t, 40, created.Results[0].TypeID)
+
+	require.NoError(t, client.DeleteAssociationLabel(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, 40))
+	assert.Error(t, client.DeleteAssociationLabel(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, 0))
+}
+
+func TestClient_BatchCreateAssociations(t *testing.T) {
+	t.Run("with label", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v4/associations/contacts/companies/batch/create", r.URL.Path)
+
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"inputs":[
+				{"from":{"id":"1"},"to":{"id":"10"},"types":[{"associationCategory":"USER_DEFINED","associationTypeId":36}]}
+			]}`, string(body))
+
+			w.WriteHeader(http.StatusMultiStatus)
+			w.Write([]byte(`{"status":"COMPLETE","results":[],"numErrors":1,"errors":[{"status":"error","message":"Object not found"}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.BatchCreateAssociations(context.Background(), ObjectTypeContacts, ObjectTypeCompanies,
+			[]AssociationPair{{FromID: "1", ToID: "10"}},
+			[]AssociationSpec{{Category: AssociationCategoryUser, TypeID: 36}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.NumErrors)
+		assert.Equal(t, "Object not found", result.Errors[0].Message)
+	})
+
+	t.Run("default association", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v4/associations/contacts/companies/batch/associate/default", r.URL.Path)
+			w.Write([]byte(`{"status":"COMPLETE","results":[{}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		_, err := client.BatchCreateAssociations(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, []AssociationPair{{FromID: "1", ToID: "10"}}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("validates pairs", func(t *testing.T) {
+		client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+
+		_, err := client.BatchCreateAssociations(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, nil, nil)
+		assert.Error(t, err)
+
+		_, err = client.BatchCreateAssociations(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, []AssociationPair{{FromID: "1"}}, nil)
+		assert.Error(t, err)
+
+		_, err = client.BatchCreateAssociations(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, make([]AssociationPair, MaxBatchSize+1), nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_BatchDeleteAssociations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v4/associations/contacts/companies/batch/archive", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"inputs":[
+			{"from":{"id":"1"},"to":[{"id":"10"},{"id":"11"}]},
+			{"from":{"id":"2"},"to":[{"id":"20"}]}
+		]}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	err := client.BatchDeleteAssociations(context.Background(), ObjectTypeContacts, ObjectTypeCompanies, []AssociationPair{
+		{FromID: "1", ToID: "10"},
+		{FromID: "2", ToID: "20"},
+		{FromID: "1", ToID: "11"},
+	})
+	require.NoError(t, err)
+}
+
+func TestClient_RemoveAssociationLabel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v4/associations/contacts/companies/batch/labels/archive", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"inputs":[
+			{"from":{"id":"1"},"to":{"id":"10"},"types":[{"associationCategory":"USER_DEFINED","associationTypeId":36}]}
+		]}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	err := client.RemoveAssociationLabel(context.Background(), ObjectTypeContacts, "1", ObjectTypeCompanies, "10", []AssociationSpec{
+		{Category: AssociationCategoryUser, TypeID: 36},
+	})
+	require.NoError(t, err)
+}
+
+func TestDefaultAssociationType(t *testing.T) {
+	tests := []struct {
+		from, to ObjectType
+		typeID   int
+	}{
+		{ObjectTypeNotes, ObjectTypeContacts, 202},
+		{ObjectTypeCalls, ObjectTypeCompanies, 182},
+		{ObjectTypeEmails, ObjectTypeDeals, 210},
+		{ObjectTypeMeetings, ObjectTypeTickets, 226},
+		{ObjectTypeTasks, ObjectTypeContacts, 204},
+		{ObjectTypeQuotes, ObjectTypeDeals, 64},
+		{ObjectTypeQuotes, ObjectTypeQuoteTemplates, 286},
+		{ObjectTypeLineItems, ObjectTypeDeals, 20},
+	}
+
+	for _, tt := range tests {
+		spec, ok := DefaultAssociationType(tt.from, tt.to)
+		require.True(t, ok)
+		assert.Equal(t, AssociationSpec{Category: AssociationCategoryHubSpot, TypeID: tt.typeID}, spec)
+	}
+
+	_, ok := DefaultAssociationType(ObjectTypeContacts, ObjectTypeCompanies)
+	assert.False(t, ok)
+}
+
+func TestClient_BatchReadAssociations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/crm/v4/associations/deals/companies/batch/read", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"inputs": [{"id": "1"}, {"id": "2"}]}`, string(body))
+
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{
+			"status": "COMPLETE",
+			"results": [{"from": {"id": "1"}, "to": [
+				{"toObjectId": 501, "associationTypes": [{"category": "HUBSPOT_DEFINED", "typeId": 5, "label": "Primary"}]},
+				{"toObjectId": 502, "associationTypes": [{"category": "HUBSPOT_DEFINED", "typeId": 341}]}
+			]}],
+			"errors": [{"status": "error", "message": "No companies are associated with deal 2"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	associations, err := client.BatchReadAssociations(context.Background(), ObjectTypeDeals, ObjectTypeCompanies, []string{"1", "2"})
+	require.NoError(t, err)
+	require.Len(t, associations["1"], 2)
+	assert.Equal(t, "501", associations["1"][0].ToObjectID.String())
+	assert.Equal(t, "Primary", associations["1"][0].AssociationTypes[0].Label)
+	assert.NotContains(t, associations, "2")
+
+	_, err = client.BatchReadAssociations(context.Background(), ObjectTypeDeals, ObjectTypeCompanies, nil)
+	assert.ErrorContains(t, err, "at least one object ID")
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TokenInfo describes the access token a client authenticates with
+type TokenInfo struct {
+	// Type is "private-app" or "oauth"
+	Type      string   `json:"type"`
+	PortalID  int64    `json:"portalId"`
+	HubDomain string   `json:"hubDomain,omitempty"`
+	AppID     int64    `json:"appId,omitempty"`
+	UserID    int64    `json:"userId,omitempty"`
+	User      string   `json:"user,omitempty"`
+	Scopes    []string `json:"scopes"`
+	// ExpiresAt is when an OAuth token expires; private app tokens do not
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// privateAppTokenInfo is the private app token introspection response
+type privateAppTokenInfo struct {
+	UserID int64    `json:"userId"`
+	HubID  int64    `json:"hubId"`
+	AppID  int64    `json:"appId"`
+	Scopes []string `json:"scopes"`
+}
+
+// oauthTokenInfo is the OAuth access token introspection response
+type oauthTokenInfo struct {
+	User      string   `json:"user"`
+	UserID    int64    `json:"user_id"`
+	HubDomain string   `json:"hub_domain"`
+	HubID     int64    `json:"hub_id"`
+	AppID     int64    `json:"app_id"`
+	Scopes    []string `json:"scopes"`
+	ExpiresIn int64    `json:"expires_in"`
+}
+
+// IsPrivateAppToken reports whether a token belongs to a private app rather
+// than an OAuth install. Private app tokens start with "pat-".
+func IsPrivateAppToken(token string) bool {
+	return strings.HasPrefix(token, "pat-")
+}
+
+// GetTokenInfo looks up the portal, app, user, and scopes of the client's
+// access token
+func (c *Client) GetTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	if c.AccessToken == "" {
+		return nil, ErrAccessTokenRequired
+	}
+	if IsPrivateAppToken(c.AccessToken) {
+		return c.getPrivateAppTokenInfo(ctx)
+	}
+	return c.getOAuthTokenInfo(ctx)
+}
+
+func (c *Client) getPrivateAppTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	endpoint := fmt.Sprintf("%s/oauth/v2/private-apps/get/access-token-info", c.BaseURL)
+
+	body, err := c.post(ctx, endpoint, map[string]string{"tokenKey": c.AccessToken})
+	if err != nil {
+		return nil, err
+	}
+
+	var result privateAppTokenInfo
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse token info response: %w", err)
+	}
+
+	return &TokenInfo{
+		Type:     "private-app",
+		PortalID: result.HubID,
+		AppID:    result.AppID,
+		UserID:   result.UserID,
+		Scopes:   result.Scopes,
+	}, nil
+}
+
+func (c *Client) getOAuthTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	endpoint := fmt.Sprintf("%s/oauth/v1/access-tokens/%s", c.BaseURL, url.PathEscape(c.AccessToken))
+
+	body, err := c.get(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var result oauthTokenInfo
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse token info response: %w", err)
+	}
+
+	info := &TokenInfo{
+		Type:      "oauth",
+		PortalID:  result.HubID,
+		HubDomain: result.HubDomain,
+		AppID:     result.AppID,
+		UserID:    result.UserID,
+		User:      result.User,
+		Scopes:    result.Scopes,
+	}
+	if result.ExpiresIn > 0 {
+		expires := time.Now().Add(time.Duration(result.ExpiresIn) * time.Second).UTC().Truncate(time.Second)
+		info.ExpiresAt = &expires
+	}
+	return info, nil
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPrivateAppToken(t *testing.T) {
+	assert.True(t, IsPrivateAppToken("pat-na1-abc"))
+	assert.False(t, IsPrivateAppToken("CJT1abc"))
+}
+
+func TestClient_GetTokenInfo_PrivateApp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/oauth/v2/private-apps/get/access-token-info", r.URL.Path)
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "pat-na1-abc", body["tokenKey"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"userId":42,"hubId":12345,"appId":678,"scopes":["crm.objects.contacts.read","oauth"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "pat-na1-abc", HTTPClient: server.Client()}
+
+	info, err := client.GetTokenInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "private-app", info.Type)
+	assert.Equal(t, int64(12345), info.PortalID)
+	assert.Equal(t, int64(678), info.AppID)
+	assert.Equal(t, int64(42), info.UserID)
+	assert.Equal(t, []string{"crm.objects.contacts.read", "oauth"}, info.Scopes)
+	assert.Nil(t, info.ExpiresAt)
+}
+
+func TestClient_GetTokenInfo_OAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/oauth/v1/access-tokens/oauth-token", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"user":"jane@example.com","user_id":42,"hub_domain":"example.com","hub_id":12345,"app_id":678,"scopes":["oauth"],"expires_in":1800}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "oauth-token", HTTPClient: server.Client()}
+
+	before := time.Now()
+	info, err := client.GetTokenInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "oauth", info.Type)
+	assert.Equal(t, int64(12345), info.PortalID)
+	assert.Equal(t, "example.com", info.HubDomain)
+	assert.Equal(t, "jane@example.com", info.User)
+	require.NotNil(t, info.ExpiresAt)
+	assert.WithinDuration(t, before.Add(30*time.Minute), *info.ExpiresAt, 5*time.Second)
+}
+
+func TestClient_GetTokenInfo_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"status":"error","message":"token is expired"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "pat-na1-abc", HTTPClient: server.Client()}
+
+	_, err := client.GetTokenInfo(context.Background())
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -25,7 +26,21 @@ type WorkflowList struct {
 }
 
 // ListWorkflows retrieves workflows with pagination
-func (c *Client) ListWorkflows(opts ListOptions) (*WorkflowList, error) {
+func (c *Client) ListWorkflows(ctx context.Context, opts ListOptions) (*WorkflowList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Workflow, *Paging, error) {
+			page, err := c.ListWorkflows(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &WorkflowList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/automation/v4/flows", c.BaseURL)
 
 	params := make(map[string]string)
@@ -40,7 +55,7 @@ func (c *Client) ListWorkflows(opts ListOptions) (*WorkflowList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -54,14 +69,14 @@ func (c *Client) ListWorkflows(opts ListOptions) (*WorkflowList, error) {
 }
 
 // GetWorkflow retrieves a single workflow by ID
-func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
+func (c *Client) GetWorkflow(ctx context.Context, workflowID string) (*Workflow, error) {
 	if workflowID == "" {
 		return nil, fmt.Errorf("workflow ID is required")
 	}
 
 	url := fmt.Sprintf("%s/automation/v4/flows/%s", c.BaseURL, workflowID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -74,11 +89,31 @@ func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
 	return &result, nil
 }
 
+// GetWorkflowDefinition retrieves the full definition of a workflow, with
+// its actions, enrollment criteria, and settings, as HubSpot returns it
+func (c *Client) GetWorkflowDefinition(ctx context.Context, workflowID string) (json.RawMessage, error) {
+	if workflowID == "" {
+		return nil, fmt.Errorf("workflow ID is required")
+	}
+
+	url := fmt.Sprintf("%s/automation/v4/flows/%s", c.BaseURL, workflowID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("failed to parse workflow response: invalid JSON")
+	}
+
+	return json.RawMessage(body), nil
+}
+
 // CreateWorkflow creates a new workflow
-func (c *Client) CreateWorkflow(data map[string]interface{}) (*Workflow, error) {
+func (c *Client) CreateWorkflow(ctx context.Context, data map[string]interface{}) (*Workflow, error) {
 	url := fmt.Sprintf("%s/automation/v4/flows", c.BaseURL)
 
-	body, err := c.post(url, data)
+	body, err := c.post(ctx, url, data)
 	if err != nil {
 		return nil, err
 	}
@@ -92,14 +127,14 @@ func (c *Client) CreateWorkflow(data map[string]interface{}) (*Workflow, error)
 }
 
 // UpdateWorkflow updates an existing workflow
-func (c *Client) UpdateWorkflow(workflowID string, data map[string]interface{}) (*Workflow, error) {
+func (c *Client) UpdateWorkflow(ctx context.Context, workflowID string, data map[string]interface{}) (*Workflow, error) {
 	if workflowID == "" {
 		return nil, fmt.Errorf("workflow ID is required")
 	}
 
 	url := fmt.Sprintf("%s/automation/v4/flows/%s", c.BaseURL, workflowID)
 
-	body, err := c.patch(url, data)
+	body, err := c.patch(ctx, url, data)
 	if err != nil {
 		return nil, err
 	}
@@ -113,14 +148,14 @@ func (c *Client) UpdateWorkflow(workflowID string, data map[string]interface{})
 }
 
 // DeleteWorkflow deletes a workflow by ID
-func (c *Client) DeleteWorkflow(workflowID string) error {
+func (c *Client) DeleteWorkflow(ctx context.Context, workflowID string) error {
 	if workflowID == "" {
 		return fmt.Errorf("workflow ID is required")
 	}
 
 	url := fmt.Sprintf("%s/automation/v4/flows/%s", c.BaseURL, workflowID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
 
@@ -140,7 +175,7 @@ type WorkflowEnrollmentList struct {
 }
 
 // EnrollInWorkflow enrolls an object in a workflow
-func (c *Client) EnrollInWorkflow(workflowID string, objectID string) error {
+func (c *Client) EnrollInWorkflow(ctx context.Context, workflowID string, objectID string) error {
 	if workflowID == "" {
 		return fmt.Errorf("workflow ID is required")
 	}
@@ -154,12 +189,45 @@ func (c *Client) EnrollInWorkflow(workflowID string, objectID string) error {
 		"objectId": objectID,
 	}
 
-	_, err := c.post(url, data)
+	_, err := c.post(ctx, url, data)
+	return err
+}
+
+// UnenrollFromWorkflow removes an object from a workflow it is enrolled in
+func (c *Client) UnenrollFromWorkflow(ctx context.Context, workflowID string, objectID string) error {
+	if workflowID == "" {
+		return fmt.Errorf("workflow ID is required")
+	}
+	if objectID == "" {
+		return fmt.Errorf("object ID is required")
+	}
+
+	url := fmt.Sprintf("%s/automation/v4/flows/%s/enrollments/stop", c.BaseURL, workflowID)
+
+	data := map[string]interface{}{
+		"objectId": objectID,
+	}
+
+	_, err := c.post(ctx, url, data)
 	return err
 }
 
 // ListWorkflowEnrollments lists enrollments for a workflow
-func (c *Client) ListWorkflowEnrollments(workflowID string, opts ListOptions) (*WorkflowEnrollmentList, error) {
+func (c *Client) ListWorkflowEnrollments(ctx context.Context, workflowID string, opts ListOptions) (*WorkflowEnrollmentList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]WorkflowEnrollment, *Paging, error) {
+			page, err := c.ListWorkflowEnrollments(ctx, workflowID, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &WorkflowEnrollmentList{Results: results, Paging: paging}, nil
+	}
+
 	if workflowID == "" {
 		return nil, fmt.Errorf("workflow ID is required")
 	}
@@ -178,7 +246,7 @@ func (c *Client) ListWorkflowEnrollments(workflowID string, opts ListOptions) (*
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -44,7 +46,7 @@ func TestClient_ListWorkflows(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListWorkflows(ListOptions{Limit: 10})
+		result, err := client.ListWorkflows(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "workflow-123", result.Results[0].ID)
@@ -67,7 +69,7 @@ func TestClient_ListWorkflows(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListWorkflows(ListOptions{})
+		result, err := client.ListWorkflows(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -99,7 +101,7 @@ func TestClient_GetWorkflow(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		workflow, err := client.GetWorkflow("workflow-123")
+		workflow, err := client.GetWorkflow(context.Background(), "workflow-123")
 		require.NoError(t, err)
 		assert.Equal(t, "workflow-123", workflow.ID)
 		assert.Equal(t, "Welcome Email", workflow.Name)
@@ -121,7 +123,7 @@ func TestClient_GetWorkflow(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		workflow, err := client.GetWorkflow("nonexistent")
+		workflow, err := client.GetWorkflow(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, workflow)
@@ -129,7 +131,7 @@ func TestClient_GetWorkflow(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		workflow, err := client.GetWorkflow("")
+		workflow, err := client.GetWorkflow(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "workflow ID is required")
 		assert.Nil(t, workflow)
@@ -163,7 +165,7 @@ func TestClient_CreateWorkflow(t *testing.T) {
 			"name": "New Workflow",
 			"type": "CONTACT_FLOW",
 		}
-		workflow, err := client.CreateWorkflow(data)
+		workflow, err := client.CreateWorkflow(context.Background(), data)
 		require.NoError(t, err)
 		assert.Equal(t, "workflow-456", workflow.ID)
 		assert.Equal(t, "New Workflow", workflow.Name)
@@ -196,7 +198,7 @@ func TestClient_UpdateWorkflow(t *testing.T) {
 		updates := map[string]interface{}{
 			"name": "Updated Workflow",
 		}
-		workflow, err := client.UpdateWorkflow("workflow-123", updates)
+		workflow, err := client.UpdateWorkflow(context.Background(), "workflow-123", updates)
 		require.NoError(t, err)
 		assert.Equal(t, "workflow-123", workflow.ID)
 		assert.Equal(t, "Updated Workflow", workflow.Name)
@@ -204,7 +206,7 @@ func TestClient_UpdateWorkflow(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		workflow, err := client.UpdateWorkflow("", map[string]interface{}{})
+		workflow, err := client.UpdateWorkflow(context.Background(), "", map[string]interface{}{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "workflow ID is required")
 		assert.Nil(t, workflow)
@@ -227,13 +229,13 @@ func TestClient_DeleteWorkflow(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteWorkflow("workflow-123")
+		err := client.DeleteWorkflow(context.Background(), "workflow-123")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteWorkflow("")
+		err := client.DeleteWorkflow(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "workflow ID is required")
 	})
@@ -256,25 +258,67 @@ func TestClient_EnrollInWorkflow(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.EnrollInWorkflow("workflow-123", "contact-456")
+		err := client.EnrollInWorkflow(context.Background(), "workflow-123", "contact-456")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty workflow ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.EnrollInWorkflow("", "contact-456")
+		err := client.EnrollInWorkflow(context.Background(), "", "contact-456")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "workflow ID is required")
 	})
 
 	t.Run("empty object ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.EnrollInWorkflow("workflow-123", "")
+		err := client.EnrollInWorkflow(context.Background(), "workflow-123", "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "object ID is required")
 	})
 }
 
+func TestClient_GetWorkflowDefinition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/automation/v4/flows/workflow-123", r.URL.Path)
+		w.Write([]byte(`{"id": "workflow-123", "name": "Welcome Email", "actions": [{"actionId": "1", "fields": {"content_id": "5550"}}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	def, err := client.GetWorkflowDefinition(context.Background(), "workflow-123")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "workflow-123", "name": "Welcome Email", "actions": [{"actionId": "1", "fields": {"content_id": "5550"}}]}`, string(def))
+
+	_, err = client.GetWorkflowDefinition(context.Background(), "")
+	assert.EqualError(t, err, "workflow ID is required")
+}
+
+func TestClient_UnenrollFromWorkflow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/automation/v4/flows/workflow-123/enrollments/stop", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"objectId": "contact-456"}`, string(body))
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		require.NoError(t, client.UnenrollFromWorkflow(context.Background(), "workflow-123", "contact-456"))
+	})
+
+	t.Run("missing IDs", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		assert.EqualError(t, client.UnenrollFromWorkflow(context.Background(), "", "contact-456"), "workflow ID is required")
+		assert.EqualError(t, client.UnenrollFromWorkflow(context.Background(), "workflow-123", ""), "object ID is required")
+	})
+}
+
 func TestClient_ListWorkflowEnrollments(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -306,7 +350,7 @@ func TestClient_ListWorkflowEnrollments(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListWorkflowEnrollments("workflow-123", ListOptions{Limit: 10})
+		result, err := client.ListWorkflowEnrollments(context.Background(), "workflow-123", ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "contact-456", result.Results[0].ObjectID)
@@ -317,7 +361,7 @@ func TestClient_ListWorkflowEnrollments(t *testing.T) {
 
 	t.Run("empty workflow ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		result, err := client.ListWorkflowEnrollments("", ListOptions{})
+		result, err := client.ListWorkflowEnrollments(context.Background(), "", ListOptions{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "workflow ID is required")
 		assert.Nil(t, result)
@@ -336,7 +380,7 @@ func TestClient_ListWorkflowEnrollments(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListWorkflowEnrollments("workflow-123", ListOptions{})
+		result, err := client.ListWorkflowEnrollments(context.Background(), "workflow-123", ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
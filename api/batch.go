@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MaxBatchSize is the maximum number of inputs HubSpot accepts in a single
+// CRM batch request
+const MaxBatchSize = 100
+
+// BatchInput represents a single record in a batch create, update, or upsert
+// request. ID is only used for updates and upserts; IDProperty names the
+// unique property ID holds a value of, for upserts.
+type BatchInput struct {
+	ID         string                 `json:"id,omitempty"`
+	IDProperty string                 `json:"idProperty,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// BatchError represents a per-record error returned by a batch request
+type BatchError struct {
+	Status   string              `json:"status"`
+	Category string              `json:"category,omitempty"`
+	Message  string              `json:"message"`
+	Context  map[string][]string `json:"context,omitempty"`
+}
+
+// BatchResult represents the response from a batch create or update request.
+//
+// HubSpot returns 201/200 when every record succeeded and 207 (multi-status)
+// when some records failed; in the latter case Results holds the successes
+// and Errors the failures.
+type BatchResult struct {
+	Status    string       `json:"status"`
+	Results   []CRMObject  `json:"results"`
+	Errors    []BatchError `json:"errors,omitempty"`
+	NumErrors int          `json:"numErrors,omitempty"`
+}
+
+// batchRequest is the request body for CRM batch endpoints
+type batchRequest struct {
+	Inputs []BatchInput `json:"inputs"`
+}
+
+// BatchCreateObjects creates up to MaxBatchSize CRM objects in one request
+func (c *Client) BatchCreateObjects(ctx context.Context, objectType ObjectType, inputs []BatchInput) (*BatchResult, error) {
+	if err := validateBatchInputs(inputs); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/objects/%s/batch/create", c.BaseURL, objectType)
+
+	return c.doBatch(ctx, url, inputs)
+}
+
+// BatchUpdateObjects updates up to MaxBatchSize CRM objects in one request.
+// Every input must carry an ID.
+func (c *Client) BatchUpdateObjects(ctx context.Context, objectType ObjectType, inputs []BatchInput) (*BatchResult, error) {
+	if err := validateBatchInputs(inputs); err != nil {
+		return nil, err
+	}
+	for i, in := range inputs {
+		if in.ID == "" {
+			return nil, fmt.Errorf("batch input %d is missing an object ID", i)
+		}
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/objects/%s/batch/update", c.BaseURL, objectType)
+
+	return c.doBatch(ctx, url, inputs)
+}
+
+// UpsertedObject is a record returned by a batch upsert. New reports whether
+// it was created rather than updated.
+type UpsertedObject struct {
+	CRMObject
+	New bool `json:"new"`
+}
+
+// BatchUpsertResult represents the response from a batch upsert request
+type BatchUpsertResult struct {
+	Status    string           `json:"status"`
+	Results   []UpsertedObject `json:"results"`
+	Errors    []BatchError     `json:"errors,omitempty"`
+	NumErrors int              `json:"numErrors,omitempty"`
+}
+
+// BatchUpsertObjects creates or updates up to MaxBatchSize CRM objects in
+// one request, matching existing records by each input's IDProperty, such
+// as email for contacts or a custom unique property.
+func (c *Client) BatchUpsertObjects(ctx context.Context, objectType ObjectType, inputs []BatchInput) (*BatchUpsertResult, error) {
+	if err := validateBatchInputs(inputs); err != nil {
+		return nil, err
+	}
+	for i, in := range inputs {
+		if in.ID == "" || in.IDProperty == "" {
+			return nil, fmt.Errorf("batch input %d needs an ID and an ID property to upsert", i)
+		}
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/objects/%s/batch/upsert", c.BaseURL, objectType)
+
+	body, err := c.post(ctx, url, batchRequest{Inputs: inputs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result BatchUpsertResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// batchReadRequest is the request body for the CRM batch read endpoint
+type batchReadRequest struct {
+	Inputs     []BatchInput `json:"inputs"`
+	Properties []string     `json:"properties,omitempty"`
+}
+
+// BatchReadObjects retrieves up to MaxBatchSize CRM objects by ID in one
+// request. IDs that do not exist are reported in Errors.
+func (c *Client) BatchReadObjects(ctx context.Context, objectType ObjectType, ids []string, properties []string) (*BatchResult, error) {
+	inputs := make([]BatchInput, 0, len(ids))
+	for _, id := range ids {
+		inputs = append(inputs, BatchInput{ID: id})
+	}
+	if err := validateBatchInputs(inputs); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/objects/%s/batch/read", c.BaseURL, objectType)
+
+	body, err := c.post(ctx, url, batchReadRequest{Inputs: inputs, Properties: properties})
+	if err != nil {
+		return nil, err
+	}
+
+	var result BatchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	return &result, nil
+}
+
+func (c *Client) doBatch(ctx context.Context, url string, inputs []BatchInput) (*BatchResult, error) {
+	body, err := c.post(ctx, url, batchRequest{Inputs: inputs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result BatchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	return &result, nil
+}
+
+func validateBatchInputs(inputs []BatchInput) error {
+	if len(inputs) == 0 {
+		return fmt.Errorf("at least one batch input is required")
+	}
+	if len(inputs) > MaxBatchSize {
+		return fmt.Errorf("batch size %d exceeds the maximum of %d", len(inputs), MaxBatchSize)
+	}
+	return nil
+}
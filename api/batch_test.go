@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_BatchCreateObjects(t *testing.T) {
+	t.Run("create contacts", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/contacts/batch/create", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req batchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Len(t, req.Inputs, 2)
+			assert.Equal(t, "a@example.com", req.Inputs[0].Properties["email"])
+			assert.Empty(t, req.Inputs[0].ID)
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{
+				"status": "COMPLETE",
+				"results": [
+					{"id": "1", "properties": {"email": "a@example.com"}},
+					{"id": "2", "properties": {"email": "b@example.com"}}
+				]
+			}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		result, err := client.BatchCreateObjects(context.Background(), ObjectTypeContacts, []BatchInput{
+			{Properties: map[string]interface{}{"email": "a@example.com"}},
+			{Properties: map[string]interface{}{"email": "b@example.com"}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "COMPLETE", result.Status)
+		assert.Len(t, result.Results, 2)
+		assert.Empty(t, result.Errors)
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusMultiStatus)
+			w.Write([]byte(`{
+				"status": "COMPLETE",
+				"results": [{"id": "1", "properties": {"email": "a@example.com"}}],
+				"numErrors": 1,
+				"errors": [{"status": "error", "category": "VALIDATION_ERROR", "message": "Property values were not valid"}]
+			}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		result, err := client.BatchCreateObjects(context.Background(), ObjectTypeContacts, []BatchInput{
+			{Properties: map[string]interface{}{"email": "a@example.com"}},
+			{Properties: map[string]interface{}{"email": "not-an-email"}},
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Results, 1)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "VALIDATION_ERROR", result.Errors[0].Category)
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		client := &Client{BaseURL: "http://localhost", AccessToken: "test-token"}
+
+		result, err := client.BatchCreateObjects(context.Background(), ObjectTypeContacts, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("too many inputs", func(t *testing.T) {
+		client := &Client{BaseURL: "http://localhost", AccessToken: "test-token"}
+
+		inputs := make([]BatchInput, MaxBatchSize+1)
+		result, err := client.BatchCreateObjects(context.Background(), ObjectTypeContacts, inputs)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the maximum")
+		assert.Nil(t, result)
+	})
+}
+
+func TestClient_BatchUpdateObjects(t *testing.T) {
+	t.Run("update deals", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/deals/batch/update", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req batchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Len(t, req.Inputs, 1)
+			assert.Equal(t, "42", req.Inputs[0].ID)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status": "COMPLETE", "results": [{"id": "42", "properties": {"amount": "100"}}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		result, err := client.BatchUpdateObjects(context.Background(), ObjectTypeDeals, []BatchInput{
+			{ID: "42", Properties: map[string]interface{}{"amount": "100"}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "42", result.Results[0].ID)
+	})
+
+	t.Run("missing ID", func(t *testing.T) {
+		client := &Client{BaseURL: "http://localhost", AccessToken: "test-token"}
+
+		result, err := client.BatchUpdateObjects(context.Background(), ObjectTypeDeals, []BatchInput{
+			{Properties: map[string]interface{}{"amount": "100"}},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing an object ID")
+		assert.Nil(t, result)
+	})
+}
+
+func TestClient_BatchReadObjects(t *testing.T) {
+	t.Run("read feedback submissions", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/feedback_submissions/batch/read", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req batchReadRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Len(t, req.Inputs, 2)
+			assert.Equal(t, "7", req.Inputs[1].ID)
+			assert.Equal(t, []string{"hs_response_value"}, req.Properties)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status": "COMPLETE", "results": [
+				{"id": "5", "properties": {"hs_response_value": "9"}},
+				{"id": "7", "properties": {"hs_response_value": "3"}}
+			]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.BatchReadObjects(context.Background(), ObjectTypeFeedbackSubmissions, []string{"5", "7"}, []string{"hs_response_value"})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 2)
+		assert.Equal(t, "3", result.Results[1].GetProperty("hs_response_value"))
+	})
+
+	t.Run("no IDs", func(t *testing.T) {
+		client := &Client{BaseURL: "http://localhost", AccessToken: "test-token"}
+
+		_, err := client.BatchReadObjects(context.Background(), ObjectTypeFeedbackSubmissions, nil, nil)
+		assert.ErrorContains(t, err, "at least one batch input is required")
+	})
+}
+
+func TestClient_BatchUpsertObjects(t *testing.T) {
+	t.Run("upsert contacts by email", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/contacts/batch/upsert", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req batchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Len(t, req.Inputs, 1)
+			assert.Equal(t, "jane@example.com", req.Inputs[0].ID)
+			assert.Equal(t, "email", req.Inputs[0].IDProperty)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status": "COMPLETE", "results": [{"id": "42", "new": true, "properties": {"email": "jane@example.com"}}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.BatchUpsertObjects(context.Background(), ObjectTypeContacts, []BatchInput{
+			{ID: "jane@example.com", IDProperty: "email", Properties: map[string]interface{}{"firstname": "Jane"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "42", result.Results[0].ID)
+		assert.True(t, result.Results[0].New)
+		assert.Equal(t, "jane@example.com", result.Results[0].GetProperty("email"))
+	})
+
+	t.Run("missing ID property", func(t *testing.T) {
+		client := &Client{BaseURL: "http://localhost", AccessToken: "test-token"}
+
+		_, err := client.BatchUpsertObjects(context.Background(), ObjectTypeContacts, []BatchInput{{ID: "jane@example.com"}})
+		assert.ErrorContains(t, err, "needs an ID and an ID property")
+	})
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -82,7 +83,21 @@ type BlogTagList struct {
 }
 
 // ListBlogPosts retrieves blog posts with pagination
-func (c *Client) ListBlogPosts(opts ListOptions) (*BlogPostList, error) {
+func (c *Client) ListBlogPosts(ctx context.Context, opts ListOptions) (*BlogPostList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]BlogPost, *Paging, error) {
+			page, err := c.ListBlogPosts(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &BlogPostList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/cms/v3/blogs/posts", c.BaseURL)
 
 	params := make(map[string]string)
@@ -97,7 +112,7 @@ func (c *Client) ListBlogPosts(opts ListOptions) (*BlogPostList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -111,14 +126,14 @@ func (c *Client) ListBlogPosts(opts ListOptions) (*BlogPostList, error) {
 }
 
 // GetBlogPost retrieves a single blog post by ID
-func (c *Client) GetBlogPost(postID string) (*BlogPost, error) {
+func (c *Client) GetBlogPost(ctx context.Context, postID string) (*BlogPost, error) {
 	if postID == "" {
 		return nil, fmt.Errorf("post ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/blogs/posts/%s", c.BaseURL, postID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -132,10 +147,10 @@ func (c *Client) GetBlogPost(postID string) (*BlogPost, error) {
 }
 
 // CreateBlogPost creates a new blog post
-func (c *Client) CreateBlogPost(post map[string]interface{}) (*BlogPost, error) {
+func (c *Client) CreateBlogPost(ctx context.Context, post map[string]interface{}) (*BlogPost, error) {
 	url := fmt.Sprintf("%s/cms/v3/blogs/posts", c.BaseURL)
 
-	body, err := c.post(url, post)
+	body, err := c.post(ctx, url, post)
 	if err != nil {
 		return nil, err
 	}
@@ -149,14 +164,14 @@ func (c *Client) CreateBlogPost(post map[string]interface{}) (*BlogPost, error)
 }
 
 // UpdateBlogPost updates an existing blog post
-func (c *Client) UpdateBlogPost(postID string, updates map[string]interface{}) (*BlogPost, error) {
+func (c *Client) UpdateBlogPost(ctx context.Context, postID string, updates map[string]interface{}) (*BlogPost, error) {
 	if postID == "" {
 		return nil, fmt.Errorf("post ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/blogs/posts/%s", c.BaseURL, postID)
 
-	body, err := c.patch(url, updates)
+	body, err := c.patch(ctx, url, updates)
 	if err != nil {
 		return nil, err
 	}
@@ -170,19 +185,33 @@ func (c *Client) UpdateBlogPost(postID string, updates map[string]interface{}) (
 }
 
 // DeleteBlogPost archives a blog post
-func (c *Client) DeleteBlogPost(postID string) error {
+func (c *Client) DeleteBlogPost(ctx context.Context, postID string) error {
 	if postID == "" {
 		return fmt.Errorf("post ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/blogs/posts/%s", c.BaseURL, postID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
 
 // ListBlogAuthors retrieves blog authors with pagination
-func (c *Client) ListBlogAuthors(opts ListOptions) (*BlogAuthorList, error) {
+func (c *Client) ListBlogAuthors(ctx context.Context, opts ListOptions) (*BlogAuthorList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]BlogAuthor, *Paging, error) {
+			page, err := c.ListBlogAuthors(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &BlogAuthorList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/cms/v3/blogs/authors", c.BaseURL)
 
 	params := make(map[string]string)
@@ -197,7 +226,7 @@ func (c *Client) ListBlogAuthors(opts ListOptions) (*BlogAuthorList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +240,21 @@ func (c *Client) ListBlogAuthors(opts ListOptions) (*BlogAuthorList, error) {
 }
 
 // ListBlogTags retrieves blog tags with pagination
-func (c *Client) ListBlogTags(opts ListOptions) (*BlogTagList, error) {
+func (c *Client) ListBlogTags(ctx context.Context, opts ListOptions) (*BlogTagList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]BlogTag, *Paging, error) {
+			page, err := c.ListBlogTags(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &BlogTagList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/cms/v3/blogs/tags", c.BaseURL)
 
 	params := make(map[string]string)
@@ -226,7 +269,7 @@ func (c *Client) ListBlogTags(opts ListOptions) (*BlogTagList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
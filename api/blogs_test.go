@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -44,7 +45,7 @@ func TestClient_ListBlogPosts(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListBlogPosts(ListOptions{Limit: 10})
+		result, err := client.ListBlogPosts(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "post-123", result.Results[0].ID)
@@ -65,7 +66,7 @@ func TestClient_ListBlogPosts(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListBlogPosts(ListOptions{})
+		result, err := client.ListBlogPosts(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -99,7 +100,7 @@ func TestClient_GetBlogPost(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		post, err := client.GetBlogPost("post-123")
+		post, err := client.GetBlogPost(context.Background(), "post-123")
 		require.NoError(t, err)
 		assert.Equal(t, "post-123", post.ID)
 		assert.Equal(t, "My First Post", post.Name)
@@ -119,7 +120,7 @@ func TestClient_GetBlogPost(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		post, err := client.GetBlogPost("nonexistent")
+		post, err := client.GetBlogPost(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, post)
@@ -127,7 +128,7 @@ func TestClient_GetBlogPost(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		post, err := client.GetBlogPost("")
+		post, err := client.GetBlogPost(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "post ID is required")
 		assert.Nil(t, post)
@@ -162,7 +163,7 @@ func TestClient_CreateBlogPost(t *testing.T) {
 			"name": "New Post",
 			"slug": "new-post",
 		}
-		post, err := client.CreateBlogPost(data)
+		post, err := client.CreateBlogPost(context.Background(), data)
 		require.NoError(t, err)
 		assert.Equal(t, "post-456", post.ID)
 		assert.Equal(t, "New Post", post.Name)
@@ -196,7 +197,7 @@ func TestClient_UpdateBlogPost(t *testing.T) {
 		updates := map[string]interface{}{
 			"name": "Updated Post",
 		}
-		post, err := client.UpdateBlogPost("post-123", updates)
+		post, err := client.UpdateBlogPost(context.Background(), "post-123", updates)
 		require.NoError(t, err)
 		assert.Equal(t, "post-123", post.ID)
 		assert.Equal(t, "Updated Post", post.Name)
@@ -204,7 +205,7 @@ func TestClient_UpdateBlogPost(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		post, err := client.UpdateBlogPost("", map[string]interface{}{"name": "test"})
+		post, err := client.UpdateBlogPost(context.Background(), "", map[string]interface{}{"name": "test"})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "post ID is required")
 		assert.Nil(t, post)
@@ -227,13 +228,13 @@ func TestClient_DeleteBlogPost(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteBlogPost("post-123")
+		err := client.DeleteBlogPost(context.Background(), "post-123")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteBlogPost("")
+		err := client.DeleteBlogPost(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "post ID is required")
 	})
@@ -266,7 +267,7 @@ func TestClient_ListBlogAuthors(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListBlogAuthors(ListOptions{})
+		result, err := client.ListBlogAuthors(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "author-123", result.Results[0].ID)
@@ -300,7 +301,7 @@ func TestClient_ListBlogTags(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListBlogTags(ListOptions{})
+		result, err := client.ListBlogTags(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "tag-123", result.Results[0].ID)
@@ -0,0 +1,59 @@
+package api
+
+import "sync"
+
+// RequestBudget caps the number of requests sent to HubSpot, so a long bulk
+// job cannot use up the portal's daily API limit that other integrations
+// share. Every attempt counts, including retries; cached responses do not.
+// It is safe for concurrent use.
+type RequestBudget struct {
+	Limit int
+
+	mu      sync.Mutex
+	used    int
+	refused bool
+}
+
+// NewRequestBudget creates a budget allowing limit requests
+func NewRequestBudget(limit int) *RequestBudget {
+	return &RequestBudget{Limit: limit}
+}
+
+// take records one request, or returns ErrBudgetExhausted when the budget is
+// spent. A nil budget or one without a limit allows every request.
+func (b *RequestBudget) take() error {
+	if b == nil || b.Limit <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.used >= b.Limit {
+		b.refused = true
+		return ErrBudgetExhausted
+	}
+	b.used++
+	return nil
+}
+
+// Used returns the number of requests sent so far
+func (b *RequestBudget) Used() int {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// Exhausted reports whether a request was refused because the budget was
+// spent. A job that used exactly its budget is not exhausted.
+func (b *RequestBudget) Exhausted() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.refused
+}
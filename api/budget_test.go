@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestBudget(t *testing.T) {
+	t.Run("counts requests until spent", func(t *testing.T) {
+		b := NewRequestBudget(2)
+		require.NoError(t, b.take())
+		require.NoError(t, b.take())
+		assert.False(t, b.Exhausted(), "using the whole budget is not exhausting it")
+		assert.True(t, IsBudgetExhausted(b.take()))
+		assert.True(t, b.Exhausted())
+		assert.Equal(t, 2, b.Used())
+	})
+
+	t.Run("nil and zero budgets allow everything", func(t *testing.T) {
+		var b *RequestBudget
+		assert.NoError(t, b.take())
+		assert.False(t, b.Exhausted())
+		assert.Equal(t, 0, b.Used())
+
+		unlimited := NewRequestBudget(0)
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, unlimited.take())
+		}
+		assert.False(t, unlimited.Exhausted())
+	})
+}
+
+func TestClient_send_Budget(t *testing.T) {
+	t.Run("retries are charged to the budget", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+			MaxRetries:  5,
+			Budget:      NewRequestBudget(2),
+			sleep:       noSleep,
+		}
+		_, err := client.GetOwners(context.Background())
+		require.Error(t, err)
+
+		assert.True(t, IsBudgetExhausted(err))
+		assert.Equal(t, 2, calls)
+	})
+}
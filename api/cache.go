@@ -0,0 +1,110 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ResponseCache stores successful read responses on disk so repeated
+// requests within TTL are served locally. Entries are keyed by a hash of the
+// access token, method, URL, and request body, so profiles never share
+// results. Writes do not invalidate entries; they simply expire.
+type ResponseCache struct {
+	Dir string
+	TTL time.Duration
+}
+
+// livePaths report the progress of background imports, exports, and folder
+// updates or the remaining lifetime of a token, so their responses change
+// from one request to the next and are never cached
+var livePaths = []string{"/crm/v3/imports", "/crm/v3/exports/", "/oauth/v1/access-tokens/", "/files/v3/folders/update/async/tasks/"}
+
+// cacheable reports whether a request only reads data that stays put: any
+// GET except job progress and token info, GraphQL queries, and CRM search
+func cacheable(method, urlStr string) bool {
+	if method != http.MethodGet && method != http.MethodPost {
+		return false
+	}
+
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return false
+	}
+
+	if method == http.MethodGet {
+		for _, p := range livePaths {
+			if strings.HasPrefix(u.Path, p) {
+				return false
+			}
+		}
+		return true
+	}
+	return u.Path == "/collector/graphql" || strings.HasSuffix(u.Path, "/search")
+}
+
+// key returns the cache file name for a request
+func (rc *ResponseCache) key(token, method, urlStr string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(token))
+	h.Write([]byte{0})
+	h.Write([]byte(method + " " + urlStr))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)) + ".json"
+}
+
+// get returns a cached response younger than TTL
+func (rc *ResponseCache) get(key string) ([]byte, bool) {
+	path := filepath.Join(rc.Dir, key)
+
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > rc.TTL {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// put stores a response. Failing to cache never fails the request.
+func (rc *ResponseCache) put(key string, data []byte) {
+	if err := os.MkdirAll(rc.Dir, 0700); err != nil {
+		return
+	}
+
+	// Write to a temp file first so concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(rc.Dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(rc.Dir, key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Clear deletes every cached response
+func (rc *ResponseCache) Clear() error {
+	if err := os.RemoveAll(rc.Dir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to clear response cache: %w", err)
+	}
+	return nil
+}
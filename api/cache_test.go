@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheable(t *testing.T) {
+	tests := []struct {
+		method string
+		url    string
+		want   bool
+	}{
+		{http.MethodGet, "https://api.hubapi.com/crm/v3/objects/contacts", true},
+		{http.MethodPost, "https://api.hubapi.com/collector/graphql", true},
+		{http.MethodPost, "https://api.hubapi.com/crm/v3/objects/contacts/search", true},
+		{http.MethodPost, "https://api.hubapi.com/crm/v3/objects/contacts", false},
+		{http.MethodPatch, "https://api.hubapi.com/crm/v3/objects/contacts/1", false},
+		{http.MethodDelete, "https://api.hubapi.com/crm/v3/objects/contacts/1", false},
+		{http.MethodGet, "https://api.hubapi.com/crm/v3/imports/881", false},
+		{http.MethodGet, "https://api.hubapi.com/crm/v3/exports/export/async/tasks/7/status", false},
+		{http.MethodGet, "https://api.hubapi.com/oauth/v1/access-tokens/abc", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, cacheable(tt.method, tt.url), "%s %s", tt.method, tt.url)
+	}
+}
+
+func TestClient_ResponseCache(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := &Client{
+		BaseURL:     server.URL,
+		AccessToken: "test-token",
+		HTTPClient:  server.Client(),
+		Cache:       &ResponseCache{Dir: dir, TTL: time.Minute},
+	}
+
+	t.Run("repeat reads are served from cache", func(t *testing.T) {
+		calls = 0
+		for i := 0; i < 3; i++ {
+			body, err := client.get(context.Background(), server.URL+"/things")
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"ok": true}`, string(body))
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("request bodies are part of the key", func(t *testing.T) {
+		calls = 0
+		_, err := client.post(context.Background(), server.URL+"/collector/graphql", map[string]string{"query": "{ a }"})
+		require.NoError(t, err)
+		_, err = client.post(context.Background(), server.URL+"/collector/graphql", map[string]string{"query": "{ b }"})
+		require.NoError(t, err)
+		_, err = client.post(context.Background(), server.URL+"/collector/graphql", map[string]string{"query": "{ a }"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("writes are never cached", func(t *testing.T) {
+		calls = 0
+		for i := 0; i < 2; i++ {
+			_, err := client.patch(context.Background(), server.URL+"/things/1", map[string]string{"a": "b"})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("errors are never cached", func(t *testing.T) {
+		calls = 0
+		for i := 0; i < 2; i++ {
+			_, err := client.get(context.Background(), server.URL+"/missing")
+			require.Error(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("expired entries are refetched", func(t *testing.T) {
+		calls = 0
+		_, err := client.get(context.Background(), server.URL+"/stale")
+		require.NoError(t, err)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		old := time.Now().Add(-2 * time.Minute)
+		for _, e := range entries {
+			require.NoError(t, os.Chtimes(filepath.Join(dir, e.Name()), old, old))
+		}
+
+		_, err = client.get(context.Background(), server.URL+"/stale")
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("tokens do not share entries", func(t *testing.T) {
+		calls = 0
+		other := *client
+		other.AccessToken = "other-token"
+
+		_, err := client.get(context.Background(), server.URL+"/shared")
+		require.NoError(t, err)
+		_, err = other.get(context.Background(), server.URL+"/shared")
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		require.NoError(t, client.Cache.Clear())
+		_, err := os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CallingSettings are the settings of a public app's calling extension:
+// the widget HubSpot opens for calls and what it supports
+type CallingSettings struct {
+	Name                  string `json:"name"`
+	URL                   string `json:"url"`
+	Width                 int    `json:"width,omitempty"`
+	Height                int    `json:"height,omitempty"`
+	IsReady               bool   `json:"isReady"`
+	SupportsCustomObjects bool   `json:"supportsCustomObjects"`
+	UsesCallingWindow     bool   `json:"usesCallingWindow"`
+	UsesRemote            bool   `json:"usesRemote"`
+	CreatedAt             string `json:"createdAt,omitempty"`
+	UpdatedAt             string `json:"updatedAt,omitempty"`
+}
+
+// callingSettingsURL returns the URL of an app's calling extension
+// settings, authenticated with the developer API key
+func (c *Client) callingSettingsURL(appID string) (string, error) {
+	if appID == "" {
+		return "", fmt.Errorf("app ID is required")
+	}
+	if c.DeveloperAPIKey == "" {
+		return "", ErrDeveloperAPIKeyRequired
+	}
+	url := fmt.Sprintf("%s/crm/v3/extensions/calling/%s/settings", c.BaseURL, appID)
+	return buildURL(url, map[string]string{"hapikey": c.DeveloperAPIKey}), nil
+}
+
+// GetCallingSettings retrieves an app's calling extension settings
+func (c *Client) GetCallingSettings(ctx context.Context, appID string) (*CallingSettings, error) {
+	url, err := c.callingSettingsURL(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CallingSettings
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateCallingSettings sets up an app's calling extension, which needs a
+// name and a widget URL
+func (c *Client) CreateCallingSettings(ctx context.Context, appID string, settings map[string]interface{}) (*CallingSettings, error) {
+	if settings["name"] == nil || settings["url"] == nil {
+		return nil, fmt.Errorf("name and url are required")
+	}
+
+	url, err := c.callingSettingsURL(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.post(ctx, url, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CallingSettings
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateCallingSettings changes the calling extension settings given in
+// update
+func (c *Client) UpdateCallingSettings(ctx context.Context, appID string, update map[string]interface{}) (*CallingSettings, error) {
+	url, err := c.callingSettingsURL(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.patch(ctx, url, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CallingSettings
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetCallingSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/extensions/calling/123/settings", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "dev-key", r.URL.Query().Get("hapikey"))
+
+		w.Write([]byte(`{"name": "Dialer", "url": "https://example.com/widget", "width": 400, "height": 600, "isReady": true, "usesCallingWindow": true}`))
+	}))
+	defer server.Close()
+
+	settings, err := newWebhooksTestClient(server).GetCallingSettings(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, "Dialer", settings.Name)
+	assert.Equal(t, 400, settings.Width)
+	assert.True(t, settings.IsReady)
+	assert.False(t, settings.UsesRemote)
+}
+
+func TestClient_CallingSettings_RequireDeveloperKey(t *testing.T) {
+	client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+	_, err := client.GetCallingSettings(context.Background(), "123")
+	assert.ErrorIs(t, err, ErrDeveloperAPIKeyRequired)
+}
+
+func TestClient_CreateCallingSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/crm/v3/extensions/calling/123/settings", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"name": "Dialer", "url": "https://example.com/widget"}, body)
+
+		w.Write([]byte(`{"name": "Dialer", "url": "https://example.com/widget"}`))
+	}))
+	defer server.Close()
+
+	client := newWebhooksTestClient(server)
+
+	settings, err := client.CreateCallingSettings(context.Background(), "123", map[string]interface{}{"name": "Dialer", "url": "https://example.com/widget"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/widget", settings.URL)
+
+	_, err = client.CreateCallingSettings(context.Background(), "123", map[string]interface{}{"name": "Dialer"})
+	assert.ErrorContains(t, err, "name and url are required")
+}
+
+func TestClient_UpdateCallingSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/crm/v3/extensions/calling/123/settings", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"isReady": false}, body)
+
+		w.Write([]byte(`{"name": "Dialer", "url": "https://example.com/widget", "isReady": false}`))
+	}))
+	defer server.Close()
+
+	settings, err := newWebhooksTestClient(server).UpdateCallingSettings(context.Background(), "123", map[string]interface{}{"isReady": false})
+	require.NoError(t, err)
+	assert.False(t, settings.IsReady)
+}
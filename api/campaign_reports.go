@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CampaignMetrics are a campaign's attribution metrics
+type CampaignMetrics struct {
+	Sessions              int `json:"sessions"`
+	NewContactsFirstTouch int `json:"newContactsFirstTouch"`
+	NewContactsLastTouch  int `json:"newContactsLastTouch"`
+	InfluencedContacts    int `json:"influencedContacts"`
+}
+
+// CampaignRevenue is a campaign's attributed revenue
+type CampaignRevenue struct {
+	CurrencyCode   string  `json:"currencyCode"`
+	RevenueAmount  float64 `json:"revenueAmount"`
+	DealAmount     float64 `json:"dealAmount"`
+	DealsNumber    int     `json:"dealsNumber"`
+	ContactsNumber int     `json:"contactsNumber"`
+}
+
+// CampaignBudgetItem is one budget or spend line of a campaign
+type CampaignBudgetItem struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+	Order       int     `json:"order"`
+}
+
+// CampaignBudget is a campaign's budget and spend with their totals
+type CampaignBudget struct {
+	CurrencyCode    string               `json:"currencyCode"`
+	BudgetTotal     float64              `json:"budgetTotal"`
+	SpendTotal      float64              `json:"spendTotal"`
+	RemainingBudget float64              `json:"remainingBudget"`
+	BudgetItems     []CampaignBudgetItem `json:"budgetItems"`
+	SpendItems      []CampaignBudgetItem `json:"spendItems"`
+}
+
+// CampaignReportRange limits campaign reports to dates (YYYY-MM-DD); empty
+// values leave the range open
+type CampaignReportRange struct {
+	StartDate string
+	EndDate   string
+}
+
+func (r CampaignReportRange) params() map[string]string {
+	params := make(map[string]string)
+	if r.StartDate != "" {
+		params["startDate"] = r.StartDate
+	}
+	if r.EndDate != "" {
+		params["endDate"] = r.EndDate
+	}
+	return params
+}
+
+// GetCampaignMetrics retrieves a campaign's sessions and contact attribution
+func (c *Client) GetCampaignMetrics(ctx context.Context, campaignID string, dates CampaignReportRange) (*CampaignMetrics, error) {
+	if campaignID == "" {
+		return nil, fmt.Errorf("campaign ID is required")
+	}
+
+	url := buildURL(fmt.Sprintf("%s/marketing/v3/campaigns/%s/reports/metrics", c.BaseURL, campaignID), dates.params())
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CampaignMetrics
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse campaign metrics response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetCampaignRevenue retrieves a campaign's attributed revenue. model is a
+// HubSpot attribution model such as LINEAR or FIRST_INTERACTION; empty uses
+// HubSpot's default.
+func (c *Client) GetCampaignRevenue(ctx context.Context, campaignID, model string, dates CampaignReportRange) (*CampaignRevenue, error) {
+	if campaignID == "" {
+		return nil, fmt.Errorf("campaign ID is required")
+	}
+
+	params := dates.params()
+	if model != "" {
+		params["attributionModel"] = model
+	}
+	url := buildURL(fmt.Sprintf("%s/marketing/v3/campaigns/%s/reports/revenue", c.BaseURL, campaignID), params)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CampaignRevenue
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse campaign revenue response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetCampaignBudget retrieves a campaign's budget and spend items
+func (c *Client) GetCampaignBudget(ctx context.Context, campaignID string) (*CampaignBudget, error) {
+	if campaignID == "" {
+		return nil, fmt.Errorf("campaign ID is required")
+	}
+
+	url := fmt.Sprintf("%s/marketing/v3/campaigns/%s/budget/totals", c.BaseURL, campaignID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CampaignBudget
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse campaign budget response: %w", err)
+	}
+
+	return &result, nil
+}
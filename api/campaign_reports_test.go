@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetCampaignMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/marketing/v3/campaigns/c-1/reports/metrics", r.URL.Path)
+		assert.Equal(t, "2026-01-01", r.URL.Query().Get("startDate"))
+		assert.False(t, r.URL.Query().Has("endDate"))
+
+		w.Write([]byte(`{"sessions": 1200, "newContactsFirstTouch": 40, "newContactsLastTouch": 35, "influencedContacts": 310}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	metrics, err := client.GetCampaignMetrics(context.Background(), "c-1", CampaignReportRange{StartDate: "2026-01-01"})
+	require.NoError(t, err)
+	assert.Equal(t, CampaignMetrics{Sessions: 1200, NewContactsFirstTouch: 40, NewContactsLastTouch: 35, InfluencedContacts: 310}, *metrics)
+
+	_, err = client.GetCampaignMetrics(context.Background(), "", CampaignReportRange{})
+	assert.Error(t, err)
+}
+
+func TestClient_GetCampaignRevenue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/marketing/v3/campaigns/c-1/reports/revenue", r.URL.Path)
+		assert.Equal(t, "LINEAR", r.URL.Query().Get("attributionModel"))
+
+		w.Write([]byte(`{"currencyCode": "USD", "revenueAmount": 52000.5, "dealAmount": 80000, "dealsNumber": 6, "contactsNumber": 14}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	revenue, err := client.GetCampaignRevenue(context.Background(), "c-1", "LINEAR", CampaignReportRange{})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", revenue.CurrencyCode)
+	assert.Equal(t, 52000.5, revenue.RevenueAmount)
+	assert.Equal(t, 6, revenue.DealsNumber)
+}
+
+func TestClient_GetCampaignBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/marketing/v3/campaigns/c-1/budget/totals", r.URL.Path)
+
+		w.Write([]byte(`{
+			"currencyCode": "USD",
+			"budgetTotal": 20000,
+			"spendTotal": 12500,
+			"remainingBudget": 7500,
+			"budgetItems": [{"id": "b1", "name": "Q1", "amount": 20000, "order": 0}],
+			"spendItems": [{"id": "s1", "name": "LinkedIn ads", "amount": 9000, "order": 0}, {"id": "s2", "name": "Webinar", "amount": 3500, "order": 1}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	budget, err := client.GetCampaignBudget(context.Background(), "c-1")
+	require.NoError(t, err)
+	assert.Equal(t, 12500.0, budget.SpendTotal)
+	require.Len(t, budget.SpendItems, 2)
+	assert.Equal(t, "LinkedIn ads", budget.SpendItems[0].Name)
+}
@@ -2,47 +2,222 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
 	"time"
 )
 
 const (
 	// DefaultBaseURL is the base URL for HubSpot API
 	DefaultBaseURL = "https://api.hubapi.com"
+
+	// DefaultFormsURL is the base URL for the Forms submission API, which
+	// HubSpot serves from a separate host
+	DefaultFormsURL = "https://api.hsforms.com"
+
+	// DefaultTimeout is the per-request timeout used by the CLI
+	DefaultTimeout = 30 * time.Second
 )
 
 // Client is a HubSpot API client
 type Client struct {
 	BaseURL     string
+	FormsURL    string
 	AccessToken string
 	HTTPClient  *http.Client
 	Verbose     bool
+
+	// DeveloperAPIKey authenticates app-level APIs such as webhooks, which
+	// take a developer account's key instead of a portal access token
+	DeveloperAPIKey string
+
+	// Cache, when set, serves repeated read requests from disk
+	Cache *ResponseCache
+
+	// MaxRetries is how many times a request that failed with 429, or an
+	// idempotent request that failed with 5xx, is retried with backoff. Zero
+	// disables retries.
+	MaxRetries int
+	// Limiter, when set, keeps requests under HubSpot's burst limit
+	Limiter *RateLimiter
+	// Budget, when set, caps the total number of requests sent
+	Budget *RequestBudget
+	// Tracer, when set, records a span for every request
+	Tracer *Tracer
+	// ReadOnly refuses requests that change data, failing them with
+	// ErrReadOnly before they are sent
+	ReadOnly bool
+	// ASCII writes verbose request logs without arrows and other symbols
+	ASCII bool
+
+	// sleep waits between retries; replaced in tests
+	sleep func(context.Context, time.Duration) error
+
+	// scopes, when set, keeps the granted scopes GrantedScopes looks up
+	scopes *scopeCache
+
+	// personalAccessKey, when set, is exchanged for AccessToken before the
+	// first request and again whenever the token is about to expire
+	personalAccessKey *personalAccessKeyAuth
 }
 
 // ClientConfig contains configuration for creating a new client
 type ClientConfig struct {
 	AccessToken string
 	Verbose     bool
+
+	// DeveloperAPIKey is the developer account key for app-level APIs. A
+	// client may be created with it instead of an access token.
+	DeveloperAPIKey string
+
+	// PersonalAccessKey is a HubSpot CLI personal access key, used when
+	// AccessToken is empty. The client exchanges it for short-lived access
+	// tokens.
+	PersonalAccessKey string
+
+	// CABundle is an optional path to a PEM file of additional root
+	// certificates, used when traffic passes through a TLS-intercepting proxy.
+	CABundle string
+	// ClientCert and ClientKey are optional paths to a PEM client certificate
+	// and private key for mutual TLS. Both must be set together.
+	ClientCert string
+	ClientKey  string
+
+	// CacheTTL enables the on-disk response cache in CacheDir for read
+	// requests when greater than zero.
+	CacheTTL time.Duration
+	CacheDir string
+
+	// Timeout bounds each HTTP request, including reading the response.
+	// Zero means no timeout.
+	Timeout time.Duration
+
+	// MaxRetries is how many times a rate-limited request, or an idempotent
+	// one that failed (5xx), is retried before giving up
+	MaxRetries int
+
+	// Budget, when set, caps the number of requests. Clients created with
+	// the same budget share it.
+	Budget *RequestBudget
+
+	// Tracer, when set, records the requests of every client created with
+	// it for export to OpenTelemetry
+	Tracer *Tracer
+
+	// ReadOnly refuses every request that changes data
+	ReadOnly bool
+
+	// ASCII keeps verbose request logs to ASCII characters
+	ASCII bool
 }
 
 // New creates a new HubSpot API client from config
 func New(cfg ClientConfig) (*Client, error) {
-	if cfg.AccessToken == "" {
+	if cfg.AccessToken == "" && cfg.DeveloperAPIKey == "" && cfg.PersonalAccessKey == "" {
 		return nil, ErrAccessTokenRequired
 	}
 
-	return &Client{
+	transport, err := newTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{
 		BaseURL:     DefaultBaseURL,
+		FormsURL:    DefaultFormsURL,
 		AccessToken: cfg.AccessToken,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   cfg.Timeout,
+			Transport: transport,
 		},
-		Verbose: cfg.Verbose,
-	}, nil
+		Verbose:    cfg.Verbose,
+		Cache:      newCache(cfg),
+		MaxRetries: cfg.MaxRetries,
+		Limiter:    NewRateLimiter(DefaultRateLimit, RateLimitWindow),
+		Budget:     cfg.Budget,
+		Tracer:     cfg.Tracer,
+		ReadOnly:   cfg.ReadOnly,
+		ASCII:      cfg.ASCII,
+		scopes:     &scopeCache{},
+
+		DeveloperAPIKey: cfg.DeveloperAPIKey,
+	}
+	if cfg.AccessToken == "" && cfg.PersonalAccessKey != "" {
+		client.personalAccessKey = &personalAccessKeyAuth{key: cfg.PersonalAccessKey}
+	}
+	return client, nil
+}
+
+// NewHTTPClient returns an HTTP client with the proxy, TLS, and timeout
+// settings of cfg, for requests to hosts other than the HubSpot API such as
+// release downloads. It sends no credentials.
+func NewHTTPClient(cfg ClientConfig) (*http.Client, error) {
+	transport, err := newTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Timeout: cfg.Timeout, Transport: transport}, nil
+}
+
+func newCache(cfg ClientConfig) *ResponseCache {
+	if cfg.CacheTTL <= 0 || cfg.CacheDir == "" {
+		return nil
+	}
+	return &ResponseCache{Dir: cfg.CacheDir, TTL: cfg.CacheTTL}
+}
+
+// newTransport builds the HTTP transport shared by all requests. Proxies are
+// taken from HTTPS_PROXY/HTTP_PROXY/NO_PROXY, and the TLS configuration is
+// extended with any custom CA bundle or client certificate.
+func newTransport(cfg ClientConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if cfg.CABundle == "" && cfg.ClientCert == "" && cfg.ClientKey == "" {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CABundle != "" {
+		pem, err := os.ReadFile(cfg.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", cfg.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		if cfg.ClientCert == "" || cfg.ClientKey == "" {
+			return nil, fmt.Errorf("client certificate and client key must be provided together")
+		}
+
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
 }
 
 // authHeader returns the Bearer auth header value
@@ -51,74 +226,219 @@ func (c *Client) authHeader() string {
 }
 
 // doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, urlStr string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+func (c *Client) doRequest(ctx context.Context, method, urlStr string, body interface{}) ([]byte, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, urlStr, reqBody)
+	var cacheKey string
+	if c.Cache != nil && c.Cache.TTL > 0 && cacheable(method, urlStr) {
+		cacheKey = c.Cache.key(c.AccessToken, method, urlStr, jsonBody)
+		if data, ok := c.Cache.get(cacheKey); ok {
+			c.verbosef("← cached %s %s", method, c.redact(urlStr))
+			return data, nil
+		}
+	}
+
+	resp, respBody, err := c.send(ctx, method, urlStr, jsonBody, "application/json")
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
 
-	req.Header.Set("Authorization", c.authHeader())
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	if resp.StatusCode >= 400 {
+		return nil, c.responseError(ctx, method, urlStr, resp, respBody)
+	}
 
-	if c.Verbose {
-		fmt.Printf("→ %s %s\n", method, urlStr)
+	if cacheKey != "" {
+		c.Cache.put(cacheKey, respBody)
 	}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+	return respBody, nil
+}
+
+// responseError converts an error response to an error, explaining a 403 by
+// the scopes the token is missing when they can be determined
+func (c *Client) responseError(ctx context.Context, method, urlStr string, resp *http.Response, body []byte) error {
+	err := ParseAPIError(resp, body)
+	if resp.StatusCode == http.StatusForbidden {
+		return c.scopeError(ctx, method, urlStr, err)
 	}
-	defer resp.Body.Close()
+	return err
+}
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+// send issues a request, waiting for the rate limiter first and retrying
+// 429 responses, and 5xx responses to idempotent requests, up to MaxRetries
+// times. The last response is returned whatever its status. Each attempt is
+// charged to the budget, and the request with its retries is traced as one
+// span. A read-only client sends only requests that read.
+func (c *Client) send(ctx context.Context, method, urlStr string, reqBytes []byte, contentType string) (*http.Response, []byte, error) {
+	if c.ReadOnly {
+		if err := checkReadOnly(method, urlStr); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	if c.Verbose {
-		fmt.Printf("← %d %s\n", resp.StatusCode, http.StatusText(resp.StatusCode))
+	start := time.Now()
+	resp, body, attempts, err := c.sendAttempts(ctx, method, urlStr, reqBytes, contentType)
+	if attempts > 0 {
+		c.Tracer.recordRequest(method, urlStr, start, attempts, resp, err, c.redact)
 	}
+	return resp, body, err
+}
 
-	if resp.StatusCode >= 400 {
-		return nil, ParseAPIError(resp, respBody)
+// sendAttempts does the work of send, also returning how many requests
+// were sent
+func (c *Client) sendAttempts(ctx context.Context, method, urlStr string, reqBytes []byte, contentType string) (*http.Response, []byte, int, error) {
+	sleep := c.sleep
+	if sleep == nil {
+		sleep = SleepContext
 	}
 
-	return respBody, nil
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if reqBytes != nil {
+			reqBody = bytes.NewReader(reqBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBody)
+		if err != nil {
+			return nil, nil, attempt, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Retries of a long backoff may outlive a personal access key's
+		// token
+		if err := c.authenticate(ctx); err != nil {
+			return nil, nil, attempt, err
+		}
+
+		if c.AccessToken != "" {
+			req.Header.Set("Authorization", c.authHeader())
+		}
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Accept", "application/json")
+
+		if err := c.Budget.take(); err != nil {
+			return nil, nil, attempt, err
+		}
+		if err := c.Limiter.Wait(ctx); err != nil {
+			return nil, nil, attempt, err
+		}
+
+		c.verbosef("→ %s %s", method, c.redact(urlStr))
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				urlErr.URL = c.redact(urlErr.URL)
+			}
+			return nil, nil, attempt + 1, fmt.Errorf("request failed: %w", err)
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, attempt + 1, fmt.Errorf("failed to read response: %w", err)
+		}
+
+		c.verbosef("← %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+
+		if !retryable(method, req.URL.Path, resp.StatusCode) || attempt >= c.MaxRetries {
+			return resp, respBody, attempt + 1, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		c.verbosef("↻ retrying in %s (%d/%d)", delay, attempt+1, c.MaxRetries)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, nil, attempt + 1, err
+		}
+	}
 }
 
 // get performs a GET request
-func (c *Client) get(urlStr string) ([]byte, error) {
-	return c.doRequest(http.MethodGet, urlStr, nil)
+func (c *Client) get(ctx context.Context, urlStr string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, urlStr, nil)
 }
 
 // post performs a POST request
-func (c *Client) post(urlStr string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPost, urlStr, body)
+func (c *Client) post(ctx context.Context, urlStr string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, urlStr, body)
+}
+
+// postMultipart performs a POST request with a multipart/form-data body, as
+// built by a multipart.Writer with the given content type
+func (c *Client) postMultipart(ctx context.Context, urlStr string, body []byte, contentType string) ([]byte, error) {
+	return c.sendMultipart(ctx, http.MethodPost, urlStr, body, contentType)
+}
+
+// putMultipart performs a PUT request with a multipart/form-data body
+func (c *Client) putMultipart(ctx context.Context, urlStr string, body []byte, contentType string) ([]byte, error) {
+	return c.sendMultipart(ctx, http.MethodPut, urlStr, body, contentType)
+}
+
+// sendMultipart sends a multipart/form-data body, which is never cached
+func (c *Client) sendMultipart(ctx context.Context, method, urlStr string, body []byte, contentType string) ([]byte, error) {
+	resp, respBody, err := c.send(ctx, method, urlStr, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.responseError(ctx, method, urlStr, resp, respBody)
+	}
+
+	return respBody, nil
 }
 
 // patch performs a PATCH request
-func (c *Client) patch(urlStr string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPatch, urlStr, body)
+func (c *Client) patch(ctx context.Context, urlStr string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPatch, urlStr, body)
 }
 
 // put performs a PUT request
-func (c *Client) put(urlStr string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPut, urlStr, body)
+func (c *Client) put(ctx context.Context, urlStr string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPut, urlStr, body)
 }
 
 // delete performs a DELETE request
-func (c *Client) delete(urlStr string) ([]byte, error) {
-	return c.doRequest(http.MethodDelete, urlStr, nil)
+func (c *Client) delete(ctx context.Context, urlStr string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodDelete, urlStr, nil)
+}
+
+// redact hides the access token and developer API key in a URL or error
+// text, for endpoints such as token introspection that take the token in
+// the path and developer APIs that take the key as hapikey
+func (c *Client) redact(s string) string {
+	for _, secret := range []string{c.AccessToken, c.DeveloperAPIKey} {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "REDACTED")
+		}
+	}
+	return s
+}
+
+// asciiArrows spells the symbols of verbose request logs in ASCII
+var asciiArrows = strings.NewReplacer("→", "->", "←", "<-", "↻", "~")
+
+// verbosef prints a request log line when the client is verbose
+func (c *Client) verbosef(format string, args ...interface{}) {
+	if !c.Verbose {
+		return
+	}
+	line := fmt.Sprintf(format, args...)
+	if c.ASCII {
+		line = asciiArrows.Replace(line)
+	}
+	fmt.Println(line)
 }
 
 // buildURL builds a URL with query parameters
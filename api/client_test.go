@@ -1,10 +1,15 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +39,11 @@ func TestNew(t *testing.T) {
 			wantErr:     nil,
 			wantBaseURL: DefaultBaseURL,
 		},
+		{
+			name:        "developer API key without access token",
+			cfg:         ClientConfig{DeveloperAPIKey: "dev-key"},
+			wantBaseURL: DefaultBaseURL,
+		},
 		{
 			name:    "missing access token",
 			cfg:     ClientConfig{},
@@ -66,6 +76,93 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNew_Timeout(t *testing.T) {
+	client, err := New(ClientConfig{AccessToken: "test-token", Timeout: 90 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, client.HTTPClient.Timeout)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client = &Client{AccessToken: "test-token", HTTPClient: &http.Client{Timeout: 20 * time.Millisecond}}
+	_, err = client.get(context.Background(), server.URL)
+	assert.ErrorContains(t, err, "request failed")
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	client, err := NewHTTPClient(ClientConfig{Timeout: 45 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, 45*time.Second, client.Timeout)
+
+	_, err = NewHTTPClient(ClientConfig{ClientCert: "cert.pem"})
+	assert.ErrorContains(t, err, "must be provided together")
+}
+
+func TestNew_TLSOptions(t *testing.T) {
+	t.Run("custom CA bundle is trusted", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		bundle := filepath.Join(t.TempDir(), "ca.pem")
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		require.NoError(t, os.WriteFile(bundle, certPEM, 0600))
+
+		client, err := New(ClientConfig{AccessToken: "test-token", CABundle: bundle})
+		require.NoError(t, err)
+
+		_, err = client.get(context.Background(), server.URL)
+		assert.NoError(t, err)
+	})
+
+	t.Run("untrusted server without CA bundle fails", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, err := New(ClientConfig{AccessToken: "test-token"})
+		require.NoError(t, err)
+
+		_, err = client.get(context.Background(), server.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("proxy is taken from the environment", func(t *testing.T) {
+		client, err := New(ClientConfig{AccessToken: "test-token"})
+		require.NoError(t, err)
+
+		transport, ok := client.HTTPClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		assert.NotNil(t, transport.Proxy)
+	})
+
+	t.Run("missing CA bundle file", func(t *testing.T) {
+		client, err := New(ClientConfig{AccessToken: "test-token", CABundle: filepath.Join(t.TempDir(), "missing.pem")})
+		assert.Error(t, err)
+		assert.Nil(t, client)
+	})
+
+	t.Run("CA bundle without certificates", func(t *testing.T) {
+		bundle := filepath.Join(t.TempDir(), "empty.pem")
+		require.NoError(t, os.WriteFile(bundle, []byte("not a certificate"), 0600))
+
+		client, err := New(ClientConfig{AccessToken: "test-token", CABundle: bundle})
+		assert.ErrorContains(t, err, "no certificates found")
+		assert.Nil(t, client)
+	})
+
+	t.Run("client cert without key", func(t *testing.T) {
+		client, err := New(ClientConfig{AccessToken: "test-token", ClientCert: "cert.pem"})
+		assert.ErrorContains(t, err, "must be provided together")
+		assert.Nil(t, client)
+	})
+}
+
 func TestClient_authHeader(t *testing.T) {
 	client := &Client{
 		AccessToken: "pat-na1-test-token-value",
@@ -154,7 +251,7 @@ func TestClient_doRequest(t *testing.T) {
 				HTTPClient:  server.Client(),
 			}
 
-			body, err := client.doRequest(tt.method, server.URL, nil)
+			body, err := client.doRequest(context.Background(), tt.method, server.URL, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -188,7 +285,7 @@ func TestClient_doRequest_withBody(t *testing.T) {
 		"lastname":  "Doe",
 	}
 
-	_, err := client.doRequest(http.MethodPost, server.URL, requestBody)
+	_, err := client.doRequest(context.Background(), http.MethodPost, server.URL, requestBody)
 	require.NoError(t, err)
 
 	assert.Equal(t, "john@example.com", receivedBody["email"])
@@ -213,25 +310,25 @@ func TestClient_httpMethods(t *testing.T) {
 	}
 
 	t.Run("get", func(t *testing.T) {
-		_, err := client.get(server.URL)
+		_, err := client.get(context.Background(), server.URL)
 		require.NoError(t, err)
 		assert.Equal(t, http.MethodGet, calledMethod)
 	})
 
 	t.Run("post", func(t *testing.T) {
-		_, err := client.post(server.URL, nil)
+		_, err := client.post(context.Background(), server.URL, nil)
 		require.NoError(t, err)
 		assert.Equal(t, http.MethodPost, calledMethod)
 	})
 
 	t.Run("patch", func(t *testing.T) {
-		_, err := client.patch(server.URL, nil)
+		_, err := client.patch(context.Background(), server.URL, nil)
 		require.NoError(t, err)
 		assert.Equal(t, http.MethodPatch, calledMethod)
 	})
 
 	t.Run("delete", func(t *testing.T) {
-		_, err := client.delete(server.URL)
+		_, err := client.delete(context.Background(), server.URL)
 		require.NoError(t, err)
 		assert.Equal(t, http.MethodDelete, calledMethod)
 	})
@@ -299,3 +396,23 @@ func TestBuildURL(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_redact(t *testing.T) {
+	client := &Client{DeveloperAPIKey: "dev-key-123"}
+	assert.Equal(t, "https://api.hubapi.com/webhooks/v3/1/settings?hapikey=REDACTED",
+		client.redact("https://api.hubapi.com/webhooks/v3/1/settings?hapikey=dev-key-123"))
+
+	client = &Client{AccessToken: "pat-na1-secret"}
+	assert.Equal(t, "/oauth/v1/access-tokens/REDACTED", client.redact("/oauth/v1/access-tokens/pat-na1-secret"))
+}
+
+func TestClient_send_RequestFailedHidesDeveloperKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	client := &Client{HTTPClient: server.Client(), DeveloperAPIKey: "dev-key-123"}
+	_, err := client.get(context.Background(), server.URL+"/webhooks/v3/1/settings?hapikey=dev-key-123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hapikey=REDACTED")
+	assert.NotContains(t, err.Error(), "dev-key-123")
+}
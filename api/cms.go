@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -63,6 +64,16 @@ type Domain struct {
 	IsUsedForKnowledge   bool   `json:"isUsedForKnowledge"`
 	CreatedAt            string `json:"createdAt"`
 	UpdatedAt            string `json:"updatedAt"`
+
+	// ExpectedCname is the host the domain's CNAME record must point to;
+	// ActualCname and ActualIP are what HubSpot last found in DNS
+	ExpectedCname             string `json:"expectedCname,omitempty"`
+	ActualCname               string `json:"actualCname,omitempty"`
+	ActualIP                  string `json:"actualIp,omitempty"`
+	IsDNSCorrect              bool   `json:"isDnsCorrect"`
+	IsSetupComplete           bool   `json:"isSetupComplete"`
+	ManuallyMarkedAsResolving bool   `json:"manuallyMarkedAsResolving"`
+	SecondaryToDomain         string `json:"secondaryToDomain,omitempty"`
 }
 
 // DomainList represents a paginated list of domains
@@ -72,7 +83,21 @@ type DomainList struct {
 }
 
 // ListFiles retrieves files with pagination
-func (c *Client) ListFiles(opts ListOptions) (*FileList, error) {
+func (c *Client) ListFiles(ctx context.Context, opts ListOptions) (*FileList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]File, *Paging, error) {
+			page, err := c.ListFiles(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &FileList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/files/v3/files", c.BaseURL)
 
 	params := make(map[string]string)
@@ -87,7 +112,7 @@ func (c *Client) ListFiles(opts ListOptions) (*FileList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -101,14 +126,14 @@ func (c *Client) ListFiles(opts ListOptions) (*FileList, error) {
 }
 
 // GetFile retrieves a single file by ID
-func (c *Client) GetFile(fileID string) (*File, error) {
+func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
 	if fileID == "" {
 		return nil, fmt.Errorf("file ID is required")
 	}
 
 	url := fmt.Sprintf("%s/files/v3/files/%s", c.BaseURL, fileID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -122,19 +147,33 @@ func (c *Client) GetFile(fileID string) (*File, error) {
 }
 
 // DeleteFile deletes a file by ID
-func (c *Client) DeleteFile(fileID string) error {
+func (c *Client) DeleteFile(ctx context.Context, fileID string) error {
 	if fileID == "" {
 		return fmt.Errorf("file ID is required")
 	}
 
 	url := fmt.Sprintf("%s/files/v3/files/%s", c.BaseURL, fileID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
 
 // ListFolders retrieves folders with pagination
-func (c *Client) ListFolders(opts ListOptions) (*FolderList, error) {
+func (c *Client) ListFolders(ctx context.Context, opts ListOptions) (*FolderList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Folder, *Paging, error) {
+			page, err := c.ListFolders(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &FolderList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/files/v3/folders", c.BaseURL)
 
 	params := make(map[string]string)
@@ -149,7 +188,7 @@ func (c *Client) ListFolders(opts ListOptions) (*FolderList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +202,21 @@ func (c *Client) ListFolders(opts ListOptions) (*FolderList, error) {
 }
 
 // ListDomains retrieves domains with pagination
-func (c *Client) ListDomains(opts ListOptions) (*DomainList, error) {
+func (c *Client) ListDomains(ctx context.Context, opts ListOptions) (*DomainList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Domain, *Paging, error) {
+			page, err := c.ListDomains(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &DomainList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/cms/v3/domains", c.BaseURL)
 
 	params := make(map[string]string)
@@ -178,7 +231,7 @@ func (c *Client) ListDomains(opts ListOptions) (*DomainList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -192,14 +245,14 @@ func (c *Client) ListDomains(opts ListOptions) (*DomainList, error) {
 }
 
 // GetDomain retrieves a single domain by ID
-func (c *Client) GetDomain(domainID string) (*Domain, error) {
+func (c *Client) GetDomain(ctx context.Context, domainID string) (*Domain, error) {
 	if domainID == "" {
 		return nil, fmt.Errorf("domain ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/domains/%s", c.BaseURL, domainID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
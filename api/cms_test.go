@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -48,7 +49,7 @@ func TestClient_ListFiles(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListFiles(ListOptions{Limit: 10})
+		result, err := client.ListFiles(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "file-123", result.Results[0].ID)
@@ -70,7 +71,7 @@ func TestClient_ListFiles(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListFiles(ListOptions{})
+		result, err := client.ListFiles(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -105,7 +106,7 @@ func TestClient_GetFile(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		file, err := client.GetFile("file-123")
+		file, err := client.GetFile(context.Background(), "file-123")
 		require.NoError(t, err)
 		assert.Equal(t, "file-123", file.ID)
 		assert.Equal(t, "document.pdf", file.Name)
@@ -125,7 +126,7 @@ func TestClient_GetFile(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		file, err := client.GetFile("nonexistent")
+		file, err := client.GetFile(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, file)
@@ -133,7 +134,7 @@ func TestClient_GetFile(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		file, err := client.GetFile("")
+		file, err := client.GetFile(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "file ID is required")
 		assert.Nil(t, file)
@@ -155,13 +156,13 @@ func TestClient_DeleteFile(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteFile("file-123")
+		err := client.DeleteFile(context.Background(), "file-123")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteFile("")
+		err := client.DeleteFile(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "file ID is required")
 	})
@@ -195,7 +196,7 @@ func TestClient_ListFolders(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListFolders(ListOptions{})
+		result, err := client.ListFolders(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "folder-123", result.Results[0].ID)
@@ -233,7 +234,7 @@ func TestClient_ListDomains(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListDomains(ListOptions{})
+		result, err := client.ListDomains(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "domain-123", result.Results[0].ID)
@@ -259,7 +260,11 @@ func TestClient_GetDomain(t *testing.T) {
 				"isSslOnly": false,
 				"isUsedForBlogPost": true,
 				"createdAt": "2024-01-15T10:00:00Z",
-				"updatedAt": "2024-01-16T12:00:00Z"
+				"updatedAt": "2024-01-16T12:00:00Z",
+				"expectedCname": "123.group1.sites.hscoscdn-na1.net",
+				"actualCname": "123.group1.sites.hscoscdn-na1.net",
+				"isDnsCorrect": true,
+				"isSetupComplete": true
 			}`))
 		}))
 		defer server.Close()
@@ -270,12 +275,15 @@ func TestClient_GetDomain(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		domain, err := client.GetDomain("domain-123")
+		domain, err := client.GetDomain(context.Background(), "domain-123")
 		require.NoError(t, err)
 		assert.Equal(t, "domain-123", domain.ID)
 		assert.Equal(t, "blog.example.com", domain.Domain)
 		assert.True(t, domain.PrimaryBlogPost)
 		assert.True(t, domain.IsUsedForBlogPost)
+		assert.Equal(t, "123.group1.sites.hscoscdn-na1.net", domain.ExpectedCname)
+		assert.True(t, domain.IsDNSCorrect)
+		assert.True(t, domain.IsSetupComplete)
 	})
 
 	t.Run("not found", func(t *testing.T) {
@@ -291,7 +299,7 @@ func TestClient_GetDomain(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		domain, err := client.GetDomain("nonexistent")
+		domain, err := client.GetDomain(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, domain)
@@ -299,7 +307,7 @@ func TestClient_GetDomain(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		domain, err := client.GetDomain("")
+		domain, err := client.GetDomain(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "domain ID is required")
 		assert.Nil(t, domain)
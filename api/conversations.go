@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -40,7 +41,21 @@ type ThreadList struct {
 }
 
 // ListInboxes retrieves inboxes with pagination
-func (c *Client) ListInboxes(opts ListOptions) (*InboxList, error) {
+func (c *Client) ListInboxes(ctx context.Context, opts ListOptions) (*InboxList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Inbox, *Paging, error) {
+			page, err := c.ListInboxes(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &InboxList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/conversations/v3/conversations/inboxes", c.BaseURL)
 
 	params := make(map[string]string)
@@ -55,7 +70,7 @@ func (c *Client) ListInboxes(opts ListOptions) (*InboxList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -69,14 +84,14 @@ func (c *Client) ListInboxes(opts ListOptions) (*InboxList, error) {
 }
 
 // GetInbox retrieves a single inbox by ID
-func (c *Client) GetInbox(inboxID string) (*Inbox, error) {
+func (c *Client) GetInbox(ctx context.Context, inboxID string) (*Inbox, error) {
 	if inboxID == "" {
 		return nil, fmt.Errorf("inbox ID is required")
 	}
 
 	url := fmt.Sprintf("%s/conversations/v3/conversations/inboxes/%s", c.BaseURL, inboxID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +105,21 @@ func (c *Client) GetInbox(inboxID string) (*Inbox, error) {
 }
 
 // ListThreads retrieves threads with pagination
-func (c *Client) ListThreads(opts ListOptions) (*ThreadList, error) {
+func (c *Client) ListThreads(ctx context.Context, opts ListOptions) (*ThreadList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Thread, *Paging, error) {
+			page, err := c.ListThreads(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ThreadList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/conversations/v3/conversations/threads", c.BaseURL)
 
 	params := make(map[string]string)
@@ -105,7 +134,7 @@ func (c *Client) ListThreads(opts ListOptions) (*ThreadList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -118,15 +147,39 @@ func (c *Client) ListThreads(opts ListOptions) (*ThreadList, error) {
 	return &result, nil
 }
 
+// ListTicketThreads retrieves the conversation threads associated with a
+// ticket
+func (c *Client) ListTicketThreads(ctx context.Context, ticketID string) ([]Thread, error) {
+	if ticketID == "" {
+		return nil, fmt.Errorf("ticket ID is required")
+	}
+
+	url := buildURL(fmt.Sprintf("%s/conversations/v3/conversations/threads", c.BaseURL), map[string]string{
+		"associatedTicketId": ticketID,
+	})
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ThreadList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse threads response: %w", err)
+	}
+
+	return result.Results, nil
+}
+
 // GetThread retrieves a single thread by ID
-func (c *Client) GetThread(threadID string) (*Thread, error) {
+func (c *Client) GetThread(ctx context.Context, threadID string) (*Thread, error) {
 	if threadID == "" {
 		return nil, fmt.Errorf("thread ID is required")
 	}
 
 	url := fmt.Sprintf("%s/conversations/v3/conversations/threads/%s", c.BaseURL, threadID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -157,23 +210,110 @@ type ChannelList struct {
 
 // Message represents a HubSpot conversations message
 type Message struct {
-	ID          string                 `json:"id"`
-	Type        string                 `json:"type"`
-	Text        string                 `json:"text,omitempty"`
-	RichText    string                 `json:"richText,omitempty"`
-	Direction   string                 `json:"direction,omitempty"`
-	Status      string                 `json:"status,omitempty"`
-	ChannelID   string                 `json:"channelId,omitempty"`
-	ChannelType string                 `json:"channelAccountId,omitempty"`
-	SenderID    string                 `json:"senderId,omitempty"`
-	Recipients  []MessageRecipient     `json:"recipients,omitempty"`
-	CreatedAt   string                 `json:"createdAt"`
-	Client      map[string]interface{} `json:"client,omitempty"`
+	ID               string                 `json:"id"`
+	Type             string                 `json:"type"`
+	Text             string                 `json:"text,omitempty"`
+	RichText         string                 `json:"richText,omitempty"`
+	Direction        string                 `json:"direction,omitempty"`
+	Status           *MessageStatus         `json:"status,omitempty"`
+	ChannelID        string                 `json:"channelId,omitempty"`
+	ChannelAccountID string                 `json:"channelAccountId,omitempty"`
+	SenderID         string                 `json:"senderId,omitempty"`
+	Senders          []MessageSender        `json:"senders,omitempty"`
+	Recipients       []MessageRecipient     `json:"recipients,omitempty"`
+	CreatedAt        string                 `json:"createdAt"`
+	Client           map[string]interface{} `json:"client,omitempty"`
+
+	Attachments      []MessageAttachment `json:"attachments,omitempty"`
+	TruncationStatus string              `json:"truncationStatus,omitempty"`
+}
+
+// Truncated reports whether HubSpot cut the message's text short; the
+// whole message is in its original content
+func (m *Message) Truncated() bool {
+	return m.TruncationStatus != "" && m.TruncationStatus != "NOT_TRUNCATED"
+}
+
+// MessageAttachment is a file, or other content, attached to a message
+type MessageAttachment struct {
+	Type          string `json:"type"`
+	FileID        string `json:"fileId,omitempty"`
+	Name          string `json:"name,omitempty"`
+	URL           string `json:"url,omitempty"`
+	FileUsageType string `json:"fileUsageType,omitempty"`
+}
+
+// MessageOriginalContent is a message as it was received, before HubSpot
+// trimmed quoted replies and signatures or truncated it
+type MessageOriginalContent struct {
+	Text     string `json:"text,omitempty"`
+	RichText string `json:"richText,omitempty"`
+}
+
+// Message delivery statuses
+const (
+	MessageStatusSent     = "SENT"
+	MessageStatusReceived = "RECEIVED"
+	MessageStatusRead     = "READ"
+	MessageStatusFailed   = "FAILED"
+)
+
+// MessageStatus is how far an outgoing message has got
+type MessageStatus struct {
+	Type           string                 `json:"statusType"`
+	FailureDetails *MessageFailureDetails `json:"failureDetails,omitempty"`
+}
+
+// MessageFailureDetails explains why a message was not delivered
+type MessageFailureDetails struct {
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// UnmarshalJSON accepts a status object or a bare status string
+func (s *MessageStatus) UnmarshalJSON(data []byte) error {
+	var statusType string
+	if err := json.Unmarshal(data, &statusType); err == nil {
+		*s = MessageStatus{Type: statusType}
+		return nil
+	}
+	type plain MessageStatus
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+// Delivered reports whether the message reached the channel
+func (s *MessageStatus) Delivered() bool {
+	switch s.Type {
+	case MessageStatusSent, MessageStatusReceived, MessageStatusRead:
+		return true
+	}
+	return false
+}
+
+// Failed reports whether the message could not be delivered
+func (s *MessageStatus) Failed() bool {
+	return s.Type == MessageStatusFailed
+}
+
+// DeliveryIdentifier is the address a message came from or goes to, such
+// as an email address
+type DeliveryIdentifier struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// MessageSender represents the sender of a message
+type MessageSender struct {
+	ActorID            string              `json:"actorId,omitempty"`
+	Name               string              `json:"name,omitempty"`
+	DeliveryIdentifier *DeliveryIdentifier `json:"deliveryIdentifier,omitempty"`
 }
 
 // MessageRecipient represents a recipient of a message
 type MessageRecipient struct {
-	RecipientID string `json:"recipientId,omitempty"`
+	RecipientID        string              `json:"recipientId,omitempty"`
+	ActorID            string              `json:"actorId,omitempty"`
+	RecipientField     string              `json:"recipientField,omitempty"`
+	DeliveryIdentifier *DeliveryIdentifier `json:"deliveryIdentifier,omitempty"`
 }
 
 // MessageList represents a paginated list of messages
@@ -184,15 +324,31 @@ type MessageList struct {
 
 // SendMessageRequest represents a request to send a message
 type SendMessageRequest struct {
-	Type      string `json:"type"`
-	Text      string `json:"text,omitempty"`
-	RichText  string `json:"richText,omitempty"`
-	SenderID  string `json:"senderActorId,omitempty"`
-	ChannelID string `json:"channelId,omitempty"`
+	Type             string             `json:"type"`
+	Text             string             `json:"text,omitempty"`
+	RichText         string             `json:"richText,omitempty"`
+	SenderID         string             `json:"senderActorId,omitempty"`
+	ChannelID        string             `json:"channelId,omitempty"`
+	ChannelAccountID string             `json:"channelAccountId,omitempty"`
+	Recipients       []MessageRecipient `json:"recipients,omitempty"`
 }
 
 // ListChannels retrieves channels with pagination
-func (c *Client) ListChannels(opts ListOptions) (*ChannelList, error) {
+func (c *Client) ListChannels(ctx context.Context, opts ListOptions) (*ChannelList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Channel, *Paging, error) {
+			page, err := c.ListChannels(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ChannelList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/conversations/v3/conversations/channels", c.BaseURL)
 
 	params := make(map[string]string)
@@ -207,7 +363,7 @@ func (c *Client) ListChannels(opts ListOptions) (*ChannelList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -221,14 +377,14 @@ func (c *Client) ListChannels(opts ListOptions) (*ChannelList, error) {
 }
 
 // GetChannel retrieves a single channel by ID
-func (c *Client) GetChannel(channelID string) (*Channel, error) {
+func (c *Client) GetChannel(ctx context.Context, channelID string) (*Channel, error) {
 	if channelID == "" {
 		return nil, fmt.Errorf("channel ID is required")
 	}
 
 	url := fmt.Sprintf("%s/conversations/v3/conversations/channels/%s", c.BaseURL, channelID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +398,21 @@ func (c *Client) GetChannel(channelID string) (*Channel, error) {
 }
 
 // ListMessages retrieves messages for a thread
-func (c *Client) ListMessages(threadID string, opts ListOptions) (*MessageList, error) {
+func (c *Client) ListMessages(ctx context.Context, threadID string, opts ListOptions) (*MessageList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Message, *Paging, error) {
+			page, err := c.ListMessages(ctx, threadID, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &MessageList{Results: results, Paging: paging}, nil
+	}
+
 	if threadID == "" {
 		return nil, fmt.Errorf("thread ID is required")
 	}
@@ -261,7 +431,7 @@ func (c *Client) ListMessages(threadID string, opts ListOptions) (*MessageList,
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -274,15 +444,64 @@ func (c *Client) ListMessages(threadID string, opts ListOptions) (*MessageList,
 	return &result, nil
 }
 
+// GetMessage retrieves one message of a thread
+func (c *Client) GetMessage(ctx context.Context, threadID, messageID string) (*Message, error) {
+	if threadID == "" {
+		return nil, fmt.Errorf("thread ID is required")
+	}
+	if messageID == "" {
+		return nil, fmt.Errorf("message ID is required")
+	}
+
+	url := fmt.Sprintf("%s/conversations/v3/conversations/threads/%s/messages/%s", c.BaseURL, threadID, messageID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Message
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse message response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetMessageOriginalContent retrieves the full original content of a
+// message of a thread
+func (c *Client) GetMessageOriginalContent(ctx context.Context, threadID, messageID string) (*MessageOriginalContent, error) {
+	if threadID == "" {
+		return nil, fmt.Errorf("thread ID is required")
+	}
+	if messageID == "" {
+		return nil, fmt.Errorf("message ID is required")
+	}
+
+	url := fmt.Sprintf("%s/conversations/v3/conversations/threads/%s/messages/%s/original-content", c.BaseURL, threadID, messageID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MessageOriginalContent
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse original content response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // SendMessage sends a message to a thread
-func (c *Client) SendMessage(threadID string, req SendMessageRequest) (*Message, error) {
+func (c *Client) SendMessage(ctx context.Context, threadID string, req SendMessageRequest) (*Message, error) {
 	if threadID == "" {
 		return nil, fmt.Errorf("thread ID is required")
 	}
 
 	url := fmt.Sprintf("%s/conversations/v3/conversations/threads/%s/messages", c.BaseURL, threadID)
 
-	body, err := c.post(url, req)
+	body, err := c.post(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -41,7 +44,7 @@ func TestClient_ListInboxes(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListInboxes(ListOptions{Limit: 10})
+		result, err := client.ListInboxes(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "inbox-123", result.Results[0].ID)
@@ -62,7 +65,7 @@ func TestClient_ListInboxes(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListInboxes(ListOptions{})
+		result, err := client.ListInboxes(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -91,7 +94,7 @@ func TestClient_GetInbox(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		inbox, err := client.GetInbox("inbox-123")
+		inbox, err := client.GetInbox(context.Background(), "inbox-123")
 		require.NoError(t, err)
 		assert.Equal(t, "inbox-123", inbox.ID)
 		assert.Equal(t, "Support", inbox.Name)
@@ -110,7 +113,7 @@ func TestClient_GetInbox(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		inbox, err := client.GetInbox("nonexistent")
+		inbox, err := client.GetInbox(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, inbox)
@@ -118,7 +121,7 @@ func TestClient_GetInbox(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		inbox, err := client.GetInbox("")
+		inbox, err := client.GetInbox(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "inbox ID is required")
 		assert.Nil(t, inbox)
@@ -154,7 +157,7 @@ func TestClient_ListThreads(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListThreads(ListOptions{})
+		result, err := client.ListThreads(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "thread-123", result.Results[0].ID)
@@ -189,7 +192,7 @@ func TestClient_GetThread(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		thread, err := client.GetThread("thread-123")
+		thread, err := client.GetThread(context.Background(), "thread-123")
 		require.NoError(t, err)
 		assert.Equal(t, "thread-123", thread.ID)
 		assert.Equal(t, "CLOSED", thread.Status)
@@ -209,7 +212,7 @@ func TestClient_GetThread(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		thread, err := client.GetThread("nonexistent")
+		thread, err := client.GetThread(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, thread)
@@ -217,9 +220,147 @@ func TestClient_GetThread(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		thread, err := client.GetThread("")
+		thread, err := client.GetThread(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "thread ID is required")
 		assert.Nil(t, thread)
 	})
 }
+
+func TestClient_ListTicketThreads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/conversations/v3/conversations/threads", r.URL.Path)
+		assert.Equal(t, "321", r.URL.Query().Get("associatedTicketId"))
+		w.Write([]byte(`{"results": [{"id": "9001", "status": "OPEN", "associatedContactId": "55"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	threads, err := client.ListTicketThreads(context.Background(), "321")
+	require.NoError(t, err)
+	require.Len(t, threads, 1)
+	assert.Equal(t, "9001", threads[0].ID)
+	assert.Equal(t, "55", threads[0].AssociatedContactID)
+
+	_, err = client.ListTicketThreads(context.Background(), "")
+	assert.ErrorContains(t, err, "ticket ID is required")
+}
+
+func TestClient_SendMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/conversations/v3/conversations/threads/9001/messages", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"type": "MESSAGE",
+			"text": "Thanks!",
+			"senderActorId": "A-7",
+			"channelId": "1002",
+			"channelAccountId": "44",
+			"recipients": [{"recipientField": "TO", "deliveryIdentifier": {"type": "HS_EMAIL_ADDRESS", "value": "ann@example.com"}}]
+		}`, string(body))
+
+		w.Write([]byte(`{"id": "m1", "type": "MESSAGE", "text": "Thanks!", "channelAccountId": "44"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	msg, err := client.SendMessage(context.Background(), "9001", SendMessageRequest{
+		Type:             "MESSAGE",
+		Text:             "Thanks!",
+		SenderID:         "A-7",
+		ChannelID:        "1002",
+		ChannelAccountID: "44",
+		Recipients: []MessageRecipient{{
+			RecipientField:     "TO",
+			DeliveryIdentifier: &DeliveryIdentifier{Type: "HS_EMAIL_ADDRESS", Value: "ann@example.com"},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "m1", msg.ID)
+	assert.Equal(t, "44", msg.ChannelAccountID)
+}
+
+func TestClient_GetMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/conversations/v3/conversations/threads/9001/messages/m1", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Write([]byte(`{"id": "m1", "type": "MESSAGE", "status": {"statusType": "FAILED", "failureDetails": {"errorMessage": "Mailbox full"}}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	msg, err := client.GetMessage(context.Background(), "9001", "m1")
+	require.NoError(t, err)
+	require.NotNil(t, msg.Status)
+	assert.True(t, msg.Status.Failed())
+	assert.False(t, msg.Status.Delivered())
+	assert.Equal(t, "Mailbox full", msg.Status.FailureDetails.ErrorMessage)
+
+	_, err = client.GetMessage(context.Background(), "9001", "")
+	assert.ErrorContains(t, err, "message ID is required")
+}
+
+func TestMessageStatus_UnmarshalJSON(t *testing.T) {
+	var msg Message
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "m1", "status": "SENT"}`), &msg))
+	require.NotNil(t, msg.Status)
+	assert.Equal(t, MessageStatusSent, msg.Status.Type)
+	assert.True(t, msg.Status.Delivered())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "m2", "status": {"statusType": "READ"}}`), &msg))
+	assert.Equal(t, MessageStatusRead, msg.Status.Type)
+	assert.Nil(t, msg.Status.FailureDetails)
+}
+
+func TestClient_GetMessage_Attachments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"id": "m1",
+			"type": "MESSAGE",
+			"text": "See attached",
+			"truncationStatus": "TRUNCATED_TO_MOST_RECENT_REPLY",
+			"attachments": [
+				{"type": "FILE", "fileId": "555", "name": "invoice.pdf", "url": "https://cdn.example.com/invoice.pdf", "fileUsageType": "OTHER"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	msg, err := client.GetMessage(context.Background(), "9001", "m1")
+	require.NoError(t, err)
+	assert.True(t, msg.Truncated())
+	require.Len(t, msg.Attachments, 1)
+	assert.Equal(t, "555", msg.Attachments[0].FileID)
+	assert.Equal(t, "invoice.pdf", msg.Attachments[0].Name)
+
+	assert.False(t, (&Message{TruncationStatus: "NOT_TRUNCATED"}).Truncated())
+	assert.False(t, (&Message{}).Truncated())
+}
+
+func TestClient_GetMessageOriginalContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/conversations/v3/conversations/threads/9001/messages/m1/original-content", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Write([]byte(`{"text": "Hi\n\n> earlier reply", "richText": "<p>Hi</p><blockquote>earlier reply</blockquote>"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	content, err := client.GetMessageOriginalContent(context.Background(), "9001", "m1")
+	require.NoError(t, err)
+	assert.Equal(t, "Hi\n\n> earlier reply", content.Text)
+	assert.Contains(t, content.RichText, "<blockquote>")
+
+	_, err = client.GetMessageOriginalContent(context.Background(), "", "m1")
+	assert.ErrorContains(t, err, "thread ID is required")
+}
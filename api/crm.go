@@ -1,9 +1,11 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // ObjectType represents a HubSpot CRM object type
@@ -23,6 +25,16 @@ const (
 	ObjectTypeEmails    ObjectType = "emails"
 	ObjectTypeMeetings  ObjectType = "meetings"
 	ObjectTypeTasks     ObjectType = "tasks"
+
+	ObjectTypeFeedbackSubmissions ObjectType = "feedback_submissions"
+
+	// Commerce object types, created by HubSpot payments and billing
+	ObjectTypeInvoices      ObjectType = "invoices"
+	ObjectTypePayments      ObjectType = "commerce_payments"
+	ObjectTypeSubscriptions ObjectType = "subscriptions"
+
+	// ObjectTypeQuoteTemplates are the templates quotes are rendered with
+	ObjectTypeQuoteTemplates ObjectType = "quote_template"
 )
 
 // CRMObject represents a generic HubSpot CRM object
@@ -32,6 +44,19 @@ type CRMObject struct {
 	CreatedAt  string                 `json:"createdAt"`
 	UpdatedAt  string                 `json:"updatedAt"`
 	Archived   bool                   `json:"archived,omitempty"`
+
+	// PropertiesWithHistory holds past values, newest first, for properties
+	// requested with GetObjectWithHistory
+	PropertiesWithHistory map[string][]PropertyHistoryEntry `json:"propertiesWithHistory,omitempty"`
+}
+
+// PropertyHistoryEntry is one past value of a property and what set it
+type PropertyHistoryEntry struct {
+	Value       string `json:"value"`
+	Timestamp   string `json:"timestamp"`
+	SourceType  string `json:"sourceType,omitempty"`
+	SourceID    string `json:"sourceId,omitempty"`
+	SourceLabel string `json:"sourceLabel,omitempty"`
 }
 
 // GetProperty returns a property value as a string, or empty string if not found
@@ -59,6 +84,8 @@ func (o *CRMObject) GetProperty(name string) string {
 
 // CRMObjectList represents a paginated list of CRM objects
 type CRMObjectList struct {
+	// Total is the number of matching records, reported by search only
+	Total   int         `json:"total,omitempty"`
 	Results []CRMObject `json:"results"`
 	Paging  *Paging     `json:"paging,omitempty"`
 }
@@ -68,6 +95,12 @@ type ListOptions struct {
 	Limit      int
 	After      string
 	Properties []string
+
+	// All follows pagination cursors and returns every page in one list.
+	// Limit is then the page size (DefaultPageSize when zero).
+	All bool
+	// Max caps the number of results collected when All is set (0 for no cap).
+	Max int
 }
 
 // SearchFilter represents a single filter condition
@@ -92,6 +125,9 @@ type SearchSort struct {
 
 // SearchRequest represents a CRM search request
 type SearchRequest struct {
+	// Query is a free-text search across the object's default searchable
+	// properties (such as name, email, and domain)
+	Query        string              `json:"query,omitempty"`
 	FilterGroups []SearchFilterGroup `json:"filterGroups,omitempty"`
 	Sorts        []SearchSort        `json:"sorts,omitempty"`
 	Properties   []string            `json:"properties,omitempty"`
@@ -101,7 +137,8 @@ type SearchRequest struct {
 
 // CreateRequest represents a CRM object creation request
 type CreateRequest struct {
-	Properties map[string]interface{} `json:"properties"`
+	Properties   map[string]interface{} `json:"properties"`
+	Associations []ObjectAssociation    `json:"associations,omitempty"`
 }
 
 // UpdateRequest represents a CRM object update request
@@ -110,7 +147,21 @@ type UpdateRequest struct {
 }
 
 // ListObjects lists CRM objects of the given type
-func (c *Client) ListObjects(objectType ObjectType, opts ListOptions) (*CRMObjectList, error) {
+func (c *Client) ListObjects(ctx context.Context, objectType ObjectType, opts ListOptions) (*CRMObjectList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]CRMObject, *Paging, error) {
+			page, err := c.ListObjects(ctx, objectType, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &CRMObjectList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/crm/v3/objects/%s", c.BaseURL, objectType)
 
 	params := make(map[string]string)
@@ -136,7 +187,7 @@ func (c *Client) ListObjects(objectType ObjectType, opts ListOptions) (*CRMObjec
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +201,13 @@ func (c *Client) ListObjects(objectType ObjectType, opts ListOptions) (*CRMObjec
 }
 
 // GetObject retrieves a single CRM object by ID
-func (c *Client) GetObject(objectType ObjectType, id string, properties []string) (*CRMObject, error) {
+func (c *Client) GetObject(ctx context.Context, objectType ObjectType, id string, properties []string) (*CRMObject, error) {
+	return c.GetObjectWithHistory(ctx, objectType, id, properties, nil)
+}
+
+// GetObjectWithHistory retrieves a single CRM object by ID, including the
+// value history of the properties in history
+func (c *Client) GetObjectWithHistory(ctx context.Context, objectType ObjectType, id string, properties, history []string) (*CRMObject, error) {
 	if id == "" {
 		return nil, fmt.Errorf("object ID is required")
 	}
@@ -159,21 +216,17 @@ func (c *Client) GetObject(objectType ObjectType, id string, properties []string
 
 	params := make(map[string]string)
 	if len(properties) > 0 {
-		props := ""
-		for i, p := range properties {
-			if i > 0 {
-				props += ","
-			}
-			props += p
-		}
-		params["properties"] = props
+		params["properties"] = strings.Join(properties, ",")
+	}
+	if len(history) > 0 {
+		params["propertiesWithHistory"] = strings.Join(history, ",")
 	}
 
 	if len(params) > 0 {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -187,12 +240,18 @@ func (c *Client) GetObject(objectType ObjectType, id string, properties []string
 }
 
 // CreateObject creates a new CRM object
-func (c *Client) CreateObject(objectType ObjectType, properties map[string]interface{}) (*CRMObject, error) {
+func (c *Client) CreateObject(ctx context.Context, objectType ObjectType, properties map[string]interface{}) (*CRMObject, error) {
+	return c.CreateObjectWithAssociations(ctx, objectType, properties, nil)
+}
+
+// CreateObjectWithAssociations creates a new CRM object already associated
+// with existing records
+func (c *Client) CreateObjectWithAssociations(ctx context.Context, objectType ObjectType, properties map[string]interface{}, associations []ObjectAssociation) (*CRMObject, error) {
 	url := fmt.Sprintf("%s/crm/v3/objects/%s", c.BaseURL, objectType)
 
-	req := CreateRequest{Properties: properties}
+	req := CreateRequest{Properties: properties, Associations: associations}
 
-	body, err := c.post(url, req)
+	body, err := c.post(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +265,7 @@ func (c *Client) CreateObject(objectType ObjectType, properties map[string]inter
 }
 
 // UpdateObject updates an existing CRM object
-func (c *Client) UpdateObject(objectType ObjectType, id string, properties map[string]interface{}) (*CRMObject, error) {
+func (c *Client) UpdateObject(ctx context.Context, objectType ObjectType, id string, properties map[string]interface{}) (*CRMObject, error) {
 	if id == "" {
 		return nil, fmt.Errorf("object ID is required")
 	}
@@ -215,7 +274,7 @@ func (c *Client) UpdateObject(objectType ObjectType, id string, properties map[s
 
 	req := UpdateRequest{Properties: properties}
 
-	body, err := c.patch(url, req)
+	body, err := c.patch(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -229,22 +288,75 @@ func (c *Client) UpdateObject(objectType ObjectType, id string, properties map[s
 }
 
 // DeleteObject deletes a CRM object (moves to archive)
-func (c *Client) DeleteObject(objectType ObjectType, id string) error {
+func (c *Client) DeleteObject(ctx context.Context, objectType ObjectType, id string) error {
 	if id == "" {
 		return fmt.Errorf("object ID is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/objects/%s/%s", c.BaseURL, objectType, id)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
 
+// GDPRDeleteRequest is the body of a GDPR delete request
+type GDPRDeleteRequest struct {
+	ObjectID   string `json:"objectId"`
+	IDProperty string `json:"idProperty,omitempty"`
+}
+
+// GDPRDeleteContact permanently deletes a contact and its email history, as
+// required by GDPR erasure requests. id is a contact ID, or the value of
+// idProperty (e.g. "email") when that is set. Unlike DeleteObject, the
+// contact cannot be restored.
+func (c *Client) GDPRDeleteContact(ctx context.Context, id, idProperty string) error {
+	if id == "" {
+		return fmt.Errorf("contact ID or email is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/objects/%s/gdpr-delete", c.BaseURL, ObjectTypeContacts)
+
+	_, err := c.post(ctx, url, GDPRDeleteRequest{ObjectID: id, IDProperty: idProperty})
+	return err
+}
+
+// MergeRequest is the body of a merge request
+type MergeRequest struct {
+	PrimaryObjectID string `json:"primaryObjectId"`
+	ObjectIDToMerge string `json:"objectIdToMerge"`
+}
+
+// MergeObjects merges the record mergeID into primaryID and returns the
+// merged record. HubSpot keeps primaryID's values where both records have
+// one; mergeID is archived.
+func (c *Client) MergeObjects(ctx context.Context, objectType ObjectType, primaryID, mergeID string) (*CRMObject, error) {
+	if primaryID == "" || mergeID == "" {
+		return nil, fmt.Errorf("both object IDs are required")
+	}
+	if primaryID == mergeID {
+		return nil, fmt.Errorf("cannot merge a record into itself")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/objects/%s/merge", c.BaseURL, objectType)
+
+	body, err := c.post(ctx, url, MergeRequest{PrimaryObjectID: primaryID, ObjectIDToMerge: mergeID})
+	if err != nil {
+		return nil, err
+	}
+
+	var result CRMObject
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // SearchObjects searches for CRM objects
-func (c *Client) SearchObjects(objectType ObjectType, req SearchRequest) (*CRMObjectList, error) {
+func (c *Client) SearchObjects(ctx context.Context, objectType ObjectType, req SearchRequest) (*CRMObjectList, error) {
 	url := fmt.Sprintf("%s/crm/v3/objects/%s/search", c.BaseURL, objectType)
 
-	body, err := c.post(url, req)
+	body, err := c.post(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -256,3 +368,17 @@ func (c *Client) SearchObjects(objectType ObjectType, req SearchRequest) (*CRMOb
 
 	return &result, nil
 }
+
+// CountObjects returns how many objects match all of the filters, using the
+// total of a one-record search
+func (c *Client) CountObjects(ctx context.Context, objectType ObjectType, filters []SearchFilter) (int, error) {
+	result, err := c.SearchObjects(ctx, objectType, SearchRequest{
+		FilterGroups: []SearchFilterGroup{{Filters: filters}},
+		Properties:   []string{"hs_object_id"},
+		Limit:        1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
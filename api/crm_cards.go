@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CRMCard is a public app's CRM card: a panel on record pages filled from
+// the app's data fetch URL
+type CRMCard struct {
+	ID        string          `json:"id"`
+	Title     string          `json:"title"`
+	Fetch     CRMCardFetch    `json:"fetch"`
+	Display   CRMCardDisplay  `json:"display"`
+	Actions   *CRMCardActions `json:"actions,omitempty"`
+	CreatedAt string          `json:"createdAt,omitempty"`
+	UpdatedAt string          `json:"updatedAt,omitempty"`
+}
+
+// CRMCardFetch is where HubSpot fetches a card's data, and for which records
+type CRMCardFetch struct {
+	TargetURL   string              `json:"targetUrl"`
+	ObjectTypes []CRMCardObjectType `json:"objectTypes"`
+}
+
+// CRMCardObjectType is a record type a card appears on, with the record
+// properties sent to the data fetch URL
+type CRMCardObjectType struct {
+	Name             string   `json:"name"`
+	PropertiesToSend []string `json:"propertiesToSend,omitempty"`
+}
+
+// CRMCardDisplay lists the properties a card shows
+type CRMCardDisplay struct {
+	Properties []CRMCardProperty `json:"properties,omitempty"`
+}
+
+// CRMCardProperty is one property a card shows
+type CRMCardProperty struct {
+	Name     string `json:"name"`
+	Label    string `json:"label"`
+	DataType string `json:"dataType"`
+}
+
+// CRMCardActions are the URLs a card's action buttons may call
+type CRMCardActions struct {
+	BaseURLs []string `json:"baseUrls"`
+}
+
+// CRMCardList is the list of an app's CRM cards
+type CRMCardList struct {
+	Results []CRMCard `json:"results"`
+}
+
+// crmCardsURL returns the URL of an app's CRM cards resource, authenticated
+// with the developer API key
+func (c *Client) crmCardsURL(appID, cardID string) (string, error) {
+	if appID == "" {
+		return "", fmt.Errorf("app ID is required")
+	}
+	if c.DeveloperAPIKey == "" {
+		return "", ErrDeveloperAPIKeyRequired
+	}
+	url := fmt.Sprintf("%s/crm/v3/extensions/cards/%s", c.BaseURL, appID)
+	if cardID != "" {
+		url += "/" + cardID
+	}
+	return buildURL(url, map[string]string{"hapikey": c.DeveloperAPIKey}), nil
+}
+
+// ListCRMCards retrieves an app's CRM cards
+func (c *Client) ListCRMCards(ctx context.Context, appID string) (*CRMCardList, error) {
+	url, err := c.crmCardsURL(appID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CRMCardList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetCRMCard retrieves one of an app's CRM cards
+func (c *Client) GetCRMCard(ctx context.Context, appID, cardID string) (*CRMCard, error) {
+	if cardID == "" {
+		return nil, fmt.Errorf("card ID is required")
+	}
+
+	url, err := c.crmCardsURL(appID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CRMCard
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateCRMCard creates a CRM card for an app from its definition
+func (c *Client) CreateCRMCard(ctx context.Context, appID string, card map[string]interface{}) (*CRMCard, error) {
+	url, err := c.crmCardsURL(appID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.post(ctx, url, card)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CRMCard
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateCRMCard changes the parts of a CRM card given in update
+func (c *Client) UpdateCRMCard(ctx context.Context, appID, cardID string, update map[string]interface{}) (*CRMCard, error) {
+	if cardID == "" {
+		return nil, fmt.Errorf("card ID is required")
+	}
+
+	url, err := c.crmCardsURL(appID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.patch(ctx, url, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CRMCard
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteCRMCard deletes a CRM card
+func (c *Client) DeleteCRMCard(ctx context.Context, appID, cardID string) error {
+	if cardID == "" {
+		return fmt.Errorf("card ID is required")
+	}
+
+	url, err := c.crmCardsURL(appID, cardID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.delete(ctx, url)
+	return err
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListCRMCards(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/extensions/cards/123", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "dev-key", r.URL.Query().Get("hapikey"))
+
+		w.Write([]byte(`{"results": [{
+			"id": "7",
+			"title": "Orders",
+			"fetch": {"targetUrl": "https://example.com/cards/orders", "objectTypes": [{"name": "contacts", "propertiesToSend": ["email"]}]},
+			"display": {"properties": [{"name": "total", "label": "Total", "dataType": "CURRENCY"}]}
+		}]}`))
+	}))
+	defer server.Close()
+
+	result, err := newWebhooksTestClient(server).ListCRMCards(context.Background(), "123")
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	card := result.Results[0]
+	assert.Equal(t, "Orders", card.Title)
+	assert.Equal(t, "https://example.com/cards/orders", card.Fetch.TargetURL)
+	require.Len(t, card.Fetch.ObjectTypes, 1)
+	assert.Equal(t, []string{"email"}, card.Fetch.ObjectTypes[0].PropertiesToSend)
+	require.Len(t, card.Display.Properties, 1)
+	assert.Equal(t, "CURRENCY", card.Display.Properties[0].DataType)
+}
+
+func TestClient_CRMCards_RequireDeveloperKey(t *testing.T) {
+	client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+	_, err := client.ListCRMCards(context.Background(), "123")
+	assert.ErrorIs(t, err, ErrDeveloperAPIKeyRequired)
+
+	client = &Client{BaseURL: "http://unused", DeveloperAPIKey: "dev-key"}
+	_, err = client.ListCRMCards(context.Background(), "")
+	assert.ErrorContains(t, err, "app ID is required")
+}
+
+func TestClient_GetCRMCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/extensions/cards/123/7", r.URL.Path)
+		w.Write([]byte(`{"id": "7", "title": "Orders", "actions": {"baseUrls": ["https://example.com"]}}`))
+	}))
+	defer server.Close()
+
+	card, err := newWebhooksTestClient(server).GetCRMCard(context.Background(), "123", "7")
+	require.NoError(t, err)
+	require.NotNil(t, card.Actions)
+	assert.Equal(t, []string{"https://example.com"}, card.Actions.BaseURLs)
+}
+
+func TestClient_CreateCRMCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/crm/v3/extensions/cards/123", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Orders", body["title"])
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "7", "title": "Orders"}`))
+	}))
+	defer server.Close()
+
+	card, err := newWebhooksTestClient(server).CreateCRMCard(context.Background(), "123", map[string]interface{}{"title": "Orders"})
+	require.NoError(t, err)
+	assert.Equal(t, "7", card.ID)
+}
+
+func TestClient_UpdateCRMCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/crm/v3/extensions/cards/123/7", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"title": "Recent orders"}, body)
+
+		w.Write([]byte(`{"id": "7", "title": "Recent orders"}`))
+	}))
+	defer server.Close()
+
+	card, err := newWebhooksTestClient(server).UpdateCRMCard(context.Background(), "123", "7", map[string]interface{}{"title": "Recent orders"})
+	require.NoError(t, err)
+	assert.Equal(t, "Recent orders", card.Title)
+}
+
+func TestClient_DeleteCRMCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/crm/v3/extensions/cards/123/7", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := newWebhooksTestClient(server).DeleteCRMCard(context.Background(), "123", "7")
+	require.NoError(t, err)
+}
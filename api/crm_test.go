@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -119,7 +120,7 @@ func TestClient_ListObjects(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListObjects(ObjectTypeContacts, ListOptions{
+		result, err := client.ListObjects(context.Background(), ObjectTypeContacts, ListOptions{
 			Limit:      10,
 			Properties: []string{"email", "firstname", "lastname"},
 		})
@@ -145,7 +146,7 @@ func TestClient_ListObjects(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		_, err := client.ListObjects(ObjectTypeContacts, ListOptions{After: "cursor123"})
+		_, err := client.ListObjects(context.Background(), ObjectTypeContacts, ListOptions{After: "cursor123"})
 		require.NoError(t, err)
 	})
 }
@@ -176,7 +177,7 @@ func TestClient_GetObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		obj, err := client.GetObject(ObjectTypeContacts, "12345", nil)
+		obj, err := client.GetObject(context.Background(), ObjectTypeContacts, "12345", nil)
 		require.NoError(t, err)
 		assert.Equal(t, "12345", obj.ID)
 		assert.Equal(t, "jane@example.com", obj.GetProperty("email"))
@@ -195,7 +196,7 @@ func TestClient_GetObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		obj, err := client.GetObject(ObjectTypeContacts, "99999", nil)
+		obj, err := client.GetObject(context.Background(), ObjectTypeContacts, "99999", nil)
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, obj)
@@ -203,13 +204,43 @@ func TestClient_GetObject(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		obj, err := client.GetObject(ObjectTypeContacts, "", nil)
+		obj, err := client.GetObject(context.Background(), ObjectTypeContacts, "", nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "object ID is required")
 		assert.Nil(t, obj)
 	})
 }
 
+func TestClient_GetObjectWithHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/contacts/12345", r.URL.Path)
+		assert.Equal(t, "hubspotscore,lifecyclestage", r.URL.Query().Get("properties"))
+		assert.Equal(t, "hubspotscore", r.URL.Query().Get("propertiesWithHistory"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "12345",
+			"properties": {"hubspotscore": "25", "lifecyclestage": "marketingqualifiedlead"},
+			"propertiesWithHistory": {
+				"hubspotscore": [
+					{"value": "25", "timestamp": "2024-02-01T00:00:00Z", "sourceType": "CALCULATED"},
+					{"value": "10", "timestamp": "2024-01-01T00:00:00Z", "sourceType": "CALCULATED"}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	obj, err := client.GetObjectWithHistory(context.Background(), ObjectTypeContacts, "12345",
+		[]string{"hubspotscore", "lifecyclestage"}, []string{"hubspotscore"})
+	require.NoError(t, err)
+	require.Len(t, obj.PropertiesWithHistory["hubspotscore"], 2)
+	assert.Equal(t, "10", obj.PropertiesWithHistory["hubspotscore"][1].Value)
+	assert.Equal(t, "CALCULATED", obj.PropertiesWithHistory["hubspotscore"][0].SourceType)
+}
+
 func TestClient_CreateObject(t *testing.T) {
 	t.Run("create contact", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -236,7 +267,7 @@ func TestClient_CreateObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		obj, err := client.CreateObject(ObjectTypeContacts, map[string]interface{}{
+		obj, err := client.CreateObject(context.Background(), ObjectTypeContacts, map[string]interface{}{
 			"email":     "new@example.com",
 			"firstname": "New",
 			"lastname":  "Contact",
@@ -247,6 +278,33 @@ func TestClient_CreateObject(t *testing.T) {
 		assert.Equal(t, "new@example.com", obj.GetProperty("email"))
 	})
 
+	t.Run("with associations", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/notes", r.URL.Path)
+
+			var req CreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Len(t, req.Associations, 1)
+			assert.Equal(t, "123", req.Associations[0].To.ID)
+			assert.Equal(t, []AssociationSpec{{Category: "HUBSPOT_DEFINED", TypeID: 202}}, req.Associations[0].Types)
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "555", "properties": {"hs_note_body": "Hi"}}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		spec, ok := DefaultAssociationType(ObjectTypeNotes, ObjectTypeContacts)
+		require.True(t, ok)
+
+		obj, err := client.CreateObjectWithAssociations(context.Background(), ObjectTypeNotes,
+			map[string]interface{}{"hs_note_body": "Hi"},
+			[]ObjectAssociation{NewObjectAssociation("123", spec)})
+		require.NoError(t, err)
+		assert.Equal(t, "555", obj.ID)
+	})
+
 	t.Run("bad request - missing required field", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusBadRequest)
@@ -260,7 +318,7 @@ func TestClient_CreateObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		obj, err := client.CreateObject(ObjectTypeContacts, map[string]interface{}{
+		obj, err := client.CreateObject(context.Background(), ObjectTypeContacts, map[string]interface{}{
 			"firstname": "No Email",
 		})
 
@@ -296,7 +354,7 @@ func TestClient_UpdateObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		obj, err := client.UpdateObject(ObjectTypeContacts, "12345", map[string]interface{}{
+		obj, err := client.UpdateObject(context.Background(), ObjectTypeContacts, "12345", map[string]interface{}{
 			"firstname": "Johnny",
 		})
 
@@ -307,7 +365,7 @@ func TestClient_UpdateObject(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		obj, err := client.UpdateObject(ObjectTypeContacts, "", nil)
+		obj, err := client.UpdateObject(context.Background(), ObjectTypeContacts, "", nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "object ID is required")
 		assert.Nil(t, obj)
@@ -329,7 +387,7 @@ func TestClient_DeleteObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteObject(ObjectTypeContacts, "12345")
+		err := client.DeleteObject(context.Background(), ObjectTypeContacts, "12345")
 		require.NoError(t, err)
 	})
 
@@ -346,19 +404,86 @@ func TestClient_DeleteObject(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteObject(ObjectTypeContacts, "99999")
+		err := client.DeleteObject(context.Background(), ObjectTypeContacts, "99999")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteObject(ObjectTypeContacts, "")
+		err := client.DeleteObject(context.Background(), ObjectTypeContacts, "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "object ID is required")
 	})
 }
 
+func TestClient_GDPRDeleteContact(t *testing.T) {
+	t.Run("by email", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/contacts/gdpr-delete", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req GDPRDeleteRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, GDPRDeleteRequest{ObjectID: "jane@example.com", IDProperty: "email"}, req)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		require.NoError(t, client.GDPRDeleteContact(context.Background(), "jane@example.com", "email"))
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		assert.Error(t, client.GDPRDeleteContact(context.Background(), "", ""))
+	})
+}
+
+func TestClient_MergeObjects(t *testing.T) {
+	t.Run("merge contacts", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/contacts/merge", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req MergeRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "100", req.PrimaryObjectID)
+			assert.Equal(t, "200", req.ObjectIDToMerge)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "100", "properties": {"email": "jane@example.com"}}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		obj, err := client.MergeObjects(context.Background(), ObjectTypeContacts, "100", "200")
+		require.NoError(t, err)
+		assert.Equal(t, "100", obj.ID)
+		assert.Equal(t, "jane@example.com", obj.GetProperty("email"))
+	})
+
+	t.Run("invalid IDs", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.MergeObjects(context.Background(), ObjectTypeDeals, "", "200")
+		assert.Error(t, err)
+		_, err = client.MergeObjects(context.Background(), ObjectTypeDeals, "100", "100")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "into itself")
+	})
+}
+
 func TestClient_SearchObjects(t *testing.T) {
 	t.Run("search contacts by email", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -387,7 +512,7 @@ func TestClient_SearchObjects(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.SearchObjects(ObjectTypeContacts, SearchRequest{
+		result, err := client.SearchObjects(context.Background(), ObjectTypeContacts, SearchRequest{
 			FilterGroups: []SearchFilterGroup{
 				{
 					Filters: []SearchFilter{
@@ -421,7 +546,7 @@ func TestClient_SearchObjects(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		_, err := client.SearchObjects(ObjectTypeContacts, SearchRequest{
+		_, err := client.SearchObjects(context.Background(), ObjectTypeContacts, SearchRequest{
 			Sorts: []SearchSort{
 				{PropertyName: "createdate", Direction: "DESCENDING"},
 			},
@@ -443,7 +568,7 @@ func TestClient_SearchObjects(t *testing.T) {
 
 		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
 
-		result, err := client.SearchObjects(ObjectTypeTasks, SearchRequest{
+		result, err := client.SearchObjects(context.Background(), ObjectTypeTasks, SearchRequest{
 			FilterGroups: []SearchFilterGroup{
 				{Filters: []SearchFilter{{PropertyName: "hs_task_status", Operator: "EQ", Value: "NOT_STARTED"}}},
 			},
@@ -482,7 +607,7 @@ func TestClient_SearchObjects(t *testing.T) {
 
 		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
 
-		_, err := client.SearchObjects(ObjectTypeEmails, SearchRequest{
+		_, err := client.SearchObjects(context.Background(), ObjectTypeEmails, SearchRequest{
 			FilterGroups: []SearchFilterGroup{
 				{Filters: []SearchFilter{
 					{PropertyName: "hs_timestamp", Operator: "BETWEEN", Value: "1000", HighValue: "2000"},
@@ -516,6 +641,9 @@ func TestClient_AllObjectTypes(t *testing.T) {
 		ObjectTypeEmails,
 		ObjectTypeMeetings,
 		ObjectTypeTasks,
+		ObjectTypeInvoices,
+		ObjectTypePayments,
+		ObjectTypeSubscriptions,
 	}
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -532,9 +660,30 @@ func TestClient_AllObjectTypes(t *testing.T) {
 
 	for _, ot := range objectTypes {
 		t.Run(string(ot), func(t *testing.T) {
-			result, err := client.ListObjects(ot, ListOptions{Limit: 1})
+			result, err := client.ListObjects(context.Background(), ot, ListOptions{Limit: 1})
 			require.NoError(t, err)
 			assert.NotNil(t, result)
 		})
 	}
 }
+
+func TestClient_CountObjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+
+		var req SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 1, req.Limit)
+		require.Len(t, req.FilterGroups, 1)
+		assert.Equal(t, []SearchFilter{{PropertyName: "dealstage", Operator: "EQ", Value: "closedwon"}}, req.FilterGroups[0].Filters)
+
+		w.Write([]byte(`{"total": 42, "results": [{"id": "1"}], "paging": {"next": {"after": "1"}}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	n, err := client.CountObjects(context.Background(), ObjectTypeDeals, []SearchFilter{{PropertyName: "dealstage", Operator: "EQ", Value: "closedwon"}})
+	require.NoError(t, err)
+	assert.Equal(t, 42, n)
+}
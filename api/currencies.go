@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ExchangeRate is a portal exchange rate: one unit of FromCurrencyCode, the
+// company currency, is worth ConversionRate units of ToCurrencyCode
+type ExchangeRate struct {
+	ID               string  `json:"id"`
+	FromCurrencyCode string  `json:"fromCurrencyCode"`
+	ToCurrencyCode   string  `json:"toCurrencyCode"`
+	ConversionRate   float64 `json:"conversionRate"`
+	EffectiveAt      string  `json:"effectiveAt,omitempty"`
+	VisibleInUI      bool    `json:"visibleInUI"`
+}
+
+// ExchangeRateList represents a paginated list of exchange rates
+type ExchangeRateList struct {
+	Results []ExchangeRate `json:"results"`
+	Paging  *Paging        `json:"paging,omitempty"`
+}
+
+// ExchangeRateInput is a new exchange rate from the company currency to
+// ToCurrencyCode. EffectiveAt defaults to now; a currency the portal does
+// not have yet is added.
+type ExchangeRateInput struct {
+	ToCurrencyCode string  `json:"toCurrencyCode"`
+	ConversionRate float64 `json:"conversionRate"`
+	EffectiveAt    string  `json:"effectiveAt,omitempty"`
+}
+
+// GetCompanyCurrency retrieves the portal's company (home) currency code
+func (c *Client) GetCompanyCurrency(ctx context.Context) (string, error) {
+	url := fmt.Sprintf("%s/settings/v3/currencies/company-currency", c.BaseURL)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		CurrencyCode string `json:"currencyCode"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to parse company currency response: %w", err)
+	}
+
+	return result.CurrencyCode, nil
+}
+
+// ListCurrentExchangeRates retrieves the exchange rate in effect for each
+// of the portal's currencies
+func (c *Client) ListCurrentExchangeRates(ctx context.Context) ([]ExchangeRate, error) {
+	url := fmt.Sprintf("%s/settings/v3/currencies/exchange-rates/current", c.BaseURL)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Results []ExchangeRate `json:"results"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rates response: %w", err)
+	}
+
+	return result.Results, nil
+}
+
+// ListExchangeRates retrieves every exchange rate the portal has had, past
+// and current, with pagination
+func (c *Client) ListExchangeRates(ctx context.Context, opts ListOptions) (*ExchangeRateList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]ExchangeRate, *Paging, error) {
+			page, err := c.ListExchangeRates(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ExchangeRateList{Results: results, Paging: paging}, nil
+	}
+
+	url := fmt.Sprintf("%s/settings/v3/currencies/exchange-rates", c.BaseURL)
+
+	params := make(map[string]string)
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.After != "" {
+		params["after"] = opts.After
+	}
+
+	if len(params) > 0 {
+		url = buildURL(url, params)
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExchangeRateList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rates response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateExchangeRate sets a new exchange rate for a currency. Earlier rates
+// are kept as its history.
+func (c *Client) CreateExchangeRate(ctx context.Context, input ExchangeRateInput) (*ExchangeRate, error) {
+	if input.ToCurrencyCode == "" {
+		return nil, fmt.Errorf("currency code is required")
+	}
+
+	url := fmt.Sprintf("%s/settings/v3/currencies/exchange-rates", c.BaseURL)
+
+	body, err := c.post(ctx, url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExchangeRate
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rate response: %w", err)
+	}
+
+	return &result, nil
+}
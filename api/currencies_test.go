@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetCompanyCurrency(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings/v3/currencies/company-currency", r.URL.Path)
+		w.Write([]byte(`{"currencyCode": "USD", "updatedAt": "2026-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	code, err := client.GetCompanyCurrency(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "USD", code)
+}
+
+func TestClient_ListCurrentExchangeRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings/v3/currencies/exchange-rates/current", r.URL.Path)
+		w.Write([]byte(`{"results": [
+			{"id": "1", "fromCurrencyCode": "USD", "toCurrencyCode": "EUR", "conversionRate": 0.92, "visibleInUI": true}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	rates, err := client.ListCurrentExchangeRates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.Equal(t, "EUR", rates[0].ToCurrencyCode)
+	assert.InDelta(t, 0.92, rates[0].ConversionRate, 1e-9)
+}
+
+func TestClient_ListExchangeRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings/v3/currencies/exchange-rates", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+
+		if r.URL.Query().Get("after") == "" {
+			w.Write([]byte(`{"results": [{"id": "1", "toCurrencyCode": "EUR", "conversionRate": 0.9}], "paging": {"next": {"after": "1"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "2", "toCurrencyCode": "EUR", "conversionRate": 0.92}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	page, err := client.ListExchangeRates(context.Background(), ListOptions{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "1", page.Paging.Next.After)
+
+	all, err := client.ListExchangeRates(context.Background(), ListOptions{Limit: 1, All: true})
+	require.NoError(t, err)
+	require.Len(t, all.Results, 2)
+	assert.Equal(t, "2", all.Results[1].ID)
+}
+
+func TestClient_CreateExchangeRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/v3/currencies/exchange-rates", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"toCurrencyCode": "GBP", "conversionRate": 0.79}, body)
+
+		w.Write([]byte(`{"id": "3", "fromCurrencyCode": "USD", "toCurrencyCode": "GBP", "conversionRate": 0.79, "effectiveAt": "2026-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	rate, err := client.CreateExchangeRate(context.Background(), ExchangeRateInput{ToCurrencyCode: "GBP", ConversionRate: 0.79})
+	require.NoError(t, err)
+	assert.Equal(t, "3", rate.ID)
+
+	_, err = client.CreateExchangeRate(context.Background(), ExchangeRateInput{})
+	assert.Error(t, err)
+}
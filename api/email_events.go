@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Email event types reported by the email events API
+const (
+	EmailEventSent         = "SENT"
+	EmailEventDelivered    = "DELIVERED"
+	EmailEventBounce       = "BOUNCE"
+	EmailEventDropped      = "DROPPED"
+	EmailEventSpamReport   = "SPAMREPORT"
+	EmailEventStatusChange = "STATUSCHANGE"
+
+	EmailEventProcessed  = "PROCESSED"
+	EmailEventDeferred   = "DEFERRED"
+	EmailEventOpen       = "OPEN"
+	EmailEventClick      = "CLICK"
+	EmailEventPrint      = "PRINT"
+	EmailEventForward    = "FORWARD"
+	EmailEventSuppressed = "SUPPRESSED"
+)
+
+// EmailEventTypes are the event types the email events API can filter by
+var EmailEventTypes = []string{
+	EmailEventProcessed, EmailEventDropped, EmailEventDeferred, EmailEventBounce,
+	EmailEventDelivered, EmailEventSent, EmailEventOpen, EmailEventClick,
+	EmailEventPrint, EmailEventForward, EmailEventStatusChange,
+	EmailEventSpamReport, EmailEventSuppressed,
+}
+
+// EmailEvent is one event in the life of a marketing email sent to a
+// recipient. Which of the reason fields are set depends on the type.
+type EmailEvent struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	Created         int64  `json:"created"`
+	Recipient       string `json:"recipient"`
+	EmailCampaignID int64  `json:"emailCampaignId,omitempty"`
+
+	// BOUNCE: the bounce category, SMTP status code, and server response
+	Category string `json:"category,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Response string `json:"response,omitempty"`
+
+	// DROPPED: why HubSpot did not send the email
+	DropReason  string `json:"dropReason,omitempty"`
+	DropMessage string `json:"dropMessage,omitempty"`
+
+	// STATUSCHANGE: the new portal-wide status and what changed it
+	PortalSubscriptionStatus string `json:"portalSubscriptionStatus,omitempty"`
+	Source                   string `json:"source,omitempty"`
+
+	// OPEN and CLICK: the link clicked, the reader's client, and whether
+	// HubSpot filtered the event as a bot or mail scanner
+	URL           string              `json:"url,omitempty"`
+	UserAgent     string              `json:"userAgent,omitempty"`
+	DeviceType    string              `json:"deviceType,omitempty"`
+	Location      *EmailEventLocation `json:"location,omitempty"`
+	FilteredEvent bool                `json:"filteredEvent,omitempty"`
+}
+
+// EmailEventLocation is where an email was opened or clicked, from the
+// reader's IP address
+type EmailEventLocation struct {
+	City    string `json:"city,omitempty"`
+	State   string `json:"state,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// EmailEventList is a page of email events
+type EmailEventList struct {
+	Events  []EmailEvent `json:"events"`
+	HasMore bool         `json:"hasMore"`
+	Offset  string       `json:"offset,omitempty"`
+}
+
+// EmailEventOptions filters a query for email events
+type EmailEventOptions struct {
+	Recipient string
+	EventType string
+	Limit     int
+	Offset    string
+
+	// CampaignID and AppID narrow the events to one email campaign, which
+	// needs both
+	CampaignID string
+	AppID      string
+	// StartTime and EndTime bound when the events happened; zero times are
+	// left unbounded
+	StartTime time.Time
+	EndTime   time.Time
+	// ExcludeFiltered leaves out opens and clicks HubSpot filtered as bots
+	ExcludeFiltered bool
+	// All follows offsets and returns every page, up to Max events when Max
+	// is set
+	All bool
+	Max int
+}
+
+// ListEmailEvents lists marketing email events, newest first
+func (c *Client) ListEmailEvents(ctx context.Context, opts EmailEventOptions) (*EmailEventList, error) {
+	if opts.All {
+		// The events API pages by offset and hasMore rather than
+		// paging.next.after, so each page is translated for listAll
+		events, paging, err := listAll(ListOptions{Limit: opts.Limit, After: opts.Offset, Max: opts.Max}, func(o ListOptions) ([]EmailEvent, *Paging, error) {
+			query := opts
+			query.All, query.Max = false, 0
+			query.Limit, query.Offset = o.Limit, o.After
+			page, err := c.ListEmailEvents(ctx, query)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !page.HasMore {
+				return page.Events, nil, nil
+			}
+			return page.Events, &Paging{Next: &PagingNext{After: page.Offset}}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := &EmailEventList{Events: events}
+		if paging != nil {
+			result.HasMore, result.Offset = true, paging.Next.After
+		}
+		return result, nil
+	}
+
+	params := map[string]string{
+		"recipient":  opts.Recipient,
+		"eventType":  opts.EventType,
+		"offset":     opts.Offset,
+		"campaignId": opts.CampaignID,
+		"appId":      opts.AppID,
+	}
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if !opts.StartTime.IsZero() {
+		params["startTimestamp"] = strconv.FormatInt(opts.StartTime.UnixMilli(), 10)
+	}
+	if !opts.EndTime.IsZero() {
+		params["endTimestamp"] = strconv.FormatInt(opts.EndTime.UnixMilli(), 10)
+	}
+	if opts.ExcludeFiltered {
+		params["excludeFilteredEvents"] = "true"
+	}
+
+	reqURL := buildURL(fmt.Sprintf("%s/email/public/v1/events", c.BaseURL), params)
+
+	body, err := c.get(ctx, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EmailEventList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SubscriptionStatus is whether an address is subscribed to one
+// subscription type
+type SubscriptionStatus struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description,omitempty"`
+	Status              string `json:"status"`
+	SourceOfStatus      string `json:"sourceOfStatus,omitempty"`
+	PreferenceGroupName string `json:"preferenceGroupName,omitempty"`
+	LegalBasis          string `json:"legalBasis,omitempty"`
+}
+
+// SubscriptionStatuses is the subscription status of an address for every
+// subscription type in the portal
+type SubscriptionStatuses struct {
+	Recipient            string               `json:"recipient"`
+	SubscriptionStatuses []SubscriptionStatus `json:"subscriptionStatuses"`
+}
+
+// GetSubscriptionStatuses retrieves the subscription statuses of an email
+// address
+func (c *Client) GetSubscriptionStatuses(ctx context.Context, email string) (*SubscriptionStatuses, error) {
+	if email == "" {
+		return nil, fmt.Errorf("email address is required")
+	}
+
+	reqURL := fmt.Sprintf("%s/communication-preferences/v3/status/email/%s", c.BaseURL, url.PathEscape(email))
+
+	body, err := c.get(ctx, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SubscriptionStatuses
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListEmailEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/email/public/v1/events", r.URL.Path)
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("recipient"))
+		assert.Equal(t, "BOUNCE", r.URL.Query().Get("eventType"))
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		assert.False(t, r.URL.Query().Has("offset"))
+
+		w.Write([]byte(`{
+			"hasMore": true,
+			"offset": "abc",
+			"events": [{
+				"id": "e1",
+				"type": "BOUNCE",
+				"created": 1717200000000,
+				"recipient": "jane@example.com",
+				"category": "MAILBOX_FULL",
+				"status": "552",
+				"response": "552 5.2.2 Mailbox full"
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListEmailEvents(context.Background(), EmailEventOptions{
+		Recipient: "jane@example.com",
+		EventType: EmailEventBounce,
+		Limit:     50,
+	})
+	require.NoError(t, err)
+	assert.True(t, result.HasMore)
+	assert.Equal(t, "abc", result.Offset)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t, "MAILBOX_FULL", result.Events[0].Category)
+	assert.Equal(t, int64(1717200000000), result.Events[0].Created)
+}
+
+func TestClient_ListEmailEvents_CampaignFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "123", q.Get("campaignId"))
+		assert.Equal(t, "113", q.Get("appId"))
+		assert.Equal(t, "OPEN", q.Get("eventType"))
+		assert.Equal(t, "1717200000000", q.Get("startTimestamp"))
+		assert.Equal(t, "1717286400000", q.Get("endTimestamp"))
+		assert.Equal(t, "true", q.Get("excludeFilteredEvents"))
+
+		w.Write([]byte(`{
+			"hasMore": false,
+			"events": [{
+				"id": "e1",
+				"type": "OPEN",
+				"created": 1717210000000,
+				"recipient": "jane@example.com",
+				"emailCampaignId": 123,
+				"userAgent": "Mozilla/5.0",
+				"deviceType": "COMPUTER",
+				"location": {"city": "Boston", "country": "United States"}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	start := time.UnixMilli(1717200000000)
+	result, err := client.ListEmailEvents(context.Background(), EmailEventOptions{
+		CampaignID:      "123",
+		AppID:           "113",
+		EventType:       EmailEventOpen,
+		StartTime:       start,
+		EndTime:         start.Add(24 * time.Hour),
+		ExcludeFiltered: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t, "COMPUTER", result.Events[0].DeviceType)
+	require.NotNil(t, result.Events[0].Location)
+	assert.Equal(t, "Boston", result.Events[0].Location.City)
+}
+
+func TestClient_ListEmailEvents_All(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		assert.Equal(t, "123", r.URL.Query().Get("campaignId"))
+
+		if offset == "" {
+			w.Write([]byte(`{"hasMore": true, "offset": "p2", "events": [{"id": "e1", "type": "CLICK"}, {"id": "e2", "type": "CLICK"}]}`))
+			return
+		}
+		w.Write([]byte(`{"hasMore": false, "offset": "p3", "events": [{"id": "e3", "type": "CLICK"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListEmailEvents(context.Background(), EmailEventOptions{CampaignID: "123", Limit: 2, All: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "p2"}, offsets)
+	assert.Len(t, result.Events, 3)
+	assert.False(t, result.HasMore)
+	assert.Empty(t, result.Offset)
+}
+
+func TestClient_ListEmailEvents_AllMax(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"hasMore": true, "offset": "next", "events": [{"id": "e1"}, {"id": "e2"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListEmailEvents(context.Background(), EmailEventOptions{Limit: 2, All: true, Max: 2})
+	require.NoError(t, err)
+	assert.Len(t, result.Events, 2)
+	assert.True(t, result.HasMore)
+	assert.Equal(t, "next", result.Offset)
+}
+
+func TestClient_GetSubscriptionStatuses(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/communication-preferences/v3/status/email/jane+news@example.com", r.URL.Path)
+			w.Write([]byte(`{
+				"recipient": "jane+news@example.com",
+				"subscriptionStatuses": [
+					{"id": "1", "name": "Newsletter", "status": "NOT_SUBSCRIBED", "sourceOfStatus": "SUBSCRIPTION_STATUS"}
+				]
+			}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.GetSubscriptionStatuses(context.Background(), "jane+news@example.com")
+		require.NoError(t, err)
+		require.Len(t, result.SubscriptionStatuses, 1)
+		assert.Equal(t, "NOT_SUBSCRIBED", result.SubscriptionStatuses[0].Status)
+	})
+
+	t.Run("empty email", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.GetSubscriptionStatuses(context.Background(), "")
+		assert.ErrorContains(t, err, "email address is required")
+	})
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// EmailStatisticsCounters are the event counts of marketing email sends
+type EmailStatisticsCounters struct {
+	Sent         int `json:"sent"`
+	Delivered    int `json:"delivered"`
+	Open         int `json:"open"`
+	Click        int `json:"click"`
+	Bounce       int `json:"bounce"`
+	HardBounced  int `json:"hardbounced"`
+	SoftBounced  int `json:"softbounced"`
+	Unsubscribed int `json:"unsubscribed"`
+	SpamReport   int `json:"spamreport"`
+}
+
+// EmailStatisticsRatios are the event rates of marketing email sends, as
+// percentages
+type EmailStatisticsRatios struct {
+	DeliveredRatio    float64 `json:"deliveredratio"`
+	OpenRatio         float64 `json:"openratio"`
+	ClickRatio        float64 `json:"clickratio"`
+	ClickThroughRatio float64 `json:"clickthroughratio"`
+	BounceRatio       float64 `json:"bounceratio"`
+	UnsubscribedRatio float64 `json:"unsubscribedratio"`
+	SpamReportRatio   float64 `json:"spamreportratio"`
+}
+
+// EmailStatistics are the aggregated send statistics of marketing emails
+type EmailStatistics struct {
+	Counters EmailStatisticsCounters `json:"counters"`
+	Ratios   EmailStatisticsRatios   `json:"ratios"`
+}
+
+// EmailStatisticsRange limits email statistics to sends between two ISO 8601
+// timestamps; empty values leave the range open
+type EmailStatisticsRange struct {
+	StartTimestamp string
+	EndTimestamp   string
+}
+
+// GetMarketingEmailStatistics retrieves the aggregated statistics of a
+// marketing email
+func (c *Client) GetMarketingEmailStatistics(ctx context.Context, emailID string, r EmailStatisticsRange) (*EmailStatistics, error) {
+	if emailID == "" {
+		return nil, fmt.Errorf("email ID is required")
+	}
+
+	url := buildURL(fmt.Sprintf("%s/marketing/v3/emails/statistics/list", c.BaseURL), map[string]string{
+		"emailIds":       emailID,
+		"startTimestamp": r.StartTimestamp,
+		"endTimestamp":   r.EndTimestamp,
+	})
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Aggregate EmailStatistics `json:"aggregate"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse email statistics response: %w", err)
+	}
+
+	return &result.Aggregate, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetMarketingEmailStatistics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/marketing/v3/emails/statistics/list", r.URL.Path)
+		assert.Equal(t, "123", r.URL.Query().Get("emailIds"))
+		assert.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("startTimestamp"))
+		assert.False(t, r.URL.Query().Has("endTimestamp"))
+
+		w.Write([]byte(`{
+			"aggregate": {
+				"counters": {"sent": 1000, "delivered": 980, "open": 420, "click": 85, "bounce": 20, "hardbounced": 5, "softbounced": 15, "unsubscribed": 7, "spamreport": 1},
+				"ratios": {"deliveredratio": 98, "openratio": 42.86, "clickratio": 8.67, "bounceratio": 2, "unsubscribedratio": 0.71}
+			},
+			"emails": [123]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	stats, err := client.GetMarketingEmailStatistics(context.Background(), "123", EmailStatisticsRange{StartTimestamp: "2026-01-01T00:00:00Z"})
+	require.NoError(t, err)
+	assert.Equal(t, 1000, stats.Counters.Sent)
+	assert.Equal(t, 420, stats.Counters.Open)
+	assert.Equal(t, 5, stats.Counters.HardBounced)
+	assert.Equal(t, 7, stats.Counters.Unsubscribed)
+	assert.Equal(t, 42.86, stats.Ratios.OpenRatio)
+
+	_, err = client.GetMarketingEmailStatistics(context.Background(), "", EmailStatisticsRange{})
+	assert.Error(t, err)
+}
@@ -17,6 +17,10 @@ var (
 	ErrRateLimited         = errors.New("rate limited: too many requests")
 	ErrServerError         = errors.New("server error")
 	ErrAccessTokenRequired = errors.New("access token is required")
+	ErrBudgetExhausted     = errors.New("API request budget exhausted")
+	ErrReadOnly            = errors.New("read-only mode: requests that change data are disabled")
+
+	ErrDeveloperAPIKeyRequired = errors.New("developer API key is required")
 )
 
 // APIError represents an error response from the HubSpot API
@@ -26,6 +30,63 @@ type APIError struct {
 	Message       string `json:"message"`
 	ErrorType     string `json:"errorType"`
 	CorrelationID string `json:"correlationId"`
+	Category      string `json:"category"`
+
+	// Errors carries per-error details, such as the scopes a MISSING_SCOPES
+	// response requires
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+// APIErrorDetail is one entry of an error response's errors list
+type APIErrorDetail struct {
+	Message string `json:"message"`
+	Context struct {
+		RequiredScopes         []string `json:"requiredScopes"`
+		RequiredGranularScopes []string `json:"requiredGranularScopes"`
+	} `json:"context"`
+}
+
+// requiredScopes returns the scopes a MISSING_SCOPES response names,
+// preferring granular scopes
+func (e *APIError) requiredScopes() []string {
+	var scopes, granular []string
+	for _, d := range e.Errors {
+		scopes = append(scopes, d.Context.RequiredScopes...)
+		granular = append(granular, d.Context.RequiredGranularScopes...)
+	}
+	if len(granular) > 0 {
+		return granular
+	}
+	return scopes
+}
+
+// ScopeError is a 403 caused by scopes the access token was not granted. It
+// matches ErrForbidden with errors.Is.
+type ScopeError struct {
+	Missing []string
+	// Err is HubSpot's own error for the 403, if any
+	Err error
+}
+
+func (e *ScopeError) Error() string {
+	noun := "scope"
+	if len(e.Missing) > 1 {
+		noun = "scopes"
+	}
+	msg := fmt.Sprintf("missing %s %s: grant it to the app and use the updated access token",
+		noun, strings.Join(e.Missing, ", "))
+	if e.Err != nil {
+		msg += " (" + e.Err.Error() + ")"
+	}
+	return msg
+}
+
+// Unwrap makes a ScopeError match ErrForbidden and its cause
+func (e *ScopeError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrForbidden}
+	}
+	return []error{ErrForbidden, e.Err}
 }
 
 func (e *APIError) Error() string {
@@ -66,6 +127,9 @@ func ParseAPIError(resp *http.Response, body []byte) error {
 		}
 		return ErrUnauthorized
 	case http.StatusForbidden:
+		if scopes := apiErr.requiredScopes(); len(scopes) > 0 {
+			return &ScopeError{Missing: scopes, Err: apiErr}
+		}
 		if apiErr.Message != "" {
 			return fmt.Errorf("%w: %s", ErrForbidden, apiErr.Message)
 		}
@@ -108,6 +172,18 @@ func IsForbidden(err error) bool {
 	return errors.Is(err, ErrForbidden)
 }
 
+// IsBudgetExhausted checks if an error was caused by the request budget
+// running out
+func IsBudgetExhausted(err error) bool {
+	return errors.Is(err, ErrBudgetExhausted)
+}
+
+// IsReadOnly checks if an error was caused by a read-only client refusing
+// a request that changes data
+func IsReadOnly(err error) bool {
+	return errors.Is(err, ErrReadOnly)
+}
+
 // IsRateLimited checks if an error is a rate limited error
 func IsRateLimited(err error) bool {
 	return errors.Is(err, ErrRateLimited)
@@ -94,6 +94,13 @@ func TestParseAPIError(t *testing.T) {
 			wantErr:    ErrForbidden,
 			wantMsg:    "Missing required scope",
 		},
+		{
+			name:       "403 missing scopes",
+			statusCode: http.StatusForbidden,
+			body:       `{"status": "error", "message": "This app hasn't been granted all required scopes to make this call.", "category": "MISSING_SCOPES", "errors": [{"message": "One or more of the following scopes are required.", "context": {"requiredGranularScopes": ["crm.objects.deals.write"]}}]}`,
+			wantErr:    ErrForbidden,
+			wantMsg:    "missing scope crm.objects.deals.write",
+		},
 		{
 			name:       "404 not found",
 			statusCode: http.StatusNotFound,
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Event is one completion of a behavioral event by a CRM record
+type Event struct {
+	ID         string                 `json:"id"`
+	EventType  string                 `json:"eventType"`
+	ObjectType string                 `json:"objectType"`
+	ObjectID   string                 `json:"objectId"`
+	OccurredAt time.Time              `json:"occurredAt"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// EventList represents a paginated list of event completions
+type EventList struct {
+	Results []Event `json:"results"`
+	Paging  *Paging `json:"paging,omitempty"`
+}
+
+// EventQuery selects the event completions ListEvents returns
+type EventQuery struct {
+	// EventType is the event's internal name, such as pe1234567_viewed_pricing
+	// for a custom event or e_visited_page for a standard one
+	EventType string
+	// ObjectType is the type of record that completed the event, such as
+	// contact; ObjectID narrows it to one record
+	ObjectType string
+	ObjectID   string
+	// OccurredAfter and OccurredBefore bound when the events happened; zero
+	// times are left unbounded
+	OccurredAfter  time.Time
+	OccurredBefore time.Time
+}
+
+// ListEvents retrieves completions of a behavioral event, or every event a
+// record completed
+func (c *Client) ListEvents(ctx context.Context, query EventQuery, opts ListOptions) (*EventList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Event, *Paging, error) {
+			page, err := c.ListEvents(ctx, query, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &EventList{Results: results, Paging: paging}, nil
+	}
+
+	// Without an event type, the API lists every event of one record
+	if query.EventType == "" && (query.ObjectType == "" || query.ObjectID == "") {
+		return nil, fmt.Errorf("event type or object type and ID is required")
+	}
+
+	params := map[string]string{
+		"eventType":  query.EventType,
+		"objectType": query.ObjectType,
+		"objectId":   query.ObjectID,
+		"after":      opts.After,
+	}
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if !query.OccurredAfter.IsZero() {
+		params["occurredAfter"] = query.OccurredAfter.UTC().Format(time.RFC3339)
+	}
+	if !query.OccurredBefore.IsZero() {
+		params["occurredBefore"] = query.OccurredBefore.UTC().Format(time.RFC3339)
+	}
+
+	url := buildURL(fmt.Sprintf("%s/events/v3/events", c.BaseURL), params)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EventList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse events response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// EventDefinition defines a custom behavioral event
+type EventDefinition struct {
+	ID                 string                    `json:"id"`
+	Name               string                    `json:"name"`
+	FullyQualifiedName string                    `json:"fullyQualifiedName"`
+	Labels             EventDefinitionLabels     `json:"labels"`
+	Description        string                    `json:"description,omitempty"`
+	PrimaryObject      string                    `json:"primaryObject,omitempty"`
+	Archived           bool                      `json:"archived,omitempty"`
+	CreatedAt          string                    `json:"createdAt,omitempty"`
+	Properties         []EventPropertyDefinition `json:"properties,omitempty"`
+}
+
+// EventDefinitionLabels are the names an event definition is shown with
+type EventDefinitionLabels struct {
+	Singular string `json:"singular"`
+	Plural   string `json:"plural,omitempty"`
+}
+
+// EventPropertyDefinition is one property a custom event carries. Type is
+// string, number, enumeration, or datetime; enumerations list their Options.
+type EventPropertyDefinition struct {
+	Name    string                `json:"name"`
+	Label   string                `json:"label"`
+	Type    string                `json:"type"`
+	Options []EventPropertyOption `json:"options,omitempty"`
+}
+
+// EventPropertyOption is one value of an enumeration event property
+type EventPropertyOption struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// EventDefinitionList represents a paginated list of event definitions
+type EventDefinitionList struct {
+	Results []EventDefinition `json:"results"`
+	Paging  *Paging           `json:"paging,omitempty"`
+}
+
+// EventDefinitionInput is a new custom event definition. Name is the event's
+// internal name without the pe<portal ID>_ prefix HubSpot adds.
+type EventDefinitionInput struct {
+	Name                string                    `json:"name"`
+	Label               string                    `json:"label"`
+	Description         string                    `json:"description,omitempty"`
+	PrimaryObject       string                    `json:"primaryObject,omitempty"`
+	PropertyDefinitions []EventPropertyDefinition `json:"propertyDefinitions,omitempty"`
+}
+
+// ListEventDefinitions lists the portal's custom event definitions, those
+// whose name or label contains search when it is set
+func (c *Client) ListEventDefinitions(ctx context.Context, search string, opts ListOptions) (*EventDefinitionList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]EventDefinition, *Paging, error) {
+			page, err := c.ListEventDefinitions(ctx, search, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &EventDefinitionList{Results: results, Paging: paging}, nil
+	}
+
+	params := map[string]string{
+		"searchString": search,
+		"after":        opts.After,
+	}
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+
+	url := buildURL(fmt.Sprintf("%s/events/v3/event-definitions", c.BaseURL), params)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EventDefinitionList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse event definitions response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateEventDefinition creates a custom event definition
+func (c *Client) CreateEventDefinition(ctx context.Context, input EventDefinitionInput) (*EventDefinition, error) {
+	url := fmt.Sprintf("%s/events/v3/event-definitions", c.BaseURL)
+
+	body, err := c.post(ctx, url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EventDefinition
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse event definition response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// EventOccurrence is one completion of a custom event to send. It names the
+// record that completed it by Email, UTK (the hubspotutk tracking cookie),
+// or ObjectID; a zero OccurredAt is now.
+type EventOccurrence struct {
+	EventName  string            `json:"eventName"`
+	Email      string            `json:"email,omitempty"`
+	UTK        string            `json:"utk,omitempty"`
+	ObjectID   string            `json:"objectId,omitempty"`
+	OccurredAt *time.Time        `json:"occurredAt,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// SendEvent records a completion of a custom event
+func (c *Client) SendEvent(ctx context.Context, event EventOccurrence) error {
+	if event.EventName == "" {
+		return fmt.Errorf("event name is required")
+	}
+	if event.Email == "" && event.UTK == "" && event.ObjectID == "" {
+		return fmt.Errorf("an email, utk, or object ID is required")
+	}
+
+	url := fmt.Sprintf("%s/events/v3/send", c.BaseURL)
+
+	_, err := c.post(ctx, url, event)
+	return err
+}
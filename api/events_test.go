@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListEvents(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/events/v3/events", r.URL.Path)
+			assert.Equal(t, "pe123_viewed_pricing", r.URL.Query().Get("eventType"))
+			assert.Equal(t, "contact", r.URL.Query().Get("objectType"))
+			assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("occurredAfter"))
+			assert.Empty(t, r.URL.Query().Get("occurredBefore"))
+			assert.Equal(t, "50", r.URL.Query().Get("limit"))
+
+			w.Write([]byte(`{
+				"results": [{"id": "e1", "eventType": "pe123_viewed_pricing", "objectType": "CONTACT", "objectId": "101", "occurredAt": "2024-02-03T04:05:06.789Z"}],
+				"paging": {"next": {"after": "abc"}}
+			}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.ListEvents(context.Background(), EventQuery{
+			EventType:     "pe123_viewed_pricing",
+			ObjectType:    "contact",
+			OccurredAfter: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}, ListOptions{Limit: 50})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "101", result.Results[0].ObjectID)
+		assert.Equal(t, 2024, result.Results[0].OccurredAt.Year())
+		assert.Equal(t, "abc", result.Paging.Next.After)
+	})
+
+	t.Run("all pages", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.URL.Query().Get("after") == "" {
+				w.Write([]byte(`{"results": [{"id": "e1", "objectId": "1", "occurredAt": "2024-02-03T00:00:00Z"}], "paging": {"next": {"after": "p2"}}}`))
+				return
+			}
+			w.Write([]byte(`{"results": [{"id": "e2", "objectId": "2", "occurredAt": "2024-02-04T00:00:00Z"}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.ListEvents(context.Background(), EventQuery{EventType: "e_visited_page"}, ListOptions{All: true})
+		require.NoError(t, err)
+		assert.Len(t, result.Results, 2)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("one record's events", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.False(t, r.URL.Query().Has("eventType"))
+			assert.Equal(t, "contact", r.URL.Query().Get("objectType"))
+			assert.Equal(t, "101", r.URL.Query().Get("objectId"))
+
+			w.Write([]byte(`{"results": [{"id": "e1", "eventType": "e_visited_page", "objectType": "CONTACT", "objectId": "101", "occurredAt": "2024-02-03T00:00:00Z", "properties": {"hs_url": "https://example.com/pricing"}}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.ListEvents(context.Background(), EventQuery{ObjectType: "contact", ObjectID: "101"}, ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "https://example.com/pricing", result.Results[0].Properties["hs_url"])
+	})
+
+	t.Run("requires event type or record", func(t *testing.T) {
+		client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+		_, err := client.ListEvents(context.Background(), EventQuery{}, ListOptions{})
+		assert.ErrorContains(t, err, "event type or object type and ID is required")
+
+		_, err = client.ListEvents(context.Background(), EventQuery{ObjectType: "contact"}, ListOptions{})
+		assert.ErrorContains(t, err, "event type or object type and ID is required")
+	})
+}
+
+func TestClient_ListEventDefinitions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/events/v3/event-definitions", r.URL.Path)
+		assert.Equal(t, "signup", r.URL.Query().Get("searchString"))
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+
+		w.Write([]byte(`{
+			"results": [{
+				"id": "123",
+				"name": "signed_up",
+				"fullyQualifiedName": "pe456_signed_up",
+				"labels": {"singular": "Signed up"},
+				"primaryObject": "CONTACT",
+				"properties": [{"name": "plan", "label": "Plan", "type": "string"}]
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListEventDefinitions(context.Background(), "signup", ListOptions{Limit: 20})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "pe456_signed_up", result.Results[0].FullyQualifiedName)
+	assert.Equal(t, "Signed up", result.Results[0].Labels.Singular)
+	require.Len(t, result.Results[0].Properties, 1)
+	assert.Equal(t, "plan", result.Results[0].Properties[0].Name)
+}
+
+func TestClient_CreateEventDefinition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/events/v3/event-definitions", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "signed_up", body["name"])
+		assert.Equal(t, "Signed up", body["label"])
+		assert.Equal(t, "CONTACT", body["primaryObject"])
+		props := body["propertyDefinitions"].([]interface{})
+		require.Len(t, props, 1)
+		assert.Equal(t, "number", props[0].(map[string]interface{})["type"])
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "123", "name": "signed_up", "fullyQualifiedName": "pe456_signed_up", "labels": {"singular": "Signed up"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	def, err := client.CreateEventDefinition(context.Background(), EventDefinitionInput{
+		Name:          "signed_up",
+		Label:         "Signed up",
+		PrimaryObject: "CONTACT",
+		PropertyDefinitions: []EventPropertyDefinition{
+			{Name: "seats", Label: "Seats", Type: "number"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pe456_signed_up", def.FullyQualifiedName)
+}
+
+func TestClient_SendEvent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/events/v3/send", r.URL.Path)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "pe456_signed_up", body["eventName"])
+			assert.Equal(t, "user@example.com", body["email"])
+			assert.Equal(t, "2024-02-03T04:05:06Z", body["occurredAt"])
+			assert.Equal(t, map[string]interface{}{"plan": "pro"}, body["properties"])
+			assert.NotContains(t, body, "utk")
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		at := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+		err := client.SendEvent(context.Background(), EventOccurrence{
+			EventName:  "pe456_signed_up",
+			Email:      "user@example.com",
+			OccurredAt: &at,
+			Properties: map[string]string{"plan": "pro"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("requires a record", func(t *testing.T) {
+		client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+		err := client.SendEvent(context.Background(), EventOccurrence{EventName: "pe456_signed_up"})
+		assert.ErrorContains(t, err, "an email, utk, or object ID is required")
+	})
+}
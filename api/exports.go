@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Export types
+const (
+	ExportTypeView = "VIEW"
+	ExportTypeList = "LIST"
+)
+
+// Export task statuses
+const (
+	ExportStatusPending    = "PENDING"
+	ExportStatusProcessing = "PROCESSING"
+	ExportStatusComplete   = "COMPLETE"
+	ExportStatusCanceled   = "CANCELED"
+	ExportStatusConflict   = "CONFLICT"
+)
+
+// ExportRequest is the request body for starting an export. A VIEW export
+// selects records with PublicCrmSearchRequest; a LIST export exports the
+// members of ListID.
+type ExportRequest struct {
+	ExportType             string         `json:"exportType"`
+	ExportName             string         `json:"exportName"`
+	Format                 string         `json:"format"`
+	Language               string         `json:"language"`
+	ObjectType             string         `json:"objectType"`
+	ObjectProperties       []string       `json:"objectProperties"`
+	AssociatedObjectType   []string       `json:"associatedObjectType,omitempty"`
+	ListID                 string         `json:"listId,omitempty"`
+	PublicCrmSearchRequest *ExportFilters `json:"publicCrmSearchRequest,omitempty"`
+}
+
+// ExportFilters selects the records of a VIEW export
+type ExportFilters struct {
+	Filters []SearchFilter `json:"filters,omitempty"`
+	Sorts   []SearchSort   `json:"sorts,omitempty"`
+	Query   string         `json:"query,omitempty"`
+}
+
+// ExportTask identifies a started export
+type ExportTask struct {
+	ID    string            `json:"id"`
+	Links map[string]string `json:"links,omitempty"`
+}
+
+// ExportError is a problem HubSpot reported while exporting
+type ExportError struct {
+	Message string `json:"message"`
+}
+
+// ExportStatus is the progress of an export. Result is the URL of the
+// finished file once Status is COMPLETE.
+type ExportStatus struct {
+	Status      string        `json:"status"`
+	Result      string        `json:"result,omitempty"`
+	NumErrors   int           `json:"numErrors,omitempty"`
+	Errors      []ExportError `json:"errors,omitempty"`
+	RequestedAt string        `json:"requestedAt,omitempty"`
+	StartedAt   string        `json:"startedAt,omitempty"`
+	CompletedAt string        `json:"completedAt,omitempty"`
+}
+
+// Done reports whether the export has stopped, successfully or not
+func (s *ExportStatus) Done() bool {
+	switch s.Status {
+	case ExportStatusComplete, ExportStatusCanceled, ExportStatusConflict:
+		return true
+	}
+	return false
+}
+
+// StartExport starts an export; HubSpot builds the file in the background
+func (c *Client) StartExport(ctx context.Context, req ExportRequest) (*ExportTask, error) {
+	if req.ObjectType == "" {
+		return nil, fmt.Errorf("object type is required")
+	}
+	if len(req.ObjectProperties) == 0 {
+		return nil, fmt.Errorf("at least one property is required")
+	}
+	if req.ExportType == ExportTypeList && req.ListID == "" {
+		return nil, fmt.Errorf("list ID is required for a list export")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/exports/export/async", c.BaseURL)
+
+	body, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExportTask
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse export response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetExportStatus retrieves the progress of an export, including the
+// download URL once it is complete
+func (c *Client) GetExportStatus(ctx context.Context, taskID string) (*ExportStatus, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("export task ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/exports/export/async/tasks/%s/status", c.BaseURL, taskID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExportStatus
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse export status response: %w", err)
+	}
+
+	return &result, nil
+}
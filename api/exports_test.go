@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_StartExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/exports/export/async", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "VIEW", body["exportType"])
+		assert.Equal(t, "CONTACT", body["objectType"])
+		assert.Equal(t, []interface{}{"email", "firstname"}, body["objectProperties"])
+		search := body["publicCrmSearchRequest"].(map[string]interface{})
+		assert.Len(t, search["filters"], 1)
+		assert.NotContains(t, body, "listId")
+
+		w.Write([]byte(`{"id": "5566", "links": {"status": "https://api.hubapi.com/crm/v3/exports/export/async/tasks/5566/status"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	task, err := client.StartExport(context.Background(), ExportRequest{
+		ExportType:       ExportTypeView,
+		ExportName:       "MQLs",
+		Format:           "CSV",
+		Language:         "EN",
+		ObjectType:       "CONTACT",
+		ObjectProperties: []string{"email", "firstname"},
+		PublicCrmSearchRequest: &ExportFilters{
+			Filters: []SearchFilter{{PropertyName: "lifecyclestage", Operator: "EQ", Value: "marketingqualifiedlead"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "5566", task.ID)
+}
+
+func TestClient_StartExport_Validation(t *testing.T) {
+	client := &Client{BaseURL: "https://api.example.com", AccessToken: "test-token"}
+
+	_, err := client.StartExport(context.Background(), ExportRequest{ObjectProperties: []string{"email"}})
+	assert.ErrorContains(t, err, "object type is required")
+
+	_, err = client.StartExport(context.Background(), ExportRequest{ObjectType: "CONTACT"})
+	assert.ErrorContains(t, err, "at least one property")
+
+	_, err = client.StartExport(context.Background(), ExportRequest{ExportType: ExportTypeList, ObjectType: "CONTACT", ObjectProperties: []string{"email"}})
+	assert.ErrorContains(t, err, "list ID is required")
+}
+
+func TestClient_GetExportStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/exports/export/async/tasks/5566/status", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Write([]byte(`{"status": "COMPLETE", "result": "https://files.example.com/export.zip", "completedAt": "2024-05-01T10:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	status, err := client.GetExportStatus(context.Background(), "5566")
+	require.NoError(t, err)
+	assert.Equal(t, ExportStatusComplete, status.Status)
+	assert.Equal(t, "https://files.example.com/export.zip", status.Result)
+	assert.True(t, status.Done())
+
+	_, err = client.GetExportStatus(context.Background(), "")
+	assert.ErrorContains(t, err, "export task ID is required")
+}
+
+func TestExportStatus_Done(t *testing.T) {
+	for status, done := range map[string]bool{
+		ExportStatusPending:    false,
+		ExportStatusProcessing: false,
+		ExportStatusComplete:   true,
+		ExportStatusCanceled:   true,
+		ExportStatusConflict:   true,
+	} {
+		assert.Equal(t, done, (&ExportStatus{Status: status}).Done(), status)
+	}
+}
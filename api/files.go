@@ -0,0 +1,265 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"strings"
+)
+
+// File access levels
+const (
+	FileAccessPublicIndexable    = "PUBLIC_INDEXABLE"
+	FileAccessPublicNotIndexable = "PUBLIC_NOT_INDEXABLE"
+	FileAccessPrivate            = "PRIVATE"
+)
+
+// Folder update task statuses
+const (
+	FolderUpdatePending    = "PENDING"
+	FolderUpdateProcessing = "PROCESSING"
+	FolderUpdateComplete   = "COMPLETE"
+	FolderUpdateCanceled   = "CANCELED"
+)
+
+// FileUpload is a file to upload to the file manager
+type FileUpload struct {
+	// Name is the file name, including its extension
+	Name string
+	// FolderPath is the folder to upload to, e.g. /assets/img; HubSpot
+	// creates missing folders
+	FolderPath string
+	// Access is one of the FileAccess constants
+	Access string
+	// Overwrite replaces a file with the same name in the folder
+	Overwrite bool
+	Content   io.Reader
+}
+
+// FolderUpdate changes a folder's name or moves it to another parent
+type FolderUpdate struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	ParentFolderID string `json:"parentFolderId,omitempty"`
+}
+
+// FolderUpdateTask is a background folder update
+type FolderUpdateTask struct {
+	ID     string  `json:"id"`
+	Status string  `json:"status"`
+	Result *Folder `json:"result,omitempty"`
+}
+
+// Done reports whether the folder update has stopped
+func (t *FolderUpdateTask) Done() bool {
+	return t.Status == FolderUpdateComplete || t.Status == FolderUpdateCanceled
+}
+
+// PathStat is what a file manager path refers to: a file or a folder
+type PathStat struct {
+	File   *File   `json:"file,omitempty"`
+	Folder *Folder `json:"folder,omitempty"`
+}
+
+// escapePath escapes each segment of a file manager path for use in a URL
+func escapePath(p string) string {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// StatPath looks up the file or folder at a file manager path such as
+// /assets/img. It returns an error matching ErrNotFound when nothing is
+// there.
+func (c *Client) StatPath(ctx context.Context, path string) (*PathStat, error) {
+	if strings.Trim(path, "/") == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+
+	url := fmt.Sprintf("%s/files/v3/files/stat/%s", c.BaseURL, escapePath(path))
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PathStat
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse path response: %w", err)
+	}
+	if result.File == nil && result.Folder == nil {
+		return nil, fmt.Errorf("nothing at %s: %w", path, ErrNotFound)
+	}
+
+	return &result, nil
+}
+
+// CreateFolder creates a folder named name inside the folder at parentPath;
+// an empty parentPath creates it at the top level
+func (c *Client) CreateFolder(ctx context.Context, name, parentPath string) (*Folder, error) {
+	if name == "" {
+		return nil, fmt.Errorf("folder name is required")
+	}
+
+	req := map[string]string{"name": name}
+	if p := strings.Trim(parentPath, "/"); p != "" {
+		req["parentPath"] = "/" + p
+	}
+
+	url := fmt.Sprintf("%s/files/v3/folders", c.BaseURL)
+
+	body, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Folder
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse folder response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteFolder deletes a folder by ID
+func (c *Client) DeleteFolder(ctx context.Context, folderID string) error {
+	if folderID == "" {
+		return fmt.Errorf("folder ID is required")
+	}
+
+	url := fmt.Sprintf("%s/files/v3/folders/%s", c.BaseURL, folderID)
+
+	_, err := c.delete(ctx, url)
+	return err
+}
+
+// StartFolderUpdate starts renaming or moving a folder; HubSpot updates the
+// folder and the paths of everything in it in the background
+func (c *Client) StartFolderUpdate(ctx context.Context, update FolderUpdate) (*FolderUpdateTask, error) {
+	if update.ID == "" {
+		return nil, fmt.Errorf("folder ID is required")
+	}
+
+	url := fmt.Sprintf("%s/files/v3/folders/update/async", c.BaseURL)
+
+	body, err := c.post(ctx, url, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var result FolderUpdateTask
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse folder update response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetFolderUpdateStatus retrieves the progress of a folder update
+func (c *Client) GetFolderUpdateStatus(ctx context.Context, taskID string) (*FolderUpdateTask, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task ID is required")
+	}
+
+	url := fmt.Sprintf("%s/files/v3/folders/update/async/tasks/%s/status", c.BaseURL, taskID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result FolderUpdateTask
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse folder update status: %w", err)
+	}
+	if result.ID == "" {
+		result.ID = taskID
+	}
+
+	return &result, nil
+}
+
+// UploadFile uploads a file to the file manager
+func (c *Client) UploadFile(ctx context.Context, upload FileUpload) (*File, error) {
+	if upload.Name == "" {
+		return nil, fmt.Errorf("file name is required")
+	}
+	access := upload.Access
+	if access == "" {
+		access = FileAccessPublicNotIndexable
+	}
+	options, err := json.Marshal(map[string]interface{}{
+		"access":    access,
+		"overwrite": upload.Overwrite,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fields := [][2]string{
+		{"fileName", upload.Name},
+		{"folderPath", "/" + strings.Trim(upload.FolderPath, "/")},
+		{"options", string(options)},
+	}
+	for _, f := range fields {
+		if err := mw.WriteField(f[0], f[1]); err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
+	}
+	part, err := mw.CreateFormFile("file", upload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	if _, err := io.Copy(part, upload.Content); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", upload.Name, err)
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/files/v3/files", c.BaseURL)
+
+	body, err := c.postMultipart(ctx, url, buf.Bytes(), mw.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	var result File
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse file response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetFileSignedURL returns a short-lived download URL for a file, which
+// works for private files too
+func (c *Client) GetFileSignedURL(ctx context.Context, fileID string) (string, error) {
+	if fileID == "" {
+		return "", fmt.Errorf("file ID is required")
+	}
+
+	url := fmt.Sprintf("%s/files/v3/files/%s/signed-url", c.BaseURL, fileID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to parse signed URL response: %w", err)
+	}
+
+	return result.URL, nil
+}
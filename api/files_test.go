@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_StatPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.EscapedPath() {
+		case "/files/v3/files/stat/assets/brand%20kit":
+			w.Write([]byte(`{"folder": {"id": "77", "name": "brand kit", "path": "/assets/brand kit"}}`))
+		case "/files/v3/files/stat/assets/logo.png":
+			w.Write([]byte(`{"file": {"id": "901", "name": "logo", "path": "/assets/logo.png"}}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	stat, err := client.StatPath(context.Background(), "/assets/brand kit/")
+	require.NoError(t, err)
+	require.NotNil(t, stat.Folder)
+	assert.Nil(t, stat.File)
+	assert.Equal(t, "77", stat.Folder.ID)
+
+	stat, err = client.StatPath(context.Background(), "assets/logo.png")
+	require.NoError(t, err)
+	require.NotNil(t, stat.File)
+	assert.Equal(t, "901", stat.File.ID)
+
+	_, err = client.StatPath(context.Background(), "/missing")
+	assert.True(t, IsNotFound(err))
+
+	_, err = client.StatPath(context.Background(), "/")
+	assert.ErrorContains(t, err, "path is required")
+}
+
+func TestClient_CreateFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/v3/folders", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var req map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]string{"name": "img", "parentPath": "/assets"}, req)
+
+		w.Write([]byte(`{"id": "78", "name": "img", "path": "/assets/img", "parentFolderId": "77"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	folder, err := client.CreateFolder(context.Background(), "img", "assets/")
+	require.NoError(t, err)
+	assert.Equal(t, "78", folder.ID)
+	assert.Equal(t, "/assets/img", folder.Path)
+
+	_, err = client.CreateFolder(context.Background(), "", "")
+	assert.ErrorContains(t, err, "folder name is required")
+}
+
+func TestClient_DeleteFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/v3/folders/78", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.DeleteFolder(context.Background(), "78"))
+	assert.ErrorContains(t, client.DeleteFolder(context.Background(), ""), "folder ID is required")
+}
+
+func TestClient_FolderUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/files/v3/folders/update/async":
+			assert.Equal(t, http.MethodPost, r.Method)
+			var req map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, map[string]string{"id": "78", "parentFolderId": "90"}, req)
+			w.Write([]byte(`{"id": "task-1", "status": "PENDING"}`))
+		case "/files/v3/folders/update/async/tasks/task-1/status":
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.Write([]byte(`{"status": "COMPLETE", "result": {"id": "78", "path": "/archive/img"}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	task, err := client.StartFolderUpdate(context.Background(), FolderUpdate{ID: "78", ParentFolderID: "90"})
+	require.NoError(t, err)
+	assert.Equal(t, "task-1", task.ID)
+	assert.False(t, task.Done())
+
+	task, err = client.GetFolderUpdateStatus(context.Background(), "task-1")
+	require.NoError(t, err)
+	assert.Equal(t, "task-1", task.ID)
+	assert.True(t, task.Done())
+	require.NotNil(t, task.Result)
+	assert.Equal(t, "/archive/img", task.Result.Path)
+
+	_, err = client.StartFolderUpdate(context.Background(), FolderUpdate{})
+	assert.ErrorContains(t, err, "folder ID is required")
+}
+
+func TestClient_UploadFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/v3/files", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "logo.png", r.FormValue("fileName"))
+		assert.Equal(t, "/assets/img", r.FormValue("folderPath"))
+		assert.JSONEq(t, `{"access": "PUBLIC_NOT_INDEXABLE", "overwrite": true}`, r.FormValue("options"))
+
+		files := r.MultipartForm.File["file"]
+		require.Len(t, files, 1)
+		f, err := files[0].Open()
+		require.NoError(t, err)
+		data, _ := io.ReadAll(f)
+		assert.Equal(t, "PNG", string(data))
+
+		w.Write([]byte(`{"id": "902", "name": "logo", "path": "/assets/img/logo.png", "size": 3}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	file, err := client.UploadFile(context.Background(), FileUpload{
+		Name:       "logo.png",
+		FolderPath: "assets/img/",
+		Overwrite:  true,
+		Content:    strings.NewReader("PNG"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "902", file.ID)
+	assert.Equal(t, int64(3), file.Size)
+
+	_, err = client.UploadFile(context.Background(), FileUpload{Content: strings.NewReader("")})
+	assert.ErrorContains(t, err, "file name is required")
+}
+
+func TestClient_GetFileSignedURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/v3/files/902/signed-url", r.URL.Path)
+		w.Write([]byte(`{"url": "https://cdn.example.com/logo.png?sig=abc", "expiresAt": "2026-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	url, err := client.GetFileSignedURL(context.Background(), "902")
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/logo.png?sig=abc", url)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -55,7 +56,7 @@ func (r *GraphQLResponse) ErrorMessages() string {
 }
 
 // ExecuteGraphQL executes a GraphQL query
-func (c *Client) ExecuteGraphQL(query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+func (c *Client) ExecuteGraphQL(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
 	if query == "" {
 		return nil, fmt.Errorf("query is required")
 	}
@@ -67,12 +68,7 @@ func (c *Client) ExecuteGraphQL(query string, variables map[string]interface{})
 		Variables: variables,
 	}
 
-	bodyBytes, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal GraphQL request: %w", err)
-	}
-
-	respBytes, err := c.post(url, bodyBytes)
+	respBytes, err := c.post(ctx, url, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +160,7 @@ func (t IntrospectionTypeRef) TypeName() string {
 }
 
 // IntrospectSchema fetches the GraphQL schema via introspection
-func (c *Client) IntrospectSchema() (*IntrospectionSchema, error) {
+func (c *Client) IntrospectSchema(ctx context.Context) (*IntrospectionSchema, error) {
 	query := `
 query IntrospectionQuery {
   __schema {
@@ -253,7 +249,7 @@ query IntrospectionQuery {
   }
 }`
 
-	resp, err := c.ExecuteGraphQL(query, nil)
+	resp, err := c.ExecuteGraphQL(ctx, query, nil)
 	if err != nil {
 		return nil, err
 	}
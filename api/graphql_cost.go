@@ -0,0 +1,399 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GraphQLComplexityLimit is the most complexity points HubSpot allows in a
+// single GraphQL query
+const GraphQLComplexityLimit = 30000
+
+// DefaultCollectionLimit is the number of items HubSpot returns from a
+// _collection field when no limit argument is given
+const DefaultCollectionLimit = 10
+
+// GraphQLCost is the estimated complexity of a GraphQL query.
+//
+// The estimate follows HubSpot's documented model: every object returned
+// costs one point, and a _collection field returns up to its limit of
+// objects, multiplying the cost of everything selected beneath it. Scalar
+// fields are free.
+type GraphQLCost struct {
+	Total  int                `json:"total"`
+	Fields []GraphQLFieldCost `json:"fields"`
+}
+
+// GraphQLFieldCost is the estimated cost of one object or collection field
+type GraphQLFieldCost struct {
+	// Path is the dotted field path, e.g. CRM.contact_collection
+	Path string `json:"path"`
+	// Items is the number of objects the field may return per parent
+	Items int `json:"items"`
+	// Points is the field's cost including everything nested beneath it
+	Points int `json:"points"`
+}
+
+// EstimateGraphQLCost estimates the complexity points query will consume
+// without sending it
+func EstimateGraphQLCost(query string) (*GraphQLCost, error) {
+	p := &gqlParser{tokens: tokenizeGraphQL(query), fragments: make(map[string][]gqlField)}
+	operations, err := p.document()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL query: %w", err)
+	}
+
+	cost := &GraphQLCost{}
+	for _, op := range operations {
+		total, err := p.cost(op, "", 1, cost, nil)
+		if err != nil {
+			return nil, err
+		}
+		cost.Total += total
+	}
+	return cost, nil
+}
+
+// gqlField is a parsed field selection
+type gqlField struct {
+	name     string
+	limit    int
+	children []gqlField
+	// spread names a fragment to expand in place of this field
+	spread string
+}
+
+// isCollection reports whether the field returns a paginated list
+func (f gqlField) isCollection() bool {
+	return strings.HasSuffix(f.name, "_collection")
+}
+
+// cost walks fields, appending object and collection fields to out, and
+// returns their combined points. mult is how many times the enclosing
+// selection is repeated by collections above it.
+func (p *gqlParser) cost(fields []gqlField, prefix string, mult int, out *GraphQLCost, expanding []string) (int, error) {
+	total := 0
+	for _, f := range fields {
+		if f.spread != "" {
+			for _, name := range expanding {
+				if name == f.spread {
+					return 0, fmt.Errorf("fragment %q references itself", f.spread)
+				}
+			}
+			frag, ok := p.fragments[f.spread]
+			if !ok {
+				return 0, fmt.Errorf("unknown fragment %q", f.spread)
+			}
+			points, err := p.cost(frag, prefix, mult, out, append(expanding, f.spread))
+			if err != nil {
+				return 0, err
+			}
+			total += points
+			continue
+		}
+
+		// Scalars (and inline fragment wrappers, which have no name) are free
+		if len(f.children) == 0 {
+			continue
+		}
+		if f.name == "" {
+			points, err := p.cost(f.children, prefix, mult, out, expanding)
+			if err != nil {
+				return 0, err
+			}
+			total += points
+			continue
+		}
+
+		items := 1
+		if f.isCollection() {
+			items = f.limit
+			if items <= 0 {
+				items = DefaultCollectionLimit
+			}
+		}
+
+		path := prefix + f.name
+		idx := len(out.Fields)
+		out.Fields = append(out.Fields, GraphQLFieldCost{Path: path, Items: items})
+
+		nested, err := p.cost(f.children, path+".", mult*items, out, expanding)
+		if err != nil {
+			return 0, err
+		}
+		points := mult*items + nested
+		out.Fields[idx].Points = points
+		total += points
+	}
+	return total, nil
+}
+
+// tokenizeGraphQL splits a query into names, numbers, and punctuators,
+// dropping whitespace, commas, comments, and string literals (which never
+// affect cost)
+func tokenizeGraphQL(src string) []string {
+	var tokens []string
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			if strings.HasPrefix(src[i:], `"""`) {
+				end := strings.Index(src[i+3:], `"""`)
+				if end < 0 {
+					i = len(src)
+				} else {
+					i += end + 6
+				}
+				tokens = append(tokens, `""`)
+				continue
+			}
+			i++
+			for i < len(src) && src[i] != '"' {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			tokens = append(tokens, `""`)
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case isNameStart(c) || c == '-' || (c >= '0' && c <= '9'):
+			j := i + 1
+			for j < len(src) && (isNameStart(src[j]) || (src[j] >= '0' && src[j] <= '9') || src[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, src[i:j])
+			i = j
+		default:
+			tokens = append(tokens, string(c))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// gqlParser is a minimal recursive-descent parser for the parts of a GraphQL
+// document that determine cost: selection sets, limit arguments, and
+// fragments
+type gqlParser struct {
+	tokens    []string
+	pos       int
+	fragments map[string][]gqlField
+}
+
+func (p *gqlParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *gqlParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *gqlParser) expect(tok string) error {
+	if got := p.next(); got != tok {
+		if got == "" {
+			return fmt.Errorf("expected %q, got end of query", tok)
+		}
+		return fmt.Errorf("expected %q, got %q", tok, got)
+	}
+	return nil
+}
+
+// document parses every definition, returning the operations' selections
+// and recording fragments for later expansion
+func (p *gqlParser) document() ([][]gqlField, error) {
+	var operations [][]gqlField
+	for p.peek() != "" {
+		if p.peek() == "fragment" {
+			p.next()
+			name := p.next()
+			if err := p.skipUntil("{"); err != nil {
+				return nil, err
+			}
+			fields, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			p.fragments[name] = fields
+			continue
+		}
+
+		// Operation: optional "query Name($vars) @directives" header
+		if err := p.skipUntil("{"); err != nil {
+			return nil, err
+		}
+		fields, err := p.selectionSet()
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, fields)
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no operation found")
+	}
+	return operations, nil
+}
+
+// skipUntil advances to tok without consuming it, skipping balanced
+// parentheses (variable definitions and directive arguments)
+func (p *gqlParser) skipUntil(tok string) error {
+	for p.peek() != tok {
+		switch p.peek() {
+		case "":
+			return fmt.Errorf("expected %q, got end of query", tok)
+		case "(":
+			if err := p.skipBalanced("(", ")"); err != nil {
+				return err
+			}
+		default:
+			p.next()
+		}
+	}
+	return nil
+}
+
+func (p *gqlParser) skipBalanced(open, close string) error {
+	depth := 0
+	for {
+		t := p.next()
+		switch t {
+		case "":
+			return fmt.Errorf("expected %q, got end of query", close)
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+func (p *gqlParser) selectionSet() ([]gqlField, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var fields []gqlField
+	for p.peek() != "}" {
+		if p.peek() == "" {
+			return nil, fmt.Errorf("expected \"}\", got end of query")
+		}
+
+		if p.peek() == "..." {
+			p.next()
+			if p.peek() == "on" || p.peek() == "{" || p.peek() == "@" {
+				// Inline fragment: same multiplier as the enclosing selection
+				if err := p.skipUntil("{"); err != nil {
+					return nil, err
+				}
+				children, err := p.selectionSet()
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, gqlField{children: children})
+				continue
+			}
+			fields = append(fields, gqlField{spread: p.next()})
+			p.skipDirectives()
+			continue
+		}
+
+		f, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	p.next()
+
+	return fields, nil
+}
+
+func (p *gqlParser) field() (gqlField, error) {
+	name := p.next()
+	if !isNameStart(name[0]) {
+		return gqlField{}, fmt.Errorf("unexpected %q", name)
+	}
+	if p.peek() == ":" {
+		// alias: the second name is the real field
+		p.next()
+		name = p.next()
+	}
+	f := gqlField{name: name}
+
+	if p.peek() == "(" {
+		limit, err := p.arguments()
+		if err != nil {
+			return gqlField{}, err
+		}
+		f.limit = limit
+	}
+	p.skipDirectives()
+
+	if p.peek() == "{" {
+		children, err := p.selectionSet()
+		if err != nil {
+			return gqlField{}, err
+		}
+		f.children = children
+	}
+	return f, nil
+}
+
+// arguments consumes an argument list and returns the integer limit
+// argument, if any
+func (p *gqlParser) arguments() (int, error) {
+	start := p.pos
+	if err := p.skipBalanced("(", ")"); err != nil {
+		return 0, err
+	}
+	args := p.tokens[start:p.pos]
+
+	// Only top-level arguments count: "limit" at depth 1
+	depth := 0
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "(", "{", "[":
+			depth++
+		case ")", "}", "]":
+			depth--
+		case "limit":
+			if depth == 1 && i+2 < len(args) && args[i+1] == ":" {
+				if n, err := strconv.Atoi(args[i+2]); err == nil {
+					return n, nil
+				}
+			}
+		}
+	}
+	return 0, nil
+}
+
+func (p *gqlParser) skipDirectives() {
+	for p.peek() == "@" {
+		p.next()
+		p.next()
+		if p.peek() == "(" {
+			_ = p.skipBalanced("(", ")")
+		}
+	}
+}
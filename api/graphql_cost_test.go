@@ -0,0 +1,106 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateGraphQLCost(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		total int
+	}{
+		{
+			name:  "single object",
+			query: `{ CRM { contact(uniqueIdentifier: "id", uniqueIdentifierValue: "1") { firstname email } } }`,
+			total: 2,
+		},
+		{
+			name:  "collection with limit",
+			query: `{ CRM { contact_collection(limit: 50) { items { email } } } }`,
+			total: 1 + 50 + 50,
+		},
+		{
+			name:  "collection default limit",
+			query: `query Contacts { CRM { contact_collection { items { email } } } }`,
+			total: 1 + 10 + 10,
+		},
+		{
+			name: "nested collections multiply",
+			query: `{
+				CRM {
+					contact_collection(limit: 5) {
+						items {
+							associations {
+								company_collection(limit: 3) { items { name } }
+							}
+						}
+					}
+				}
+			}`,
+			// CRM 1, contacts 5, items 5, associations 5, companies 15, items 15
+			total: 1 + 5 + 5 + 5 + 15 + 15,
+		},
+		{
+			name: "fragments and aliases",
+			query: `query ($after: String) {
+				CRM {
+					recent: contact_collection(limit: 2, filter: { lifecyclestage__eq: "lead" }, offset: $after) {
+						items { ...ContactFields }
+					}
+				}
+			}
+			fragment ContactFields on crm_contact {
+				email # comment with { braces
+				associations { deal_collection(limit: 4) { items { dealname } } }
+			}`,
+			// CRM 1, contacts 2, items 2, associations 2, deals 8, items 8
+			total: 1 + 2 + 2 + 2 + 8 + 8,
+		},
+		{
+			name:  "nested limit argument ignored",
+			query: `{ CRM { contact_collection(filter: { limit: 400 }) { items { email } } } }`,
+			total: 1 + 10 + 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, err := EstimateGraphQLCost(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.total, cost.Total)
+		})
+	}
+}
+
+func TestEstimateGraphQLCost_Fields(t *testing.T) {
+	cost, err := EstimateGraphQLCost(`{ CRM { contact_collection(limit: 20) { items { email } } } }`)
+	require.NoError(t, err)
+
+	require.Len(t, cost.Fields, 3)
+	assert.Equal(t, GraphQLFieldCost{Path: "CRM", Items: 1, Points: 41}, cost.Fields[0])
+	assert.Equal(t, GraphQLFieldCost{Path: "CRM.contact_collection", Items: 20, Points: 40}, cost.Fields[1])
+	assert.Equal(t, GraphQLFieldCost{Path: "CRM.contact_collection.items", Items: 1, Points: 20}, cost.Fields[2])
+}
+
+func TestEstimateGraphQLCost_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "empty", query: ""},
+		{name: "unbalanced", query: `{ CRM { contact_collection { items { email } }`},
+		{name: "unknown fragment", query: `{ CRM { ...Missing } }`},
+		{name: "recursive fragment", query: `{ CRM { ...A } } fragment A on X { n { ...A } }`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EstimateGraphQLCost(tt.query)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -44,7 +45,7 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 		}
 
 		query := `query { CRM { contact_collection(limit: 10) { items { firstname lastname email } total } } }`
-		result, err := client.ExecuteGraphQL(query, nil)
+		result, err := client.ExecuteGraphQL(context.Background(), query, nil)
 		require.NoError(t, err)
 		assert.False(t, result.HasErrors())
 		assert.NotNil(t, result.Data)
@@ -52,6 +53,12 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 
 	t.Run("with variables", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The request body must be a JSON object, not a JSON-encoded string
+			var req GraphQLRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Contains(t, req.Query, "contact(")
+			assert.Equal(t, "123", req.Variables["contactId"])
+
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{
 				"data": {
@@ -71,7 +78,7 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 
 		query := `query GetContact($contactId: ID!) { CRM { contact(id: $contactId) { firstname email } } }`
 		vars := map[string]interface{}{"contactId": "123"}
-		result, err := client.ExecuteGraphQL(query, vars)
+		result, err := client.ExecuteGraphQL(context.Background(), query, vars)
 		require.NoError(t, err)
 		assert.False(t, result.HasErrors())
 	})
@@ -99,7 +106,7 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 		}
 
 		query := `query { CRM { contact(id: "123") { invalid_field } } }`
-		result, err := client.ExecuteGraphQL(query, nil)
+		result, err := client.ExecuteGraphQL(context.Background(), query, nil)
 		require.NoError(t, err)
 		assert.True(t, result.HasErrors())
 		assert.Len(t, result.Errors, 1)
@@ -125,7 +132,7 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ExecuteGraphQL("query { invalid }", nil)
+		result, err := client.ExecuteGraphQL(context.Background(), "query { invalid }", nil)
 		require.NoError(t, err)
 		assert.True(t, result.HasErrors())
 		assert.Equal(t, "Error 1; Error 2", result.ErrorMessages())
@@ -133,7 +140,7 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 
 	t.Run("empty query", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		result, err := client.ExecuteGraphQL("", nil)
+		result, err := client.ExecuteGraphQL(context.Background(), "", nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "query is required")
 		assert.Nil(t, result)
@@ -152,7 +159,7 @@ func TestClient_ExecuteGraphQL(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ExecuteGraphQL("query { CRM { contact(id: \"123\") { email } } }", nil)
+		result, err := client.ExecuteGraphQL(context.Background(), "query { CRM { contact(id: \"123\") { email } } }", nil)
 		assert.Error(t, err)
 		assert.True(t, IsUnauthorized(err))
 		assert.Nil(t, result)
@@ -260,7 +267,7 @@ func TestClient_IntrospectSchema(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		schema, err := client.IntrospectSchema()
+		schema, err := client.IntrospectSchema(context.Background())
 		require.NoError(t, err)
 		assert.NotNil(t, schema.QueryType)
 		assert.Equal(t, "Query", *schema.QueryType.Name)
@@ -282,7 +289,7 @@ func TestClient_IntrospectSchema(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		schema, err := client.IntrospectSchema()
+		schema, err := client.IntrospectSchema(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Introspection not allowed")
 		assert.Nil(t, schema)
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 )
 
@@ -67,7 +69,21 @@ type HubDBRowList struct {
 }
 
 // ListHubDBTables retrieves HubDB tables with pagination
-func (c *Client) ListHubDBTables(opts ListOptions) (*HubDBTableList, error) {
+func (c *Client) ListHubDBTables(ctx context.Context, opts ListOptions) (*HubDBTableList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]HubDBTable, *Paging, error) {
+			page, err := c.ListHubDBTables(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &HubDBTableList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables", c.BaseURL)
 
 	params := make(map[string]string)
@@ -82,7 +98,7 @@ func (c *Client) ListHubDBTables(opts ListOptions) (*HubDBTableList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -96,14 +112,83 @@ func (c *Client) ListHubDBTables(opts ListOptions) (*HubDBTableList, error) {
 }
 
 // GetHubDBTable retrieves a single HubDB table by ID or name
-func (c *Client) GetHubDBTable(tableIDOrName string) (*HubDBTable, error) {
+func (c *Client) GetHubDBTable(ctx context.Context, tableIDOrName string) (*HubDBTable, error) {
 	if tableIDOrName == "" {
 		return nil, fmt.Errorf("table ID or name is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s", c.BaseURL, tableIDOrName)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result HubDBTable
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse hubdb table response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetHubDBTableDraft retrieves the draft version of a HubDB table, which
+// holds schema changes not yet published
+func (c *Client) GetHubDBTableDraft(ctx context.Context, tableIDOrName string) (*HubDBTable, error) {
+	if tableIDOrName == "" {
+		return nil, fmt.Errorf("table ID or name is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/draft", c.BaseURL, tableIDOrName)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result HubDBTable
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse hubdb table response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// hubDBTableRequest is the writable part of a HubDB table definition
+type hubDBTableRequest struct {
+	Name                  string                 `json:"name"`
+	Label                 string                 `json:"label,omitempty"`
+	Columns               []HubDBColumn          `json:"columns"`
+	AllowPublicAPIAccess  bool                   `json:"allowPublicApiAccess"`
+	AllowChildTables      bool                   `json:"allowChildTables"`
+	EnableChildTablePages bool                   `json:"enableChildTablePages"`
+	DynamicMetaTags       map[string]interface{} `json:"dynamicMetaTags,omitempty"`
+}
+
+// UpdateHubDBTableDraft replaces the draft definition of a HubDB table. The
+// columns sent become the table's columns: existing columns are kept by ID,
+// columns without an ID are added, and columns left out are removed.
+func (c *Client) UpdateHubDBTableDraft(ctx context.Context, tableIDOrName string, table *HubDBTable) (*HubDBTable, error) {
+	if tableIDOrName == "" {
+		return nil, fmt.Errorf("table ID or name is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/draft", c.BaseURL, tableIDOrName)
+
+	req := hubDBTableRequest{
+		Name:                  table.Name,
+		Label:                 table.Label,
+		Columns:               table.Columns,
+		AllowPublicAPIAccess:  table.AllowPublicAPIAccess,
+		AllowChildTables:      table.AllowChildTables,
+		EnableChildTablePages: table.EnableChildTablePages,
+		DynamicMetaTags:       table.DynamicMetaTags,
+	}
+	if req.Columns == nil {
+		req.Columns = []HubDBColumn{}
+	}
+
+	body, err := c.patch(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -117,10 +202,10 @@ func (c *Client) GetHubDBTable(tableIDOrName string) (*HubDBTable, error) {
 }
 
 // CreateHubDBTable creates a new HubDB table
-func (c *Client) CreateHubDBTable(table map[string]interface{}) (*HubDBTable, error) {
+func (c *Client) CreateHubDBTable(ctx context.Context, table map[string]interface{}) (*HubDBTable, error) {
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables", c.BaseURL)
 
-	body, err := c.post(url, table)
+	body, err := c.post(ctx, url, table)
 	if err != nil {
 		return nil, err
 	}
@@ -134,26 +219,26 @@ func (c *Client) CreateHubDBTable(table map[string]interface{}) (*HubDBTable, er
 }
 
 // DeleteHubDBTable deletes a HubDB table
-func (c *Client) DeleteHubDBTable(tableIDOrName string) error {
+func (c *Client) DeleteHubDBTable(ctx context.Context, tableIDOrName string) error {
 	if tableIDOrName == "" {
 		return fmt.Errorf("table ID or name is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s", c.BaseURL, tableIDOrName)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
 
 // PublishHubDBTable publishes a HubDB table draft
-func (c *Client) PublishHubDBTable(tableIDOrName string) (*HubDBTable, error) {
+func (c *Client) PublishHubDBTable(ctx context.Context, tableIDOrName string) (*HubDBTable, error) {
 	if tableIDOrName == "" {
 		return nil, fmt.Errorf("table ID or name is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/draft/publish", c.BaseURL, tableIDOrName)
 
-	body, err := c.post(url, nil)
+	body, err := c.post(ctx, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,27 +251,135 @@ func (c *Client) PublishHubDBTable(tableIDOrName string) (*HubDBTable, error) {
 	return &result, nil
 }
 
+// ResetHubDBTableDraft discards a HubDB table's draft changes, resetting the
+// draft to the published table
+func (c *Client) ResetHubDBTableDraft(ctx context.Context, tableIDOrName string) (*HubDBTable, error) {
+	if tableIDOrName == "" {
+		return nil, fmt.Errorf("table ID or name is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/draft/reset", c.BaseURL, tableIDOrName)
+
+	body, err := c.post(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result HubDBTable
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse hubdb table response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// HubDBCloneRequest describes the copy CloneHubDBTable makes
+type HubDBCloneRequest struct {
+	NewName  string `json:"newName"`
+	NewLabel string `json:"newLabel,omitempty"`
+	// CopyRows copies the rows as well as the columns and settings
+	CopyRows bool `json:"copyRows"`
+}
+
+// CloneHubDBTable copies a HubDB table draft to a new, unpublished table
+func (c *Client) CloneHubDBTable(ctx context.Context, tableIDOrName string, req HubDBCloneRequest) (*HubDBTable, error) {
+	if tableIDOrName == "" {
+		return nil, fmt.Errorf("table ID or name is required")
+	}
+	if req.NewName == "" {
+		return nil, fmt.Errorf("new table name is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/draft/clone", c.BaseURL, tableIDOrName)
+
+	body, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result HubDBTable
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse hubdb table response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// HubDBRowFilter is one condition on a HubDB rows query, sent as the
+// column__operator=value query parameter
+type HubDBRowFilter struct {
+	Column string
+	// Operator is a HubDB filter operator such as eq, ne, gt, contains, or
+	// is_null
+	Operator string
+	Value    string
+}
+
+// HubDBRowQuery filters and sorts the rows a HubDB rows request returns
+type HubDBRowQuery struct {
+	Filters []HubDBRowFilter
+	// Sort lists the columns to order by; a leading "-" sorts descending
+	Sort []string
+	// Draft queries the table draft, including rows not yet published
+	Draft bool
+}
+
 // ListHubDBRows retrieves rows from a HubDB table
-func (c *Client) ListHubDBRows(tableIDOrName string, opts ListOptions) (*HubDBRowList, error) {
+func (c *Client) ListHubDBRows(ctx context.Context, tableIDOrName string, opts ListOptions) (*HubDBRowList, error) {
+	return c.QueryHubDBRows(ctx, tableIDOrName, HubDBRowQuery{}, opts)
+}
+
+// ListHubDBDraftRows retrieves rows from a HubDB table draft, including rows
+// not yet published
+func (c *Client) ListHubDBDraftRows(ctx context.Context, tableIDOrName string, opts ListOptions) (*HubDBRowList, error) {
+	return c.QueryHubDBRows(ctx, tableIDOrName, HubDBRowQuery{Draft: true}, opts)
+}
+
+// QueryHubDBRows retrieves the rows of a HubDB table that match query, in
+// the order it asks for
+func (c *Client) QueryHubDBRows(ctx context.Context, tableIDOrName string, query HubDBRowQuery, opts ListOptions) (*HubDBRowList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]HubDBRow, *Paging, error) {
+			page, err := c.QueryHubDBRows(ctx, tableIDOrName, query, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &HubDBRowList{Results: results, Paging: paging}, nil
+	}
+
 	if tableIDOrName == "" {
 		return nil, fmt.Errorf("table ID or name is required")
 	}
 
-	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows", c.BaseURL, tableIDOrName)
+	endpoint := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows", c.BaseURL, tableIDOrName)
+	if query.Draft {
+		endpoint += "/draft"
+	}
 
-	params := make(map[string]string)
+	// Filters and sorts can repeat a parameter, which buildURL cannot express
+	params := url.Values{}
 	if opts.Limit > 0 {
-		params["limit"] = strconv.Itoa(opts.Limit)
+		params.Set("limit", strconv.Itoa(opts.Limit))
 	}
 	if opts.After != "" {
-		params["after"] = opts.After
+		params.Set("after", opts.After)
+	}
+	for _, f := range query.Filters {
+		params.Add(f.Column+"__"+f.Operator, f.Value)
+	}
+	for _, s := range query.Sort {
+		params.Add("sort", s)
 	}
-
 	if len(params) > 0 {
-		url = buildURL(url, params)
+		endpoint += "?" + params.Encode()
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +393,7 @@ func (c *Client) ListHubDBRows(tableIDOrName string, opts ListOptions) (*HubDBRo
 }
 
 // GetHubDBRow retrieves a single row from a HubDB table
-func (c *Client) GetHubDBRow(tableIDOrName, rowID string) (*HubDBRow, error) {
+func (c *Client) GetHubDBRow(ctx context.Context, tableIDOrName, rowID string) (*HubDBRow, error) {
 	if tableIDOrName == "" {
 		return nil, fmt.Errorf("table ID or name is required")
 	}
@@ -210,7 +403,7 @@ func (c *Client) GetHubDBRow(tableIDOrName, rowID string) (*HubDBRow, error) {
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows/%s", c.BaseURL, tableIDOrName, rowID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -224,14 +417,14 @@ func (c *Client) GetHubDBRow(tableIDOrName, rowID string) (*HubDBRow, error) {
 }
 
 // CreateHubDBRow creates a new row in a HubDB table draft
-func (c *Client) CreateHubDBRow(tableIDOrName string, row map[string]interface{}) (*HubDBRow, error) {
+func (c *Client) CreateHubDBRow(ctx context.Context, tableIDOrName string, row map[string]interface{}) (*HubDBRow, error) {
 	if tableIDOrName == "" {
 		return nil, fmt.Errorf("table ID or name is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows/draft", c.BaseURL, tableIDOrName)
 
-	body, err := c.post(url, row)
+	body, err := c.post(ctx, url, row)
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +438,7 @@ func (c *Client) CreateHubDBRow(tableIDOrName string, row map[string]interface{}
 }
 
 // UpdateHubDBRow updates a row in a HubDB table draft
-func (c *Client) UpdateHubDBRow(tableIDOrName, rowID string, updates map[string]interface{}) (*HubDBRow, error) {
+func (c *Client) UpdateHubDBRow(ctx context.Context, tableIDOrName, rowID string, updates map[string]interface{}) (*HubDBRow, error) {
 	if tableIDOrName == "" {
 		return nil, fmt.Errorf("table ID or name is required")
 	}
@@ -255,7 +448,7 @@ func (c *Client) UpdateHubDBRow(tableIDOrName, rowID string, updates map[string]
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows/draft/%s", c.BaseURL, tableIDOrName, rowID)
 
-	body, err := c.patch(url, updates)
+	body, err := c.patch(ctx, url, updates)
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +462,7 @@ func (c *Client) UpdateHubDBRow(tableIDOrName, rowID string, updates map[string]
 }
 
 // DeleteHubDBRow deletes a row from a HubDB table draft
-func (c *Client) DeleteHubDBRow(tableIDOrName, rowID string) error {
+func (c *Client) DeleteHubDBRow(ctx context.Context, tableIDOrName, rowID string) error {
 	if tableIDOrName == "" {
 		return fmt.Errorf("table ID or name is required")
 	}
@@ -279,6 +472,67 @@ func (c *Client) DeleteHubDBRow(tableIDOrName, rowID string) error {
 
 	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows/draft/%s", c.BaseURL, tableIDOrName, rowID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
+
+// HubDBRowInput is one row of a HubDB batch create or update request
+type HubDBRowInput struct {
+	// ID identifies the row to update; batch creates leave it empty
+	ID     string                 `json:"id,omitempty"`
+	Path   string                 `json:"path,omitempty"`
+	Name   string                 `json:"name,omitempty"`
+	Values map[string]interface{} `json:"values"`
+}
+
+// HubDBRowBatchResult represents the response from a HubDB batch create or
+// update request. As with CRM batches, Results holds the rows that
+// succeeded and Errors describes the rest.
+type HubDBRowBatchResult struct {
+	Status  string       `json:"status"`
+	Results []HubDBRow   `json:"results"`
+	Errors  []BatchError `json:"errors,omitempty"`
+}
+
+// BatchCreateHubDBRows creates up to MaxBatchSize rows in a HubDB table draft
+// in one request
+func (c *Client) BatchCreateHubDBRows(ctx context.Context, tableIDOrName string, inputs []HubDBRowInput) (*HubDBRowBatchResult, error) {
+	return c.batchHubDBRows(ctx, tableIDOrName, "create", inputs)
+}
+
+// BatchUpdateHubDBRows updates up to MaxBatchSize rows in a HubDB table draft
+// in one request. Every input must carry a row ID.
+func (c *Client) BatchUpdateHubDBRows(ctx context.Context, tableIDOrName string, inputs []HubDBRowInput) (*HubDBRowBatchResult, error) {
+	for i, in := range inputs {
+		if in.ID == "" {
+			return nil, fmt.Errorf("batch input %d is missing a row ID", i)
+		}
+	}
+	return c.batchHubDBRows(ctx, tableIDOrName, "update", inputs)
+}
+
+func (c *Client) batchHubDBRows(ctx context.Context, tableIDOrName, action string, inputs []HubDBRowInput) (*HubDBRowBatchResult, error) {
+	if tableIDOrName == "" {
+		return nil, fmt.Errorf("table ID or name is required")
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("at least one batch input is required")
+	}
+	if len(inputs) > MaxBatchSize {
+		return nil, fmt.Errorf("batch size %d exceeds the maximum of %d", len(inputs), MaxBatchSize)
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/hubdb/tables/%s/rows/draft/batch/%s", c.BaseURL, tableIDOrName, action)
+
+	body, err := c.post(ctx, url, map[string]interface{}{"inputs": inputs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result HubDBRowBatchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	return &result, nil
+}
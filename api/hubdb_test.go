@@ -1,6 +1,9 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -44,7 +47,7 @@ func TestClient_ListHubDBTables(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListHubDBTables(ListOptions{Limit: 10})
+		result, err := client.ListHubDBTables(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "123", result.Results[0].ID)
@@ -66,7 +69,7 @@ func TestClient_ListHubDBTables(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListHubDBTables(ListOptions{})
+		result, err := client.ListHubDBTables(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -103,7 +106,7 @@ func TestClient_GetHubDBTable(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		table, err := client.GetHubDBTable("products")
+		table, err := client.GetHubDBTable(context.Background(), "products")
 		require.NoError(t, err)
 		assert.Equal(t, "123", table.ID)
 		assert.Equal(t, "products", table.Name)
@@ -123,7 +126,7 @@ func TestClient_GetHubDBTable(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		table, err := client.GetHubDBTable("nonexistent")
+		table, err := client.GetHubDBTable(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, table)
@@ -131,7 +134,7 @@ func TestClient_GetHubDBTable(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		table, err := client.GetHubDBTable("")
+		table, err := client.GetHubDBTable(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 		assert.Nil(t, table)
@@ -164,7 +167,7 @@ func TestClient_CreateHubDBTable(t *testing.T) {
 			"name":  "new_table",
 			"label": "New Table",
 		}
-		table, err := client.CreateHubDBTable(data)
+		table, err := client.CreateHubDBTable(context.Background(), data)
 		require.NoError(t, err)
 		assert.Equal(t, "456", table.ID)
 		assert.Equal(t, "new_table", table.Name)
@@ -187,13 +190,13 @@ func TestClient_DeleteHubDBTable(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteHubDBTable("products")
+		err := client.DeleteHubDBTable(context.Background(), "products")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteHubDBTable("")
+		err := client.DeleteHubDBTable(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 	})
@@ -221,7 +224,7 @@ func TestClient_PublishHubDBTable(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		table, err := client.PublishHubDBTable("products")
+		table, err := client.PublishHubDBTable(context.Background(), "products")
 		require.NoError(t, err)
 		assert.Equal(t, "123", table.ID)
 		assert.True(t, table.Published)
@@ -229,7 +232,39 @@ func TestClient_PublishHubDBTable(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		table, err := client.PublishHubDBTable("")
+		table, err := client.PublishHubDBTable(context.Background(), "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "table ID or name is required")
+		assert.Nil(t, table)
+	})
+}
+
+func TestClient_ResetHubDBTableDraft(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/cms/v3/hubdb/tables/products/draft/reset", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "123", "name": "products", "rowCount": 4}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		table, err := client.ResetHubDBTableDraft(context.Background(), "products")
+		require.NoError(t, err)
+		assert.Equal(t, "123", table.ID)
+		assert.Equal(t, 4, table.RowCount)
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		table, err := client.ResetHubDBTableDraft(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 		assert.Nil(t, table)
@@ -265,7 +300,7 @@ func TestClient_ListHubDBRows(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListHubDBRows("products", ListOptions{})
+		result, err := client.ListHubDBRows(context.Background(), "products", ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "row-1", result.Results[0].ID)
@@ -274,7 +309,7 @@ func TestClient_ListHubDBRows(t *testing.T) {
 
 	t.Run("empty table ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		result, err := client.ListHubDBRows("", ListOptions{})
+		result, err := client.ListHubDBRows(context.Background(), "", ListOptions{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 		assert.Nil(t, result)
@@ -306,7 +341,7 @@ func TestClient_GetHubDBRow(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		row, err := client.GetHubDBRow("products", "row-1")
+		row, err := client.GetHubDBRow(context.Background(), "products", "row-1")
 		require.NoError(t, err)
 		assert.Equal(t, "row-1", row.ID)
 		assert.Equal(t, "Widget", row.Name)
@@ -314,7 +349,7 @@ func TestClient_GetHubDBRow(t *testing.T) {
 
 	t.Run("empty table ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		row, err := client.GetHubDBRow("", "row-1")
+		row, err := client.GetHubDBRow(context.Background(), "", "row-1")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 		assert.Nil(t, row)
@@ -322,7 +357,7 @@ func TestClient_GetHubDBRow(t *testing.T) {
 
 	t.Run("empty row ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		row, err := client.GetHubDBRow("products", "")
+		row, err := client.GetHubDBRow(context.Background(), "products", "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "row ID is required")
 		assert.Nil(t, row)
@@ -354,14 +389,14 @@ func TestClient_CreateHubDBRow(t *testing.T) {
 			"path": "/products/gadget",
 			"name": "Gadget",
 		}
-		row, err := client.CreateHubDBRow("products", data)
+		row, err := client.CreateHubDBRow(context.Background(), "products", data)
 		require.NoError(t, err)
 		assert.Equal(t, "row-2", row.ID)
 	})
 
 	t.Run("empty table ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		row, err := client.CreateHubDBRow("", map[string]interface{}{})
+		row, err := client.CreateHubDBRow(context.Background(), "", map[string]interface{}{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 		assert.Nil(t, row)
@@ -392,7 +427,7 @@ func TestClient_UpdateHubDBRow(t *testing.T) {
 		updates := map[string]interface{}{
 			"name": "Updated Widget",
 		}
-		row, err := client.UpdateHubDBRow("products", "row-1", updates)
+		row, err := client.UpdateHubDBRow(context.Background(), "products", "row-1", updates)
 		require.NoError(t, err)
 		assert.Equal(t, "row-1", row.ID)
 		assert.Equal(t, "Updated Widget", row.Name)
@@ -400,7 +435,7 @@ func TestClient_UpdateHubDBRow(t *testing.T) {
 
 	t.Run("empty table ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		row, err := client.UpdateHubDBRow("", "row-1", map[string]interface{}{})
+		row, err := client.UpdateHubDBRow(context.Background(), "", "row-1", map[string]interface{}{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 		assert.Nil(t, row)
@@ -408,7 +443,7 @@ func TestClient_UpdateHubDBRow(t *testing.T) {
 
 	t.Run("empty row ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		row, err := client.UpdateHubDBRow("products", "", map[string]interface{}{})
+		row, err := client.UpdateHubDBRow(context.Background(), "products", "", map[string]interface{}{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "row ID is required")
 		assert.Nil(t, row)
@@ -431,21 +466,194 @@ func TestClient_DeleteHubDBRow(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteHubDBRow("products", "row-1")
+		err := client.DeleteHubDBRow(context.Background(), "products", "row-1")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty table ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteHubDBRow("", "row-1")
+		err := client.DeleteHubDBRow(context.Background(), "", "row-1")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "table ID or name is required")
 	})
 
 	t.Run("empty row ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteHubDBRow("products", "")
+		err := client.DeleteHubDBRow(context.Background(), "products", "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "row ID is required")
 	})
 }
+
+func TestClient_GetHubDBTableDraft(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/draft", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Write([]byte(`{"id": "123", "name": "products", "columns": [{"id": "1", "name": "price", "type": "NUMBER"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	table, err := client.GetHubDBTableDraft(context.Background(), "products")
+	require.NoError(t, err)
+	require.Len(t, table.Columns, 1)
+	assert.Equal(t, "price", table.Columns[0].Name)
+}
+
+func TestClient_UpdateHubDBTableDraft(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/draft", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "products", body["name"])
+		assert.Equal(t, true, body["allowPublicApiAccess"])
+		assert.NotContains(t, body, "id")
+		assert.NotContains(t, body, "rowCount")
+		columns := body["columns"].([]interface{})
+		require.Len(t, columns, 2)
+		assert.Equal(t, "1", columns[0].(map[string]interface{})["id"])
+		assert.NotContains(t, columns[1], "id")
+
+		w.Write([]byte(`{"id": "123", "name": "products"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	_, err := client.UpdateHubDBTableDraft(context.Background(), "products", &HubDBTable{
+		ID:                   "123",
+		Name:                 "products",
+		RowCount:             4,
+		AllowPublicAPIAccess: true,
+		Columns: []HubDBColumn{
+			{ID: "1", Name: "price", Type: "NUMBER"},
+			{Name: "size", Type: "SELECT", Options: []HubDBOption{{Name: "small"}}},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestClient_ListHubDBDraftRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/rows/draft", r.URL.Path)
+
+		w.Write([]byte(`{"results": [{"id": "row-1", "values": {"price": 10}}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListHubDBDraftRows(context.Background(), "products", ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, float64(10), result.Results[0].Values["price"])
+}
+
+func TestClient_QueryHubDBRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/rows/draft", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "Widget", q.Get("name__eq"))
+		assert.Equal(t, "10", q.Get("price__gte"))
+		assert.True(t, q.Has("image__is_null"))
+		assert.Equal(t, []string{"-price", "name"}, q["sort"])
+		assert.Equal(t, "25", q.Get("limit"))
+
+		w.Write([]byte(`{"results": [{"id": "row-1", "name": "Widget"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.QueryHubDBRows(context.Background(), "products", HubDBRowQuery{
+		Filters: []HubDBRowFilter{
+			{Column: "name", Operator: "eq", Value: "Widget"},
+			{Column: "price", Operator: "gte", Value: "10"},
+			{Column: "image", Operator: "is_null"},
+		},
+		Sort:  []string{"-price", "name"},
+		Draft: true,
+	}, ListOptions{Limit: 25})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "Widget", result.Results[0].Name)
+}
+
+func TestClient_BatchCreateHubDBRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/rows/draft/batch/create", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"inputs": [{"path": "widget", "values": {"sku": "WID-100", "price": 9.5}}]}`, string(body))
+
+		w.Write([]byte(`{"status": "COMPLETE", "results": [{"id": "r1", "path": "widget", "values": {"sku": "WID-100"}}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.BatchCreateHubDBRows(context.Background(), "products", []HubDBRowInput{
+		{Path: "widget", Values: map[string]interface{}{"sku": "WID-100", "price": 9.5}},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "r1", result.Results[0].ID)
+
+	_, err = client.BatchCreateHubDBRows(context.Background(), "products", nil)
+	assert.ErrorContains(t, err, "at least one batch input")
+}
+
+func TestClient_BatchUpdateHubDBRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/rows/draft/batch/update", r.URL.Path)
+
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{
+			"status": "COMPLETE",
+			"results": [{"id": "r1"}],
+			"errors": [{"status": "error", "message": "Row r2 not found"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.BatchUpdateHubDBRows(context.Background(), "products", []HubDBRowInput{
+		{ID: "r1", Values: map[string]interface{}{"sku": "A"}},
+		{ID: "r2", Values: map[string]interface{}{"sku": "B"}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Results, 1)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "Row r2 not found", result.Errors[0].Message)
+
+	_, err = client.BatchUpdateHubDBRows(context.Background(), "products", []HubDBRowInput{{Values: map[string]interface{}{}}})
+	assert.ErrorContains(t, err, "missing a row ID")
+}
+
+func TestClient_CloneHubDBTable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cms/v3/hubdb/tables/products/draft/clone", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"newName": "products_staging", "newLabel": "Products (staging)", "copyRows": false}`, string(body))
+
+		w.Write([]byte(`{"id": "2002", "name": "products_staging", "label": "Products (staging)", "published": false}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	table, err := client.CloneHubDBTable(context.Background(), "products", HubDBCloneRequest{NewName: "products_staging", NewLabel: "Products (staging)"})
+	require.NoError(t, err)
+	assert.Equal(t, "2002", table.ID)
+
+	_, err = client.CloneHubDBTable(context.Background(), "products", HubDBCloneRequest{})
+	assert.ErrorContains(t, err, "new table name is required")
+}
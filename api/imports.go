@@ -0,0 +1,181 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strconv"
+)
+
+// Import states
+const (
+	ImportStateStarted    = "STARTED"
+	ImportStateProcessing = "PROCESSING"
+	ImportStateDone       = "DONE"
+	ImportStateFailed     = "FAILED"
+	ImportStateCanceled   = "CANCELED"
+	ImportStateDeferred   = "DEFERRED"
+)
+
+// Import is a CRM import job
+type Import struct {
+	ID                  string          `json:"id"`
+	Name                string          `json:"importName,omitempty"`
+	State               string          `json:"state"`
+	Source              string          `json:"importSource,omitempty"`
+	OptOutImport        bool            `json:"optOutImport"`
+	MappedObjectTypeIDs []string        `json:"mappedObjectTypeIds,omitempty"`
+	Metadata            ImportMetadata  `json:"metadata"`
+	Request             json.RawMessage `json:"importRequestJson,omitempty"`
+	CreatedAt           string          `json:"createdAt,omitempty"`
+	UpdatedAt           string          `json:"updatedAt,omitempty"`
+}
+
+// ImportMetadata holds an import's progress counters and the lists it created
+type ImportMetadata struct {
+	Counters    map[string]int     `json:"counters,omitempty"`
+	FileIDs     []string           `json:"fileIds,omitempty"`
+	ObjectLists []ImportObjectList `json:"objectLists,omitempty"`
+}
+
+// ImportObjectList is the list of records an import created or updated for
+// one object type
+type ImportObjectList struct {
+	ListID     string `json:"listId"`
+	ObjectType string `json:"objectType"`
+}
+
+// ImportList is a page of imports
+type ImportList struct {
+	Results []Import `json:"results"`
+	Paging  *Paging  `json:"paging,omitempty"`
+}
+
+// ImportFile is a file uploaded with an import. Name must match a fileName
+// in the import request.
+type ImportFile struct {
+	Name    string
+	Content io.Reader
+}
+
+// CreateImport starts an import. request is the import request JSON that
+// names the files and maps their columns to properties.
+func (c *Client) CreateImport(ctx context.Context, request json.RawMessage, files []ImportFile) (*Import, error) {
+	if !json.Valid(request) {
+		return nil, fmt.Errorf("import request must be valid JSON")
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("at least one file is required")
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	if err := mw.WriteField("importRequest", string(request)); err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	for _, f := range files {
+		part, err := mw.CreateFormFile("files", f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
+		if _, err := io.Copy(part, f.Content); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", f.Name, err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/imports", c.BaseURL)
+
+	body, err := c.postMultipart(ctx, url, buf.Bytes(), mw.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	var result Import
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse import response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListImports retrieves recent imports, newest first
+func (c *Client) ListImports(ctx context.Context, opts ListOptions) (*ImportList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Import, *Paging, error) {
+			page, err := c.ListImports(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ImportList{Results: results, Paging: paging}, nil
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/imports", c.BaseURL)
+
+	params := make(map[string]string)
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.After != "" {
+		params["after"] = opts.After
+	}
+
+	if len(params) > 0 {
+		url = buildURL(url, params)
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ImportList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse imports response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetImport retrieves an import and its progress
+func (c *Client) GetImport(ctx context.Context, importID string) (*Import, error) {
+	if importID == "" {
+		return nil, fmt.Errorf("import ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/imports/%s", c.BaseURL, importID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Import
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse import response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CancelImport stops an import that is still running
+func (c *Client) CancelImport(ctx context.Context, importID string) error {
+	if importID == "" {
+		return fmt.Errorf("import ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/imports/%s/cancel", c.BaseURL, importID)
+
+	_, err := c.post(ctx, url, nil)
+	return err
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/imports", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.JSONEq(t, `{"name": "Q3 leads", "files": [{"fileName": "leads.csv"}]}`, r.FormValue("importRequest"))
+
+		files := r.MultipartForm.File["files"]
+		require.Len(t, files, 1)
+		assert.Equal(t, "leads.csv", files[0].Filename)
+		f, err := files[0].Open()
+		require.NoError(t, err)
+		data, _ := io.ReadAll(f)
+		assert.Equal(t, "email\na@example.com\n", string(data))
+
+		w.Write([]byte(`{"id": "881", "state": "STARTED", "importName": "Q3 leads", "importSource": "API"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	imp, err := client.CreateImport(context.Background(), []byte(`{"name": "Q3 leads", "files": [{"fileName": "leads.csv"}]}`), []ImportFile{
+		{Name: "leads.csv", Content: strings.NewReader("email\na@example.com\n")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "881", imp.ID)
+	assert.Equal(t, ImportStateStarted, imp.State)
+	assert.Equal(t, "Q3 leads", imp.Name)
+
+	_, err = client.CreateImport(context.Background(), []byte(`{`), []ImportFile{{Name: "leads.csv", Content: strings.NewReader("")}})
+	assert.ErrorContains(t, err, "valid JSON")
+
+	_, err = client.CreateImport(context.Background(), []byte(`{}`), nil)
+	assert.ErrorContains(t, err, "at least one file")
+}
+
+func TestClient_CreateImport_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status": "error", "message": "Unable to find file leads.csv in the import request"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	_, err := client.CreateImport(context.Background(), []byte(`{}`), []ImportFile{{Name: "leads.csv", Content: strings.NewReader("")}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Unable to find file")
+}
+
+func TestClient_ListImports(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/imports", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		if r.URL.Query().Get("after") == "" {
+			assert.Equal(t, "1", r.URL.Query().Get("limit"))
+			w.Write([]byte(`{"results": [{"id": "2", "state": "DONE", "metadata": {"counters": {"TOTAL_ROWS": 10, "CREATED_OBJECTS": 9}}}], "paging": {"next": {"after": "abc"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "1", "state": "FAILED"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	page, err := client.ListImports(context.Background(), ListOptions{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, 10, page.Results[0].Metadata.Counters["TOTAL_ROWS"])
+	assert.Equal(t, "abc", page.Paging.Next.After)
+
+	all, err := client.ListImports(context.Background(), ListOptions{Limit: 1, All: true})
+	require.NoError(t, err)
+	require.Len(t, all.Results, 2)
+	assert.Equal(t, ImportStateFailed, all.Results[1].State)
+}
+
+func TestClient_GetImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/imports/881", r.URL.Path)
+		w.Write([]byte(`{
+			"id": "881",
+			"state": "PROCESSING",
+			"metadata": {
+				"counters": {"TOTAL_ROWS": 500, "CREATED_OBJECTS": 120},
+				"fileIds": ["f1"],
+				"objectLists": [{"listId": "77", "objectType": "CONTACT"}]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	imp, err := client.GetImport(context.Background(), "881")
+	require.NoError(t, err)
+	assert.Equal(t, ImportStateProcessing, imp.State)
+	assert.Equal(t, 120, imp.Metadata.Counters["CREATED_OBJECTS"])
+	require.Len(t, imp.Metadata.ObjectLists, 1)
+	assert.Equal(t, "77", imp.Metadata.ObjectLists[0].ListID)
+
+	_, err = client.GetImport(context.Background(), "")
+	assert.ErrorContains(t, err, "import ID is required")
+}
+
+func TestClient_CancelImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/imports/881/cancel", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		w.Write([]byte(`{"status": "COMPLETE"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.CancelImport(context.Background(), "881"))
+	assert.ErrorContains(t, client.CancelImport(context.Background(), ""), "import ID is required")
+}
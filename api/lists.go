@@ -0,0 +1,279 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// List processing types
+const (
+	// ListProcessingManual is a static list whose members are added and
+	// removed explicitly
+	ListProcessingManual = "MANUAL"
+	// ListProcessingDynamic is an active list whose members follow its filters
+	ListProcessingDynamic = "DYNAMIC"
+	// ListProcessingSnapshot is evaluated once from its filters, then static
+	ListProcessingSnapshot = "SNAPSHOT"
+)
+
+// List represents a HubSpot CRM list (v3 lists API)
+type List struct {
+	ListID               string                 `json:"listId"`
+	Name                 string                 `json:"name"`
+	ObjectTypeID         string                 `json:"objectTypeId,omitempty"`
+	ProcessingType       string                 `json:"processingType,omitempty"`
+	ProcessingStatus     string                 `json:"processingStatus,omitempty"`
+	ListVersion          int                    `json:"listVersion,omitempty"`
+	Size                 int                    `json:"size,omitempty"`
+	CreatedAt            string                 `json:"createdAt,omitempty"`
+	UpdatedAt            string                 `json:"updatedAt,omitempty"`
+	FiltersUpdatedAt     string                 `json:"filtersUpdatedAt,omitempty"`
+	FilterBranch         map[string]interface{} `json:"filterBranch,omitempty"`
+	AdditionalProperties map[string]string      `json:"additionalProperties,omitempty"`
+}
+
+// MemberCount returns the number of records in the list. Search results
+// report it as the hs_list_size additional property rather than size.
+func (l *List) MemberCount() int {
+	if l.Size > 0 {
+		return l.Size
+	}
+	n, _ := strconv.Atoi(l.AdditionalProperties["hs_list_size"])
+	return n
+}
+
+// ListList represents a page of lists. Results are offset-paginated by
+// HubSpot; Paging.Next.After carries the next offset.
+type ListList struct {
+	Results []List  `json:"results"`
+	Paging  *Paging `json:"paging,omitempty"`
+	Total   int     `json:"total,omitempty"`
+}
+
+// listSearchResponse is the raw response from the list search endpoint
+type listSearchResponse struct {
+	Lists   []List `json:"lists"`
+	HasMore bool   `json:"hasMore"`
+	Offset  int    `json:"offset"`
+	Total   int    `json:"total"`
+}
+
+// listResponse wraps a single list in get and create responses
+type listResponse struct {
+	List List `json:"list"`
+}
+
+// CreateListRequest is the body for creating a list
+type CreateListRequest struct {
+	Name           string                 `json:"name"`
+	ObjectTypeID   string                 `json:"objectTypeId"`
+	ProcessingType string                 `json:"processingType"`
+	FilterBranch   map[string]interface{} `json:"filterBranch,omitempty"`
+}
+
+// ListMembership is a record belonging to a list
+type ListMembership struct {
+	RecordID            string `json:"recordId"`
+	MembershipTimestamp string `json:"membershipTimestamp,omitempty"`
+}
+
+// ListMembershipList represents a paginated list of list memberships
+type ListMembershipList struct {
+	Results []ListMembership `json:"results"`
+	Paging  *Paging          `json:"paging,omitempty"`
+}
+
+// ListMembershipChange is the result of adding or removing list members
+type ListMembershipChange struct {
+	RecordIDsAdded   []string `json:"recordIdsAdded,omitempty"`
+	RecordIDsRemoved []string `json:"recordIdsRemoved,omitempty"`
+	RecordIDsMissing []string `json:"recordIdsMissing,omitempty"`
+}
+
+// ListLists searches lists by name. An empty query returns every list.
+func (c *Client) ListLists(ctx context.Context, query string, opts ListOptions) (*ListList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]List, *Paging, error) {
+			page, err := c.ListLists(ctx, query, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ListList{Results: results, Paging: paging}, nil
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/lists/search", c.BaseURL)
+
+	payload := map[string]interface{}{
+		"query":                query,
+		"additionalProperties": []string{"hs_list_size"},
+	}
+	if opts.Limit > 0 {
+		payload["count"] = opts.Limit
+	}
+	if opts.After != "" {
+		offset, err := strconv.Atoi(opts.After)
+		if err != nil {
+			return nil, fmt.Errorf("invalid list offset %q: must be a number", opts.After)
+		}
+		payload["offset"] = offset
+	}
+
+	body, err := c.post(ctx, url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listSearchResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse lists response: %w", err)
+	}
+
+	result := &ListList{Results: resp.Lists, Total: resp.Total}
+	if resp.HasMore {
+		result.Paging = &Paging{Next: &PagingNext{After: strconv.Itoa(resp.Offset)}}
+	}
+
+	return result, nil
+}
+
+// GetList retrieves a single list by ID
+func (c *Client) GetList(ctx context.Context, listID string) (*List, error) {
+	if listID == "" {
+		return nil, fmt.Errorf("list ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/lists/%s", c.BaseURL, listID)
+	url = buildURL(url, map[string]string{"includeFilters": "true"})
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse list response: %w", err)
+	}
+
+	return &resp.List, nil
+}
+
+// CreateList creates a new list
+func (c *Client) CreateList(ctx context.Context, req CreateListRequest) (*List, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("list name is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/lists", c.BaseURL)
+
+	body, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse list response: %w", err)
+	}
+
+	return &resp.List, nil
+}
+
+// DeleteList deletes a list. HubSpot keeps deleted lists restorable for 90 days.
+func (c *Client) DeleteList(ctx context.Context, listID string) error {
+	if listID == "" {
+		return fmt.Errorf("list ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/lists/%s", c.BaseURL, listID)
+
+	_, err := c.delete(ctx, url)
+	return err
+}
+
+// ListListMemberships retrieves the records in a list
+func (c *Client) ListListMemberships(ctx context.Context, listID string, opts ListOptions) (*ListMembershipList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]ListMembership, *Paging, error) {
+			page, err := c.ListListMemberships(ctx, listID, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ListMembershipList{Results: results, Paging: paging}, nil
+	}
+
+	if listID == "" {
+		return nil, fmt.Errorf("list ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/lists/%s/memberships", c.BaseURL, listID)
+
+	params := make(map[string]string)
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.After != "" {
+		params["after"] = opts.After
+	}
+
+	if len(params) > 0 {
+		url = buildURL(url, params)
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListMembershipList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse list memberships response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// AddListMembers adds records to a static (MANUAL or SNAPSHOT) list
+func (c *Client) AddListMembers(ctx context.Context, listID string, recordIDs []string) (*ListMembershipChange, error) {
+	return c.changeListMembers(ctx, listID, "add", recordIDs)
+}
+
+// RemoveListMembers removes records from a static (MANUAL or SNAPSHOT) list
+func (c *Client) RemoveListMembers(ctx context.Context, listID string, recordIDs []string) (*ListMembershipChange, error) {
+	return c.changeListMembers(ctx, listID, "remove", recordIDs)
+}
+
+func (c *Client) changeListMembers(ctx context.Context, listID, action string, recordIDs []string) (*ListMembershipChange, error) {
+	if listID == "" {
+		return nil, fmt.Errorf("list ID is required")
+	}
+	if len(recordIDs) == 0 {
+		return nil, fmt.Errorf("at least one record ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/lists/%s/memberships/%s", c.BaseURL, listID, action)
+
+	body, err := c.put(ctx, url, recordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListMembershipChange
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse list memberships response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListLists(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/lists/search", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "news", body["query"])
+			assert.Equal(t, float64(20), body["count"])
+			assert.Equal(t, float64(40), body["offset"])
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"lists": [
+					{
+						"listId": "42",
+						"name": "Newsletter",
+						"objectTypeId": "0-1",
+						"processingType": "MANUAL",
+						"additionalProperties": {"hs_list_size": "17"}
+					}
+				],
+				"hasMore": true,
+				"offset": 60,
+				"total": 100
+			}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		result, err := client.ListLists(context.Background(), "news", ListOptions{Limit: 20, After: "40"})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "42", result.Results[0].ListID)
+		assert.Equal(t, 17, result.Results[0].MemberCount())
+		assert.Equal(t, 100, result.Total)
+		assert.Equal(t, "60", result.Paging.Next.After)
+	})
+
+	t.Run("last page has no paging", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"lists": [], "hasMore": false, "offset": 0, "total": 0}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		result, err := client.ListLists(context.Background(), "", ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, result.Results)
+		assert.Nil(t, result.Paging)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+
+		_, err := client.ListLists(context.Background(), "", ListOptions{After: "abc"})
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_GetList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/42", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("includeFilters"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"list": {"listId": "42", "name": "Newsletter", "processingType": "DYNAMIC", "size": 5}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	list, err := client.GetList(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Equal(t, "Newsletter", list.Name)
+	assert.Equal(t, 5, list.MemberCount())
+
+	_, err = client.GetList(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestClient_CreateList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var req CreateListRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "Attendees", req.Name)
+		assert.Equal(t, "0-1", req.ObjectTypeID)
+		assert.Equal(t, ListProcessingManual, req.ProcessingType)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"list": {"listId": "99", "name": "Attendees"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	list, err := client.CreateList(context.Background(), CreateListRequest{Name: "Attendees", ObjectTypeID: "0-1", ProcessingType: ListProcessingManual})
+	require.NoError(t, err)
+	assert.Equal(t, "99", list.ListID)
+}
+
+func TestClient_DeleteList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/42", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.DeleteList(context.Background(), "42"))
+}
+
+func TestClient_ListListMemberships(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/42/memberships", r.URL.Path)
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"results": [{"recordId": "101", "membershipTimestamp": "2024-05-01T00:00:00Z"}],
+			"paging": {"next": {"after": "xyz"}}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListListMemberships(context.Background(), "42", ListOptions{Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "101", result.Results[0].RecordID)
+	assert.Equal(t, "xyz", result.Paging.Next.After)
+}
+
+func TestClient_AddListMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/42/memberships/add", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `["101","102"]`, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"recordIdsAdded": ["101"], "recordIdsMissing": ["102"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.AddListMembers(context.Background(), "42", []string{"101", "102"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"101"}, result.RecordIDsAdded)
+	assert.Equal(t, []string{"102"}, result.RecordIDsMissing)
+
+	_, err = client.AddListMembers(context.Background(), "42", nil)
+	assert.Error(t, err)
+}
+
+func TestClient_RemoveListMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/42/memberships/remove", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"recordIdsRemoved": ["101"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.RemoveListMembers(context.Background(), "42", []string{"101"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"101"}, result.RecordIDsRemoved)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -67,7 +68,21 @@ type CampaignList struct {
 }
 
 // ListForms retrieves all forms with pagination
-func (c *Client) ListForms(opts ListOptions) (*FormList, error) {
+func (c *Client) ListForms(ctx context.Context, opts ListOptions) (*FormList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Form, *Paging, error) {
+			page, err := c.ListForms(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &FormList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/marketing/v3/forms", c.BaseURL)
 
 	params := make(map[string]string)
@@ -82,7 +97,7 @@ func (c *Client) ListForms(opts ListOptions) (*FormList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -96,14 +111,14 @@ func (c *Client) ListForms(opts ListOptions) (*FormList, error) {
 }
 
 // GetForm retrieves a single form by ID
-func (c *Client) GetForm(formID string) (*Form, error) {
+func (c *Client) GetForm(ctx context.Context, formID string) (*Form, error) {
 	if formID == "" {
 		return nil, fmt.Errorf("form ID is required")
 	}
 
 	url := fmt.Sprintf("%s/marketing/v3/forms/%s", c.BaseURL, formID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +132,21 @@ func (c *Client) GetForm(formID string) (*Form, error) {
 }
 
 // GetFormSubmissions retrieves submissions for a form
-func (c *Client) GetFormSubmissions(formID string, opts ListOptions) (*FormSubmissionList, error) {
+func (c *Client) GetFormSubmissions(ctx context.Context, formID string, opts ListOptions) (*FormSubmissionList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]FormSubmission, *Paging, error) {
+			page, err := c.GetFormSubmissions(ctx, formID, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &FormSubmissionList{Results: results, Paging: paging}, nil
+	}
+
 	if formID == "" {
 		return nil, fmt.Errorf("form ID is required")
 	}
@@ -136,7 +165,7 @@ func (c *Client) GetFormSubmissions(formID string, opts ListOptions) (*FormSubmi
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -149,8 +178,84 @@ func (c *Client) GetFormSubmissions(formID string, opts ListOptions) (*FormSubmi
 	return &result, nil
 }
 
+// FormSubmitField is one field value in a form submission
+type FormSubmitField struct {
+	// ObjectTypeID is the CRM object the field belongs to, e.g. "0-1" for
+	// contacts. HubSpot assumes contacts when it is empty.
+	ObjectTypeID string `json:"objectTypeId,omitempty"`
+	Name         string `json:"name"`
+	Value        string `json:"value"`
+}
+
+// FormSubmitContext describes the page a submission was made from
+type FormSubmitContext struct {
+	PageURI  string `json:"pageUri,omitempty"`
+	PageName string `json:"pageName,omitempty"`
+}
+
+// FormSubmitRequest is the body of a form submission
+type FormSubmitRequest struct {
+	Fields  []FormSubmitField  `json:"fields"`
+	Context *FormSubmitContext `json:"context,omitempty"`
+}
+
+// FormSubmitResponse is HubSpot's reply to an accepted submission
+type FormSubmitResponse struct {
+	InlineMessage string `json:"inlineMessage,omitempty"`
+	RedirectURI   string `json:"redirectUri,omitempty"`
+}
+
+// SubmitForm posts a submission to a form through the authenticated Forms
+// submission API. It is processed like a real visitor submission, so
+// workflows and notifications attached to the form fire.
+func (c *Client) SubmitForm(ctx context.Context, portalID, formID string, req FormSubmitRequest) (*FormSubmitResponse, error) {
+	if portalID == "" {
+		return nil, fmt.Errorf("portal ID is required")
+	}
+	if formID == "" {
+		return nil, fmt.Errorf("form ID is required")
+	}
+	if len(req.Fields) == 0 {
+		return nil, fmt.Errorf("at least one field is required")
+	}
+
+	formsURL := c.FormsURL
+	if formsURL == "" {
+		formsURL = DefaultFormsURL
+	}
+	url := fmt.Sprintf("%s/submissions/v3/integration/secure/submit/%s/%s", formsURL, portalID, formID)
+
+	body, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result FormSubmitResponse
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse form submission response: %w", err)
+		}
+	}
+
+	return &result, nil
+}
+
 // ListCampaigns retrieves all campaigns with pagination
-func (c *Client) ListCampaigns(opts ListOptions) (*CampaignList, error) {
+func (c *Client) ListCampaigns(ctx context.Context, opts ListOptions) (*CampaignList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Campaign, *Paging, error) {
+			page, err := c.ListCampaigns(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &CampaignList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/marketing/v3/campaigns", c.BaseURL)
 
 	params := make(map[string]string)
@@ -165,7 +270,7 @@ func (c *Client) ListCampaigns(opts ListOptions) (*CampaignList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -179,14 +284,14 @@ func (c *Client) ListCampaigns(opts ListOptions) (*CampaignList, error) {
 }
 
 // GetCampaign retrieves a single campaign by ID
-func (c *Client) GetCampaign(campaignID string) (*Campaign, error) {
+func (c *Client) GetCampaign(ctx context.Context, campaignID string) (*Campaign, error) {
 	if campaignID == "" {
 		return nil, fmt.Errorf("campaign ID is required")
 	}
 
 	url := fmt.Sprintf("%s/marketing/v3/campaigns/%s", c.BaseURL, campaignID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +329,21 @@ type MarketingEmailList struct {
 }
 
 // ListMarketingEmails retrieves marketing emails with pagination
-func (c *Client) ListMarketingEmails(opts ListOptions) (*MarketingEmailList, error) {
+func (c *Client) ListMarketingEmails(ctx context.Context, opts ListOptions) (*MarketingEmailList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]MarketingEmail, *Paging, error) {
+			page, err := c.ListMarketingEmails(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &MarketingEmailList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/marketing/v3/emails", c.BaseURL)
 
 	params := make(map[string]string)
@@ -239,7 +358,7 @@ func (c *Client) ListMarketingEmails(opts ListOptions) (*MarketingEmailList, err
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -253,14 +372,14 @@ func (c *Client) ListMarketingEmails(opts ListOptions) (*MarketingEmailList, err
 }
 
 // GetMarketingEmail retrieves a single marketing email by ID
-func (c *Client) GetMarketingEmail(emailID string) (*MarketingEmail, error) {
+func (c *Client) GetMarketingEmail(ctx context.Context, emailID string) (*MarketingEmail, error) {
 	if emailID == "" {
 		return nil, fmt.Errorf("email ID is required")
 	}
 
 	url := fmt.Sprintf("%s/marketing/v3/emails/%s", c.BaseURL, emailID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -274,10 +393,10 @@ func (c *Client) GetMarketingEmail(emailID string) (*MarketingEmail, error) {
 }
 
 // CreateMarketingEmail creates a new marketing email
-func (c *Client) CreateMarketingEmail(email map[string]interface{}) (*MarketingEmail, error) {
+func (c *Client) CreateMarketingEmail(ctx context.Context, email map[string]interface{}) (*MarketingEmail, error) {
 	url := fmt.Sprintf("%s/marketing/v3/emails", c.BaseURL)
 
-	body, err := c.post(url, email)
+	body, err := c.post(ctx, url, email)
 	if err != nil {
 		return nil, err
 	}
@@ -291,14 +410,14 @@ func (c *Client) CreateMarketingEmail(email map[string]interface{}) (*MarketingE
 }
 
 // UpdateMarketingEmail updates an existing marketing email
-func (c *Client) UpdateMarketingEmail(emailID string, updates map[string]interface{}) (*MarketingEmail, error) {
+func (c *Client) UpdateMarketingEmail(ctx context.Context, emailID string, updates map[string]interface{}) (*MarketingEmail, error) {
 	if emailID == "" {
 		return nil, fmt.Errorf("email ID is required")
 	}
 
 	url := fmt.Sprintf("%s/marketing/v3/emails/%s", c.BaseURL, emailID)
 
-	body, err := c.patch(url, updates)
+	body, err := c.patch(ctx, url, updates)
 	if err != nil {
 		return nil, err
 	}
@@ -312,13 +431,29 @@ func (c *Client) UpdateMarketingEmail(emailID string, updates map[string]interfa
 }
 
 // DeleteMarketingEmail archives a marketing email
-func (c *Client) DeleteMarketingEmail(emailID string) error {
+func (c *Client) DeleteMarketingEmail(ctx context.Context, emailID string) error {
 	if emailID == "" {
 		return fmt.Errorf("email ID is required")
 	}
 
 	url := fmt.Sprintf("%s/marketing/v3/emails/%s", c.BaseURL, emailID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
+
+// PreviewMarketingEmail returns the rendered HTML of a marketing email. When
+// contactEmail is set, personalization tokens are resolved for that contact;
+// otherwise HubSpot's default token values are used.
+func (c *Client) PreviewMarketingEmail(ctx context.Context, emailID, contactEmail string) ([]byte, error) {
+	if emailID == "" {
+		return nil, fmt.Errorf("email ID is required")
+	}
+
+	url := fmt.Sprintf("%s/marketing/v3/emails/%s/preview", c.BaseURL, emailID)
+	if contactEmail != "" {
+		url = buildURL(url, map[string]string{"contactEmail": contactEmail})
+	}
+
+	return c.get(ctx, url)
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -43,7 +45,7 @@ func TestClient_ListForms(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListForms(ListOptions{Limit: 10})
+		result, err := client.ListForms(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "form-123", result.Results[0].ID)
@@ -64,7 +66,7 @@ func TestClient_ListForms(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListForms(ListOptions{})
+		result, err := client.ListForms(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -108,7 +110,7 @@ func TestClient_GetForm(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		form, err := client.GetForm("form-123")
+		form, err := client.GetForm(context.Background(), "form-123")
 		require.NoError(t, err)
 		assert.Equal(t, "form-123", form.ID)
 		assert.Equal(t, "Contact Us", form.Name)
@@ -130,7 +132,7 @@ func TestClient_GetForm(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		form, err := client.GetForm("nonexistent")
+		form, err := client.GetForm(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, form)
@@ -138,7 +140,7 @@ func TestClient_GetForm(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		form, err := client.GetForm("")
+		form, err := client.GetForm(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "form ID is required")
 		assert.Nil(t, form)
@@ -173,7 +175,7 @@ func TestClient_GetFormSubmissions(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.GetFormSubmissions("form-123", ListOptions{})
+		result, err := client.GetFormSubmissions(context.Background(), "form-123", ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "sub-456", result.Results[0].ID)
@@ -182,13 +184,61 @@ func TestClient_GetFormSubmissions(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		result, err := client.GetFormSubmissions("", ListOptions{})
+		result, err := client.GetFormSubmissions(context.Background(), "", ListOptions{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "form ID is required")
 		assert.Nil(t, result)
 	})
 }
 
+func TestClient_SubmitForm(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/submissions/v3/integration/secure/submit/123456/form-123", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			fields := body["fields"].([]interface{})
+			require.Len(t, fields, 1)
+			assert.Equal(t, "email", fields[0].(map[string]interface{})["name"])
+			assert.Equal(t, "test@example.com", fields[0].(map[string]interface{})["value"])
+			assert.Equal(t, "hspt test", body["context"].(map[string]interface{})["pageName"])
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"inlineMessage": "Thanks for submitting the form."}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			FormsURL:    server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		result, err := client.SubmitForm(context.Background(), "123456", "form-123", FormSubmitRequest{
+			Fields:  []FormSubmitField{{Name: "email", Value: "test@example.com"}},
+			Context: &FormSubmitContext{PageName: "hspt test"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Thanks for submitting the form.", result.InlineMessage)
+	})
+
+	t.Run("missing arguments", func(t *testing.T) {
+		client := &Client{}
+		fields := FormSubmitRequest{Fields: []FormSubmitField{{Name: "email", Value: "x"}}}
+
+		_, err := client.SubmitForm(context.Background(), "", "form-123", fields)
+		assert.ErrorContains(t, err, "portal ID is required")
+
+		_, err = client.SubmitForm(context.Background(), "123456", "", fields)
+		assert.ErrorContains(t, err, "form ID is required")
+
+		_, err = client.SubmitForm(context.Background(), "123456", "form-123", FormSubmitRequest{})
+		assert.ErrorContains(t, err, "at least one field is required")
+	})
+}
+
 func TestClient_ListCampaigns(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -215,7 +265,7 @@ func TestClient_ListCampaigns(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListCampaigns(ListOptions{})
+		result, err := client.ListCampaigns(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "campaign-123", result.Results[0].ID)
@@ -245,7 +295,7 @@ func TestClient_GetCampaign(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		campaign, err := client.GetCampaign("campaign-123")
+		campaign, err := client.GetCampaign(context.Background(), "campaign-123")
 		require.NoError(t, err)
 		assert.Equal(t, "campaign-123", campaign.ID)
 		assert.Equal(t, "Q1 Launch", campaign.Name)
@@ -264,7 +314,7 @@ func TestClient_GetCampaign(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		campaign, err := client.GetCampaign("nonexistent")
+		campaign, err := client.GetCampaign(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, campaign)
@@ -272,7 +322,7 @@ func TestClient_GetCampaign(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		campaign, err := client.GetCampaign("")
+		campaign, err := client.GetCampaign(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "campaign ID is required")
 		assert.Nil(t, campaign)
@@ -315,7 +365,7 @@ func TestClient_ListMarketingEmails(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListMarketingEmails(ListOptions{Limit: 10})
+		result, err := client.ListMarketingEmails(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "email-123", result.Results[0].ID)
@@ -337,7 +387,7 @@ func TestClient_ListMarketingEmails(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListMarketingEmails(ListOptions{})
+		result, err := client.ListMarketingEmails(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -371,7 +421,7 @@ func TestClient_GetMarketingEmail(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		email, err := client.GetMarketingEmail("email-123")
+		email, err := client.GetMarketingEmail(context.Background(), "email-123")
 		require.NoError(t, err)
 		assert.Equal(t, "email-123", email.ID)
 		assert.Equal(t, "Welcome Email", email.Name)
@@ -392,7 +442,7 @@ func TestClient_GetMarketingEmail(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		email, err := client.GetMarketingEmail("nonexistent")
+		email, err := client.GetMarketingEmail(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, email)
@@ -400,7 +450,7 @@ func TestClient_GetMarketingEmail(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		email, err := client.GetMarketingEmail("")
+		email, err := client.GetMarketingEmail(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "email ID is required")
 		assert.Nil(t, email)
@@ -436,7 +486,7 @@ func TestClient_CreateMarketingEmail(t *testing.T) {
 			"name":    "New Campaign Email",
 			"subject": "Check out our new products",
 		}
-		email, err := client.CreateMarketingEmail(data)
+		email, err := client.CreateMarketingEmail(context.Background(), data)
 		require.NoError(t, err)
 		assert.Equal(t, "email-456", email.ID)
 		assert.Equal(t, "New Campaign Email", email.Name)
@@ -472,7 +522,7 @@ func TestClient_UpdateMarketingEmail(t *testing.T) {
 			"name":    "Updated Email Name",
 			"subject": "Updated Subject",
 		}
-		email, err := client.UpdateMarketingEmail("email-123", updates)
+		email, err := client.UpdateMarketingEmail(context.Background(), "email-123", updates)
 		require.NoError(t, err)
 		assert.Equal(t, "email-123", email.ID)
 		assert.Equal(t, "Updated Email Name", email.Name)
@@ -491,7 +541,7 @@ func TestClient_UpdateMarketingEmail(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		email, err := client.UpdateMarketingEmail("nonexistent", map[string]interface{}{"name": "test"})
+		email, err := client.UpdateMarketingEmail(context.Background(), "nonexistent", map[string]interface{}{"name": "test"})
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, email)
@@ -499,7 +549,7 @@ func TestClient_UpdateMarketingEmail(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		email, err := client.UpdateMarketingEmail("", map[string]interface{}{"name": "test"})
+		email, err := client.UpdateMarketingEmail(context.Background(), "", map[string]interface{}{"name": "test"})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "email ID is required")
 		assert.Nil(t, email)
@@ -522,7 +572,7 @@ func TestClient_DeleteMarketingEmail(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteMarketingEmail("email-123")
+		err := client.DeleteMarketingEmail(context.Background(), "email-123")
 		require.NoError(t, err)
 	})
 
@@ -539,15 +589,66 @@ func TestClient_DeleteMarketingEmail(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteMarketingEmail("nonexistent")
+		err := client.DeleteMarketingEmail(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteMarketingEmail("")
+		err := client.DeleteMarketingEmail(context.Background(), "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "email ID is required")
+	})
+}
+
+func TestClient_PreviewMarketingEmail(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/marketing/v3/emails/email-123/preview", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "a@b.com", r.URL.Query().Get("contactEmail"))
+
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<html><body>Hi Ada</body></html>`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		html, err := client.PreviewMarketingEmail(context.Background(), "email-123", "a@b.com")
+		require.NoError(t, err)
+		assert.Equal(t, "<html><body>Hi Ada</body></html>", string(html))
+	})
+
+	t.Run("without contact", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.URL.RawQuery)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<html></html>`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		_, err := client.PreviewMarketingEmail(context.Background(), "email-123", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		html, err := client.PreviewMarketingEmail(context.Background(), "", "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "email ID is required")
+		assert.Nil(t, html)
 	})
 }
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // OwnersResponse represents the response from the owners list endpoint
@@ -12,10 +14,10 @@ type OwnersResponse struct {
 }
 
 // GetOwners retrieves all owners (users) from HubSpot
-func (c *Client) GetOwners() ([]Owner, error) {
+func (c *Client) GetOwners(ctx context.Context) ([]Owner, error) {
 	url := fmt.Sprintf("%s/crm/v3/owners", c.BaseURL)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -29,14 +31,14 @@ func (c *Client) GetOwners() ([]Owner, error) {
 }
 
 // GetOwner retrieves a single owner by ID
-func (c *Client) GetOwner(ownerID string) (*Owner, error) {
+func (c *Client) GetOwner(ctx context.Context, ownerID string) (*Owner, error) {
 	if ownerID == "" {
 		return nil, fmt.Errorf("owner ID is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/owners/%s", c.BaseURL, ownerID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -48,3 +50,45 @@ func (c *Client) GetOwner(ownerID string) (*Owner, error) {
 
 	return &owner, nil
 }
+
+// GetOwnerByEmail retrieves the owner with the given email address. It
+// returns an error matching ErrNotFound when no owner has the address.
+func (c *Client) GetOwnerByEmail(ctx context.Context, email string) (*Owner, error) {
+	return c.findOwnerByEmail(ctx, email, false)
+}
+
+// GetArchivedOwnerByEmail retrieves the deactivated owner with the given
+// email address. Owners of users removed from the portal are archived but
+// still own records.
+func (c *Client) GetArchivedOwnerByEmail(ctx context.Context, email string) (*Owner, error) {
+	return c.findOwnerByEmail(ctx, email, true)
+}
+
+func (c *Client) findOwnerByEmail(ctx context.Context, email string, archived bool) (*Owner, error) {
+	if email == "" {
+		return nil, fmt.Errorf("owner email is required")
+	}
+
+	params := map[string]string{"email": email}
+	if archived {
+		params["archived"] = "true"
+	}
+	url := buildURL(fmt.Sprintf("%s/crm/v3/owners", c.BaseURL), params)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp OwnersResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse owners response: %w", err)
+	}
+
+	for i := range resp.Results {
+		if strings.EqualFold(resp.Results[i].Email, email) {
+			return &resp.Results[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no owner has email %s: %w", email, ErrNotFound)
+}
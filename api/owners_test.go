@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -45,7 +46,7 @@ func TestClient_GetOwners(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		owners, err := client.GetOwners()
+		owners, err := client.GetOwners(context.Background())
 		require.NoError(t, err)
 		assert.Len(t, owners, 2)
 
@@ -71,7 +72,7 @@ func TestClient_GetOwners(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		owners, err := client.GetOwners()
+		owners, err := client.GetOwners(context.Background())
 		require.NoError(t, err)
 		assert.Empty(t, owners)
 	})
@@ -89,7 +90,7 @@ func TestClient_GetOwners(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		owners, err := client.GetOwners()
+		owners, err := client.GetOwners(context.Background())
 		assert.Error(t, err)
 		assert.True(t, IsUnauthorized(err))
 		assert.Nil(t, owners)
@@ -127,7 +128,7 @@ func TestClient_GetOwner(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		owner, err := client.GetOwner("12345")
+		owner, err := client.GetOwner(context.Background(), "12345")
 		require.NoError(t, err)
 		assert.Equal(t, "12345", owner.ID)
 		assert.Equal(t, "john@example.com", owner.Email)
@@ -151,7 +152,7 @@ func TestClient_GetOwner(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		owner, err := client.GetOwner("99999")
+		owner, err := client.GetOwner(context.Background(), "99999")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, owner)
@@ -163,13 +164,61 @@ func TestClient_GetOwner(t *testing.T) {
 			AccessToken: "test-token",
 		}
 
-		owner, err := client.GetOwner("")
+		owner, err := client.GetOwner(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "owner ID is required")
 		assert.Nil(t, owner)
 	})
 }
 
+func TestClient_GetOwnerByEmail(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/owners", r.URL.Path)
+			assert.Equal(t, "jane@example.com", r.URL.Query().Get("email"))
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"results": [{"id": "555", "email": "Jane@Example.com", "firstName": "Jane"}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		owner, err := client.GetOwnerByEmail(context.Background(), "jane@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "555", owner.ID)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"results": []}`))
+		}))
+		defer server.Close()
+
+		client := &Client{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+			HTTPClient:  server.Client(),
+		}
+
+		owner, err := client.GetOwnerByEmail(context.Background(), "nobody@example.com")
+		assert.Error(t, err)
+		assert.True(t, IsNotFound(err))
+		assert.Nil(t, owner)
+	})
+
+	t.Run("empty email", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.GetOwnerByEmail(context.Background(), "")
+		assert.Error(t, err)
+	})
+}
+
 func TestOwner_FullName(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -216,3 +265,21 @@ func TestOwner_FullName(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetArchivedOwnerByEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/owners", r.URL.Path)
+		assert.Equal(t, "old@example.com", r.URL.Query().Get("email"))
+		assert.Equal(t, "true", r.URL.Query().Get("archived"))
+
+		w.Write([]byte(`{"results": [{"id": "777", "email": "old@example.com", "archived": true}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	owner, err := client.GetArchivedOwnerByEmail(context.Background(), "old@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "777", owner.ID)
+	assert.True(t, owner.Archived)
+}
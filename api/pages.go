@@ -1,9 +1,11 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // PageType represents the type of CMS page
@@ -32,6 +34,28 @@ type Page struct {
 	ArchivedAt      string                 `json:"archivedAt,omitempty"`
 	CurrentState    string                 `json:"currentState,omitempty"`
 	LayoutSections  map[string]interface{} `json:"layoutSections,omitempty"`
+
+	// DynamicPageDataSourceType and DynamicPageDataSourceID name the data
+	// source of a dynamic page; type 1 is a HubDB table. Older pages name
+	// the table in DynamicPageHubDBTableID instead.
+	DynamicPageDataSourceType int    `json:"dynamicPageDataSourceType,omitempty"`
+	DynamicPageDataSourceID   string `json:"dynamicPageDataSourceId,omitempty"`
+	DynamicPageHubDBTableID   string `json:"dynamicPageHubDbTableId,omitempty"`
+}
+
+// DynamicPageSourceHubDB is the dynamic page data source type of HubDB tables
+const DynamicPageSourceHubDB = 1
+
+// UsesHubDBTable reports whether the page is a dynamic page built from the
+// HubDB table with the given ID
+func (p *Page) UsesHubDBTable(tableID string) bool {
+	if tableID == "" {
+		return false
+	}
+	if p.DynamicPageHubDBTableID == tableID {
+		return true
+	}
+	return p.DynamicPageDataSourceType == DynamicPageSourceHubDB && p.DynamicPageDataSourceID == tableID
 }
 
 // PageList represents a paginated list of pages
@@ -42,7 +66,21 @@ type PageList struct {
 }
 
 // ListPages retrieves pages with pagination
-func (c *Client) ListPages(pageType PageType, opts ListOptions) (*PageList, error) {
+func (c *Client) ListPages(ctx context.Context, pageType PageType, opts ListOptions) (*PageList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Page, *Paging, error) {
+			page, err := c.ListPages(ctx, pageType, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &PageList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/cms/v3/pages/%s", c.BaseURL, pageType)
 
 	params := make(map[string]string)
@@ -57,7 +95,7 @@ func (c *Client) ListPages(pageType PageType, opts ListOptions) (*PageList, erro
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -71,14 +109,14 @@ func (c *Client) ListPages(pageType PageType, opts ListOptions) (*PageList, erro
 }
 
 // GetPage retrieves a single page by ID
-func (c *Client) GetPage(pageType PageType, pageID string) (*Page, error) {
+func (c *Client) GetPage(ctx context.Context, pageType PageType, pageID string) (*Page, error) {
 	if pageID == "" {
 		return nil, fmt.Errorf("page ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/pages/%s/%s", c.BaseURL, pageType, pageID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -92,10 +130,10 @@ func (c *Client) GetPage(pageType PageType, pageID string) (*Page, error) {
 }
 
 // CreatePage creates a new page
-func (c *Client) CreatePage(pageType PageType, page map[string]interface{}) (*Page, error) {
+func (c *Client) CreatePage(ctx context.Context, pageType PageType, page map[string]interface{}) (*Page, error) {
 	url := fmt.Sprintf("%s/cms/v3/pages/%s", c.BaseURL, pageType)
 
-	body, err := c.post(url, page)
+	body, err := c.post(ctx, url, page)
 	if err != nil {
 		return nil, err
 	}
@@ -109,14 +147,14 @@ func (c *Client) CreatePage(pageType PageType, page map[string]interface{}) (*Pa
 }
 
 // UpdatePage updates an existing page
-func (c *Client) UpdatePage(pageType PageType, pageID string, updates map[string]interface{}) (*Page, error) {
+func (c *Client) UpdatePage(ctx context.Context, pageType PageType, pageID string, updates map[string]interface{}) (*Page, error) {
 	if pageID == "" {
 		return nil, fmt.Errorf("page ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/pages/%s/%s", c.BaseURL, pageType, pageID)
 
-	body, err := c.patch(url, updates)
+	body, err := c.patch(ctx, url, updates)
 	if err != nil {
 		return nil, err
 	}
@@ -130,26 +168,26 @@ func (c *Client) UpdatePage(pageType PageType, pageID string, updates map[string
 }
 
 // DeletePage archives a page
-func (c *Client) DeletePage(pageType PageType, pageID string) error {
+func (c *Client) DeletePage(ctx context.Context, pageType PageType, pageID string) error {
 	if pageID == "" {
 		return fmt.Errorf("page ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/pages/%s/%s", c.BaseURL, pageType, pageID)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
 
 // ClonePage creates a copy of an existing page
-func (c *Client) ClonePage(pageType PageType, pageID string) (*Page, error) {
+func (c *Client) ClonePage(ctx context.Context, pageType PageType, pageID string) (*Page, error) {
 	if pageID == "" {
 		return nil, fmt.Errorf("page ID is required")
 	}
 
 	url := fmt.Sprintf("%s/cms/v3/pages/%s/%s/clone", c.BaseURL, pageType, pageID)
 
-	body, err := c.post(url, nil)
+	body, err := c.post(ctx, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -161,3 +199,43 @@ func (c *Client) ClonePage(pageType PageType, pageID string) (*Page, error) {
 
 	return &result, nil
 }
+
+// PushPageDraftLive publishes a page's draft, replacing the live page
+func (c *Client) PushPageDraftLive(ctx context.Context, pageType PageType, pageID string) error {
+	if pageID == "" {
+		return fmt.Errorf("page ID is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/pages/%s/%s/draft/push-live", c.BaseURL, pageType, pageID)
+
+	_, err := c.post(ctx, url, nil)
+	return err
+}
+
+// ResetPageDraft discards a page's draft changes, resetting the draft to
+// the live page
+func (c *Client) ResetPageDraft(ctx context.Context, pageType PageType, pageID string) error {
+	if pageID == "" {
+		return fmt.Errorf("page ID is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/pages/%s/%s/draft/reset", c.BaseURL, pageType, pageID)
+
+	_, err := c.post(ctx, url, nil)
+	return err
+}
+
+// SchedulePage schedules a page to be published at the given time
+func (c *Client) SchedulePage(ctx context.Context, pageType PageType, pageID string, at time.Time) error {
+	if pageID == "" {
+		return fmt.Errorf("page ID is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/pages/%s/schedule", c.BaseURL, pageType)
+
+	_, err := c.post(ctx, url, map[string]string{
+		"id":          pageID,
+		"publishDate": at.UTC().Format(time.RFC3339),
+	})
+	return err
+}
@@ -1,9 +1,12 @@
 package api
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,7 +46,7 @@ func TestClient_ListPages(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListPages(PageTypeSite, ListOptions{Limit: 10})
+		result, err := client.ListPages(context.Background(), PageTypeSite, ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "page-123", result.Results[0].ID)
@@ -65,7 +68,7 @@ func TestClient_ListPages(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListPages(PageTypeLanding, ListOptions{})
+		result, err := client.ListPages(context.Background(), PageTypeLanding, ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -97,7 +100,7 @@ func TestClient_GetPage(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		page, err := client.GetPage(PageTypeSite, "page-123")
+		page, err := client.GetPage(context.Background(), PageTypeSite, "page-123")
 		require.NoError(t, err)
 		assert.Equal(t, "page-123", page.ID)
 		assert.Equal(t, "About Us", page.Name)
@@ -117,7 +120,7 @@ func TestClient_GetPage(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		page, err := client.GetPage(PageTypeSite, "nonexistent")
+		page, err := client.GetPage(context.Background(), PageTypeSite, "nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, page)
@@ -125,7 +128,7 @@ func TestClient_GetPage(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		page, err := client.GetPage(PageTypeSite, "")
+		page, err := client.GetPage(context.Background(), PageTypeSite, "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "page ID is required")
 		assert.Nil(t, page)
@@ -160,7 +163,7 @@ func TestClient_CreatePage(t *testing.T) {
 			"name": "New Page",
 			"slug": "new-page",
 		}
-		page, err := client.CreatePage(PageTypeSite, data)
+		page, err := client.CreatePage(context.Background(), PageTypeSite, data)
 		require.NoError(t, err)
 		assert.Equal(t, "page-456", page.ID)
 		assert.Equal(t, "New Page", page.Name)
@@ -194,7 +197,7 @@ func TestClient_UpdatePage(t *testing.T) {
 		updates := map[string]interface{}{
 			"name": "Updated Page",
 		}
-		page, err := client.UpdatePage(PageTypeSite, "page-123", updates)
+		page, err := client.UpdatePage(context.Background(), PageTypeSite, "page-123", updates)
 		require.NoError(t, err)
 		assert.Equal(t, "page-123", page.ID)
 		assert.Equal(t, "Updated Page", page.Name)
@@ -202,7 +205,7 @@ func TestClient_UpdatePage(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		page, err := client.UpdatePage(PageTypeSite, "", map[string]interface{}{"name": "test"})
+		page, err := client.UpdatePage(context.Background(), PageTypeSite, "", map[string]interface{}{"name": "test"})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "page ID is required")
 		assert.Nil(t, page)
@@ -225,13 +228,13 @@ func TestClient_DeletePage(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeletePage(PageTypeSite, "page-123")
+		err := client.DeletePage(context.Background(), PageTypeSite, "page-123")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeletePage(PageTypeSite, "")
+		err := client.DeletePage(context.Background(), PageTypeSite, "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "page ID is required")
 	})
@@ -261,7 +264,7 @@ func TestClient_ClonePage(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		page, err := client.ClonePage(PageTypeSite, "page-123")
+		page, err := client.ClonePage(context.Background(), PageTypeSite, "page-123")
 		require.NoError(t, err)
 		assert.Equal(t, "page-789", page.ID)
 		assert.Equal(t, "About Us (Copy)", page.Name)
@@ -269,9 +272,72 @@ func TestClient_ClonePage(t *testing.T) {
 
 	t.Run("empty ID", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		page, err := client.ClonePage(PageTypeSite, "")
+		page, err := client.ClonePage(context.Background(), PageTypeSite, "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "page ID is required")
 		assert.Nil(t, page)
 	})
 }
+
+func TestPage_UsesHubDBTable(t *testing.T) {
+	dynamic := Page{DynamicPageDataSourceType: DynamicPageSourceHubDB, DynamicPageDataSourceID: "123"}
+	legacy := Page{DynamicPageHubDBTableID: "123"}
+	crm := Page{DynamicPageDataSourceType: 3, DynamicPageDataSourceID: "123"}
+
+	assert.True(t, dynamic.UsesHubDBTable("123"))
+	assert.True(t, legacy.UsesHubDBTable("123"))
+	assert.False(t, dynamic.UsesHubDBTable("456"))
+	assert.False(t, crm.UsesHubDBTable("123"))
+	assert.False(t, (&Page{}).UsesHubDBTable(""))
+}
+
+func TestClient_PageDraftActions(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		requests = append(requests, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		BaseURL:     server.URL,
+		AccessToken: "test-token",
+		HTTPClient:  server.Client(),
+	}
+
+	require.NoError(t, client.PushPageDraftLive(context.Background(), PageTypeSite, "page-123"))
+	require.NoError(t, client.ResetPageDraft(context.Background(), PageTypeLanding, "page-456"))
+	assert.Equal(t, []string{
+		"/cms/v3/pages/site-pages/page-123/draft/push-live",
+		"/cms/v3/pages/landing-pages/page-456/draft/reset",
+	}, requests)
+
+	assert.Error(t, client.PushPageDraftLive(context.Background(), PageTypeSite, ""))
+	assert.Error(t, client.ResetPageDraft(context.Background(), PageTypeSite, ""))
+}
+
+func TestClient_SchedulePage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cms/v3/pages/landing-pages/schedule", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"id": "page-123", "publishDate": "2025-03-01T14:00:00Z"}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		BaseURL:     server.URL,
+		AccessToken: "test-token",
+		HTTPClient:  server.Client(),
+	}
+
+	at := time.Date(2025, 3, 1, 15, 0, 0, 0, time.FixedZone("CET", 3600))
+	require.NoError(t, client.SchedulePage(context.Background(), PageTypeLanding, "page-123", at))
+
+	err := client.SchedulePage(context.Background(), PageTypeLanding, "", at)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "page ID is required")
+}
@@ -0,0 +1,49 @@
+package api
+
+// DefaultPageSize is the page size used when following cursors with
+// ListOptions.All and no explicit Limit. It is the maximum most HubSpot v3
+// list endpoints accept.
+const DefaultPageSize = 100
+
+// listAll follows paging.next.after cursors, calling fetch once per page,
+// until the last page is reached or opts.Max results have been collected.
+// Rate-limited pages are retried by the client (see Client.MaxRetries).
+//
+// The returned Paging is nil when every page was read. When the Max cap or
+// the request budget stops the walk early it holds the cursor for the next
+// unread page so callers can resume with --after.
+func listAll[T any](opts ListOptions, fetch func(ListOptions) ([]T, *Paging, error)) ([]T, *Paging, error) {
+	page := opts
+	page.All = false
+	page.Max = 0
+	if page.Limit <= 0 {
+		page.Limit = DefaultPageSize
+	}
+
+	var results []T
+	for {
+		if opts.Max > 0 {
+			if remaining := opts.Max - len(results); remaining < page.Limit {
+				page.Limit = remaining
+			}
+		}
+
+		items, paging, err := fetch(page)
+		if err != nil {
+			if IsBudgetExhausted(err) && len(results) > 0 {
+				return results, &Paging{Next: &PagingNext{After: page.After}}, nil
+			}
+			return nil, nil, err
+		}
+		results = append(results, items...)
+
+		if paging == nil || paging.Next == nil || paging.Next.After == "" || paging.Next.After == page.After {
+			return results, nil, nil
+		}
+		if opts.Max > 0 && len(results) >= opts.Max {
+			return results[:opts.Max], paging, nil
+		}
+
+		page.After = paging.Next.After
+	}
+}
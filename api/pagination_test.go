@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedServer serves total contacts in pages, honouring limit and after
+func pagedServer(t *testing.T, total int, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		end := start + limit
+		if end > total {
+			end = total
+		}
+
+		results := ""
+		for i := start; i < end; i++ {
+			if i > start {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"id": "%d", "properties": {}}`, i)
+		}
+		paging := ""
+		if end < total {
+			paging = fmt.Sprintf(`, "paging": {"next": {"after": "%d"}}`, end)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"results": [%s]%s}`, results, paging)
+	}))
+}
+
+func TestListObjects_All(t *testing.T) {
+	t.Run("follows cursors until the last page", func(t *testing.T) {
+		var requests []string
+		server := pagedServer(t, 5, &requests)
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+		result, err := client.ListObjects(context.Background(), ObjectTypeContacts, ListOptions{Limit: 2, All: true})
+		require.NoError(t, err)
+
+		assert.Len(t, result.Results, 5)
+		assert.Equal(t, "0", result.Results[0].ID)
+		assert.Equal(t, "4", result.Results[4].ID)
+		assert.Nil(t, result.Paging)
+		assert.Len(t, requests, 3)
+	})
+
+	t.Run("uses default page size when limit is unset", func(t *testing.T) {
+		var requests []string
+		server := pagedServer(t, 3, &requests)
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+		_, err := client.ListObjects(context.Background(), ObjectTypeContacts, ListOptions{All: true})
+		require.NoError(t, err)
+
+		require.Len(t, requests, 1)
+		assert.Contains(t, requests[0], "limit=100")
+	})
+
+	t.Run("max caps results and returns the resume cursor", func(t *testing.T) {
+		var requests []string
+		server := pagedServer(t, 10, &requests)
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+		result, err := client.ListObjects(context.Background(), ObjectTypeContacts, ListOptions{Limit: 3, All: true, Max: 5})
+		require.NoError(t, err)
+
+		assert.Len(t, result.Results, 5)
+		require.NotNil(t, result.Paging)
+		assert.Equal(t, "5", result.Paging.Next.After)
+		// second page is trimmed to the remaining two results
+		require.Len(t, requests, 2)
+		assert.Contains(t, requests[1], "limit=2")
+	})
+
+	t.Run("budget stops the walk with a resume cursor", func(t *testing.T) {
+		var requests []string
+		server := pagedServer(t, 10, &requests)
+		defer server.Close()
+
+		budget := NewRequestBudget(2)
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client(), Budget: budget}
+		result, err := client.ListObjects(context.Background(), ObjectTypeContacts, ListOptions{Limit: 3, All: true})
+		require.NoError(t, err)
+
+		assert.Len(t, result.Results, 6)
+		require.NotNil(t, result.Paging)
+		assert.Equal(t, "6", result.Paging.Next.After)
+		assert.Len(t, requests, 2)
+		assert.True(t, budget.Exhausted())
+	})
+}
+
+func TestListAll_RateLimitRetry(t *testing.T) {
+	t.Run("retries a rate limited page", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"status": "error", "message": "Too many requests", "category": "RATE_LIMITS"}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"results": [{"id": "1"}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client(), MaxRetries: DefaultMaxRetries, sleep: noSleep}
+		result, err := client.ListForms(context.Background(), ListOptions{All: true})
+		require.NoError(t, err)
+
+		assert.Len(t, result.Results, 1)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("gives up after repeated rate limits", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"status": "error", "message": "Too many requests"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client(), MaxRetries: DefaultMaxRetries, sleep: noSleep}
+		_, err := client.ListForms(context.Background(), ListOptions{All: true})
+		require.Error(t, err)
+
+		assert.True(t, IsRateLimited(err))
+		assert.Equal(t, DefaultMaxRetries+1, calls)
+	})
+}
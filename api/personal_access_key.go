@@ -0,0 +1,112 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before it expires an access token from a
+// personal access key is replaced, so a request never starts with a token
+// about to lapse
+const tokenRefreshMargin = time.Minute
+
+// PersonalAccessToken is a short-lived access token exchanged for a personal
+// access key
+type PersonalAccessToken struct {
+	AccessToken string    `json:"oauthAccessToken"`
+	PortalID    int64     `json:"hubId"`
+	HubName     string    `json:"hubName,omitempty"`
+	ExpiresAt   time.Time `json:"-"`
+}
+
+// ExchangePersonalAccessKey trades a personal access key, the credential the
+// HubSpot CLI for Node stores, for an access token. The token lasts about
+// half an hour; clients created with a key exchange it again as needed.
+func (c *Client) ExchangePersonalAccessKey(ctx context.Context, key string) (*PersonalAccessToken, error) {
+	if key == "" {
+		return nil, fmt.Errorf("personal access key is required")
+	}
+
+	reqBody, err := json.Marshal(map[string]string{"encodedOAuthRefreshToken": key})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	// Sent directly rather than through send: the exchange is what
+	// authenticates the requests send makes
+	url := fmt.Sprintf("%s/localdevauth/v1/auth/refresh", c.BaseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("failed to exchange personal access key: %w", ParseAPIError(resp, body))
+	}
+
+	var result struct {
+		PersonalAccessToken
+		ExpiresAtMillis int64 `json:"expiresAtMillis"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse personal access key response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("failed to exchange personal access key: no access token in response")
+	}
+
+	token := result.PersonalAccessToken
+	token.ExpiresAt = time.UnixMilli(result.ExpiresAtMillis)
+	return &token, nil
+}
+
+// personalAccessKeyAuth keeps the personal access key a client
+// authenticates with and when its current access token expires
+type personalAccessKeyAuth struct {
+	key string
+
+	mu        sync.Mutex
+	expiresAt time.Time
+}
+
+// authenticate exchanges the client's personal access key for an access
+// token when it has none or the one it has is about to expire. Clients
+// created with an access token are left alone.
+func (c *Client) authenticate(ctx context.Context) error {
+	pak := c.personalAccessKey
+	if pak == nil {
+		return nil
+	}
+
+	pak.mu.Lock()
+	defer pak.mu.Unlock()
+
+	if c.AccessToken != "" && time.Until(pak.expiresAt) > tokenRefreshMargin {
+		return nil
+	}
+	token, err := c.ExchangePersonalAccessKey(ctx, pak.key)
+	if err != nil {
+		return err
+	}
+	c.verbosef("↻ access token from personal access key (portal %d)", token.PortalID)
+	c.AccessToken = token.AccessToken
+	pak.expiresAt = token.ExpiresAt
+	return nil
+}
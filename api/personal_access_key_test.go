@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ExchangePersonalAccessKey(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/localdevauth/v1/auth/refresh", r.URL.Path)
+			assert.Empty(t, r.Header.Get("Authorization"))
+
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"encodedOAuthRefreshToken": "pak-key"}`, string(body))
+
+			w.Write([]byte(`{"oauthAccessToken": "CJT-token", "expiresAtMillis": 1700000000000, "hubId": 123, "hubName": "Acme"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, HTTPClient: server.Client()}
+
+		token, err := client.ExchangePersonalAccessKey(context.Background(), "pak-key")
+		require.NoError(t, err)
+		assert.Equal(t, "CJT-token", token.AccessToken)
+		assert.Equal(t, int64(123), token.PortalID)
+		assert.Equal(t, "Acme", token.HubName)
+		assert.Equal(t, time.UnixMilli(1700000000000), token.ExpiresAt)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status": "error", "message": "Invalid key"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, HTTPClient: server.Client()}
+
+		_, err := client.ExchangePersonalAccessKey(context.Background(), "bad")
+		require.Error(t, err)
+		assert.True(t, IsUnauthorized(err))
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.ExchangePersonalAccessKey(context.Background(), "")
+		assert.EqualError(t, err, "personal access key is required")
+	})
+}
+
+func TestClient_PersonalAccessKeyAuth(t *testing.T) {
+	exchanges := 0
+	expiresIn := time.Hour
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/localdevauth/v1/auth/refresh" {
+			exchanges++
+			fmt.Fprintf(w, `{"oauthAccessToken": "token-%d", "expiresAtMillis": %d, "hubId": 123}`, exchanges, time.Now().Add(expiresIn).UnixMilli())
+			return
+		}
+		assert.Equal(t, fmt.Sprintf("Bearer token-%d", exchanges), r.Header.Get("Authorization"))
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{PersonalAccessKey: "pak-key"})
+	require.NoError(t, err)
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	_, err = client.get(context.Background(), server.URL+"/crm/v3/objects/contacts")
+	require.NoError(t, err)
+	_, err = client.get(context.Background(), server.URL+"/crm/v3/objects/deals")
+	require.NoError(t, err)
+	assert.Equal(t, 1, exchanges, "a valid token is reused")
+
+	// A token about to expire is replaced before the next request
+	client.personalAccessKey.expiresAt = time.Now().Add(tokenRefreshMargin / 2)
+	_, err = client.get(context.Background(), server.URL+"/crm/v3/objects/deals")
+	require.NoError(t, err)
+	assert.Equal(t, 2, exchanges)
+	assert.Equal(t, "token-2", client.AccessToken)
+}
+
+func TestNew_PersonalAccessKeyIgnoredWithToken(t *testing.T) {
+	client, err := New(ClientConfig{AccessToken: "pat-na1-abc", PersonalAccessKey: "pak-key"})
+	require.NoError(t, err)
+	assert.Nil(t, client.personalAccessKey)
+	assert.NoError(t, client.authenticate(context.Background()))
+	assert.Equal(t, "pat-na1-abc", client.AccessToken)
+}
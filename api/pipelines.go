@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -35,10 +36,10 @@ type PipelineList struct {
 }
 
 // ListPipelines lists all pipelines for an object type
-func (c *Client) ListPipelines(objectType ObjectType) (*PipelineList, error) {
+func (c *Client) ListPipelines(ctx context.Context, objectType ObjectType) (*PipelineList, error) {
 	url := fmt.Sprintf("%s/crm/v3/pipelines/%s", c.BaseURL, objectType)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -52,14 +53,14 @@ func (c *Client) ListPipelines(objectType ObjectType) (*PipelineList, error) {
 }
 
 // GetPipeline retrieves a specific pipeline by ID
-func (c *Client) GetPipeline(objectType ObjectType, pipelineID string) (*Pipeline, error) {
+func (c *Client) GetPipeline(ctx context.Context, objectType ObjectType, pipelineID string) (*Pipeline, error) {
 	if pipelineID == "" {
 		return nil, fmt.Errorf("pipeline ID is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s", c.BaseURL, objectType, pipelineID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +74,14 @@ func (c *Client) GetPipeline(objectType ObjectType, pipelineID string) (*Pipelin
 }
 
 // GetPipelineStages retrieves all stages for a pipeline
-func (c *Client) GetPipelineStages(objectType ObjectType, pipelineID string) ([]PipelineStage, error) {
+func (c *Client) GetPipelineStages(ctx context.Context, objectType ObjectType, pipelineID string) ([]PipelineStage, error) {
 	if pipelineID == "" {
 		return nil, fmt.Errorf("pipeline ID is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s/stages", c.BaseURL, objectType, pipelineID)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -94,3 +95,157 @@ func (c *Client) GetPipelineStages(objectType ObjectType, pipelineID string) ([]
 
 	return result.Results, nil
 }
+
+// PipelineInput is a pipeline definition for creating or replacing a pipeline
+type PipelineInput struct {
+	Label        string               `json:"label"`
+	DisplayOrder int                  `json:"displayOrder"`
+	Stages       []PipelineStageInput `json:"stages"`
+}
+
+// PipelineStageInput is a stage definition. Deal stages need a "probability"
+// and ticket stages a "ticketState" (OPEN or CLOSED) in their metadata.
+type PipelineStageInput struct {
+	Label        string            `json:"label"`
+	DisplayOrder int               `json:"displayOrder"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+// CreatePipeline creates a pipeline with its stages
+func (c *Client) CreatePipeline(ctx context.Context, objectType ObjectType, input PipelineInput) (*Pipeline, error) {
+	if input.Label == "" {
+		return nil, fmt.Errorf("pipeline label is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s", c.BaseURL, objectType)
+
+	body, err := c.post(ctx, url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Pipeline
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdatePipeline changes a pipeline's label, display order, or archived
+// flag. Only the keys present in updates are modified.
+func (c *Client) UpdatePipeline(ctx context.Context, objectType ObjectType, pipelineID string, updates map[string]interface{}) (*Pipeline, error) {
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s", c.BaseURL, objectType, pipelineID)
+
+	body, err := c.patch(ctx, url, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Pipeline
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ReplacePipeline overwrites a pipeline, including all of its stages, with
+// the given definition
+func (c *Client) ReplacePipeline(ctx context.Context, objectType ObjectType, pipelineID string, input PipelineInput) (*Pipeline, error) {
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required")
+	}
+	if input.Label == "" {
+		return nil, fmt.Errorf("pipeline label is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s", c.BaseURL, objectType, pipelineID)
+
+	body, err := c.put(ctx, url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Pipeline
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeletePipeline archives a pipeline
+func (c *Client) DeletePipeline(ctx context.Context, objectType ObjectType, pipelineID string) error {
+	if pipelineID == "" {
+		return fmt.Errorf("pipeline ID is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s", c.BaseURL, objectType, pipelineID)
+
+	_, err := c.delete(ctx, url)
+	return err
+}
+
+// CreatePipelineStage adds a stage to a pipeline
+func (c *Client) CreatePipelineStage(ctx context.Context, objectType ObjectType, pipelineID string, input PipelineStageInput) (*PipelineStage, error) {
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required")
+	}
+	if input.Label == "" {
+		return nil, fmt.Errorf("stage label is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s/stages", c.BaseURL, objectType, pipelineID)
+
+	body, err := c.post(ctx, url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PipelineStage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdatePipelineStage changes a stage's label, display order, metadata, or
+// archived flag. Only the keys present in updates are modified.
+func (c *Client) UpdatePipelineStage(ctx context.Context, objectType ObjectType, pipelineID, stageID string, updates map[string]interface{}) (*PipelineStage, error) {
+	if pipelineID == "" || stageID == "" {
+		return nil, fmt.Errorf("pipeline ID and stage ID are required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s/stages/%s", c.BaseURL, objectType, pipelineID, stageID)
+
+	body, err := c.patch(ctx, url, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PipelineStage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeletePipelineStage archives a stage. HubSpot rejects the request while
+// records are still in the stage.
+func (c *Client) DeletePipelineStage(ctx context.Context, objectType ObjectType, pipelineID, stageID string) error {
+	if pipelineID == "" || stageID == "" {
+		return fmt.Errorf("pipeline ID and stage ID are required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/pipelines/%s/%s/stages/%s", c.BaseURL, objectType, pipelineID, stageID)
+
+	_, err := c.delete(ctx, url)
+	return err
+}
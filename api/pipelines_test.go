@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreatePipeline(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/pipelines/deals", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body PipelineInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Renewals", body.Label)
+			require.Len(t, body.Stages, 1)
+			assert.Equal(t, "0.5", body.Stages[0].Metadata["probability"])
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "123", "label": "Renewals", "stages": [{"id": "s1", "label": "Open"}]}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		pipeline, err := client.CreatePipeline(context.Background(), ObjectTypeDeals, PipelineInput{
+			Label:  "Renewals",
+			Stages: []PipelineStageInput{{Label: "Open", Metadata: map[string]string{"probability": "0.5"}}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "123", pipeline.ID)
+		assert.Len(t, pipeline.Stages, 1)
+	})
+
+	t.Run("empty label", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.CreatePipeline(context.Background(), ObjectTypeDeals, PipelineInput{})
+		assert.ErrorContains(t, err, "pipeline label is required")
+	})
+}
+
+func TestClient_UpdatePipeline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/pipelines/deals/123", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"label": "Renewals 2"}, body)
+
+		w.Write([]byte(`{"id": "123", "label": "Renewals 2"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	pipeline, err := client.UpdatePipeline(context.Background(), ObjectTypeDeals, "123", map[string]interface{}{"label": "Renewals 2"})
+	require.NoError(t, err)
+	assert.Equal(t, "Renewals 2", pipeline.Label)
+}
+
+func TestClient_ReplacePipeline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/pipelines/tickets/7", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		w.Write([]byte(`{"id": "7", "label": "Support"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	pipeline, err := client.ReplacePipeline(context.Background(), ObjectTypeTickets, "7", PipelineInput{Label: "Support"})
+	require.NoError(t, err)
+	assert.Equal(t, "7", pipeline.ID)
+
+	_, err = client.ReplacePipeline(context.Background(), ObjectTypeTickets, "", PipelineInput{Label: "Support"})
+	assert.ErrorContains(t, err, "pipeline ID is required")
+}
+
+func TestClient_DeletePipeline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/pipelines/deals/123", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.DeletePipeline(context.Background(), ObjectTypeDeals, "123"))
+}
+
+func TestClient_PipelineStages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/crm/v3/pipelines/deals/123/stages":
+			var body PipelineStageInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Negotiation", body.Label)
+			assert.Equal(t, 2, body.DisplayOrder)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "s9", "label": "Negotiation", "displayOrder": 2}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/crm/v3/pipelines/deals/123/stages/s9":
+			w.Write([]byte(`{"id": "s9", "label": "Negotiation", "displayOrder": 0}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/crm/v3/pipelines/deals/123/stages/s9":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	ctx := context.Background()
+
+	stage, err := client.CreatePipelineStage(ctx, ObjectTypeDeals, "123", PipelineStageInput{Label: "Negotiation", DisplayOrder: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "s9", stage.ID)
+
+	stage, err = client.UpdatePipelineStage(ctx, ObjectTypeDeals, "123", "s9", map[string]interface{}{"displayOrder": 0})
+	require.NoError(t, err)
+	assert.Equal(t, 0, stage.DisplayOrder)
+
+	require.NoError(t, client.DeletePipelineStage(ctx, ObjectTypeDeals, "123", "s9"))
+
+	_, err = client.UpdatePipelineStage(ctx, ObjectTypeDeals, "123", "", nil)
+	assert.ErrorContains(t, err, "pipeline ID and stage ID are required")
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -65,10 +66,10 @@ type CreatePropertyRequest struct {
 }
 
 // ListProperties lists all properties for an object type
-func (c *Client) ListProperties(objectType ObjectType) (*PropertyList, error) {
+func (c *Client) ListProperties(ctx context.Context, objectType ObjectType) (*PropertyList, error) {
 	url := fmt.Sprintf("%s/crm/v3/properties/%s", c.BaseURL, objectType)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -82,14 +83,14 @@ func (c *Client) ListProperties(objectType ObjectType) (*PropertyList, error) {
 }
 
 // GetProperty retrieves a specific property by name
-func (c *Client) GetProperty(objectType ObjectType, propertyName string) (*Property, error) {
+func (c *Client) GetProperty(ctx context.Context, objectType ObjectType, propertyName string) (*Property, error) {
 	if propertyName == "" {
 		return nil, fmt.Errorf("property name is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/properties/%s/%s", c.BaseURL, objectType, propertyName)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -103,10 +104,10 @@ func (c *Client) GetProperty(objectType ObjectType, propertyName string) (*Prope
 }
 
 // CreateProperty creates a new property for an object type
-func (c *Client) CreateProperty(objectType ObjectType, req CreatePropertyRequest) (*Property, error) {
+func (c *Client) CreateProperty(ctx context.Context, objectType ObjectType, req CreatePropertyRequest) (*Property, error) {
 	url := fmt.Sprintf("%s/crm/v3/properties/%s", c.BaseURL, objectType)
 
-	body, err := c.post(url, req)
+	body, err := c.post(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -120,13 +121,99 @@ func (c *Client) CreateProperty(objectType ObjectType, req CreatePropertyRequest
 }
 
 // DeleteProperty archives (soft deletes) a property
-func (c *Client) DeleteProperty(objectType ObjectType, propertyName string) error {
+func (c *Client) DeleteProperty(ctx context.Context, objectType ObjectType, propertyName string) error {
 	if propertyName == "" {
 		return fmt.Errorf("property name is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/properties/%s/%s", c.BaseURL, objectType, propertyName)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
+	return err
+}
+
+// UpdateProperty changes a property's definition. Only the keys present in
+// updates are modified; the name cannot be changed.
+func (c *Client) UpdateProperty(ctx context.Context, objectType ObjectType, propertyName string, updates map[string]interface{}) (*Property, error) {
+	if propertyName == "" {
+		return nil, fmt.Errorf("property name is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/properties/%s/%s", c.BaseURL, objectType, propertyName)
+
+	body, err := c.patch(ctx, url, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Property
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// PropertyGroup represents a group that organizes properties in the HubSpot UI
+type PropertyGroup struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DisplayOrder int    `json:"displayOrder,omitempty"`
+	Archived     bool   `json:"archived,omitempty"`
+}
+
+// PropertyGroupList represents a list of property groups
+type PropertyGroupList struct {
+	Results []PropertyGroup `json:"results"`
+}
+
+// ListPropertyGroups lists the property groups for an object type
+func (c *Client) ListPropertyGroups(ctx context.Context, objectType ObjectType) (*PropertyGroupList, error) {
+	url := fmt.Sprintf("%s/crm/v3/properties/%s/groups", c.BaseURL, objectType)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PropertyGroupList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreatePropertyGroup creates a property group for an object type
+func (c *Client) CreatePropertyGroup(ctx context.Context, objectType ObjectType, group PropertyGroup) (*PropertyGroup, error) {
+	if group.Name == "" || group.Label == "" {
+		return nil, fmt.Errorf("group name and label are required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/properties/%s/groups", c.BaseURL, objectType)
+
+	body, err := c.post(ctx, url, group)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PropertyGroup
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeletePropertyGroup archives a property group. HubSpot rejects the request
+// while the group still contains properties.
+func (c *Client) DeletePropertyGroup(ctx context.Context, objectType ObjectType, groupName string) error {
+	if groupName == "" {
+		return fmt.Errorf("group name is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/properties/%s/groups/%s", c.BaseURL, objectType, groupName)
+
+	_, err := c.delete(ctx, url)
 	return err
 }
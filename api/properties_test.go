@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UpdateProperty(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/properties/contacts/favorite_color", r.URL.Path)
+			assert.Equal(t, http.MethodPatch, r.Method)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"label": "Favourite colour"}, body)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "favorite_color", "label": "Favourite colour", "type": "string"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		prop, err := client.UpdateProperty(context.Background(), ObjectTypeContacts, "favorite_color", map[string]interface{}{"label": "Favourite colour"})
+		require.NoError(t, err)
+		assert.Equal(t, "Favourite colour", prop.Label)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.UpdateProperty(context.Background(), ObjectTypeContacts, "", nil)
+		assert.ErrorContains(t, err, "property name is required")
+	})
+}
+
+func TestClient_ListPropertyGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/properties/deals/groups", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"results": [{"name": "dealinformation", "label": "Deal information", "displayOrder": -1}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListPropertyGroups(context.Background(), ObjectTypeDeals)
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "dealinformation", result.Results[0].Name)
+	assert.Equal(t, -1, result.Results[0].DisplayOrder)
+}
+
+func TestClient_CreatePropertyGroup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/properties/contacts/groups", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body PropertyGroup
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "scoring", body.Name)
+			assert.Equal(t, "Lead scoring", body.Label)
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"name": "scoring", "label": "Lead scoring", "displayOrder": 3}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		group, err := client.CreatePropertyGroup(context.Background(), ObjectTypeContacts, PropertyGroup{Name: "scoring", Label: "Lead scoring"})
+		require.NoError(t, err)
+		assert.Equal(t, 3, group.DisplayOrder)
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.CreatePropertyGroup(context.Background(), ObjectTypeContacts, PropertyGroup{Name: "scoring"})
+		assert.ErrorContains(t, err, "name and label are required")
+	})
+}
+
+func TestClient_DeletePropertyGroup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/properties/contacts/groups/scoring", r.URL.Path)
+			assert.Equal(t, http.MethodDelete, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+		require.NoError(t, client.DeletePropertyGroup(context.Background(), ObjectTypeContacts, "scoring"))
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		assert.ErrorContains(t, client.DeletePropertyGroup(context.Background(), ObjectTypeContacts, ""), "group name is required")
+	})
+}
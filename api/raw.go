@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Do sends a request to any API endpoint and returns the raw response body.
+// endpoint is a path such as /crm/v3/objects/contacts, resolved against
+// BaseURL, or a full URL on the API or forms host. body, when not nil, must
+// be JSON. Error responses are returned as errors like any other request.
+func (c *Client) Do(ctx context.Context, method, endpoint string, body json.RawMessage) ([]byte, error) {
+	reqURL, err := c.resolveEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return c.doRequest(ctx, method, reqURL, nil)
+	}
+	return c.doRequest(ctx, method, reqURL, body)
+}
+
+// resolveEndpoint turns a path into a URL on BaseURL. Full URLs are only
+// accepted on the API and forms hosts so the access token is never sent
+// anywhere else.
+func (c *Client) resolveEndpoint(endpoint string) (string, error) {
+	if endpoint == "" {
+		return "", fmt.Errorf("endpoint is required")
+	}
+
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		if !strings.HasPrefix(endpoint, "/") {
+			endpoint = "/" + endpoint
+		}
+		return strings.TrimSuffix(c.BaseURL, "/") + endpoint, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", endpoint, err)
+	}
+	for _, allowed := range []string{c.BaseURL, c.FormsURL} {
+		if a, err := url.Parse(allowed); err == nil && allowed != "" && a.Scheme == u.Scheme && a.Host == u.Host {
+			return endpoint, nil
+		}
+	}
+	return "", fmt.Errorf("refusing to send the access token to %s: use a path or a %s URL", u.Host, c.BaseURL)
+}
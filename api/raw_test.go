@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Do(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/crm/v3/objects/contacts/123", r.URL.Path)
+			assert.Equal(t, "email", r.URL.Query().Get("properties"))
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"id":"123"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		body, err := client.Do(context.Background(), http.MethodGet, "/crm/v3/objects/contacts/123?properties=email", nil)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id":"123"}`, string(body))
+	})
+
+	t.Run("post body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			data, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"properties":{"email":"a@example.com"}}`, string(data))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"1"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		_, err := client.Do(context.Background(), http.MethodPost, "crm/v3/objects/contacts",
+			json.RawMessage(`{"properties": {"email": "a@example.com"}}`))
+		require.NoError(t, err)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		_, err := client.Do(context.Background(), http.MethodGet, "/crm/v3/objects/contacts/999", nil)
+		assert.True(t, IsNotFound(err))
+	})
+}
+
+func TestClient_resolveEndpoint(t *testing.T) {
+	client := &Client{BaseURL: "https://api.hubapi.com", FormsURL: "https://api.hsforms.com"}
+
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  string
+	}{
+		{endpoint: "/crm/v3/objects/contacts", want: "https://api.hubapi.com/crm/v3/objects/contacts"},
+		{endpoint: "crm/v3/objects/contacts", want: "https://api.hubapi.com/crm/v3/objects/contacts"},
+		{endpoint: "https://api.hubapi.com/account-info/v3/details", want: "https://api.hubapi.com/account-info/v3/details"},
+		{endpoint: "https://api.hsforms.com/submissions/v3/x", want: "https://api.hsforms.com/submissions/v3/x"},
+		{endpoint: "https://evil.example.com/steal", wantErr: "refusing to send the access token to evil.example.com"},
+		{endpoint: "http://api.hubapi.com/crm", wantErr: "refusing to send the access token"},
+		{endpoint: "", wantErr: "endpoint is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			got, err := client.resolveEndpoint(tt.endpoint)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
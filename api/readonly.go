@@ -0,0 +1,27 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// readPosts are POST endpoints that only read: GraphQL, which HubSpot
+// serves for queries only, and the private app token lookup behind
+// "auth status" and missing-scope errors
+var readPosts = map[string]bool{
+	"/collector/graphql":                           true,
+	"/oauth/v2/private-apps/get/access-token-info": true,
+}
+
+// checkReadOnly returns ErrReadOnly for a request that may change data.
+// GETs, searches, batch reads, and readPosts are allowed.
+func checkReadOnly(method, urlStr string) error {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return fmt.Errorf("%w: %s %s", ErrReadOnly, method, urlStr)
+	}
+	if isReadRequest(method, u.Path) || readPosts[u.Path] {
+		return nil
+	}
+	return fmt.Errorf("%w: refusing %s %s", ErrReadOnly, method, u.Path)
+}
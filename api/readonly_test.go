@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckReadOnly(t *testing.T) {
+	allowed := []struct{ method, url string }{
+		{http.MethodGet, "https://api.hubapi.com/crm/v3/objects/contacts"},
+		{http.MethodPost, "https://api.hubapi.com/crm/v3/objects/contacts/search"},
+		{http.MethodPost, "https://api.hubapi.com/crm/v3/objects/deals/batch/read"},
+		{http.MethodPost, "https://api.hubapi.com/crm/v4/associations/deals/contacts/batch/read"},
+		{http.MethodPost, "https://api.hubapi.com/collector/graphql"},
+		{http.MethodPost, "https://api.hubapi.com/oauth/v2/private-apps/get/access-token-info"},
+	}
+	for _, tt := range allowed {
+		assert.NoError(t, checkReadOnly(tt.method, tt.url), "%s %s", tt.method, tt.url)
+	}
+
+	refused := []struct{ method, url string }{
+		{http.MethodPost, "https://api.hubapi.com/crm/v3/objects/contacts"},
+		{http.MethodPost, "https://api.hubapi.com/crm/v3/objects/contacts/batch/update"},
+		{http.MethodPatch, "https://api.hubapi.com/crm/v3/objects/contacts/1"},
+		{http.MethodPut, "https://api.hubapi.com/crm/v4/objects/deals/1/associations/contacts/2"},
+		{http.MethodDelete, "https://api.hubapi.com/crm/v3/objects/contacts/1"},
+	}
+	for _, tt := range refused {
+		err := checkReadOnly(tt.method, tt.url)
+		assert.True(t, IsReadOnly(err), "%s %s", tt.method, tt.url)
+	}
+}
+
+func TestClient_ReadOnly(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client(), ReadOnly: true}
+
+	_, err := client.SearchObjects(context.Background(), ObjectTypeContacts, SearchRequest{})
+	require.NoError(t, err)
+
+	_, err = client.CreateObject(context.Background(), ObjectTypeContacts, map[string]interface{}{"email": "a@example.com"})
+	require.Error(t, err)
+	assert.True(t, IsReadOnly(err))
+	assert.Contains(t, err.Error(), "refusing POST /crm/v3/objects/contacts")
+
+	err = client.DeleteObject(context.Background(), ObjectTypeContacts, "1")
+	assert.True(t, IsReadOnly(err))
+
+	assert.Equal(t, 1, calls)
+}
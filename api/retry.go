@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is how many times a request is retried after a 429,
+	// or a 5xx response to an idempotent request, before the error is
+	// returned.
+	DefaultMaxRetries = 3
+
+	// DefaultRateLimit is the number of requests allowed per RateLimitWindow.
+	// It matches HubSpot's burst limit for private apps on the lowest tier,
+	// so it is safe for every account.
+	DefaultRateLimit = 100
+
+	// RateLimitWindow is the rolling window HubSpot applies burst limits to
+	RateLimitWindow = 10 * time.Second
+
+	// maxBackoff caps the exponential backoff between retries
+	maxBackoff = 30 * time.Second
+)
+
+// RateLimiter spaces out requests so that no more than Limit are sent in any
+// rolling Window. It is safe for concurrent use.
+type RateLimiter struct {
+	Limit  int
+	Window time.Duration
+
+	mu   sync.Mutex
+	sent []time.Time
+
+	// now and sleep are replaced in tests
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// NewRateLimiter creates a limiter allowing limit requests per window
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{Limit: limit, Window: window}
+}
+
+// Wait blocks until another request may be sent and records it. It returns
+// early with the context's error if ctx is cancelled while waiting.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	if rl == nil || rl.Limit <= 0 {
+		return nil
+	}
+
+	now, sleep := time.Now, SleepContext
+	if rl.now != nil {
+		now = rl.now
+	}
+	if rl.sleep != nil {
+		sleep = rl.sleep
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	for {
+		t := now()
+		cutoff := t.Add(-rl.Window)
+		i := 0
+		for i < len(rl.sent) && !rl.sent[i].After(cutoff) {
+			i++
+		}
+		rl.sent = rl.sent[i:]
+
+		if len(rl.sent) < rl.Limit {
+			rl.sent = append(rl.sent, t)
+			return nil
+		}
+		if err := sleep(ctx, rl.sent[0].Sub(cutoff)); err != nil {
+			return err
+		}
+	}
+}
+
+// SleepContext waits for d, or until ctx is cancelled. Commands that poll
+// for a job to finish wait with it between checks.
+func SleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryable reports whether a response is worth retrying. A 429 means
+// HubSpot turned the request away, so it is always safe to send again. A 5xx
+// may come after HubSpot committed a create, so it is only retried for
+// requests that can be repeated without side effects.
+func retryable(method, path string, status int) bool {
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+	return status >= 500 && idempotent(method, path)
+}
+
+// idempotent reports whether sending a request twice has the same effect as
+// sending it once: reads, including searches and batch reads, PUTs, and
+// DELETEs
+func idempotent(method, path string) bool {
+	switch method {
+	case http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return isReadRequest(method, path)
+}
+
+// retryDelay returns how long to wait before retry number attempt (0-based).
+// A Retry-After header, in seconds or as an HTTP date, takes precedence over
+// exponential backoff.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if after := resp.Header.Get("Retry-After"); after != "" {
+		if secs, err := strconv.Atoi(after); err == nil && secs >= 0 {
+			return min(time.Duration(secs)*time.Second, maxBackoff)
+		}
+		if at, err := http.ParseTime(after); err == nil {
+			return min(max(time.Until(at), 0), maxBackoff)
+		}
+	}
+
+	d := time.Duration(1<<attempt) * time.Second
+	if d <= 0 || d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_send_Retry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		statuses   []int
+		maxRetries int
+		wantCalls  int
+		wantErr    error
+	}{
+		{
+			name:       "retries 429 until success",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 3,
+			wantCalls:  3,
+		},
+		{
+			name:       "retries 5xx for GET",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries: 3,
+			wantCalls:  2,
+		},
+		{
+			name:       "retries 5xx for a search",
+			path:       "/crm/v3/objects/contacts/search",
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 3,
+			wantCalls:  2,
+		},
+		{
+			name:       "does not resend a create after 5xx",
+			path:       "/crm/v3/objects/contacts/batch/create",
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 3,
+			wantCalls:  1,
+			wantErr:    ErrServerError,
+		},
+		{
+			name:       "retries a create after 429",
+			path:       "/crm/v3/objects/contacts",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 3,
+			wantCalls:  2,
+		},
+		{
+			name:       "gives up after max retries",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			maxRetries: 2,
+			wantCalls:  3,
+			wantErr:    ErrRateLimited,
+		},
+		{
+			name:       "zero retries disables retrying",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 0,
+			wantCalls:  1,
+			wantErr:    ErrServerError,
+		},
+		{
+			name:       "client errors are not retried",
+			statuses:   []int{http.StatusBadRequest, http.StatusOK},
+			maxRetries: 3,
+			wantCalls:  1,
+			wantErr:    ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statuses[calls])
+				calls++
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			var slept []time.Duration
+			client := &Client{
+				AccessToken: "test-token",
+				HTTPClient:  server.Client(),
+				MaxRetries:  tt.maxRetries,
+				sleep: func(_ context.Context, d time.Duration) error {
+					slept = append(slept, d)
+					return nil
+				},
+			}
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			var body interface{}
+			if method == http.MethodPost {
+				body = map[string]string{"a": "b"}
+			}
+
+			_, err := client.doRequest(context.Background(), method, server.URL+tt.path, body)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Len(t, slept, tt.wantCalls-1)
+		})
+	}
+}
+
+func TestClient_send_RetryResendsBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(buf)
+		bodies = append(bodies, string(buf))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := &Client{AccessToken: "test-token", HTTPClient: server.Client(), MaxRetries: 1, sleep: noSleep}
+	_, err := client.doRequest(context.Background(), http.MethodPost, server.URL, map[string]string{"a": "b"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{`{"a":"b"}`, `{"a":"b"}`}, bodies)
+}
+
+func TestRetryDelay(t *testing.T) {
+	header := func(v string) *http.Response {
+		resp := &http.Response{Header: http.Header{}}
+		if v != "" {
+			resp.Header.Set("Retry-After", v)
+		}
+		return resp
+	}
+
+	assert.Equal(t, 1*time.Second, retryDelay(header(""), 0))
+	assert.Equal(t, 4*time.Second, retryDelay(header(""), 2))
+	assert.Equal(t, maxBackoff, retryDelay(header(""), 10))
+	assert.Equal(t, 7*time.Second, retryDelay(header("7"), 0))
+	assert.Equal(t, maxBackoff, retryDelay(header("3600"), 0))
+	assert.Equal(t, time.Duration(0), retryDelay(header(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)), 0))
+	assert.Equal(t, 2*time.Second, retryDelay(header("not-a-delay"), 1))
+
+	d := retryDelay(header(time.Now().Add(5*time.Second).UTC().Format(http.TimeFormat)), 0)
+	assert.True(t, d > 3*time.Second && d <= 5*time.Second, "got %s", d)
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+
+	rl := NewRateLimiter(2, 10*time.Second)
+	rl.now = func() time.Time { return clock }
+	rl.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		clock = clock.Add(d)
+		return nil
+	}
+
+	ctx := context.Background()
+	require.NoError(t, rl.Wait(ctx))
+	clock = clock.Add(3 * time.Second)
+	require.NoError(t, rl.Wait(ctx))
+	assert.Empty(t, slept)
+
+	// The window is full until the first request is 10s old
+	require.NoError(t, rl.Wait(ctx))
+	assert.Equal(t, []time.Duration{7 * time.Second}, slept)
+
+	// A nil limiter never blocks
+	var none *RateLimiter
+	assert.NoError(t, none.Wait(ctx))
+}
+
+func TestClient_send_Cancelled(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &Client{AccessToken: "test-token", HTTPClient: server.Client(), MaxRetries: 3}
+
+	// Cancel while the client is backing off before the first retry
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err := client.doRequest(ctx, http.MethodGet, server.URL, nil)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
+
+// noSleep skips retry backoff in tests
+func noSleep(context.Context, time.Duration) error { return nil }
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -48,7 +49,21 @@ type SchemaList struct {
 }
 
 // ListSchemas retrieves custom object schemas with pagination
-func (c *Client) ListSchemas(opts ListOptions) (*SchemaList, error) {
+func (c *Client) ListSchemas(ctx context.Context, opts ListOptions) (*SchemaList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]Schema, *Paging, error) {
+			page, err := c.ListSchemas(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &SchemaList{Results: results, Paging: paging}, nil
+	}
+
 	url := fmt.Sprintf("%s/crm/v3/schemas", c.BaseURL)
 
 	params := make(map[string]string)
@@ -63,7 +78,7 @@ func (c *Client) ListSchemas(opts ListOptions) (*SchemaList, error) {
 		url = buildURL(url, params)
 	}
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -77,14 +92,14 @@ func (c *Client) ListSchemas(opts ListOptions) (*SchemaList, error) {
 }
 
 // GetSchema retrieves a single custom object schema by fully qualified name
-func (c *Client) GetSchema(fullyQualifiedName string) (*Schema, error) {
+func (c *Client) GetSchema(ctx context.Context, fullyQualifiedName string) (*Schema, error) {
 	if fullyQualifiedName == "" {
 		return nil, fmt.Errorf("fully qualified name is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/schemas/%s", c.BaseURL, fullyQualifiedName)
 
-	body, err := c.get(url)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -98,10 +113,10 @@ func (c *Client) GetSchema(fullyQualifiedName string) (*Schema, error) {
 }
 
 // CreateSchema creates a new custom object schema
-func (c *Client) CreateSchema(schema map[string]interface{}) (*Schema, error) {
+func (c *Client) CreateSchema(ctx context.Context, schema map[string]interface{}) (*Schema, error) {
 	url := fmt.Sprintf("%s/crm/v3/schemas", c.BaseURL)
 
-	body, err := c.post(url, schema)
+	body, err := c.post(ctx, url, schema)
 	if err != nil {
 		return nil, err
 	}
@@ -115,13 +130,13 @@ func (c *Client) CreateSchema(schema map[string]interface{}) (*Schema, error) {
 }
 
 // DeleteSchema deletes a custom object schema
-func (c *Client) DeleteSchema(fullyQualifiedName string) error {
+func (c *Client) DeleteSchema(ctx context.Context, fullyQualifiedName string) error {
 	if fullyQualifiedName == "" {
 		return fmt.Errorf("fully qualified name is required")
 	}
 
 	url := fmt.Sprintf("%s/crm/v3/schemas/%s", c.BaseURL, fullyQualifiedName)
 
-	_, err := c.delete(url)
+	_, err := c.delete(ctx, url)
 	return err
 }
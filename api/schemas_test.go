@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -53,7 +54,7 @@ func TestClient_ListSchemas(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListSchemas(ListOptions{Limit: 10})
+		result, err := client.ListSchemas(context.Background(), ListOptions{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, result.Results, 1)
 		assert.Equal(t, "123", result.Results[0].ID)
@@ -76,7 +77,7 @@ func TestClient_ListSchemas(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		result, err := client.ListSchemas(ListOptions{})
+		result, err := client.ListSchemas(context.Background(), ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, result.Results)
 	})
@@ -120,7 +121,7 @@ func TestClient_GetSchema(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		schema, err := client.GetSchema("p_my_custom_object")
+		schema, err := client.GetSchema(context.Background(), "p_my_custom_object")
 		require.NoError(t, err)
 		assert.Equal(t, "123", schema.ID)
 		assert.Equal(t, "my_custom_object", schema.Name)
@@ -142,7 +143,7 @@ func TestClient_GetSchema(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		schema, err := client.GetSchema("p_nonexistent")
+		schema, err := client.GetSchema(context.Background(), "p_nonexistent")
 		assert.Error(t, err)
 		assert.True(t, IsNotFound(err))
 		assert.Nil(t, schema)
@@ -150,7 +151,7 @@ func TestClient_GetSchema(t *testing.T) {
 
 	t.Run("empty name", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		schema, err := client.GetSchema("")
+		schema, err := client.GetSchema(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "fully qualified name is required")
 		assert.Nil(t, schema)
@@ -190,7 +191,7 @@ func TestClient_CreateSchema(t *testing.T) {
 				"plural":   "New Objects",
 			},
 		}
-		schema, err := client.CreateSchema(data)
+		schema, err := client.CreateSchema(context.Background(), data)
 		require.NoError(t, err)
 		assert.Equal(t, "456", schema.ID)
 		assert.Equal(t, "new_object", schema.Name)
@@ -214,13 +215,13 @@ func TestClient_DeleteSchema(t *testing.T) {
 			HTTPClient:  server.Client(),
 		}
 
-		err := client.DeleteSchema("p_my_custom_object")
+		err := client.DeleteSchema(context.Background(), "p_my_custom_object")
 		require.NoError(t, err)
 	})
 
 	t.Run("empty name", func(t *testing.T) {
 		client := &Client{BaseURL: "https://api.hubapi.com"}
-		err := client.DeleteSchema("")
+		err := client.DeleteSchema(context.Background(), "")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "fully qualified name is required")
 	})
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ScopeRequirement lists the scopes a group of hspt commands needs
+type ScopeRequirement struct {
+	// Group is the command group, such as "deals"
+	Group string
+	// Path is the API path prefix of the group's requests
+	Path string
+	// Read and Write are the scopes needed to read and to change records
+	Read  []string
+	Write []string
+}
+
+// customObjectsPath stands in for the object type of a custom object request,
+// which is a portal-specific ID such as 2-123456 or p123456_cars
+const customObjectsPath = "/crm/v3/objects/{customObjectType}"
+
+// ScopeRequirements are the scopes known to be required by each command
+// group. Requests are matched to the entry with the longest Path prefix.
+var ScopeRequirements = []ScopeRequirement{
+	{Group: "access", Path: "/settings/v3/users", Read: []string{"settings.users.read"}, Write: []string{"settings.users.write"}},
+	{Group: "blogs", Path: "/cms/v3/blogs", Read: []string{"content"}, Write: []string{"content"}},
+	{Group: "calls", Path: "/crm/v3/objects/calls", Read: []string{"crm.objects.contacts.read"}, Write: []string{"crm.objects.contacts.write"}},
+	{Group: "campaigns", Path: "/marketing/v3/campaigns", Read: []string{"marketing.campaigns.read"}, Write: []string{"marketing.campaigns.write"}},
+	{Group: "cms", Path: "/cms/v3/source-code", Read: []string{"content"}, Write: []string{"content"}},
+	{Group: "companies", Path: "/crm/v3/objects/companies", Read: []string{"crm.objects.companies.read"}, Write: []string{"crm.objects.companies.write"}},
+	{Group: "contacts", Path: "/crm/v3/objects/contacts", Read: []string{"crm.objects.contacts.read"}, Write: []string{"crm.objects.contacts.write"}},
+	{Group: "conversations", Path: "/conversations/v3", Read: []string{"conversations.read"}, Write: []string{"conversations.write"}},
+	{Group: "commercesubscriptions", Path: "/crm/v3/objects/subscriptions", Read: []string{"crm.objects.subscriptions.read"}},
+	{Group: "customobjects", Path: customObjectsPath, Read: []string{"crm.objects.custom.read"}, Write: []string{"crm.objects.custom.write"}},
+	{Group: "deals", Path: "/crm/v3/objects/deals", Read: []string{"crm.objects.deals.read"}, Write: []string{"crm.objects.deals.write"}},
+	{Group: "emails", Path: "/crm/v3/objects/emails", Read: []string{"crm.objects.contacts.read"}, Write: []string{"crm.objects.contacts.write"}},
+	{Group: "exports", Path: "/crm/v3/exports", Read: []string{"crm.export"}, Write: []string{"crm.export"}},
+	{Group: "files", Path: "/files/v3", Read: []string{"files"}, Write: []string{"files"}},
+	{Group: "forms", Path: "/marketing/v3/forms", Read: []string{"forms"}, Write: []string{"forms"}},
+	{Group: "hubdb", Path: "/cms/v3/hubdb", Read: []string{"hubdb"}, Write: []string{"hubdb"}},
+	{Group: "imports", Path: "/crm/v3/imports", Read: []string{"crm.import"}, Write: []string{"crm.import"}},
+	{Group: "invoices", Path: "/crm/v3/objects/invoices", Read: []string{"crm.objects.invoices.read"}},
+	{Group: "lineitems", Path: "/crm/v3/objects/line_items", Read: []string{"e-commerce"}, Write: []string{"e-commerce"}},
+	{Group: "lists", Path: "/crm/v3/lists", Read: []string{"crm.lists.read"}, Write: []string{"crm.lists.write"}},
+	{Group: "marketingemails", Path: "/marketing/v3/emails", Read: []string{"content"}, Write: []string{"content"}},
+	{Group: "marketingemails", Path: "/marketing/v3/emails/statistics", Read: []string{"marketing-email"}},
+	{Group: "meetings", Path: "/crm/v3/objects/meetings", Read: []string{"crm.objects.contacts.read"}, Write: []string{"crm.objects.contacts.write"}},
+	{Group: "notes", Path: "/crm/v3/objects/notes", Read: []string{"crm.objects.contacts.read"}, Write: []string{"crm.objects.contacts.write"}},
+	{Group: "owners", Path: "/crm/v3/owners", Read: []string{"crm.objects.owners.read"}},
+	{Group: "pages", Path: "/cms/v3/pages", Read: []string{"content"}, Write: []string{"content"}},
+	{Group: "payments", Path: "/crm/v3/objects/commerce_payments", Read: []string{"crm.objects.commercepayments.read"}},
+	{Group: "products", Path: "/crm/v3/objects/products", Read: []string{"e-commerce"}, Write: []string{"e-commerce"}},
+	{Group: "quotes", Path: "/crm/v3/objects/quotes", Read: []string{"crm.objects.quotes.read"}, Write: []string{"crm.objects.quotes.write"}},
+	{Group: "schemas", Path: "/crm/v3/schemas", Read: []string{"crm.schemas.custom.read"}, Write: []string{"crm.schemas.custom.write"}},
+	{Group: "subscriptions", Path: "/communication-preferences/v3", Read: []string{"communication_preferences.read"}, Write: []string{"communication_preferences.write"}},
+	{Group: "tasks", Path: "/crm/v3/objects/tasks", Read: []string{"crm.objects.contacts.read"}, Write: []string{"crm.objects.contacts.write"}},
+	{Group: "tickets", Path: "/crm/v3/objects/tickets", Read: []string{"tickets"}, Write: []string{"tickets"}},
+	{Group: "workflows", Path: "/automation/v4", Read: []string{"automation"}, Write: []string{"automation"}},
+}
+
+// RequiredScopes returns the scopes known to be needed for a request, or nil
+// when the endpoint is not in ScopeRequirements
+func RequiredScopes(method, urlStr string) []string {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return nil
+	}
+	// v4 object endpoints (associations) need the same scopes as v3
+	path := strings.Replace(u.Path, "/crm/v4/objects/", "/crm/v3/objects/", 1)
+	if rest, ok := strings.CutPrefix(path, "/crm/v3/objects/"); ok {
+		objectType, tail, _ := strings.Cut(rest, "/")
+		if isCustomObjectType(objectType) {
+			path = customObjectsPath
+			if tail != "" {
+				path += "/" + tail
+			}
+		}
+	}
+
+	var match *ScopeRequirement
+	for i, req := range ScopeRequirements {
+		if path != req.Path && !strings.HasPrefix(path, req.Path+"/") {
+			continue
+		}
+		if match == nil || len(req.Path) > len(match.Path) {
+			match = &ScopeRequirements[i]
+		}
+	}
+	if match == nil {
+		return nil
+	}
+
+	if isReadRequest(method, path) {
+		return match.Read
+	}
+	return match.Write
+}
+
+// isCustomObjectType reports whether an object type names a custom object: an
+// object type ID such as 2-123456 or a fully qualified name such as
+// p123456_cars
+func isCustomObjectType(objectType string) bool {
+	if strings.HasPrefix(objectType, "2-") {
+		return true
+	}
+	name, ok := strings.CutPrefix(objectType, "p")
+	if !ok {
+		return false
+	}
+	portalID, _, ok := strings.Cut(name, "_")
+	return ok && strings.Trim(portalID, "0123456789") == ""
+}
+
+// isReadRequest reports whether a request only reads data. Searches and
+// batch reads are POSTs that read.
+func isReadRequest(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		return strings.HasSuffix(path, "/search") || strings.HasSuffix(path, "/batch/read")
+	}
+	return false
+}
+
+// scopeCache remembers the scopes granted to each access token
+type scopeCache struct {
+	mu      sync.Mutex
+	byToken map[string][]string
+}
+
+// GrantedScopes returns the scopes granted to the client's access token. The
+// token is looked up once per client and the scopes are reused afterwards.
+func (c *Client) GrantedScopes(ctx context.Context) ([]string, error) {
+	if c.scopes == nil {
+		info, err := c.GetTokenInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return info.Scopes, nil
+	}
+
+	c.scopes.mu.Lock()
+	defer c.scopes.mu.Unlock()
+
+	if scopes, ok := c.scopes.byToken[c.AccessToken]; ok {
+		return scopes, nil
+	}
+
+	info, err := c.GetTokenInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.scopes.byToken == nil {
+		c.scopes.byToken = make(map[string][]string)
+	}
+	c.scopes.byToken[c.AccessToken] = info.Scopes
+	return info.Scopes, nil
+}
+
+// scopeError explains a 403 in terms of missing scopes. When HubSpot did not
+// name the scopes, the ones the endpoint is known to need are compared with
+// the token's granted scopes. err is kept as the ScopeError's cause, and is
+// returned unchanged when no scope is found missing.
+func (c *Client) scopeError(ctx context.Context, method, urlStr string, err error) error {
+	if _, ok := err.(*ScopeError); ok || c.AccessToken == "" {
+		return err
+	}
+
+	required := RequiredScopes(method, urlStr)
+	if len(required) == 0 {
+		return err
+	}
+
+	granted, gerr := c.GrantedScopes(ctx)
+	if gerr != nil {
+		return err
+	}
+
+	has := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		has[s] = true
+	}
+	var missing []string
+	for _, s := range required {
+		if !has[s] {
+			missing = append(missing, s)
+		}
+	}
+	if len(missing) == 0 {
+		return err
+	}
+	return &ScopeError{Missing: missing, Err: err}
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		want   []string
+	}{
+		{"read", http.MethodGet, "https://api.hubapi.com/crm/v3/objects/deals/123", []string{"crm.objects.deals.read"}},
+		{"write", http.MethodPatch, "https://api.hubapi.com/crm/v3/objects/deals/123", []string{"crm.objects.deals.write"}},
+		{"search is a read", http.MethodPost, "https://api.hubapi.com/crm/v3/objects/contacts/search", []string{"crm.objects.contacts.read"}},
+		{"batch read", http.MethodPost, "https://api.hubapi.com/crm/v3/objects/companies/batch/read", []string{"crm.objects.companies.read"}},
+		{"v4 associations", http.MethodPut, "https://api.hubapi.com/crm/v4/objects/tickets/1/associations/contacts/2", []string{"tickets"}},
+		{"custom object", http.MethodGet, "https://api.hubapi.com/crm/v3/objects/p_cars", []string{"crm.objects.custom.read"}},
+		{"custom object type ID", http.MethodPatch, "https://api.hubapi.com/crm/v3/objects/2-123456/7", []string{"crm.objects.custom.write"}},
+		{"custom object fully qualified name", http.MethodPost, "https://api.hubapi.com/crm/v3/objects/p123456_cars/search", []string{"crm.objects.custom.read"}},
+		{"custom object associations", http.MethodGet, "https://api.hubapi.com/crm/v4/objects/2-123456/7/associations/contacts", []string{"crm.objects.custom.read"}},
+		{"invoices", http.MethodGet, "https://api.hubapi.com/crm/v3/objects/invoices", []string{"crm.objects.invoices.read"}},
+		{"payments", http.MethodGet, "https://api.hubapi.com/crm/v3/objects/commerce_payments/1", []string{"crm.objects.commercepayments.read"}},
+		{"commerce subscriptions", http.MethodGet, "https://api.hubapi.com/crm/v3/objects/subscriptions", []string{"crm.objects.subscriptions.read"}},
+		{"unlisted standard object", http.MethodGet, "https://api.hubapi.com/crm/v3/objects/feedback_submissions", nil},
+		{"hubdb", http.MethodPost, "https://api.hubapi.com/cms/v3/hubdb/tables?limit=10", []string{"hubdb"}},
+		{"email statistics", http.MethodGet, "https://api.hubapi.com/marketing/v3/emails/statistics/list?emailIds=1", []string{"marketing-email"}},
+		{"unknown", http.MethodGet, "https://api.hubapi.com/crm/v3/properties/contacts", nil},
+		{"prefix needs a path boundary", http.MethodGet, "https://api.hubapi.com/crm/v3/listsx", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RequiredScopes(tt.method, tt.url))
+		})
+	}
+}
+
+func TestClient_GrantedScopes_Cached(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"hubId": 1, "scopes": ["crm.objects.deals.read"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "pat-na1-abc", HTTPClient: server.Client(), scopes: &scopeCache{}}
+
+	for i := 0; i < 2; i++ {
+		scopes, err := client.GrantedScopes(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"crm.objects.deals.read"}, scopes)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_ForbiddenExplainsMissingScope(t *testing.T) {
+	granted := `["crm.objects.deals.read"]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/v2/private-apps/get/access-token-info" {
+			w.Write([]byte(`{"hubId": 1, "scopes": ` + granted + `}`))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"status": "error", "message": "Forbidden"}`))
+	}))
+	defer server.Close()
+
+	t.Run("scope not granted", func(t *testing.T) {
+		client := &Client{BaseURL: server.URL, AccessToken: "pat-na1-abc", HTTPClient: server.Client()}
+
+		_, err := client.patch(context.Background(), server.URL+"/crm/v3/objects/deals/1", map[string]any{})
+		require.Error(t, err)
+		assert.True(t, IsForbidden(err))
+
+		var scopeErr *ScopeError
+		require.True(t, errors.As(err, &scopeErr))
+		assert.Equal(t, []string{"crm.objects.deals.write"}, scopeErr.Missing)
+		assert.Contains(t, err.Error(), "missing scope crm.objects.deals.write")
+		assert.Contains(t, err.Error(), "Forbidden", "HubSpot's message is kept")
+		assert.Error(t, scopeErr.Err)
+	})
+
+	t.Run("scope granted", func(t *testing.T) {
+		granted = `["crm.objects.deals.read", "crm.objects.deals.write"]`
+		client := &Client{BaseURL: server.URL, AccessToken: "pat-na1-abc", HTTPClient: server.Client()}
+
+		_, err := client.patch(context.Background(), server.URL+"/crm/v3/objects/deals/1", map[string]any{})
+		require.Error(t, err)
+		assert.True(t, IsForbidden(err))
+
+		var scopeErr *ScopeError
+		assert.False(t, errors.As(err, &scopeErr))
+		assert.Contains(t, err.Error(), "Forbidden")
+	})
+}
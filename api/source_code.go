@@ -0,0 +1,167 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// Design manager environments
+const (
+	SourceEnvironmentDraft     = "draft"
+	SourceEnvironmentPublished = "published"
+)
+
+// SourceMetadata describes a file or folder in the design manager
+type SourceMetadata struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Folder bool   `json:"folder"`
+	// Children are the names of a folder's entries
+	Children  []string `json:"children,omitempty"`
+	Hash      string   `json:"hash,omitempty"`
+	CreatedAt int64    `json:"createdAt,omitempty"`
+	UpdatedAt int64    `json:"updatedAt,omitempty"`
+}
+
+// sourceURL builds a source code API URL for a design manager path
+func (c *Client) sourceURL(environment, kind, p string) (string, error) {
+	switch environment {
+	case SourceEnvironmentDraft, SourceEnvironmentPublished:
+	default:
+		return "", fmt.Errorf("environment must be %s or %s, got %q", SourceEnvironmentDraft, SourceEnvironmentPublished, environment)
+	}
+	if strings.Trim(p, "/") == "" && kind != "metadata" {
+		return "", fmt.Errorf("path is required")
+	}
+	return fmt.Sprintf("%s/cms/v3/source-code/%s/%s/%s", c.BaseURL, environment, kind, escapePath(p)), nil
+}
+
+// sourceFileBody builds the multipart body that uploads and validation send
+func sourceFileBody(p string, content io.Reader) ([]byte, string, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile("file", path.Base(p))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to build request: %w", err)
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", p, err)
+	}
+	if err := mw.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to build request: %w", err)
+	}
+	return buf.Bytes(), mw.FormDataContentType(), nil
+}
+
+// GetSourceMetadata describes the file or folder at a design manager path;
+// an empty path is the root folder
+func (c *Client) GetSourceMetadata(ctx context.Context, environment, p string) (*SourceMetadata, error) {
+	url, err := c.sourceURL(environment, "metadata", p)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SourceMetadata
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DownloadSource returns the content of a design manager file
+func (c *Client) DownloadSource(ctx context.Context, environment, p string) ([]byte, error) {
+	url, err := c.sourceURL(environment, "content", p)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.get(ctx, url)
+}
+
+// UploadSource creates or replaces a design manager file
+func (c *Client) UploadSource(ctx context.Context, environment, p string, content io.Reader) (*SourceMetadata, error) {
+	url, err := c.sourceURL(environment, "content", p)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, contentType, err := sourceFileBody(p, content)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.putMultipart(ctx, url, reqBody, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SourceMetadata
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse upload response: %w", err)
+		}
+	}
+
+	return &result, nil
+}
+
+// DeleteSource deletes a design manager file or folder
+func (c *Client) DeleteSource(ctx context.Context, environment, p string) error {
+	url, err := c.sourceURL(environment, "content", p)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.delete(ctx, url)
+	return err
+}
+
+// ValidateSource checks a file's HubL and JSON as if it were uploaded to
+// path, without saving it. It returns the problems HubSpot found, which are
+// empty when the file is valid.
+func (c *Client) ValidateSource(ctx context.Context, environment, p string, content io.Reader) ([]string, error) {
+	url, err := c.sourceURL(environment, "validate", p)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, contentType, err := sourceFileBody(p, content)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, body, err := c.send(ctx, http.MethodPost, url, reqBody, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusBadRequest {
+		var apiErr APIError
+		_ = json.Unmarshal(body, &apiErr)
+		var problems []string
+		for _, d := range apiErr.Errors {
+			problems = append(problems, d.Message)
+		}
+		if len(problems) == 0 {
+			problems = append(problems, apiErr.Message)
+		}
+		return problems, nil
+	}
+	if resp.StatusCode >= 400 {
+		return nil, c.responseError(ctx, http.MethodPost, url, resp, body)
+	}
+
+	return nil, nil
+}
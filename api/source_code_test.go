@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetSourceMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/source-code/draft/metadata/my-theme/templates", r.URL.EscapedPath())
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Write([]byte(`{"id": "my-theme/templates", "name": "templates", "folder": true, "children": ["home.html", "partials"], "createdAt": 1717000000000, "updatedAt": 1717100000000}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	meta, err := client.GetSourceMetadata(context.Background(), SourceEnvironmentDraft, "/my-theme/templates/")
+	require.NoError(t, err)
+	assert.True(t, meta.Folder)
+	assert.Equal(t, []string{"home.html", "partials"}, meta.Children)
+	assert.Equal(t, int64(1717100000000), meta.UpdatedAt)
+
+	_, err = client.GetSourceMetadata(context.Background(), "staging", "my-theme")
+	assert.ErrorContains(t, err, `environment must be draft or published, got "staging"`)
+}
+
+func TestClient_DownloadSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/source-code/published/content/my-theme/templates/home%20page.html", r.URL.EscapedPath())
+		w.Write([]byte(`{% extends "./layouts/base.html" %}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	content, err := client.DownloadSource(context.Background(), SourceEnvironmentPublished, "my-theme/templates/home page.html")
+	require.NoError(t, err)
+	assert.Equal(t, `{% extends "./layouts/base.html" %}`, string(content))
+
+	_, err = client.DownloadSource(context.Background(), SourceEnvironmentDraft, "/")
+	assert.ErrorContains(t, err, "path is required")
+}
+
+func TestClient_UploadSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/source-code/draft/content/my-theme/modules/hero.module/module.html", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		files := r.MultipartForm.File["file"]
+		require.Len(t, files, 1)
+		assert.Equal(t, "module.html", files[0].Filename)
+		f, err := files[0].Open()
+		require.NoError(t, err)
+		data, _ := io.ReadAll(f)
+		assert.Equal(t, "<h1>{{ module.title }}</h1>", string(data))
+
+		w.Write([]byte(`{"id": "my-theme/modules/hero.module/module.html", "name": "module.html", "folder": false}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	meta, err := client.UploadSource(context.Background(), SourceEnvironmentDraft, "my-theme/modules/hero.module/module.html", strings.NewReader("<h1>{{ module.title }}</h1>"))
+	require.NoError(t, err)
+	assert.Equal(t, "module.html", meta.Name)
+}
+
+func TestClient_DeleteSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/source-code/draft/content/my-theme/old.css", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.DeleteSource(context.Background(), SourceEnvironmentDraft, "my-theme/old.css"))
+}
+
+func TestClient_ValidateSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/source-code/draft/validate/my-theme/templates/home.html", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		f, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		data, _ := io.ReadAll(f)
+		if strings.Contains(string(data), "{% if") && !strings.Contains(string(data), "endif") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status": "error", "message": "Template has errors", "errors": [
+				{"message": "Line 1: Unclosed tag 'if'"},
+				{"message": "Line 3: Unknown filter 'shout'"}
+			]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	problems, err := client.ValidateSource(context.Background(), SourceEnvironmentDraft, "my-theme/templates/home.html", strings.NewReader("{% if x %}"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Line 1: Unclosed tag 'if'", "Line 3: Unknown filter 'shout'"}, problems)
+
+	problems, err = client.ValidateSource(context.Background(), SourceEnvironmentDraft, "my-theme/templates/home.html", strings.NewReader("{% if x %}{% endif %}"))
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestClient_ValidateSource_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	_, err := client.ValidateSource(context.Background(), SourceEnvironmentDraft, "a.html", strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
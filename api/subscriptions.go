@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Subscription statuses reported by the communication preferences API
+const (
+	SubscriptionSubscribed    = "SUBSCRIBED"
+	SubscriptionNotSubscribed = "NOT_SUBSCRIBED"
+)
+
+// SubscriptionDefinition is a subscription type email contacts can opt in
+// to or out of
+type SubscriptionDefinition struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description,omitempty"`
+	Purpose             string `json:"purpose,omitempty"`
+	CommunicationMethod string `json:"communicationMethod,omitempty"`
+	IsActive            bool   `json:"isActive"`
+	IsDefault           bool   `json:"isDefault"`
+	IsInternal          bool   `json:"isInternal"`
+	CreatedAt           string `json:"createdAt,omitempty"`
+	UpdatedAt           string `json:"updatedAt,omitempty"`
+}
+
+// SubscriptionChange subscribes or unsubscribes an address from one
+// subscription type
+type SubscriptionChange struct {
+	EmailAddress   string `json:"emailAddress"`
+	SubscriptionID string `json:"subscriptionId"`
+	// LegalBasis records why the contact may be emailed, for portals with
+	// GDPR features enabled (e.g. CONSENT_WITH_NOTICE, LEGITIMATE_INTEREST_CLIENT)
+	LegalBasis            string `json:"legalBasis,omitempty"`
+	LegalBasisExplanation string `json:"legalBasisExplanation,omitempty"`
+}
+
+// ListSubscriptionDefinitions lists the portal's subscription types
+func (c *Client) ListSubscriptionDefinitions(ctx context.Context) ([]SubscriptionDefinition, error) {
+	reqURL := fmt.Sprintf("%s/communication-preferences/v3/definitions", c.BaseURL)
+
+	body, err := c.get(ctx, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		SubscriptionDefinitions []SubscriptionDefinition `json:"subscriptionDefinitions"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return result.SubscriptionDefinitions, nil
+}
+
+// Subscribe opts an address in to a subscription type
+func (c *Client) Subscribe(ctx context.Context, change SubscriptionChange) (*SubscriptionStatus, error) {
+	return c.changeSubscription(ctx, "subscribe", change)
+}
+
+// Unsubscribe opts an address out of a subscription type
+func (c *Client) Unsubscribe(ctx context.Context, change SubscriptionChange) (*SubscriptionStatus, error) {
+	return c.changeSubscription(ctx, "unsubscribe", change)
+}
+
+func (c *Client) changeSubscription(ctx context.Context, action string, change SubscriptionChange) (*SubscriptionStatus, error) {
+	if change.EmailAddress == "" {
+		return nil, fmt.Errorf("email address is required")
+	}
+	if change.SubscriptionID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	reqURL := fmt.Sprintf("%s/communication-preferences/v3/%s", c.BaseURL, action)
+
+	body, err := c.post(ctx, reqURL, change)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SubscriptionStatus
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListSubscriptionDefinitions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/communication-preferences/v3/definitions", r.URL.Path)
+		w.Write([]byte(`{"subscriptionDefinitions": [
+			{"id": "123", "name": "Newsletter", "purpose": "Marketing", "communicationMethod": "Email", "isActive": true}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	defs, err := client.ListSubscriptionDefinitions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	assert.Equal(t, "Newsletter", defs[0].Name)
+	assert.True(t, defs[0].IsActive)
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	for _, action := range []string{"subscribe", "unsubscribe"} {
+		t.Run(action, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/communication-preferences/v3/"+action, r.URL.Path)
+
+				var body map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "jane@example.com", body["emailAddress"])
+				assert.Equal(t, "123", body["subscriptionId"])
+				assert.Equal(t, "CONSENT_WITH_NOTICE", body["legalBasis"])
+
+				w.Write([]byte(`{"id": "123", "name": "Newsletter", "status": "SUBSCRIBED"}`))
+			}))
+			defer server.Close()
+
+			client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+			change := SubscriptionChange{EmailAddress: "jane@example.com", SubscriptionID: "123", LegalBasis: "CONSENT_WITH_NOTICE"}
+			var status *SubscriptionStatus
+			var err error
+			if action == "subscribe" {
+				status, err = client.Subscribe(context.Background(), change)
+			} else {
+				status, err = client.Unsubscribe(context.Background(), change)
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Newsletter", status.Name)
+		})
+	}
+
+	t.Run("missing subscription", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.Subscribe(context.Background(), SubscriptionChange{EmailAddress: "jane@example.com"})
+		assert.ErrorContains(t, err, "subscription ID is required")
+	})
+}
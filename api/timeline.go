@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TimelineEventTemplate defines the timeline cards an app can add to CRM
+// records: the markdown they show, with {{token}} placeholders, and the
+// tokens each event fills in
+type TimelineEventTemplate struct {
+	ID             string               `json:"id,omitempty"`
+	Name           string               `json:"name"`
+	ObjectType     string               `json:"objectType"`
+	HeaderTemplate string               `json:"headerTemplate,omitempty"`
+	DetailTemplate string               `json:"detailTemplate,omitempty"`
+	Tokens         []TimelineEventToken `json:"tokens"`
+	CreatedAt      string               `json:"createdAt,omitempty"`
+	UpdatedAt      string               `json:"updatedAt,omitempty"`
+}
+
+// TimelineEventToken is a value a timeline event carries. Type is string,
+// number, date, or enumeration; enumerations list their Options.
+// ObjectPropertyName copies the value to a property of the record.
+type TimelineEventToken struct {
+	Name               string                     `json:"name"`
+	Label              string                     `json:"label"`
+	Type               string                     `json:"type"`
+	Options            []TimelineEventTokenOption `json:"options,omitempty"`
+	ObjectPropertyName string                     `json:"objectPropertyName,omitempty"`
+}
+
+// TimelineEventTokenOption is one value of an enumeration token
+type TimelineEventTokenOption struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// TimelineEventTemplateList is the list of an app's event templates
+type TimelineEventTemplateList struct {
+	Results []TimelineEventTemplate `json:"results"`
+}
+
+// TimelineEvent is one timeline card added to a record. The record is given
+// by ObjectID, or for contacts by Email or UTK (the hubspotutk cookie); a
+// zero Timestamp is now.
+type TimelineEvent struct {
+	ID              string            `json:"id,omitempty"`
+	EventTemplateID string            `json:"eventTemplateId"`
+	ObjectID        string            `json:"objectId,omitempty"`
+	Email           string            `json:"email,omitempty"`
+	UTK             string            `json:"utk,omitempty"`
+	Timestamp       *time.Time        `json:"timestamp,omitempty"`
+	Tokens          map[string]string `json:"tokens,omitempty"`
+	ObjectType      string            `json:"objectType,omitempty"`
+	CreatedAt       string            `json:"createdAt,omitempty"`
+}
+
+// timelineTemplatesURL returns the URL of an app's event templates resource,
+// authenticated with the developer API key
+func (c *Client) timelineTemplatesURL(appID, templateID string) (string, error) {
+	if appID == "" {
+		return "", fmt.Errorf("app ID is required")
+	}
+	if c.DeveloperAPIKey == "" {
+		return "", ErrDeveloperAPIKeyRequired
+	}
+	url := fmt.Sprintf("%s/crm/v3/timeline/%s/event-templates", c.BaseURL, appID)
+	if templateID != "" {
+		url += "/" + templateID
+	}
+	return buildURL(url, map[string]string{"hapikey": c.DeveloperAPIKey}), nil
+}
+
+// ListTimelineEventTemplates retrieves an app's timeline event templates
+func (c *Client) ListTimelineEventTemplates(ctx context.Context, appID string) (*TimelineEventTemplateList, error) {
+	url, err := c.timelineTemplatesURL(appID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TimelineEventTemplateList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetTimelineEventTemplate retrieves one of an app's event templates
+func (c *Client) GetTimelineEventTemplate(ctx context.Context, appID, templateID string) (*TimelineEventTemplate, error) {
+	if templateID == "" {
+		return nil, fmt.Errorf("template ID is required")
+	}
+
+	url, err := c.timelineTemplatesURL(appID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TimelineEventTemplate
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateTimelineEventTemplate creates an event template for an app
+func (c *Client) CreateTimelineEventTemplate(ctx context.Context, appID string, template TimelineEventTemplate) (*TimelineEventTemplate, error) {
+	if template.Name == "" {
+		return nil, fmt.Errorf("template name is required")
+	}
+
+	url, err := c.timelineTemplatesURL(appID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.post(ctx, url, template)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TimelineEventTemplate
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateTimelineEventTemplate replaces an event template. Tokens left out
+// of template are removed from it, so callers start from the current one.
+func (c *Client) UpdateTimelineEventTemplate(ctx context.Context, appID string, template TimelineEventTemplate) (*TimelineEventTemplate, error) {
+	if template.ID == "" {
+		return nil, fmt.Errorf("template ID is required")
+	}
+
+	url, err := c.timelineTemplatesURL(appID, template.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.put(ctx, url, template)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TimelineEventTemplate
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateTimelineEvent adds a timeline card to a record. Unlike templates,
+// events are created with the portal's access token.
+func (c *Client) CreateTimelineEvent(ctx context.Context, event TimelineEvent) (*TimelineEvent, error) {
+	if event.EventTemplateID == "" {
+		return nil, fmt.Errorf("event template ID is required")
+	}
+	if event.ObjectID == "" && event.Email == "" && event.UTK == "" {
+		return nil, fmt.Errorf("an object ID, email, or utk is required")
+	}
+
+	url := fmt.Sprintf("%s/crm/v3/timeline/events", c.BaseURL)
+
+	body, err := c.post(ctx, url, event)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TimelineEvent
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
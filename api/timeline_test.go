@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListTimelineEventTemplates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/timeline/123/event-templates", r.URL.Path)
+		assert.Equal(t, "dev-key", r.URL.Query().Get("hapikey"))
+
+		w.Write([]byte(`{"results": [{
+			"id": "1001",
+			"name": "Webinar registration",
+			"objectType": "contacts",
+			"headerTemplate": "Registered for {{webinarName}}",
+			"tokens": [{"name": "webinarName", "label": "Webinar", "type": "string"}]
+		}]}`))
+	}))
+	defer server.Close()
+
+	result, err := newWebhooksTestClient(server).ListTimelineEventTemplates(context.Background(), "123")
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "1001", result.Results[0].ID)
+	require.Len(t, result.Results[0].Tokens, 1)
+	assert.Equal(t, "webinarName", result.Results[0].Tokens[0].Name)
+}
+
+func TestClient_TimelineEventTemplates_RequireDeveloperKey(t *testing.T) {
+	client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+
+	_, err := client.ListTimelineEventTemplates(context.Background(), "123")
+	assert.ErrorIs(t, err, ErrDeveloperAPIKeyRequired)
+
+	client = &Client{BaseURL: "http://unused", DeveloperAPIKey: "dev-key"}
+	_, err = client.ListTimelineEventTemplates(context.Background(), "")
+	assert.ErrorContains(t, err, "app ID is required")
+}
+
+func TestClient_CreateTimelineEventTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/crm/v3/timeline/123/event-templates", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Webinar registration", body["name"])
+		assert.Equal(t, "contacts", body["objectType"])
+		assert.NotContains(t, body, "id")
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "1001", "name": "Webinar registration", "objectType": "contacts", "tokens": []}`))
+	}))
+	defer server.Close()
+
+	template, err := newWebhooksTestClient(server).CreateTimelineEventTemplate(context.Background(), "123", TimelineEventTemplate{
+		Name:       "Webinar registration",
+		ObjectType: "contacts",
+		Tokens:     []TimelineEventToken{{Name: "webinarName", Label: "Webinar", Type: "string"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1001", template.ID)
+}
+
+func TestClient_UpdateTimelineEventTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/crm/v3/timeline/123/event-templates/1001", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "1001", body["id"])
+		assert.Equal(t, "Attended {{webinarName}}", body["headerTemplate"])
+
+		w.Write([]byte(`{"id": "1001", "name": "Webinar attendance", "headerTemplate": "Attended {{webinarName}}"}`))
+	}))
+	defer server.Close()
+
+	template, err := newWebhooksTestClient(server).UpdateTimelineEventTemplate(context.Background(), "123", TimelineEventTemplate{
+		ID:             "1001",
+		Name:           "Webinar attendance",
+		HeaderTemplate: "Attended {{webinarName}}",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Webinar attendance", template.Name)
+}
+
+func TestClient_CreateTimelineEvent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/crm/v3/timeline/events", r.URL.Path)
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			assert.Empty(t, r.URL.Query().Get("hapikey"))
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "1001", body["eventTemplateId"])
+			assert.Equal(t, "555", body["objectId"])
+			assert.Equal(t, map[string]interface{}{"webinarName": "Q3 launch"}, body["tokens"])
+			assert.NotContains(t, body, "timestamp")
+
+			w.Write([]byte(`{"id": "ev-1", "eventTemplateId": "1001", "objectType": "contacts", "objectId": "555"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		event, err := client.CreateTimelineEvent(context.Background(), TimelineEvent{
+			EventTemplateID: "1001",
+			ObjectID:        "555",
+			Tokens:          map[string]string{"webinarName": "Q3 launch"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ev-1", event.ID)
+		assert.Equal(t, "contacts", event.ObjectType)
+	})
+
+	t.Run("requires a record", func(t *testing.T) {
+		client := &Client{BaseURL: "http://unused", AccessToken: "test-token"}
+		_, err := client.CreateTimelineEvent(context.Background(), TimelineEvent{EventTemplateID: "1001"})
+		assert.ErrorContains(t, err, "an object ID, email, or utk is required")
+	})
+}
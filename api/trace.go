@@ -0,0 +1,285 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OTLP span kinds and status codes
+const (
+	spanKindInternal = 1
+	spanKindClient   = 3
+
+	spanStatusError = 2
+)
+
+// Tracer records a span for every API request of a CLI run and exports them,
+// under a span for the command, to an OpenTelemetry collector as OTLP/HTTP
+// JSON. Clients created with the same tracer share its trace. It is safe for
+// concurrent use; a nil tracer records nothing.
+type Tracer struct {
+	// Endpoint is the collector's OTLP/HTTP base URL; spans are posted to
+	// its /v1/traces path
+	Endpoint string
+	// Headers are sent with the export, e.g. an API key for a hosted
+	// collector
+	Headers     map[string]string
+	ServiceName string
+	Version     string
+	HTTPClient  *http.Client
+
+	traceID string
+	rootID  string
+
+	mu    sync.Mutex
+	spans []otlpSpan
+}
+
+// NewTracer creates a tracer exporting to endpoint
+func NewTracer(endpoint, serviceName, version string) *Tracer {
+	return &Tracer{
+		Endpoint:    endpoint,
+		ServiceName: serviceName,
+		Version:     version,
+		traceID:     randomID(16),
+		rootID:      randomID(8),
+	}
+}
+
+// TraceID returns the hex ID of the run's trace
+func (t *Tracer) TraceID() string {
+	if t == nil {
+		return ""
+	}
+	return t.traceID
+}
+
+// recordRequest records the span of one API request. attempts counts the
+// requests sent, so retries are attempts-1; resp is nil when no response
+// was received. redact hides credentials in the path and error text before
+// they leave for the collector.
+func (t *Tracer) recordRequest(method, urlStr string, start time.Time, attempts int, resp *http.Response, err error, redact func(string) string) {
+	if t == nil {
+		return
+	}
+
+	attrs := []otlpAttribute{
+		stringAttribute("http.request.method", method),
+		intAttribute("http.request.resend_count", max(attempts-1, 0)),
+	}
+	if u, perr := url.Parse(urlStr); perr == nil {
+		attrs = append(attrs, stringAttribute("server.address", u.Hostname()), stringAttribute("url.path", redact(u.Path)))
+	}
+
+	var status *otlpStatus
+	switch {
+	case err != nil:
+		attrs = append(attrs, stringAttribute("error.type", fmt.Sprintf("%T", err)))
+		status = &otlpStatus{Code: spanStatusError, Message: redact(err.Error())}
+	case resp != nil:
+		attrs = append(attrs, intAttribute("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= 400 {
+			attrs = append(attrs, stringAttribute("error.type", strconv.Itoa(resp.StatusCode)))
+			status = &otlpStatus{Code: spanStatusError}
+		}
+	}
+
+	t.add(otlpSpan{
+		TraceID:      t.traceID,
+		SpanID:       randomID(8),
+		ParentSpanID: t.rootID,
+		Name:         method,
+		Kind:         spanKindClient,
+		Start:        unixNano(start),
+		End:          unixNano(time.Now()),
+		Attributes:   attrs,
+		Status:       status,
+	})
+}
+
+func (t *Tracer) add(s otlpSpan) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, s)
+}
+
+// Export records the command's span, named after it and covering the run
+// from start, as the parent of the request spans, and posts the trace to
+// the collector
+func (t *Tracer) Export(ctx context.Context, command string, start time.Time, cmdErr error) error {
+	if t == nil {
+		return nil
+	}
+
+	root := otlpSpan{
+		TraceID:    t.traceID,
+		SpanID:     t.rootID,
+		Name:       command,
+		Kind:       spanKindInternal,
+		Start:      unixNano(start),
+		End:        unixNano(time.Now()),
+		Attributes: []otlpAttribute{stringAttribute("hspt.command", command)},
+	}
+	if cmdErr != nil {
+		root.Status = &otlpStatus{Code: spanStatusError, Message: cmdErr.Error()}
+	}
+
+	t.mu.Lock()
+	spans := append([]otlpSpan{root}, t.spans...)
+	t.mu.Unlock()
+
+	payload := otlpTraces{ResourceSpans: []otlpResourceSpans{{
+		Resource: otlpResource{Attributes: []otlpAttribute{
+			stringAttribute("service.name", t.ServiceName),
+			stringAttribute("service.version", t.Version),
+		}},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{Name: "github.com/open-cli-collective/hubspot-cli", Version: t.Version},
+			Spans: spans,
+		}},
+	}}}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode trace: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tracesURL(t.Endpoint), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create trace export request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range t.Headers {
+		req.Header.Set(k, v)
+	}
+
+	client := t.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export trace: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to export trace: collector returned %s", resp.Status)
+	}
+	return nil
+}
+
+// tracesURL returns the OTLP/HTTP traces URL of a collector base URL, which
+// may already end in /v1/traces
+func tracesURL(endpoint string) string {
+	endpoint = strings.TrimRight(endpoint, "/")
+	if strings.HasSuffix(endpoint, "/v1/traces") {
+		return endpoint
+	}
+	return endpoint + "/v1/traces"
+}
+
+// ParseOTLPHeaders reads headers in the OTEL_EXPORTER_OTLP_HEADERS format,
+// comma-separated key=value pairs with URL-encoded values
+func ParseOTLPHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid OTLP header %q (want key=value)", pair)
+		}
+		value, err := url.QueryUnescape(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid OTLP header %q: %w", pair, err)
+		}
+		headers[strings.TrimSpace(k)] = value
+	}
+	return headers, nil
+}
+
+func randomID(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// OTLP/HTTP JSON encoding of a trace. IDs are hex and 64-bit integers are
+// strings, as the protocol's JSON mapping requires.
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID      string          `json:"traceId"`
+	SpanID       string          `json:"spanId"`
+	ParentSpanID string          `json:"parentSpanId,omitempty"`
+	Name         string          `json:"name"`
+	Kind         int             `json:"kind"`
+	Start        string          `json:"startTimeUnixNano"`
+	End          string          `json:"endTimeUnixNano"`
+	Attributes   []otlpAttribute `json:"attributes,omitempty"`
+	Status       *otlpStatus     `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int) otlpAttribute {
+	s := strconv.Itoa(value)
+	return otlpAttribute{Key: key, Value: otlpValue{IntValue: &s}}
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// attributes flattens span attributes for assertions
+func attributes(s otlpSpan) map[string]string {
+	attrs := make(map[string]string)
+	for _, a := range s.Attributes {
+		switch {
+		case a.Value.StringValue != nil:
+			attrs[a.Key] = *a.Value.StringValue
+		case a.Value.IntValue != nil:
+			attrs[a.Key] = *a.Value.IntValue
+		}
+	}
+	return attrs
+}
+
+func TestTracer_Export(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var received otlpTraces
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(collector.URL+"/", "hspt", "1.2.3")
+	tracer.Headers = map[string]string{"X-Api-Key": "secret"}
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client(), MaxRetries: 2, Tracer: tracer, sleep: noSleep}
+
+	_, err := client.get(context.Background(), server.URL+"/crm/v3/objects/contacts?limit=1")
+	require.NoError(t, err)
+
+	start := time.Now().Add(-time.Second)
+	require.NoError(t, tracer.Export(context.Background(), "hspt contacts list", start, nil))
+
+	require.Len(t, received.ResourceSpans, 1)
+	rs := received.ResourceSpans[0]
+	assert.Equal(t, map[string]string{"service.name": "hspt", "service.version": "1.2.3"}, attributes(otlpSpan{Attributes: rs.Resource.Attributes}))
+
+	spans := rs.ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+
+	root, request := spans[0], spans[1]
+	assert.Equal(t, "hspt contacts list", root.Name)
+	assert.Equal(t, spanKindInternal, root.Kind)
+	assert.Empty(t, root.ParentSpanID)
+	assert.Nil(t, root.Status)
+	assert.Len(t, root.TraceID, 32)
+
+	assert.Equal(t, "GET", request.Name)
+	assert.Equal(t, spanKindClient, request.Kind)
+	assert.Equal(t, root.TraceID, request.TraceID)
+	assert.Equal(t, root.SpanID, request.ParentSpanID)
+	assert.Len(t, request.SpanID, 16)
+	assert.LessOrEqual(t, request.Start, request.End)
+	assert.Nil(t, request.Status)
+
+	attrs := attributes(request)
+	assert.Equal(t, "GET", attrs["http.request.method"])
+	assert.Equal(t, "200", attrs["http.response.status_code"])
+	assert.Equal(t, "1", attrs["http.request.resend_count"])
+	assert.Equal(t, "/crm/v3/objects/contacts", attrs["url.path"])
+	assert.Equal(t, "127.0.0.1", attrs["server.address"])
+}
+
+// noRedact leaves span text unchanged
+func noRedact(s string) string { return s }
+
+func TestTracer_recordRequest_Error(t *testing.T) {
+	tracer := NewTracer("http://localhost:4318", "hspt", "dev")
+
+	tracer.recordRequest(http.MethodPost, "https://api.hubapi.com/crm/v3/objects/deals", time.Now(), 1, &http.Response{StatusCode: http.StatusNotFound}, nil, noRedact)
+	tracer.recordRequest(http.MethodGet, "https://api.hubapi.com/crm/v3/objects/deals", time.Now(), 1, nil, context.DeadlineExceeded, noRedact)
+
+	require.Len(t, tracer.spans, 2)
+	assert.Equal(t, &otlpStatus{Code: spanStatusError}, tracer.spans[0].Status)
+	assert.Equal(t, "404", attributes(tracer.spans[0])["error.type"])
+	assert.Equal(t, spanStatusError, tracer.spans[1].Status.Code)
+	assert.Equal(t, "context deadline exceeded", tracer.spans[1].Status.Message)
+	assert.NotContains(t, attributes(tracer.spans[1]), "http.response.status_code")
+}
+
+func TestTracer_RedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var exported []byte
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exported, _ = io.ReadAll(r.Body)
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(collector.URL, "hspt", "dev")
+	client := &Client{BaseURL: server.URL, AccessToken: "oauth-token-123", DeveloperAPIKey: "dev-key-456", HTTPClient: server.Client(), Tracer: tracer}
+
+	// Token introspection puts the access token in the path
+	_, err := client.get(context.Background(), server.URL+"/oauth/v1/access-tokens/oauth-token-123")
+	require.NoError(t, err)
+
+	// A failed request's error names the URL, with the developer key
+	unreachable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	unreachable.Close()
+	client.HTTPClient = unreachable.Client()
+	_, err = client.get(context.Background(), unreachable.URL+"/webhooks/v3/1/settings?hapikey=dev-key-456")
+	require.Error(t, err)
+
+	require.NoError(t, tracer.Export(context.Background(), "hspt auth whoami", time.Now(), nil))
+
+	require.NotEmpty(t, exported)
+	assert.NotContains(t, string(exported), "oauth-token-123")
+	assert.NotContains(t, string(exported), "dev-key-456")
+	assert.Contains(t, string(exported), "/oauth/v1/access-tokens/REDACTED")
+	assert.Contains(t, string(exported), "hapikey=REDACTED")
+}
+
+func TestTracer_Export_CollectorError(t *testing.T) {
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(collector.URL+"/v1/traces", "hspt", "dev")
+	err := tracer.Export(context.Background(), "hspt", time.Now(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestTracer_Nil(t *testing.T) {
+	var tracer *Tracer
+	tracer.recordRequest(http.MethodGet, "https://api.hubapi.com", time.Now(), 1, nil, nil, noRedact)
+	assert.NoError(t, tracer.Export(context.Background(), "hspt", time.Now(), nil))
+	assert.Empty(t, tracer.TraceID())
+}
+
+func TestTracesURL(t *testing.T) {
+	assert.Equal(t, "http://localhost:4318/v1/traces", tracesURL("http://localhost:4318"))
+	assert.Equal(t, "http://localhost:4318/v1/traces", tracesURL("http://localhost:4318/"))
+	assert.Equal(t, "https://otel.example.com/v1/traces", tracesURL("https://otel.example.com/v1/traces"))
+}
+
+func TestParseOTLPHeaders(t *testing.T) {
+	headers, err := ParseOTLPHeaders("api-key=abc%3D%3D, x-team = growth ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"api-key": "abc==", "x-team": "growth"}, headers)
+
+	headers, err = ParseOTLPHeaders("")
+	require.NoError(t, err)
+	assert.Empty(t, headers)
+
+	_, err = ParseOTLPHeaders("novalue")
+	assert.Error(t, err)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// URLRedirect is a CMS URL redirect, sending visitors of RoutePrefix to
+// Destination
+type URLRedirect struct {
+	ID                      string `json:"id"`
+	RoutePrefix             string `json:"routePrefix"`
+	Destination             string `json:"destination"`
+	RedirectStyle           int    `json:"redirectStyle"`
+	Precedence              int    `json:"precedence,omitempty"`
+	IsOnlyAfterNotFound     bool   `json:"isOnlyAfterNotFound"`
+	IsMatchFullURL          bool   `json:"isMatchFullUrl"`
+	IsMatchQueryString      bool   `json:"isMatchQueryString"`
+	IsPattern               bool   `json:"isPattern"`
+	IsTrailingSlashOptional bool   `json:"isTrailingSlashOptional"`
+	IsProtocolAgnostic      bool   `json:"isProtocolAgnostic"`
+	CreatedAt               string `json:"created,omitempty"`
+	UpdatedAt               string `json:"updated,omitempty"`
+}
+
+// URLRedirectList represents a paginated list of URL redirects
+type URLRedirectList struct {
+	Results []URLRedirect `json:"results"`
+	Paging  *Paging       `json:"paging,omitempty"`
+	Total   int           `json:"total,omitempty"`
+}
+
+// ListURLRedirects retrieves URL redirects with pagination
+func (c *Client) ListURLRedirects(ctx context.Context, opts ListOptions) (*URLRedirectList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]URLRedirect, *Paging, error) {
+			page, err := c.ListURLRedirects(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &URLRedirectList{Results: results, Paging: paging}, nil
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/url-redirects", c.BaseURL)
+
+	params := make(map[string]string)
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.After != "" {
+		params["after"] = opts.After
+	}
+
+	if len(params) > 0 {
+		url = buildURL(url, params)
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result URLRedirectList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse URL redirects response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetURLRedirect retrieves a single URL redirect by ID
+func (c *Client) GetURLRedirect(ctx context.Context, redirectID string) (*URLRedirect, error) {
+	if redirectID == "" {
+		return nil, fmt.Errorf("redirect ID is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/url-redirects/%s", c.BaseURL, redirectID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result URLRedirect
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse URL redirect response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateURLRedirect creates a URL redirect. The redirect needs at least a
+// routePrefix, a destination, and a redirectStyle.
+func (c *Client) CreateURLRedirect(ctx context.Context, redirect map[string]interface{}) (*URLRedirect, error) {
+	url := fmt.Sprintf("%s/cms/v3/url-redirects", c.BaseURL)
+
+	body, err := c.post(ctx, url, redirect)
+	if err != nil {
+		return nil, err
+	}
+
+	var result URLRedirect
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse URL redirect response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateURLRedirect updates the given fields of a URL redirect
+func (c *Client) UpdateURLRedirect(ctx context.Context, redirectID string, updates map[string]interface{}) (*URLRedirect, error) {
+	if redirectID == "" {
+		return nil, fmt.Errorf("redirect ID is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/url-redirects/%s", c.BaseURL, redirectID)
+
+	body, err := c.patch(ctx, url, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	var result URLRedirect
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse URL redirect response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteURLRedirect permanently deletes a URL redirect
+func (c *Client) DeleteURLRedirect(ctx context.Context, redirectID string) error {
+	if redirectID == "" {
+		return fmt.Errorf("redirect ID is required")
+	}
+
+	url := fmt.Sprintf("%s/cms/v3/url-redirects/%s", c.BaseURL, redirectID)
+
+	_, err := c.delete(ctx, url)
+	return err
+}
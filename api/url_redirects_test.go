@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListURLRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/url-redirects", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+		assert.Equal(t, "cursor", r.URL.Query().Get("after"))
+
+		w.Write([]byte(`{
+			"results": [
+				{
+					"id": "101",
+					"routePrefix": "/old-blog",
+					"destination": "/blog",
+					"redirectStyle": 301,
+					"precedence": 10,
+					"isOnlyAfterNotFound": true,
+					"isMatchFullUrl": false,
+					"isPattern": false,
+					"created": "2024-01-15T10:00:00Z"
+				}
+			],
+			"paging": {"next": {"after": "next-page"}}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	result, err := client.ListURLRedirects(context.Background(), ListOptions{Limit: 20, After: "cursor"})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	redirect := result.Results[0]
+	assert.Equal(t, "101", redirect.ID)
+	assert.Equal(t, "/old-blog", redirect.RoutePrefix)
+	assert.Equal(t, "/blog", redirect.Destination)
+	assert.Equal(t, 301, redirect.RedirectStyle)
+	assert.True(t, redirect.IsOnlyAfterNotFound)
+	assert.Equal(t, "next-page", result.Paging.Next.After)
+}
+
+func TestClient_GetURLRedirect(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/cms/v3/url-redirects/101", r.URL.Path)
+			w.Write([]byte(`{"id": "101", "routePrefix": "/a", "destination": "/b", "redirectStyle": 302}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		redirect, err := client.GetURLRedirect(context.Background(), "101")
+		require.NoError(t, err)
+		assert.Equal(t, 302, redirect.RedirectStyle)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status": "error", "message": "Not found"}`))
+		}))
+		defer server.Close()
+
+		client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+		_, err := client.GetURLRedirect(context.Background(), "999")
+		require.Error(t, err)
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		client := &Client{BaseURL: "https://api.hubapi.com"}
+		_, err := client.GetURLRedirect(context.Background(), "")
+		assert.EqualError(t, err, "redirect ID is required")
+	})
+}
+
+func TestClient_CreateURLRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/url-redirects", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, "/old", req["routePrefix"])
+		assert.Equal(t, "/new", req["destination"])
+		assert.Equal(t, float64(301), req["redirectStyle"])
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "202", "routePrefix": "/old", "destination": "/new", "redirectStyle": 301}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	redirect, err := client.CreateURLRedirect(context.Background(), map[string]interface{}{
+		"routePrefix":   "/old",
+		"destination":   "/new",
+		"redirectStyle": 301,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "202", redirect.ID)
+}
+
+func TestClient_UpdateURLRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/url-redirects/202", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"destination": "/newer"}`, string(body))
+
+		w.Write([]byte(`{"id": "202", "routePrefix": "/old", "destination": "/newer", "redirectStyle": 301}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	redirect, err := client.UpdateURLRedirect(context.Background(), "202", map[string]interface{}{"destination": "/newer"})
+	require.NoError(t, err)
+	assert.Equal(t, "/newer", redirect.Destination)
+
+	_, err = client.UpdateURLRedirect(context.Background(), "", nil)
+	assert.EqualError(t, err, "redirect ID is required")
+}
+
+func TestClient_DeleteURLRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/url-redirects/202", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.DeleteURLRedirect(context.Background(), "202"))
+	assert.EqualError(t, client.DeleteURLRedirect(context.Background(), ""), "redirect ID is required")
+}
@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// User is a portal user from the user provisioning API
+type User struct {
+	ID               string   `json:"id"`
+	Email            string   `json:"email"`
+	FirstName        string   `json:"firstName,omitempty"`
+	LastName         string   `json:"lastName,omitempty"`
+	RoleID           string   `json:"roleId,omitempty"`
+	RoleIDs          []string `json:"roleIds,omitempty"`
+	PrimaryTeamID    string   `json:"primaryTeamId,omitempty"`
+	SecondaryTeamIDs []string `json:"secondaryTeamIds,omitempty"`
+	SuperAdmin       bool     `json:"superAdmin"`
+}
+
+// TeamIDs returns the user's primary and secondary team IDs
+func (u *User) TeamIDs() []string {
+	var ids []string
+	if u.PrimaryTeamID != "" {
+		ids = append(ids, u.PrimaryTeamID)
+	}
+	return append(ids, u.SecondaryTeamIDs...)
+}
+
+// UserList represents a paginated list of portal users
+type UserList struct {
+	Results []User  `json:"results"`
+	Paging  *Paging `json:"paging,omitempty"`
+}
+
+// Team is a team of portal users
+type Team struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	UserIDs          []string `json:"userIds"`
+	SecondaryUserIDs []string `json:"secondaryUserIds"`
+}
+
+// Role is a user role defined in the portal
+type Role struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	RequiresBillingWrite bool   `json:"requiresBillingWrite"`
+}
+
+// userURL returns the URL of a portal user given by user ID or, when user
+// contains "@", by email address
+func (c *Client) userURL(user string) string {
+	urlStr := fmt.Sprintf("%s/settings/v3/users/%s", c.BaseURL, url.PathEscape(user))
+	if strings.Contains(user, "@") {
+		urlStr = buildURL(urlStr, map[string]string{"idProperty": "EMAIL"})
+	}
+	return urlStr
+}
+
+// ListUsers retrieves the portal's users with pagination
+func (c *Client) ListUsers(ctx context.Context, opts ListOptions) (*UserList, error) {
+	if opts.All {
+		results, paging, err := listAll(opts, func(o ListOptions) ([]User, *Paging, error) {
+			page, err := c.ListUsers(ctx, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Results, page.Paging, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &UserList{Results: results, Paging: paging}, nil
+	}
+
+	url := fmt.Sprintf("%s/settings/v3/users", c.BaseURL)
+
+	params := make(map[string]string)
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.After != "" {
+		params["after"] = opts.After
+	}
+
+	if len(params) > 0 {
+		url = buildURL(url, params)
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse users response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetUser retrieves a portal user by user ID or, when user contains "@", by
+// email address
+func (c *Client) GetUser(ctx context.Context, user string) (*User, error) {
+	if user == "" {
+		return nil, fmt.Errorf("user ID or email is required")
+	}
+
+	body, err := c.get(ctx, c.userURL(user))
+	if err != nil {
+		return nil, err
+	}
+
+	var result User
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse user response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateUser adds a user to the portal. The user needs at least an email;
+// sendWelcomeEmail controls whether HubSpot emails them an invitation.
+func (c *Client) CreateUser(ctx context.Context, user map[string]interface{}) (*User, error) {
+	url := fmt.Sprintf("%s/settings/v3/users", c.BaseURL)
+
+	body, err := c.post(ctx, url, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var result User
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse user response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateUser updates the given fields of a portal user, given by user ID or
+// email address
+func (c *Client) UpdateUser(ctx context.Context, user string, updates map[string]interface{}) (*User, error) {
+	if user == "" {
+		return nil, fmt.Errorf("user ID or email is required")
+	}
+
+	body, err := c.put(ctx, c.userURL(user), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	var result User
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse user response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteUser removes a user, given by user ID or email address, from the
+// portal
+func (c *Client) DeleteUser(ctx context.Context, user string) error {
+	if user == "" {
+		return fmt.Errorf("user ID or email is required")
+	}
+
+	_, err := c.delete(ctx, c.userURL(user))
+	return err
+}
+
+// ListRoles retrieves the portal's user roles
+func (c *Client) ListRoles(ctx context.Context) ([]Role, error) {
+	url := fmt.Sprintf("%s/settings/v3/users/roles", c.BaseURL)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Results []Role `json:"results"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse roles response: %w", err)
+	}
+
+	return result.Results, nil
+}
+
+// ListTeams retrieves the portal's teams
+func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
+	url := fmt.Sprintf("%s/settings/v3/users/teams", c.BaseURL)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Results []Team `json:"results"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse teams response: %w", err)
+	}
+
+	return result.Results, nil
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/settings/v3/users/jane@example.com":
+			assert.Equal(t, "EMAIL", r.URL.Query().Get("idProperty"))
+		case "/settings/v3/users/42":
+			assert.False(t, r.URL.Query().Has("idProperty"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`{"id": "42", "email": "jane@example.com", "roleIds": ["7"], "primaryTeamId": "100", "secondaryTeamIds": ["200"], "superAdmin": false}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	user, err := client.GetUser(context.Background(), "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "42", user.ID)
+	assert.Equal(t, []string{"7"}, user.RoleIDs)
+	assert.Equal(t, []string{"100", "200"}, user.TeamIDs())
+
+	_, err = client.GetUser(context.Background(), "42")
+	require.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestClient_ListRoles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings/v3/users/roles", r.URL.Path)
+		w.Write([]byte(`{"results": [{"id": "7", "name": "Sales Rep", "requiresBillingWrite": false}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	roles, err := client.ListRoles(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Role{{ID: "7", Name: "Sales Rep"}}, roles)
+}
+
+func TestClient_ListUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings/v3/users", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		if r.URL.Query().Get("after") == "" {
+			w.Write([]byte(`{"results": [{"id": "1", "email": "a@example.com"}, {"id": "2", "email": "b@example.com"}], "paging": {"next": {"after": "2"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "3", "email": "c@example.com", "superAdmin": true}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	page, err := client.ListUsers(context.Background(), ListOptions{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, page.Results, 2)
+	assert.Equal(t, "2", page.Paging.Next.After)
+
+	all, err := client.ListUsers(context.Background(), ListOptions{Limit: 2, All: true})
+	require.NoError(t, err)
+	require.Len(t, all.Results, 3)
+	assert.True(t, all.Results[2].SuperAdmin)
+}
+
+func TestClient_CreateUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/v3/users", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "new@example.com", body["email"])
+		assert.Equal(t, "7", body["roleId"])
+		assert.Equal(t, false, body["sendWelcomeEmail"])
+
+		w.Write([]byte(`{"id": "55", "email": "new@example.com", "roleIds": ["7"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	user, err := client.CreateUser(context.Background(), map[string]interface{}{
+		"email":            "new@example.com",
+		"roleId":           "7",
+		"sendWelcomeEmail": false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "55", user.ID)
+}
+
+func TestClient_UpdateUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/settings/v3/users/jane@example.com", r.URL.Path)
+		assert.Equal(t, "EMAIL", r.URL.Query().Get("idProperty"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "100", body["primaryTeamId"])
+
+		w.Write([]byte(`{"id": "42", "email": "jane@example.com", "primaryTeamId": "100"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	user, err := client.UpdateUser(context.Background(), "jane@example.com", map[string]interface{}{"primaryTeamId": "100"})
+	require.NoError(t, err)
+	assert.Equal(t, "100", user.PrimaryTeamID)
+
+	_, err = client.UpdateUser(context.Background(), "", nil)
+	assert.Error(t, err)
+}
+
+func TestClient_DeleteUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/settings/v3/users/42", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	require.NoError(t, client.DeleteUser(context.Background(), "42"))
+	assert.Error(t, client.DeleteUser(context.Background(), ""))
+}
+
+func TestClient_ListTeams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/settings/v3/users/teams", r.URL.Path)
+		w.Write([]byte(`{"results": [{"id": "100", "name": "EMEA Sales", "userIds": ["42"], "secondaryUserIds": ["43"]}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	teams, err := client.ListTeams(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Team{{ID: "100", Name: "EMEA Sales", UserIDs: []string{"42"}, SecondaryUserIDs: []string{"43"}}}, teams)
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Webhook throttling periods
+const (
+	WebhookPeriodSecondly      = "SECONDLY"
+	WebhookPeriodRollingMinute = "ROLLING_MINUTE"
+)
+
+// WebhookSubscription is an event an app receives webhooks for
+type WebhookSubscription struct {
+	ID           json.Number `json:"id"`
+	EventType    string      `json:"eventType"`
+	PropertyName string      `json:"propertyName,omitempty"`
+	Active       bool        `json:"active"`
+	CreatedAt    string      `json:"createdAt,omitempty"`
+	UpdatedAt    string      `json:"updatedAt,omitempty"`
+}
+
+// WebhookSubscriptionList is the list of an app's subscriptions
+type WebhookSubscriptionList struct {
+	Results []WebhookSubscription `json:"results"`
+}
+
+// WebhookSubscriptionCreate is the request body for creating a subscription
+type WebhookSubscriptionCreate struct {
+	EventType    string `json:"eventType"`
+	PropertyName string `json:"propertyName,omitempty"`
+	Active       bool   `json:"active"`
+}
+
+// WebhookThrottling limits how fast HubSpot sends webhooks to an app
+type WebhookThrottling struct {
+	MaxConcurrentRequests int    `json:"maxConcurrentRequests"`
+	Period                string `json:"period,omitempty"`
+}
+
+// WebhookSettings is where and how fast an app's webhooks are delivered
+type WebhookSettings struct {
+	TargetURL  string            `json:"targetUrl"`
+	Throttling WebhookThrottling `json:"throttling"`
+	CreatedAt  string            `json:"createdAt,omitempty"`
+	UpdatedAt  string            `json:"updatedAt,omitempty"`
+}
+
+// webhooksURL returns the URL of an app's webhooks resource, authenticated
+// with the developer API key
+func (c *Client) webhooksURL(appID, path string) (string, error) {
+	if appID == "" {
+		return "", fmt.Errorf("app ID is required")
+	}
+	if c.DeveloperAPIKey == "" {
+		return "", ErrDeveloperAPIKeyRequired
+	}
+	url := fmt.Sprintf("%s/webhooks/v3/%s/%s", c.BaseURL, appID, path)
+	return buildURL(url, map[string]string{"hapikey": c.DeveloperAPIKey}), nil
+}
+
+// ListWebhookSubscriptions retrieves an app's webhook subscriptions
+func (c *Client) ListWebhookSubscriptions(ctx context.Context, appID string) (*WebhookSubscriptionList, error) {
+	url, err := c.webhooksURL(appID, "subscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSubscriptionList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateWebhookSubscription subscribes an app to an event
+func (c *Client) CreateWebhookSubscription(ctx context.Context, appID string, req WebhookSubscriptionCreate) (*WebhookSubscription, error) {
+	if req.EventType == "" {
+		return nil, fmt.Errorf("event type is required")
+	}
+
+	url, err := c.webhooksURL(appID, "subscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSubscription
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateWebhookSubscription pauses or resumes a subscription
+func (c *Client) UpdateWebhookSubscription(ctx context.Context, appID, subscriptionID string, active bool) (*WebhookSubscription, error) {
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+
+	url, err := c.webhooksURL(appID, "subscriptions/"+subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.patch(ctx, url, map[string]bool{"active": active})
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSubscription
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteWebhookSubscription removes a subscription
+func (c *Client) DeleteWebhookSubscription(ctx context.Context, appID, subscriptionID string) error {
+	if subscriptionID == "" {
+		return fmt.Errorf("subscription ID is required")
+	}
+
+	url, err := c.webhooksURL(appID, "subscriptions/"+subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.delete(ctx, url)
+	return err
+}
+
+// GetWebhookSettings retrieves an app's webhook target URL and throttling
+func (c *Client) GetWebhookSettings(ctx context.Context, appID string) (*WebhookSettings, error) {
+	url, err := c.webhooksURL(appID, "settings")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSettings
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateWebhookSettings sets an app's webhook target URL and throttling
+func (c *Client) UpdateWebhookSettings(ctx context.Context, appID string, settings WebhookSettings) (*WebhookSettings, error) {
+	if settings.TargetURL == "" {
+		return nil, fmt.Errorf("target URL is required")
+	}
+
+	url, err := c.webhooksURL(appID, "settings")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.put(ctx, url, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSettings
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
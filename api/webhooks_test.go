@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWebhooksTestClient(server *httptest.Server) *Client {
+	return &Client{
+		BaseURL:         server.URL,
+		HTTPClient:      server.Client(),
+		DeveloperAPIKey: "dev-key",
+	}
+}
+
+func TestClient_ListWebhookSubscriptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webhooks/v3/123/subscriptions", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "dev-key", r.URL.Query().Get("hapikey"))
+		assert.Empty(t, r.Header.Get("Authorization"), "no bearer token without an access token")
+
+		w.Write([]byte(`{"results": [
+			{"id": 4, "eventType": "contact.propertyChange", "propertyName": "email", "active": true},
+			{"id": "5", "eventType": "deal.creation", "active": false}
+		]}`))
+	}))
+	defer server.Close()
+
+	result, err := newWebhooksTestClient(server).ListWebhookSubscriptions(context.Background(), "123")
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "4", result.Results[0].ID.String())
+	assert.Equal(t, "email", result.Results[0].PropertyName)
+	assert.Equal(t, "5", result.Results[1].ID.String())
+	assert.False(t, result.Results[1].Active)
+}
+
+func TestClient_webhooksURL(t *testing.T) {
+	client := &Client{BaseURL: "https://api.example.com", AccessToken: "test-token"}
+
+	_, err := client.ListWebhookSubscriptions(context.Background(), "123")
+	assert.ErrorIs(t, err, ErrDeveloperAPIKeyRequired)
+
+	client.DeveloperAPIKey = "dev-key"
+	_, err = client.ListWebhookSubscriptions(context.Background(), "")
+	assert.ErrorContains(t, err, "app ID is required")
+}
+
+func TestClient_CreateWebhookSubscription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webhooks/v3/123/subscriptions", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "contact.propertyChange", body["eventType"])
+		assert.Equal(t, "email", body["propertyName"])
+		assert.Equal(t, true, body["active"])
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 9, "eventType": "contact.propertyChange", "propertyName": "email", "active": true}`))
+	}))
+	defer server.Close()
+
+	sub, err := newWebhooksTestClient(server).CreateWebhookSubscription(context.Background(), "123", WebhookSubscriptionCreate{
+		EventType:    "contact.propertyChange",
+		PropertyName: "email",
+		Active:       true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "9", sub.ID.String())
+
+	_, err = newWebhooksTestClient(server).CreateWebhookSubscription(context.Background(), "123", WebhookSubscriptionCreate{})
+	assert.ErrorContains(t, err, "event type is required")
+}
+
+func TestClient_UpdateWebhookSubscription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webhooks/v3/123/subscriptions/9", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"active": false}`, string(body))
+
+		w.Write([]byte(`{"id": 9, "eventType": "deal.creation", "active": false}`))
+	}))
+	defer server.Close()
+
+	sub, err := newWebhooksTestClient(server).UpdateWebhookSubscription(context.Background(), "123", "9", false)
+	require.NoError(t, err)
+	assert.False(t, sub.Active)
+}
+
+func TestClient_DeleteWebhookSubscription(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/webhooks/v3/123/subscriptions/9", r.URL.Path)
+			assert.Equal(t, http.MethodDelete, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := newWebhooksTestClient(server).DeleteWebhookSubscription(context.Background(), "123", "9")
+		require.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status": "error", "message": "not found"}`))
+		}))
+		defer server.Close()
+
+		err := newWebhooksTestClient(server).DeleteWebhookSubscription(context.Background(), "123", "9")
+		assert.True(t, IsNotFound(err))
+	})
+}
+
+func TestClient_WebhookSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webhooks/v3/123/settings", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"targetUrl": "https://example.com/hook", "throttling": {"maxConcurrentRequests": 10, "period": "SECONDLY"}}`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"targetUrl": "https://example.com/new", "throttling": {"maxConcurrentRequests": 20, "period": "ROLLING_MINUTE"}}`, string(body))
+			w.Write(body)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := newWebhooksTestClient(server)
+
+	settings, err := client.GetWebhookSettings(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook", settings.TargetURL)
+	assert.Equal(t, 10, settings.Throttling.MaxConcurrentRequests)
+	assert.Equal(t, WebhookPeriodSecondly, settings.Throttling.Period)
+
+	updated, err := client.UpdateWebhookSettings(context.Background(), "123", WebhookSettings{
+		TargetURL:  "https://example.com/new",
+		Throttling: WebhookThrottling{MaxConcurrentRequests: 20, Period: WebhookPeriodRollingMinute},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/new", updated.TargetURL)
+
+	_, err = client.UpdateWebhookSettings(context.Background(), "123", WebhookSettings{})
+	assert.ErrorContains(t, err, "target URL is required")
+}
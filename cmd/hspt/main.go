@@ -1,58 +1,123 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/access"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/analytics"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/apicmd"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/associations"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/auditlogs"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/auth"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/blogs"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/callingextension"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/calls"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/campaigns"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/cms"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/commercesubscriptions"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/companies"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/completion"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/configcmd"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/contacts"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/contextcmd"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/conversations"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/crm"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/crmcards"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/currencies"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/customobjects"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/deals"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/digest"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/docs"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/domains"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/emails"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/events"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/exports"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/files"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/forms"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/graphql"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/hubdb"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/imports"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/initcmd"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/invoices"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/lineitems"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/lists"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/marketingemails"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/meetings"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/notes"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/owners"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/pages"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/payments"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/pipelines"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/products"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/properties"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/quotes"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/redirects"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/schemas"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/search"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/stats"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/subscriptions"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/tasks"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/teams"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/tickets"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/timelineevents"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/upgradecmd"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/users"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/webhooks"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/workflows"
 	"github.com/open-cli-collective/hubspot-cli/internal/exitcode"
+	"github.com/open-cli-collective/hubspot-cli/internal/usage"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
+// stderr is where errors are reported; --plain makes it ASCII-only
+var stderr io.Writer = os.Stderr
+
 func main() {
-	if err := run(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
+	// Ctrl-C cancels the context, aborting in-flight requests and any
+	// pagination or retry backoff in progress
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx)
+	stop()
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			fmt.Fprintln(stderr, "interrupted")
+			os.Exit(exitcode.Interrupted)
+		}
+		if api.IsBudgetExhausted(err) {
+			fmt.Fprintln(stderr, err)
+			os.Exit(exitcode.BudgetExhausted)
+		}
+		if code, ok := exitcode.FromError(err); ok {
+			fmt.Fprintln(stderr, err)
+			os.Exit(code)
+		}
+		fmt.Fprintln(stderr, err)
 		os.Exit(exitcode.GeneralError)
 	}
 }
 
-func run() error {
+func run(ctx context.Context) error {
 	rootCmd, opts := root.NewCmd()
 
 	// Register all commands
 	initcmd.Register(rootCmd, opts)
 	configcmd.Register(rootCmd, opts)
+	auth.Register(rootCmd, opts)
 	completion.Register(rootCmd, opts)
+	docs.Register(rootCmd, opts)
+	stats.Register(rootCmd, opts)
+	digest.Register(rootCmd, opts)
+	upgradecmd.Register(rootCmd, opts)
 
 	// CRM commands
 	contacts.Register(rootCmd, opts)
@@ -60,9 +125,19 @@ func run() error {
 	deals.Register(rootCmd, opts)
 	tickets.Register(rootCmd, opts)
 	owners.Register(rootCmd, opts)
+	access.Register(rootCmd, opts)
+	users.Register(rootCmd, opts)
+	teams.Register(rootCmd, opts)
+	auditlogs.Register(rootCmd, opts)
+	currencies.Register(rootCmd, opts)
 	products.Register(rootCmd, opts)
 	lineitems.Register(rootCmd, opts)
 	quotes.Register(rootCmd, opts)
+	invoices.Register(rootCmd, opts)
+	payments.Register(rootCmd, opts)
+	commercesubscriptions.Register(rootCmd, opts)
+	crm.Register(rootCmd, opts)
+	contextcmd.Register(rootCmd, opts)
 
 	// CRM engagement commands
 	notes.Register(rootCmd, opts)
@@ -75,28 +150,59 @@ func run() error {
 	associations.Register(rootCmd, opts)
 	properties.Register(rootCmd, opts)
 	pipelines.Register(rootCmd, opts)
+	search.Register(rootCmd, opts)
 	schemas.Register(rootCmd, opts)
+	customobjects.Register(rootCmd, opts)
+	lists.Register(rootCmd, opts)
+	imports.Register(rootCmd, opts)
+	exports.Register(rootCmd, opts)
 
 	// Marketing commands
 	forms.Register(rootCmd, opts)
 	campaigns.Register(rootCmd, opts)
 	marketingemails.Register(rootCmd, opts)
+	analytics.Register(rootCmd, opts)
+	subscriptions.Register(rootCmd, opts)
+	events.Register(rootCmd, opts)
 
 	// CMS commands
 	files.Register(rootCmd, opts)
 	domains.Register(rootCmd, opts)
+	redirects.Register(rootCmd, opts)
 	pages.Register(rootCmd, opts)
 	blogs.Register(rootCmd, opts)
 	hubdb.Register(rootCmd, opts)
+	cms.Register(rootCmd, opts)
 
 	// Conversations commands
 	conversations.Register(rootCmd, opts)
 
 	// Automation commands
 	workflows.Register(rootCmd, opts)
+	webhooks.Register(rootCmd, opts)
+	timelineevents.Register(rootCmd, opts)
+	crmcards.Register(rootCmd, opts)
+	callingextension.Register(rootCmd, opts)
 
 	// GraphQL commands
 	graphql.Register(rootCmd, opts)
 
-	return rootCmd.Execute()
+	// Raw API access
+	apicmd.Register(rootCmd, opts)
+
+	start := time.Now()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	if opts.Plain {
+		stderr = view.NewASCIIWriter(os.Stderr)
+	}
+	usage.Record(cmd, time.Since(start), err)
+	opts.ExportTrace(cmd, start, err)
+
+	// Commands that stop at the budget and return what they have so far
+	// still exit non-zero so scheduled jobs notice
+	if err == nil && opts.BudgetExhausted() {
+		err = fmt.Errorf("stopped early: %w (--api-budget %d)", api.ErrBudgetExhausted, opts.APIBudget)
+	}
+
+	return err
 }
@@ -0,0 +1,293 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the access command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "Debug record visibility",
+		Long:  "Commands for working out why a user can or cannot see a record.",
+	}
+
+	cmd.AddCommand(newCheckCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// accessProperties are the record properties that decide who can see it
+var accessProperties = []string{
+	"hubspot_owner_id",
+	"hs_all_owner_ids",
+	"hubspot_team_id",
+	"hs_all_team_ids",
+	"hs_all_accessible_team_ids",
+}
+
+// Grant levels: the least a role's view or edit permission must allow for
+// the user to reach the record
+const (
+	grantSuperAdmin = "super-admin"
+	grantOwned      = "owned"
+	grantTeam       = "team"
+	grantAll        = "all"
+)
+
+// accessReport explains a user's access to a record
+type accessReport struct {
+	ObjectType     string    `json:"objectType"`
+	RecordID       string    `json:"recordId"`
+	User           *api.User `json:"user"`
+	Roles          []string  `json:"roles"`
+	OwnerID        string    `json:"ownerId,omitempty"`
+	RecordOwnerIDs []string  `json:"recordOwnerIds"`
+	RecordTeamIDs  []string  `json:"recordTeamIds"`
+	OwnsRecord     bool      `json:"ownsRecord"`
+	SharedTeams    []string  `json:"sharedTeams"`
+	// Grant is the least permission level that gives the user the record:
+	// super-admin (always), owned, team, or all
+	Grant   string   `json:"grant"`
+	Reasons []string `json:"reasons"`
+}
+
+// splitIDs splits a multi-value ID property such as hs_all_owner_ids
+func splitIDs(values ...string) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, id := range strings.Split(value, ";") {
+			id = strings.TrimSpace(id)
+			if id != "" && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// evaluate works out how the user reaches the record. owner is the user's
+// owner record, nil when the user is not an owner; it names their teams.
+func evaluate(record *api.CRMObject, user *api.User, owner *api.Owner) accessReport {
+	r := accessReport{
+		RecordID:       record.ID,
+		User:           user,
+		RecordOwnerIDs: splitIDs(record.GetProperty("hubspot_owner_id"), record.GetProperty("hs_all_owner_ids")),
+		RecordTeamIDs: splitIDs(record.GetProperty("hubspot_team_id"), record.GetProperty("hs_all_team_ids"),
+			record.GetProperty("hs_all_accessible_team_ids")),
+		Roles:       []string{},
+		SharedTeams: []string{},
+	}
+
+	teamNames := make(map[string]string)
+	if owner != nil {
+		r.OwnerID = owner.ID
+		for _, t := range owner.Teams {
+			teamNames[t.ID] = t.Name
+		}
+	}
+	for _, id := range r.RecordOwnerIDs {
+		if id == r.OwnerID {
+			r.OwnsRecord = true
+		}
+	}
+	recordTeams := make(map[string]bool, len(r.RecordTeamIDs))
+	for _, id := range r.RecordTeamIDs {
+		recordTeams[id] = true
+	}
+	for _, id := range user.TeamIDs() {
+		if recordTeams[id] {
+			name := id
+			if teamNames[id] != "" {
+				name = teamNames[id] + " (" + id + ")"
+			}
+			r.SharedTeams = append(r.SharedTeams, name)
+		}
+	}
+
+	switch {
+	case user.SuperAdmin:
+		r.Grant = grantSuperAdmin
+		r.Reasons = append(r.Reasons, "the user is a super admin, who can see and edit every record")
+	case r.OwnsRecord:
+		r.Grant = grantOwned
+		r.Reasons = append(r.Reasons, "the user owns the record, so Owned only, Team only, and All permissions reach it")
+	case len(r.SharedTeams) > 0:
+		r.Grant = grantTeam
+		r.Reasons = append(r.Reasons, fmt.Sprintf("the record is assigned to the user's team %s, so Team only and All permissions reach it",
+			strings.Join(r.SharedTeams, ", ")))
+	default:
+		r.Grant = grantAll
+		r.Reasons = append(r.Reasons, "the user neither owns the record nor shares a team with it, so only All permission reaches it")
+	}
+
+	if len(r.RecordOwnerIDs) == 0 && !user.SuperAdmin {
+		r.Reasons = append(r.Reasons, "the record has no owner; roles limited to owned or team records see it only if they include unassigned records")
+	}
+	if owner == nil && !user.SuperAdmin {
+		r.Reasons = append(r.Reasons, "the user is not a HubSpot owner, so no record can be assigned to them")
+	}
+
+	return r
+}
+
+// verdict describes the role permission the user needs for an action on
+// the record
+func verdict(grant, action string) string {
+	switch grant {
+	case grantSuperAdmin:
+		return "Yes (super admin)"
+	case grantOwned:
+		return fmt.Sprintf("Yes, if their role can %s owned, team, or all records", action)
+	case grantTeam:
+		return fmt.Sprintf("Yes, if their role can %s team or all records", action)
+	default:
+		return fmt.Sprintf("Only if their role can %s all records", action)
+	}
+}
+
+// roleNames names the user's roles, falling back to IDs when the roles
+// cannot be listed
+func roleNames(ctx context.Context, client *api.Client, user *api.User) []string {
+	ids := user.RoleIDs
+	if len(ids) == 0 && user.RoleID != "" {
+		ids = []string{user.RoleID}
+	}
+	if len(ids) == 0 {
+		return []string{}
+	}
+
+	names := make(map[string]string)
+	if roles, err := client.ListRoles(ctx); err == nil {
+		for _, role := range roles {
+			names[role.ID] = role.Name
+		}
+	}
+
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if names[id] != "" {
+			result = append(result, names[id]+" ("+id+")")
+		} else {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func newCheckCmd(opts *root.Options) *cobra.Command {
+	var object, id, userFlag string
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Explain whether a user can see a record",
+		Long: `Explain whether a user can view and edit a record, and why: super admin
+status, record ownership, and team assignment.
+
+HubSpot does not expose a role's record permission levels (All, Team only,
+Owned only) through its API, so the result names the least level that
+reaches the record; compare it with the user's role under Settings > Users
+& Teams. Requires the settings.users.read scope.`,
+		Example: `  # Why can't someone see this deal?
+  hspt access check --object deals --id 123 --user someone@example.com
+
+  # As JSON
+  hspt access check --object tickets --id 456 --user 789 -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			if object == "" || id == "" || userFlag == "" {
+				return fmt.Errorf("--object, --id, and --user are required")
+			}
+			objectType := api.ObjectType(strings.ToLower(strings.TrimSpace(object)))
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			record, err := client.GetObject(ctx, objectType, id, accessProperties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Record %s not found in %s", id, objectType)
+					return nil
+				}
+				return fmt.Errorf("failed to get record: %w", err)
+			}
+
+			user, err := client.GetUser(ctx, userFlag)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("User %s not found", userFlag)
+					return nil
+				}
+				return fmt.Errorf("failed to get user: %w", err)
+			}
+
+			var owner *api.Owner
+			if user.Email != "" {
+				owner, err = client.GetOwnerByEmail(ctx, user.Email)
+				if err != nil && !api.IsNotFound(err) {
+					return fmt.Errorf("failed to look up owner: %w", err)
+				}
+			}
+
+			report := evaluate(record, user, owner)
+			report.ObjectType = string(objectType)
+			report.Roles = roleNames(ctx, client, user)
+
+			headers := []string{"CHECK", "RESULT"}
+			rows := [][]string{
+				{"User", fmt.Sprintf("%s (%s)", user.Email, user.ID)},
+				{"Super admin", yesNo(user.SuperAdmin)},
+				{"Roles", listOrNone(report.Roles)},
+				{"User's owner ID", orNone(report.OwnerID)},
+				{"Record owners", listOrNone(report.RecordOwnerIDs)},
+				{"Record teams", listOrNone(report.RecordTeamIDs)},
+				{"Owns record", yesNo(report.OwnsRecord)},
+				{"Shared teams", listOrNone(report.SharedTeams)},
+				{"Can view", verdict(report.Grant, "view")},
+				{"Can edit", verdict(report.Grant, "edit")},
+			}
+			for _, reason := range report.Reasons {
+				rows = append(rows, []string{"Why", reason})
+			}
+
+			return v.Render(headers, rows, report)
+		},
+	}
+
+	cmd.Flags().StringVar(&object, "object", "", "Object type of the record (e.g. deals, contacts, tickets)")
+	cmd.Flags().StringVar(&id, "id", "", "Record ID")
+	cmd.Flags().StringVar(&userFlag, "user", "", "User email or user ID")
+
+	return cmd
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func listOrNone(values []string) string {
+	return orNone(strings.Join(values, ", "))
+}
@@ -0,0 +1,87 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func record(props map[string]interface{}) *api.CRMObject {
+	return &api.CRMObject{ID: "123", Properties: props}
+}
+
+func TestSplitIDs(t *testing.T) {
+	assert.Equal(t, []string{"1", "2", "3"}, splitIDs("1", "1;2", " 3 ;"))
+	assert.Nil(t, splitIDs("", ""))
+}
+
+func TestEvaluate(t *testing.T) {
+	owner := &api.Owner{ID: "500", Teams: []api.OwnerTeam{{ID: "10", Name: "EMEA Sales", Primary: true}}}
+	user := &api.User{ID: "42", Email: "jane@example.com", PrimaryTeamID: "10"}
+
+	tests := []struct {
+		name   string
+		record *api.CRMObject
+		user   *api.User
+		owner  *api.Owner
+		grant  string
+		shared []string
+	}{
+		{
+			name:   "super admin",
+			record: record(map[string]interface{}{"hubspot_owner_id": "900"}),
+			user:   &api.User{ID: "1", SuperAdmin: true},
+			grant:  grantSuperAdmin,
+			shared: []string{},
+		},
+		{
+			name:   "co-owner",
+			record: record(map[string]interface{}{"hubspot_owner_id": "900", "hs_all_owner_ids": "900;500"}),
+			user:   user,
+			owner:  owner,
+			grant:  grantOwned,
+			shared: []string{},
+		},
+		{
+			name:   "shared team",
+			record: record(map[string]interface{}{"hubspot_owner_id": "900", "hs_all_accessible_team_ids": "10;11"}),
+			user:   user,
+			owner:  owner,
+			grant:  grantTeam,
+			shared: []string{"EMEA Sales (10)"},
+		},
+		{
+			name:   "no relation",
+			record: record(map[string]interface{}{"hubspot_owner_id": "900", "hubspot_team_id": "11"}),
+			user:   user,
+			owner:  owner,
+			grant:  grantAll,
+			shared: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := evaluate(tt.record, tt.user, tt.owner)
+			assert.Equal(t, tt.grant, r.Grant)
+			assert.Equal(t, tt.shared, r.SharedTeams)
+			assert.NotEmpty(t, r.Reasons)
+		})
+	}
+}
+
+func TestEvaluate_Unassigned(t *testing.T) {
+	r := evaluate(record(map[string]interface{}{}), &api.User{ID: "42"}, nil)
+	assert.Equal(t, grantAll, r.Grant)
+	assert.Len(t, r.Reasons, 3)
+	assert.Contains(t, r.Reasons[1], "no owner")
+	assert.Contains(t, r.Reasons[2], "not a HubSpot owner")
+}
+
+func TestVerdict(t *testing.T) {
+	assert.Equal(t, "Yes (super admin)", verdict(grantSuperAdmin, "view"))
+	assert.Equal(t, "Yes, if their role can edit owned, team, or all records", verdict(grantOwned, "edit"))
+	assert.Equal(t, "Yes, if their role can view team or all records", verdict(grantTeam, "view"))
+	assert.Equal(t, "Only if their role can view all records", verdict(grantAll, "view"))
+}
@@ -0,0 +1,196 @@
+package analytics
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the analytics command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "analytics",
+		Short: "Export raw engagement analytics",
+		Long: `Commands for exporting raw engagement data, such as the individual events
+behind marketing email reports or a record's page views and form
+submissions, for analysis outside HubSpot.`,
+	}
+
+	cmd.AddCommand(newEmailEventsCmd(opts))
+	cmd.AddCommand(newEventsCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// parseEventType checks an --event-type value against the types the email
+// events API knows, in any case
+func parseEventType(s string) (string, error) {
+	t := strings.ToUpper(strings.TrimSpace(s))
+	if t == "" || slices.Contains(api.EmailEventTypes, t) {
+		return t, nil
+	}
+	return "", fmt.Errorf("invalid --event-type %q: use one of %s", s, strings.Join(api.EmailEventTypes, ", "))
+}
+
+// parseTime reads a --since or --until value: an RFC 3339 timestamp, or
+// anything shared.ParseSince accepts
+func parseTime(flag, s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, strings.TrimSpace(s)); err == nil {
+		return t, nil
+	}
+	t, err := shared.ParseSince(s, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s %q: use a timestamp (2024-01-31T09:00:00Z), a date (2024-01-31), or days (30d), weeks (12w), or a duration (36h) ago", flag, s)
+	}
+	return t, nil
+}
+
+// eventDetail describes an event from the fields its type sets
+func eventDetail(e api.EmailEvent) string {
+	var parts []string
+	switch e.Type {
+	case api.EmailEventBounce:
+		parts = []string{e.Category, e.Status}
+	case api.EmailEventDropped:
+		parts = []string{e.DropReason}
+	case api.EmailEventStatusChange:
+		parts = []string{e.PortalSubscriptionStatus, e.Source}
+	case api.EmailEventClick:
+		parts = []string{e.URL}
+	case api.EmailEventOpen:
+		parts = []string{e.DeviceType}
+	}
+	if e.FilteredEvent {
+		parts = append(parts, "filtered")
+	}
+
+	var detail []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			detail = append(detail, p)
+		}
+	}
+	return strings.Join(detail, ": ")
+}
+
+func newEmailEventsCmd(opts *root.Options) *cobra.Command {
+	var campaignID, appID, eventType, recipient, since, until, offset string
+	var excludeFiltered bool
+	var limit int
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "email-events",
+		Short: "Export marketing email events",
+		Long: `List the raw events behind marketing email reports: sends, deliveries,
+opens, clicks, bounces, and more, newest first.
+
+Narrow them to one campaign, event type, recipient, or time range, and use
+--all -o csv to pull the whole stream for deliverability analysis. Opens and
+clicks HubSpot filtered as bots or mail scanners are marked "filtered"; leave
+them out with --exclude-filtered.`,
+		Example: `  # Opens for a campaign in the last week
+  hspt analytics email-events --campaign-id 123 --event-type OPEN --since 7d
+
+  # Every bounce since a timestamp, as CSV
+  hspt analytics email-events --event-type bounce --since 2024-06-01T00:00:00Z --all -o csv > bounces.csv
+
+  # One recipient's events
+  hspt analytics email-events --recipient jane@example.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			now := time.Now()
+
+			query := api.EmailEventOptions{
+				CampaignID:      campaignID,
+				AppID:           appID,
+				Recipient:       recipient,
+				ExcludeFiltered: excludeFiltered,
+			}
+
+			var err error
+			if query.EventType, err = parseEventType(eventType); err != nil {
+				return err
+			}
+			if since != "" {
+				if query.StartTime, err = parseTime("since", since, now); err != nil {
+					return err
+				}
+			}
+			if until != "" {
+				if query.EndTime, err = parseTime("until", until, now); err != nil {
+					return err
+				}
+			}
+			if !query.StartTime.IsZero() && !query.EndTime.IsZero() && !query.EndTime.After(query.StartTime) {
+				return fmt.Errorf("--until must be after --since")
+			}
+
+			page := pages.Apply(cmd, api.ListOptions{Limit: limit, After: offset})
+			query.Limit, query.Offset, query.All, query.Max = page.Limit, page.After, page.All, page.Max
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListEmailEvents(cmd.Context(), query)
+			if err != nil {
+				return fmt.Errorf("failed to list email events: %w", err)
+			}
+
+			if len(result.Events) == 0 {
+				v.Info("No email events found")
+				return nil
+			}
+
+			headers := []string{"CREATED", "TYPE", "RECIPIENT", "CAMPAIGN ID", "DETAIL"}
+			rows := make([][]string, 0, len(result.Events))
+			for _, e := range result.Events {
+				campaign := ""
+				if e.EmailCampaignID != 0 {
+					campaign = strconv.FormatInt(e.EmailCampaignID, 10)
+				}
+				rows = append(rows, []string{
+					v.Time(strconv.FormatInt(e.Created, 10)),
+					e.Type,
+					e.Recipient,
+					campaign,
+					eventDetail(e),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.HasMore && result.Offset != "" {
+				v.Info("\nMore results available. Use --offset %s to get the next page.", result.Offset)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&campaignID, "campaign-id", "", "Only events of this email campaign")
+	cmd.Flags().StringVar(&appID, "app-id", "", "App that sent the campaign, which HubSpot may need with --campaign-id")
+	cmd.Flags().StringVar(&eventType, "event-type", "", "Only events of this type (OPEN, CLICK, DELIVERED, BOUNCE, ...)")
+	cmd.Flags().StringVar(&recipient, "recipient", "", "Only events for this email address")
+	cmd.Flags().StringVar(&since, "since", "", "Only events after this timestamp, date, or long ago (7d, 12w, 36h)")
+	cmd.Flags().StringVar(&until, "until", "", "Only events before this timestamp, date, or long ago")
+	cmd.Flags().BoolVar(&excludeFiltered, "exclude-filtered", false, "Leave out opens and clicks filtered as bots or mail scanners")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of events to return")
+	cmd.Flags().StringVar(&offset, "offset", "", "Pagination offset for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseEventType(t *testing.T) {
+	got, err := parseEventType("open")
+	require.NoError(t, err)
+	assert.Equal(t, api.EmailEventOpen, got)
+
+	got, err = parseEventType("")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = parseEventType("READ")
+	assert.ErrorContains(t, err, "CLICK")
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	got, err := parseTime("since", "2024-06-01T09:30:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC), got)
+
+	got, err = parseTime("since", "7d", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, -7), got)
+
+	_, err = parseTime("until", "yesterday", now)
+	assert.ErrorContains(t, err, "--until")
+}
+
+func TestEventDetail(t *testing.T) {
+	tests := []struct {
+		name  string
+		event api.EmailEvent
+		want  string
+	}{
+		{"bounce", api.EmailEvent{Type: api.EmailEventBounce, Category: "MAILBOX_FULL", Status: "552"}, "MAILBOX_FULL: 552"},
+		{"dropped", api.EmailEvent{Type: api.EmailEventDropped, DropReason: "PREVIOUSLY_BOUNCED"}, "PREVIOUSLY_BOUNCED"},
+		{"click", api.EmailEvent{Type: api.EmailEventClick, URL: "https://example.com/offer"}, "https://example.com/offer"},
+		{"filtered open", api.EmailEvent{Type: api.EmailEventOpen, DeviceType: "COMPUTER", FilteredEvent: true}, "COMPUTER: filtered"},
+		{"delivered", api.EmailEvent{Type: api.EmailEventDelivered, Response: "250 OK"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, eventDetail(tt.event))
+		})
+	}
+}
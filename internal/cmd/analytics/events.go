@@ -0,0 +1,170 @@
+package analytics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// eventObjectTypes maps the plural object type names used elsewhere in the
+// CLI to the singular names the events API expects
+var eventObjectTypes = map[string]string{
+	"contacts":  "contact",
+	"companies": "company",
+	"deals":     "deal",
+	"tickets":   "ticket",
+}
+
+// eventObjectType returns the events API name of an --object-type value
+func eventObjectType(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if singular, ok := eventObjectTypes[s]; ok {
+		return singular
+	}
+	return s
+}
+
+// maxDetailProperties caps how many properties a table row shows for an
+// event without a URL
+const maxDetailProperties = 3
+
+// webEventDetail describes an event by the page it happened on, or else by
+// its first few properties
+func webEventDetail(e api.Event) string {
+	if url, ok := e.Properties["hs_url"].(string); ok && url != "" {
+		return url
+	}
+
+	names := make([]string, 0, len(e.Properties))
+	for name, value := range e.Properties {
+		if value != nil && value != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, maxDetailProperties+1)
+	for i, name := range names {
+		if i == maxDetailProperties {
+			parts = append(parts, fmt.Sprintf("+%d more", len(names)-i))
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s=%v", name, e.Properties[name]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func newEventsCmd(opts *root.Options) *cobra.Command {
+	var objectType, objectID, eventType, since, until, after string
+	var limit int
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "List a record's web and behavioral events",
+		Long: `List the events a CRM record completed: page views, form submissions,
+custom behavioral events, and other events HubSpot tracks, newest first.
+
+Give a record with --object-type and --object-id, an event type with
+--event-type, or both. Event types use their internal names, such as
+e_visited_page for page views or pe<portal ID>_<name> for custom events.`,
+		Example: `  # Everything a contact did on the website
+  hspt analytics events --object-type contact --object-id 123
+
+  # Their page views in the last 30 days
+  hspt analytics events --object-type contact --object-id 123 --event-type e_visited_page --since 30d
+
+  # Every completion of a custom event this year, as CSV
+  hspt analytics events --event-type pe456_viewed_pricing --since 2026-01-01 --all -o csv > pricing-views.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			now := time.Now()
+
+			query := api.EventQuery{
+				EventType:  strings.TrimSpace(eventType),
+				ObjectType: eventObjectType(objectType),
+				ObjectID:   strings.TrimSpace(objectID),
+			}
+			if query.ObjectID != "" && query.ObjectType == "" {
+				return fmt.Errorf("--object-id needs --object-type")
+			}
+			if query.EventType == "" && query.ObjectID == "" {
+				return fmt.Errorf("set --event-type, or --object-type and --object-id for one record's events")
+			}
+
+			var err error
+			if since != "" {
+				if query.OccurredAfter, err = parseTime("since", since, now); err != nil {
+					return err
+				}
+			}
+			if until != "" {
+				if query.OccurredBefore, err = parseTime("until", until, now); err != nil {
+					return err
+				}
+			}
+			if !query.OccurredAfter.IsZero() && !query.OccurredBefore.IsZero() && !query.OccurredBefore.After(query.OccurredAfter) {
+				return fmt.Errorf("--until must be after --since")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListEvents(cmd.Context(), query, pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list events: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No events found")
+				return nil
+			}
+
+			headers := []string{"OCCURRED", "EVENT TYPE", "OBJECT TYPE", "OBJECT ID", "DETAIL"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, e := range result.Results {
+				rows = append(rows, []string{
+					v.Time(e.OccurredAt.Format(time.RFC3339)),
+					e.EventType,
+					strings.ToLower(e.ObjectType),
+					e.ObjectID,
+					webEventDetail(e),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Type of record that completed the events (contact, company, deal, ticket)")
+	cmd.Flags().StringVar(&objectID, "object-id", "", "ID of the record that completed the events")
+	cmd.Flags().StringVar(&eventType, "event-type", "", "Only events of this type, by internal name (e_visited_page, pe123_signed_up)")
+	cmd.Flags().StringVar(&since, "since", "", "Only events after this timestamp, date, or long ago (30d, 12w, 36h)")
+	cmd.Flags().StringVar(&until, "until", "", "Only events before this timestamp, date, or long ago")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of events to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
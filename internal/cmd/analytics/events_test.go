@@ -0,0 +1,36 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestEventObjectType(t *testing.T) {
+	assert.Equal(t, "contact", eventObjectType("contacts"))
+	assert.Equal(t, "contact", eventObjectType("Contact"))
+	assert.Equal(t, "company", eventObjectType("companies"))
+	assert.Equal(t, "p123_cars", eventObjectType("p123_cars"))
+	assert.Empty(t, eventObjectType(""))
+}
+
+func TestWebEventDetail(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]interface{}
+		want       string
+	}{
+		{"page view", map[string]interface{}{"hs_url": "https://example.com/pricing", "hs_title": "Pricing"}, "https://example.com/pricing"},
+		{"properties", map[string]interface{}{"plan": "pro", "seats": float64(5)}, "plan=pro, seats=5"},
+		{"capped", map[string]interface{}{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"}, "a=1, b=2, c=3, +2 more"},
+		{"empty values", map[string]interface{}{"a": "", "b": nil}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, webEventDetail(api.Event{Properties: tt.properties}))
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package apicmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// Register registers the api command
+func Register(parent *cobra.Command, opts *root.Options) {
+	parent.AddCommand(newAPICmd(opts))
+}
+
+func newAPICmd(opts *root.Options) *cobra.Command {
+	var method, input string
+	var fields, rawFields []string
+	var paginate bool
+
+	cmd := &cobra.Command{
+		Use:   "api <endpoint>",
+		Short: "Make an authenticated HubSpot API request",
+		Long: `Send a request to any HubSpot API endpoint with the configured access token
+and print the JSON response, shaped by --jq or --template when given. Use it
+for endpoints hspt does not wrap yet.
+
+The endpoint is a path such as /crm/v3/objects/contacts, or a full
+api.hubapi.com URL. The method is GET, or POST when fields or --input are
+given; set another with --method.
+
+--raw-field (-f) adds a string value and --field (-F) adds a typed one: true,
+false, null, and numbers are converted, and @file reads the value from a file
+(@- from stdin). Use key[subkey]=value to build nested objects. Fields become
+query parameters for GET requests and a JSON body otherwise. --input sends a
+JSON body from a file, or from stdin with "-".
+
+--paginate follows paging.next.after cursors on GET requests and prints every
+page's results as one {"results": [...]} object.`,
+		Example: `  # Get a contact
+  hspt api /crm/v3/objects/contacts/123
+
+  # Query parameters for a GET request
+  hspt api /crm/v3/objects/deals -f limit=100 -f properties=dealname,amount
+
+  # Create a contact with a nested body
+  hspt api /crm/v3/objects/contacts -f 'properties[email]=jane@example.com' -f 'properties[firstname]=Jane'
+
+  # Send a body from stdin
+  echo '{"properties":{"phone":"+1-555-0100"}}' | hspt api -X PATCH /crm/v3/objects/contacts/123 --input -
+
+  # Every page of results
+  hspt api /crm/v3/owners --paginate`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpoint := args[0]
+
+			params, err := parseFields(fields, rawFields, opts.Stdin)
+			if err != nil {
+				return err
+			}
+			if input != "" && len(params) > 0 {
+				return fmt.Errorf("--input cannot be combined with --field or --raw-field")
+			}
+
+			method = strings.ToUpper(method)
+			if method == "" {
+				method = http.MethodGet
+				if input != "" || len(params) > 0 {
+					method = http.MethodPost
+				}
+			}
+			if paginate && method != http.MethodGet {
+				return fmt.Errorf("--paginate only works with GET requests")
+			}
+
+			var body json.RawMessage
+			switch {
+			case input != "":
+				body, err = readInput(input, opts.Stdin)
+				if err != nil {
+					return err
+				}
+			case method == http.MethodGet || method == http.MethodDelete:
+				endpoint, err = addQuery(endpoint, params)
+				if err != nil {
+					return err
+				}
+			case len(params) > 0:
+				body, err = json.Marshal(params)
+				if err != nil {
+					return fmt.Errorf("failed to encode fields: %w", err)
+				}
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var resp []byte
+			if paginate {
+				resp, err = fetchAllPages(cmd.Context(), client, endpoint)
+			} else {
+				resp, err = client.Do(cmd.Context(), method, endpoint, body)
+			}
+			if err != nil {
+				return err
+			}
+
+			return printResponse(opts.View(), resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&method, "method", "X", "", "HTTP method (default GET, or POST with fields or --input)")
+	cmd.Flags().StringArrayVarP(&fields, "field", "F", nil, "Typed field as key=value (repeatable)")
+	cmd.Flags().StringArrayVarP(&rawFields, "raw-field", "f", nil, "String field as key=value (repeatable)")
+	cmd.Flags().StringVar(&input, "input", "", `JSON file to send as the request body ("-" for stdin)`)
+	cmd.Flags().BoolVar(&paginate, "paginate", false, "Fetch every page of a GET request")
+
+	return cmd
+}
+
+// parseFields builds request parameters from --field and --raw-field flags
+func parseFields(typed, raw []string, stdin io.Reader) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+
+	for _, f := range raw {
+		key, value, err := splitField(f)
+		if err != nil {
+			return nil, err
+		}
+		if err := setField(params, key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, f := range typed {
+		key, value, err := splitField(f)
+		if err != nil {
+			return nil, err
+		}
+		typedValue, err := fieldValue(value, stdin)
+		if err != nil {
+			return nil, err
+		}
+		if err := setField(params, key, typedValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+func splitField(f string) (string, string, error) {
+	key, value, ok := strings.Cut(f, "=")
+	if !ok || key == "" {
+		return "", "", fmt.Errorf("invalid field %q: expected key=value", f)
+	}
+	return key, value, nil
+}
+
+// fieldValue converts a --field value to a JSON literal, number, or the
+// contents of the file it names with @
+func fieldValue(value string, stdin io.Reader) (interface{}, error) {
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	if strings.HasPrefix(value, "@") {
+		var data []byte
+		var err error
+		if value == "@-" {
+			data, err = io.ReadAll(stdin)
+		} else {
+			data, err = os.ReadFile(value[1:])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read field value: %w", err)
+		}
+		return string(data), nil
+	}
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return n, nil
+	}
+	return value, nil
+}
+
+// setField stores value under key, where key[a][b] nests objects
+func setField(params map[string]interface{}, key string, value interface{}) error {
+	path := []string{key}
+	if i := strings.Index(key, "["); i > 0 && strings.HasSuffix(key, "]") {
+		path = append([]string{key[:i]}, strings.Split(key[i+1:len(key)-1], "][")...)
+	}
+
+	m := params
+	for _, part := range path[:len(path)-1] {
+		if part == "" {
+			return fmt.Errorf("invalid field key %q", key)
+		}
+		next, ok := m[part].(map[string]interface{})
+		if !ok {
+			if _, exists := m[part]; exists {
+				return fmt.Errorf("field %q conflicts with an earlier field", key)
+			}
+			next = make(map[string]interface{})
+			m[part] = next
+		}
+		m = next
+	}
+
+	last := path[len(path)-1]
+	if last == "" {
+		return fmt.Errorf("invalid field key %q", key)
+	}
+	m[last] = value
+	return nil
+}
+
+// addQuery adds fields to an endpoint's query string
+func addQuery(endpoint string, params map[string]interface{}) (string, error) {
+	if len(params) == 0 {
+		return endpoint, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+
+	q := u.Query()
+	for k, v := range params {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			return "", fmt.Errorf("nested field %q cannot be sent as a query parameter", k)
+		case nil:
+			q.Set(k, "")
+		default:
+			q.Set(k, fmt.Sprint(val))
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// readInput reads a JSON request body from a file, or stdin for "-"
+func readInput(path string, stdin io.Reader) (json.RawMessage, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("request body is not valid JSON")
+	}
+	return data, nil
+}
+
+// page is the part of a paginated response --paginate reads
+type page struct {
+	Results []json.RawMessage `json:"results"`
+	Paging  *struct {
+		Next *struct {
+			After string `json:"after"`
+		} `json:"next"`
+	} `json:"paging"`
+}
+
+// fetchAllPages follows paging.next.after cursors and joins the results of
+// every page. A response without a results array is returned unchanged.
+func fetchAllPages(ctx context.Context, client *api.Client, endpoint string) ([]byte, error) {
+	all := []json.RawMessage{}
+	seen := make(map[string]bool)
+
+	next := endpoint
+	for {
+		body, err := client.Do(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var p page
+		if err := json.Unmarshal(body, &p); err != nil || p.Results == nil {
+			if len(seen) == 0 {
+				return body, nil
+			}
+			return nil, fmt.Errorf("unexpected page at %s: no results array", next)
+		}
+		all = append(all, p.Results...)
+
+		if p.Paging == nil || p.Paging.Next == nil || p.Paging.Next.After == "" || seen[p.Paging.Next.After] {
+			break
+		}
+		seen[p.Paging.Next.After] = true
+
+		next, err = addQuery(endpoint, map[string]interface{}{"after": p.Paging.Next.After})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{"results": all})
+}
+
+// printResponse pretty-prints a JSON response, shaped by --jq or --template
+// when set, or writes it unchanged when it is not JSON
+func printResponse(v *view.View, body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+	if !json.Valid(body) {
+		_, err := v.Out.Write(body)
+		return err
+	}
+
+	return v.JSON(json.RawMessage(body))
+}
@@ -0,0 +1,98 @@
+package apicmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseFields(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "note.txt")
+	require.NoError(t, os.WriteFile(file, []byte("from file"), 0o600))
+
+	params, err := parseFields(
+		[]string{"limit=10", "archived=false", "ratio=0.5", "owner=null", "body=@" + file, "stdin=@-"},
+		[]string{"properties[email]=jane@example.com", "properties[firstname]=Jane", "id=007"},
+		strings.NewReader("from stdin"),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"limit":    int64(10),
+		"archived": false,
+		"ratio":    0.5,
+		"owner":    nil,
+		"body":     "from file",
+		"stdin":    "from stdin",
+		"id":       "007",
+		"properties": map[string]interface{}{
+			"email":     "jane@example.com",
+			"firstname": "Jane",
+		},
+	}, params)
+}
+
+func TestParseFields_Errors(t *testing.T) {
+	_, err := parseFields(nil, []string{"novalue"}, nil)
+	assert.ErrorContains(t, err, `invalid field "novalue"`)
+
+	_, err = parseFields(nil, []string{"a=1", "a[b]=2"}, nil)
+	assert.ErrorContains(t, err, "conflicts with an earlier field")
+
+	_, err = parseFields(nil, []string{"a[]=1"}, nil)
+	assert.ErrorContains(t, err, "invalid field key")
+}
+
+func TestAddQuery(t *testing.T) {
+	got, err := addQuery("/crm/v3/objects/deals?archived=false", map[string]interface{}{"limit": int64(100)})
+	require.NoError(t, err)
+	assert.Equal(t, "/crm/v3/objects/deals?archived=false&limit=100", got)
+
+	_, err = addQuery("/crm", map[string]interface{}{"properties": map[string]interface{}{"a": "b"}})
+	assert.ErrorContains(t, err, "cannot be sent as a query parameter")
+}
+
+func TestFetchAllPages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/owners", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{"results": [{"id": "1"}, {"id": "2"}], "paging": {"next": {"after": "2"}}}`))
+		case "2":
+			w.Write([]byte(`{"results": [{"id": "3"}]}`))
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	body, err := fetchAllPages(context.Background(), client, "/crm/v3/owners?limit=2")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"results": [{"id": "1"}, {"id": "2"}, {"id": "3"}]}`, string(body))
+}
+
+func TestFetchAllPages_NotPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"portalId": 123}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	body, err := fetchAllPages(context.Background(), client, "/account-info/v3/details")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"portalId": 123}`, string(body))
+}
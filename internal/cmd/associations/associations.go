@@ -7,6 +7,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the associations command and subcommands
@@ -15,12 +16,15 @@ func Register(parent *cobra.Command, opts *root.Options) {
 		Use:     "associations",
 		Aliases: []string{"assoc"},
 		Short:   "Manage HubSpot associations",
-		Long:    "Commands for listing, creating, and deleting associations between CRM objects.",
+		Long:    "Commands for listing, creating, and deleting associations between CRM objects, and managing association labels.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newCreateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newLabelsCmd(opts))
+	cmd.AddCommand(newBatchCmd(opts))
+	cmd.AddCommand(newAuditCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -29,6 +33,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var fromType, toType, fromID string
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -51,14 +56,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListAssociations(
+			result, err := client.ListAssociations(cmd.Context(),
 				api.ObjectType(fromType),
 				fromID,
 				api.ObjectType(toType),
-				api.ListOptions{
+				pages.Apply(cmd, api.ListOptions{
 					Limit: limit,
 					After: after,
-				},
+				}),
 			)
 			if err != nil {
 				return err
@@ -103,11 +108,13 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 100, "Maximum number of associations to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
-	var fromType, toType, fromID, toID string
+	var fromType, toType, fromID, toID, label string
 	var typeID int
 
 	cmd := &cobra.Command{
@@ -118,7 +125,10 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   hspt associations create --from-type contacts --from-id 123 --to-type companies --to-id 456
 
   # Associate with a specific type ID
-  hspt associations create --from-type contacts --from-id 123 --to-type companies --to-id 456 --type-id 1`,
+  hspt associations create --from-type contacts --from-id 123 --to-type companies --to-id 456 --type-id 1
+
+  # Associate with a custom label
+  hspt associations create --from-type contacts --from-id 123 --to-type companies --to-id 456 --label "Decision maker"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
@@ -131,12 +141,20 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.CreateAssociation(
+			spec := api.AssociationSpec{Category: api.AssociationCategoryHubSpot, TypeID: typeID}
+			if label != "" {
+				spec, err = lookupLabel(cmd.Context(), client, fromType, toType, label)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = client.CreateAssociationWithTypes(cmd.Context(),
 				api.ObjectType(fromType),
 				fromID,
 				api.ObjectType(toType),
 				toID,
-				typeID,
+				[]api.AssociationSpec{spec},
 			)
 			if err != nil {
 				return err
@@ -152,20 +170,28 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&toType, "to-type", "", "Target object type (contacts, companies, deals, etc.)")
 	cmd.Flags().StringVar(&toID, "to-id", "", "Target object ID")
 	cmd.Flags().IntVar(&typeID, "type-id", 1, "Association type ID (default: 1 for standard association)")
+	cmd.Flags().StringVar(&label, "label", "", "Association label to apply (see 'associations labels list')")
+	cmd.MarkFlagsMutuallyExclusive("type-id", "label")
 
 	return cmd
 }
 
 func newDeleteCmd(opts *root.Options) *cobra.Command {
-	var fromType, toType, fromID, toID string
+	var fromType, toType, fromID, toID, label string
 	var force bool
 
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete an association",
-		Long:  "Delete an association between two CRM objects.",
+		Long: `Delete an association between two CRM objects.
+
+With --label only that label is removed and the objects stay associated
+through any other labels they have.`,
 		Example: `  # Delete association between contact and company
-  hspt associations delete --from-type contacts --from-id 123 --to-type companies --to-id 456 --force`,
+  hspt associations delete --from-type contacts --from-id 123 --to-type companies --to-id 456 --force
+
+  # Remove just one label
+  hspt associations delete --from-type contacts --from-id 123 --to-type companies --to-id 456 --label "Decision maker" --force`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
@@ -183,7 +209,28 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteAssociation(
+			if label != "" {
+				spec, err := lookupLabel(cmd.Context(), client, fromType, toType, label)
+				if err != nil {
+					return err
+				}
+
+				err = client.RemoveAssociationLabel(cmd.Context(),
+					api.ObjectType(fromType),
+					fromID,
+					api.ObjectType(toType),
+					toID,
+					[]api.AssociationSpec{spec},
+				)
+				if err != nil {
+					return err
+				}
+
+				v.Success("Label %q removed between %s %s and %s %s", label, fromType, fromID, toType, toID)
+				return nil
+			}
+
+			err = client.DeleteAssociation(cmd.Context(),
 				api.ObjectType(fromType),
 				fromID,
 				api.ObjectType(toType),
@@ -202,6 +249,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&fromID, "from-id", "", "Source object ID")
 	cmd.Flags().StringVar(&toType, "to-type", "", "Target object type (contacts, companies, deals, etc.)")
 	cmd.Flags().StringVar(&toID, "to-id", "", "Target object ID")
+	cmd.Flags().StringVar(&label, "label", "", "Remove only this association label")
 	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
 
 	return cmd
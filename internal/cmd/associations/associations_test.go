@@ -0,0 +1,63 @@
+package associations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestFindLabel(t *testing.T) {
+	types := []api.AssociationType{
+		{Category: "HUBSPOT_DEFINED", TypeID: 1},
+		{Category: "USER_DEFINED", TypeID: 36, Label: "Decision maker"},
+		{Category: "USER_DEFINED", TypeID: 37, Label: "Billing contact"},
+	}
+
+	spec, err := findLabel(types, "decision MAKER", "contacts", "companies")
+	require.NoError(t, err)
+	assert.Equal(t, api.AssociationSpec{Category: "USER_DEFINED", TypeID: 36}, spec)
+
+	_, err = findLabel(types, "Champion", "contacts", "companies")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Decision maker, Billing contact")
+
+	_, err = findLabel(types[:1], "Champion", "contacts", "companies")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no association labels are defined")
+}
+
+func TestLabelName(t *testing.T) {
+	assert.Equal(t, "decision_maker", labelName("Decision maker"))
+	assert.Equal(t, "vp_sales", labelName("  VP / Sales! "))
+	assert.Equal(t, "manager", labelName("Manager"))
+}
+
+func TestReadPairs(t *testing.T) {
+	t.Run("default columns", func(t *testing.T) {
+		csv := "\ufeffto_id,from_id,note\n10,1,a\n20,2\n,3,skip\n"
+		pairs, err := readPairs(strings.NewReader(csv), "from_id", "to_id")
+		require.NoError(t, err)
+		assert.Equal(t, []api.AssociationPair{{FromID: "1", ToID: "10"}, {FromID: "2", ToID: "20"}}, pairs)
+	})
+
+	t.Run("custom columns", func(t *testing.T) {
+		csv := "Contact ID,Company ID\n 5 , 50 \n"
+		pairs, err := readPairs(strings.NewReader(csv), "contact id", "Company ID")
+		require.NoError(t, err)
+		assert.Equal(t, []api.AssociationPair{{FromID: "5", ToID: "50"}}, pairs)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		_, err := readPairs(strings.NewReader("from_id\n1\n"), "from_id", "to_id")
+		assert.Error(t, err)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		_, err := readPairs(strings.NewReader(""), "from_id", "to_id")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,384 @@
+package associations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Audit finding kinds, which are also the --fix values
+const (
+	findingOrphan   = "orphans"
+	findingConflict = "conflicts"
+	findingArchived = "archived"
+)
+
+// auditFinding is one problem found by associations audit
+type auditFinding struct {
+	Kind   string `json:"kind"`
+	FromID string `json:"fromId"`
+	ToID   string `json:"toId,omitempty"`
+	// Labels are the conflicting labels of a pair
+	Labels []string `json:"labels,omitempty"`
+	Fixed  bool     `json:"fixed"`
+	// types are the labeled association types of a conflicting pair
+	types []api.AssociationType
+}
+
+// auditReport is the JSON output of associations audit
+type auditReport struct {
+	FromType string         `json:"fromType"`
+	ToType   string         `json:"toType"`
+	Checked  int            `json:"checked"`
+	Findings []auditFinding `json:"findings"`
+}
+
+// count returns how many findings are of kind
+func (r *auditReport) count(kind string) int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// auditAssociations checks each record's associations: records with none
+// are orphans, pairs with more than one custom label have conflicting
+// labels, and associations to records missing from live are to archived
+// records. Findings are ordered by record ID.
+func auditAssociations(ids []string, associations map[string][]api.Association, live map[string]bool) []auditFinding {
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+
+	findings := []auditFinding{}
+	for _, id := range sorted {
+		assocs := associations[id]
+		if len(assocs) == 0 {
+			findings = append(findings, auditFinding{Kind: findingOrphan, FromID: id})
+			continue
+		}
+		for _, a := range assocs {
+			toID := a.ToObjectID.String()
+			if !live[toID] {
+				findings = append(findings, auditFinding{Kind: findingArchived, FromID: id, ToID: toID})
+				continue
+			}
+			var labeled []api.AssociationType
+			for _, t := range a.AssociationTypes {
+				if t.Category == "USER_DEFINED" && t.Label != "" {
+					labeled = append(labeled, t)
+				}
+			}
+			if len(labeled) > 1 {
+				f := auditFinding{Kind: findingConflict, FromID: id, ToID: toID, types: labeled}
+				for _, t := range labeled {
+					f.Labels = append(f.Labels, t.Label)
+				}
+				findings = append(findings, f)
+			}
+		}
+	}
+	return findings
+}
+
+// parseFixes validates --fix values
+func parseFixes(raw []string) (map[string]bool, error) {
+	fixes := make(map[string]bool)
+	for _, r := range raw {
+		r = strings.ToLower(strings.TrimSpace(r))
+		switch r {
+		case "":
+			continue
+		case findingOrphan, findingConflict, findingArchived:
+			fixes[r] = true
+		default:
+			return nil, fmt.Errorf("invalid --fix %q (use %s, %s, or %s)", r, findingOrphan, findingConflict, findingArchived)
+		}
+	}
+	return fixes, nil
+}
+
+// labelsToRemove groups the pairs of conflicting findings by the label each
+// pair should lose to keep only keep
+func labelsToRemove(findings []*auditFinding, keep api.AssociationSpec) map[api.AssociationSpec][]*auditFinding {
+	remove := make(map[api.AssociationSpec][]*auditFinding)
+	for _, f := range findings {
+		hasKeep := false
+		for _, t := range f.types {
+			if t.Spec() == keep {
+				hasKeep = true
+			}
+		}
+		if !hasKeep {
+			continue
+		}
+		for _, t := range f.types {
+			if spec := t.Spec(); spec != keep {
+				remove[spec] = append(remove[spec], f)
+			}
+		}
+	}
+	return remove
+}
+
+// auditPairs turns findings into association pairs
+func auditPairs(findings []*auditFinding, toID string) []api.AssociationPair {
+	pairs := make([]api.AssociationPair, 0, len(findings))
+	for _, f := range findings {
+		to := f.ToID
+		if toID != "" {
+			to = toID
+		}
+		pairs = append(pairs, api.AssociationPair{FromID: f.FromID, ToID: to})
+	}
+	return pairs
+}
+
+// inBatches calls fn with up to MaxBatchSize findings at a time, marking
+// them fixed when fn succeeds
+func inBatches(findings []*auditFinding, fn func([]*auditFinding) error) error {
+	for start := 0; start < len(findings); start += api.MaxBatchSize {
+		end := start + api.MaxBatchSize
+		if end > len(findings) {
+			end = len(findings)
+		}
+		if err := fn(findings[start:end]); err != nil {
+			return err
+		}
+		for _, f := range findings[start:end] {
+			f.Fixed = true
+		}
+	}
+	return nil
+}
+
+// readAssociations reads the toType associations of every record
+func readAssociations(ctx context.Context, client *api.Client, fromType, toType api.ObjectType, ids []string) (map[string][]api.Association, error) {
+	associations := make(map[string][]api.Association, len(ids))
+	for start := 0; start < len(ids); start += api.MaxBatchSize {
+		end := start + api.MaxBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch, err := client.BatchReadAssociations(ctx, fromType, toType, ids[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read associations: %w", err)
+		}
+		for id, a := range batch {
+			associations[id] = a
+		}
+	}
+	return associations, nil
+}
+
+// liveRecords returns which of the associated records still exist
+func liveRecords(ctx context.Context, client *api.Client, toType api.ObjectType, associations map[string][]api.Association) (map[string]bool, error) {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, assocs := range associations {
+		for _, a := range assocs {
+			id := a.ToObjectID.String()
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+
+	live := make(map[string]bool, len(ids))
+	for start := 0; start < len(ids); start += api.MaxBatchSize {
+		end := start + api.MaxBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		result, err := client.BatchReadObjects(ctx, toType, ids[start:end], []string{"hs_object_id"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read associated %s: %w", toType, err)
+		}
+		for _, obj := range result.Results {
+			live[obj.ID] = true
+		}
+	}
+	return live, nil
+}
+
+func newAuditCmd(opts *root.Options) *cobra.Command {
+	var fromType, toType, assignTo, keepLabel string
+	var fixArgs []string
+	var max int
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Find orphaned, conflicting, and stale associations",
+		Long: `Check the associations from every record of one type to another and report:
+
+  orphans    records with no associated records of the other type
+  conflicts  pairs carrying more than one custom association label
+  archived   associations to records that have been archived or deleted
+
+--fix repairs a class of findings, and needs --force:
+
+  orphans    associates each orphan with the --assign-to record
+  conflicts  removes every label but --keep-label from pairs that have it
+  archived   deletes the associations to archived records`,
+		Example: `  # Report problems between deals and contacts
+  hspt associations audit --from-type deals --to-type contacts
+
+  # Remove associations to archived contacts
+  hspt associations audit --from-type deals --to-type contacts --fix archived --force
+
+  # Keep only the "Decision maker" label where pairs have several
+  hspt associations audit --from-type deals --to-type contacts --fix conflicts --keep-label "Decision maker" --force
+
+  # Attach orphaned deals to a placeholder contact for review
+  hspt associations audit --from-type deals --to-type contacts --fix orphans --assign-to 12345 --force`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			if fromType == "" || toType == "" {
+				return fmt.Errorf("--from-type and --to-type are required")
+			}
+			fixes, err := parseFixes(fixArgs)
+			if err != nil {
+				return err
+			}
+			if fixes[findingOrphan] && assignTo == "" {
+				return fmt.Errorf("--fix orphans needs --assign-to <record ID>")
+			}
+			if fixes[findingConflict] && keepLabel == "" {
+				return fmt.Errorf("--fix conflicts needs --keep-label <label>")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			from, to := api.ObjectType(fromType), api.ObjectType(toType)
+
+			var keep api.AssociationSpec
+			if fixes[findingConflict] {
+				if keep, err = lookupLabel(ctx, client, fromType, toType, keepLabel); err != nil {
+					return err
+				}
+			}
+
+			records, err := client.ListObjects(ctx, from, api.ListOptions{All: true, Max: max})
+			if err != nil {
+				return fmt.Errorf("failed to list %s: %w", fromType, err)
+			}
+			if len(records.Results) == 0 {
+				v.Info("No %s found", fromType)
+				return nil
+			}
+			ids := make([]string, 0, len(records.Results))
+			for _, r := range records.Results {
+				ids = append(ids, r.ID)
+			}
+
+			associations, err := readAssociations(ctx, client, from, to, ids)
+			if err != nil {
+				return err
+			}
+			live, err := liveRecords(ctx, client, to, associations)
+			if err != nil {
+				return err
+			}
+
+			report := auditReport{FromType: fromType, ToType: toType, Checked: len(ids), Findings: auditAssociations(ids, associations, live)}
+
+			byKind := make(map[string][]*auditFinding)
+			for i := range report.Findings {
+				f := &report.Findings[i]
+				byKind[f.Kind] = append(byKind[f.Kind], f)
+			}
+
+			if len(fixes) > 0 && !force {
+				v.Warning("Use --force to apply --fix; showing findings only")
+				fixes = nil
+			}
+
+			if fixes[findingOrphan] {
+				err := inBatches(byKind[findingOrphan], func(batch []*auditFinding) error {
+					result, err := client.BatchCreateAssociations(ctx, from, to, auditPairs(batch, assignTo), nil)
+					if err == nil && result.NumErrors > 0 {
+						err = fmt.Errorf("%d association(s) not created", result.NumErrors)
+					}
+					return err
+				})
+				if err != nil {
+					v.Error("Failed to fix orphans: %v", err)
+				}
+			}
+			if fixes[findingConflict] {
+				for spec, findings := range labelsToRemove(byKind[findingConflict], keep) {
+					err := inBatches(findings, func(batch []*auditFinding) error {
+						return client.BatchRemoveAssociationLabels(ctx, from, to, auditPairs(batch, ""), []api.AssociationSpec{spec})
+					})
+					if err != nil {
+						v.Error("Failed to fix conflicting labels: %v", err)
+					}
+				}
+			}
+			if fixes[findingArchived] {
+				err := inBatches(byKind[findingArchived], func(batch []*auditFinding) error {
+					return client.BatchDeleteAssociations(ctx, from, to, auditPairs(batch, ""))
+				})
+				if err != nil {
+					v.Error("Failed to fix associations to archived records: %v", err)
+				}
+			}
+
+			v.Info("Checked %d %s: %d orphaned, %d with conflicting labels, %d associated with archived %s",
+				report.Checked, fromType, report.count(findingOrphan), report.count(findingConflict), report.count(findingArchived), toType)
+			if len(report.Findings) == 0 {
+				return v.Render(nil, nil, report)
+			}
+
+			headers := []string{"FINDING", "FROM ID", "TO ID", "DETAIL", "FIXED"}
+			rows := make([][]string, 0, len(report.Findings))
+			for _, f := range report.Findings {
+				detail := ""
+				switch f.Kind {
+				case findingOrphan:
+					detail = "no associated " + toType
+				case findingConflict:
+					detail = strings.Join(f.Labels, ", ")
+				case findingArchived:
+					detail = toType + " record archived"
+				}
+				rows = append(rows, []string{f.Kind, f.FromID, f.ToID, detail, formatFixed(f.Fixed)})
+			}
+			return v.Render(headers, rows, report)
+		},
+	}
+
+	cmd.Flags().StringVar(&fromType, "from-type", "", "Object type to audit (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&toType, "to-type", "", "Associated object type to check (contacts, companies, deals, etc.)")
+	cmd.Flags().IntVar(&max, "max", 0, "Audit at most this many records (0 for all)")
+	cmd.Flags().StringSliceVar(&fixArgs, "fix", nil, "Finding classes to fix: orphans, conflicts, archived (comma-separated or repeatable)")
+	cmd.Flags().StringVar(&assignTo, "assign-to", "", "Record ID to associate orphans with (for --fix orphans)")
+	cmd.Flags().StringVar(&keepLabel, "keep-label", "", "Label to keep on conflicting pairs (for --fix conflicts)")
+	cmd.Flags().BoolVar(&force, "force", false, "Apply --fix without prompt")
+
+	return cmd
+}
+
+func formatFixed(fixed bool) string {
+	if fixed {
+		return "yes"
+	}
+	return ""
+}
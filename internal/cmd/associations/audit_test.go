@@ -0,0 +1,89 @@
+package associations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestAuditAssociations(t *testing.T) {
+	primary := api.AssociationType{Category: "HUBSPOT_DEFINED", TypeID: 3}
+	decider := api.AssociationType{Category: "USER_DEFINED", TypeID: 36, Label: "Decision maker"}
+	billing := api.AssociationType{Category: "USER_DEFINED", TypeID: 37, Label: "Billing contact"}
+
+	associations := map[string][]api.Association{
+		"1": {
+			{ToObjectID: "10", AssociationTypes: []api.AssociationType{primary, decider}},
+			{ToObjectID: "11", AssociationTypes: []api.AssociationType{primary, decider, billing}},
+		},
+		"3": {
+			{ToObjectID: "99", AssociationTypes: []api.AssociationType{primary}},
+		},
+	}
+	live := map[string]bool{"10": true, "11": true}
+
+	findings := auditAssociations([]string{"3", "2", "1"}, associations, live)
+	require.Len(t, findings, 3)
+
+	assert.Equal(t, findingConflict, findings[0].Kind)
+	assert.Equal(t, "1", findings[0].FromID)
+	assert.Equal(t, "11", findings[0].ToID)
+	assert.Equal(t, []string{"Decision maker", "Billing contact"}, findings[0].Labels)
+
+	assert.Equal(t, auditFinding{Kind: findingOrphan, FromID: "2"}, findings[1])
+	assert.Equal(t, auditFinding{Kind: findingArchived, FromID: "3", ToID: "99"}, findings[2])
+
+	assert.Empty(t, auditAssociations([]string{"1"}, map[string][]api.Association{
+		"1": {{ToObjectID: "10", AssociationTypes: []api.AssociationType{primary}}},
+	}, live))
+}
+
+func TestParseFixes(t *testing.T) {
+	fixes, err := parseFixes([]string{"Orphans", " archived", ""})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{findingOrphan: true, findingArchived: true}, fixes)
+
+	_, err = parseFixes([]string{"duplicates"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --fix")
+}
+
+func TestLabelsToRemove(t *testing.T) {
+	decider := api.AssociationType{Category: "USER_DEFINED", TypeID: 36, Label: "Decision maker"}
+	billing := api.AssociationType{Category: "USER_DEFINED", TypeID: 37, Label: "Billing contact"}
+	champion := api.AssociationType{Category: "USER_DEFINED", TypeID: 38, Label: "Champion"}
+
+	a := &auditFinding{Kind: findingConflict, FromID: "1", ToID: "10", types: []api.AssociationType{decider, billing}}
+	b := &auditFinding{Kind: findingConflict, FromID: "2", ToID: "20", types: []api.AssociationType{decider, billing, champion}}
+	c := &auditFinding{Kind: findingConflict, FromID: "3", ToID: "30", types: []api.AssociationType{billing, champion}}
+
+	remove := labelsToRemove([]*auditFinding{a, b, c}, decider.Spec())
+	assert.Equal(t, map[api.AssociationSpec][]*auditFinding{
+		billing.Spec():  {a, b},
+		champion.Spec(): {b},
+	}, remove)
+}
+
+func TestAuditPairs(t *testing.T) {
+	findings := []*auditFinding{{FromID: "1", ToID: "10"}, {FromID: "2"}}
+	assert.Equal(t, []api.AssociationPair{{FromID: "1", ToID: "10"}, {FromID: "2"}}, auditPairs(findings, ""))
+	assert.Equal(t, []api.AssociationPair{{FromID: "1", ToID: "5"}, {FromID: "2", ToID: "5"}}, auditPairs(findings, "5"))
+}
+
+func TestInBatches(t *testing.T) {
+	findings := make([]*auditFinding, api.MaxBatchSize+1)
+	for i := range findings {
+		findings[i] = &auditFinding{}
+	}
+
+	var sizes []int
+	require.NoError(t, inBatches(findings, func(batch []*auditFinding) error {
+		sizes = append(sizes, len(batch))
+		return nil
+	}))
+	assert.Equal(t, []int{api.MaxBatchSize, 1}, sizes)
+	assert.True(t, findings[api.MaxBatchSize].Fixed)
+}
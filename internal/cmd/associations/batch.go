@@ -0,0 +1,241 @@
+package associations
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// batchSummary is the outcome of a batch create or delete
+type batchSummary struct {
+	Pairs     int      `json:"pairs"`
+	Succeeded int      `json:"succeeded"`
+	Failed    int      `json:"failed"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+func newBatchCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "Create or delete associations in bulk",
+		Long: `Create or delete many associations from a CSV file.
+
+The file needs a header row with a column of source object IDs and a
+column of target object IDs (from_id and to_id by default). Pairs are
+sent in batches of up to 100.`,
+	}
+
+	cmd.AddCommand(newBatchRunCmd(opts, true))
+	cmd.AddCommand(newBatchRunCmd(opts, false))
+
+	return cmd
+}
+
+// newBatchRunCmd builds "batch create" (create true) or "batch delete"
+func newBatchRunCmd(opts *root.Options, create bool) *cobra.Command {
+	var fromType, toType, file, fromColumn, toColumn, label string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete associations listed in a CSV file",
+		Example: `  # Remove every association between the listed pairs
+  hspt associations batch delete --from-type contacts --to-type companies --file pairs.csv --force
+
+  # Remove only one label from each pair
+  hspt associations batch delete --from-type contacts --to-type companies --file pairs.csv --label "Decision maker" --force`,
+	}
+	if create {
+		cmd.Use = "create"
+		cmd.Short = "Create associations listed in a CSV file"
+		cmd.Example = `  # Link contacts to companies with the default association
+  hspt associations batch create --from-type contacts --to-type companies --file pairs.csv
+
+  # Apply a custom label, reading IDs from other columns
+  hspt associations batch create --from-type contacts --to-type companies --file export.csv --from-column "Contact ID" --to-column "Company ID" --label "Decision maker"`
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		v := opts.View()
+
+		if fromType == "" || toType == "" || file == "" {
+			return fmt.Errorf("--from-type, --to-type, and --file are required")
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer f.Close()
+
+		pairs, err := readPairs(f, fromColumn, toColumn)
+		if err != nil {
+			return err
+		}
+		if len(pairs) == 0 {
+			v.Info("No association pairs found in %s", file)
+			return nil
+		}
+
+		if !create && !force {
+			v.Warning("This will delete associations for %d pair(s) of %s and %s. Use --force to confirm.", len(pairs), fromType, toType)
+			return nil
+		}
+
+		client, err := opts.APIClient()
+		if err != nil {
+			return err
+		}
+
+		var types []api.AssociationSpec
+		if label != "" {
+			spec, err := lookupLabel(cmd.Context(), client, fromType, toType, label)
+			if err != nil {
+				return err
+			}
+			types = []api.AssociationSpec{spec}
+		}
+
+		from, to := api.ObjectType(fromType), api.ObjectType(toType)
+		summary := &batchSummary{Pairs: len(pairs)}
+
+		for start := 0; start < len(pairs); start += api.MaxBatchSize {
+			end := start + api.MaxBatchSize
+			if end > len(pairs) {
+				end = len(pairs)
+			}
+			chunk := pairs[start:end]
+
+			var failed int
+			var errs []string
+			switch {
+			case create:
+				result, err := client.BatchCreateAssociations(cmd.Context(), from, to, chunk, types)
+				if err != nil {
+					failed, errs = len(chunk), []string{err.Error()}
+					break
+				}
+				failed = result.NumErrors
+				for _, e := range result.Errors {
+					errs = append(errs, e.Message)
+				}
+			case label != "":
+				if err := client.BatchRemoveAssociationLabels(cmd.Context(), from, to, chunk, types); err != nil {
+					failed, errs = len(chunk), []string{err.Error()}
+				}
+			default:
+				if err := client.BatchDeleteAssociations(cmd.Context(), from, to, chunk); err != nil {
+					failed, errs = len(chunk), []string{err.Error()}
+				}
+			}
+
+			summary.Succeeded += len(chunk) - failed
+			summary.Failed += failed
+			for _, e := range errs {
+				summary.Errors = append(summary.Errors, fmt.Sprintf("Pairs %d-%d: %s", start+1, end, e))
+			}
+		}
+
+		verb := "Deleted"
+		if create {
+			verb = "Created"
+		}
+		if summary.Failed == 0 {
+			v.Success("%s associations for %d pair(s)", verb, summary.Succeeded)
+		} else {
+			v.Warning("%s associations for %d of %d pair(s); %d failed", verb, summary.Succeeded, summary.Pairs, summary.Failed)
+		}
+
+		headers := []string{"PAIRS", "SUCCEEDED", "FAILED"}
+		rows := [][]string{
+			{fmt.Sprintf("%d", summary.Pairs), fmt.Sprintf("%d", summary.Succeeded), fmt.Sprintf("%d", summary.Failed)},
+		}
+		if err := v.Render(headers, rows, summary); err != nil {
+			return err
+		}
+
+		for _, e := range summary.Errors {
+			v.Error("%s", e)
+		}
+
+		return nil
+	}
+
+	cmd.Flags().StringVar(&fromType, "from-type", "", "Source object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&toType, "to-type", "", "Target object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&file, "file", "", "CSV file of ID pairs (required)")
+	cmd.Flags().StringVar(&fromColumn, "from-column", "from_id", "CSV column holding source object IDs")
+	cmd.Flags().StringVar(&toColumn, "to-column", "to_id", "CSV column holding target object IDs")
+	if create {
+		cmd.Flags().StringVar(&label, "label", "", "Association label to apply to every pair")
+	} else {
+		cmd.Flags().StringVar(&label, "label", "", "Remove only this association label")
+		cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+	}
+
+	return cmd
+}
+
+// readPairs reads ID pairs from a CSV with a header row, skipping rows
+// where either ID is blank
+func readPairs(r io.Reader, fromColumn, toColumn string) ([]api.AssociationPair, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("CSV file is empty")
+		}
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	fromIdx, toIdx := -1, -1
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		switch {
+		case strings.EqualFold(h, fromColumn):
+			fromIdx = i
+		case strings.EqualFold(h, toColumn):
+			toIdx = i
+		}
+	}
+	if fromIdx < 0 || toIdx < 0 {
+		return nil, fmt.Errorf("CSV header must include %q and %q columns", fromColumn, toColumn)
+	}
+
+	var pairs []api.AssociationPair
+	row := 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		row++
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV row %d: %w", row, err)
+		}
+
+		var fromID, toID string
+		if fromIdx < len(record) {
+			fromID = strings.TrimSpace(record[fromIdx])
+		}
+		if toIdx < len(record) {
+			toID = strings.TrimSpace(record[toIdx])
+		}
+		if fromID == "" || toID == "" {
+			continue
+		}
+		pairs = append(pairs, api.AssociationPair{FromID: fromID, ToID: toID})
+	}
+
+	return pairs, nil
+}
@@ -0,0 +1,247 @@
+package associations
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+func newLabelsCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "labels",
+		Short: "Manage association labels",
+		Long:  "Commands for listing, creating, and deleting custom association labels between two object types.",
+	}
+
+	cmd.AddCommand(newLabelsListCmd(opts))
+	cmd.AddCommand(newLabelsCreateCmd(opts))
+	cmd.AddCommand(newLabelsDeleteCmd(opts))
+
+	return cmd
+}
+
+func newLabelsListCmd(opts *root.Options) *cobra.Command {
+	var fromType, toType string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List association labels",
+		Long:  "List the association types, including custom labels, defined from one object type to another.",
+		Example: `  # Labels available between contacts and companies
+  hspt associations labels list --from-type contacts --to-type companies`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if fromType == "" || toType == "" {
+				return fmt.Errorf("--from-type and --to-type are required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListAssociationLabels(cmd.Context(), api.ObjectType(fromType), api.ObjectType(toType))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No association labels found")
+				return nil
+			}
+
+			headers := []string{"TYPE ID", "LABEL", "CATEGORY"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, l := range result.Results {
+				label := l.Label
+				if label == "" {
+					label = "(unlabeled)"
+				}
+				rows = append(rows, []string{strconv.Itoa(l.TypeID), label, l.Category})
+			}
+
+			return v.Render(headers, rows, result)
+		},
+	}
+
+	cmd.Flags().StringVar(&fromType, "from-type", "", "Source object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&toType, "to-type", "", "Target object type (contacts, companies, deals, etc.)")
+
+	return cmd
+}
+
+func newLabelsCreateCmd(opts *root.Options) *cobra.Command {
+	var fromType, toType, label, name, inverseLabel string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an association label",
+		Long: `Create a custom association label between two object types.
+
+Use --inverse-label for paired labels that read differently from each
+side, such as Manager and Employee.`,
+		Example: `  # Create a label
+  hspt associations labels create --from-type contacts --to-type companies --label "Decision maker"
+
+  # Create a paired label
+  hspt associations labels create --from-type contacts --to-type contacts --label Manager --inverse-label Employee`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if fromType == "" || toType == "" || label == "" {
+				return fmt.Errorf("--from-type, --to-type, and --label are required")
+			}
+			if name == "" {
+				name = labelName(label)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.CreateAssociationLabel(cmd.Context(), api.ObjectType(fromType), api.ObjectType(toType), label, name, inverseLabel)
+			if err != nil {
+				return err
+			}
+
+			for _, l := range result.Results {
+				v.Success("Association label %q created with type ID %d", l.Label, l.TypeID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fromType, "from-type", "", "Source object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&toType, "to-type", "", "Target object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&label, "label", "", "Label shown in HubSpot (required)")
+	cmd.Flags().StringVar(&name, "name", "", "Internal name (defaults to the label in snake_case)")
+	cmd.Flags().StringVar(&inverseLabel, "inverse-label", "", "Label shown from the other side of a paired label")
+
+	return cmd
+}
+
+func newLabelsDeleteCmd(opts *root.Options) *cobra.Command {
+	var fromType, toType, label string
+	var typeID int
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete an association label",
+		Long:  "Delete a custom association label, identified by --label or --type-id.",
+		Example: `  # Delete a label by name
+  hspt associations labels delete --from-type contacts --to-type companies --label "Decision maker" --force
+
+  # Delete a label by type ID
+  hspt associations labels delete --from-type contacts --to-type companies --type-id 36 --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if fromType == "" || toType == "" {
+				return fmt.Errorf("--from-type and --to-type are required")
+			}
+			if label == "" && typeID == 0 {
+				return fmt.Errorf("--label or --type-id is required")
+			}
+
+			name := label
+			if name == "" {
+				name = fmt.Sprintf("type %d", typeID)
+			}
+
+			if !force {
+				v.Warning("This will delete association label %q between %s and %s. Use --force to confirm.", name, fromType, toType)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if label != "" {
+				spec, err := lookupLabel(cmd.Context(), client, fromType, toType, label)
+				if err != nil {
+					return err
+				}
+				typeID = spec.TypeID
+			}
+
+			if err := client.DeleteAssociationLabel(cmd.Context(), api.ObjectType(fromType), api.ObjectType(toType), typeID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Association label %s not found", name)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Association label %q deleted", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fromType, "from-type", "", "Source object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&toType, "to-type", "", "Target object type (contacts, companies, deals, etc.)")
+	cmd.Flags().StringVar(&label, "label", "", "Label to delete")
+	cmd.Flags().IntVar(&typeID, "type-id", 0, "Association type ID to delete")
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+	cmd.MarkFlagsMutuallyExclusive("label", "type-id")
+
+	return cmd
+}
+
+// lookupLabel resolves a label name to its association type between two
+// object types
+func lookupLabel(ctx context.Context, client *api.Client, fromType, toType, label string) (api.AssociationSpec, error) {
+	labels, err := client.ListAssociationLabels(ctx, api.ObjectType(fromType), api.ObjectType(toType))
+	if err != nil {
+		return api.AssociationSpec{}, fmt.Errorf("failed to load association labels: %w", err)
+	}
+	return findLabel(labels.Results, label, fromType, toType)
+}
+
+// findLabel matches label case-insensitively against the defined types
+func findLabel(types []api.AssociationType, label, fromType, toType string) (api.AssociationSpec, error) {
+	var available []string
+	for _, t := range types {
+		if t.Label == "" {
+			continue
+		}
+		if strings.EqualFold(t.Label, label) {
+			return t.Spec(), nil
+		}
+		available = append(available, t.Label)
+	}
+
+	if len(available) == 0 {
+		return api.AssociationSpec{}, fmt.Errorf("no association labels are defined from %s to %s", fromType, toType)
+	}
+	return api.AssociationSpec{}, fmt.Errorf("unknown association label %q from %s to %s (available: %s)", label, fromType, toType, strings.Join(available, ", "))
+}
+
+// labelName derives an internal name from a label, e.g. "Decision maker"
+// becomes decision_maker
+func labelName(label string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(strings.TrimSpace(label)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
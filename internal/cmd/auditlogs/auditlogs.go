@@ -0,0 +1,347 @@
+package auditlogs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// Register registers the audit-logs command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "audit-logs",
+		Short: "Review portal activity for security audits",
+		Long: `Commands for reviewing the portal's account activity: the audit log of
+changes users made, login history, and security activity.
+
+Every list can be narrowed to one user and a time range, and exported as
+CSV with -o csv. These APIs need an Enterprise portal and the
+account-info.security.read scope.`,
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newLoginsCmd(opts))
+	cmd.AddCommand(newSecurityCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// activityFlags are the flags every activity list takes
+type activityFlags struct {
+	since, until, user string
+	limit              int
+	after              string
+	pages              shared.AllPages
+}
+
+func (f *activityFlags) add(cmd *cobra.Command, noun string) {
+	cmd.Flags().StringVar(&f.since, "since", "", "Only activity after this date (2024-01-01) or this long ago (30d, 12w, 36h)")
+	cmd.Flags().StringVar(&f.until, "until", "", "Only activity before this date or this long ago")
+	cmd.Flags().StringVar(&f.user, "user", "", "Only activity of this user, by user ID or email")
+	cmd.Flags().IntVar(&f.limit, "limit", 10, fmt.Sprintf("Maximum number of %s to return", noun))
+	cmd.Flags().StringVar(&f.after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &f.pages)
+}
+
+// query turns the flags into an activity query, looking up a user given by
+// email
+func (f *activityFlags) query(ctx context.Context, client *api.Client, now time.Time) (api.ActivityQuery, error) {
+	var q api.ActivityQuery
+	var err error
+
+	if f.since != "" {
+		if q.OccurredAfter, err = shared.ParseSince(f.since, now); err != nil {
+			return q, err
+		}
+	}
+	if f.until != "" {
+		if q.OccurredBefore, err = shared.ParseSince(f.until, now); err != nil {
+			return q, fmt.Errorf("invalid --until %q: use a date (2024-01-31) or days (30d), weeks (12w), or a duration (36h) ago", f.until)
+		}
+	}
+	if !q.OccurredAfter.IsZero() && !q.OccurredBefore.IsZero() && !q.OccurredBefore.After(q.OccurredAfter) {
+		return q, fmt.Errorf("--until must be after --since")
+	}
+
+	q.UserID, err = resolveUser(ctx, client, f.user)
+	return q, err
+}
+
+// resolveUser returns the HubSpot user ID of a user given by ID or email.
+// Users removed from the portal are found by email too, since their past
+// activity is often what an audit is after.
+func resolveUser(ctx context.Context, client *api.Client, user string) (string, error) {
+	user = strings.TrimSpace(user)
+	if user == "" || !strings.Contains(user, "@") {
+		return user, nil
+	}
+
+	owner, err := client.GetOwnerByEmail(ctx, user)
+	if api.IsNotFound(err) {
+		owner, err = client.GetArchivedOwnerByEmail(ctx, user)
+	}
+	if err != nil {
+		if api.IsNotFound(err) {
+			return "", fmt.Errorf("no HubSpot user has email %s; use their user ID instead", user)
+		}
+		return "", fmt.Errorf("failed to look up user: %w", err)
+	}
+	if owner.UserID == 0 {
+		return "", fmt.Errorf("%s has no HubSpot user ID; use their user ID instead", user)
+	}
+	return strconv.FormatInt(owner.UserID, 10), nil
+}
+
+// userText names the user of an activity by email, falling back to their ID
+func userText(email string, userID int64) string {
+	if email != "" {
+		return email
+	}
+	if userID == 0 {
+		return "-"
+	}
+	return strconv.FormatInt(userID, 10)
+}
+
+// morePages points to the next page of a list read one page at a time
+func morePages(v *view.View, paging *api.Paging) {
+	if paging != nil && paging.Next != nil {
+		v.Info("\nMore results available. Use --after %s to get the next page.", paging.Next.After)
+	}
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var f activityFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit logs",
+		Long:  "List the changes users made in the portal, such as settings updated, records deleted, or users added, newest first.",
+		Example: `  # Changes since the start of the year
+  hspt audit-logs list --since 2024-01-01
+
+  # One user's changes in the last 30 days
+  hspt audit-logs list --user ada@example.com --since 30d --all
+
+  # Export a quarter for review
+  hspt audit-logs list --since 2024-01-01 --until 2024-04-01 --all -o csv > audit-q1.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			query, err := f.query(cmd.Context(), client, time.Now())
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListAuditLogs(cmd.Context(), query, f.pages.Apply(cmd, api.ListOptions{
+				Limit: f.limit,
+				After: f.after,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list audit logs: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No audit logs found")
+				return nil
+			}
+
+			headers := []string{"OCCURRED", "USER", "CATEGORY", "SUB CATEGORY", "ACTION", "TARGET ID"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, log := range result.Results {
+				user := "-"
+				if log.ActingUser != nil {
+					user = userText(log.ActingUser.UserEmail, log.ActingUser.UserID)
+				}
+				rows = append(rows, []string{
+					v.Time(log.OccurredAt),
+					user,
+					log.Category,
+					log.SubCategory,
+					log.Action,
+					log.TargetObjectID,
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			morePages(v, result.Paging)
+			return nil
+		},
+	}
+
+	f.add(cmd, "audit logs")
+
+	return cmd
+}
+
+func newLoginsCmd(opts *root.Options) *cobra.Command {
+	var f activityFlags
+	var failed bool
+
+	cmd := &cobra.Command{
+		Use:   "logins",
+		Short: "List login history",
+		Long:  "List users' logins to the portal, with where they logged in from and whether the login succeeded, newest first.",
+		Example: `  # Recent logins
+  hspt audit-logs logins --since 7d
+
+  # Failed logins this month
+  hspt audit-logs logins --since 30d --failed --all
+
+  # One user's login history as CSV
+  hspt audit-logs logins --user ada@example.com --all -o csv > logins.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			query, err := f.query(cmd.Context(), client, time.Now())
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListLoginActivity(cmd.Context(), query, f.pages.Apply(cmd, api.ListOptions{
+				Limit: f.limit,
+				After: f.after,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list login activity: %w", err)
+			}
+
+			if failed {
+				kept := result.Results[:0]
+				for _, login := range result.Results {
+					if !login.LoginSucceeded {
+						kept = append(kept, login)
+					}
+				}
+				result.Results = kept
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No logins found")
+				morePages(v, result.Paging)
+				return nil
+			}
+
+			headers := []string{"LOGIN AT", "USER", "RESULT", "IP ADDRESS", "LOCATION", "USER AGENT"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, login := range result.Results {
+				outcome := "Succeeded"
+				if !login.LoginSucceeded {
+					outcome = "Failed"
+				}
+				rows = append(rows, []string{
+					v.Time(login.LoginAt),
+					userText(login.Email, login.UserID),
+					outcome,
+					login.IPAddress,
+					login.Location,
+					login.UserAgent,
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			morePages(v, result.Paging)
+			return nil
+		},
+	}
+
+	f.add(cmd, "logins")
+	cmd.Flags().BoolVar(&failed, "failed", false, "Only failed logins")
+
+	return cmd
+}
+
+func newSecurityCmd(opts *root.Options) *cobra.Command {
+	var f activityFlags
+
+	cmd := &cobra.Command{
+		Use:   "security",
+		Short: "List security activity",
+		Long:  "List security-related activity in the portal, such as data exports and changes to two-factor authentication, newest first.",
+		Example: `  # Security activity in the last 90 days
+  hspt audit-logs security --since 90d --all
+
+  # One user's, as CSV
+  hspt audit-logs security --user 12345 --all -o csv > security.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			query, err := f.query(cmd.Context(), client, time.Now())
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListSecurityActivity(cmd.Context(), query, f.pages.Apply(cmd, api.ListOptions{
+				Limit: f.limit,
+				After: f.after,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list security activity: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No security activity found")
+				return nil
+			}
+
+			headers := []string{"OCCURRED", "USER", "TYPE", "OBJECT ID", "IP ADDRESS", "LOCATION"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, a := range result.Results {
+				user := userText("", a.UserID)
+				if a.ActingUser != nil {
+					user = userText(a.ActingUser.UserEmail, a.ActingUser.UserID)
+				}
+				rows = append(rows, []string{
+					v.Time(a.CreatedAt),
+					user,
+					a.Type,
+					a.ObjectID,
+					a.IPAddress,
+					a.Location,
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			morePages(v, result.Paging)
+			return nil
+		},
+	}
+
+	f.add(cmd, "security events")
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package auditlogs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestResolveUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("email") == "ada@example.com" && r.URL.Query().Get("archived") == "":
+			_, _ = w.Write([]byte(`{"results": [{"id": "500", "email": "ada@example.com", "userId": 42}]}`))
+		case r.URL.Query().Get("email") == "gone@example.com" && r.URL.Query().Get("archived") == "true":
+			_, _ = w.Write([]byte(`{"results": [{"id": "501", "email": "gone@example.com", "userId": 43}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"results": []}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	ctx := context.Background()
+
+	id, err := resolveUser(ctx, client, "")
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	id, err = resolveUser(ctx, client, " 12345 ")
+	require.NoError(t, err)
+	assert.Equal(t, "12345", id)
+
+	id, err = resolveUser(ctx, client, "ada@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "42", id)
+
+	id, err = resolveUser(ctx, client, "gone@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "43", id)
+
+	_, err = resolveUser(ctx, client, "nobody@example.com")
+	assert.ErrorContains(t, err, "no HubSpot user has email nobody@example.com")
+}
+
+func TestActivityFlagsQuery(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	f := activityFlags{since: "2024-01-01", until: "7d", user: "12345"}
+	q, err := f.query(context.Background(), nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, "12345", q.UserID)
+	assert.Equal(t, 2024, q.OccurredAfter.Year())
+	assert.Equal(t, time.January, q.OccurredAfter.Month())
+	assert.Equal(t, now.Add(-7*24*time.Hour), q.OccurredBefore)
+
+	q, err = (&activityFlags{}).query(context.Background(), nil, now)
+	require.NoError(t, err)
+	assert.True(t, q.OccurredAfter.IsZero())
+	assert.True(t, q.OccurredBefore.IsZero())
+
+	_, err = (&activityFlags{since: "7d", until: "30d"}).query(context.Background(), nil, now)
+	assert.ErrorContains(t, err, "--until must be after --since")
+
+	_, err = (&activityFlags{until: "soon"}).query(context.Background(), nil, now)
+	assert.ErrorContains(t, err, "invalid --until")
+}
+
+func TestUserText(t *testing.T) {
+	assert.Equal(t, "ada@example.com", userText("ada@example.com", 42))
+	assert.Equal(t, "42", userText("", 42))
+	assert.Equal(t, "-", userText("", 0))
+}
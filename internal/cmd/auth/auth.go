@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// Register registers the auth command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Inspect HubSpot authentication",
+		Long:  "Commands for inspecting the access token hspt authenticates with.",
+	}
+
+	cmd.AddCommand(newWhoamiCmd(opts))
+	cmd.AddCommand(newScopesCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// whoami is the output of "auth whoami"
+type whoami struct {
+	Profile string `json:"profile"`
+	*api.TokenInfo
+}
+
+// whoamiRows lays out token info as property rows, one row per scope
+func whoamiRows(v *view.View, w whoami) [][]string {
+	rows := [][]string{
+		{"Profile", w.Profile},
+		{"Token Type", w.Type},
+		{"Portal ID", strconv.FormatInt(w.PortalID, 10)},
+	}
+	if w.HubDomain != "" {
+		rows = append(rows, []string{"Hub Domain", w.HubDomain})
+	}
+	if w.AppID != 0 {
+		rows = append(rows, []string{"App ID", strconv.FormatInt(w.AppID, 10)})
+	}
+	if w.User != "" {
+		rows = append(rows, []string{"User", w.User})
+	} else if w.UserID != 0 {
+		rows = append(rows, []string{"User ID", strconv.FormatInt(w.UserID, 10)})
+	}
+	if w.ExpiresAt != nil {
+		rows = append(rows, []string{"Expires", v.Time(w.ExpiresAt.Format(time.RFC3339))})
+	} else {
+		rows = append(rows, []string{"Expires", "never"})
+	}
+
+	scopes := append([]string{}, w.Scopes...)
+	sort.Strings(scopes)
+	for i, s := range scopes {
+		label := ""
+		if i == 0 {
+			label = fmt.Sprintf("Scopes (%d)", len(scopes))
+		}
+		rows = append(rows, []string{label, s})
+	}
+	if len(scopes) == 0 {
+		rows = append(rows, []string{"Scopes", "none"})
+	}
+
+	return rows
+}
+
+func newWhoamiCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the portal, app, and scopes of the access token",
+		Long: `Look up the access token hspt is using and show the portal it belongs to,
+the app and user behind it, its scopes, and when it expires.
+
+Private app tokens (pat-...) are looked up with the private app token info
+endpoint and never expire; other tokens are treated as OAuth access tokens.
+When a command fails with 403, compare the scopes listed here with the ones
+the endpoint needs.`,
+		Example: `  # Show the current token
+  hspt auth whoami
+
+  # Check whether the token can write deals
+  hspt auth whoami -o json | jq '.scopes | index("crm.objects.deals.write")'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			info, err := client.GetTokenInfo(cmd.Context())
+			if err != nil {
+				if api.IsUnauthorized(err) || api.IsNotFound(err) {
+					return fmt.Errorf("access token is invalid or expired: %w", err)
+				}
+				return fmt.Errorf("failed to look up access token: %w", err)
+			}
+
+			// Private app token info has no hub domain; the account details
+			// have it when the token has the account-info scope
+			if info.HubDomain == "" {
+				if details, err := client.GetAccountDetails(cmd.Context()); err == nil {
+					info.HubDomain = details.UIDomain
+				}
+			}
+
+			profile := "-"
+			if cfg, err := config.Load(); err == nil {
+				profile = cfg.ActiveProfileName()
+			}
+
+			w := whoami{Profile: profile, TokenInfo: info}
+			return v.Render([]string{"PROPERTY", "VALUE"}, whoamiRows(v, w), w)
+		},
+	}
+}
+
+// scopeStatus is one row of "auth scopes": a scope a command group needs, or
+// a granted scope no known group needs
+type scopeStatus struct {
+	Group   string `json:"group"`
+	Access  string `json:"access"`
+	Scope   string `json:"scope"`
+	Granted bool   `json:"granted"`
+}
+
+// scopeStatuses compares the granted scopes with each command group's known
+// requirements. Granted scopes no group needs are listed last under "other".
+func scopeStatuses(granted []string) []scopeStatus {
+	has := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		has[s] = true
+	}
+
+	known := make(map[string]bool)
+	var statuses []scopeStatus
+	for _, req := range api.ScopeRequirements {
+		for _, s := range req.Read {
+			statuses = append(statuses, scopeStatus{Group: req.Group, Access: "read", Scope: s, Granted: has[s]})
+			known[s] = true
+		}
+		for _, s := range req.Write {
+			statuses = append(statuses, scopeStatus{Group: req.Group, Access: "write", Scope: s, Granted: has[s]})
+			known[s] = true
+		}
+	}
+
+	other := make([]string, 0, len(granted))
+	for _, s := range granted {
+		if !known[s] {
+			other = append(other, s)
+		}
+	}
+	sort.Strings(other)
+	for _, s := range other {
+		statuses = append(statuses, scopeStatus{Group: "other", Scope: s, Granted: true})
+	}
+
+	return statuses
+}
+
+func newScopesCmd(opts *root.Options) *cobra.Command {
+	var missing bool
+
+	cmd := &cobra.Command{
+		Use:   "scopes",
+		Short: "Compare granted scopes with what each command group needs",
+		Long: `List the scopes each command group is known to need for reading and for
+writing, and whether the access token has been granted them. Granted scopes
+that no command group needs are listed under "other".
+
+When a request fails with 403, hspt names the missing scope in the error, so
+it can be added to the app before the token is reinstalled or rotated.`,
+		Example: `  # Show every command group's scopes
+  hspt auth scopes
+
+  # Only the scopes the token is missing
+  hspt auth scopes --missing`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			granted, err := client.GrantedScopes(cmd.Context())
+			if err != nil {
+				if api.IsUnauthorized(err) || api.IsNotFound(err) {
+					return fmt.Errorf("access token is invalid or expired: %w", err)
+				}
+				return fmt.Errorf("failed to look up access token: %w", err)
+			}
+
+			statuses := scopeStatuses(granted)
+			if missing {
+				filtered := statuses[:0]
+				for _, s := range statuses {
+					if !s.Granted {
+						filtered = append(filtered, s)
+					}
+				}
+				statuses = filtered
+				if len(statuses) == 0 {
+					v.Success("The token has every scope hspt knows about")
+					return nil
+				}
+			}
+
+			headers := []string{"GROUP", "ACCESS", "SCOPE", "GRANTED"}
+			rows := make([][]string, 0, len(statuses))
+			for _, s := range statuses {
+				g := "No"
+				if s.Granted {
+					g = "Yes"
+				}
+				rows = append(rows, []string{s.Group, s.Access, s.Scope, g})
+			}
+
+			return v.Render(headers, rows, statuses)
+		},
+	}
+
+	cmd.Flags().BoolVar(&missing, "missing", false, "Only show scopes the token has not been granted")
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestScopeStatuses(t *testing.T) {
+	statuses := scopeStatuses([]string{"crm.objects.deals.read", "oauth", "crm.objects.deals.write"})
+
+	find := func(group, access string) scopeStatus {
+		for _, s := range statuses {
+			if s.Group == group && s.Access == access {
+				return s
+			}
+		}
+		t.Fatalf("no %s %s scope", group, access)
+		return scopeStatus{}
+	}
+
+	assert.Equal(t, scopeStatus{Group: "deals", Access: "read", Scope: "crm.objects.deals.read", Granted: true}, find("deals", "read"))
+	assert.True(t, find("deals", "write").Granted)
+	assert.False(t, find("contacts", "read").Granted)
+
+	last := statuses[len(statuses)-1]
+	assert.Equal(t, scopeStatus{Group: "other", Scope: "oauth", Granted: true}, last)
+}
+
+func TestWhoamiRows(t *testing.T) {
+	rows := whoamiRows(nil, whoami{
+		Profile:   "default",
+		TokenInfo: &api.TokenInfo{Type: "private-app", PortalID: 12345, AppID: 678, Scopes: []string{"tickets", "crm.objects.deals.read"}},
+	})
+
+	assert.Contains(t, rows, []string{"Portal ID", "12345"})
+	assert.Contains(t, rows, []string{"App ID", "678"})
+	assert.Contains(t, rows, []string{"Expires", "never"})
+	assert.Contains(t, rows, []string{"Scopes (2)", "crm.objects.deals.read"})
+	assert.Contains(t, rows, []string{"", "tickets"})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the blogs command and subcommands
@@ -45,6 +46,7 @@ func newPostsCmd(opts *root.Options) *cobra.Command {
 func newPostsListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -63,10 +65,10 @@ func newPostsListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListBlogPosts(api.ListOptions{
+			result, err := client.ListBlogPosts(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -103,6 +105,8 @@ func newPostsListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of posts to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -123,7 +127,7 @@ func newPostsGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			post, err := client.GetBlogPost(id)
+			post, err := client.GetBlogPost(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Blog post %s not found", id)
@@ -145,8 +149,8 @@ func newPostsGetCmd(opts *root.Options) *cobra.Command {
 				{"Author ID", post.BlogAuthorID},
 				{"Featured Image", truncate(post.FeaturedImage, 40)},
 				{"Archived", formatBool(post.Archived)},
-				{"Created", post.CreatedAt},
-				{"Updated", post.UpdatedAt},
+				{"Created", v.Time(post.CreatedAt)},
+				{"Updated", v.Time(post.UpdatedAt)},
 			}
 
 			if post.PublishDate != "" {
@@ -189,7 +193,7 @@ func newPostsCreateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			post, err := client.CreateBlogPost(postData)
+			post, err := client.CreateBlogPost(cmd.Context(), postData)
 			if err != nil {
 				return err
 			}
@@ -237,7 +241,7 @@ func newPostsUpdateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			post, err := client.UpdateBlogPost(id, updates)
+			post, err := client.UpdateBlogPost(cmd.Context(), id, updates)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Blog post %s not found", id)
@@ -273,7 +277,7 @@ func newPostsDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteBlogPost(id)
+			err = client.DeleteBlogPost(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Blog post %s not found", id)
@@ -303,6 +307,7 @@ func newAuthorsCmd(opts *root.Options) *cobra.Command {
 func newAuthorsListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -318,10 +323,10 @@ func newAuthorsListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListBlogAuthors(api.ListOptions{
+			result, err := client.ListBlogAuthors(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -361,6 +366,8 @@ func newAuthorsListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of authors to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -379,6 +386,7 @@ func newTagsCmd(opts *root.Options) *cobra.Command {
 func newTagsListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -394,10 +402,10 @@ func newTagsListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListBlogTags(api.ListOptions{
+			result, err := client.ListBlogTags(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -433,6 +441,8 @@ func newTagsListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of tags to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
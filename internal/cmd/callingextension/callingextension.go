@@ -0,0 +1,195 @@
+package callingextension
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the calling-extension command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	a := &shared.DeveloperApp{}
+
+	cmd := &cobra.Command{
+		Use:   "calling-extension",
+		Short: "Manage a public app's calling extension",
+		Long: `Commands for a HubSpot public app's calling extension: the calling widget
+HubSpot opens from records when users place calls through the app.
+
+Calling extension APIs use the developer account's API key; pass the app ID
+and key with --app-id and --developer-key, or set HUBSPOT_APP_ID and
+HUBSPOT_DEVELOPER_API_KEY.`,
+	}
+
+	shared.AddDeveloperAppFlags(cmd, a)
+
+	cmd.AddCommand(newSettingsCmd(opts, a))
+
+	parent.AddCommand(cmd)
+}
+
+func newSettingsCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settings",
+		Short: "View or change calling extension settings",
+		Long:  "Commands for the calling extension's settings: the widget's name, URL, and size, and what it supports.",
+	}
+
+	cmd.AddCommand(newSettingsGetCmd(opts, a))
+	cmd.AddCommand(newSettingsUpdateCmd(opts, a))
+
+	return cmd
+}
+
+// settingsFlags maps each update flag to its settings field
+var settingsFlags = []struct{ flag, field string }{
+	{"name", "name"},
+	{"url", "url"},
+	{"width", "width"},
+	{"height", "height"},
+	{"ready", "isReady"},
+	{"supports-custom-objects", "supportsCustomObjects"},
+	{"uses-calling-window", "usesCallingWindow"},
+	{"uses-remote", "usesRemote"},
+}
+
+// settingsUpdate collects the settings whose flags were set
+func settingsUpdate(flags *pflag.FlagSet) (map[string]interface{}, error) {
+	update := map[string]interface{}{}
+	for _, f := range settingsFlags {
+		if !flags.Changed(f.flag) {
+			continue
+		}
+		var value interface{}
+		var err error
+		switch flags.Lookup(f.flag).Value.Type() {
+		case "int":
+			value, err = flags.GetInt(f.flag)
+		case "bool":
+			value, err = flags.GetBool(f.flag)
+		default:
+			value, err = flags.GetString(f.flag)
+		}
+		if err != nil {
+			return nil, err
+		}
+		update[f.field] = value
+	}
+	return update, nil
+}
+
+// settingsRows are the rows of the settings
+func settingsRows(s *api.CallingSettings) [][]string {
+	return [][]string{
+		{"Name", s.Name},
+		{"URL", s.URL},
+		{"Width", strconv.Itoa(s.Width)},
+		{"Height", strconv.Itoa(s.Height)},
+		{"Ready", strconv.FormatBool(s.IsReady)},
+		{"Supports Custom Objects", strconv.FormatBool(s.SupportsCustomObjects)},
+		{"Uses Calling Window", strconv.FormatBool(s.UsesCallingWindow)},
+		{"Uses Remote", strconv.FormatBool(s.UsesRemote)},
+	}
+}
+
+func newSettingsGetCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get",
+		Short: "Get calling extension settings",
+		Long:  "Show the app's calling extension settings.",
+		Example: `  # Show settings
+  HUBSPOT_DEVELOPER_API_KEY=... hspt calling-extension settings get --app-id 123456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			settings, err := client.GetCallingSettings(cmd.Context(), a.ID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("App %s has no calling extension settings; create them with settings update --name --url", a.ID)
+					return nil
+				}
+				return fmt.Errorf("failed to get calling extension settings: %w", err)
+			}
+
+			return v.Render([]string{"PROPERTY", "VALUE"}, settingsRows(settings), settings)
+		},
+	}
+}
+
+func newSettingsUpdateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var name, url string
+	var width, height int
+	var ready, customObjects, callingWindow, remote bool
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update calling extension settings",
+		Long: `Update the app's calling extension settings. Only the flags given are
+changed. If the app has no settings yet they are created, which needs --name
+and --url.`,
+		Example: `  # Set up the extension
+  hspt calling-extension settings update --app-id 123456 --name "Acme Dialer" \
+    --url https://dialer.example.com/widget --width 400 --height 600
+
+  # Mark it ready for users
+  hspt calling-extension settings update --app-id 123456 --ready
+
+  # Take it out of service
+  hspt calling-extension settings update --app-id 123456 --ready=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			update, err := settingsUpdate(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if len(update) == 0 {
+				return fmt.Errorf("nothing to update: set --name, --url, --width, --height, --ready, --supports-custom-objects, --uses-calling-window, or --uses-remote")
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			settings, err := client.UpdateCallingSettings(cmd.Context(), a.ID, update)
+			if err != nil && api.IsNotFound(err) {
+				if name == "" || url == "" {
+					return fmt.Errorf("app %s has no calling extension settings yet: --name and --url are required to create them", a.ID)
+				}
+				if settings, err = client.CreateCallingSettings(cmd.Context(), a.ID, update); err == nil {
+					v.Success("Calling extension settings created")
+					return v.Render([]string{"PROPERTY", "VALUE"}, settingsRows(settings), settings)
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to update calling extension settings: %w", err)
+			}
+
+			v.Success("Calling extension settings updated")
+			return v.Render([]string{"PROPERTY", "VALUE"}, settingsRows(settings), settings)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name shown to users for the calling provider")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the calling widget")
+	cmd.Flags().IntVar(&width, "width", 0, "Widget width in pixels")
+	cmd.Flags().IntVar(&height, "height", 0, "Widget height in pixels")
+	cmd.Flags().BoolVar(&ready, "ready", false, "Whether the extension is ready for users")
+	cmd.Flags().BoolVar(&customObjects, "supports-custom-objects", false, "Whether calls can be placed from custom object records")
+	cmd.Flags().BoolVar(&callingWindow, "uses-calling-window", false, "Whether the widget opens in a separate calling window")
+	cmd.Flags().BoolVar(&remote, "uses-remote", false, "Whether the widget is opened from the HubSpot remote")
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package callingextension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+func TestSettingsUpdate(t *testing.T) {
+	cmd := newSettingsUpdateCmd(&root.Options{}, &shared.DeveloperApp{})
+	require.NoError(t, cmd.ParseFlags([]string{"--name", "Acme Dialer", "--width", "400", "--ready=false"}))
+
+	update, err := settingsUpdate(cmd.Flags())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "Acme Dialer", "width": 400, "isReady": false}, update)
+}
+
+func TestSettingsUpdate_Nothing(t *testing.T) {
+	cmd := newSettingsUpdateCmd(&root.Options{}, &shared.DeveloperApp{})
+	require.NoError(t, cmd.ParseFlags(nil))
+
+	update, err := settingsUpdate(cmd.Flags())
+	require.NoError(t, err)
+	assert.Empty(t, update)
+}
+
+func TestSettingsRows(t *testing.T) {
+	rows := settingsRows(&api.CallingSettings{Name: "Acme Dialer", Width: 400, IsReady: true})
+	assert.Equal(t, []string{"Name", "Acme Dialer"}, rows[0])
+	assert.Equal(t, []string{"Width", "400"}, rows[2])
+	assert.Equal(t, []string{"Ready", "true"}, rows[4])
+}
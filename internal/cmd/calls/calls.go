@@ -8,6 +8,8 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for calls
@@ -26,6 +28,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newCreateCmd(opts))
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newRecordingURLCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -34,6 +37,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -56,11 +60,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeCalls, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeCalls, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -78,7 +82,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 					obj.GetProperty("hs_call_direction"),
 					obj.GetProperty("hs_call_duration"),
 					obj.GetProperty("hs_call_status"),
-					obj.GetProperty("hs_timestamp"),
+					v.Time(obj.GetProperty("hs_timestamp")),
 				})
 			}
 
@@ -98,11 +102,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -124,7 +131,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeCalls, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeCalls, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Call %s not found", id)
@@ -140,24 +147,29 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Direction", obj.GetProperty("hs_call_direction")},
 				{"Duration", obj.GetProperty("hs_call_duration")},
 				{"Status", obj.GetProperty("hs_call_status")},
-				{"Timestamp", obj.GetProperty("hs_timestamp")},
+				{"Timestamp", v.Time(obj.GetProperty("hs_timestamp"))},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
 	var body, direction, duration, status, timestamp, ownerID string
-	var props []string
+	var props, associate []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -167,10 +179,23 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   hspt calls create --body "Discussion about project" --direction INBOUND --duration 300
 
   # Create with status
-  hspt calls create --body "Sales call" --direction OUTBOUND --status COMPLETED`,
+  hspt calls create --body "Sales call" --direction OUTBOUND --status COMPLETED
+
+  # Attach to records
+  hspt calls create --body "Intro call" --direction OUTBOUND --associate contacts:123`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			values, fromContext := shared.WithRecordContext(cmd, associate)
+			values, err := shared.ResolveAssociateValues(cmd, opts, values)
+			if err != nil {
+				return err
+			}
+			associations, err := shared.ParseAssociations(api.ObjectTypeCalls, values)
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -207,12 +232,15 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeCalls, properties)
+			obj, err := client.CreateObjectWithAssociations(cmd.Context(), api.ObjectTypeCalls, properties, associations)
 			if err != nil {
 				return err
 			}
 
 			v.Success("Call created with ID: %s", obj.ID)
+			if len(fromContext) > 0 {
+				v.Info("Associated from context: %s", strings.Join(fromContext, ", "))
+			}
 
 			headers := []string{"PROPERTY", "VALUE"}
 			rows := [][]string{
@@ -232,6 +260,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&timestamp, "timestamp", "", "Call timestamp (Unix milliseconds)")
 	cmd.Flags().StringVar(&ownerID, "owner-id", "", "HubSpot owner ID")
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
+	shared.AddAssociateFlag(cmd, &associate)
 
 	return cmd
 }
@@ -287,7 +316,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeCalls, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeCalls, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Call %s not found", id)
@@ -339,7 +368,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeCalls, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeCalls, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Call %s not found", id)
 					return nil
@@ -356,3 +385,50 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 
 	return cmd
 }
+
+func newRecordingURLCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "recording-url <id>",
+		Short: "Print a call's recording URL",
+		Long: `Print the URL of a call's recording, as logged by the calling extension
+or HubSpot's own calling. Only the URL is written to stdout, so it can be
+passed straight to a download tool.`,
+		Example: `  # Print the recording URL
+  hspt calls recording-url 12345
+
+  # Download the recording
+  curl -L -o call.mp3 "$(hspt calls recording-url 12345)"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			obj, err := client.GetObject(cmd.Context(), api.ObjectTypeCalls, id, []string{"hs_call_recording_url", "hs_call_duration", "hs_timestamp"})
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Call %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			url := obj.GetProperty("hs_call_recording_url")
+			if url == "" {
+				v.Warning("Call %s has no recording", id)
+				return nil
+			}
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(obj)
+			}
+
+			fmt.Fprintln(v.Out, url)
+			return nil
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the campaigns command and subcommands
@@ -17,6 +18,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newROICmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -24,6 +26,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -42,10 +45,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListCampaigns(api.ListOptions{
+			result, err := client.ListCampaigns(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -81,6 +84,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of campaigns to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -101,7 +106,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			campaign, err := client.GetCampaign(id)
+			campaign, err := client.GetCampaign(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Campaign %s not found", id)
@@ -114,8 +119,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			rows := [][]string{
 				{"ID", campaign.ID},
 				{"Name", campaign.Name},
-				{"Created", campaign.CreatedAt},
-				{"Updated", campaign.UpdatedAt},
+				{"Created", v.Time(campaign.CreatedAt)},
+				{"Updated", v.Time(campaign.UpdatedAt)},
 			}
 
 			return v.Render(headers, rows, campaign)
@@ -0,0 +1,214 @@
+package campaigns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// maxInfluencedDeals caps the deals search, which HubSpot limits to 10,000
+// results per query
+const maxInfluencedDeals = 10000
+
+// influencedDeals totals the deals associated with a campaign
+type influencedDeals struct {
+	Count     int     `json:"count"`
+	Amount    float64 `json:"amount"`
+	Won       int     `json:"won"`
+	WonAmount float64 `json:"wonAmount"`
+}
+
+// roiSummary is the rollup printed by "campaigns roi"
+type roiSummary struct {
+	CampaignID       string                   `json:"campaignId"`
+	Name             string                   `json:"name"`
+	StartDate        string                   `json:"startDate,omitempty"`
+	EndDate          string                   `json:"endDate,omitempty"`
+	AttributionModel string                   `json:"attributionModel,omitempty"`
+	CurrencyCode     string                   `json:"currencyCode,omitempty"`
+	Metrics          api.CampaignMetrics      `json:"metrics"`
+	Revenue          float64                  `json:"revenue"`
+	AttributedDeals  int                      `json:"attributedDeals"`
+	InfluencedDeals  influencedDeals          `json:"influencedDeals"`
+	Budget           float64                  `json:"budget"`
+	Spend            float64                  `json:"spend"`
+	RemainingBudget  float64                  `json:"remainingBudget"`
+	SpendItems       []api.CampaignBudgetItem `json:"spendItems"`
+	// RevenuePerDollar is attributed revenue divided by spend; nil when
+	// nothing has been spent
+	RevenuePerDollar *float64 `json:"revenuePerDollar"`
+}
+
+// buildROI combines a campaign's reports and influenced deals into a summary
+func buildROI(campaign *api.Campaign, metrics *api.CampaignMetrics, revenue *api.CampaignRevenue, budget *api.CampaignBudget, deals []api.CRMObject) roiSummary {
+	s := roiSummary{
+		CampaignID:      campaign.ID,
+		Name:            campaign.Name,
+		Metrics:         *metrics,
+		Revenue:         revenue.RevenueAmount,
+		AttributedDeals: revenue.DealsNumber,
+		CurrencyCode:    revenue.CurrencyCode,
+		Budget:          budget.BudgetTotal,
+		Spend:           budget.SpendTotal,
+		RemainingBudget: budget.RemainingBudget,
+		SpendItems:      budget.SpendItems,
+	}
+	if s.CurrencyCode == "" {
+		s.CurrencyCode = budget.CurrencyCode
+	}
+	if s.SpendItems == nil {
+		s.SpendItems = []api.CampaignBudgetItem{}
+	}
+
+	for _, d := range deals {
+		amount, _ := strconv.ParseFloat(d.GetProperty("amount"), 64)
+		s.InfluencedDeals.Count++
+		s.InfluencedDeals.Amount += amount
+		if d.GetProperty("hs_is_closed_won") == "true" {
+			s.InfluencedDeals.Won++
+			s.InfluencedDeals.WonAmount += amount
+		}
+	}
+
+	if s.Spend > 0 {
+		rpd := s.Revenue / s.Spend
+		s.RevenuePerDollar = &rpd
+	}
+
+	return s
+}
+
+// campaignDeals returns the deals associated with a campaign
+func campaignDeals(ctx context.Context, client *api.Client, campaignID string) ([]api.CRMObject, error) {
+	req := api.SearchRequest{
+		FilterGroups: []api.SearchFilterGroup{{Filters: []api.SearchFilter{
+			{PropertyName: "associations.campaign", Operator: "EQ", Value: campaignID},
+		}}},
+		Properties: []string{"amount", "hs_is_closed_won"},
+		Limit:      api.DefaultPageSize,
+	}
+
+	var deals []api.CRMObject
+	for len(deals) < maxInfluencedDeals {
+		page, err := client.SearchObjects(ctx, api.ObjectTypeDeals, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search influenced deals: %w", err)
+		}
+		deals = append(deals, page.Results...)
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			break
+		}
+		req.After = page.Paging.Next.After
+	}
+	return deals, nil
+}
+
+func newROICmd(opts *root.Options) *cobra.Command {
+	var dates api.CampaignReportRange
+	var model string
+
+	cmd := &cobra.Command{
+		Use:   "roi <id>",
+		Short: "Summarize a campaign's return on spend",
+		Long: `Combine a campaign's attribution metrics, attributed revenue, influenced
+deals, and budget and spend into one summary, with revenue per dollar spent.
+
+Revenue and attributed deals come from the campaign revenue report for the
+attribution model (--attribution). Influenced deals are every deal associated
+with the campaign, whatever the model. Use -o json to feed the summary to a
+BI tool.`,
+		Example: `  # ROI summary for a campaign
+  hspt campaigns roi 6f8e2f4a-1d2b-4c3e-9f10-2a3b4c5d6e7f
+
+  # This year, first-touch attribution, as JSON
+  hspt campaigns roi 6f8e2f4a-1d2b-4c3e-9f10-2a3b4c5d6e7f --start-date 2026-01-01 --attribution FIRST_INTERACTION -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+			ctx := cmd.Context()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			campaign, err := client.GetCampaign(ctx, id)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Campaign %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			metrics, err := client.GetCampaignMetrics(ctx, id, dates)
+			if err != nil {
+				return fmt.Errorf("failed to get campaign metrics: %w", err)
+			}
+			revenue, err := client.GetCampaignRevenue(ctx, id, model, dates)
+			if err != nil {
+				return fmt.Errorf("failed to get campaign revenue: %w", err)
+			}
+			budget, err := client.GetCampaignBudget(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get campaign budget: %w", err)
+			}
+			deals, err := campaignDeals(ctx, client, id)
+			if err != nil {
+				return err
+			}
+
+			summary := buildROI(campaign, metrics, revenue, budget, deals)
+			summary.StartDate = dates.StartDate
+			summary.EndDate = dates.EndDate
+			summary.AttributionModel = model
+
+			money := func(f float64) string {
+				return v.Money(strconv.FormatFloat(f, 'f', 2, 64))
+			}
+			rpd := "n/a (no spend)"
+			if summary.RevenuePerDollar != nil {
+				rpd = strconv.FormatFloat(*summary.RevenuePerDollar, 'f', 2, 64)
+			}
+
+			headers := []string{"METRIC", "VALUE"}
+			rows := [][]string{
+				{"Campaign", summary.Name},
+				{"Currency", summary.CurrencyCode},
+				{"Sessions", strconv.Itoa(summary.Metrics.Sessions)},
+				{"New contacts (first touch)", strconv.Itoa(summary.Metrics.NewContactsFirstTouch)},
+				{"New contacts (last touch)", strconv.Itoa(summary.Metrics.NewContactsLastTouch)},
+				{"Influenced contacts", strconv.Itoa(summary.Metrics.InfluencedContacts)},
+				{"Attributed revenue", money(summary.Revenue)},
+				{"Attributed deals", strconv.Itoa(summary.AttributedDeals)},
+				{"Influenced deals", fmt.Sprintf("%d (%s)", summary.InfluencedDeals.Count, money(summary.InfluencedDeals.Amount))},
+				{"Influenced deals won", fmt.Sprintf("%d (%s)", summary.InfluencedDeals.Won, money(summary.InfluencedDeals.WonAmount))},
+				{"Budget", money(summary.Budget)},
+				{"Spend", money(summary.Spend)},
+				{"Remaining budget", money(summary.RemainingBudget)},
+			}
+			for _, item := range summary.SpendItems {
+				rows = append(rows, []string{"Spend: " + item.Name, money(item.Amount)})
+			}
+			rows = append(rows, []string{"Revenue per dollar", rpd})
+
+			if len(deals) >= maxInfluencedDeals {
+				v.Warning("Influenced deals stop at the first %d; totals are a lower bound", maxInfluencedDeals)
+			}
+
+			return v.Render(headers, rows, summary)
+		},
+	}
+
+	cmd.Flags().StringVar(&dates.StartDate, "start-date", "", "Report start date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&dates.EndDate, "end-date", "", "Report end date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&model, "attribution", "", "Revenue attribution model (e.g. LINEAR, FIRST_INTERACTION, LAST_INTERACTION); default is HubSpot's")
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package campaigns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestBuildROI(t *testing.T) {
+	campaign := &api.Campaign{ID: "c-1", Name: "Spring launch"}
+	metrics := &api.CampaignMetrics{Sessions: 1200}
+	revenue := &api.CampaignRevenue{CurrencyCode: "USD", RevenueAmount: 50000, DealsNumber: 4}
+	budget := &api.CampaignBudget{
+		BudgetTotal:     20000,
+		SpendTotal:      12500,
+		RemainingBudget: 7500,
+		SpendItems:      []api.CampaignBudgetItem{{Name: "LinkedIn ads", Amount: 12500}},
+	}
+	deals := []api.CRMObject{
+		{ID: "1", Properties: map[string]interface{}{"amount": "30000", "hs_is_closed_won": "true"}},
+		{ID: "2", Properties: map[string]interface{}{"amount": "10000", "hs_is_closed_won": "false"}},
+		{ID: "3", Properties: map[string]interface{}{"hs_is_closed_won": "false"}},
+	}
+
+	s := buildROI(campaign, metrics, revenue, budget, deals)
+	assert.Equal(t, "Spring launch", s.Name)
+	assert.Equal(t, 1200, s.Metrics.Sessions)
+	assert.Equal(t, influencedDeals{Count: 3, Amount: 40000, Won: 1, WonAmount: 30000}, s.InfluencedDeals)
+	require.NotNil(t, s.RevenuePerDollar)
+	assert.Equal(t, 4.0, *s.RevenuePerDollar)
+	assert.Equal(t, "USD", s.CurrencyCode)
+}
+
+func TestBuildROINoSpend(t *testing.T) {
+	s := buildROI(&api.Campaign{ID: "c-1"}, &api.CampaignMetrics{}, &api.CampaignRevenue{RevenueAmount: 100}, &api.CampaignBudget{CurrencyCode: "EUR"}, nil)
+	assert.Nil(t, s.RevenuePerDollar)
+	assert.Equal(t, "EUR", s.CurrencyCode)
+	assert.NotNil(t, s.SpendItems)
+}
@@ -0,0 +1,22 @@
+package cms
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the cms command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "cms",
+		Short: "CMS developer tools",
+		Long:  "Commands for developing and inspecting HubSpot CMS themes, templates, and modules.",
+	}
+
+	cmd.AddCommand(newSourceCmd(opts))
+	cmd.AddCommand(newThemesCmd(opts))
+	cmd.AddCommand(newModulesCmd(opts))
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,106 @@
+package cms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// defaultAssetsFolder holds HubSpot's built-in templates and modules, which
+// discovery skips
+const defaultAssetsFolder = "@hubspot"
+
+// marketplaceFolder holds themes and modules installed from the marketplace
+const marketplaceFolder = "@marketplace"
+
+// assetSource says where a theme or module comes from
+func assetSource(p string) string {
+	if p == marketplaceFolder || strings.HasPrefix(p, marketplaceFolder+"/") {
+		return "marketplace"
+	}
+	return "portal"
+}
+
+// likelyFile reports whether a design manager entry is a file, judging by
+// its name: files have extensions, while folders do not, except for
+// modules. This saves a metadata request per file while searching.
+func likelyFile(name string) bool {
+	ext := path.Ext(name)
+	return ext != "" && ext != ".module"
+}
+
+// findFolders searches the folders under root, skipping HubSpot's built-in
+// assets, and returns the paths of those match accepts. Matching folders
+// are not searched further.
+func findFolders(ctx context.Context, client *api.Client, env, root string, match func(p string, meta *api.SourceMetadata) bool) ([]string, error) {
+	root = strings.Trim(root, "/")
+	var found []string
+	queue := []string{root}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		meta, err := client.GetSourceMetadata(ctx, env, p)
+		if err != nil {
+			if p != root && api.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", displayPath(p), err)
+		}
+		if !meta.Folder {
+			continue
+		}
+		if p != "" && match(p, meta) {
+			found = append(found, p)
+			continue
+		}
+		for _, child := range meta.Children {
+			if likelyFile(child) || (p == "" && child == defaultAssetsFolder) {
+				continue
+			}
+			queue = append(queue, path.Join(p, child))
+		}
+	}
+	sort.Strings(found)
+	return found, nil
+}
+
+// displayPath names a design manager path in messages
+func displayPath(p string) string {
+	if p == "" {
+		return "the design manager root"
+	}
+	return p
+}
+
+// isModule matches module folders for findFolders
+func isModule(p string, _ *api.SourceMetadata) bool {
+	return strings.HasSuffix(p, ".module")
+}
+
+// hasChild reports whether a folder has an entry named name
+func hasChild(meta *api.SourceMetadata, name string) bool {
+	for _, c := range meta.Children {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// readJSON downloads a design manager JSON file into out
+func readJSON(ctx context.Context, client *api.Client, env, p string, out interface{}) error {
+	content, err := client.DownloadSource(ctx, env, p)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(content, out); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package cms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestAssetSource(t *testing.T) {
+	assert.Equal(t, "marketplace", assetSource("@marketplace/Acme/Acme Theme"))
+	assert.Equal(t, "portal", assetSource("my-theme"))
+	assert.Equal(t, "portal", assetSource("@marketplaceish"))
+}
+
+func TestLikelyFile(t *testing.T) {
+	assert.True(t, likelyFile("home.html"))
+	assert.True(t, likelyFile("theme.json"))
+	assert.False(t, likelyFile("hero.module"))
+	assert.False(t, likelyFile("templates"))
+}
+
+func TestFindFolders(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/cms/v3/source-code/published/metadata/":
+			w.Write([]byte(`{"folder": true, "children": ["@hubspot", "@marketplace", "my-theme", "notes.txt"]}`))
+		case "/cms/v3/source-code/published/metadata/@marketplace":
+			w.Write([]byte(`{"folder": true, "children": ["Acme"]}`))
+		case "/cms/v3/source-code/published/metadata/@marketplace/Acme":
+			w.Write([]byte(`{"folder": true, "children": ["Acme Theme"]}`))
+		case "/cms/v3/source-code/published/metadata/@marketplace/Acme/Acme Theme":
+			w.Write([]byte(`{"folder": true, "children": ["theme.json", "modules"]}`))
+		case "/cms/v3/source-code/published/metadata/my-theme":
+			w.Write([]byte(`{"folder": true, "children": ["theme.json", "templates", "modules"]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	themes, err := findFolders(context.Background(), client, api.SourceEnvironmentPublished, "", func(_ string, meta *api.SourceMetadata) bool {
+		return hasChild(meta, "theme.json")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"@marketplace/Acme/Acme Theme", "my-theme"}, themes)
+	assert.NotContains(t, requested, "/cms/v3/source-code/published/metadata/@hubspot")
+}
+
+func TestModuleTemplateTypes(t *testing.T) {
+	assert.Equal(t, "SITE_PAGE, BLOG_POST", moduleMeta{ContentTypes: []string{"SITE_PAGE", "BLOG_POST"}, HostTemplateTypes: []string{"PAGE"}}.templateTypes())
+	assert.Equal(t, "PAGE, EMAIL", moduleMeta{HostTemplateTypes: []string{"PAGE", "EMAIL"}}.templateTypes())
+}
+
+func TestAvailableText(t *testing.T) {
+	no := false
+	yes := true
+	assert.Equal(t, "Yes", availableText(nil))
+	assert.Equal(t, "Yes", availableText(&yes))
+	assert.Equal(t, "No", availableText(&no))
+}
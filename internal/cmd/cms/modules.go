@@ -0,0 +1,189 @@
+package cms
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// moduleMeta is the part of a module's meta.json that hspt shows
+type moduleMeta struct {
+	Label             string      `json:"label"`
+	ModuleID          json.Number `json:"module_id,omitempty"`
+	Global            bool        `json:"global"`
+	HostTemplateTypes []string    `json:"host_template_types"`
+	ContentTypes      []string    `json:"content_types"`
+	Tags              []string    `json:"tags,omitempty"`
+	Available         *bool       `json:"is_available_for_new_content"`
+}
+
+// module is a custom module found in the design manager
+type module struct {
+	Path   string     `json:"path"`
+	Source string     `json:"source"`
+	Meta   moduleMeta `json:"meta"`
+	// Fields and Files are filled in by modules get
+	Fields *int     `json:"fields,omitempty"`
+	Files  []string `json:"files,omitempty"`
+}
+
+// templateTypes lists where a module can be used: the content types it
+// declares, or else its host template types
+func (m moduleMeta) templateTypes() string {
+	if len(m.ContentTypes) > 0 {
+		return strings.Join(m.ContentTypes, ", ")
+	}
+	return strings.Join(m.HostTemplateTypes, ", ")
+}
+
+func newModulesCmd(opts *root.Options) *cobra.Command {
+	var env string
+
+	cmd := &cobra.Command{
+		Use:   "modules",
+		Short: "Inspect CMS custom modules",
+		Long: `List and inspect the custom modules in the design manager: the portal's own
+modules and those installed from the marketplace. A module is a folder
+whose name ends in .module.`,
+	}
+
+	cmd.PersistentFlags().StringVar(&env, "env", api.SourceEnvironmentPublished, "Environment: draft or published")
+
+	cmd.AddCommand(newModulesListCmd(opts, &env))
+	cmd.AddCommand(newModulesGetCmd(opts, &env))
+
+	return cmd
+}
+
+func newModulesListCmd(opts *root.Options, env *string) *cobra.Command {
+	var in string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List custom modules",
+		Long: `List the custom modules in the design manager, or only those under a folder
+such as a theme with --in. HubSpot's built-in modules are not listed.`,
+		Example: `  # Every custom module
+  hspt cms modules list
+
+  # A theme's modules
+  hspt cms modules list --in my-theme`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			paths, err := findFolders(cmd.Context(), client, *env, in, isModule)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Folder %s not found", in)
+					return nil
+				}
+				return err
+			}
+			if len(paths) == 0 {
+				v.Info("No modules found")
+				return nil
+			}
+
+			modules := make([]module, 0, len(paths))
+			for _, p := range paths {
+				m := module{Path: p, Source: assetSource(p)}
+				if err := readJSON(cmd.Context(), client, *env, p+"/meta.json", &m.Meta); err != nil {
+					v.Warning("Skipping module %s: %v", p, err)
+					continue
+				}
+				modules = append(modules, m)
+			}
+
+			headers := []string{"PATH", "LABEL", "GLOBAL", "USED IN", "SOURCE", "AVAILABLE"}
+			rows := make([][]string, 0, len(modules))
+			for _, m := range modules {
+				rows = append(rows, []string{m.Path, m.Meta.Label, formatBool(m.Meta.Global), m.Meta.templateTypes(), m.Source, availableText(m.Meta.Available)})
+			}
+
+			return v.Render(headers, rows, modules)
+		},
+	}
+
+	cmd.Flags().StringVar(&in, "in", "", "Only list modules under this folder")
+
+	return cmd
+}
+
+func newModulesGetCmd(opts *root.Options, env *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <path>",
+		Short: "Get a custom module",
+		Long:  "Show a module's details from its meta.json, with its field count and files.",
+		Example: `  # Get a module
+  hspt cms modules get my-theme/modules/hero.module`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			p := strings.Trim(args[0], "/")
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			folder, err := client.GetSourceMetadata(cmd.Context(), *env, p)
+			if err == nil && !folder.Folder {
+				err = api.ErrNotFound
+			}
+			m := module{Path: p, Source: assetSource(p)}
+			if err == nil {
+				err = readJSON(cmd.Context(), client, *env, p+"/meta.json", &m.Meta)
+			}
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Module %s not found", p)
+					return nil
+				}
+				return err
+			}
+			m.Files = folder.Children
+
+			var fields []json.RawMessage
+			if hasChild(folder, "fields.json") {
+				if err := readJSON(cmd.Context(), client, *env, p+"/fields.json", &fields); err != nil {
+					return err
+				}
+			}
+			count := len(fields)
+			m.Fields = &count
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"Path", m.Path},
+				{"Label", m.Meta.Label},
+				{"Module ID", m.Meta.ModuleID.String()},
+				{"Global", formatBool(m.Meta.Global)},
+				{"Used In", m.Meta.templateTypes()},
+				{"Tags", strings.Join(m.Meta.Tags, ", ")},
+				{"Source", m.Source},
+				{"Available", availableText(m.Meta.Available)},
+				{"Fields", strconv.Itoa(count)},
+				{"Files", strings.Join(m.Files, ", ")},
+			}
+
+			return v.Render(headers, rows, m)
+		},
+	}
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
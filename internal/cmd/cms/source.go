@@ -0,0 +1,452 @@
+package cms
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+func newSourceCmd(opts *root.Options) *cobra.Command {
+	var env string
+
+	cmd := &cobra.Command{
+		Use:   "source",
+		Short: "Manage design manager files",
+		Long: `Upload, download, list, delete, and validate the templates, modules, and
+theme files in the design manager.
+
+Paths are design manager paths such as my-theme/templates/home.html.
+--env chooses the draft files (the default) or the published ones; uploading
+to published makes a change live immediately.`,
+	}
+
+	cmd.PersistentFlags().StringVar(&env, "env", api.SourceEnvironmentDraft, "Environment: draft or published")
+
+	cmd.AddCommand(newSourceListCmd(opts, &env))
+	cmd.AddCommand(newSourceDownloadCmd(opts, &env))
+	cmd.AddCommand(newSourceUploadCmd(opts, &env))
+	cmd.AddCommand(newSourceDeleteCmd(opts, &env))
+	cmd.AddCommand(newSourceValidateCmd(opts, &env))
+
+	return cmd
+}
+
+// sourceFile is a local file and the design manager path it maps to
+type sourceFile struct {
+	Local  string
+	Remote string
+}
+
+// sourceFiles maps a local file, or every file under a local directory, to
+// design manager paths under remote. Dotfiles and dot-directories are
+// skipped.
+func sourceFiles(local, remote string) ([]sourceFile, error) {
+	remote = strings.Trim(remote, "/")
+
+	info, err := os.Stat(local)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []sourceFile{{Local: local, Remote: remote}}, nil
+	}
+
+	var files []sourceFile
+	err = filepath.WalkDir(local, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != local && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(local, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, sourceFile{Local: p, Remote: path.Join(remote, filepath.ToSlash(rel))})
+		return nil
+	})
+	return files, err
+}
+
+// walkSource calls fn with the path of every file at or under p
+func walkSource(ctx context.Context, client *api.Client, env, p string, fn func(string) error) error {
+	meta, err := client.GetSourceMetadata(ctx, env, p)
+	if err != nil {
+		return err
+	}
+	if !meta.Folder {
+		return fn(p)
+	}
+	children := append([]string(nil), meta.Children...)
+	sort.Strings(children)
+	for _, child := range children {
+		if err := walkSource(ctx, client, env, path.Join(p, child), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newSourceListCmd(opts *root.Options, env *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [path]",
+		Short: "List a design manager folder",
+		Long:  "List the entries of a design manager folder, or describe a file. Without a path, lists the root.",
+		Example: `  # Top-level folders
+  hspt cms source list
+
+  # A theme's templates
+  hspt cms source list my-theme/templates`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			p := ""
+			if len(args) == 1 {
+				p = strings.Trim(args[0], "/")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			meta, err := client.GetSourceMetadata(cmd.Context(), *env, p)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Design manager path %s not found", p)
+					return nil
+				}
+				return err
+			}
+
+			if !meta.Folder {
+				headers := []string{"PROPERTY", "VALUE"}
+				rows := [][]string{
+					{"Path", p},
+					{"Name", meta.Name},
+					{"Hash", meta.Hash},
+					{"Created", v.Time(strconv.FormatInt(meta.CreatedAt, 10))},
+					{"Updated", v.Time(strconv.FormatInt(meta.UpdatedAt, 10))},
+				}
+				return v.Render(headers, rows, meta)
+			}
+
+			if len(meta.Children) == 0 {
+				v.Info("Folder %s is empty", p)
+				return nil
+			}
+
+			children := append([]string(nil), meta.Children...)
+			sort.Strings(children)
+			headers := []string{"NAME", "PATH"}
+			rows := make([][]string, 0, len(children))
+			for _, child := range children {
+				rows = append(rows, []string{child, path.Join(p, child)})
+			}
+
+			return v.Render(headers, rows, meta)
+		},
+	}
+}
+
+func newSourceDownloadCmd(opts *root.Options, env *string) *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "download <path>",
+		Short: "Download design manager files",
+		Long: `Download a design manager file, or a folder and everything in it.
+
+A file is written to stdout unless --out names a file. A folder is written
+to the --out directory, by default one named after the folder.`,
+		Example: `  # Print a template
+  hspt cms source download my-theme/templates/home.html
+
+  # Download a whole theme
+  hspt cms source download my-theme --out ./my-theme
+
+  # Download the live version of a module
+  hspt cms source download my-theme/modules/hero.module --env published`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			p := strings.Trim(args[0], "/")
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			meta, err := client.GetSourceMetadata(cmd.Context(), *env, p)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Design manager path %s not found", p)
+					return nil
+				}
+				return err
+			}
+
+			if !meta.Folder {
+				content, err := client.DownloadSource(cmd.Context(), *env, p)
+				if err != nil {
+					return fmt.Errorf("failed to download %s: %w", p, err)
+				}
+				if out == "" {
+					_, err := opts.Stdout.Write(content)
+					return err
+				}
+				if err := os.WriteFile(out, content, 0644); err != nil {
+					return fmt.Errorf("failed to write %s: %w", out, err)
+				}
+				v.Success("Downloaded %s to %s", p, out)
+				return nil
+			}
+
+			dir := out
+			if dir == "" {
+				dir = path.Base(p)
+			}
+			count := 0
+			err = walkSource(cmd.Context(), client, *env, p, func(file string) error {
+				content, err := client.DownloadSource(cmd.Context(), *env, file)
+				if err != nil {
+					return fmt.Errorf("failed to download %s: %w", file, err)
+				}
+				dest := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(file, p+"/")))
+				if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+					return err
+				}
+				if err := os.WriteFile(dest, content, 0644); err != nil {
+					return fmt.Errorf("failed to write %s: %w", dest, err)
+				}
+				count++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			v.Success("Downloaded %d file(s) from %s to %s", count, p, dir)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File or directory to write to")
+
+	return cmd
+}
+
+func newSourceUploadCmd(opts *root.Options, env *string) *cobra.Command {
+	var validate bool
+
+	cmd := &cobra.Command{
+		Use:   "upload <local> <path>",
+		Short: "Upload files to the design manager",
+		Long: `Upload a local file to a design manager path, or every file in a local
+directory to the folder at path, creating or replacing them. Dotfiles and
+dot-directories are skipped.
+
+--validate checks every file's HubL first and uploads nothing if any fail.`,
+		Example: `  # Upload one template
+  hspt cms source upload ./templates/home.html my-theme/templates/home.html
+
+  # Upload a whole theme, validating it first
+  hspt cms source upload ./my-theme my-theme --validate`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			files, err := sourceFiles(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				v.Info("No files to upload in %s", args[0])
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if validate {
+				if err := validateFiles(cmd.Context(), opts, client, *env, files); err != nil {
+					return err
+				}
+			}
+
+			for i, f := range files {
+				file, err := os.Open(f.Local)
+				if err != nil {
+					return err
+				}
+				_, err = client.UploadSource(cmd.Context(), *env, f.Remote, file)
+				file.Close()
+				if err != nil {
+					if i > 0 {
+						v.Warning("Stopped after %d of %d file(s)", i, len(files))
+					}
+					return fmt.Errorf("failed to upload %s: %w", f.Remote, err)
+				}
+			}
+
+			if len(files) == 1 {
+				v.Success("Uploaded %s to %s (%s)", files[0].Local, files[0].Remote, *env)
+			} else {
+				v.Success("Uploaded %d file(s) to %s (%s)", len(files), strings.Trim(args[1], "/"), *env)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&validate, "validate", false, "Validate every file before uploading any")
+
+	return cmd
+}
+
+func newSourceDeleteCmd(opts *root.Options, env *string) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <path>",
+		Short: "Delete a design manager file or folder",
+		Long:  "Delete a design manager file, or a folder and everything in it.",
+		Example: `  # Delete a template
+  hspt cms source delete my-theme/templates/old.html --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			p := strings.Trim(args[0], "/")
+
+			if !force {
+				v.Warning("This will permanently delete %s from the %s design manager. Use --force to confirm.", p, *env)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteSource(cmd.Context(), *env, p); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Design manager path %s not found", p)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Deleted %s (%s)", p, *env)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func newSourceValidateCmd(opts *root.Options, env *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <local> [path]",
+		Short: "Validate HubL without uploading",
+		Long: `Check a local file, or every file in a local directory, for HubL and JSON
+errors as if it were uploaded to path, without saving anything. Path
+defaults to the local name. The command fails when any file has errors, so
+it can gate CI.`,
+		Example: `  # Validate a template
+  hspt cms source validate ./templates/home.html my-theme/templates/home.html
+
+  # Validate a whole theme
+  hspt cms source validate ./my-theme my-theme`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			remote := filepath.ToSlash(filepath.Base(filepath.Clean(args[0])))
+			if len(args) == 2 {
+				remote = args[1]
+			}
+
+			files, err := sourceFiles(args[0], remote)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := validateFiles(cmd.Context(), opts, client, *env, files); err != nil {
+				return err
+			}
+			opts.View().Success("%d file(s) valid", len(files))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// validationProblem is one problem HubSpot found in a file
+type validationProblem struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// validateFiles validates each file, renders any problems, and fails if
+// there are some
+func validateFiles(ctx context.Context, opts *root.Options, client *api.Client, env string, files []sourceFile) error {
+	v := opts.View()
+
+	problems := []validationProblem{}
+	failed := 0
+	for _, f := range files {
+		file, err := os.Open(f.Local)
+		if err != nil {
+			return err
+		}
+		messages, err := client.ValidateSource(ctx, env, f.Remote, file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to validate %s: %w", f.Remote, err)
+		}
+		if len(messages) > 0 {
+			failed++
+		}
+		for _, m := range messages {
+			problems = append(problems, validationProblem{Path: f.Remote, Message: m})
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+
+	headers := []string{"PATH", "PROBLEM"}
+	rows := make([][]string, 0, len(problems))
+	for _, p := range problems {
+		rows = append(rows, []string{p.Path, p.Message})
+	}
+	if err := v.Render(headers, rows, problems); err != nil {
+		return err
+	}
+	return fmt.Errorf("%d of %d file(s) failed validation", failed, len(files))
+}
@@ -0,0 +1,62 @@
+package cms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestSourceFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "templates"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "theme.json"), []byte("{}"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "templates", "home.html"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("x"), 0o644))
+
+	files, err := sourceFiles(dir, "/my-theme/")
+	require.NoError(t, err)
+	assert.Equal(t, []sourceFile{
+		{Local: filepath.Join(dir, "templates", "home.html"), Remote: "my-theme/templates/home.html"},
+		{Local: filepath.Join(dir, "theme.json"), Remote: "my-theme/theme.json"},
+	}, files)
+
+	files, err = sourceFiles(filepath.Join(dir, "theme.json"), "other/theme.json")
+	require.NoError(t, err)
+	assert.Equal(t, []sourceFile{{Local: filepath.Join(dir, "theme.json"), Remote: "other/theme.json"}}, files)
+
+	_, err = sourceFiles(filepath.Join(dir, "missing"), "x")
+	assert.Error(t, err)
+}
+
+func TestWalkSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cms/v3/source-code/draft/metadata/my-theme":
+			w.Write([]byte(`{"folder": true, "children": ["templates", "theme.json"]}`))
+		case "/cms/v3/source-code/draft/metadata/my-theme/templates":
+			w.Write([]byte(`{"folder": true, "children": ["home.html", "about.html"]}`))
+		default:
+			w.Write([]byte(`{"folder": false}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	var files []string
+	err := walkSource(context.Background(), client, api.SourceEnvironmentDraft, "my-theme", func(p string) error {
+		files = append(files, p)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-theme/templates/about.html", "my-theme/templates/home.html", "my-theme/theme.json"}, files)
+}
@@ -0,0 +1,186 @@
+package cms
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// themeConfig is the part of a theme's theme.json that hspt shows
+type themeConfig struct {
+	Label   string `json:"label"`
+	Version string `json:"version"`
+	Author  struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		URL   string `json:"url"`
+	} `json:"author"`
+	DocumentationURL string `json:"documentation_url"`
+	License          string `json:"license"`
+	// Available is nil when theme.json does not say, which means available
+	Available *bool `json:"is_available_for_new_content"`
+}
+
+// theme is a theme found in the design manager
+type theme struct {
+	Path   string      `json:"path"`
+	Source string      `json:"source"`
+	Config themeConfig `json:"config"`
+	// Templates and Modules are counted by themes get
+	Templates *int `json:"templates,omitempty"`
+	Modules   *int `json:"modules,omitempty"`
+}
+
+// availableText describes whether new content can use a theme or module
+func availableText(available *bool) string {
+	if available != nil && !*available {
+		return "No"
+	}
+	return "Yes"
+}
+
+func newThemesCmd(opts *root.Options) *cobra.Command {
+	var env string
+
+	cmd := &cobra.Command{
+		Use:   "themes",
+		Short: "Inspect CMS themes",
+		Long: `List and inspect the themes in the design manager: the portal's own themes
+and those installed from the marketplace. A theme is a folder with a
+theme.json file.`,
+	}
+
+	cmd.PersistentFlags().StringVar(&env, "env", api.SourceEnvironmentPublished, "Environment: draft or published")
+
+	cmd.AddCommand(newThemesListCmd(opts, &env))
+	cmd.AddCommand(newThemesGetCmd(opts, &env))
+
+	return cmd
+}
+
+func newThemesListCmd(opts *root.Options, env *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List themes",
+		Long:  "List the themes in the design manager with their labels, versions, and authors.",
+		Example: `  # List themes
+  hspt cms themes list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			paths, err := findFolders(cmd.Context(), client, *env, "", func(p string, meta *api.SourceMetadata) bool {
+				return hasChild(meta, "theme.json")
+			})
+			if err != nil {
+				return err
+			}
+			if len(paths) == 0 {
+				v.Info("No themes found")
+				return nil
+			}
+
+			themes := make([]theme, 0, len(paths))
+			for _, p := range paths {
+				t := theme{Path: p, Source: assetSource(p)}
+				if err := readJSON(cmd.Context(), client, *env, p+"/theme.json", &t.Config); err != nil {
+					v.Warning("Skipping theme %s: %v", p, err)
+					continue
+				}
+				themes = append(themes, t)
+			}
+
+			headers := []string{"PATH", "LABEL", "VERSION", "AUTHOR", "SOURCE", "AVAILABLE"}
+			rows := make([][]string, 0, len(themes))
+			for _, t := range themes {
+				rows = append(rows, []string{t.Path, t.Config.Label, t.Config.Version, t.Config.Author.Name, t.Source, availableText(t.Config.Available)})
+			}
+
+			return v.Render(headers, rows, themes)
+		},
+	}
+}
+
+func newThemesGetCmd(opts *root.Options, env *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <path>",
+		Short: "Get a theme",
+		Long:  "Show a theme's details from its theme.json, with how many templates and modules it has.",
+		Example: `  # Get a portal theme
+  hspt cms themes get my-theme
+
+  # Get a marketplace theme
+  hspt cms themes get "@marketplace/Acme/Acme Theme"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			p := strings.Trim(args[0], "/")
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			t := theme{Path: p, Source: assetSource(p)}
+			if err := readJSON(cmd.Context(), client, *env, p+"/theme.json", &t.Config); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Theme %s not found", p)
+					return nil
+				}
+				return err
+			}
+
+			templates, err := countTemplates(cmd.Context(), client, *env, p)
+			if err != nil {
+				return err
+			}
+			t.Templates = &templates
+			modules, err := findFolders(cmd.Context(), client, *env, p, isModule)
+			if err != nil {
+				return err
+			}
+			count := len(modules)
+			t.Modules = &count
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"Path", t.Path},
+				{"Label", t.Config.Label},
+				{"Version", t.Config.Version},
+				{"Author", t.Config.Author.Name},
+				{"Author Email", t.Config.Author.Email},
+				{"Source", t.Source},
+				{"Available", availableText(t.Config.Available)},
+				{"License", t.Config.License},
+				{"Documentation", t.Config.DocumentationURL},
+				{"Templates", strconv.Itoa(templates)},
+				{"Modules", strconv.Itoa(count)},
+			}
+
+			return v.Render(headers, rows, t)
+		},
+	}
+}
+
+// countTemplates counts the files in a theme's templates folder and its
+// subfolders
+func countTemplates(ctx context.Context, client *api.Client, env, themePath string) (int, error) {
+	count := 0
+	err := walkSource(ctx, client, env, themePath+"/templates", func(string) error {
+		count++
+		return nil
+	})
+	if api.IsNotFound(err) {
+		return 0, nil
+	}
+	return count, err
+}
@@ -0,0 +1,67 @@
+package commercesubscriptions
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// DefaultProperties are the default properties to fetch for commerce
+// subscriptions
+var DefaultProperties = []string{"hs_name", "hs_status", "hs_recurring_billing_frequency", "hs_recurring_billing_start_date", "hs_recurring_billing_end_date", "hs_next_payment_due_date", "hs_last_payment_amount", "hs_currency_code"}
+
+var config = shared.ObjectCmdConfig{
+	ObjectType: api.ObjectTypeSubscriptions,
+	Noun:       "commerce subscription",
+	ListExample: `  # List first 10 subscriptions
+  hspt commerce-subscriptions list
+
+  # Every subscription, for a renewals review
+  hspt commerce-subscriptions list --all -o csv > subscriptions.csv`,
+	GetExample: `  # Get subscription by ID
+  hspt commerce-subscriptions get 12345
+
+  # When did it change status?
+  hspt commerce-subscriptions get 12345 --with-history hs_status`,
+	DefaultProperties: DefaultProperties,
+	Headers:           []string{"ID", "NAME", "STATUS", "FREQUENCY", "NEXT PAYMENT", "LAST PAYMENT"},
+	Row: func(v *view.View, obj api.CRMObject) []string {
+		return []string{
+			obj.ID,
+			obj.GetProperty("hs_name"),
+			obj.GetProperty("hs_status"),
+			obj.GetProperty("hs_recurring_billing_frequency"),
+			v.Time(obj.GetProperty("hs_next_payment_due_date")),
+			v.MoneyIn(obj.GetProperty("hs_last_payment_amount"), obj.GetProperty("hs_currency_code")),
+		}
+	},
+	Details: func(v *view.View, obj api.CRMObject) [][]string {
+		return [][]string{
+			{"Name", obj.GetProperty("hs_name")},
+			{"Status", obj.GetProperty("hs_status")},
+			{"Billing Frequency", obj.GetProperty("hs_recurring_billing_frequency")},
+			{"Start Date", v.Time(obj.GetProperty("hs_recurring_billing_start_date"))},
+			{"End Date", v.Time(obj.GetProperty("hs_recurring_billing_end_date"))},
+			{"Next Payment Due", v.Time(obj.GetProperty("hs_next_payment_due_date"))},
+			{"Last Payment Amount", v.MoneyIn(obj.GetProperty("hs_last_payment_amount"), obj.GetProperty("hs_currency_code"))},
+		}
+	},
+}
+
+// Register registers the commerce-subscriptions command and subcommands.
+// It is not "subscriptions", which manages email subscription preferences.
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "commerce-subscriptions",
+		Short: "View HubSpot commerce subscriptions",
+		Long:  "Commands for listing and viewing recurring billing subscriptions in HubSpot commerce. For email subscription preferences, see hspt subscriptions.",
+	}
+
+	cmd.AddCommand(shared.NewListCmd(opts, config))
+	cmd.AddCommand(shared.NewGetCmd(opts, config))
+
+	parent.AddCommand(cmd)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for companies
@@ -18,7 +20,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "companies",
 		Short: "Manage HubSpot companies",
-		Long:  "Commands for listing, viewing, creating, updating, and searching companies in HubSpot CRM.",
+		Long:  "Commands for listing, viewing, creating, updating, merging, and searching companies in HubSpot CRM.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
@@ -27,6 +29,38 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newSearchCmd(opts))
+	cmd.AddCommand(shared.NewMergeCmd(opts, shared.MergeCmdConfig{
+		ObjectType: api.ObjectTypeCompanies,
+		Noun:       "company",
+		Properties: DefaultProperties,
+		Example: `  # Preview merging a duplicate company into the one to keep
+  hspt companies merge 100 200
+
+  # Compare every property and see which values win
+  hspt companies merge 100 200 --preview
+
+  # Merge it
+  hspt companies merge 100 200 --force`,
+	}))
+	cmd.AddCommand(shared.NewDiffCmd(opts, shared.DiffCmdConfig{
+		ObjectType: api.ObjectTypeCompanies,
+		Noun:       "company",
+		Properties: DefaultProperties,
+		Example: `  # Properties that differ between two companies
+  hspt companies diff 111 222
+
+  # Compare every property, including matching ones
+  hspt companies diff 111 222 --properties all --all`,
+	}))
+	cmd.AddCommand(shared.NewTimelineCmd(opts, shared.TimelineCmdConfig{
+		ObjectType: api.ObjectTypeCompanies,
+		Noun:       "company",
+		Example: `  # Recent activity on a company
+  hspt companies timeline 202
+
+  # Every entry, only emails and meetings
+  hspt companies timeline 202 --types email,meeting --limit 0`,
+	}))
 
 	parent.AddCommand(cmd)
 }
@@ -35,6 +69,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
+	var hydrate shared.Hydration
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -47,10 +83,18 @@ func newListCmd(opts *root.Options) *cobra.Command {
   hspt companies list --properties name,domain,industry
 
   # List with pagination
-  hspt companies list --limit 50 --after abc123`,
+  hspt companies list --limit 50 --after abc123
+
+  # With associated contact and deal counts
+  hspt companies list --hydrate associations`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			hydrating, err := hydrate.Enabled()
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -60,11 +104,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeCompanies, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeCompanies, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -86,7 +130,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				})
 			}
 
-			if err := v.Render(headers, rows, result); err != nil {
+			var data interface{} = result
+			if hydrating {
+				if headers, rows, data, err = hydrate.Apply(cmd.Context(), v, client, api.ObjectTypeCompanies, result, headers, rows); err != nil {
+					return err
+				}
+			}
+
+			if err := v.Render(headers, rows, data); err != nil {
 				return err
 			}
 
@@ -102,11 +153,15 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+	shared.AddHydrateFlag(cmd, &hydrate)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -128,7 +183,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeCompanies, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeCompanies, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Company %s not found", id)
@@ -147,15 +202,20 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"City", obj.GetProperty("city")},
 				{"State", obj.GetProperty("state")},
 				{"Country", obj.GetProperty("country")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
@@ -209,7 +269,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeCompanies, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeCompanies, properties)
 			if err != nil {
 				return err
 			}
@@ -283,7 +343,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeCompanies, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeCompanies, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Company %s not found", id)
@@ -329,7 +389,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeCompanies, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeCompanies, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Company %s not found", id)
 					return nil
@@ -348,90 +408,36 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 }
 
 func newSearchCmd(opts *root.Options) *cobra.Command {
-	var name, domain string
-	var limit int
-	var properties []string
-
-	cmd := &cobra.Command{
-		Use:   "search",
-		Short: "Search companies",
-		Long:  "Search for companies using filters.",
+	return shared.NewSearchCmd(opts, shared.SearchCmdConfig{
+		ObjectType: api.ObjectTypeCompanies,
+		Noun:       "company",
+		Short:      "Search companies",
+		Long: `Search for companies using filters.
+
+The --name and --domain flags are shortcuts for common filters; --filter
+accepts any property and search operator.`,
 		Example: `  # Search by domain
   hspt companies search --domain acme.com
 
   # Search by name
-  hspt companies search --name "Acme"`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			if len(properties) == 0 {
-				properties = DefaultProperties
-			}
-
-			var filters []api.SearchFilter
-
-			if name != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "name",
-					Operator:     "CONTAINS_TOKEN",
-					Value:        name,
-				})
-			}
-			if domain != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "domain",
-					Operator:     "EQ",
-					Value:        domain,
-				})
-			}
-
-			req := api.SearchRequest{
-				Properties: properties,
-				Limit:      limit,
-			}
-
-			if len(filters) > 0 {
-				req.FilterGroups = []api.SearchFilterGroup{
-					{Filters: filters},
-				}
-			}
-
-			result, err := client.SearchObjects(api.ObjectTypeCompanies, req)
-			if err != nil {
-				return err
-			}
-
-			if len(result.Results) == 0 {
-				v.Info("No companies found matching criteria")
-				return nil
-			}
+  hspt companies search --name "Acme"
 
-			headers := []string{"ID", "NAME", "DOMAIN", "INDUSTRY", "CITY"}
-			rows := make([][]string, 0, len(result.Results))
-			for _, obj := range result.Results {
-				rows = append(rows, []string{
-					obj.ID,
-					obj.GetProperty("name"),
-					obj.GetProperty("domain"),
-					obj.GetProperty("industry"),
-					obj.GetProperty("city"),
-				})
+  # Large software companies, biggest first
+  hspt companies search --filter "industry EQ COMPUTER_SOFTWARE" --filter "numberofemployees>=500" --sort numberofemployees:desc`,
+		Shorthands: []shared.SearchShorthand{
+			{Flag: "name", Property: "name", Operator: "CONTAINS_TOKEN", Usage: "Search by name (contains)"},
+			{Flag: "domain", Property: "domain", Operator: "EQ", Usage: "Search by exact domain"},
+		},
+		DefaultProperties: DefaultProperties,
+		Headers:           []string{"ID", "NAME", "DOMAIN", "INDUSTRY", "CITY"},
+		Row: func(v *view.View, obj api.CRMObject) []string {
+			return []string{
+				obj.ID,
+				obj.GetProperty("name"),
+				obj.GetProperty("domain"),
+				obj.GetProperty("industry"),
+				obj.GetProperty("city"),
 			}
-
-			v.Info("Found %d company(ies)", len(result.Results))
-			return v.Render(headers, rows, result)
 		},
-	}
-
-	cmd.Flags().StringVar(&name, "name", "", "Search by name (contains)")
-	cmd.Flags().StringVar(&domain, "domain", "", "Search by exact domain")
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
-	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
-
-	return cmd
+	})
 }
@@ -3,6 +3,7 @@ package configcmd
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -22,7 +23,10 @@ func Register(parent *cobra.Command, opts *root.Options) {
 
 	cmd.AddCommand(newShowCmd(opts))
 	cmd.AddCommand(newClearCmd(opts))
+	cmd.AddCommand(newClearCacheCmd(opts))
 	cmd.AddCommand(newTestCmd(opts))
+	cmd.AddCommand(newValidateCmd(opts))
+	cmd.AddCommand(newProfileCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -36,18 +40,53 @@ func newShowCmd(opts *root.Options) *cobra.Command {
 			v := opts.View()
 
 			token := config.GetAccessToken()
+			tokenKey := "access_token"
+			if key := config.GetPersonalAccessKey(); token == "" && key != "" {
+				token, tokenKey = key, "personal_access_key"
+			}
 
 			// Mask the token
 			maskedToken := maskToken(token)
 
 			headers := []string{"KEY", "VALUE", "SOURCE"}
 			rows := [][]string{
-				{"access_token", maskedToken, getTokenSource()},
+				{tokenKey, maskedToken, getTokenSource()},
 			}
 
 			data := map[string]string{
-				"access_token": maskedToken,
-				"path":         config.Path(),
+				tokenKey: maskedToken,
+				"path":   config.Path(),
+			}
+
+			if cfg, err := config.Load(); err == nil {
+				settings := [][2]string{{"profile", cfg.ActiveProfileName()}}
+				if p := cfg.ActiveProfile(); p != nil {
+					if p.PortalID != 0 {
+						settings = append(settings, [2]string{"portal_id", strconv.FormatInt(p.PortalID, 10)})
+					}
+					settings = append(settings,
+						[2]string{"region", p.Region},
+						[2]string{"time_zone", p.TimeZone},
+						[2]string{"currency", p.Currency},
+					)
+				}
+				settings = append(settings,
+					[2]string{"output", cfg.Output},
+					[2]string{"cache_ttl", cfg.CacheTTL},
+				)
+				if cfg.MaxRetries != nil {
+					settings = append(settings, [2]string{"max_retries", strconv.Itoa(*cfg.MaxRetries)})
+				}
+				if cfg.ReadOnly {
+					settings = append(settings, [2]string{"read_only", "true"})
+				}
+				for _, kv := range settings {
+					if kv[1] == "" {
+						continue
+					}
+					rows = append(rows, []string{kv[0], kv[1], "config"})
+					data[kv[0]] = kv[1]
+				}
 			}
 
 			if err := v.Render(headers, rows, data); err != nil {
@@ -110,6 +149,32 @@ func newClearCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
+func newClearCacheCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear-cache",
+		Short: "Delete cached API responses",
+		Long:  "Delete the responses stored by --cache so the next read goes to HubSpot.",
+		Example: `  # Drop all cached responses
+  hspt config clear-cache`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			dir := config.CacheDir()
+			if dir == "" {
+				return fmt.Errorf("failed to locate the cache directory")
+			}
+
+			cache := &api.ResponseCache{Dir: dir}
+			if err := cache.Clear(); err != nil {
+				return err
+			}
+
+			v.Success("Response cache cleared")
+			return nil
+		},
+	}
+}
+
 func getTokenSource() string {
 	if os.Getenv("HUBSPOT_ACCESS_TOKEN") != "" {
 		return "env (HUBSPOT_ACCESS_TOKEN)"
@@ -118,8 +183,8 @@ func getTokenSource() string {
 	if err != nil {
 		return "-"
 	}
-	if cfg.AccessToken != "" {
-		return "config"
+	if p := cfg.ActiveProfile(); p != nil && (p.AccessToken != "" || p.PersonalAccessKey != "") {
+		return fmt.Sprintf("config (profile %s)", cfg.ActiveProfileName())
 	}
 	return "-"
 }
@@ -137,8 +202,7 @@ pass/fail status and troubleshooting suggestions on failure.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
-			token := config.GetAccessToken()
-			if token == "" {
+			if !config.IsConfigured() {
 				v.Error("No HubSpot access token configured")
 				v.PrintlnStatus("")
 				v.Info("Configure with: hspt init")
@@ -155,7 +219,7 @@ pass/fail status and troubleshooting suggestions on failure.`,
 				return nil
 			}
 
-			owners, err := client.GetOwners()
+			owners, err := client.GetOwners(cmd.Context())
 			if err != nil {
 				if api.IsUnauthorized(err) {
 					v.Error("Authentication failed: invalid access token")
@@ -184,3 +248,38 @@ pass/fail status and troubleshooting suggestions on failure.`,
 		},
 	}
 }
+
+func newValidateCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Check the config file against the schema",
+		Long: `Check the config file for unknown keys, values of the wrong type, and
+unsupported schema versions. Each problem names the offending key.
+
+Files written by older versions of hspt are reported as needing
+migration; they are upgraded automatically the next time they are loaded.`,
+		Example: `  # Validate after editing the config file by hand
+  hspt config validate`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			result, err := config.Validate()
+			if err != nil {
+				return err
+			}
+
+			if len(result.Errors) > 0 {
+				for _, e := range result.Errors {
+					v.Error("%s", e)
+				}
+				return fmt.Errorf("%s has %d problem(s)", result.Path, len(result.Errors))
+			}
+
+			if result.NeedsMigration {
+				v.Info("Config uses schema version %d; it will be migrated to version %d on next load", result.Version, config.SchemaVersion)
+			}
+			v.Success("%s is valid", result.Path)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package configcmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+func newProfileCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage named account profiles",
+		Long: `Manage named profiles, one per HubSpot portal.
+
+Each profile stores its own access token. Select a profile for a single
+command with --profile (or HUBSPOT_PROFILE), or make it the default
+with "hspt config profile use".`,
+	}
+
+	cmd.AddCommand(newProfileAddCmd(opts))
+	cmd.AddCommand(newProfileListCmd(opts))
+	cmd.AddCommand(newProfileUseCmd(opts))
+
+	return cmd
+}
+
+func newProfileAddCmd(opts *root.Options) *cobra.Command {
+	var token string
+	var use, force bool
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add a profile",
+		Long: `Add a named profile with its own access token.
+
+For the guided setup with connection verification and portal detection,
+use "hspt init --profile <name>" instead.`,
+		Example: `  # Add a profile for a client portal
+  hspt config profile add client-a --token pat-eu1-xxxxx
+
+  # Add and switch to it
+  hspt config profile add client-a --token pat-eu1-xxxxx --use`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			name := args[0]
+
+			if token == "" {
+				return fmt.Errorf("--token is required")
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if _, exists := cfg.Profiles[name]; exists && !force {
+				v.Warning("Profile %q already exists. Use --force to replace it.", name)
+				return nil
+			}
+
+			cfg.SetProfile(name, &config.Profile{
+				AccessToken: token,
+				Region:      config.RegionFromToken(token),
+			})
+			if use || cfg.CurrentProfile == "" {
+				cfg.CurrentProfile = name
+			}
+
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save configuration: %w", err)
+			}
+
+			v.Success("Profile %q added", name)
+			if cfg.CurrentProfile == name {
+				v.Info("Now using profile %q", name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&token, "token", "", "HubSpot access token (required)")
+	cmd.Flags().BoolVar(&use, "use", false, "Make this the current profile")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Replace an existing profile with the same name")
+
+	return cmd
+}
+
+func newProfileListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List profiles",
+		Long:  "List configured profiles. The active profile is marked with *.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if len(cfg.Profiles) == 0 {
+				v.Info("No profiles configured")
+				v.Info("Add one with: hspt init")
+				return nil
+			}
+
+			active := cfg.ActiveProfileName()
+			headers := []string{"CURRENT", "NAME", "PORTAL", "REGION", "TOKEN"}
+			rows := make([][]string, 0, len(cfg.Profiles))
+			for _, name := range cfg.ProfileNames() {
+				p := cfg.Profiles[name]
+				current := ""
+				if name == active {
+					current = "*"
+				}
+				portal := ""
+				if p.PortalID != 0 {
+					portal = strconv.FormatInt(p.PortalID, 10)
+				}
+				token := maskToken(p.AccessToken)
+				if token == "" && p.PersonalAccessKey != "" {
+					token = "key " + maskToken(p.PersonalAccessKey)
+				}
+				rows = append(rows, []string{current, name, portal, p.Region, token})
+			}
+
+			type profileJSON struct {
+				Name     string `json:"name"`
+				Current  bool   `json:"current"`
+				PortalID int64  `json:"portalId,omitempty"`
+				Region   string `json:"region,omitempty"`
+			}
+			data := make([]profileJSON, 0, len(cfg.Profiles))
+			for _, name := range cfg.ProfileNames() {
+				p := cfg.Profiles[name]
+				data = append(data, profileJSON{Name: name, Current: name == active, PortalID: p.PortalID, Region: p.Region})
+			}
+
+			return v.Render(headers, rows, data)
+		},
+	}
+}
+
+func newProfileUseCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Set the current profile",
+		Long:  "Make a profile the default for commands run without --profile.",
+		Example: `  # Switch to another portal
+  hspt config profile use client-a`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			name := args[0]
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := cfg.Profiles[name]; !ok {
+				v.Error("Profile %q not found", name)
+				return nil
+			}
+
+			cfg.CurrentProfile = name
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save configuration: %w", err)
+			}
+
+			v.Success("Now using profile %q", name)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package contacts
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// activityExport is the file written by export-activity
+type activityExport struct {
+	ExportedAt  string                 `json:"exportedAt"`
+	Contact     *api.CRMObject         `json:"contact"`
+	Counts      map[string]int         `json:"counts"`
+	Engagements []shared.TimelineEntry `json:"engagements"`
+}
+
+// newActivityExport bundles a contact with its engagements
+func newActivityExport(contact *api.CRMObject, entries []shared.TimelineEntry, now time.Time) activityExport {
+	counts := make(map[string]int)
+	for _, e := range entries {
+		counts[e.Type]++
+	}
+	if entries == nil {
+		entries = []shared.TimelineEntry{}
+	}
+	return activityExport{
+		ExportedAt:  now.UTC().Format(time.RFC3339),
+		Contact:     contact,
+		Counts:      counts,
+		Engagements: entries,
+	}
+}
+
+// describeCounts lists engagement counts such as "3 call, 12 note", sorted
+// by type
+func describeCounts(counts map[string]int) string {
+	kinds := make([]string, 0, len(counts))
+	for k := range counts {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+
+	parts := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[k], k))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func newExportActivityCmd(opts *root.Options) *cobra.Command {
+	var out string
+	var redact []string
+
+	cmd := &cobra.Command{
+		Use:   "export-activity <id|email>",
+		Short: "Export a contact's engagements with their full content",
+		Long: `Export every note, call, email, meeting, and task associated with a contact
+to one JSON file, with full bodies (email text and HTML, call notes and
+recordings, meeting notes) rather than the summaries of "contacts timeline".
+
+Useful for handing a contact's history to legal or to a new account owner.
+Each engagement type costs one associations request plus one batch read per
+100 engagements.
+
+--redact hashes or masks the contact's personal data properties (emails,
+phones, names, or profiles from the redaction config key). Engagement bodies
+are free text and are not redacted.`,
+		Example: `  # Bundle a contact's activity into a file
+  hspt contacts export-activity 12345 --out activity.json
+
+  # Look a contact up by email and write to stdout
+  hspt contacts export-activity jane@example.com > jane.json
+
+  # Hash the contact's email and mask their name
+  hspt contacts export-activity 12345 --out activity.json --redact emails,names`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			target := args[0]
+
+			redactor, err := shared.LoadRedactor(redact)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			contact, err := findContact(cmd.Context(), client, target, DefaultProperties)
+			if err != nil && !api.IsNotFound(err) {
+				return err
+			}
+			if contact == nil {
+				v.Error("Contact %s not found", target)
+				return nil
+			}
+
+			entries, err := shared.FetchActivity(cmd.Context(), client, api.ObjectTypeContacts, contact.ID)
+			if err != nil {
+				return err
+			}
+
+			if redactor != nil {
+				redactor.Properties(contact.Properties)
+			}
+
+			export := newActivityExport(contact, entries, time.Now())
+			data, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal activity: %w", err)
+			}
+			data = append(data, '\n')
+
+			if out == "" {
+				_, err = v.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(out, data, 0600); err != nil {
+				return fmt.Errorf("failed to write activity: %w", err)
+			}
+
+			summary := describeCounts(export.Counts)
+			if summary == "" {
+				summary = "no engagements"
+			}
+			v.Success("Exported %d engagement(s) of contact %s to %s (%s)", len(entries), contact.ID, out, summary)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File to write the activity to (default: stdout)")
+	shared.AddRedactFlag(cmd, &redact)
+
+	return cmd
+}
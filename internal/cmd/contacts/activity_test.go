@@ -0,0 +1,42 @@
+package contacts
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+func TestNewActivityExport(t *testing.T) {
+	contact := &api.CRMObject{ID: "101", Properties: map[string]interface{}{"email": "jane@example.com"}}
+	entries := []shared.TimelineEntry{
+		{Type: "email", ID: "1", Properties: map[string]interface{}{"hs_email_text": "Full text"}},
+		{Type: "note", ID: "2"},
+		{Type: "note", ID: "3"},
+	}
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	export := newActivityExport(contact, entries, now)
+	assert.Equal(t, "2024-06-01T10:00:00Z", export.ExportedAt)
+	assert.Equal(t, map[string]int{"email": 1, "note": 2}, export.Counts)
+	assert.Len(t, export.Engagements, 3)
+
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"hs_email_text":"Full text"`)
+
+	empty := newActivityExport(contact, nil, now)
+	data, err = json.Marshal(empty)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"engagements":[]`)
+}
+
+func TestDescribeCounts(t *testing.T) {
+	assert.Equal(t, "1 call, 12 note", describeCounts(map[string]int{"note": 12, "call": 1}))
+	assert.Equal(t, "", describeCounts(nil))
+}
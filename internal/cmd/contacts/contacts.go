@@ -8,6 +8,8 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for contacts
@@ -18,15 +20,66 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "contacts",
 		Short: "Manage HubSpot contacts",
-		Long:  "Commands for listing, viewing, creating, updating, and searching contacts in HubSpot CRM.",
+		Long:  "Commands for listing, viewing, creating, updating, upserting, merging, deleting, searching, and importing contacts in HubSpot CRM.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
 	cmd.AddCommand(newCreateCmd(opts))
 	cmd.AddCommand(newUpdateCmd(opts))
+	cmd.AddCommand(newUpsertCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newGDPRDeleteCmd(opts))
 	cmd.AddCommand(newSearchCmd(opts))
+	cmd.AddCommand(shared.NewMergeCmd(opts, shared.MergeCmdConfig{
+		ObjectType: api.ObjectTypeContacts,
+		Noun:       "contact",
+		Properties: DefaultProperties,
+		Example: `  # Preview merging a duplicate contact into the one to keep
+  hspt contacts merge 100 200
+
+  # Compare every property and see which values win
+  hspt contacts merge 100 200 --preview
+
+  # Merge it
+  hspt contacts merge 100 200 --force`,
+	}))
+	cmd.AddCommand(shared.NewDiffCmd(opts, shared.DiffCmdConfig{
+		ObjectType: api.ObjectTypeContacts,
+		Noun:       "contact",
+		Properties: DefaultProperties,
+		Example: `  # Properties that differ between two contacts
+  hspt contacts diff 111 222
+
+  # Compare every property, including matching ones
+  hspt contacts diff 111 222 --properties all --all`,
+	}))
+	cmd.AddCommand(newScoreBreakdownCmd(opts))
+	cmd.AddCommand(newDeliverabilityCmd(opts))
+	cmd.AddCommand(shared.NewImportCmd(opts, shared.ImportCmdConfig{
+		ObjectType: api.ObjectTypeContacts,
+		Short:      "Import contacts from a CSV file",
+		Long: `Import contacts from a CSV file.
+
+Headers are validated against the contact properties before any data is
+sent. Rows are sent in batches; rows with a value in --id-column update the
+existing contact and all other rows create new contacts.`,
+		Example: `  # Import contacts using CSV headers as property names
+  hspt contacts import --file contacts.csv
+
+  # Map CSV columns to contact properties
+  hspt contacts import --file contacts.csv --map email=Email,firstname=First,lastname=Last`,
+	}))
+	cmd.AddCommand(shared.NewTimelineCmd(opts, shared.TimelineCmdConfig{
+		ObjectType: api.ObjectTypeContacts,
+		Noun:       "contact",
+		Example: `  # Recent activity on a contact
+  hspt contacts timeline 101
+
+  # Every entry, only notes and calls
+  hspt contacts timeline 101 --types note,call --limit 0`,
+	}))
+	cmd.AddCommand(newExportActivityCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -35,6 +88,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
+	var hydrate shared.Hydration
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -47,10 +102,18 @@ func newListCmd(opts *root.Options) *cobra.Command {
   hspt contacts list --properties email,firstname,lastname,company
 
   # List with pagination
-  hspt contacts list --limit 50 --after abc123`,
+  hspt contacts list --limit 50 --after abc123
+
+  # With associated company names and deal counts
+  hspt contacts list --hydrate associations`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			hydrating, err := hydrate.Enabled()
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -60,11 +123,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeContacts, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeContacts, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -87,7 +150,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				})
 			}
 
-			if err := v.Render(headers, rows, result); err != nil {
+			var data interface{} = result
+			if hydrating {
+				if headers, rows, data, err = hydrate.Apply(cmd.Context(), v, client, api.ObjectTypeContacts, result, headers, rows); err != nil {
+					return err
+				}
+			}
+
+			if err := v.Render(headers, rows, data); err != nil {
 				return err
 			}
 
@@ -103,11 +173,15 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+	shared.AddHydrateFlag(cmd, &hydrate)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -117,7 +191,10 @@ func newGetCmd(opts *root.Options) *cobra.Command {
   hspt contacts get 12345
 
   # Get with specific properties
-  hspt contacts get 12345 --properties email,firstname,lastname`,
+  hspt contacts get 12345 --properties email,firstname,lastname
+
+  # With the change log of the lifecycle stage and owner
+  hspt contacts get 12345 --with-history lifecyclestage,hubspot_owner_id`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
@@ -132,7 +209,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeContacts, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeContacts, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Contact %s not found", id)
@@ -149,21 +226,26 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Last Name", obj.GetProperty("lastname")},
 				{"Phone", obj.GetProperty("phone")},
 				{"Company", obj.GetProperty("company")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
-	var email, firstname, lastname, phone, company string
+	var email, firstname, lastname, phone, company, ownerID, ownerEmail string
 	var props []string
 
 	cmd := &cobra.Command{
@@ -199,6 +281,13 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 			if company != "" {
 				properties["company"] = company
 			}
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
+			}
 
 			// Parse custom properties
 			for _, p := range props {
@@ -212,7 +301,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeContacts, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeContacts, properties)
 			if err != nil {
 				return err
 			}
@@ -236,13 +325,14 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&lastname, "lastname", "", "Contact last name")
 	cmd.Flags().StringVar(&phone, "phone", "", "Contact phone number")
 	cmd.Flags().StringVar(&company, "company", "", "Contact company name")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
 
 	return cmd
 }
 
 func newUpdateCmd(opts *root.Options) *cobra.Command {
-	var email, firstname, lastname, phone, company string
+	var email, firstname, lastname, phone, company, ownerID, ownerEmail string
 	var props []string
 
 	cmd := &cobra.Command{
@@ -280,6 +370,13 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 			if company != "" {
 				properties["company"] = company
 			}
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
+			}
 
 			// Parse custom properties
 			for _, p := range props {
@@ -293,7 +390,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeContacts, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeContacts, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Contact %s not found", id)
@@ -312,6 +409,106 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&lastname, "lastname", "", "Contact last name")
 	cmd.Flags().StringVar(&phone, "phone", "", "Contact phone number")
 	cmd.Flags().StringVar(&company, "company", "", "Contact company name")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
+	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
+
+	return cmd
+}
+
+func newUpsertCmd(opts *root.Options) *cobra.Command {
+	var email, firstname, lastname, phone, company, ownerID, ownerEmail string
+	var props []string
+
+	cmd := &cobra.Command{
+		Use:   "upsert",
+		Short: "Create or update a contact by email",
+		Long: `Update the contact with the given email address, or create it if no contact
+has that address. Running the same upsert twice leaves a single contact, so
+sync scripts need no search-then-create logic.`,
+		Example: `  # Create or update a contact
+  hspt contacts upsert --email jane@example.com --firstname Jane --lastname Doe
+
+  # Set custom properties
+  hspt contacts upsert --email jane@example.com --prop lifecyclestage=customer`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if email == "" {
+				return fmt.Errorf("--email is required")
+			}
+
+			properties := make(map[string]interface{})
+			if firstname != "" {
+				properties["firstname"] = firstname
+			}
+			if lastname != "" {
+				properties["lastname"] = lastname
+			}
+			if phone != "" {
+				properties["phone"] = phone
+			}
+			if company != "" {
+				properties["company"] = company
+			}
+			for _, p := range props {
+				parts := strings.SplitN(p, "=", 2)
+				if len(parts) == 2 {
+					properties[parts[0]] = parts[1]
+				}
+			}
+			properties["email"] = email
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
+			}
+
+			result, err := client.BatchUpsertObjects(cmd.Context(), api.ObjectTypeContacts, []api.BatchInput{
+				{ID: email, IDProperty: "email", Properties: properties},
+			})
+			if err != nil {
+				return err
+			}
+			if len(result.Errors) > 0 {
+				return fmt.Errorf("failed to upsert contact: %s", result.Errors[0].Message)
+			}
+			if len(result.Results) == 0 {
+				return fmt.Errorf("failed to upsert contact: no result returned")
+			}
+
+			obj := result.Results[0]
+			if obj.New {
+				v.Success("Contact created with ID: %s", obj.ID)
+			} else {
+				v.Success("Contact %s updated", obj.ID)
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", obj.ID},
+				{"Email", obj.GetProperty("email")},
+				{"First Name", obj.GetProperty("firstname")},
+				{"Last Name", obj.GetProperty("lastname")},
+			}
+
+			return v.Render(headers, rows, obj)
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Contact email address to match on (required)")
+	cmd.Flags().StringVar(&firstname, "firstname", "", "Contact first name")
+	cmd.Flags().StringVar(&lastname, "lastname", "", "Contact last name")
+	cmd.Flags().StringVar(&phone, "phone", "", "Contact phone number")
+	cmd.Flags().StringVar(&company, "company", "", "Contact company name")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
 
 	return cmd
@@ -323,7 +520,9 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <id>",
 		Short: "Delete a contact",
-		Long:  "Archive (soft delete) a contact in HubSpot CRM.",
+		Long: `Archive (soft delete) a contact in HubSpot CRM. Archived contacts can be
+restored from the recycling bin for 90 days; use "contacts gdpr-delete" to
+delete a contact permanently.`,
 		Example: `  # Delete contact
   hspt contacts delete 12345
 
@@ -344,7 +543,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeContacts, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeContacts, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Contact %s not found", id)
 					return nil
@@ -352,8 +551,8 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			v.Success("Contact %s archived", id)
-			return nil
+			v.Success("Contact %s archived (restorable for 90 days)", id)
+			return renderDeletion(v, deletionResult{ID: id, Deletion: deletionArchived})
 		},
 	}
 
@@ -363,122 +562,42 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 }
 
 func newSearchCmd(opts *root.Options) *cobra.Command {
-	var email, firstname, lastname string
-	var query string
-	var limit int
-	var properties []string
-
-	cmd := &cobra.Command{
-		Use:   "search",
-		Short: "Search contacts",
-		Long:  "Search for contacts using filters.",
+	return shared.NewSearchCmd(opts, shared.SearchCmdConfig{
+		ObjectType: api.ObjectTypeContacts,
+		Noun:       "contact",
+		Short:      "Search contacts",
+		Long: `Search for contacts using filters.
+
+The --email, --firstname, --lastname, and --query flags are shortcuts for
+common filters; --filter accepts any property and search operator.`,
 		Example: `  # Search by email
   hspt contacts search --email john@example.com
 
   # Search by name
   hspt contacts search --firstname John --lastname Doe
 
-  # Full-text search
-  hspt contacts search --query "john"`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			if len(properties) == 0 {
-				properties = DefaultProperties
-			}
+  # Search by email fragment
+  hspt contacts search --query "john"
 
-			// Build filters
-			var filters []api.SearchFilter
-
-			if email != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "email",
-					Operator:     "EQ",
-					Value:        email,
-				})
-			}
-			if firstname != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "firstname",
-					Operator:     "CONTAINS_TOKEN",
-					Value:        firstname,
-				})
-			}
-			if lastname != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "lastname",
-					Operator:     "CONTAINS_TOKEN",
-					Value:        lastname,
-				})
-			}
-
-			req := api.SearchRequest{
-				Properties: properties,
-				Limit:      limit,
-			}
-
-			if len(filters) > 0 {
-				req.FilterGroups = []api.SearchFilterGroup{
-					{Filters: filters},
-				}
-			}
-
-			// Note: HubSpot search API doesn't have a direct full-text query parameter
-			// for contacts like some other APIs. The filters above handle specific field searches.
-			if query != "" && len(filters) == 0 {
-				// For simple query, search in email field
-				req.FilterGroups = []api.SearchFilterGroup{
-					{
-						Filters: []api.SearchFilter{
-							{
-								PropertyName: "email",
-								Operator:     "CONTAINS_TOKEN",
-								Value:        query,
-							},
-						},
-					},
-				}
-			}
-
-			result, err := client.SearchObjects(api.ObjectTypeContacts, req)
-			if err != nil {
-				return err
-			}
-
-			if len(result.Results) == 0 {
-				v.Info("No contacts found matching criteria")
-				return nil
-			}
-
-			headers := []string{"ID", "EMAIL", "FIRST NAME", "LAST NAME", "PHONE", "COMPANY"}
-			rows := make([][]string, 0, len(result.Results))
-			for _, obj := range result.Results {
-				rows = append(rows, []string{
-					obj.ID,
-					obj.GetProperty("email"),
-					obj.GetProperty("firstname"),
-					obj.GetProperty("lastname"),
-					obj.GetProperty("phone"),
-					obj.GetProperty("company"),
-				})
+  # Leads created this year, newest first
+  hspt contacts search --filter "lifecyclestage EQ lead" --filter "createdate>=2026-01-01" --sort createdate:desc`,
+		Shorthands: []shared.SearchShorthand{
+			{Flag: "email", Property: "email", Operator: "EQ", Usage: "Search by exact email"},
+			{Flag: "firstname", Property: "firstname", Operator: "CONTAINS_TOKEN", Usage: "Search by first name (contains)"},
+			{Flag: "lastname", Property: "lastname", Operator: "CONTAINS_TOKEN", Usage: "Search by last name (contains)"},
+			{Flag: "query", Property: "email", Operator: "CONTAINS_TOKEN", Usage: "Search by email (contains)"},
+		},
+		DefaultProperties: DefaultProperties,
+		Headers:           []string{"ID", "EMAIL", "FIRST NAME", "LAST NAME", "PHONE", "COMPANY"},
+		Row: func(v *view.View, obj api.CRMObject) []string {
+			return []string{
+				obj.ID,
+				obj.GetProperty("email"),
+				obj.GetProperty("firstname"),
+				obj.GetProperty("lastname"),
+				obj.GetProperty("phone"),
+				obj.GetProperty("company"),
 			}
-
-			v.Info("Found %d contact(s)", len(result.Results))
-			return v.Render(headers, rows, result)
 		},
-	}
-
-	cmd.Flags().StringVar(&email, "email", "", "Search by exact email")
-	cmd.Flags().StringVar(&firstname, "firstname", "", "Search by first name (contains)")
-	cmd.Flags().StringVar(&lastname, "lastname", "", "Search by last name (contains)")
-	cmd.Flags().StringVar(&query, "query", "", "Full-text search query")
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
-	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
-
-	return cmd
+	})
 }
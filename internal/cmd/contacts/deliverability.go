@@ -0,0 +1,249 @@
+package contacts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// deliverabilityProperties are the contact properties HubSpot keeps about
+// an address's email health
+var deliverabilityProperties = []string{
+	"email",
+	"hs_email_optout",
+	"hs_email_quarantined",
+	"hs_email_quarantined_reason",
+	"hs_email_hard_bounce_reason_enum",
+	"hs_email_last_send_date",
+}
+
+// deliverabilityEventTypes are the email events summarized, problems first
+var deliverabilityEventTypes = []string{
+	api.EmailEventBounce,
+	api.EmailEventDropped,
+	api.EmailEventSpamReport,
+	api.EmailEventStatusChange,
+	api.EmailEventDelivered,
+}
+
+// deliverabilityReport is the JSON output of deliverability
+type deliverabilityReport struct {
+	ContactID        string                   `json:"contactId,omitempty"`
+	Email            string                   `json:"email"`
+	OptedOut         bool                     `json:"optedOut"`
+	Quarantined      bool                     `json:"quarantined"`
+	QuarantineReason string                   `json:"quarantineReason,omitempty"`
+	HardBounceReason string                   `json:"hardBounceReason,omitempty"`
+	LastSend         string                   `json:"lastSend,omitempty"`
+	Events           []eventSummary           `json:"events"`
+	Subscriptions    []api.SubscriptionStatus `json:"subscriptions,omitempty"`
+}
+
+// eventSummary counts the events of one type and describes the latest
+type eventSummary struct {
+	Type       string `json:"type"`
+	Count      int    `json:"count"`
+	More       bool   `json:"more,omitempty"`
+	MostRecent string `json:"mostRecent,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// eventReason describes why an event happened, from the fields its type sets
+func eventReason(e api.EmailEvent) string {
+	var parts []string
+	switch e.Type {
+	case api.EmailEventBounce:
+		parts = []string{e.Category, e.Response}
+		if e.Response == "" {
+			parts = append(parts, e.Status)
+		}
+	case api.EmailEventDropped:
+		parts = []string{e.DropReason, e.DropMessage}
+	case api.EmailEventStatusChange:
+		parts = []string{e.PortalSubscriptionStatus, e.Source}
+	}
+
+	var reason []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			reason = append(reason, p)
+		}
+	}
+	return strings.Join(reason, ": ")
+}
+
+// summarizeEvents summarizes one page of events of a type, newest first.
+// more reports that the page did not hold every event.
+func summarizeEvents(eventType string, events []api.EmailEvent, more bool) eventSummary {
+	summary := eventSummary{Type: eventType, Count: len(events), More: more}
+	if len(events) > 0 {
+		summary.MostRecent = time.UnixMilli(events[0].Created).UTC().Format(time.RFC3339)
+		summary.Reason = eventReason(events[0])
+	}
+	return summary
+}
+
+// findContact resolves a contact ID or email address to a contact with the
+// given properties. A nil contact without error means no contact has the
+// email address.
+func findContact(ctx context.Context, client *api.Client, idOrEmail string, properties []string) (*api.CRMObject, error) {
+	if !strings.Contains(idOrEmail, "@") {
+		return client.GetObject(ctx, api.ObjectTypeContacts, idOrEmail, properties)
+	}
+
+	result, err := client.SearchObjects(ctx, api.ObjectTypeContacts, api.SearchRequest{
+		FilterGroups: []api.SearchFilterGroup{{
+			Filters: []api.SearchFilter{{PropertyName: "email", Operator: "EQ", Value: idOrEmail}},
+		}},
+		Properties: properties,
+		Limit:      1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, nil
+	}
+	return &result.Results[0], nil
+}
+
+func newDeliverabilityCmd(opts *root.Options) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "deliverability <id|email>",
+		Short: "Show a contact's email bounce and suppression history",
+		Long: `Summarize why email to a contact might not be arriving: whether the address
+is opted out or quarantined, its bounce, drop, spam report, and unsubscribe
+history with the most recent reason for each, and its subscription status
+for every subscription type.
+
+An email address with no contact record still shows its email history.`,
+		Example: `  # Why isn't this contact getting our emails?
+  hspt contacts deliverability jane@example.com
+
+  # By contact ID, as JSON
+  hspt contacts deliverability 12345 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			obj, err := findContact(ctx, client, args[0], deliverabilityProperties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Contact %s not found", args[0])
+					return nil
+				}
+				return err
+			}
+
+			report := deliverabilityReport{Events: []eventSummary{}}
+			if obj == nil {
+				v.Warning("No contact has email %s; showing email history only", args[0])
+				report.Email = args[0]
+			} else {
+				report.ContactID = obj.ID
+				report.Email = obj.GetProperty("email")
+				report.OptedOut = obj.GetProperty("hs_email_optout") == "true"
+				report.Quarantined = obj.GetProperty("hs_email_quarantined") == "true"
+				report.QuarantineReason = obj.GetProperty("hs_email_quarantined_reason")
+				report.HardBounceReason = obj.GetProperty("hs_email_hard_bounce_reason_enum")
+				report.LastSend = obj.GetProperty("hs_email_last_send_date")
+			}
+			if report.Email == "" {
+				v.Error("Contact %s has no email address", obj.ID)
+				return nil
+			}
+
+			for _, eventType := range deliverabilityEventTypes {
+				events, err := client.ListEmailEvents(ctx, api.EmailEventOptions{
+					Recipient: report.Email,
+					EventType: eventType,
+					Limit:     limit,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to load %s events: %w", strings.ToLower(eventType), err)
+				}
+				report.Events = append(report.Events, summarizeEvents(eventType, events.Events, events.HasMore))
+			}
+
+			statuses, err := client.GetSubscriptionStatuses(ctx, report.Email)
+			if err != nil {
+				v.Warning("Could not load subscription status: %v", err)
+			} else {
+				report.Subscriptions = statuses.SubscriptionStatuses
+			}
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(report)
+			}
+
+			if report.ContactID != "" {
+				v.Info("Contact %s (%s)", report.ContactID, report.Email)
+				v.Info("Opted out of all email: %s", formatYesNo(report.OptedOut))
+				quarantined := formatYesNo(report.Quarantined)
+				if report.QuarantineReason != "" {
+					quarantined += " (" + report.QuarantineReason + ")"
+				}
+				v.Info("Quarantined: %s", quarantined)
+				if report.HardBounceReason != "" {
+					v.Info("Hard bounce reason: %s", report.HardBounceReason)
+				}
+				if report.LastSend != "" {
+					v.Info("Last marketing email sent: %s", v.Time(report.LastSend))
+				}
+			}
+
+			headers := []string{"EVENT", "COUNT", "MOST RECENT", "REASON"}
+			rows := make([][]string, 0, len(report.Events))
+			for _, e := range report.Events {
+				count := strconv.Itoa(e.Count)
+				if e.More {
+					count += "+"
+				}
+				rows = append(rows, []string{e.Type, count, orDash(v.Time(e.MostRecent)), orDash(e.Reason)})
+			}
+			if err := v.Render(headers, rows, nil); err != nil {
+				return err
+			}
+
+			if len(report.Subscriptions) > 0 {
+				v.PrintlnStatus("")
+				subRows := make([][]string, 0, len(report.Subscriptions))
+				for _, s := range report.Subscriptions {
+					subRows = append(subRows, []string{s.Name, s.Status, orDash(s.SourceOfStatus)})
+				}
+				if err := v.Render([]string{"SUBSCRIPTION", "STATUS", "SOURCE"}, subRows, nil); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 100, "Maximum events of each type to read")
+
+	return cmd
+}
+
+func formatYesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
@@ -0,0 +1,67 @@
+package contacts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestEventReason(t *testing.T) {
+	tests := []struct {
+		name  string
+		event api.EmailEvent
+		want  string
+	}{
+		{
+			name:  "bounce with response",
+			event: api.EmailEvent{Type: api.EmailEventBounce, Category: "MAILBOX_FULL", Status: "552", Response: "552 5.2.2 Mailbox full"},
+			want:  "MAILBOX_FULL: 552 5.2.2 Mailbox full",
+		},
+		{
+			name:  "bounce without response falls back to status",
+			event: api.EmailEvent{Type: api.EmailEventBounce, Category: "UNKNOWN_USER", Status: "550"},
+			want:  "UNKNOWN_USER: 550",
+		},
+		{
+			name:  "drop",
+			event: api.EmailEvent{Type: api.EmailEventDropped, DropReason: "PREVIOUSLY_BOUNCED"},
+			want:  "PREVIOUSLY_BOUNCED",
+		},
+		{
+			name:  "unsubscribe",
+			event: api.EmailEvent{Type: api.EmailEventStatusChange, PortalSubscriptionStatus: "UNSUBSCRIBED", Source: "SOURCE_RECIPIENT"},
+			want:  "UNSUBSCRIBED: SOURCE_RECIPIENT",
+		},
+		{
+			name:  "delivered has no reason",
+			event: api.EmailEvent{Type: api.EmailEventDelivered, Response: "250 OK"},
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, eventReason(tt.event))
+		})
+	}
+}
+
+func TestSummarizeEvents(t *testing.T) {
+	events := []api.EmailEvent{
+		{Type: api.EmailEventDropped, Created: 1717200000000, DropReason: "PREVIOUSLY_UNSUBSCRIBED_PORTAL"},
+		{Type: api.EmailEventDropped, Created: 1717100000000, DropReason: "PREVIOUSLY_BOUNCED"},
+	}
+
+	summary := summarizeEvents(api.EmailEventDropped, events, true)
+	assert.Equal(t, eventSummary{
+		Type:       api.EmailEventDropped,
+		Count:      2,
+		More:       true,
+		MostRecent: "2024-06-01T00:00:00Z",
+		Reason:     "PREVIOUSLY_UNSUBSCRIBED_PORTAL",
+	}, summary)
+
+	assert.Equal(t, eventSummary{Type: api.EmailEventBounce}, summarizeEvents(api.EmailEventBounce, nil, false))
+}
@@ -0,0 +1,106 @@
+package contacts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// Kinds of deletion reported by delete and gdpr-delete
+const (
+	deletionArchived  = "archived"
+	deletionPermanent = "permanent"
+)
+
+// deletionResult is the JSON output of delete and gdpr-delete
+type deletionResult struct {
+	ID       string `json:"id"`
+	Email    string `json:"email,omitempty"`
+	Deletion string `json:"deletion"`
+}
+
+func newGDPRDeleteCmd(opts *root.Options) *cobra.Command {
+	var confirm string
+
+	cmd := &cobra.Command{
+		Use:   "gdpr-delete <id|email>",
+		Short: "Permanently delete a contact for a GDPR erasure request",
+		Long: `Permanently delete a contact and its email history to honor a GDPR right to
+erasure request. Unlike "contacts delete", which archives the contact so it
+can be restored for 90 days, this cannot be undone.
+
+To confirm, repeat the contact ID or email exactly as given with --confirm.
+Without it the contact is only shown.`,
+		Example: `  # Check which contact would be deleted
+  hspt contacts gdpr-delete jane@example.com
+
+  # Delete it permanently
+  hspt contacts gdpr-delete jane@example.com --confirm jane@example.com`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			target := args[0]
+
+			if confirm != "" && confirm != target {
+				return fmt.Errorf("--confirm %q does not match %q", confirm, target)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			obj, err := findContact(cmd.Context(), client, target, []string{"email", "firstname", "lastname"})
+			if err != nil && !api.IsNotFound(err) {
+				return err
+			}
+			if obj == nil {
+				v.Error("Contact %s not found", target)
+				return nil
+			}
+
+			if confirm == "" {
+				rows := [][]string{
+					{"ID", obj.ID},
+					{"Email", obj.GetProperty("email")},
+					{"Name", strings.TrimSpace(obj.GetProperty("firstname") + " " + obj.GetProperty("lastname"))},
+				}
+				if err := v.Render([]string{"PROPERTY", "VALUE"}, rows, obj); err != nil {
+					return err
+				}
+				v.Warning("This will permanently delete contact %s and its email history. It cannot be restored.", obj.ID)
+				v.Info("To confirm, run again with --confirm %s", target)
+				return nil
+			}
+
+			if err := client.GDPRDeleteContact(cmd.Context(), obj.ID, ""); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Contact %s not found", target)
+					return nil
+				}
+				return fmt.Errorf("failed to delete contact: %w", err)
+			}
+
+			v.Success("Contact %s permanently deleted", obj.ID)
+			return renderDeletion(v, deletionResult{ID: obj.ID, Email: obj.GetProperty("email"), Deletion: deletionPermanent})
+		},
+	}
+
+	cmd.Flags().StringVar(&confirm, "confirm", "", "Contact ID or email, repeated to confirm the deletion")
+
+	return cmd
+}
+
+// renderDeletion prints what was deleted for JSON output; table output only
+// shows the status message
+func renderDeletion(v *view.View, result deletionResult) error {
+	if v.Format != view.FormatJSON {
+		return nil
+	}
+	return v.JSON(result)
+}
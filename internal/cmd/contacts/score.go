@@ -0,0 +1,235 @@
+package contacts
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// scoreContextProperties explain where a contact stands alongside its scores
+var scoreContextProperties = []string{
+	"email",
+	"lifecyclestage",
+	"hs_lead_status",
+	"hs_predictivescoringtier",
+	"hs_lifecyclestage_marketingqualifiedlead_date",
+}
+
+// scoreBreakdown is the JSON output of score-breakdown
+type scoreBreakdown struct {
+	ID             string       `json:"id"`
+	Email          string       `json:"email,omitempty"`
+	LifecycleStage string       `json:"lifecycleStage,omitempty"`
+	LeadStatus     string       `json:"leadStatus,omitempty"`
+	PredictiveTier string       `json:"predictiveTier,omitempty"`
+	BecameMQL      string       `json:"becameMql,omitempty"`
+	Scores         []scoreValue `json:"scores"`
+}
+
+// scoreValue is one score property and how it got its current value
+type scoreValue struct {
+	Name    string        `json:"name"`
+	Label   string        `json:"label"`
+	Value   string        `json:"value"`
+	Changes []scoreChange `json:"changes,omitempty"`
+}
+
+// scoreChange is one update of a score, with the points it added or removed
+type scoreChange struct {
+	Timestamp string `json:"timestamp"`
+	Value     string `json:"value"`
+	Delta     string `json:"delta"`
+	Source    string `json:"source,omitempty"`
+}
+
+// isScoreProperty reports whether a contact property holds a lead score:
+// the legacy HubSpot score, scores built in the lead scoring tool, and
+// number properties with "score" in their name such as predictive scores
+func isScoreProperty(p api.Property) bool {
+	if p.Name == "hubspotscore" || p.FieldType == "calculation_score" {
+		return true
+	}
+	return p.Type == "number" && strings.Contains(strings.ToLower(p.Name), "score")
+}
+
+// scoreChanges turns a property history (newest first, as HubSpot returns
+// it) into changes, oldest first, each with the difference from the value
+// before it
+func scoreChanges(history []api.PropertyHistoryEntry) []scoreChange {
+	changes := make([]scoreChange, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		h := history[i]
+
+		delta := "-"
+		if i == len(history)-1 {
+			delta = "initial"
+		} else if cur, err := strconv.ParseFloat(h.Value, 64); err == nil {
+			if prev, err := strconv.ParseFloat(history[i+1].Value, 64); err == nil {
+				delta = strconv.FormatFloat(cur-prev, 'f', -1, 64)
+				if cur-prev >= 0 {
+					delta = "+" + delta
+				}
+			}
+		}
+
+		source := h.SourceType
+		if h.SourceLabel != "" {
+			source = h.SourceLabel
+		}
+
+		changes = append(changes, scoreChange{
+			Timestamp: h.Timestamp,
+			Value:     h.Value,
+			Delta:     delta,
+			Source:    source,
+		})
+	}
+	return changes
+}
+
+func newScoreBreakdownCmd(opts *root.Options) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "score-breakdown <id>",
+		Short: "Show a contact's lead scores and how they changed",
+		Long: `Show every lead score property on a contact, its lifecycle stage, and the
+history of each score with the points gained or lost at each change.
+
+HubSpot's API does not expose which individual scoring criteria matched, so
+the history of score changes, and what triggered them, is the closest
+breakdown available. Compare change times with the contact's activity to see
+which criteria fired.`,
+		Example: `  # Why is this lead an MQL?
+  hspt contacts score-breakdown 12345
+
+  # Full history as JSON
+  hspt contacts score-breakdown 12345 --limit 0 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			props, err := client.ListProperties(cmd.Context(), api.ObjectTypeContacts)
+			if err != nil {
+				return fmt.Errorf("failed to load contact properties: %w", err)
+			}
+
+			labels := make(map[string]string)
+			var scoreNames []string
+			for _, p := range props.Results {
+				if isScoreProperty(p) {
+					scoreNames = append(scoreNames, p.Name)
+					labels[p.Name] = p.Label
+				}
+			}
+			sort.Strings(scoreNames)
+			if len(scoreNames) == 0 {
+				v.Info("No lead score properties are defined for contacts")
+				return nil
+			}
+
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeContacts, id,
+				append(append([]string{}, scoreContextProperties...), scoreNames...), scoreNames)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Contact %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			result := scoreBreakdown{
+				ID:             obj.ID,
+				Email:          obj.GetProperty("email"),
+				LifecycleStage: obj.GetProperty("lifecyclestage"),
+				LeadStatus:     obj.GetProperty("hs_lead_status"),
+				PredictiveTier: obj.GetProperty("hs_predictivescoringtier"),
+				BecameMQL:      obj.GetProperty("hs_lifecyclestage_marketingqualifiedlead_date"),
+				Scores:         []scoreValue{},
+			}
+			for _, name := range scoreNames {
+				value := obj.GetProperty(name)
+				history := obj.PropertiesWithHistory[name]
+				if value == "" && len(history) == 0 {
+					continue
+				}
+				changes := scoreChanges(history)
+				if limit > 0 && len(changes) > limit {
+					changes = changes[len(changes)-limit:]
+				}
+				result.Scores = append(result.Scores, scoreValue{
+					Name:    name,
+					Label:   labels[name],
+					Value:   value,
+					Changes: changes,
+				})
+			}
+
+			headers := []string{"SCORE", "LABEL", "VALUE", "CHANGES"}
+			rows := make([][]string, 0, len(result.Scores))
+			for _, s := range result.Scores {
+				rows = append(rows, []string{s.Name, s.Label, s.Value, strconv.Itoa(len(s.Changes))})
+			}
+
+			v.Info("Contact %s (%s): lifecycle stage %s", obj.ID, orDash(result.Email), orDash(result.LifecycleStage))
+			if result.BecameMQL != "" {
+				v.Info("Became MQL: %s", v.Time(result.BecameMQL))
+			}
+			if result.PredictiveTier != "" {
+				v.Info("Predictive tier: %s", result.PredictiveTier)
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+			if len(result.Scores) == 0 {
+				v.Info("No score values recorded for this contact")
+				return nil
+			}
+			if v.Format == view.FormatJSON {
+				return nil
+			}
+
+			for _, s := range result.Scores {
+				if len(s.Changes) == 0 {
+					continue
+				}
+				v.PrintlnStatus("")
+				v.Info("%s history:", s.Name)
+				changeRows := make([][]string, 0, len(s.Changes))
+				for _, c := range s.Changes {
+					changeRows = append(changeRows, []string{v.Time(c.Timestamp), c.Delta, c.Value, c.Source})
+				}
+				if err := v.Render([]string{"TIMESTAMP", "CHANGE", "VALUE", "SOURCE"}, changeRows, nil); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Most recent changes to show per score (0 for all)")
+
+	return cmd
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,36 @@
+package contacts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestIsScoreProperty(t *testing.T) {
+	assert.True(t, isScoreProperty(api.Property{Name: "hubspotscore", Type: "number"}))
+	assert.True(t, isScoreProperty(api.Property{Name: "engagement_points", Type: "number", FieldType: "calculation_score"}))
+	assert.True(t, isScoreProperty(api.Property{Name: "hs_predictivecontactscore_v2", Type: "number"}))
+	assert.False(t, isScoreProperty(api.Property{Name: "score_notes", Type: "string"}))
+	assert.False(t, isScoreProperty(api.Property{Name: "num_employees", Type: "number"}))
+}
+
+func TestScoreChanges(t *testing.T) {
+	history := []api.PropertyHistoryEntry{
+		{Value: "15", Timestamp: "2024-03-01T00:00:00Z", SourceType: "CALCULATED"},
+		{Value: "25", Timestamp: "2024-02-01T00:00:00Z", SourceType: "CALCULATED", SourceLabel: "Score recalculation"},
+		{Value: "10", Timestamp: "2024-01-01T00:00:00Z", SourceType: "CALCULATED"},
+	}
+
+	assert.Equal(t, []scoreChange{
+		{Timestamp: "2024-01-01T00:00:00Z", Value: "10", Delta: "initial", Source: "CALCULATED"},
+		{Timestamp: "2024-02-01T00:00:00Z", Value: "25", Delta: "+15", Source: "Score recalculation"},
+		{Timestamp: "2024-03-01T00:00:00Z", Value: "15", Delta: "-10", Source: "CALCULATED"},
+	}, scoreChanges(history))
+
+	assert.Empty(t, scoreChanges(nil))
+
+	cleared := scoreChanges([]api.PropertyHistoryEntry{{Value: ""}, {Value: "5"}})
+	assert.Equal(t, "-", cleared[1].Delta)
+}
@@ -0,0 +1,311 @@
+package contextcmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// nameProperties are the properties that name a record of each type
+var nameProperties = map[api.ObjectType][]string{
+	api.ObjectTypeContacts:  {"firstname", "lastname", "email"},
+	api.ObjectTypeCompanies: {"name"},
+	api.ObjectTypeDeals:     {"dealname"},
+	api.ObjectTypeTickets:   {"subject"},
+}
+
+// contextRecord is one record of the context in JSON output
+type contextRecord struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// Register registers the context command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Set the records new engagements are associated with",
+		Long: `Keep a record context for the current profile: the contact, company, deal,
+and ticket you are working on. While a context is set, notes, calls, emails,
+meetings, and tasks created with "create" are associated with its records
+automatically, as if each were given with --associate.
+
+An explicit --associate of the same type takes precedence over the context,
+and --no-context skips it for one command.`,
+	}
+
+	cmd.AddCommand(newSetCmd(opts))
+	cmd.AddCommand(newShowCmd(opts))
+	cmd.AddCommand(newClearCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// parseContextArgs reads alternating type and ID arguments into a context,
+// keyed by plural object type
+func parseContextArgs(args []string) (map[string]string, error) {
+	if len(args) == 0 || len(args)%2 != 0 {
+		return nil, fmt.Errorf("expected pairs of type and ID, e.g. deal 123 contact 456")
+	}
+
+	records := make(map[string]string)
+	for i := 0; i < len(args); i += 2 {
+		t, ok := shared.AssociateType(args[i])
+		if !ok {
+			return nil, fmt.Errorf("invalid type %q: must be contact, company, deal, or ticket", args[i])
+		}
+		id := strings.TrimSpace(args[i+1])
+		if id == "" {
+			return nil, fmt.Errorf("missing ID for %s", args[i])
+		}
+		records[string(t)] = id
+	}
+	return records, nil
+}
+
+// resolveRecords replaces names, emails, and domains among the context
+// records with record IDs
+func resolveRecords(cmd *cobra.Command, opts *root.Options, records map[string]string) error {
+	types := make([]string, 0, len(records))
+	for t, id := range records {
+		if !shared.IsRecordID(id) {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return nil
+	}
+	sort.Strings(types)
+
+	resolver, err := shared.NewRecordResolver(opts)
+	if err != nil {
+		return err
+	}
+	for _, t := range types {
+		id, err := resolver.Resolve(cmd.Context(), api.ObjectType(t), records[t])
+		if err != nil {
+			return err
+		}
+		records[t] = id
+	}
+	return nil
+}
+
+// activeProfile loads the config and the profile the context belongs to
+func activeProfile() (*config.Config, *config.Profile, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	p := cfg.ActiveProfile()
+	if p == nil {
+		return nil, nil, fmt.Errorf("profile %q is not configured; run hspt init first", cfg.ActiveProfileName())
+	}
+	return cfg, p, nil
+}
+
+func newSetCmd(opts *root.Options) *cobra.Command {
+	var replace bool
+
+	cmd := &cobra.Command{
+		Use:   "set <type> <id|name> [<type> <id|name>...]",
+		Short: "Add records to the context",
+		Long: `Add records to the context of the current profile. Types are contact,
+company, deal, and ticket (singular or plural). Setting a type that is
+already in the context replaces its record; --replace clears the other
+types first.
+
+A record can also be given by email, name, or domain. When that matches
+several records, an interactive terminal offers a list to choose from.`,
+		Example: `  # Work on a deal and its main contact
+  hspt context set deal 123 contact 456
+
+  # Notes are now associated with both records
+  hspt notes create --body "Sent the revised quote"
+
+  # Look records up by email and company name
+  hspt context set contact jane@example.com company "Acme Corp"
+
+  # Switch to a different deal, dropping the contact
+  hspt context set deal 789 --replace`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			records, err := parseContextArgs(args)
+			if err != nil {
+				return err
+			}
+			if err := resolveRecords(cmd, opts, records); err != nil {
+				return err
+			}
+
+			cfg, p, err := activeProfile()
+			if err != nil {
+				return err
+			}
+
+			if replace || p.Context == nil {
+				p.Context = make(map[string]string)
+			}
+			for t, id := range records {
+				p.Context[t] = id
+			}
+
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save configuration: %w", err)
+			}
+
+			v.Success("Context set: %s", formatContext(p.Context))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&replace, "replace", false, "Clear the existing context first")
+
+	return cmd
+}
+
+func newShowCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show the active context",
+		Long:  "Show the records in the context of the current profile, with their names.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			cfg, p, err := activeProfile()
+			if err != nil {
+				return err
+			}
+
+			if len(p.Context) == 0 {
+				v.Info("No context set for profile %q", cfg.ActiveProfileName())
+				v.Info("Set one with: hspt context set deal <id>")
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			records := make([]contextRecord, 0, len(p.Context))
+			for _, t := range sortedTypes(p.Context) {
+				record := contextRecord{Type: t, ID: p.Context[t]}
+
+				objectType := api.ObjectType(t)
+				obj, err := client.GetObject(cmd.Context(), objectType, record.ID, nameProperties[objectType])
+				switch {
+				case api.IsNotFound(err):
+					v.Warning("%s %s no longer exists", t, record.ID)
+				case err != nil:
+					return fmt.Errorf("failed to get %s %s: %w", t, record.ID, err)
+				default:
+					record.Name = recordName(objectType, obj)
+				}
+				records = append(records, record)
+			}
+
+			headers := []string{"TYPE", "ID", "NAME"}
+			rows := make([][]string, 0, len(records))
+			for _, r := range records {
+				rows = append(rows, []string{r.Type, r.ID, r.Name})
+			}
+
+			return v.Render(headers, rows, records)
+		},
+	}
+}
+
+func newClearCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear [type...]",
+		Short: "Remove records from the context",
+		Long:  "Remove the given types from the context of the current profile, or the whole context when no type is given.",
+		Example: `  # Stop associating with the contact
+  hspt context clear contact
+
+  # Clear everything
+  hspt context clear`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			cfg, p, err := activeProfile()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				p.Context = nil
+			}
+			for _, arg := range args {
+				t, ok := shared.AssociateType(arg)
+				if !ok {
+					return fmt.Errorf("invalid type %q: must be contact, company, deal, or ticket", arg)
+				}
+				delete(p.Context, string(t))
+			}
+			if len(p.Context) == 0 {
+				p.Context = nil
+			}
+
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save configuration: %w", err)
+			}
+
+			if len(p.Context) == 0 {
+				v.Success("Context cleared")
+			} else {
+				v.Success("Context is now: %s", formatContext(p.Context))
+			}
+			return nil
+		},
+	}
+}
+
+// recordName describes a record by its name properties
+func recordName(t api.ObjectType, obj *api.CRMObject) string {
+	if t == api.ObjectTypeContacts {
+		name := strings.TrimSpace(obj.GetProperty("firstname") + " " + obj.GetProperty("lastname"))
+		if email := obj.GetProperty("email"); email != "" {
+			if name == "" {
+				return email
+			}
+			return name + " <" + email + ">"
+		}
+		return name
+	}
+
+	props := nameProperties[t]
+	if len(props) == 0 {
+		return ""
+	}
+	return obj.GetProperty(props[0])
+}
+
+func sortedTypes(context map[string]string) []string {
+	types := make([]string, 0, len(context))
+	for t := range context {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// formatContext lists a context as type:id pairs
+func formatContext(context map[string]string) string {
+	parts := make([]string, 0, len(context))
+	for _, t := range sortedTypes(context) {
+		parts = append(parts, t+":"+context[t])
+	}
+	return strings.Join(parts, ", ")
+}
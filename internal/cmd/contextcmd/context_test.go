@@ -0,0 +1,46 @@
+package contextcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseContextArgs(t *testing.T) {
+	t.Run("pairs", func(t *testing.T) {
+		got, err := parseContextArgs([]string{"deal", "123", "Contacts", "456"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"deals": "123", "contacts": "456"}, got)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, args := range [][]string{
+			nil,
+			{"deal"},
+			{"deal", "123", "contact"},
+			{"widget", "1"},
+			{"deal", " "},
+		} {
+			_, err := parseContextArgs(args)
+			assert.Error(t, err, args)
+		}
+	})
+}
+
+func TestRecordName(t *testing.T) {
+	contact := &api.CRMObject{Properties: map[string]interface{}{"firstname": "Jane", "lastname": "Doe", "email": "jane@example.com"}}
+	assert.Equal(t, "Jane Doe <jane@example.com>", recordName(api.ObjectTypeContacts, contact))
+
+	emailOnly := &api.CRMObject{Properties: map[string]interface{}{"email": "jane@example.com"}}
+	assert.Equal(t, "jane@example.com", recordName(api.ObjectTypeContacts, emailOnly))
+
+	deal := &api.CRMObject{Properties: map[string]interface{}{"dealname": "Q1 Renewal"}}
+	assert.Equal(t, "Q1 Renewal", recordName(api.ObjectTypeDeals, deal))
+}
+
+func TestFormatContext(t *testing.T) {
+	assert.Equal(t, "contacts:456, deals:123", formatContext(map[string]string{"deals": "123", "contacts": "456"}))
+}
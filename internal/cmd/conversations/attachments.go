@@ -0,0 +1,229 @@
+package conversations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// attachmentTypeFile is the attachment type of files; other attachments,
+// such as locations and contact cards, have nothing to download
+const attachmentTypeFile = "FILE"
+
+// savedAttachment is one attachment of a message and what became of it
+type savedAttachment struct {
+	Name   string `json:"name"`
+	FileID string `json:"fileId,omitempty"`
+	Path   string `json:"path"`
+	Bytes  int64  `json:"bytes"`
+	Result string `json:"result"`
+}
+
+func newAttachmentsCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attachments",
+		Short: "Manage message attachments",
+		Long:  "Commands for downloading the files attached to conversation messages.",
+	}
+
+	cmd.AddCommand(newAttachmentsDownloadCmd(opts))
+
+	return cmd
+}
+
+func newAttachmentsDownloadCmd(opts *root.Options) *cobra.Command {
+	var dir string
+	var overwrite bool
+
+	cmd := &cobra.Command{
+		Use:   "download <threadId> <messageId>",
+		Short: "Download a message's attachments",
+		Long: `Download the files attached to a conversation message to a local directory,
+under their attached names. Files that already exist are skipped unless
+--overwrite is set.`,
+		Example: `  # Download a message's attachments to the current directory
+  hspt conversations attachments download 12345 67890
+
+  # To a directory of their own
+  hspt conversations attachments download 12345 67890 --dir ./ticket-4411`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			threadID, messageID := args[0], args[1]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			msg, err := client.GetMessage(cmd.Context(), threadID, messageID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Message %s not found in thread %s", messageID, threadID)
+					return nil
+				}
+				return err
+			}
+
+			files := fileAttachments(msg.Attachments)
+			if len(files) == 0 {
+				v.Info("Message %s has no file attachments", messageID)
+				return nil
+			}
+
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+
+			httpClient, err := opts.HTTPClient()
+			if err != nil {
+				return err
+			}
+
+			used := make(map[string]bool, len(files))
+			saved := make([]savedAttachment, 0, len(files))
+			downloaded, failed := 0, 0
+			for _, a := range files {
+				s := savedAttachment{Name: a.Name, FileID: a.FileID}
+				s.Path = filepath.Join(dir, attachmentFileName(a, used))
+
+				if _, err := os.Stat(s.Path); err == nil && !overwrite {
+					s.Result = "skipped: file exists"
+					saved = append(saved, s)
+					continue
+				}
+
+				rawURL, err := attachmentURL(cmd.Context(), client, a)
+				if err == nil {
+					s.Bytes, err = saveURL(cmd.Context(), httpClient, rawURL, s.Path)
+				}
+				if err != nil {
+					s.Result = "failed: " + err.Error()
+					failed++
+				} else {
+					s.Result = "downloaded"
+					downloaded++
+				}
+				saved = append(saved, s)
+			}
+
+			headers := []string{"NAME", "FILE", "BYTES", "RESULT"}
+			rows := make([][]string, 0, len(saved))
+			for _, s := range saved {
+				rows = append(rows, []string{s.Name, s.Path, strconv.FormatInt(s.Bytes, 10), s.Result})
+			}
+			if err := v.Render(headers, rows, saved); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d attachment(s) failed to download", failed, len(files))
+			}
+			v.Success("Downloaded %d attachment(s) to %s", downloaded, dir)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", ".", "Directory to download to")
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false, "Replace files that already exist")
+
+	return cmd
+}
+
+// fileAttachments returns the attachments that are files
+func fileAttachments(attachments []api.MessageAttachment) []api.MessageAttachment {
+	var files []api.MessageAttachment
+	for _, a := range attachments {
+		if a.Type == attachmentTypeFile && (a.FileID != "" || a.URL != "") {
+			files = append(files, a)
+		}
+	}
+	return files
+}
+
+// attachmentFileName picks a local name for an attachment: its attached
+// name, never a path outside the directory, made unique among the names
+// already used
+func attachmentFileName(a api.MessageAttachment, used map[string]bool) string {
+	name := path.Base(strings.ReplaceAll(a.Name, `\`, "/"))
+	if name == "." || name == "/" || name == ".." || name == "" {
+		name = "attachment"
+		if a.FileID != "" {
+			name += "-" + a.FileID
+		}
+	}
+
+	unique := name
+	ext := path.Ext(name)
+	for n := 2; used[unique]; n++ {
+		unique = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), n, ext)
+	}
+	used[unique] = true
+	return unique
+}
+
+// attachmentURL returns where to download an attachment from: a signed URL
+// for files in the file manager, which works for private files too, or the
+// URL it was attached with
+func attachmentURL(ctx context.Context, client *api.Client, a api.MessageAttachment) (string, error) {
+	if a.FileID == "" {
+		return a.URL, nil
+	}
+	signed, err := client.GetFileSignedURL(ctx, a.FileID)
+	if err != nil {
+		if a.URL != "" {
+			return a.URL, nil
+		}
+		return "", err
+	}
+	return signed, nil
+}
+
+// saveURL downloads rawURL to file, removing what was written if the
+// download fails part way
+func saveURL(ctx context.Context, client *http.Client, rawURL, file string) (int64, error) {
+	if rawURL == "" {
+		return 0, errors.New("attachment has no URL")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("download returned %s", resp.Status)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, resp.Body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(file)
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,98 @@
+package conversations
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestFileAttachments(t *testing.T) {
+	files := fileAttachments([]api.MessageAttachment{
+		{Type: "FILE", FileID: "1", Name: "a.pdf"},
+		{Type: "LOCATION"},
+		{Type: "FILE"},
+		{Type: "FILE", URL: "https://cdn.example.com/b.png", Name: "b.png"},
+	})
+	require.Len(t, files, 2)
+	assert.Equal(t, "a.pdf", files[0].Name)
+	assert.Equal(t, "b.png", files[1].Name)
+}
+
+func TestAttachmentFileName(t *testing.T) {
+	used := make(map[string]bool)
+
+	assert.Equal(t, "invoice.pdf", attachmentFileName(api.MessageAttachment{Name: "invoice.pdf"}, used))
+	assert.Equal(t, "invoice-2.pdf", attachmentFileName(api.MessageAttachment{Name: "invoice.pdf"}, used))
+	assert.Equal(t, "invoice-3.pdf", attachmentFileName(api.MessageAttachment{Name: "invoice.pdf"}, used))
+	assert.Equal(t, "passwd", attachmentFileName(api.MessageAttachment{Name: "../../etc/passwd"}, used))
+	assert.Equal(t, "report.xlsx", attachmentFileName(api.MessageAttachment{Name: `C:\Users\me\report.xlsx`}, used))
+	assert.Equal(t, "attachment-77", attachmentFileName(api.MessageAttachment{FileID: "77"}, used))
+	assert.Equal(t, "attachment", attachmentFileName(api.MessageAttachment{Name: ".."}, used))
+}
+
+func TestAttachmentURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/files/v3/files/404/signed-url" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not found"}`))
+			return
+		}
+		assert.Equal(t, "/files/v3/files/555/signed-url", r.URL.Path)
+		w.Write([]byte(`{"url": "https://signed.example.com/invoice.pdf?sig=abc"}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	ctx := context.Background()
+
+	got, err := attachmentURL(ctx, client, api.MessageAttachment{FileID: "555", URL: "https://cdn.example.com/invoice.pdf"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://signed.example.com/invoice.pdf?sig=abc", got)
+
+	got, err = attachmentURL(ctx, client, api.MessageAttachment{FileID: "404", URL: "https://cdn.example.com/invoice.pdf"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/invoice.pdf", got, "falls back to the attached URL")
+
+	_, err = attachmentURL(ctx, client, api.MessageAttachment{FileID: "404"})
+	assert.Error(t, err)
+
+	got, err = attachmentURL(ctx, client, api.MessageAttachment{URL: "https://cdn.example.com/b.png"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/b.png", got)
+}
+
+func TestSaveURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("%PDF-1.7"))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "invoice.pdf")
+
+	n, err := saveURL(context.Background(), server.Client(), server.URL+"/invoice.pdf", file)
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.7", string(data))
+
+	_, err = saveURL(context.Background(), server.Client(), server.URL+"/missing", filepath.Join(dir, "missing.pdf"))
+	assert.ErrorContains(t, err, "403")
+	assert.NoFileExists(t, filepath.Join(dir, "missing.pdf"))
+
+	_, err = saveURL(context.Background(), server.Client(), "", filepath.Join(dir, "none"))
+	assert.EqualError(t, err, "attachment has no URL")
+}
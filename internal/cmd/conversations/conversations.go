@@ -1,12 +1,18 @@
 package conversations
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/exitcode"
 )
 
 // Register registers the conversations command and subcommands
@@ -21,6 +27,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newThreadsCmd(opts))
 	cmd.AddCommand(newChannelsCmd(opts))
 	cmd.AddCommand(newMessagesCmd(opts))
+	cmd.AddCommand(newAttachmentsCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -41,6 +48,7 @@ func newInboxesCmd(opts *root.Options) *cobra.Command {
 func newInboxesListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -56,10 +64,10 @@ func newInboxesListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListInboxes(api.ListOptions{
+			result, err := client.ListInboxes(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -95,6 +103,8 @@ func newInboxesListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of inboxes to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -115,7 +125,7 @@ func newInboxesGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			inbox, err := client.GetInbox(id)
+			inbox, err := client.GetInbox(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Inbox %s not found", id)
@@ -129,8 +139,8 @@ func newInboxesGetCmd(opts *root.Options) *cobra.Command {
 				{"ID", inbox.ID},
 				{"Name", inbox.Name},
 				{"Archived", formatBool(inbox.Archived)},
-				{"Created", inbox.CreatedAt},
-				{"Updated", inbox.UpdatedAt},
+				{"Created", v.Time(inbox.CreatedAt)},
+				{"Updated", v.Time(inbox.UpdatedAt)},
 			}
 
 			return v.Render(headers, rows, inbox)
@@ -154,6 +164,7 @@ func newThreadsCmd(opts *root.Options) *cobra.Command {
 func newThreadsListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -169,10 +180,10 @@ func newThreadsListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListThreads(api.ListOptions{
+			result, err := client.ListThreads(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -209,6 +220,8 @@ func newThreadsListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of threads to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -229,7 +242,7 @@ func newThreadsGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			thread, err := client.GetThread(id)
+			thread, err := client.GetThread(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Thread %s not found", id)
@@ -245,8 +258,8 @@ func newThreadsGetCmd(opts *root.Options) *cobra.Command {
 				{"Inbox ID", thread.InboxID},
 				{"Contact ID", thread.AssociatedContactID},
 				{"Archived", formatBool(thread.Archived)},
-				{"Created", thread.CreatedAt},
-				{"Updated", thread.UpdatedAt},
+				{"Created", v.Time(thread.CreatedAt)},
+				{"Updated", v.Time(thread.UpdatedAt)},
 			}
 
 			if thread.ClosedAt != "" {
@@ -281,6 +294,7 @@ func newChannelsCmd(opts *root.Options) *cobra.Command {
 func newChannelsListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -296,10 +310,10 @@ func newChannelsListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListChannels(api.ListOptions{
+			result, err := client.ListChannels(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -335,6 +349,8 @@ func newChannelsListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of channels to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -355,7 +371,7 @@ func newChannelsGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			channel, err := client.GetChannel(id)
+			channel, err := client.GetChannel(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Channel %s not found", id)
@@ -370,8 +386,8 @@ func newChannelsGetCmd(opts *root.Options) *cobra.Command {
 				{"Name", channel.Name},
 				{"Type", channel.Type},
 				{"Account ID", channel.AccountID},
-				{"Created", channel.CreatedAt},
-				{"Updated", channel.UpdatedAt},
+				{"Created", v.Time(channel.CreatedAt)},
+				{"Updated", v.Time(channel.UpdatedAt)},
 			}
 
 			return v.Render(headers, rows, channel)
@@ -383,11 +399,13 @@ func newMessagesCmd(opts *root.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "messages",
 		Short: "Manage messages",
-		Long:  "Commands for listing and sending conversation messages.",
+		Long:  "Commands for listing, viewing, sending, and tracking conversation messages.",
 	}
 
 	cmd.AddCommand(newMessagesListCmd(opts))
+	cmd.AddCommand(newMessagesGetCmd(opts))
 	cmd.AddCommand(newMessagesSendCmd(opts))
+	cmd.AddCommand(newMessagesStatusCmd(opts))
 
 	return cmd
 }
@@ -395,6 +413,7 @@ func newMessagesCmd(opts *root.Options) *cobra.Command {
 func newMessagesListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list <threadId>",
@@ -412,10 +431,10 @@ func newMessagesListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListMessages(threadID, api.ListOptions{
+			result, err := client.ListMessages(cmd.Context(), threadID, pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Thread %s not found", threadID)
@@ -460,9 +479,127 @@ func newMessagesListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of messages to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
+func newMessagesGetCmd(opts *root.Options) *cobra.Command {
+	var includeOriginal bool
+
+	cmd := &cobra.Command{
+		Use:   "get <threadId> <messageId>",
+		Short: "Get a message of a thread",
+		Long: `Retrieve a single message of a conversation thread, with its full text and
+attachments.
+
+HubSpot trims quoted replies and signatures from incoming email and cuts
+long messages short; --include-original also shows the message as it was
+received.`,
+		Example: `  # Get a message
+  hspt conversations messages get 12345 67890
+
+  # With the original email, quoted replies and all
+  hspt conversations messages get 12345 67890 --include-original`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			threadID, messageID := args[0], args[1]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			msg, err := client.GetMessage(cmd.Context(), threadID, messageID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Message %s not found in thread %s", messageID, threadID)
+					return nil
+				}
+				return err
+			}
+
+			var original *api.MessageOriginalContent
+			if includeOriginal {
+				original, err = client.GetMessageOriginalContent(cmd.Context(), threadID, messageID)
+				if err != nil {
+					return fmt.Errorf("failed to get original content: %w", err)
+				}
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", msg.ID},
+				{"Type", msg.Type},
+				{"Direction", msg.Direction},
+				{"Status", statusText(msg.Status)},
+				{"Channel", msg.ChannelID},
+				{"From", senderText(msg.Senders)},
+				{"Created", v.Time(msg.CreatedAt)},
+				{"Text", messageText(msg.Text, msg.RichText)},
+				{"Truncated", formatBool(msg.Truncated())},
+				{"Attachments", attachmentNames(msg.Attachments)},
+			}
+			if original != nil {
+				rows = append(rows, []string{"Original", messageText(original.Text, original.RichText)})
+			}
+
+			data := struct {
+				*api.Message
+				OriginalContent *api.MessageOriginalContent `json:"originalContent,omitempty"`
+			}{msg, original}
+			return v.Render(headers, rows, data)
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeOriginal, "include-original", false, "Also show the message as originally received")
+
+	return cmd
+}
+
+// messageText is a message's plain text, or its rich text when it has none
+func messageText(text, richText string) string {
+	if text == "" {
+		return richText
+	}
+	return text
+}
+
+// senderText names who sent a message: by name, falling back to the address
+// it came from or the actor ID
+func senderText(senders []api.MessageSender) string {
+	names := make([]string, 0, len(senders))
+	for _, s := range senders {
+		switch {
+		case s.Name != "" && s.DeliveryIdentifier != nil:
+			names = append(names, fmt.Sprintf("%s <%s>", s.Name, s.DeliveryIdentifier.Value))
+		case s.Name != "":
+			names = append(names, s.Name)
+		case s.DeliveryIdentifier != nil:
+			names = append(names, s.DeliveryIdentifier.Value)
+		default:
+			names = append(names, s.ActorID)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func attachmentNames(attachments []api.MessageAttachment) string {
+	if len(attachments) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(attachments))
+	for _, a := range attachments {
+		name := a.Name
+		if name == "" {
+			name = a.Type
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
 func newMessagesSendCmd(opts *root.Options) *cobra.Command {
 	var text, channelID, senderID string
 
@@ -497,7 +634,7 @@ func newMessagesSendCmd(opts *root.Options) *cobra.Command {
 				req.SenderID = senderID
 			}
 
-			msg, err := client.SendMessage(threadID, req)
+			msg, err := client.SendMessage(cmd.Context(), threadID, req)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Thread %s not found", threadID)
@@ -518,6 +655,117 @@ func newMessagesSendCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
+// statusPollInterval is how often messages status --wait checks a message
+var statusPollInterval = 3 * time.Second
+
+func newMessagesStatusCmd(opts *root.Options) *cobra.Command {
+	var wait bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "status <threadId> <messageId>",
+		Short: "Show a message's delivery status",
+		Long: `Show whether a sent message was delivered. With --wait, keep checking until
+HubSpot reports it SENT (or RECEIVED or READ) or FAILED.
+
+Exit codes with --wait:
+  0   the message was delivered
+  10  the message failed to send
+  11  --wait-timeout passed before the message was delivered`,
+		Example: `  # Check a message
+  hspt conversations messages status 12345 67890
+
+  # Wait up to two minutes for a reply to be delivered
+  hspt conversations messages status 12345 67890 --wait --wait-timeout 2m`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			threadID, messageID := args[0], args[1]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			msg, err := client.GetMessage(cmd.Context(), threadID, messageID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Message %s not found in thread %s", messageID, threadID)
+					return nil
+				}
+				return err
+			}
+
+			if wait {
+				// Status changes must not be served from the response cache
+				client.Cache = nil
+
+				ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+				defer cancel()
+
+				for !deliveryDone(msg.Status) {
+					if err := api.SleepContext(ctx, statusPollInterval); err != nil {
+						if errors.Is(err, context.DeadlineExceeded) {
+							return exitcode.WithCode(fmt.Errorf("message %s still %s after %s", messageID, statusText(msg.Status), timeout), exitcode.Timeout)
+						}
+						return err
+					}
+					if msg, err = client.GetMessage(ctx, threadID, messageID); err != nil {
+						return fmt.Errorf("failed to check message status: %w", err)
+					}
+				}
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", msg.ID},
+				{"Status", statusText(msg.Status)},
+				{"Failure", failureText(msg.Status)},
+				{"Channel", msg.ChannelID},
+				{"Created", msg.CreatedAt},
+			}
+			if err := v.Render(headers, rows, msg); err != nil {
+				return err
+			}
+
+			if wait && msg.Status != nil && msg.Status.Failed() {
+				reason := failureText(msg.Status)
+				if reason == "" {
+					reason = "no reason given"
+				}
+				return exitcode.WithCode(fmt.Errorf("message %s failed to send: %s", messageID, reason), exitcode.DeliveryFailed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait until the message is sent or fails")
+	cmd.Flags().DurationVar(&timeout, "wait-timeout", 5*time.Minute, "How long --wait waits")
+
+	return cmd
+}
+
+// deliveryDone reports whether a message has reached a final status
+func deliveryDone(status *api.MessageStatus) bool {
+	return status != nil && (status.Delivered() || status.Failed())
+}
+
+// statusText names a message's status; messages HubSpot has not reported on
+// yet are pending
+func statusText(status *api.MessageStatus) string {
+	if status == nil || status.Type == "" {
+		return "PENDING"
+	}
+	return status.Type
+}
+
+func failureText(status *api.MessageStatus) string {
+	if status == nil || status.FailureDetails == nil {
+		return ""
+	}
+	return status.FailureDetails.ErrorMessage
+}
+
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -0,0 +1,48 @@
+package conversations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestDeliveryDone(t *testing.T) {
+	assert.False(t, deliveryDone(nil))
+	assert.False(t, deliveryDone(&api.MessageStatus{}))
+	assert.True(t, deliveryDone(&api.MessageStatus{Type: api.MessageStatusSent}))
+	assert.True(t, deliveryDone(&api.MessageStatus{Type: api.MessageStatusRead}))
+	assert.True(t, deliveryDone(&api.MessageStatus{Type: api.MessageStatusFailed}))
+}
+
+func TestStatusText(t *testing.T) {
+	assert.Equal(t, "PENDING", statusText(nil))
+	assert.Equal(t, "SENT", statusText(&api.MessageStatus{Type: api.MessageStatusSent}))
+
+	failed := &api.MessageStatus{
+		Type:           api.MessageStatusFailed,
+		FailureDetails: &api.MessageFailureDetails{ErrorMessage: "Mailbox full"},
+	}
+	assert.Equal(t, "Mailbox full", failureText(failed))
+	assert.Equal(t, "", failureText(&api.MessageStatus{Type: api.MessageStatusSent}))
+}
+
+func TestSenderText(t *testing.T) {
+	senders := []api.MessageSender{
+		{Name: "Ada Lovelace", DeliveryIdentifier: &api.DeliveryIdentifier{Type: "HS_EMAIL_ADDRESS", Value: "ada@example.com"}},
+		{DeliveryIdentifier: &api.DeliveryIdentifier{Value: "+15551234567"}},
+		{Name: "Support Bot"},
+		{ActorID: "A-123"},
+	}
+	assert.Equal(t, "Ada Lovelace <ada@example.com>, +15551234567, Support Bot, A-123", senderText(senders))
+	assert.Equal(t, "", senderText(nil))
+}
+
+func TestMessageTextAndAttachmentNames(t *testing.T) {
+	assert.Equal(t, "plain", messageText("plain", "<p>rich</p>"))
+	assert.Equal(t, "<p>rich</p>", messageText("", "<p>rich</p>"))
+
+	assert.Equal(t, "-", attachmentNames(nil))
+	assert.Equal(t, "a.pdf, LOCATION", attachmentNames([]api.MessageAttachment{{Type: "FILE", Name: "a.pdf"}, {Type: "LOCATION"}}))
+}
@@ -0,0 +1,33 @@
+package crm
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the crm command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "crm",
+		Short: "Work with any HubSpot CRM object type",
+		Long:  "Generic commands that operate on any CRM object type, including custom objects.",
+	}
+
+	cmd.AddCommand(shared.NewImportCmd(opts, shared.ImportCmdConfig{
+		Short: "Import CRM records from a CSV file",
+		Long: `Import records of any CRM object type from a CSV file.
+
+Headers are validated against the object's properties before any data is
+sent. Rows are sent in batches; rows with a value in --id-column update the
+existing record and all other rows create new records.`,
+		Example: `  # Import deals using CSV headers as property names
+  hspt crm import --type deals --file deals.csv
+
+  # Map CSV columns to properties and update rows that carry an ID
+  hspt crm import --type companies --file companies.csv --map name=Company,domain=Website --id-column "Record ID"`,
+	}))
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,314 @@
+package crmcards
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the crm-cards command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	a := &shared.DeveloperApp{}
+
+	cmd := &cobra.Command{
+		Use:   "crm-cards",
+		Short: "Manage CRM cards of a public app",
+		Long: `Commands for managing the CRM cards of a HubSpot public app: the panels on
+record pages that HubSpot fills from the app's data fetch URL.
+
+Cards are defined as JSON, in the shape of the CRM cards API, so a layout can
+be kept in a file, edited, and pushed again with update. CRM card APIs use the
+developer account's API key; pass the app ID and key with --app-id and
+--developer-key, or set HUBSPOT_APP_ID and HUBSPOT_DEVELOPER_API_KEY.`,
+	}
+
+	shared.AddDeveloperAppFlags(cmd, a)
+
+	cmd.AddCommand(newListCmd(opts, a))
+	cmd.AddCommand(newGetCmd(opts, a))
+	cmd.AddCommand(newCreateCmd(opts, a))
+	cmd.AddCommand(newUpdateCmd(opts, a))
+	cmd.AddCommand(newDeleteCmd(opts, a))
+
+	parent.AddCommand(cmd)
+}
+
+// readCard reads a card definition from a JSON file, or stdin for "-"
+func readCard(file string, stdin io.Reader) (map[string]interface{}, error) {
+	if file == "" {
+		return nil, fmt.Errorf("--file is required")
+	}
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var card map[string]interface{}
+	if err := json.Unmarshal(data, &card); err != nil {
+		return nil, fmt.Errorf("invalid JSON in %s: %w", file, err)
+	}
+	if len(card) == 0 {
+		return nil, fmt.Errorf("%s has no card definition", file)
+	}
+	return card, nil
+}
+
+// missingFields lists what a new card definition lacks: HubSpot needs a
+// title, and a data fetch URL and record types to fetch for
+func missingFields(card map[string]interface{}) []string {
+	var missing []string
+	if title, _ := card["title"].(string); title == "" {
+		missing = append(missing, "title")
+	}
+	fetch, _ := card["fetch"].(map[string]interface{})
+	if url, _ := fetch["targetUrl"].(string); url == "" {
+		missing = append(missing, "fetch.targetUrl")
+	}
+	if types, _ := fetch["objectTypes"].([]interface{}); len(types) == 0 {
+		missing = append(missing, "fetch.objectTypes")
+	}
+	return missing
+}
+
+// objectTypeNames lists the record types a card appears on
+func objectTypeNames(card api.CRMCard) string {
+	names := make([]string, 0, len(card.Fetch.ObjectTypes))
+	for _, t := range card.Fetch.ObjectTypes {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// cardRows are the rows of a single card
+func cardRows(card *api.CRMCard) [][]string {
+	return [][]string{
+		{"ID", card.ID},
+		{"Title", card.Title},
+		{"Target URL", card.Fetch.TargetURL},
+		{"Object Types", objectTypeNames(*card)},
+		{"Display Properties", strconv.Itoa(len(card.Display.Properties))},
+	}
+}
+
+func newListCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List CRM cards",
+		Long:  "List the app's CRM cards.",
+		Example: `  # List cards
+  HUBSPOT_DEVELOPER_API_KEY=... hspt crm-cards list --app-id 123456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListCRMCards(cmd.Context(), a.ID)
+			if err != nil {
+				return fmt.Errorf("failed to list CRM cards: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No CRM cards found")
+				return nil
+			}
+
+			headers := []string{"ID", "TITLE", "OBJECT TYPES", "TARGET URL", "UPDATED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, card := range result.Results {
+				rows = append(rows, []string{card.ID, card.Title, objectTypeNames(card), card.Fetch.TargetURL, v.Time(card.UpdatedAt)})
+			}
+
+			return v.Render(headers, rows, result)
+		},
+	}
+}
+
+func newGetCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <card-id>",
+		Short: "Get a CRM card",
+		Long:  "Get a CRM card's definition. With -o json it can be saved, edited, and pushed back with update.",
+		Example: `  # Show a card
+  hspt crm-cards get 7 --app-id 123456
+
+  # Save its definition to edit
+  hspt crm-cards get 7 --app-id 123456 -o json > orders-card.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			card, err := client.GetCRMCard(cmd.Context(), a.ID, id)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("CRM card %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			return v.Render([]string{"PROPERTY", "VALUE"}, cardRows(card), card)
+		},
+	}
+}
+
+func newCreateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a CRM card",
+		Long: `Create a CRM card from a JSON definition with at least a title, and a fetch
+object with the targetUrl HubSpot requests card data from and the
+objectTypes the card appears on.`,
+		Example: `  # Create a card from a definition
+  hspt crm-cards create --app-id 123456 --file orders-card.json
+
+  # From stdin
+  generate-card | hspt crm-cards create --app-id 123456 --file -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			definition, err := readCard(file, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			if missing := missingFields(definition); len(missing) > 0 {
+				return fmt.Errorf("card definition is missing %s", strings.Join(missing, ", "))
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			card, err := client.CreateCRMCard(cmd.Context(), a.ID, definition)
+			if err != nil {
+				return fmt.Errorf("failed to create CRM card: %w", err)
+			}
+
+			v.Success("CRM card created with ID: %s", card.ID)
+			return v.Render([]string{"PROPERTY", "VALUE"}, cardRows(card), card)
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "JSON file with the card definition, or - for stdin (required)")
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "update <card-id>",
+		Short: "Update a CRM card",
+		Long: `Update a CRM card from a JSON definition. Only the top-level parts in the
+file (title, fetch, display, actions) are changed, so a file can hold a
+whole card or just the part being worked on.`,
+		Example: `  # Push an edited layout
+  hspt crm-cards update 7 --app-id 123456 --file orders-card.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			definition, err := readCard(file, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			// A definition saved with get -o json carries fields the API
+			// sets itself
+			for _, field := range []string{"id", "createdAt", "updatedAt", "auditHistory"} {
+				delete(definition, field)
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			card, err := client.UpdateCRMCard(cmd.Context(), a.ID, id, definition)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("CRM card %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to update CRM card: %w", err)
+			}
+
+			v.Success("CRM card %s updated", id)
+			return v.Render([]string{"PROPERTY", "VALUE"}, cardRows(card), card)
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "JSON file with the card definition, or - for stdin (required)")
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <card-id>",
+		Short: "Delete a CRM card",
+		Long:  "Delete a CRM card by ID. It disappears from record pages in every portal that installed the app.",
+		Example: `  # Delete a card
+  hspt crm-cards delete 7 --app-id 123456 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !force {
+				v.Warning("This will delete CRM card %s. Use --force to confirm.", id)
+				return nil
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteCRMCard(cmd.Context(), a.ID, id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("CRM card %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("CRM card %s deleted", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package crmcards
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestReadCard(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "card.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"title": "Orders"}`), 0o600))
+
+	card, err := readCard(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Orders", card["title"])
+
+	card, err = readCard("-", strings.NewReader(`{"title": "From stdin"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "From stdin", card["title"])
+
+	_, err = readCard("", nil)
+	assert.ErrorContains(t, err, "--file is required")
+
+	_, err = readCard("-", strings.NewReader(`[1, 2]`))
+	assert.ErrorContains(t, err, "invalid JSON")
+
+	_, err = readCard("-", strings.NewReader(`{}`))
+	assert.ErrorContains(t, err, "no card definition")
+}
+
+func TestMissingFields(t *testing.T) {
+	complete := map[string]interface{}{
+		"title": "Orders",
+		"fetch": map[string]interface{}{
+			"targetUrl":   "https://example.com/cards/orders",
+			"objectTypes": []interface{}{map[string]interface{}{"name": "contacts"}},
+		},
+	}
+	assert.Empty(t, missingFields(complete))
+
+	assert.Equal(t, []string{"title", "fetch.targetUrl", "fetch.objectTypes"}, missingFields(map[string]interface{}{"display": map[string]interface{}{}}))
+	assert.Equal(t, []string{"fetch.objectTypes"}, missingFields(map[string]interface{}{
+		"title": "Orders",
+		"fetch": map[string]interface{}{"targetUrl": "https://example.com"},
+	}))
+}
+
+func TestObjectTypeNames(t *testing.T) {
+	card := api.CRMCard{Fetch: api.CRMCardFetch{ObjectTypes: []api.CRMCardObjectType{{Name: "contacts"}, {Name: "deals"}}}}
+	assert.Equal(t, "contacts, deals", objectTypeNames(card))
+}
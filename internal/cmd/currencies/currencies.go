@@ -0,0 +1,113 @@
+package currencies
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the currencies command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "currencies",
+		Short: "Manage currencies and exchange rates",
+		Long: `Commands for viewing the portal's currencies and keeping their exchange
+rates up to date. Requires a portal with multiple currencies and the
+multi-currency-read scope, and multi-currency-write to set rates.`,
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newRatesCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// portalCurrency is one of the portal's currencies with its current rate
+// from the company currency
+type portalCurrency struct {
+	Code           string  `json:"code"`
+	Company        bool    `json:"company"`
+	ConversionRate float64 `json:"conversionRate"`
+	EffectiveAt    string  `json:"effectiveAt,omitempty"`
+	VisibleInUI    bool    `json:"visibleInUI"`
+}
+
+// portalCurrencies lists the company currency first, then the others by
+// code
+func portalCurrencies(company string, rates []api.ExchangeRate) []portalCurrency {
+	result := []portalCurrency{{Code: company, Company: true, ConversionRate: 1, VisibleInUI: true}}
+
+	others := make([]portalCurrency, 0, len(rates))
+	for _, r := range rates {
+		if r.ToCurrencyCode == company {
+			continue
+		}
+		others = append(others, portalCurrency{
+			Code:           r.ToCurrencyCode,
+			ConversionRate: r.ConversionRate,
+			EffectiveAt:    r.EffectiveAt,
+			VisibleInUI:    r.VisibleInUI,
+		})
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].Code < others[j].Code })
+
+	return append(result, others...)
+}
+
+// formatRate formats a conversion rate without trailing zeros
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', -1, 64)
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the portal's currencies",
+		Long:  "List the portal's company currency and its other currencies, with each one's current exchange rate from the company currency.",
+		Example: `  # List currencies
+  hspt currencies list
+
+  # As JSON
+  hspt currencies list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			company, err := client.GetCompanyCurrency(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to get company currency: %w", err)
+			}
+
+			rates, err := client.ListCurrentExchangeRates(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list exchange rates: %w", err)
+			}
+
+			currencies := portalCurrencies(company, rates)
+
+			headers := []string{"CODE", "COMPANY CURRENCY", "RATE", "EFFECTIVE", "VISIBLE"}
+			rows := make([][]string, 0, len(currencies))
+			for _, c := range currencies {
+				rows = append(rows, []string{
+					c.Code,
+					shared.FormatBool(c.Company),
+					formatRate(c.ConversionRate),
+					v.Time(c.EffectiveAt),
+					shared.FormatBool(c.VisibleInUI),
+				})
+			}
+
+			return v.Render(headers, rows, currencies)
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package currencies
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestPortalCurrencies(t *testing.T) {
+	rates := []api.ExchangeRate{
+		{ToCurrencyCode: "GBP", ConversionRate: 0.79},
+		{ToCurrencyCode: "EUR", ConversionRate: 0.92, VisibleInUI: true},
+	}
+
+	currencies := portalCurrencies("USD", rates)
+	require.Len(t, currencies, 3)
+	assert.Equal(t, portalCurrency{Code: "USD", Company: true, ConversionRate: 1, VisibleInUI: true}, currencies[0])
+	assert.Equal(t, "EUR", currencies[1].Code)
+	assert.Equal(t, "GBP", currencies[2].Code)
+}
+
+func TestParseRate(t *testing.T) {
+	c, err := parseRate("eur=0.92")
+	require.NoError(t, err)
+	assert.Equal(t, rateChange{Code: "EUR", Rate: 0.92}, c)
+
+	c, err = parseRate(" GBP , 0.79 ")
+	require.NoError(t, err)
+	assert.Equal(t, rateChange{Code: "GBP", Rate: 0.79}, c)
+
+	for _, bad := range []string{"EUR", "EUR=abc", "=0.9", "EUR=0", "EUR=-1"} {
+		_, err := parseRate(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestReadRates(t *testing.T) {
+	changes, err := readRates(strings.NewReader("currency,rate\n# from the ECB\nEUR,0.92\n\nGBP=0.79\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []rateChange{{Code: "EUR", Rate: 0.92}, {Code: "GBP", Rate: 0.79}}, changes)
+
+	_, err = readRates(strings.NewReader("EUR,0.92\nGBP\n"))
+	assert.EqualError(t, err, `line 2: invalid rate "GBP": use CODE=RATE, like EUR=0.92`)
+}
+
+func TestParseEffectiveAt(t *testing.T) {
+	at, err := parseEffectiveAt("2024-07-01")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-07-01T00:00:00Z", at)
+
+	at, err = parseEffectiveAt("2024-07-01T09:00:00+02:00")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-07-01T07:00:00Z", at)
+
+	_, err = parseEffectiveAt("tomorrow")
+	assert.Error(t, err)
+}
+
+func TestPlanRates(t *testing.T) {
+	current := []api.ExchangeRate{
+		{ToCurrencyCode: "EUR", ConversionRate: 0.92},
+		{ToCurrencyCode: "GBP", ConversionRate: 0.79},
+	}
+
+	planned, err := planRates([]rateChange{{Code: "EUR", Rate: 0.92}, {Code: "GBP", Rate: 0.8}, {Code: "JPY", Rate: 150}}, "USD", current, true)
+	require.NoError(t, err)
+	assert.Equal(t, []rateChange{
+		{Code: "EUR", Current: 0.92, Rate: 0.92, Result: rateUnchanged},
+		{Code: "GBP", Current: 0.79, Rate: 0.8, Result: rateUpdated},
+		{Code: "JPY", Rate: 150, Result: rateAdded},
+	}, planned)
+
+	_, err = planRates([]rateChange{{Code: "JPY", Rate: 150}}, "USD", current, false)
+	assert.EqualError(t, err, "the portal has no currency JPY: use --add to add it")
+
+	_, err = planRates([]rateChange{{Code: "USD", Rate: 1}}, "USD", current, false)
+	assert.Error(t, err)
+
+	_, err = planRates([]rateChange{{Code: "EUR", Rate: 0.9}, {Code: "EUR", Rate: 0.91}}, "USD", current, false)
+	assert.EqualError(t, err, "EUR is given more than once")
+}
@@ -0,0 +1,376 @@
+package currencies
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+func newRatesCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rates",
+		Short: "Manage exchange rates",
+		Long:  "Commands for listing exchange rates and setting new ones, by hand or from an external source.",
+	}
+
+	cmd.AddCommand(newRatesListCmd(opts))
+	cmd.AddCommand(newRatesUpdateCmd(opts))
+
+	return cmd
+}
+
+// rateChange is a new rate for one currency
+type rateChange struct {
+	Code    string  `json:"code"`
+	Current float64 `json:"current,omitempty"`
+	Rate    float64 `json:"rate"`
+	Result  string  `json:"result"`
+}
+
+// Results of a rate change
+const (
+	rateUpdated   = "Updated"
+	rateAdded     = "Added"
+	rateUnchanged = "Unchanged"
+)
+
+// parseRate parses a CODE=RATE or CODE,RATE pair
+func parseRate(s string) (rateChange, error) {
+	sep := strings.IndexAny(s, "=,")
+	if sep < 0 {
+		return rateChange{}, fmt.Errorf("invalid rate %q: use CODE=RATE, like EUR=0.92", s)
+	}
+	code := strings.ToUpper(strings.TrimSpace(s[:sep]))
+	rate, err := strconv.ParseFloat(strings.TrimSpace(s[sep+1:]), 64)
+	if err != nil || code == "" {
+		return rateChange{}, fmt.Errorf("invalid rate %q: use CODE=RATE, like EUR=0.92", s)
+	}
+	if rate <= 0 {
+		return rateChange{}, fmt.Errorf("invalid rate %q: the rate must be positive", s)
+	}
+	return rateChange{Code: code, Rate: rate}, nil
+}
+
+// readRates reads one CODE,RATE or CODE=RATE pair per line. Blank lines,
+// # comments, and a header line are skipped.
+func readRates(r io.Reader) ([]rateChange, error) {
+	var changes []rateChange
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		change, err := parseRate(text)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		changes = append(changes, change)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rates: %w", err)
+	}
+	return changes, nil
+}
+
+// parseEffectiveAt parses a date (2024-01-31) or an RFC 3339 time
+func parseEffectiveAt(s string) (string, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("invalid --effective-at %q: use a date (2024-01-31) or a time (2024-01-31T09:00:00Z)", s)
+}
+
+// planRates compares the new rates with the current ones, marking each as
+// updated, added, or unchanged. A currency the portal does not have is an
+// error unless add is set, so a typo in a feed does not add a currency.
+func planRates(changes []rateChange, company string, current []api.ExchangeRate, add bool) ([]rateChange, error) {
+	rates := make(map[string]float64, len(current))
+	for _, r := range current {
+		rates[r.ToCurrencyCode] = r.ConversionRate
+	}
+
+	seen := make(map[string]bool, len(changes))
+	planned := make([]rateChange, 0, len(changes))
+	for _, c := range changes {
+		if c.Code == company {
+			return nil, fmt.Errorf("%s is the company currency: its rate is always 1", c.Code)
+		}
+		if seen[c.Code] {
+			return nil, fmt.Errorf("%s is given more than once", c.Code)
+		}
+		seen[c.Code] = true
+
+		rate, ok := rates[c.Code]
+		switch {
+		case !ok && !add:
+			return nil, fmt.Errorf("the portal has no currency %s: use --add to add it", c.Code)
+		case !ok:
+			c.Result = rateAdded
+		case sameRate(rate, c.Rate):
+			c.Current, c.Result = rate, rateUnchanged
+		default:
+			c.Current, c.Result = rate, rateUpdated
+		}
+		planned = append(planned, c)
+	}
+	return planned, nil
+}
+
+// sameRate reports whether two rates are equal to within rounding
+func sameRate(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func newRatesListCmd(opts *root.Options) *cobra.Command {
+	var history bool
+	var currency string
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List exchange rates",
+		Long:  "List the exchange rate in effect for each of the portal's currencies, or with --history every rate they have had.",
+		Example: `  # Current rates
+  hspt currencies rates list
+
+  # Every rate EUR has had
+  hspt currencies rates list --history --currency EUR --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var rates []api.ExchangeRate
+			var paging *api.Paging
+			if history {
+				result, err := client.ListExchangeRates(cmd.Context(), pages.Apply(cmd, api.ListOptions{
+					Limit: limit,
+					After: after,
+				}))
+				if err != nil {
+					return fmt.Errorf("failed to list exchange rates: %w", err)
+				}
+				rates, paging = result.Results, result.Paging
+			} else {
+				if rates, err = client.ListCurrentExchangeRates(cmd.Context()); err != nil {
+					return fmt.Errorf("failed to list exchange rates: %w", err)
+				}
+			}
+
+			if currency != "" {
+				kept := rates[:0]
+				for _, r := range rates {
+					if strings.EqualFold(r.ToCurrencyCode, currency) {
+						kept = append(kept, r)
+					}
+				}
+				rates = kept
+			}
+
+			if len(rates) == 0 {
+				v.Info("No exchange rates found")
+				return nil
+			}
+
+			headers := []string{"ID", "FROM", "TO", "RATE", "EFFECTIVE", "VISIBLE"}
+			rows := make([][]string, 0, len(rates))
+			for _, r := range rates {
+				rows = append(rows, []string{
+					r.ID,
+					r.FromCurrencyCode,
+					r.ToCurrencyCode,
+					formatRate(r.ConversionRate),
+					v.Time(r.EffectiveAt),
+					shared.FormatBool(r.VisibleInUI),
+				})
+			}
+
+			if err := v.Render(headers, rows, rates); err != nil {
+				return err
+			}
+
+			if paging != nil && paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&history, "history", false, "List every rate, past and current")
+	cmd.Flags().StringVar(&currency, "currency", "", "Only rates to this currency code")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of rates to return with --history")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page with --history")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newRatesUpdateCmd(opts *root.Options) *cobra.Command {
+	var file, effectiveAt string
+	var add, dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "update [CODE=RATE...]",
+		Short: "Set new exchange rates",
+		Long: `Set new exchange rates from the company currency, given as CODE=RATE
+arguments or read from a file of CODE,RATE lines.
+
+Rates equal to the current ones are skipped, so a scheduled sync only
+records real changes. Earlier rates are kept as history. A currency the
+portal does not have yet is added only with --add.`,
+		Example: `  # Set one rate
+  hspt currencies rates update EUR=0.92
+
+  # Several, effective from a date
+  hspt currencies rates update EUR=0.92 GBP=0.79 --effective-at 2024-07-01
+
+  # Preview a sync from a file of CODE,RATE lines
+  hspt currencies rates update --file rates.csv --dry-run
+
+  # Nightly from cron, with rates from another tool on stdin
+  fetch-rates --base USD | hspt currencies rates update --file -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			var changes []rateChange
+			for _, arg := range args {
+				change, err := parseRate(arg)
+				if err != nil {
+					return err
+				}
+				changes = append(changes, change)
+			}
+
+			if file != "" {
+				in := cmd.InOrStdin()
+				if file != "-" {
+					f, err := os.Open(file)
+					if err != nil {
+						return fmt.Errorf("failed to open rates file: %w", err)
+					}
+					defer f.Close()
+					in = f
+				}
+				read, err := readRates(in)
+				if err != nil {
+					return err
+				}
+				changes = append(changes, read...)
+			}
+
+			if len(changes) == 0 {
+				return fmt.Errorf("no rates given: pass CODE=RATE arguments or --file")
+			}
+
+			var effective string
+			if effectiveAt != "" {
+				var err error
+				if effective, err = parseEffectiveAt(effectiveAt); err != nil {
+					return err
+				}
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			company, err := client.GetCompanyCurrency(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to get company currency: %w", err)
+			}
+			current, err := client.ListCurrentExchangeRates(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list exchange rates: %w", err)
+			}
+
+			planned, err := planRates(changes, company, current, add)
+			if err != nil {
+				return err
+			}
+
+			written := 0
+			var failed error
+			if !dryRun {
+				for i, c := range planned {
+					if c.Result == rateUnchanged {
+						continue
+					}
+					_, err := client.CreateExchangeRate(cmd.Context(), api.ExchangeRateInput{
+						ToCurrencyCode: c.Code,
+						ConversionRate: c.Rate,
+						EffectiveAt:    effective,
+					})
+					if err != nil {
+						failed = fmt.Errorf("failed to set %s rate: %w", c.Code, err)
+						planned = planned[:i]
+						break
+					}
+					written++
+				}
+			}
+
+			headers := []string{"CURRENCY", "CURRENT", "NEW", "RESULT"}
+			rows := make([][]string, 0, len(planned))
+			for _, c := range planned {
+				was := "-"
+				if c.Result != rateAdded {
+					was = formatRate(c.Current)
+				}
+				result := c.Result
+				if dryRun && result != rateUnchanged {
+					result = "Would be " + strings.ToLower(result)
+				}
+				rows = append(rows, []string{c.Code, was, formatRate(c.Rate), result})
+			}
+
+			if err := v.Render(headers, rows, planned); err != nil {
+				return err
+			}
+
+			if failed != nil {
+				return failed
+			}
+			if dryRun {
+				v.Info("Dry run: no rates were changed")
+				return nil
+			}
+			v.Success("Set %d of %d rate(s) from %s", written, len(planned), company)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "File of CODE,RATE lines, or - for stdin")
+	cmd.Flags().StringVar(&effectiveAt, "effective-at", "", "When the rates take effect: a date (2024-07-01) or time; defaults to now")
+	cmd.Flags().BoolVar(&add, "add", false, "Add currencies the portal does not have yet")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the changes without making them")
+
+	return cmd
+}
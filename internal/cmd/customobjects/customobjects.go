@@ -0,0 +1,580 @@
+package customobjects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the custom-objects command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	var objectType string
+
+	cmd := &cobra.Command{
+		Use:     "custom-objects",
+		Aliases: []string{"objects"},
+		Short:   "Manage records of portal-defined custom objects",
+		Long: `Commands for listing, viewing, creating, updating, deleting, and searching
+records of custom objects defined in your portal.
+
+Select the object with --type, using its fully qualified name (p123_cars),
+object type ID (2-123456), or schema name (cars). Run "hspt custom-objects
+types" to see what is available. Requires Operations Hub Professional or
+Enterprise.`,
+	}
+
+	cmd.PersistentFlags().StringVar(&objectType, "type", "", "Custom object fully qualified name, object type ID, or name")
+
+	cmd.AddCommand(newTypesCmd(opts))
+	cmd.AddCommand(newListCmd(opts, &objectType))
+	cmd.AddCommand(newGetCmd(opts, &objectType))
+	cmd.AddCommand(newCreateCmd(opts, &objectType))
+	cmd.AddCommand(newUpdateCmd(opts, &objectType))
+	cmd.AddCommand(newDeleteCmd(opts, &objectType))
+	cmd.AddCommand(newSearchCmd(opts, &objectType))
+
+	parent.AddCommand(cmd)
+}
+
+// resolveSchema finds the schema named by the --type value. Fully qualified
+// names and object type IDs are looked up directly; anything else is matched
+// against schema names and labels.
+func resolveSchema(ctx context.Context, client *api.Client, objectType string) (*api.Schema, error) {
+	if objectType == "" {
+		return nil, fmt.Errorf("--type is required (see: hspt custom-objects types)")
+	}
+
+	schema, err := client.GetSchema(ctx, objectType)
+	if err == nil {
+		return schema, nil
+	}
+	if !api.IsNotFound(err) {
+		return nil, err
+	}
+
+	list, err := client.ListSchemas(ctx, api.ListOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+	if schema := matchSchema(list.Results, objectType); schema != nil {
+		return schema, nil
+	}
+
+	return nil, fmt.Errorf("custom object type %q not found (see: hspt custom-objects types)", objectType)
+}
+
+// matchSchema returns the schema whose name or singular/plural label matches
+// name, ignoring case
+func matchSchema(schemas []api.Schema, name string) *api.Schema {
+	for i := range schemas {
+		s := &schemas[i]
+		if strings.EqualFold(s.Name, name) ||
+			strings.EqualFold(s.Labels.Singular, name) ||
+			strings.EqualFold(s.Labels.Plural, name) {
+			return s
+		}
+	}
+	return nil
+}
+
+// displayProperties returns the properties shown by list and search: the
+// primary display property, then secondary display properties, then
+// required properties, without duplicates
+func displayProperties(schema *api.Schema) []string {
+	seen := make(map[string]bool)
+	var props []string
+	add := func(names ...string) {
+		for _, n := range names {
+			if n != "" && !seen[n] {
+				seen[n] = true
+				props = append(props, n)
+			}
+		}
+	}
+
+	add(schema.PrimaryDisplayProperty)
+	add(schema.SecondaryDisplayProperties...)
+	add(schema.RequiredProperties...)
+
+	return props
+}
+
+// label returns the singular label of the object, falling back to its name
+func label(schema *api.Schema) string {
+	if schema.Labels.Singular != "" {
+		return schema.Labels.Singular
+	}
+	return schema.Name
+}
+
+// noun returns the lower-case singular label for mid-sentence messages
+func noun(schema *api.Schema) string {
+	return strings.ToLower(label(schema))
+}
+
+// recordTable builds table headers and rows for records using properties as
+// the columns after ID
+func recordTable(records []api.CRMObject, properties []string) ([]string, [][]string) {
+	headers := []string{"ID"}
+	for _, p := range properties {
+		headers = append(headers, strings.ToUpper(p))
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, obj := range records {
+		row := []string{obj.ID}
+		for _, p := range properties {
+			row = append(row, obj.GetProperty(p))
+		}
+		rows = append(rows, row)
+	}
+
+	return headers, rows
+}
+
+// parseProps converts key=value --prop flags into a properties map
+func parseProps(props []string) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	for _, p := range props {
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid --prop %q (expected key=value)", p)
+		}
+		properties[parts[0]] = parts[1]
+	}
+	return properties, nil
+}
+
+// missingRequired returns the schema's required properties absent from
+// properties
+func missingRequired(schema *api.Schema, properties map[string]interface{}) []string {
+	var missing []string
+	for _, name := range schema.RequiredProperties {
+		if _, ok := properties[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+func newTypesCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "types",
+		Short: "List available custom object types",
+		Long:  "List the custom object types defined in your portal, with the names accepted by --type.",
+		Example: `  # Discover custom object types
+  hspt custom-objects types`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListSchemas(cmd.Context(), api.ListOptions{All: true})
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No custom object types defined")
+				return nil
+			}
+
+			headers := []string{"NAME", "LABEL", "OBJECT TYPE ID", "FULLY QUALIFIED NAME", "PRIMARY PROPERTY"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, s := range result.Results {
+				rows = append(rows, []string{
+					s.Name,
+					s.Labels.Plural,
+					s.ObjectTypeID,
+					s.FullyQualifiedName,
+					s.PrimaryDisplayProperty,
+				})
+			}
+
+			return v.Render(headers, rows, result.Results)
+		},
+	}
+
+	return cmd
+}
+
+func newListCmd(opts *root.Options, objectType *string) *cobra.Command {
+	var limit int
+	var after string
+	var properties []string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List custom object records",
+		Long:  "List records of a custom object. Columns default to the schema's display and required properties.",
+		Example: `  # List cars
+  hspt custom-objects list --type cars
+
+  # List with specific properties
+  hspt custom-objects list --type p123_cars --properties make,model,year
+
+  # Fetch every record
+  hspt objects list --type cars --all -o csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			schema, err := resolveSchema(cmd.Context(), client, *objectType)
+			if err != nil {
+				return err
+			}
+
+			if len(properties) == 0 {
+				properties = displayProperties(schema)
+			}
+
+			result, err := client.ListObjects(cmd.Context(), api.ObjectType(schema.ObjectTypeID), pages.Apply(cmd, api.ListOptions{
+				Limit:      limit,
+				After:      after,
+				Properties: properties,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No %s records found", noun(schema))
+				return nil
+			}
+
+			headers, rows := recordTable(result.Results, properties)
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of records to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newGetCmd(opts *root.Options, objectType *string) *cobra.Command {
+	var properties []string
+	var history shared.PropertyHistory
+
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a custom object record by ID",
+		Long:  "Retrieve a single custom object record. All properties defined on the schema are shown unless --properties is given.",
+		Example: `  # Get a car
+  hspt custom-objects get 12345 --type cars`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			schema, err := resolveSchema(cmd.Context(), client, *objectType)
+			if err != nil {
+				return err
+			}
+
+			if len(properties) == 0 {
+				for _, p := range schema.Properties {
+					properties = append(properties, p.Name)
+				}
+			}
+
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectType(schema.ObjectTypeID), id, properties, history.Properties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("%s %s not found", label(schema), id)
+					return nil
+				}
+				return err
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{{"ID", obj.ID}}
+			for _, p := range properties {
+				rows = append(rows, []string{p, obj.GetProperty(p)})
+			}
+			rows = append(rows,
+				[]string{"Created", v.Time(obj.CreatedAt)},
+				[]string{"Updated", v.Time(obj.UpdatedAt)},
+			)
+
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
+
+	return cmd
+}
+
+func newCreateCmd(opts *root.Options, objectType *string) *cobra.Command {
+	var props []string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a custom object record",
+		Long:  "Create a custom object record. Every property the schema marks as required must be supplied with --prop.",
+		Example: `  # Create a car
+  hspt custom-objects create --type cars --prop make=Tesla --prop model="Model 3" --prop year=2024`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			properties, err := parseProps(props)
+			if err != nil {
+				return err
+			}
+			if len(properties) == 0 {
+				return fmt.Errorf("at least one --prop is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			schema, err := resolveSchema(cmd.Context(), client, *objectType)
+			if err != nil {
+				return err
+			}
+
+			if missing := missingRequired(schema, properties); len(missing) > 0 {
+				return fmt.Errorf("missing required properties: %s", strings.Join(missing, ", "))
+			}
+
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectType(schema.ObjectTypeID), properties)
+			if err != nil {
+				return err
+			}
+
+			v.Success("%s created with ID: %s", label(schema), obj.ID)
+
+			headers, rows := recordTable([]api.CRMObject{*obj}, displayProperties(schema))
+			return v.Render(headers, rows, obj)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&props, "prop", nil, "Property in key=value format; repeatable")
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options, objectType *string) *cobra.Command {
+	var props []string
+
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update a custom object record",
+		Long:  "Update properties of an existing custom object record.",
+		Example: `  # Update a car's mileage
+  hspt custom-objects update 12345 --type cars --prop mileage=42000`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			properties, err := parseProps(props)
+			if err != nil {
+				return err
+			}
+			if len(properties) == 0 {
+				return fmt.Errorf("at least one property to update is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			schema, err := resolveSchema(cmd.Context(), client, *objectType)
+			if err != nil {
+				return err
+			}
+
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectType(schema.ObjectTypeID), id, properties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("%s %s not found", label(schema), id)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("%s %s updated", label(schema), obj.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&props, "prop", nil, "Property in key=value format; repeatable")
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options, objectType *string) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a custom object record",
+		Long:  "Archive (soft delete) a custom object record.",
+		Example: `  # Delete a car
+  hspt custom-objects delete 12345 --type cars --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !force {
+				v.Warning("This will archive %s record %s. Use --force to confirm.", *objectType, id)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			schema, err := resolveSchema(cmd.Context(), client, *objectType)
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteObject(cmd.Context(), api.ObjectType(schema.ObjectTypeID), id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("%s %s not found", label(schema), id)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("%s %s archived", label(schema), id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func newSearchCmd(opts *root.Options, objectType *string) *cobra.Command {
+	var filterArgs []string
+	var sortArgs []string
+	var limit int
+	var after string
+	var properties []string
+
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search custom object records",
+		Long:  "Search custom object records with property filters and sorting.",
+		Example: `  # Cars made after 2020
+  hspt custom-objects search --type cars --filter "year>2020"
+
+  # Newest first
+  hspt custom-objects search --type cars --filter make=Tesla --sort hs_createdate:desc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			filters, err := shared.ParseFilters(filterArgs)
+			if err != nil {
+				return err
+			}
+
+			sorts, err := shared.ParseSort(sortArgs)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			schema, err := resolveSchema(cmd.Context(), client, *objectType)
+			if err != nil {
+				return err
+			}
+
+			if len(properties) == 0 {
+				properties = displayProperties(schema)
+			}
+
+			req := api.SearchRequest{
+				Properties: properties,
+				Limit:      limit,
+				After:      after,
+				Sorts:      sorts,
+			}
+			if len(filters) > 0 {
+				req.FilterGroups = []api.SearchFilterGroup{
+					{Filters: filters},
+				}
+			}
+
+			result, err := client.SearchObjects(cmd.Context(), api.ObjectType(schema.ObjectTypeID), req)
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No %s records found matching criteria", noun(schema))
+				return nil
+			}
+
+			v.Info("Found %d %s record(s)", len(result.Results), noun(schema))
+			headers, rows := recordTable(result.Results, properties)
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&filterArgs, "filter", nil, "Filter condition (e.g. prop=value, prop>=value, prop:OPERATOR:value); repeatable")
+	cmd.Flags().StringArrayVar(&sortArgs, "sort", nil, "Sort condition (e.g. hs_createdate:asc or hs_createdate:desc); repeatable")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package customobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestMatchSchema(t *testing.T) {
+	schemas := []api.Schema{
+		{Name: "cars", Labels: api.SchemaLabels{Singular: "Car", Plural: "Cars"}, ObjectTypeID: "2-1"},
+		{Name: "pets", Labels: api.SchemaLabels{Singular: "Pet", Plural: "Pets"}, ObjectTypeID: "2-2"},
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"cars", "2-1"},
+		{"Pet", "2-2"},
+		{"PETS", "2-2"},
+		{"boats", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchSchema(schemas, tt.name)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.ObjectTypeID)
+		})
+	}
+}
+
+func TestDisplayProperties(t *testing.T) {
+	schema := &api.Schema{
+		PrimaryDisplayProperty:     "model",
+		SecondaryDisplayProperties: []string{"make", "model"},
+		RequiredProperties:         []string{"model", "year"},
+	}
+
+	assert.Equal(t, []string{"model", "make", "year"}, displayProperties(schema))
+	assert.Empty(t, displayProperties(&api.Schema{}))
+}
+
+func TestParseProps(t *testing.T) {
+	props, err := parseProps([]string{"make=Tesla", "note=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"make": "Tesla", "note": "a=b"}, props)
+
+	_, err = parseProps([]string{"make"})
+	assert.Error(t, err)
+}
+
+func TestMissingRequired(t *testing.T) {
+	schema := &api.Schema{RequiredProperties: []string{"make", "year"}}
+
+	assert.Equal(t, []string{"year"}, missingRequired(schema, map[string]interface{}{"make": "Tesla"}))
+	assert.Empty(t, missingRequired(schema, map[string]interface{}{"make": "Tesla", "year": "2024"}))
+}
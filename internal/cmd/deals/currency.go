@@ -0,0 +1,150 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// currencyConverter converts deal amounts to one currency with the portal's
+// exchange rates
+type currencyConverter struct {
+	target string
+	home   string
+	// rates is how many units of each currency one unit of home is worth
+	rates map[string]float64
+}
+
+// newCurrencyConverter loads the portal's company currency and current
+// exchange rates for converting to target
+func newCurrencyConverter(ctx context.Context, client *api.Client, target string) (*currencyConverter, error) {
+	home, err := client.GetCompanyCurrency(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company currency: %w", err)
+	}
+	rates, err := client.ListCurrentExchangeRates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+	return buildConverter(strings.ToUpper(target), home, rates)
+}
+
+func buildConverter(target, home string, rates []api.ExchangeRate) (*currencyConverter, error) {
+	c := &currencyConverter{target: target, home: home, rates: map[string]float64{home: 1}}
+	for _, r := range rates {
+		if r.FromCurrencyCode == home && r.ConversionRate > 0 {
+			c.rates[r.ToCurrencyCode] = r.ConversionRate
+		}
+	}
+	if _, ok := c.rates[target]; !ok {
+		known := make([]string, 0, len(c.rates))
+		for code := range c.rates {
+			known = append(known, code)
+		}
+		sort.Strings(known)
+		return nil, fmt.Errorf("the portal has no exchange rate for %s (use one of %s)", target, strings.Join(known, ", "))
+	}
+	return c, nil
+}
+
+// convert converts amount from currency (the company currency when empty)
+// to the target currency. It reports false when the portal has no rate for
+// the currency.
+func (c *currencyConverter) convert(amount float64, currency string) (float64, bool) {
+	if currency == "" {
+		currency = c.home
+	}
+	from, ok := c.rates[currency]
+	if !ok {
+		return 0, false
+	}
+	return amount / from * c.rates[c.target], true
+}
+
+// dealAmount returns a deal's converted amount. ok is false when the deal
+// has no amount or its currency has no rate.
+func (c *currencyConverter) dealAmount(obj api.CRMObject) (amount float64, ok bool) {
+	raw := obj.GetProperty("amount")
+	if raw == "" {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false
+	}
+	return c.convert(value, obj.GetProperty("deal_currency_code"))
+}
+
+// cell formats a deal's amount in the target currency, or the original
+// amount and currency when it cannot be converted
+func (c *currencyConverter) cell(v *view.View, obj api.CRMObject) string {
+	if amount, ok := c.dealAmount(obj); ok {
+		return v.MoneyIn(strconv.FormatFloat(amount, 'f', 2, 64), c.target)
+	}
+	raw := obj.GetProperty("amount")
+	if raw == "" {
+		return ""
+	}
+	return raw + " " + obj.GetProperty("deal_currency_code") + " (no rate)"
+}
+
+// summarize prints the total of the deals' converted amounts, warning about
+// deals left out because their currency has no rate
+func (c *currencyConverter) summarize(v *view.View, deals []api.CRMObject) {
+	var total float64
+	counted := 0
+	unconverted := make(map[string]int)
+	for _, d := range deals {
+		if amount, ok := c.dealAmount(d); ok {
+			total += amount
+			counted++
+		} else if d.GetProperty("amount") != "" {
+			unconverted[d.GetProperty("deal_currency_code")]++
+		}
+	}
+
+	v.Info("Total (%s): %s across %d deal(s)", c.target, v.MoneyIn(strconv.FormatFloat(total, 'f', 2, 64), c.target), counted)
+
+	codes := make([]string, 0, len(unconverted))
+	for code := range unconverted {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		v.Warning("%d deal(s) in %s have no exchange rate and are left out of the total", unconverted[code], code)
+	}
+}
+
+// currencyProperties are the properties a conversion needs
+var currencyProperties = []string{"amount", "deal_currency_code"}
+
+// withCurrencyProperties adds the properties a conversion needs to a
+// property list
+func withCurrencyProperties(properties []string) []string {
+	result := append([]string{}, properties...)
+	for _, p := range currencyProperties {
+		found := false
+		for _, have := range properties {
+			if have == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// addNormalizeCurrencyFlag registers --normalize-currency
+func addNormalizeCurrencyFlag(cmd *cobra.Command, target *string) {
+	cmd.Flags().StringVar(target, "normalize-currency", "", "Convert amounts to this currency (e.g. USD) with the portal's exchange rates and print the total")
+}
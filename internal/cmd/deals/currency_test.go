@@ -0,0 +1,97 @@
+package deals
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+var testRates = []api.ExchangeRate{
+	{FromCurrencyCode: "USD", ToCurrencyCode: "EUR", ConversionRate: 0.92},
+	{FromCurrencyCode: "USD", ToCurrencyCode: "GBP", ConversionRate: 0.8},
+	{FromCurrencyCode: "EUR", ToCurrencyCode: "USD", ConversionRate: 1.1},
+}
+
+func deal(amount, currency string) api.CRMObject {
+	return api.CRMObject{Properties: map[string]interface{}{
+		"amount":             amount,
+		"deal_currency_code": currency,
+	}}
+}
+
+func TestBuildConverter(t *testing.T) {
+	c, err := buildConverter("EUR", "USD", testRates)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"USD": 1, "EUR": 0.92, "GBP": 0.8}, c.rates)
+
+	_, err = buildConverter("JPY", "USD", testRates)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no exchange rate for JPY")
+	assert.Contains(t, err.Error(), "EUR, GBP, USD")
+}
+
+func TestConvert(t *testing.T) {
+	c, err := buildConverter("USD", "USD", testRates)
+	require.NoError(t, err)
+
+	amount, ok := c.convert(920, "EUR")
+	require.True(t, ok)
+	assert.InDelta(t, 1000, amount, 0.001)
+
+	amount, ok = c.convert(500, "")
+	require.True(t, ok)
+	assert.InDelta(t, 500, amount, 0.001)
+
+	_, ok = c.convert(100, "CHF")
+	assert.False(t, ok)
+
+	c, err = buildConverter("GBP", "USD", testRates)
+	require.NoError(t, err)
+	amount, ok = c.convert(920, "EUR")
+	require.True(t, ok)
+	assert.InDelta(t, 800, amount, 0.001)
+}
+
+func TestCell(t *testing.T) {
+	c, err := buildConverter("USD", "USD", testRates)
+	require.NoError(t, err)
+	v := view.New("table", true)
+
+	assert.Equal(t, "1000.00", c.cell(v, deal("920", "EUR")))
+	assert.Equal(t, "", c.cell(v, deal("", "EUR")))
+	assert.Equal(t, "100 CHF (no rate)", c.cell(v, deal("100", "CHF")))
+
+	v.Currency = "USD"
+	assert.Equal(t, "$1,000.00", c.cell(v, deal("920", "EUR")))
+}
+
+func TestSummarize(t *testing.T) {
+	c, err := buildConverter("USD", "USD", testRates)
+	require.NoError(t, err)
+	var stderr bytes.Buffer
+	v := view.New("table", true)
+	v.Err = &stderr
+
+	c.summarize(v, []api.CRMObject{
+		deal("920", "EUR"),
+		deal("250", "USD"),
+		deal("100", "CHF"),
+		deal("", "USD"),
+	})
+
+	assert.Contains(t, stderr.String(), "Total (USD): 1250.00 across 2 deal(s)")
+	assert.Contains(t, stderr.String(), "1 deal(s) in CHF have no exchange rate")
+}
+
+func TestWithCurrencyProperties(t *testing.T) {
+	assert.Equal(t, []string{"dealname", "amount", "deal_currency_code"},
+		withCurrencyProperties([]string{"dealname"}))
+
+	props := []string{"amount", "deal_currency_code", "dealstage"}
+	assert.Equal(t, props, withCurrencyProperties(props))
+}
@@ -8,17 +8,19 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for deals
-var DefaultProperties = []string{"dealname", "amount", "dealstage", "pipeline", "closedate", "hubspot_owner_id"}
+var DefaultProperties = []string{"dealname", "amount", "deal_currency_code", "dealstage", "pipeline", "closedate", "hubspot_owner_id"}
 
 // Register registers the deals command and subcommands
 func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "deals",
 		Short: "Manage HubSpot deals",
-		Long:  "Commands for listing, viewing, creating, updating, and searching deals in HubSpot CRM.",
+		Long:  "Commands for listing, viewing, creating, updating, merging, and searching deals in HubSpot CRM.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
@@ -27,6 +29,39 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newSearchCmd(opts))
+	cmd.AddCommand(newMRRReportCmd(opts))
+	cmd.AddCommand(shared.NewMergeCmd(opts, shared.MergeCmdConfig{
+		ObjectType: api.ObjectTypeDeals,
+		Noun:       "deal",
+		Properties: DefaultProperties,
+		Example: `  # Preview merging a duplicate deal into the one to keep
+  hspt deals merge 100 200
+
+  # Compare every property and see which values win
+  hspt deals merge 100 200 --preview
+
+  # Merge it
+  hspt deals merge 100 200 --force`,
+	}))
+	cmd.AddCommand(shared.NewDiffCmd(opts, shared.DiffCmdConfig{
+		ObjectType: api.ObjectTypeDeals,
+		Noun:       "deal",
+		Properties: DefaultProperties,
+		Example: `  # Properties that differ between two deals
+  hspt deals diff 111 222
+
+  # Compare every property, including matching ones
+  hspt deals diff 111 222 --properties all --all`,
+	}))
+	cmd.AddCommand(shared.NewTimelineCmd(opts, shared.TimelineCmdConfig{
+		ObjectType: api.ObjectTypeDeals,
+		Noun:       "deal",
+		Example: `  # Recent activity on a deal
+  hspt deals timeline 303
+
+  # Every entry, only tasks
+  hspt deals timeline 303 --types task --limit 0`,
+	}))
 
 	parent.AddCommand(cmd)
 }
@@ -35,6 +70,9 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var normalize string
+	var pages shared.AllPages
+	var hydrate shared.Hydration
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -47,10 +85,21 @@ func newListCmd(opts *root.Options) *cobra.Command {
   hspt deals list --properties dealname,amount,dealstage
 
   # List with pagination
-  hspt deals list --limit 50 --after abc123`,
+  hspt deals list --limit 50 --after abc123
+
+  # Every deal in USD, with the total
+  hspt deals list --all --normalize-currency USD
+
+  # With associated company names and contact counts
+  hspt deals list --hydrate associations`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			hydrating, err := hydrate.Enabled()
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -60,11 +109,19 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeDeals, api.ListOptions{
+			var conv *currencyConverter
+			if normalize != "" {
+				if conv, err = newCurrencyConverter(cmd.Context(), client, normalize); err != nil {
+					return err
+				}
+				properties = withCurrencyProperties(properties)
+			}
+
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeDeals, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -77,19 +134,33 @@ func newListCmd(opts *root.Options) *cobra.Command {
 			headers := []string{"ID", "NAME", "AMOUNT", "STAGE", "PIPELINE", "CLOSE DATE"}
 			rows := make([][]string, 0, len(result.Results))
 			for _, obj := range result.Results {
+				amount := v.MoneyIn(obj.GetProperty("amount"), obj.GetProperty("deal_currency_code"))
+				if conv != nil {
+					amount = conv.cell(v, obj)
+				}
 				rows = append(rows, []string{
 					obj.ID,
 					obj.GetProperty("dealname"),
-					obj.GetProperty("amount"),
+					amount,
 					obj.GetProperty("dealstage"),
 					obj.GetProperty("pipeline"),
-					obj.GetProperty("closedate"),
+					v.Time(obj.GetProperty("closedate")),
 				})
 			}
 
-			if err := v.Render(headers, rows, result); err != nil {
+			var data interface{} = result
+			if hydrating {
+				if headers, rows, data, err = hydrate.Apply(cmd.Context(), v, client, api.ObjectTypeDeals, result, headers, rows); err != nil {
+					return err
+				}
+			}
+
+			if err := v.Render(headers, rows, data); err != nil {
 				return err
 			}
+			if conv != nil {
+				conv.summarize(v, result.Results)
+			}
 
 			if result.Paging != nil && result.Paging.Next != nil {
 				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
@@ -102,19 +173,27 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of deals to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	addNormalizeCurrencyFlag(cmd, &normalize)
+
+	shared.AddAllPagesFlags(cmd, &pages)
+	shared.AddHydrateFlag(cmd, &hydrate)
 
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
 		Short: "Get a deal by ID",
 		Long:  "Retrieve a single deal by its ID from HubSpot CRM.",
 		Example: `  # Get deal by ID
-  hspt deals get 12345`,
+  hspt deals get 12345
+
+  # Who moved the deal stage, and when
+  hspt deals get 12345 --with-history dealstage,amount`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
@@ -129,7 +208,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeDeals, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeDeals, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Deal %s not found", id)
@@ -142,26 +221,31 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			rows := [][]string{
 				{"ID", obj.ID},
 				{"Name", obj.GetProperty("dealname")},
-				{"Amount", obj.GetProperty("amount")},
+				{"Amount", v.MoneyIn(obj.GetProperty("amount"), obj.GetProperty("deal_currency_code"))},
 				{"Stage", obj.GetProperty("dealstage")},
 				{"Pipeline", obj.GetProperty("pipeline")},
-				{"Close Date", obj.GetProperty("closedate")},
+				{"Close Date", v.Time(obj.GetProperty("closedate"))},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
-	var dealname, amount, dealstage, pipeline, closedate, ownerID string
+	var dealname, amount, dealstage, pipeline, closedate, ownerID, ownerEmail string
 	var props []string
 
 	cmd := &cobra.Command{
@@ -197,8 +281,12 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 			if closedate != "" {
 				properties["closedate"] = closedate
 			}
-			if ownerID != "" {
-				properties["hubspot_owner_id"] = ownerID
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
 			}
 
 			for _, p := range props {
@@ -212,7 +300,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeDeals, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeDeals, properties)
 			if err != nil {
 				return err
 			}
@@ -223,7 +311,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 			rows := [][]string{
 				{"ID", obj.ID},
 				{"Name", obj.GetProperty("dealname")},
-				{"Amount", obj.GetProperty("amount")},
+				{"Amount", v.MoneyIn(obj.GetProperty("amount"), obj.GetProperty("deal_currency_code"))},
 				{"Stage", obj.GetProperty("dealstage")},
 			}
 
@@ -236,14 +324,14 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&dealstage, "stage", "", "Deal stage")
 	cmd.Flags().StringVar(&pipeline, "pipeline", "", "Pipeline ID")
 	cmd.Flags().StringVar(&closedate, "closedate", "", "Close date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&ownerID, "owner", "", "Owner ID")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
 
 	return cmd
 }
 
 func newUpdateCmd(opts *root.Options) *cobra.Command {
-	var dealname, amount, dealstage, pipeline, closedate, ownerID string
+	var dealname, amount, dealstage, pipeline, closedate, ownerID, ownerEmail string
 	var props []string
 
 	cmd := &cobra.Command{
@@ -276,8 +364,12 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 			if closedate != "" {
 				properties["closedate"] = closedate
 			}
-			if ownerID != "" {
-				properties["hubspot_owner_id"] = ownerID
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
 			}
 
 			for _, p := range props {
@@ -291,7 +383,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeDeals, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeDeals, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Deal %s not found", id)
@@ -310,7 +402,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&dealstage, "stage", "", "Deal stage")
 	cmd.Flags().StringVar(&pipeline, "pipeline", "", "Pipeline ID")
 	cmd.Flags().StringVar(&closedate, "closedate", "", "Close date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&ownerID, "owner", "", "Owner ID")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
 
 	return cmd
@@ -338,7 +430,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeDeals, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeDeals, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Deal %s not found", id)
 					return nil
@@ -357,99 +449,82 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 }
 
 func newSearchCmd(opts *root.Options) *cobra.Command {
-	var dealname, dealstage, pipeline string
-	var limit int
-	var properties []string
+	var normalize string
+	var conv *currencyConverter
 
-	cmd := &cobra.Command{
-		Use:   "search",
-		Short: "Search deals",
-		Long:  "Search for deals using filters.",
+	cmd := shared.NewSearchCmd(opts, shared.SearchCmdConfig{
+		ObjectType: api.ObjectTypeDeals,
+		Noun:       "deal",
+		Short:      "Search deals",
+		Long: `Search for deals using filters.
+
+The --name, --stage, and --pipeline flags are shortcuts for common filters;
+--filter accepts any property and search operator.`,
 		Example: `  # Search by name
   hspt deals search --name "Enterprise"
 
   # Search by stage
-  hspt deals search --stage closedwon`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
+  hspt deals search --stage closedwon
 
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			if len(properties) == 0 {
-				properties = DefaultProperties
-			}
+  # Deals between 10k and 50k closing this quarter, largest first
+  hspt deals search --filter "amount BETWEEN 10000 50000" --filter "closedate:BETWEEN:2026-10-01:2026-12-31" --sort amount:desc
 
-			var filters []api.SearchFilter
+  # Deals in either of two stages
+  hspt deals search --filter "dealstage:IN:appointmentscheduled,qualifiedtobuy"
 
-			if dealname != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "dealname",
-					Operator:     "CONTAINS_TOKEN",
-					Value:        dealname,
-				})
-			}
-			if dealstage != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "dealstage",
-					Operator:     "EQ",
-					Value:        dealstage,
-				})
-			}
-			if pipeline != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "pipeline",
-					Operator:     "EQ",
-					Value:        pipeline,
-				})
+  # Open pipeline across currencies, totaled in EUR
+  hspt deals search --filter "hs_is_closed EQ false" --limit 100 --normalize-currency EUR`,
+		Shorthands: []shared.SearchShorthand{
+			{Flag: "name", Property: "dealname", Operator: "CONTAINS_TOKEN", Usage: "Search by name (contains)"},
+			{Flag: "stage", Property: "dealstage", Operator: "EQ", Usage: "Search by exact deal stage"},
+			{Flag: "pipeline", Property: "pipeline", Operator: "EQ", Usage: "Search by pipeline ID"},
+		},
+		DefaultProperties: DefaultProperties,
+		Headers:           []string{"ID", "NAME", "AMOUNT", "STAGE", "PIPELINE", "CLOSE DATE"},
+		Row: func(v *view.View, obj api.CRMObject) []string {
+			amount := v.MoneyIn(obj.GetProperty("amount"), obj.GetProperty("deal_currency_code"))
+			if conv != nil {
+				amount = conv.cell(v, obj)
+			}
+			return []string{
+				obj.ID,
+				obj.GetProperty("dealname"),
+				amount,
+				obj.GetProperty("dealstage"),
+				obj.GetProperty("pipeline"),
+				v.Time(obj.GetProperty("closedate")),
 			}
-
-			req := api.SearchRequest{
-				Properties: properties,
-				Limit:      limit,
+		},
+		Summary: func(v *view.View, results []api.CRMObject) {
+			if conv != nil {
+				conv.summarize(v, results)
 			}
+		},
+	})
 
-			if len(filters) > 0 {
-				req.FilterGroups = []api.SearchFilterGroup{
-					{Filters: filters},
+	addNormalizeCurrencyFlag(cmd, &normalize)
+	// The converter needs the API client, so it is set up before the shared
+	// search runs
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if normalize == "" {
+			return nil
+		}
+		client, err := opts.APIClient()
+		if err != nil {
+			return err
+		}
+		if conv, err = newCurrencyConverter(cmd.Context(), client, normalize); err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("properties") {
+			for _, p := range currencyProperties {
+				if err := cmd.Flags().Set("properties", p); err != nil {
+					return err
 				}
 			}
-
-			result, err := client.SearchObjects(api.ObjectTypeDeals, req)
-			if err != nil {
-				return err
-			}
-
-			if len(result.Results) == 0 {
-				v.Info("No deals found matching criteria")
-				return nil
-			}
-
-			headers := []string{"ID", "NAME", "AMOUNT", "STAGE", "PIPELINE", "CLOSE DATE"}
-			rows := make([][]string, 0, len(result.Results))
-			for _, obj := range result.Results {
-				rows = append(rows, []string{
-					obj.ID,
-					obj.GetProperty("dealname"),
-					obj.GetProperty("amount"),
-					obj.GetProperty("dealstage"),
-					obj.GetProperty("pipeline"),
-					obj.GetProperty("closedate"),
-				})
-			}
-
-			v.Info("Found %d deal(s)", len(result.Results))
-			return v.Render(headers, rows, result)
-		},
+		}
+		return nil
 	}
 
-	cmd.Flags().StringVar(&dealname, "name", "", "Search by name (contains)")
-	cmd.Flags().StringVar(&dealstage, "stage", "", "Search by exact deal stage")
-	cmd.Flags().StringVar(&pipeline, "pipeline", "", "Search by pipeline ID")
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
-	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
-
 	return cmd
 }
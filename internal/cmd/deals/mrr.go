@@ -0,0 +1,398 @@
+package deals
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// maxRecurringDeals caps the deals search, which HubSpot limits to 10,000
+// results per query
+const maxRecurringDeals = 10000
+
+// mrrDeal is a closed-won deal's contribution to recurring revenue
+type mrrDeal struct {
+	ID       string
+	Customer string
+	MRR      float64
+	// Start is the month the deal closed, as a month number (see monthNumber)
+	Start int
+	// Term is how many months the deal's revenue recurs; 0 means until
+	// further notice
+	Term int
+}
+
+// activeIn reports whether a deal's revenue recurs in month m
+func (d mrrDeal) activeIn(m int) bool {
+	return m >= d.Start && (d.Term == 0 || m < d.Start+d.Term)
+}
+
+// mrrMonth is one month of the MRR report
+type mrrMonth struct {
+	Month       string  `json:"month"`
+	Starting    float64 `json:"startingMrr"`
+	New         float64 `json:"newMrr"`
+	Expansion   float64 `json:"expansionMrr"`
+	Contraction float64 `json:"contractionMrr"`
+	Churned     float64 `json:"churnedMrr"`
+	Ending      float64 `json:"endingMrr"`
+	Customers   int     `json:"customers"`
+}
+
+// monthNumber counts months from year 0, so months can be compared and
+// stepped through with integer arithmetic
+func monthNumber(t time.Time) int {
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+// monthTime returns the first instant of a month number, in UTC
+func monthTime(m int) time.Time {
+	return time.Date(m/12, time.Month(m%12+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+var quarterPattern = regexp.MustCompile(`^(\d{4})-[Qq]([1-4])$`)
+
+// parsePeriod reads a --closed-in value (a year, YYYY-MM, or YYYY-Qn) as the
+// first and last month numbers it covers
+func parsePeriod(s string) (from, to int, err error) {
+	s = strings.TrimSpace(s)
+	if m := quarterPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		q, _ := strconv.Atoi(m[2])
+		from = year*12 + (q-1)*3
+		return from, from + 2, nil
+	}
+	if t, err := time.Parse("2006-01", s); err == nil {
+		return monthNumber(t), monthNumber(t), nil
+	}
+	if t, err := time.Parse("2006", s); err == nil {
+		from = monthNumber(t)
+		return from, from + 11, nil
+	}
+	return 0, 0, fmt.Errorf("invalid period %q: use a year (2024), a month (2024-03), or a quarter (2024-Q1)", s)
+}
+
+// buildMRRReport buckets the change in each customer's MRR from one month
+// to the next: customers going from nothing to something are new, from
+// something to nothing have churned, and the rest expand or contract
+func buildMRRReport(deals []mrrDeal, from, to int) []mrrMonth {
+	byCustomer := make(map[string][]mrrDeal)
+	for _, d := range deals {
+		byCustomer[d.Customer] = append(byCustomer[d.Customer], d)
+	}
+	mrrAt := func(customerDeals []mrrDeal, m int) float64 {
+		var total float64
+		for _, d := range customerDeals {
+			if d.activeIn(m) {
+				total += d.MRR
+			}
+		}
+		return total
+	}
+
+	report := make([]mrrMonth, 0, to-from+1)
+	for m := from; m <= to; m++ {
+		month := mrrMonth{Month: monthTime(m).Format("2006-01")}
+		for _, customerDeals := range byCustomer {
+			prev, cur := mrrAt(customerDeals, m-1), mrrAt(customerDeals, m)
+			month.Starting += prev
+			month.Ending += cur
+			if cur > 0 {
+				month.Customers++
+			}
+			switch {
+			case prev == 0 && cur > 0:
+				month.New += cur
+			case prev > 0 && cur == 0:
+				month.Churned += prev
+			case cur > prev:
+				month.Expansion += cur - prev
+			case cur < prev:
+				month.Contraction += prev - cur
+			}
+		}
+		report = append(report, month)
+	}
+	return report
+}
+
+// dealMRR reads a deal's monthly recurring revenue: hs_mrr, or hs_arr over
+// twelve when HubSpot has only the annual figure
+func dealMRR(obj api.CRMObject) float64 {
+	if mrr, err := strconv.ParseFloat(obj.GetProperty("hs_mrr"), 64); err == nil && mrr > 0 {
+		return mrr
+	}
+	if arr, err := strconv.ParseFloat(obj.GetProperty("hs_arr"), 64); err == nil && arr > 0 {
+		return arr / 12
+	}
+	return 0
+}
+
+// dealTerm reads a deal's term in months from termProperty, falling back to
+// defaultTerm when the deal has none
+func dealTerm(obj api.CRMObject, termProperty string, defaultTerm int) int {
+	if termProperty == "" {
+		return defaultTerm
+	}
+	raw := obj.GetProperty(termProperty)
+	if term, err := strconv.ParseFloat(raw, 64); err == nil && term > 0 {
+		return int(term)
+	}
+	return defaultTerm
+}
+
+// primaryCompany picks the company a deal's revenue belongs to: the one
+// labeled Primary, or else the first
+func primaryCompany(associations []api.Association) string {
+	for _, a := range associations {
+		for _, t := range a.AssociationTypes {
+			if t.Label == "Primary" {
+				return a.ToObjectID.String()
+			}
+		}
+	}
+	if len(associations) > 0 {
+		return associations[0].ToObjectID.String()
+	}
+	return ""
+}
+
+// recurringDeals returns the closed-won deals with recurring revenue that
+// closed before end, a time in milliseconds
+func recurringDeals(ctx context.Context, client *api.Client, end int64, properties []string) ([]api.CRMObject, bool, error) {
+	won := func(revenue string) api.SearchFilterGroup {
+		return api.SearchFilterGroup{Filters: []api.SearchFilter{
+			{PropertyName: "hs_is_closed_won", Operator: "EQ", Value: "true"},
+			{PropertyName: "closedate", Operator: "LT", Value: strconv.FormatInt(end, 10)},
+			{PropertyName: revenue, Operator: "GT", Value: "0"},
+		}}
+	}
+	req := api.SearchRequest{
+		FilterGroups: []api.SearchFilterGroup{won("hs_mrr"), won("hs_arr")},
+		Sorts:        []api.SearchSort{{PropertyName: "closedate", Direction: "DESCENDING"}},
+		Properties:   properties,
+		Limit:        api.DefaultPageSize,
+	}
+
+	var deals []api.CRMObject
+	for {
+		page, err := client.SearchObjects(ctx, api.ObjectTypeDeals, req)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to search deals: %w", err)
+		}
+		deals = append(deals, page.Results...)
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			return deals, false, nil
+		}
+		if len(deals) >= maxRecurringDeals {
+			return deals, true, nil
+		}
+		req.After = page.Paging.Next.After
+	}
+}
+
+// dealCustomers maps deal IDs to their primary company IDs
+func dealCustomers(ctx context.Context, client *api.Client, deals []api.CRMObject) (map[string]string, error) {
+	customers := make(map[string]string, len(deals))
+	for start := 0; start < len(deals); start += api.MaxBatchSize {
+		end := start + api.MaxBatchSize
+		if end > len(deals) {
+			end = len(deals)
+		}
+		ids := make([]string, 0, end-start)
+		for _, d := range deals[start:end] {
+			ids = append(ids, d.ID)
+		}
+		associations, err := client.BatchReadAssociations(ctx, api.ObjectTypeDeals, api.ObjectTypeCompanies, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read deal companies: %w", err)
+		}
+		for id, a := range associations {
+			if company := primaryCompany(a); company != "" {
+				customers[id] = company
+			}
+		}
+	}
+	return customers, nil
+}
+
+// mrrCSV writes the report as CSV with amounts to two decimal places
+func mrrCSV(report []mrrMonth) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	money := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 2, 64)
+	}
+
+	if err := w.Write([]string{"month", "starting_mrr", "new_mrr", "expansion_mrr", "contraction_mrr", "churned_mrr", "ending_mrr", "customers"}); err != nil {
+		return nil, err
+	}
+	for _, m := range report {
+		record := []string{m.Month, money(m.Starting), money(m.New), money(m.Expansion), money(m.Contraction), money(m.Churned), money(m.Ending), strconv.Itoa(m.Customers)}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func newMRRReportCmd(opts *root.Options) *cobra.Command {
+	var period, termProperty, currency, out string
+	var defaultTerm int
+
+	cmd := &cobra.Command{
+		Use:   "mrr-report",
+		Short: "Report monthly recurring revenue movements",
+		Long: `Report how monthly recurring revenue (MRR) moved in each month of a period,
+as CSV: starting MRR, new, expansion, contraction, and churned MRR, and
+ending MRR.
+
+Revenue comes from closed-won deals' hs_mrr property (or hs_arr / 12). A
+deal's MRR starts in the month it closed and recurs for its term: the
+number of months in --term-property, or --default-term (0 for no end).
+Deals are grouped into customers by their primary company, so a renewal
+that closes as the previous deal's term ends carries the revenue over
+rather than counting as churn and new. Deals without a company are each
+their own customer.
+
+Deals closed before the period count toward its starting MRR. Amounts are
+converted to the company currency, or to --currency, with the portal's
+exchange rates.`,
+		Example: `  # MRR movements for each month of 2024
+  hspt deals mrr-report --closed-in 2024
+
+  # One quarter, terms from a custom property, to a file
+  hspt deals mrr-report --closed-in 2024-Q3 --term-property contract_term_months --out mrr.csv
+
+  # As JSON
+  hspt deals mrr-report --closed-in 2024-03 -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			if period == "" {
+				return fmt.Errorf("--closed-in is required")
+			}
+			from, to, err := parsePeriod(period)
+			if err != nil {
+				return err
+			}
+			if defaultTerm < 0 {
+				return fmt.Errorf("--default-term must be 0 or more")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if currency == "" {
+				if currency, err = client.GetCompanyCurrency(ctx); err != nil {
+					return fmt.Errorf("failed to get company currency: %w", err)
+				}
+			}
+			conv, err := newCurrencyConverter(ctx, client, currency)
+			if err != nil {
+				return err
+			}
+
+			properties := []string{"dealname", "closedate", "hs_mrr", "hs_arr", "deal_currency_code"}
+			if termProperty != "" {
+				properties = append(properties, termProperty)
+			}
+			objects, capped, err := recurringDeals(ctx, client, monthTime(to+1).UnixMilli(), properties)
+			if err != nil {
+				return err
+			}
+			if capped {
+				v.Warning("Stopped at %d deals, the most a HubSpot search returns; only the most recent deals are included", maxRecurringDeals)
+			}
+
+			var customers map[string]string
+			if len(objects) > 0 {
+				if customers, err = dealCustomers(ctx, client, objects); err != nil {
+					return err
+				}
+			}
+
+			deals := make([]mrrDeal, 0, len(objects))
+			unconverted := make(map[string]int)
+			for _, obj := range objects {
+				closed, err := time.Parse(time.RFC3339, obj.GetProperty("closedate"))
+				if err != nil {
+					continue
+				}
+				mrr, ok := conv.convert(dealMRR(obj), obj.GetProperty("deal_currency_code"))
+				if !ok {
+					unconverted[obj.GetProperty("deal_currency_code")]++
+					continue
+				}
+				customer := customers[obj.ID]
+				if customer == "" {
+					customer = "deal:" + obj.ID
+				}
+				deals = append(deals, mrrDeal{
+					ID:       obj.ID,
+					Customer: customer,
+					MRR:      mrr,
+					Start:    monthNumber(closed.UTC()),
+					Term:     dealTerm(obj, termProperty, defaultTerm),
+				})
+			}
+
+			codes := make([]string, 0, len(unconverted))
+			for code := range unconverted {
+				codes = append(codes, code)
+			}
+			sort.Strings(codes)
+			for _, code := range codes {
+				v.Warning("%d deal(s) in %s have no exchange rate and are left out", unconverted[code], code)
+			}
+
+			report := buildMRRReport(deals, from, to)
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(report)
+			}
+
+			data, err := mrrCSV(report)
+			if err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+			if out == "" {
+				_, err = v.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(out, data, 0644); err != nil {
+				return fmt.Errorf("failed to write MRR report: %w", err)
+			}
+
+			v.Success("Wrote %d month(s) of MRR in %s from %d deal(s) to %s", len(report), conv.target, len(deals), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&period, "closed-in", "", "Period to report: a year (2024), month (2024-03), or quarter (2024-Q1) (required)")
+	cmd.Flags().StringVar(&termProperty, "term-property", "", "Deal property holding the contract term in months")
+	cmd.Flags().IntVar(&defaultTerm, "default-term", 12, "Term in months for deals without a term (0 for no end)")
+	cmd.Flags().StringVar(&currency, "currency", "", "Currency to report in (default: the company currency)")
+	cmd.Flags().StringVar(&out, "out", "", "File to write the CSV to (default: stdout)")
+
+	return cmd
+}
@@ -0,0 +1,92 @@
+package deals
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func monthOf(s string) int {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		panic(err)
+	}
+	return monthNumber(t)
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		in       string
+		from, to string
+	}{
+		{"2024", "2024-01", "2024-12"},
+		{"2024-03", "2024-03", "2024-03"},
+		{"2024-Q3", "2024-07", "2024-09"},
+		{"2024-q1", "2024-01", "2024-03"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			from, to, err := parsePeriod(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.from, monthTime(from).Format("2006-01"))
+			assert.Equal(t, tt.to, monthTime(to).Format("2006-01"))
+		})
+	}
+
+	_, _, err := parsePeriod("last year")
+	assert.ErrorContains(t, err, "invalid period")
+}
+
+func TestBuildMRRReport(t *testing.T) {
+	deals := []mrrDeal{
+		// Acme: 100 from Nov 2023 for 3 months, renewed at 150 in Feb
+		{ID: "1", Customer: "acme", MRR: 100, Start: monthOf("2023-11"), Term: 3},
+		{ID: "2", Customer: "acme", MRR: 150, Start: monthOf("2024-02"), Term: 12},
+		// Globex: 50 from Jan for 2 months, not renewed
+		{ID: "3", Customer: "globex", MRR: 50, Start: monthOf("2024-01"), Term: 2},
+		// Initech: 80 from Dec, then a 30 add-on in Feb, no end
+		{ID: "4", Customer: "initech", MRR: 80, Start: monthOf("2023-12"), Term: 0},
+		{ID: "5", Customer: "initech", MRR: 30, Start: monthOf("2024-02"), Term: 0},
+	}
+
+	report := buildMRRReport(deals, monthOf("2024-01"), monthOf("2024-03"))
+	require.Len(t, report, 3)
+
+	assert.Equal(t, mrrMonth{Month: "2024-01", Starting: 180, New: 50, Ending: 230, Customers: 3}, report[0])
+	assert.Equal(t, mrrMonth{Month: "2024-02", Starting: 230, Expansion: 80, Ending: 310, Customers: 3}, report[1])
+	assert.Equal(t, mrrMonth{Month: "2024-03", Starting: 310, Churned: 50, Ending: 260, Customers: 2}, report[2])
+}
+
+func TestDealMRR(t *testing.T) {
+	assert.Equal(t, 100.0, dealMRR(api.CRMObject{Properties: map[string]interface{}{"hs_mrr": "100", "hs_arr": "1200"}}))
+	assert.Equal(t, 50.0, dealMRR(api.CRMObject{Properties: map[string]interface{}{"hs_arr": "600"}}))
+	assert.Equal(t, 0.0, dealMRR(api.CRMObject{Properties: map[string]interface{}{}}))
+}
+
+func TestDealTerm(t *testing.T) {
+	obj := api.CRMObject{Properties: map[string]interface{}{"term_months": "24"}}
+	assert.Equal(t, 24, dealTerm(obj, "term_months", 12))
+	assert.Equal(t, 12, dealTerm(obj, "", 12))
+	assert.Equal(t, 12, dealTerm(api.CRMObject{Properties: map[string]interface{}{}}, "term_months", 12))
+}
+
+func TestPrimaryCompany(t *testing.T) {
+	associations := []api.Association{
+		{ToObjectID: json.Number("501")},
+		{ToObjectID: json.Number("502"), AssociationTypes: []api.AssociationType{{TypeID: 5, Label: "Primary"}}},
+	}
+	assert.Equal(t, "502", primaryCompany(associations))
+	assert.Equal(t, "501", primaryCompany(associations[:1]))
+	assert.Equal(t, "", primaryCompany(nil))
+}
+
+func TestMRRCSV(t *testing.T) {
+	data, err := mrrCSV([]mrrMonth{{Month: "2024-01", Starting: 100, New: 25.5, Ending: 125.5, Customers: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, "month,starting_mrr,new_mrr,expansion_mrr,contraction_mrr,churned_mrr,ending_mrr,customers\n2024-01,100.00,25.50,0.00,0.00,0.00,125.50,2\n", string(data))
+}
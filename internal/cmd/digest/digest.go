@@ -0,0 +1,443 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// section is one of the predefined queries a digest can run
+type section struct {
+	Name       string
+	Title      string
+	ObjectType api.ObjectType
+	// NameProperty names a record in the list; DateProperty is the date
+	// shown next to it
+	NameProperty string
+	DateProperty string
+	// Amounts, when set, totals the records' amount property, which needs
+	// every matching record rather than the first page
+	Amounts bool
+	// Filters returns the filters of a digest of the time from since to now
+	Filters func(since, now time.Time) []api.SearchFilter
+	Sort    api.SearchSort
+}
+
+func millis(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+// sections are the queries a digest can run, in the order they are reported
+var sections = []section{
+	{
+		Name:         "new-deals",
+		Title:        "New deals",
+		ObjectType:   api.ObjectTypeDeals,
+		NameProperty: "dealname",
+		DateProperty: "createdate",
+		Amounts:      true,
+		Filters: func(since, now time.Time) []api.SearchFilter {
+			return []api.SearchFilter{{PropertyName: "createdate", Operator: "GTE", Value: millis(since)}}
+		},
+		Sort: api.SearchSort{PropertyName: "createdate", Direction: "DESCENDING"},
+	},
+	{
+		Name:         "closed-won",
+		Title:        "Closed won",
+		ObjectType:   api.ObjectTypeDeals,
+		NameProperty: "dealname",
+		DateProperty: "closedate",
+		Amounts:      true,
+		Filters: func(since, now time.Time) []api.SearchFilter {
+			return []api.SearchFilter{
+				{PropertyName: "hs_is_closed_won", Operator: "EQ", Value: "true"},
+				{PropertyName: "closedate", Operator: "GTE", Value: millis(since)},
+			}
+		},
+		Sort: api.SearchSort{PropertyName: "amount", Direction: "DESCENDING"},
+	},
+	{
+		Name:         "new-contacts",
+		Title:        "New contacts",
+		ObjectType:   api.ObjectTypeContacts,
+		NameProperty: "email",
+		DateProperty: "createdate",
+		Filters: func(since, now time.Time) []api.SearchFilter {
+			return []api.SearchFilter{{PropertyName: "createdate", Operator: "GTE", Value: millis(since)}}
+		},
+		Sort: api.SearchSort{PropertyName: "createdate", Direction: "DESCENDING"},
+	},
+	{
+		Name:         "new-tickets",
+		Title:        "New tickets",
+		ObjectType:   api.ObjectTypeTickets,
+		NameProperty: "subject",
+		DateProperty: "createdate",
+		Filters: func(since, now time.Time) []api.SearchFilter {
+			return []api.SearchFilter{{PropertyName: "createdate", Operator: "GTE", Value: millis(since)}}
+		},
+		Sort: api.SearchSort{PropertyName: "createdate", Direction: "DESCENDING"},
+	},
+	{
+		Name:         "stale-tasks",
+		Title:        "Overdue tasks",
+		ObjectType:   api.ObjectTypeTasks,
+		NameProperty: "hs_task_subject",
+		DateProperty: "hs_timestamp",
+		Filters: func(since, now time.Time) []api.SearchFilter {
+			return []api.SearchFilter{
+				{PropertyName: "hs_task_status", Operator: "NEQ", Value: "COMPLETED"},
+				{PropertyName: "hs_timestamp", Operator: "LT", Value: millis(now)},
+			}
+		},
+		Sort: api.SearchSort{PropertyName: "hs_timestamp", Direction: "ASCENDING"},
+	},
+}
+
+// defaultSections are run when --sections is not given
+var defaultSections = []string{"new-deals", "closed-won", "new-tickets", "stale-tasks"}
+
+// parseSections looks up the sections named in --sections, in the order
+// given, dropping repeats
+func parseSections(raw []string) ([]section, error) {
+	byName := make(map[string]section, len(sections))
+	names := make([]string, 0, len(sections))
+	for _, s := range sections {
+		byName[s.Name] = s
+		names = append(names, s.Name)
+	}
+
+	var result []section
+	seen := make(map[string]bool)
+	for _, r := range raw {
+		for _, name := range strings.Split(r, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" || seen[name] {
+				continue
+			}
+			s, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown section %q (use %s)", name, strings.Join(names, ", "))
+			}
+			seen[name] = true
+			result = append(result, s)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("--sections needs at least one section")
+	}
+	return result, nil
+}
+
+// digestItem is one record listed in a section
+type digestItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Amount string `json:"amount,omitempty"`
+	Owner  string `json:"owner,omitempty"`
+	Date   string `json:"date,omitempty"`
+}
+
+// sectionResult is what a section found
+type sectionResult struct {
+	Name  string       `json:"section"`
+	Title string       `json:"title"`
+	Count int          `json:"count"`
+	Total string       `json:"totalAmount,omitempty"`
+	Items []digestItem `json:"items"`
+}
+
+// digest is the whole report
+type digest struct {
+	Since    time.Time       `json:"since"`
+	Until    time.Time       `json:"until"`
+	Sections []sectionResult `json:"sections"`
+}
+
+// recordName names a record by its section's name property, or a contact by
+// full name when it has one. Records without either are named by ID.
+func recordName(s section, o api.CRMObject) string {
+	if s.ObjectType == api.ObjectTypeContacts {
+		first, last := o.GetProperty("firstname"), o.GetProperty("lastname")
+		if name := strings.TrimSpace(first + " " + last); name != "" {
+			return name
+		}
+	}
+	if name := o.GetProperty(s.NameProperty); name != "" {
+		return name
+	}
+	return "#" + o.ID
+}
+
+// summarize turns a section's records into its result, listing up to limit
+// of them. total is the number of matching records HubSpot reported.
+func summarize(s section, records []api.CRMObject, total, limit int) sectionResult {
+	result := sectionResult{Name: s.Name, Title: s.Title, Count: total, Items: []digestItem{}}
+	if result.Count < len(records) {
+		result.Count = len(records)
+	}
+
+	var sum float64
+	for i, o := range records {
+		if s.Amounts {
+			if f, err := strconv.ParseFloat(o.GetProperty("amount"), 64); err == nil {
+				sum += f
+			}
+		}
+		if i >= limit {
+			continue
+		}
+		item := digestItem{
+			ID:    o.ID,
+			Name:  recordName(s, o),
+			Owner: o.GetProperty("hubspot_owner_id"),
+			Date:  o.GetProperty(s.DateProperty),
+		}
+		if s.Amounts {
+			item.Amount = o.GetProperty("amount")
+		}
+		result.Items = append(result.Items, item)
+	}
+	if s.Amounts {
+		result.Total = strconv.FormatFloat(sum, 'f', 2, 64)
+	}
+	return result
+}
+
+// runSection searches for a section's records. Sections that total amounts
+// read every matching record; the others read only the records they list.
+func runSection(ctx context.Context, client *api.Client, s section, since, now time.Time, limit int) (sectionResult, error) {
+	properties := []string{s.NameProperty, s.DateProperty, "hubspot_owner_id"}
+	if s.Amounts {
+		properties = append(properties, "amount")
+	}
+	if s.ObjectType == api.ObjectTypeContacts {
+		properties = append(properties, "firstname", "lastname")
+	}
+
+	pageSize := limit
+	if s.Amounts || pageSize > api.DefaultPageSize {
+		pageSize = api.DefaultPageSize
+	}
+	req := api.SearchRequest{
+		FilterGroups: []api.SearchFilterGroup{{Filters: s.Filters(since, now)}},
+		Sorts:        []api.SearchSort{s.Sort},
+		Properties:   properties,
+		Limit:        pageSize,
+	}
+
+	var records []api.CRMObject
+	total := 0
+	for {
+		page, err := client.SearchObjects(ctx, s.ObjectType, req)
+		if err != nil {
+			return sectionResult{}, fmt.Errorf("failed to search %s for %s: %w", s.ObjectType, s.Name, err)
+		}
+		records = append(records, page.Results...)
+		total = page.Total
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			break
+		}
+		if !s.Amounts && len(records) >= limit {
+			break
+		}
+		req.After = page.Paging.Next.After
+	}
+
+	return summarize(s, records, total, limit), nil
+}
+
+// nameOwners replaces owner IDs with owner names where they are known
+func nameOwners(d *digest, owners []api.Owner) {
+	names := make(map[string]string, len(owners))
+	for i := range owners {
+		names[owners[i].ID] = owners[i].FullName()
+	}
+	for i := range d.Sections {
+		for j := range d.Sections[i].Items {
+			item := &d.Sections[i].Items[j]
+			if name, ok := names[item.Owner]; ok {
+				item.Owner = name
+			}
+		}
+	}
+}
+
+// renderDigest renders a digest as Markdown, or in Slack's mrkdwn when
+// slack is set, which has no headings and marks bold with single asterisks
+func renderDigest(d *digest, v *view.View, slack bool) []byte {
+	var b bytes.Buffer
+
+	title := fmt.Sprintf("HubSpot digest: %s to %s", d.Since.In(location(v)).Format("2006-01-02"), d.Until.In(location(v)).Format("2006-01-02"))
+	if slack {
+		fmt.Fprintf(&b, "*%s*\n", title)
+	} else {
+		fmt.Fprintf(&b, "# %s\n", title)
+	}
+
+	for _, s := range d.Sections {
+		heading := fmt.Sprintf("%s (%d", s.Title, s.Count)
+		if s.Total != "" {
+			heading += ", " + v.Money(s.Total)
+		}
+		heading += ")"
+		if slack {
+			fmt.Fprintf(&b, "\n*%s*\n", heading)
+		} else {
+			fmt.Fprintf(&b, "\n## %s\n\n", heading)
+		}
+
+		if len(s.Items) == 0 {
+			b.WriteString("None.\n")
+			continue
+		}
+		for _, item := range s.Items {
+			name := markdownText(item.Name)
+			if slack {
+				name = "*" + name + "*"
+			} else {
+				name = "**" + name + "**"
+			}
+			parts := []string{name}
+			if item.Amount != "" {
+				parts = append(parts, v.Money(item.Amount))
+			}
+			if item.Owner != "" {
+				parts = append(parts, markdownText(item.Owner))
+			}
+			if item.Date != "" {
+				parts = append(parts, v.Time(item.Date))
+			}
+			fmt.Fprintf(&b, "- %s\n", strings.Join(parts, " - "))
+		}
+		if more := s.Count - len(s.Items); more > 0 {
+			fmt.Fprintf(&b, "- _and %d more_\n", more)
+		}
+	}
+
+	return b.Bytes()
+}
+
+// markdownText escapes the characters that would turn record names into
+// Markdown formatting
+func markdownText(s string) string {
+	return strings.NewReplacer("*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "\n", " ").Replace(s)
+}
+
+func location(v *view.View) *time.Location {
+	if v.Location != nil {
+		return v.Location
+	}
+	return time.Local
+}
+
+func newCmd(opts *root.Options) *cobra.Command {
+	var since, format, out string
+	var sectionNames []string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "digest",
+		Short: "Summarize recent CRM activity as a Markdown report",
+		Long: `Run a bundle of predefined searches and render them as one Markdown report,
+ready to post to Slack or send by email.
+
+Sections:
+  new-deals      deals created in the period, with their total amount
+  closed-won     deals closed won in the period, largest first
+  new-contacts   contacts created in the period
+  new-tickets    tickets created in the period
+  stale-tasks    open tasks past their due date, oldest first
+
+--format slack writes Slack's flavor of Markdown, which has no headings.
+With -o json the digest is printed as JSON instead.`,
+		Example: `  # Monday-morning summary of the last week
+  hspt digest
+
+  # Just the deal sections for the last 30 days, as a file
+  hspt digest --since 30d --sections new-deals,closed-won --out digest.md
+
+  # Post to a Slack webhook
+  hspt digest --format slack | jq -Rs '{text: .}' | curl -d @- "$SLACK_WEBHOOK_URL"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			format = strings.ToLower(format)
+			if format != "markdown" && format != "slack" {
+				return fmt.Errorf("invalid --format %q (use markdown or slack)", format)
+			}
+			if limit < 1 {
+				return fmt.Errorf("--limit must be at least 1")
+			}
+			selected, err := parseSections(sectionNames)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			start, err := shared.ParseSince(since, now)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			d := &digest{Since: start, Until: now}
+			for _, s := range selected {
+				result, err := runSection(cmd.Context(), client, s, start, now, limit)
+				if err != nil {
+					return err
+				}
+				d.Sections = append(d.Sections, result)
+			}
+
+			// Owner names need the crm.objects.owners.read scope; without it
+			// the digest shows owner IDs
+			if owners, err := client.GetOwners(cmd.Context()); err == nil {
+				nameOwners(d, owners)
+			}
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(d)
+			}
+
+			data := renderDigest(d, v, format == "slack")
+			if out == "" {
+				_, err = v.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(out, data, 0644); err != nil {
+				return fmt.Errorf("failed to write digest: %w", err)
+			}
+
+			v.Success("Wrote digest of %d section(s) to %s", len(d.Sections), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "7d", "Period to summarize: days (7d), weeks (2w), a duration (36h), or a date (2024-01-31)")
+	cmd.Flags().StringSliceVar(&sectionNames, "sections", defaultSections, "Comma-separated sections to include")
+	cmd.Flags().StringVar(&format, "format", "markdown", "Report format: markdown or slack")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum records listed per section")
+	cmd.Flags().StringVar(&out, "out", "", "File to write the digest to (default: stdout)")
+
+	return cmd
+}
+
+// Register registers the digest command
+func Register(parent *cobra.Command, opts *root.Options) {
+	parent.AddCommand(newCmd(opts))
+}
@@ -0,0 +1,149 @@
+package digest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+func deal(id, name, amount string) api.CRMObject {
+	return api.CRMObject{ID: id, Properties: map[string]interface{}{
+		"dealname":         name,
+		"amount":           amount,
+		"hubspot_owner_id": "7",
+		"createdate":       "2025-06-24T10:00:00Z",
+	}}
+}
+
+func TestParseSections(t *testing.T) {
+	got, err := parseSections([]string{"closed-won, NEW-DEALS", "closed-won"})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "closed-won", got[0].Name)
+	assert.Equal(t, "new-deals", got[1].Name)
+
+	got, err = parseSections(defaultSections)
+	require.NoError(t, err)
+	assert.Len(t, got, 4)
+
+	_, err = parseSections([]string{"new-deals,lost-deals"})
+	assert.ErrorContains(t, err, `unknown section "lost-deals"`)
+
+	_, err = parseSections([]string{" , "})
+	assert.Error(t, err)
+}
+
+func TestSummarize(t *testing.T) {
+	s, err := parseSections([]string{"new-deals"})
+	require.NoError(t, err)
+
+	records := []api.CRMObject{deal("1", "Acme", "1000"), deal("2", "", "250.5"), deal("3", "Globex", "")}
+	result := summarize(s[0], records, 5, 2)
+
+	assert.Equal(t, 5, result.Count, "the count is HubSpot's total")
+	assert.Equal(t, "1250.50", result.Total, "every record is totalled, listed or not")
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, digestItem{ID: "1", Name: "Acme", Amount: "1000", Owner: "7", Date: "2025-06-24T10:00:00Z"}, result.Items[0])
+	assert.Equal(t, "#2", result.Items[1].Name)
+
+	contacts, err := parseSections([]string{"new-contacts"})
+	require.NoError(t, err)
+	result = summarize(contacts[0], []api.CRMObject{
+		{ID: "9", Properties: map[string]interface{}{"firstname": "Jane", "lastname": "Doe", "email": "jane@example.com"}},
+		{ID: "10", Properties: map[string]interface{}{"email": "anon@example.com"}},
+	}, 0, 10)
+	assert.Equal(t, 2, result.Count)
+	assert.Empty(t, result.Total)
+	assert.Equal(t, "Jane Doe", result.Items[0].Name)
+	assert.Equal(t, "anon@example.com", result.Items[1].Name)
+}
+
+func TestRunSection(t *testing.T) {
+	var requests []api.SearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+		var req api.SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		if req.After == "" {
+			w.Write([]byte(`{"total": 3, "results": [
+				{"id": "1", "properties": {"dealname": "Acme", "amount": "100"}},
+				{"id": "2", "properties": {"dealname": "Globex", "amount": "200"}}
+			], "paging": {"next": {"after": "2"}}}`))
+			return
+		}
+		w.Write([]byte(`{"total": 3, "results": [{"id": "3", "properties": {"dealname": "Initech", "amount": "300"}}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	s, err := parseSections([]string{"closed-won"})
+	require.NoError(t, err)
+
+	since := time.Date(2025, 6, 23, 0, 0, 0, 0, time.UTC)
+	now := since.AddDate(0, 0, 7)
+	result, err := runSection(context.Background(), client, s[0], since, now, 1)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 2, "amount sections read every page")
+	filters := requests[0].FilterGroups[0].Filters
+	assert.Equal(t, api.SearchFilter{PropertyName: "hs_is_closed_won", Operator: "EQ", Value: "true"}, filters[0])
+	assert.Equal(t, fmt.Sprint(since.UnixMilli()), filters[1].Value)
+	assert.Contains(t, requests[0].Properties, "amount")
+
+	assert.Equal(t, 3, result.Count)
+	assert.Equal(t, "600.00", result.Total)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "Acme", result.Items[0].Name)
+}
+
+func TestRenderDigest(t *testing.T) {
+	d := &digest{
+		Since: time.Date(2025, 6, 23, 9, 0, 0, 0, time.UTC),
+		Until: time.Date(2025, 6, 30, 9, 0, 0, 0, time.UTC),
+		Sections: []sectionResult{
+			{Name: "new-deals", Title: "New deals", Count: 3, Total: "1250", Items: []digestItem{
+				{ID: "1", Name: "Acme *renewal*", Amount: "1000", Owner: "7", Date: "2025-06-24T10:00:00Z"},
+			}},
+			{Name: "new-tickets", Title: "New tickets", Items: []digestItem{}},
+		},
+	}
+	nameOwners(d, []api.Owner{{ID: "7", FirstName: "Jane", LastName: "Doe"}})
+
+	v := view.New("table", true)
+	v.Location = time.UTC
+	v.Currency = "USD"
+
+	assert.Equal(t, `# HubSpot digest: 2025-06-23 to 2025-06-30
+
+## New deals (3, $1,250.00)
+
+- **Acme \*renewal\*** - $1,000.00 - Jane Doe - 2025-06-24 10:00 UTC
+- _and 2 more_
+
+## New tickets (0)
+
+None.
+`, string(renderDigest(d, v, false)))
+
+	assert.Equal(t, `*HubSpot digest: 2025-06-23 to 2025-06-30*
+
+*New deals (3, $1,250.00)*
+- *Acme \*renewal\** - $1,000.00 - Jane Doe - 2025-06-24 10:00 UTC
+- _and 2 more_
+
+*New tickets (0)*
+None.
+`, string(renderDigest(d, v, true)))
+}
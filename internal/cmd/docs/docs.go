@@ -0,0 +1,85 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the docs command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "docs",
+		Short: "Generate command reference documentation",
+		Long:  "Commands for generating offline reference documentation for hspt.",
+	}
+
+	cmd.AddCommand(newGenerateCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func newGenerateCmd(opts *root.Options) *cobra.Command {
+	var format string
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate man pages or markdown docs",
+		Long: `Walk the full command tree and write one reference page per command.
+
+Man pages are written in section 1 and can be installed under a man path
+(e.g. /usr/local/share/man/man1). Markdown pages link to each other and
+can be published alongside the README.`,
+		Example: `  # Generate markdown docs
+  hspt docs generate --format markdown --out ./docs
+
+  # Generate man pages
+  hspt docs generate --format man --out ./man/man1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if out == "" {
+				return fmt.Errorf("--out is required")
+			}
+
+			rootCmd := cmd.Root()
+			rootCmd.DisableAutoGenTag = true
+
+			if err := os.MkdirAll(out, 0755); err != nil {
+				return fmt.Errorf("failed to create output directory: %w", err)
+			}
+
+			switch format {
+			case "man":
+				header := &doc.GenManHeader{
+					Title:   "HSPT",
+					Section: "1",
+					Source:  "hspt",
+					Manual:  "hspt Manual",
+				}
+				if err := doc.GenManTree(rootCmd, header, out); err != nil {
+					return fmt.Errorf("failed to generate man pages: %w", err)
+				}
+			case "markdown", "md":
+				if err := doc.GenMarkdownTree(rootCmd, out); err != nil {
+					return fmt.Errorf("failed to generate markdown docs: %w", err)
+				}
+			default:
+				return fmt.Errorf("invalid format %q (use man or markdown)", format)
+			}
+
+			v.Success("Generated %s docs in %s", format, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format: man, markdown")
+	cmd.Flags().StringVar(&out, "out", "", "Directory to write the generated files to (required)")
+
+	return cmd
+}
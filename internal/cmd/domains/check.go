@@ -0,0 +1,287 @@
+package domains
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// certWarningWindow is how close to expiry an SSL certificate is reported
+const certWarningWindow = 14 * 24 * time.Hour
+
+// hubIDHeader is set on every response HubSpot's CDN serves for a portal
+const hubIDHeader = "X-Hs-Hub-Id"
+
+// resolver looks up DNS records; net.DefaultResolver in the CLI
+type resolver interface {
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// probe is what DNS and an HTTPS request to a domain show
+type probe struct {
+	CNAME     string   `json:"cname,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+	DNSError  string   `json:"dnsError,omitempty"`
+
+	CertExpires time.Time `json:"certificateExpires,omitempty"`
+	CertIssuer  string    `json:"certificateIssuer,omitempty"`
+	HTTPSError  string    `json:"httpsError,omitempty"`
+	HTTPStatus  int       `json:"httpStatus,omitempty"`
+	// HubID is the portal HubSpot's CDN served the domain for; empty when
+	// the response did not come from HubSpot
+	HubID string `json:"hubId,omitempty"`
+}
+
+// probeDomain looks up host in DNS and requests its home page over HTTPS,
+// without following redirects, to read its certificate and who serves it
+func probeDomain(ctx context.Context, r resolver, client *http.Client, host string) *probe {
+	p := &probe{}
+
+	if cname, err := r.LookupCNAME(ctx, host); err == nil {
+		p.CNAME = strings.TrimSuffix(strings.ToLower(cname), ".")
+		if p.CNAME == host {
+			p.CNAME = ""
+		}
+	}
+	addrs, err := r.LookupHost(ctx, host)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			p.DNSError = "no DNS records"
+		} else {
+			p.DNSError = err.Error()
+		}
+		return p
+	}
+	p.Addresses = addrs
+
+	noRedirects := *client
+	noRedirects.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, "https://"+host+"/", nil)
+	if err != nil {
+		p.HTTPSError = err.Error()
+		return p
+	}
+	resp, err := noRedirects.Do(req)
+	if err != nil {
+		p.HTTPSError = httpsError(err)
+		return p
+	}
+	resp.Body.Close()
+
+	p.HTTPStatus = resp.StatusCode
+	p.HubID = resp.Header.Get(hubIDHeader)
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		cert := resp.TLS.PeerCertificates[0]
+		p.CertExpires = cert.NotAfter
+		p.CertIssuer = cert.Issuer.CommonName
+		if p.CertIssuer == "" && len(cert.Issuer.Organization) > 0 {
+			p.CertIssuer = cert.Issuer.Organization[0]
+		}
+	}
+	return p
+}
+
+// httpsError describes why an HTTPS request failed, naming certificate
+// problems plainly
+func httpsError(err error) string {
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &certErr) {
+		return "certificate not valid: " + certErr.Err.Error()
+	}
+	var urlErr interface{ Unwrap() error }
+	if errors.As(err, &urlErr) && urlErr.Unwrap() != nil {
+		return urlErr.Unwrap().Error()
+	}
+	return err.Error()
+}
+
+// finding levels
+const (
+	levelOK      = "ok"
+	levelWarning = "warning"
+	levelError   = "error"
+)
+
+// finding is the outcome of one check of a domain, with what to do about
+// it when it is a problem
+type finding struct {
+	Check   string `json:"check"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// sameHost compares host names ignoring case and a trailing dot
+func sameHost(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// diagnose checks a domain's HubSpot setup against what DNS and HTTPS show
+func diagnose(d *api.Domain, p *probe, now time.Time) []finding {
+	var findings []finding
+	add := func(check, level, format string, args ...interface{}) {
+		findings = append(findings, finding{Check: check, Level: level, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case p.DNSError != "":
+		if d.ExpectedCname != "" {
+			add("DNS", levelError, "%s: add a CNAME record for %s pointing to %s at your DNS provider", p.DNSError, d.Domain, d.ExpectedCname)
+		} else {
+			add("DNS", levelError, "%s: add the DNS records shown in HubSpot under Settings > Content > Domains & URLs", p.DNSError)
+		}
+	case d.ExpectedCname == "":
+		add("DNS", levelOK, "resolves to %s", strings.Join(p.Addresses, ", "))
+	case p.CNAME == "":
+		add("DNS", levelError, "%s has no CNAME record; point a CNAME to %s, or for a root domain use your DNS provider's ALIAS/ANAME record or redirect it to www", d.Domain, d.ExpectedCname)
+	case !sameHost(p.CNAME, d.ExpectedCname):
+		add("DNS", levelError, "CNAME points to %s, but HubSpot expects %s; change the CNAME record at your DNS provider", p.CNAME, d.ExpectedCname)
+	default:
+		add("DNS", levelOK, "CNAME points to %s", p.CNAME)
+	}
+
+	if d.IsResolving {
+		add("HubSpot", levelOK, "HubSpot sees the domain resolving")
+	} else {
+		add("HubSpot", levelWarning, "HubSpot has not seen the domain resolve yet; DNS changes can take up to 48 hours to be picked up")
+	}
+
+	switch {
+	case p.DNSError != "":
+	case p.HTTPSError != "":
+		add("SSL", levelError, "HTTPS request failed (%s); HubSpot issues the certificate once DNS points to it, so fix DNS first and check that no CAA record blocks the certificate authority", p.HTTPSError)
+	case p.CertExpires.IsZero():
+		add("SSL", levelWarning, "no certificate in the HTTPS response")
+	case !p.CertExpires.After(now):
+		add("SSL", levelError, "certificate expired on %s; HubSpot renews certificates automatically only while DNS points to it", p.CertExpires.Format("2006-01-02"))
+	case p.CertExpires.Sub(now) < certWarningWindow:
+		add("SSL", levelWarning, "certificate expires in %d day(s), on %s; renewal needs DNS to keep pointing to HubSpot", int(p.CertExpires.Sub(now).Hours()/24), p.CertExpires.Format("2006-01-02"))
+	default:
+		add("SSL", levelOK, "certificate from %s valid until %s", p.CertIssuer, p.CertExpires.Format("2006-01-02"))
+	}
+
+	if p.HTTPStatus != 0 {
+		if p.HubID != "" {
+			add("CDN", levelOK, "served by HubSpot's CDN for portal %s (HTTP %d)", p.HubID, p.HTTPStatus)
+		} else {
+			add("CDN", levelWarning, "responds with HTTP %d but not from HubSpot's CDN; remove any proxy or other host in front of the domain", p.HTTPStatus)
+		}
+	}
+
+	if d.IsSslEnabled && !d.IsSslOnly {
+		add("HTTPS only", levelWarning, "plain HTTP is not redirected to HTTPS; turn on Require HTTPS for the domain in HubSpot")
+	}
+
+	return findings
+}
+
+// findDomain finds a portal domain by name or ID
+func findDomain(domains []api.Domain, nameOrID string) *api.Domain {
+	for i := range domains {
+		if domains[i].ID == nameOrID || sameHost(domains[i].Domain, nameOrID) {
+			return &domains[i]
+		}
+	}
+	return nil
+}
+
+// probeClient returns the HTTP client domain probes use, honoring the
+// global TLS and proxy flags
+func probeClient(opts *root.Options) (*http.Client, error) {
+	client, err := opts.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	if client.Timeout == 0 || client.Timeout > probeTimeout {
+		client.Timeout = probeTimeout
+	}
+	return client, nil
+}
+
+// probeTimeout bounds an HTTPS probe of a domain
+const probeTimeout = 10 * time.Second
+
+func newCheckCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check <domain>",
+		Short: "Check a domain's DNS, SSL, and CDN setup",
+		Long: `Check a domain connected to HubSpot: look up its DNS records and compare
+them with what HubSpot expects, request it over HTTPS to check its SSL
+certificate and that HubSpot's CDN serves it, and say what to change for
+each problem found.
+
+The command fails when any check finds an error.`,
+		Example: `  # Check a domain by name
+  hspt domains check www.example.com
+
+  # By ID, as JSON
+  hspt domains check 12345 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			domains, err := client.ListDomains(cmd.Context(), api.ListOptions{All: true})
+			if err != nil {
+				return fmt.Errorf("failed to list domains: %w", err)
+			}
+			d := findDomain(domains.Results, name)
+			if d == nil {
+				v.Error("Domain %s is not connected to this portal; connect it under Settings > Content > Domains & URLs", name)
+				return nil
+			}
+
+			httpClient, err := probeClient(opts)
+			if err != nil {
+				return err
+			}
+			p := probeDomain(cmd.Context(), net.DefaultResolver, httpClient, d.Domain)
+			findings := diagnose(d, p, time.Now())
+
+			headers := []string{"CHECK", "STATUS", "DETAIL"}
+			rows := make([][]string, 0, len(findings))
+			problems := 0
+			for _, f := range findings {
+				rows = append(rows, []string{f.Check, f.Level, f.Message})
+				if f.Level == levelError {
+					problems++
+				}
+			}
+			data := struct {
+				Domain   string    `json:"domain"`
+				ID       string    `json:"id"`
+				Probe    *probe    `json:"probe"`
+				Findings []finding `json:"findings"`
+			}{d.Domain, d.ID, p, findings}
+			if err := v.Render(headers, rows, data); err != nil {
+				return err
+			}
+
+			if problems > 0 {
+				return fmt.Errorf("%d problem(s) found with %s", problems, d.Domain)
+			}
+			v.Success("%s is set up correctly", d.Domain)
+			return nil
+		},
+	}
+
+	return cmd
+}
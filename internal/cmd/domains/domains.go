@@ -1,10 +1,15 @@
 package domains
 
 import (
+	"fmt"
+	"net"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the domains command and subcommands
@@ -12,11 +17,12 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "domains",
 		Short: "Manage HubSpot domains",
-		Long:  "Commands for listing and viewing domains configured in HubSpot.",
+		Long:  "Commands for listing, viewing, and checking domains configured in HubSpot.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newCheckCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -24,6 +30,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -42,10 +49,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListDomains(api.ListOptions{
+			result, err := client.ListDomains(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -83,16 +90,26 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of domains to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
+	var noProbe bool
+
+	cmd := &cobra.Command{
 		Use:   "get <id>",
 		Short: "Get a domain by ID",
-		Long:  "Retrieve a single domain by its ID.",
+		Long: `Retrieve a single domain by its ID.
+
+Unless --no-probe is set, the domain is also requested over HTTPS to show
+when its SSL certificate expires and whether HubSpot's CDN serves it.`,
 		Example: `  # Get domain by ID
-  hspt domains get 12345`,
+  hspt domains get 12345
+
+  # Only what HubSpot has on record
+  hspt domains get 12345 --no-probe`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
@@ -103,7 +120,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			domain, err := client.GetDomain(id)
+			domain, err := client.GetDomain(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Domain %s not found", id)
@@ -119,6 +136,9 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"SSL Enabled", formatBool(domain.IsSslEnabled)},
 				{"SSL Only", formatBool(domain.IsSslOnly)},
 				{"Resolving", formatBool(domain.IsResolving)},
+				{"DNS Correct", formatBool(domain.IsDNSCorrect)},
+				{"Setup Complete", formatBool(domain.IsSetupComplete)},
+				{"Expected CNAME", valueOrDash(domain.ExpectedCname)},
 				{"Primary Site Page", formatBool(domain.PrimarySitePage)},
 				{"Primary Landing Page", formatBool(domain.PrimaryLandingPage)},
 				{"Primary Blog Post", formatBool(domain.PrimaryBlogPost)},
@@ -127,13 +147,70 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Used for Landing Pages", formatBool(domain.IsUsedForLandingPage)},
 				{"Used for Blog Posts", formatBool(domain.IsUsedForBlogPost)},
 				{"Used for Email", formatBool(domain.IsUsedForEmail)},
-				{"Created", domain.CreatedAt},
-				{"Updated", domain.UpdatedAt},
+				{"Created", v.Time(domain.CreatedAt)},
+				{"Updated", v.Time(domain.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, domain)
+			if noProbe {
+				return v.Render(headers, rows, domain)
+			}
+
+			httpClient, err := probeClient(opts)
+			if err != nil {
+				return err
+			}
+			p := probeDomain(cmd.Context(), net.DefaultResolver, httpClient, domain.Domain)
+			rows = append(rows,
+				[]string{"SSL Expires", sslExpiry(p, time.Now())},
+				[]string{"CDN", cdnStatus(p)},
+			)
+
+			data := struct {
+				*api.Domain
+				Probe *probe `json:"probe"`
+			}{domain, p}
+			return v.Render(headers, rows, data)
 		},
 	}
+
+	cmd.Flags().BoolVar(&noProbe, "no-probe", false, "Do not request the domain to check its certificate and CDN")
+
+	return cmd
+}
+
+// sslExpiry describes a probed domain's certificate expiry
+func sslExpiry(p *probe, now time.Time) string {
+	switch {
+	case p.DNSError != "":
+		return "unknown (" + p.DNSError + ")"
+	case p.HTTPSError != "":
+		return "unknown (" + p.HTTPSError + ")"
+	case p.CertExpires.IsZero():
+		return "-"
+	case !p.CertExpires.After(now):
+		return p.CertExpires.Format("2006-01-02") + " (expired)"
+	default:
+		return fmt.Sprintf("%s (in %d days)", p.CertExpires.Format("2006-01-02"), int(p.CertExpires.Sub(now).Hours()/24))
+	}
+}
+
+// cdnStatus describes whether HubSpot's CDN served a probed domain
+func cdnStatus(p *probe) string {
+	switch {
+	case p.HTTPStatus == 0:
+		return "unknown"
+	case p.HubID != "":
+		return fmt.Sprintf("HubSpot (portal %s, HTTP %d)", p.HubID, p.HTTPStatus)
+	default:
+		return fmt.Sprintf("not HubSpot (HTTP %d)", p.HTTPStatus)
+	}
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
 }
 
 func formatBool(b bool) string {
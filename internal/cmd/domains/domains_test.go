@@ -0,0 +1,192 @@
+package domains
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+type fakeResolver struct {
+	cname string
+	addrs []string
+	err   error
+}
+
+func (r fakeResolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	if r.cname == "" {
+		return host + ".", nil
+	}
+	return r.cname, nil
+}
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return r.addrs, r.err
+}
+
+func TestProbeDomain(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		w.Header().Set("X-HS-Hub-ID", "123456")
+		http.Redirect(w, r, "/home", http.StatusMovedPermanently)
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	r := fakeResolver{cname: "123456.group1.sites.hscoscdn-na1.net.", addrs: []string{"127.0.0.1"}}
+
+	p := probeDomain(context.Background(), r, server.Client(), host)
+	assert.Equal(t, "123456.group1.sites.hscoscdn-na1.net", p.CNAME)
+	assert.Equal(t, []string{"127.0.0.1"}, p.Addresses)
+	assert.Empty(t, p.HTTPSError)
+	assert.Equal(t, http.StatusMovedPermanently, p.HTTPStatus, "redirects are not followed")
+	assert.Equal(t, "123456", p.HubID)
+	assert.False(t, p.CertExpires.IsZero())
+}
+
+func TestProbeDomain_Failures(t *testing.T) {
+	t.Run("no DNS records", func(t *testing.T) {
+		r := fakeResolver{err: &net.DNSError{Err: "no such host", Name: "www.example.com", IsNotFound: true}}
+		p := probeDomain(context.Background(), r, http.DefaultClient, "www.example.com")
+		assert.Equal(t, "no DNS records", p.DNSError)
+		assert.Zero(t, p.HTTPStatus)
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		host := strings.TrimPrefix(server.URL, "https://")
+		p := probeDomain(context.Background(), fakeResolver{addrs: []string{"127.0.0.1"}}, &http.Client{}, host)
+		assert.Empty(t, p.CNAME)
+		assert.Contains(t, p.HTTPSError, "certificate not valid")
+	})
+}
+
+func TestDiagnose(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	domain := func() *api.Domain {
+		return &api.Domain{
+			Domain:        "www.example.com",
+			ExpectedCname: "123456.group1.sites.hscoscdn-na1.net",
+			IsResolving:   true,
+			IsSslEnabled:  true,
+			IsSslOnly:     true,
+		}
+	}
+	healthy := func() *probe {
+		return &probe{
+			CNAME:       "123456.group1.sites.hscoscdn-na1.net",
+			Addresses:   []string{"199.60.103.2"},
+			CertExpires: now.AddDate(0, 2, 0),
+			CertIssuer:  "R11",
+			HTTPStatus:  200,
+			HubID:       "123456",
+		}
+	}
+	levels := func(findings []finding) map[string]string {
+		m := make(map[string]string)
+		for _, f := range findings {
+			m[f.Check] = f.Level
+		}
+		return m
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		findings := diagnose(domain(), healthy(), now)
+		assert.Equal(t, map[string]string{"DNS": levelOK, "HubSpot": levelOK, "SSL": levelOK, "CDN": levelOK}, levels(findings))
+	})
+
+	t.Run("wrong CNAME", func(t *testing.T) {
+		p := healthy()
+		p.CNAME = "old-host.example.net"
+		findings := diagnose(domain(), p, now)
+		require.Equal(t, levelError, findings[0].Level)
+		assert.Contains(t, findings[0].Message, "CNAME points to old-host.example.net, but HubSpot expects 123456.group1.sites.hscoscdn-na1.net")
+	})
+
+	t.Run("no CNAME", func(t *testing.T) {
+		p := healthy()
+		p.CNAME = ""
+		findings := diagnose(domain(), p, now)
+		assert.Equal(t, levelError, findings[0].Level)
+		assert.Contains(t, findings[0].Message, "has no CNAME record")
+	})
+
+	t.Run("no DNS records", func(t *testing.T) {
+		d := domain()
+		d.IsResolving = false
+		findings := diagnose(d, &probe{DNSError: "no DNS records"}, now)
+		got := levels(findings)
+		assert.Equal(t, map[string]string{"DNS": levelError, "HubSpot": levelWarning}, got)
+		assert.Contains(t, findings[0].Message, "add a CNAME record for www.example.com pointing to 123456.group1.sites.hscoscdn-na1.net")
+	})
+
+	t.Run("certificate expiring", func(t *testing.T) {
+		p := healthy()
+		p.CertExpires = now.AddDate(0, 0, 5)
+		findings := diagnose(domain(), p, now)
+		assert.Equal(t, levelWarning, levels(findings)["SSL"])
+		assert.Contains(t, findings[2].Message, "expires in 5 day(s)")
+	})
+
+	t.Run("certificate expired", func(t *testing.T) {
+		p := healthy()
+		p.CertExpires = now.AddDate(0, 0, -1)
+		assert.Equal(t, levelError, levels(diagnose(domain(), p, now))["SSL"])
+	})
+
+	t.Run("HTTPS failure", func(t *testing.T) {
+		p := healthy()
+		p.HTTPSError = "certificate not valid: x509: certificate is valid for other.example.com"
+		p.HTTPStatus, p.HubID = 0, ""
+		got := levels(diagnose(domain(), p, now))
+		assert.Equal(t, levelError, got["SSL"])
+		assert.NotContains(t, got, "CDN")
+	})
+
+	t.Run("not served by HubSpot", func(t *testing.T) {
+		p := healthy()
+		p.HubID = ""
+		assert.Equal(t, levelWarning, levels(diagnose(domain(), p, now))["CDN"])
+	})
+
+	t.Run("HTTPS not required", func(t *testing.T) {
+		d := domain()
+		d.IsSslOnly = false
+		assert.Equal(t, levelWarning, levels(diagnose(d, healthy(), now))["HTTPS only"])
+	})
+}
+
+func TestFindDomain(t *testing.T) {
+	domains := []api.Domain{{ID: "1", Domain: "www.example.com"}, {ID: "2", Domain: "blog.example.com"}}
+
+	assert.Equal(t, "2", findDomain(domains, "BLOG.example.com.").ID)
+	assert.Equal(t, "www.example.com", findDomain(domains, "1").Domain)
+	assert.Nil(t, findDomain(domains, "shop.example.com"))
+}
+
+func TestSSLExpiryAndCDNStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2025-07-01 (in 30 days)", sslExpiry(&probe{CertExpires: now.AddDate(0, 0, 30)}, now))
+	assert.Equal(t, "2025-05-31 (expired)", sslExpiry(&probe{CertExpires: now.AddDate(0, 0, -1)}, now))
+	assert.Equal(t, "unknown (no DNS records)", sslExpiry(&probe{DNSError: "no DNS records"}, now))
+
+	assert.Equal(t, "HubSpot (portal 123456, HTTP 200)", cdnStatus(&probe{HTTPStatus: 200, HubID: "123456"}))
+	assert.Equal(t, "not HubSpot (HTTP 403)", cdnStatus(&probe{HTTPStatus: 403}))
+	assert.Equal(t, "unknown", cdnStatus(&probe{}))
+}
+
+func TestGetPrimaryUses(t *testing.T) {
+	assert.Equal(t, "Site, Blog", getPrimaryUses(&api.Domain{PrimarySitePage: true, PrimaryBlogPost: true}))
+	assert.Equal(t, "-", getPrimaryUses(&api.Domain{}))
+}
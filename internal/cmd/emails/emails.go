@@ -9,6 +9,7 @@ import (
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for emails
@@ -36,6 +37,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -58,11 +60,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeEmails, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeEmails, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -80,7 +82,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 					truncate(obj.GetProperty("hs_email_subject"), 40),
 					obj.GetProperty("hs_email_direction"),
 					obj.GetProperty("hs_email_status"),
-					obj.GetProperty("hs_timestamp"),
+					v.Time(obj.GetProperty("hs_timestamp")),
 				})
 			}
 
@@ -100,11 +102,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -126,7 +131,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeEmails, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeEmails, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Email %s not found", id)
@@ -142,24 +147,29 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Text", truncate(obj.GetProperty("hs_email_text"), 100)},
 				{"Direction", obj.GetProperty("hs_email_direction")},
 				{"Status", obj.GetProperty("hs_email_status")},
-				{"Timestamp", obj.GetProperty("hs_timestamp")},
+				{"Timestamp", v.Time(obj.GetProperty("hs_timestamp"))},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
 	var subject, text, direction, status, timestamp, ownerID string
-	var props []string
+	var props, associate []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -169,10 +179,23 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   hspt emails create --subject "Follow-up" --text "Email body content" --direction EMAIL
 
   # Create with status
-  hspt emails create --subject "Proposal" --direction EMAIL --status SENT`,
+  hspt emails create --subject "Proposal" --direction EMAIL --status SENT
+
+  # Attach to records
+  hspt emails create --subject "Proposal" --associate contacts:123 --associate deals:456`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			values, fromContext := shared.WithRecordContext(cmd, associate)
+			values, err := shared.ResolveAssociateValues(cmd, opts, values)
+			if err != nil {
+				return err
+			}
+			associations, err := shared.ParseAssociations(api.ObjectTypeEmails, values)
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -209,12 +232,15 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeEmails, properties)
+			obj, err := client.CreateObjectWithAssociations(cmd.Context(), api.ObjectTypeEmails, properties, associations)
 			if err != nil {
 				return err
 			}
 
 			v.Success("Email created with ID: %s", obj.ID)
+			if len(fromContext) > 0 {
+				v.Info("Associated from context: %s", strings.Join(fromContext, ", "))
+			}
 
 			headers := []string{"PROPERTY", "VALUE"}
 			rows := [][]string{
@@ -234,6 +260,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&timestamp, "timestamp", "", "Email timestamp (Unix milliseconds)")
 	cmd.Flags().StringVar(&ownerID, "owner-id", "", "HubSpot owner ID")
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
+	shared.AddAssociateFlag(cmd, &associate)
 
 	return cmd
 }
@@ -289,7 +316,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeEmails, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeEmails, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Email %s not found", id)
@@ -341,7 +368,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeEmails, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeEmails, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Email %s not found", id)
 					return nil
@@ -375,13 +402,13 @@ func newSearchCmd(opts *root.Options) *cobra.Command {
   hspt emails search --filter "hubspot_owner_id=77999105" --filter "hs_timestamp:BETWEEN:2026-01-01:2026-03-01"`,
 		DefaultProperties: DefaultProperties,
 		Headers:           []string{"ID", "SUBJECT", "DIRECTION", "STATUS", "TIMESTAMP"},
-		Row: func(obj api.CRMObject) []string {
+		Row: func(v *view.View, obj api.CRMObject) []string {
 			return []string{
 				obj.ID,
 				truncate(obj.GetProperty("hs_email_subject"), 40),
 				obj.GetProperty("hs_email_direction"),
 				obj.GetProperty("hs_email_status"),
-				obj.GetProperty("hs_timestamp"),
+				v.Time(obj.GetProperty("hs_timestamp")),
 			}
 		},
 	})
@@ -0,0 +1,237 @@
+package events
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// eventPropertyTypes are the types a custom event property can have
+var eventPropertyTypes = []string{"string", "number", "enumeration", "datetime"}
+
+// labelFor turns an internal name such as seat_count into a label such as
+// "Seat count"
+func labelFor(name string) string {
+	label := strings.TrimSpace(strings.ReplaceAll(name, "_", " "))
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// parsePropertyDefinition reads a --property value: name, name:type, or
+// name:enumeration:value1|value2
+func parsePropertyDefinition(raw string) (api.EventPropertyDefinition, error) {
+	parts := strings.SplitN(raw, ":", 3)
+	name := strings.TrimSpace(parts[0])
+	if name == "" {
+		return api.EventPropertyDefinition{}, fmt.Errorf("invalid --property %q: use name, name:type, or name:enumeration:a|b", raw)
+	}
+
+	prop := api.EventPropertyDefinition{Name: name, Label: labelFor(name), Type: "string"}
+	if len(parts) > 1 {
+		prop.Type = strings.ToLower(strings.TrimSpace(parts[1]))
+	}
+
+	if !slices.Contains(eventPropertyTypes, prop.Type) {
+		return api.EventPropertyDefinition{}, fmt.Errorf("invalid --property %q: type must be one of %s", raw, strings.Join(eventPropertyTypes, ", "))
+	}
+
+	if prop.Type == "enumeration" {
+		if len(parts) < 3 || strings.TrimSpace(parts[2]) == "" {
+			return api.EventPropertyDefinition{}, fmt.Errorf("invalid --property %q: list an enumeration's values as name:enumeration:a|b", raw)
+		}
+		for _, value := range strings.Split(parts[2], "|") {
+			if value = strings.TrimSpace(value); value != "" {
+				prop.Options = append(prop.Options, api.EventPropertyOption{Label: labelFor(value), Value: value})
+			}
+		}
+	} else if len(parts) == 3 {
+		return api.EventPropertyDefinition{}, fmt.Errorf("invalid --property %q: only enumerations list values", raw)
+	}
+
+	return prop, nil
+}
+
+// primaryObjects maps plural object type names to the singular names event
+// definitions use
+var primaryObjects = map[string]string{
+	"CONTACTS":  "CONTACT",
+	"COMPANIES": "COMPANY",
+	"DEALS":     "DEAL",
+	"TICKETS":   "TICKET",
+}
+
+// primaryObject returns the events API name of a --primary-object value
+func primaryObject(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if singular, ok := primaryObjects[s]; ok {
+		return singular
+	}
+	return s
+}
+
+func newDefinitionsCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "definitions",
+		Short: "Manage custom event definitions",
+		Long:  "Commands for listing and creating the definitions of custom behavioral events, which must exist before events are sent.",
+	}
+
+	cmd.AddCommand(newDefinitionsListCmd(opts))
+	cmd.AddCommand(newDefinitionsCreateCmd(opts))
+
+	return cmd
+}
+
+func newDefinitionsListCmd(opts *root.Options) *cobra.Command {
+	var search, after string
+	var limit int
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List custom event definitions",
+		Long:  "List the portal's custom behavioral event definitions with their internal names, which events are sent and queried by.",
+		Example: `  # List event definitions
+  hspt events definitions list
+
+  # Find an event by name or label
+  hspt events definitions list --search signup`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListEventDefinitions(cmd.Context(), search, pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list event definitions: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No event definitions found")
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "LABEL", "OBJECT", "PROPERTIES", "CREATED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, def := range result.Results {
+				rows = append(rows, []string{
+					def.ID,
+					def.FullyQualifiedName,
+					def.Labels.Singular,
+					def.PrimaryObject,
+					strconv.Itoa(len(def.Properties)),
+					v.Time(def.CreatedAt),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&search, "search", "", "Only definitions whose name or label contains this")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of definitions to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newDefinitionsCreateCmd(opts *root.Options) *cobra.Command {
+	var name, label, description, object string
+	var properties []string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a custom event definition",
+		Long: `Create a custom behavioral event definition. HubSpot prefixes the name with
+pe<portal ID>_ to make the internal name events are sent with.
+
+Properties are name, name:type, or name:enumeration:value1|value2, where type
+is string (the default), number, enumeration, or datetime.`,
+		Example: `  # A contact event with no properties
+  hspt events definitions create --name signed_up --label "Signed up"
+
+  # With typed properties
+  hspt events definitions create --name upgraded_plan --property seats:number --property "plan:enumeration:starter|pro|enterprise"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			name = strings.TrimSpace(name)
+			if name == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if label == "" {
+				label = labelFor(name)
+			}
+
+			input := api.EventDefinitionInput{
+				Name:          name,
+				Label:         label,
+				Description:   description,
+				PrimaryObject: primaryObject(object),
+			}
+			for _, raw := range properties {
+				prop, err := parsePropertyDefinition(raw)
+				if err != nil {
+					return err
+				}
+				input.PropertyDefinitions = append(input.PropertyDefinitions, prop)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			def, err := client.CreateEventDefinition(cmd.Context(), input)
+			if err != nil {
+				return fmt.Errorf("failed to create event definition: %w", err)
+			}
+
+			v.Success("Created event definition %s", def.FullyQualifiedName)
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", def.ID},
+				{"Name", def.FullyQualifiedName},
+				{"Label", def.Labels.Singular},
+				{"Object", def.PrimaryObject},
+				{"Properties", strconv.Itoa(len(def.Properties))},
+			}
+
+			return v.Render(headers, rows, def)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Internal name, without the pe<portal ID>_ prefix (required)")
+	cmd.Flags().StringVar(&label, "label", "", "Label shown in HubSpot (default: from the name)")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the event")
+	cmd.Flags().StringVar(&object, "primary-object", "contact", "Type of record that completes the event (contact, company, deal, ticket)")
+	cmd.Flags().StringArrayVar(&properties, "property", nil, "Event property as name, name:type, or name:enumeration:a|b (repeatable)")
+
+	return cmd
+}
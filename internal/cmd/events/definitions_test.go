@@ -0,0 +1,48 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestLabelFor(t *testing.T) {
+	assert.Equal(t, "Seat count", labelFor("seat_count"))
+	assert.Equal(t, "Plan", labelFor("plan"))
+	assert.Empty(t, labelFor(""))
+}
+
+func TestParsePropertyDefinition(t *testing.T) {
+	prop, err := parsePropertyDefinition("plan_name")
+	require.NoError(t, err)
+	assert.Equal(t, api.EventPropertyDefinition{Name: "plan_name", Label: "Plan name", Type: "string"}, prop)
+
+	prop, err = parsePropertyDefinition("seats:Number")
+	require.NoError(t, err)
+	assert.Equal(t, "number", prop.Type)
+
+	prop, err = parsePropertyDefinition("tier:enumeration:starter|pro")
+	require.NoError(t, err)
+	assert.Equal(t, []api.EventPropertyOption{{Label: "Starter", Value: "starter"}, {Label: "Pro", Value: "pro"}}, prop.Options)
+
+	_, err = parsePropertyDefinition("tier:enumeration")
+	assert.ErrorContains(t, err, "enumeration's values")
+
+	_, err = parsePropertyDefinition("seats:integer")
+	assert.ErrorContains(t, err, "type must be one of")
+
+	_, err = parsePropertyDefinition("seats:number:1|2")
+	assert.ErrorContains(t, err, "only enumerations")
+
+	_, err = parsePropertyDefinition(":number")
+	assert.Error(t, err)
+}
+
+func TestPrimaryObject(t *testing.T) {
+	assert.Equal(t, "CONTACT", primaryObject("contact"))
+	assert.Equal(t, "COMPANY", primaryObject("companies"))
+	assert.Equal(t, "2-123", primaryObject("2-123"))
+}
@@ -0,0 +1,22 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the events command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Analyze and send behavioral events",
+		Long:  "Commands for analyzing the behavioral events contacts complete, such as custom product events, and for defining and sending custom events.",
+	}
+
+	cmd.AddCommand(newFunnelCmd(opts))
+	cmd.AddCommand(newDefinitionsCmd(opts))
+	cmd.AddCommand(newSendCmd(opts))
+
+	parent.AddCommand(cmd)
+}
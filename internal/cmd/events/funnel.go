@@ -0,0 +1,251 @@
+package events
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// funnelStep is one step of a conversion funnel
+type funnelStep struct {
+	Step      int    `json:"step"`
+	EventType string `json:"eventType"`
+	Contacts  int    `json:"contacts"`
+	// StepRate is the share of the previous step's contacts that went on to
+	// complete this one; OverallRate is the share of the first step's
+	StepRate    float64 `json:"stepRate"`
+	OverallRate float64 `json:"overallRate"`
+	// MedianTime is the median time from the previous step, in seconds
+	MedianTime float64 `json:"medianSeconds,omitempty"`
+}
+
+var qualifiedEventPattern = regexp.MustCompile(`^(pe\d+_|e_)`)
+
+// eventTypeName turns a step into the event's internal name. Custom events
+// are named pe<portal ID>_<name>, so bare names get the portal's prefix;
+// qualified names, and standard events (e_...), are used as they are.
+func eventTypeName(step string, portalID int64) string {
+	if qualifiedEventPattern.MatchString(step) || portalID == 0 {
+		return step
+	}
+	return fmt.Sprintf("pe%d_%s", portalID, step)
+}
+
+// parseSteps splits --steps into event names, in order
+func parseSteps(raw []string) ([]string, error) {
+	var steps []string
+	for _, r := range raw {
+		for _, s := range strings.Split(r, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				steps = append(steps, s)
+			}
+		}
+	}
+	if len(steps) < 2 {
+		return nil, fmt.Errorf("--steps needs at least two events")
+	}
+	return steps, nil
+}
+
+// buildFunnel counts the contacts that completed each step in order: a
+// contact reaches a step by completing its event at or after the time they
+// reached the previous one. completions holds each step's events.
+func buildFunnel(eventTypes []string, completions [][]api.Event) []funnelStep {
+	// Each contact's completion times of a step, earliest first
+	times := make([]map[string][]time.Time, len(completions))
+	for i, events := range completions {
+		times[i] = make(map[string][]time.Time)
+		for _, e := range events {
+			times[i][e.ObjectID] = append(times[i][e.ObjectID], e.OccurredAt)
+		}
+		for _, ts := range times[i] {
+			sort.Slice(ts, func(a, b int) bool { return ts[a].Before(ts[b]) })
+		}
+	}
+
+	steps := make([]funnelStep, len(eventTypes))
+	var reached map[string]time.Time
+	for i := range eventTypes {
+		next := make(map[string]time.Time)
+		var gaps []float64
+		if i == 0 {
+			for id, ts := range times[0] {
+				next[id] = ts[0]
+			}
+		} else {
+			for id, prev := range reached {
+				for _, t := range times[i][id] {
+					if !t.Before(prev) {
+						next[id] = t
+						gaps = append(gaps, t.Sub(prev).Seconds())
+						break
+					}
+				}
+			}
+		}
+
+		steps[i] = funnelStep{Step: i + 1, EventType: eventTypes[i], Contacts: len(next), MedianTime: median(gaps)}
+		if i == 0 {
+			if len(next) > 0 {
+				steps[i].StepRate, steps[i].OverallRate = 1, 1
+			}
+		} else {
+			steps[i].StepRate = rate(len(next), len(reached))
+			steps[i].OverallRate = rate(len(next), steps[0].Contacts)
+		}
+		reached = next
+	}
+	return steps
+}
+
+func rate(n, of int) float64 {
+	if of == 0 {
+		return 0
+	}
+	return float64(n) / float64(of)
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+// formatRate formats a conversion rate as a percentage
+func formatRate(r float64) string {
+	return strconv.FormatFloat(r*100, 'f', 1, 64) + "%"
+}
+
+// formatGap formats a median time between steps, rounded for reading
+func formatGap(seconds float64) string {
+	if seconds == 0 {
+		return ""
+	}
+	d := time.Duration(seconds * float64(time.Second))
+	switch {
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%.1fd", d.Hours()/24)
+	case d >= time.Hour:
+		return d.Round(time.Minute).String()
+	default:
+		return d.Round(time.Second).String()
+	}
+}
+
+func newFunnelCmd(opts *root.Options) *cobra.Command {
+	var stepArgs []string
+	var since string
+
+	cmd := &cobra.Command{
+		Use:   "funnel",
+		Short: "Compute a conversion funnel from event completions",
+		Long: `Count how many contacts completed each of a sequence of behavioral events,
+in order, and the conversion rate from step to step.
+
+A contact reaches a step by completing its event at or after the time they
+reached the previous step, within the --since window. Every completion of
+each event in the window is fetched, so long windows on busy events take
+many requests; --api-budget caps them.
+
+Steps are event internal names. Custom event names without the
+pe<portal ID>_ prefix get the portal's prefix; standard events start with
+e_ and are used as given.`,
+		Example: `  # Trial funnel over the last 90 days
+  hspt events funnel --steps "viewed_pricing,started_trial,became_customer" --since 90d
+
+  # Fully qualified custom events since a date, as JSON
+  hspt events funnel --steps pe123456_viewed_pricing,pe123456_started_trial --since 2024-01-01 -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			steps, err := parseSteps(stepArgs)
+			if err != nil {
+				return err
+			}
+			start, err := shared.ParseSince(since, time.Now())
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var portalID int64
+			for _, s := range steps {
+				if !qualifiedEventPattern.MatchString(s) {
+					account, err := client.GetAccountDetails(ctx)
+					if err != nil {
+						return fmt.Errorf("failed to get portal ID for event names: %w", err)
+					}
+					portalID = account.PortalID
+					break
+				}
+			}
+
+			eventTypes := make([]string, len(steps))
+			completions := make([][]api.Event, len(steps))
+			for i, s := range steps {
+				eventTypes[i] = eventTypeName(s, portalID)
+				result, err := client.ListEvents(ctx, api.EventQuery{
+					EventType:     eventTypes[i],
+					ObjectType:    "contact",
+					OccurredAfter: start,
+				}, api.ListOptions{All: true})
+				if err != nil {
+					return fmt.Errorf("failed to list %s events: %w", eventTypes[i], err)
+				}
+				if result.Paging != nil {
+					v.Warning("Only some %s events were read before the API budget ran out", eventTypes[i])
+				}
+				completions[i] = result.Results
+			}
+
+			funnel := buildFunnel(eventTypes, completions)
+
+			headers := []string{"STEP", "EVENT", "CONTACTS", "STEP RATE", "OVERALL", "MEDIAN TIME"}
+			rows := make([][]string, 0, len(funnel))
+			for _, f := range funnel {
+				rows = append(rows, []string{
+					strconv.Itoa(f.Step),
+					f.EventType,
+					strconv.Itoa(f.Contacts),
+					formatRate(f.StepRate),
+					formatRate(f.OverallRate),
+					formatGap(f.MedianTime),
+				})
+			}
+
+			if err := v.Render(headers, rows, funnel); err != nil {
+				return err
+			}
+			if funnel[0].Contacts == 0 {
+				v.Info("No contacts completed %s since %s", eventTypes[0], start.Format("2006-01-02"))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&stepArgs, "steps", nil, "Events in funnel order (comma-separated or repeatable; at least two)")
+	cmd.Flags().StringVar(&since, "since", "30d", "Only count events since then: days (90d), weeks (12w), a duration (36h), or a date")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestEventTypeName(t *testing.T) {
+	assert.Equal(t, "pe123_viewed_pricing", eventTypeName("viewed_pricing", 123))
+	assert.Equal(t, "pe999_viewed_pricing", eventTypeName("pe999_viewed_pricing", 123))
+	assert.Equal(t, "e_visited_page", eventTypeName("e_visited_page", 123))
+	assert.Equal(t, "viewed_pricing", eventTypeName("viewed_pricing", 0))
+}
+
+func TestParseSteps(t *testing.T) {
+	steps, err := parseSteps([]string{"a, b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, steps)
+
+	_, err = parseSteps([]string{"a"})
+	assert.ErrorContains(t, err, "at least two")
+}
+
+func TestBuildFunnel(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	event := func(id string, d int) api.Event { return api.Event{ObjectID: id, OccurredAt: day(d)} }
+
+	completions := [][]api.Event{
+		// Four contacts view pricing; contact 1 twice
+		{event("1", 5), event("1", 1), event("2", 2), event("3", 3), event("4", 4)},
+		// Contact 3 started a trial before viewing pricing, so does not count;
+		// contact 5 never viewed pricing
+		{event("1", 2), event("2", 6), event("3", 1), event("5", 1)},
+		{event("1", 10), event("2", 3)},
+	}
+
+	funnel := buildFunnel([]string{"view", "trial", "buy"}, completions)
+	require.Len(t, funnel, 3)
+
+	assert.Equal(t, 4, funnel[0].Contacts)
+	assert.Equal(t, 1.0, funnel[0].StepRate)
+
+	assert.Equal(t, 2, funnel[1].Contacts)
+	assert.Equal(t, 0.5, funnel[1].StepRate)
+	assert.Equal(t, 0.5, funnel[1].OverallRate)
+	// Contact 1 took a day, contact 2 four days
+	assert.Equal(t, (2.5 * 24 * time.Hour).Seconds(), funnel[1].MedianTime)
+
+	// Contact 2 bought before their trial, so only contact 1 converts
+	assert.Equal(t, 1, funnel[2].Contacts)
+	assert.Equal(t, 0.5, funnel[2].StepRate)
+	assert.Equal(t, 0.25, funnel[2].OverallRate)
+}
+
+func TestBuildFunnel_NoContacts(t *testing.T) {
+	funnel := buildFunnel([]string{"a", "b"}, [][]api.Event{nil, nil})
+	assert.Equal(t, 0, funnel[0].Contacts)
+	assert.Equal(t, 0.0, funnel[0].StepRate)
+	assert.Equal(t, 0.0, funnel[1].OverallRate)
+}
+
+func TestFormatGap(t *testing.T) {
+	assert.Equal(t, "", formatGap(0))
+	assert.Equal(t, "45s", formatGap(45))
+	assert.Equal(t, "5h30m0s", formatGap((5*time.Hour + 30*time.Minute).Seconds()))
+	assert.Equal(t, "2.5d", formatGap((60 * time.Hour).Seconds()))
+	assert.Equal(t, "12.5%", formatRate(0.125))
+}
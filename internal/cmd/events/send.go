@@ -0,0 +1,124 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// parseEventProps reads --prop key=value flags into event properties
+func parseEventProps(raw []string) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	props := make(map[string]string, len(raw))
+	for _, p := range raw {
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid --prop %q: use key=value", p)
+		}
+		props[strings.TrimSpace(parts[0])] = parts[1]
+	}
+	return props, nil
+}
+
+// parseOccurredAt reads --occurred-at: an RFC 3339 timestamp or a date
+func parseOccurredAt(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid --occurred-at %q: use a timestamp (2024-01-31T09:00:00Z) or a date (2024-01-31)", s)
+}
+
+func newSendCmd(opts *root.Options) *cobra.Command {
+	var name, email, utk, objectID, occurredAt string
+	var props []string
+
+	cmd := &cobra.Command{
+		Use:   "send",
+		Short: "Send a custom event",
+		Long: `Record one completion of a custom behavioral event, as instrumented code
+would, to test event tracking from the shell.
+
+Name the record that completed it with --email, --utk (the visitor's
+hubspotutk cookie), or --object-id. Event names without the
+pe<portal ID>_ prefix get the portal's prefix. Properties must be defined on
+the event; see hspt events definitions list.`,
+		Example: `  # Send an event for a contact
+  hspt events send --name pe123_signed_up --email user@example.com --prop plan=pro
+
+  # Bare names get the portal's prefix; backdate with --occurred-at
+  hspt events send --name signed_up --email user@example.com --occurred-at 2024-06-01T09:00:00Z`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			name = strings.TrimSpace(name)
+			if name == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if email == "" && utk == "" && objectID == "" {
+				return fmt.Errorf("set --email, --utk, or --object-id for the record that completed the event")
+			}
+
+			properties, err := parseEventProps(props)
+			if err != nil {
+				return err
+			}
+			at, err := parseOccurredAt(occurredAt)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var portalID int64
+			if !qualifiedEventPattern.MatchString(name) {
+				account, err := client.GetAccountDetails(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to get portal ID for the event name: %w", err)
+				}
+				portalID = account.PortalID
+			}
+			eventName := eventTypeName(name, portalID)
+
+			err = client.SendEvent(ctx, api.EventOccurrence{
+				EventName:  eventName,
+				Email:      email,
+				UTK:        utk,
+				ObjectID:   objectID,
+				OccurredAt: at,
+				Properties: properties,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send %s: %w", eventName, err)
+			}
+
+			v.Success("Sent %s", eventName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Event internal name, such as pe123_signed_up (required)")
+	cmd.Flags().StringVar(&email, "email", "", "Email of the contact who completed the event")
+	cmd.Flags().StringVar(&utk, "utk", "", "hubspotutk cookie of the visitor who completed the event")
+	cmd.Flags().StringVar(&objectID, "object-id", "", "ID of the record that completed the event")
+	cmd.Flags().StringVar(&occurredAt, "occurred-at", "", "When the event happened (default: now)")
+	cmd.Flags().StringArrayVar(&props, "prop", nil, "Event property in key=value format (repeatable)")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEventProps(t *testing.T) {
+	props, err := parseEventProps([]string{"plan=pro", "note=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"plan": "pro", "note": "a=b"}, props)
+
+	props, err = parseEventProps(nil)
+	require.NoError(t, err)
+	assert.Nil(t, props)
+
+	_, err = parseEventProps([]string{"plan"})
+	assert.ErrorContains(t, err, "key=value")
+}
+
+func TestParseOccurredAt(t *testing.T) {
+	at, err := parseOccurredAt("2024-06-01T09:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC), *at)
+
+	at, err = parseOccurredAt("2024-06-01")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), *at)
+
+	at, err = parseOccurredAt("")
+	require.NoError(t, err)
+	assert.Nil(t, at)
+
+	_, err = parseOccurredAt("yesterday")
+	assert.ErrorContains(t, err, "--occurred-at")
+}
@@ -0,0 +1,530 @@
+package exports
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// pollInterval is how often --wait checks on an export
+const pollInterval = 5 * time.Second
+
+// exportObjectTypes maps object type names to the names the exports API
+// expects; other values, such as custom object type IDs, pass through
+var exportObjectTypes = map[string]string{
+	"contacts":  "CONTACT",
+	"companies": "COMPANY",
+	"deals":     "DEAL",
+	"tickets":   "TICKET",
+}
+
+// exportFormats are the accepted --format values
+var exportFormats = map[string]string{
+	"csv":  "CSV",
+	"xlsx": "XLSX",
+	"xls":  "XLS",
+}
+
+// Register registers the exports command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "exports",
+		Short: "Export CRM records to a file",
+		Long: `Commands for HubSpot's CRM exports API.
+
+An export builds a CSV or Excel file of records in the background, selected
+by search filters or list membership. Start one with create, follow it with
+get, and fetch the file with download (or use create --wait).`,
+	}
+
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newDownloadCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// exportObjectType returns the exports API name of an object type
+func exportObjectType(name string) string {
+	if t, ok := exportObjectTypes[strings.ToLower(name)]; ok {
+		return t
+	}
+	return name
+}
+
+// activeProfile returns the name of the profile in use, recorded with each
+// export so the history of one portal is not listed under another
+func activeProfile() string {
+	cfg, err := config.Load()
+	if err != nil {
+		return ""
+	}
+	return cfg.ActiveProfileName()
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var objectType, name, format, listID, query, out string
+	var properties, associated, filters, redact []string
+	var wait bool
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Start an export",
+		Long: `Start an export of the records matching --filter (all records without one),
+or of the members of a list with --list-id.
+
+The file is built in the background. With --wait, hspt checks every few
+seconds until it is ready and downloads it to --out (default: the file name
+HubSpot gives it, in the current directory).
+
+--redact hashes or masks personal data (emails, phones, names, or profiles
+from the redaction config key) as a CSV file downloads, so it can be shared
+with analysts. It needs --wait.`,
+		Example: `  # Export every MQL's name and email, and download the file
+  hspt exports create --type contacts --properties email,firstname,lastname \
+    --filter "lifecyclestage EQ marketingqualifiedlead" --wait
+
+  # The same, with emails hashed and names masked
+  hspt exports create --type contacts --properties email,firstname,lastname,lifecyclestage \
+    --wait --redact emails,names
+
+  # Export the members of a list as Excel
+  hspt exports create --type contacts --list-id 42 --properties email --format xlsx
+
+  # Include associated companies
+  hspt exports create --type deals --properties dealname,amount --associated companies`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if len(properties) == 0 {
+				return fmt.Errorf("--properties is required")
+			}
+			fileFormat, ok := exportFormats[strings.ToLower(format)]
+			if !ok {
+				return fmt.Errorf("invalid --format %q (expected csv, xlsx, or xls)", format)
+			}
+			if listID != "" && (len(filters) > 0 || query != "") {
+				return fmt.Errorf("--list-id cannot be combined with --filter or --query")
+			}
+			if len(redact) > 0 && (!wait || fileFormat != "CSV") {
+				return fmt.Errorf("--redact needs --wait and --format csv")
+			}
+			redactor, err := shared.LoadRedactor(redact)
+			if err != nil {
+				return err
+			}
+
+			req := api.ExportRequest{
+				ExportType:       api.ExportTypeView,
+				ExportName:       name,
+				Format:           fileFormat,
+				Language:         "EN",
+				ObjectType:       exportObjectType(objectType),
+				ObjectProperties: properties,
+			}
+			for _, a := range associated {
+				req.AssociatedObjectType = append(req.AssociatedObjectType, exportObjectType(a))
+			}
+			if listID != "" {
+				req.ExportType = api.ExportTypeList
+				req.ListID = listID
+			} else {
+				parsed, err := shared.ParseFilters(filters)
+				if err != nil {
+					return err
+				}
+				req.PublicCrmSearchRequest = &api.ExportFilters{Filters: parsed, Query: query}
+			}
+			if req.ExportName == "" {
+				req.ExportName = fmt.Sprintf("%s export %s", objectType, time.Now().Format("2006-01-02 15:04"))
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			task, err := client.StartExport(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to start export: %w", err)
+			}
+
+			if path, err := historyPath(); err == nil {
+				err = recordExport(path, exportRecord{
+					TaskID:      task.ID,
+					Name:        req.ExportName,
+					ObjectType:  req.ObjectType,
+					ExportType:  req.ExportType,
+					Format:      req.Format,
+					Profile:     activeProfile(),
+					RequestedAt: time.Now().UTC(),
+				})
+				if err != nil {
+					v.Warning("Export not added to history: %v", err)
+				}
+			}
+
+			v.Success("Export started with task ID: %s", task.ID)
+			if !wait {
+				v.Info("Check on it with: hspt exports get %s", task.ID)
+				return v.Render([]string{"TASK ID", "NAME", "FORMAT"}, [][]string{{task.ID, req.ExportName, req.Format}}, task)
+			}
+
+			v.Info("Waiting for the export to finish...")
+			status, err := waitForExport(cmd.Context(), client, task.ID, pollInterval, api.SleepContext)
+			if err != nil {
+				return err
+			}
+			return finishDownload(cmd.Context(), opts, task.ID, status, out, redactor)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "type", string(api.ObjectTypeContacts), "Object type to export (contacts, companies, deals, tickets, or an object type ID)")
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include as columns (comma-separated, required)")
+	cmd.Flags().StringArrayVar(&filters, "filter", nil, `Only export records matching a filter, e.g. "lifecyclestage EQ mql" (repeatable; all must match)`)
+	cmd.Flags().StringVar(&query, "query", "", "Only export records matching this text search")
+	cmd.Flags().StringVar(&listID, "list-id", "", "Export the members of this list instead")
+	cmd.Flags().StringSliceVar(&associated, "associated", nil, "Associated object types to include (comma-separated)")
+	cmd.Flags().StringVar(&name, "name", "", "Export name (default: object type and time)")
+	cmd.Flags().StringVar(&format, "format", "csv", "File format: csv, xlsx, or xls")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for the export to finish and download it")
+	cmd.Flags().StringVar(&out, "out", "", "File to download to with --wait (default: HubSpot's file name)")
+	shared.AddRedactFlag(cmd, &redact)
+
+	return cmd
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List exports started with hspt",
+		Long: `List the exports started with "hspt exports create" on this machine for the
+current profile, newest first, with their current status.
+
+HubSpot has no API for listing exports, so exports started elsewhere, such as
+in the HubSpot UI, are not shown.`,
+		Example: `  # List recent exports
+  hspt exports list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			path, err := historyPath()
+			if err != nil {
+				return err
+			}
+			records, err := loadHistory(path)
+			if err != nil {
+				return err
+			}
+			records = forProfile(records, activeProfile())
+			if len(records) == 0 {
+				v.Info("No exports found")
+				return nil
+			}
+			if limit > 0 && len(records) > limit {
+				records = records[:limit]
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			type listedExport struct {
+				exportRecord
+				Status string `json:"status"`
+			}
+			listed := make([]listedExport, 0, len(records))
+			rows := make([][]string, 0, len(records))
+			for _, rec := range records {
+				state := "unknown"
+				status, err := client.GetExportStatus(cmd.Context(), rec.TaskID)
+				switch {
+				case err == nil:
+					state = status.Status
+				case api.IsNotFound(err):
+					state = "expired"
+				case api.IsBudgetExhausted(err) || cmd.Context().Err() != nil:
+					return err
+				}
+				listed = append(listed, listedExport{exportRecord: rec, Status: state})
+				rows = append(rows, []string{
+					rec.TaskID,
+					rec.Name,
+					rec.ObjectType,
+					rec.Format,
+					state,
+					v.Time(rec.RequestedAt.Format(time.RFC3339)),
+				})
+			}
+
+			headers := []string{"TASK ID", "NAME", "OBJECT", "FORMAT", "STATUS", "REQUESTED"}
+			return v.Render(headers, rows, listed)
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of exports to show (0 for all)")
+
+	return cmd
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <taskId>",
+		Short: "Get the status of an export",
+		Long:  "Show whether an export is still running and, once it is complete, where to download it.",
+		Example: `  # Check on an export
+  hspt exports get 5566`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			taskID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			status, err := client.GetExportStatus(cmd.Context(), taskID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Export %s not found", taskID)
+					return nil
+				}
+				return err
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"Task ID", taskID},
+				{"Status", status.Status},
+				{"Requested", v.Time(status.RequestedAt)},
+				{"Started", v.Time(status.StartedAt)},
+				{"Completed", v.Time(status.CompletedAt)},
+			}
+			if status.Result != "" {
+				rows = append(rows, []string{"Download URL", status.Result})
+			}
+			for _, e := range status.Errors {
+				rows = append(rows, []string{"Error", e.Message})
+			}
+
+			return v.Render(headers, rows, status)
+		},
+	}
+}
+
+func newDownloadCmd(opts *root.Options) *cobra.Command {
+	var out string
+	var wait bool
+	var redact []string
+
+	cmd := &cobra.Command{
+		Use:   "download <taskId>",
+		Short: "Download a finished export",
+		Long: `Download the file of a finished export. With --wait, wait for a running
+export to finish first.
+
+--redact hashes or masks personal data in a CSV export as it downloads.`,
+		Example: `  # Download an export
+  hspt exports download 5566
+
+  # Wait for it and save it under a given name
+  hspt exports download 5566 --wait --out mqls.csv
+
+  # Hash emails and mask phone numbers before sharing the file
+  hspt exports download 5566 --out shareable.csv --redact emails,phones`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			taskID := args[0]
+
+			redactor, err := shared.LoadRedactor(redact)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var status *api.ExportStatus
+			if wait {
+				status, err = waitForExport(cmd.Context(), client, taskID, pollInterval, api.SleepContext)
+			} else {
+				status, err = client.GetExportStatus(cmd.Context(), taskID)
+			}
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Export %s not found", taskID)
+					return nil
+				}
+				return err
+			}
+			if !status.Done() {
+				v.Info("Export %s is %s. Try again later, or use --wait.", taskID, strings.ToLower(status.Status))
+				return nil
+			}
+
+			return finishDownload(cmd.Context(), opts, taskID, status, out, redactor)
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File to download to (default: HubSpot's file name)")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for a running export to finish")
+	shared.AddRedactFlag(cmd, &redact)
+
+	return cmd
+}
+
+// waitForExport checks an export every interval until it stops
+func waitForExport(ctx context.Context, client *api.Client, taskID string, interval time.Duration, sleep func(context.Context, time.Duration) error) (*api.ExportStatus, error) {
+	for {
+		status, err := client.GetExportStatus(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if status.Done() {
+			return status, nil
+		}
+		if err := sleep(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// finishDownload downloads a stopped export, or reports why it has no file
+func finishDownload(ctx context.Context, opts *root.Options, taskID string, status *api.ExportStatus, out string, redactor *shared.Redactor) error {
+	v := opts.View()
+
+	if status.Status != api.ExportStatusComplete || status.Result == "" {
+		for _, e := range status.Errors {
+			v.Error("%s", e.Message)
+		}
+		return fmt.Errorf("export %s ended with status %s", taskID, status.Status)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	file, err := download(ctx, httpClient, status.Result, out, redactor)
+	if err != nil {
+		return fmt.Errorf("failed to download export: %w", err)
+	}
+
+	v.Success("Downloaded export %s to %s (%d bytes)", taskID, file.Path, file.Bytes)
+	if redactor != nil {
+		if len(file.Redacted) == 0 {
+			v.Warning("No columns matched the --redact profiles; nothing was redacted")
+		} else {
+			v.Info("Redacted columns: %s", strings.Join(file.Redacted, ", "))
+		}
+	}
+	return nil
+}
+
+// downloaded describes a downloaded export file
+type downloaded struct {
+	Path  string
+	Bytes int64
+	// Redacted are the columns redacted on the way to disk
+	Redacted []string
+}
+
+// download saves the file at rawURL to out, or to the file name the server
+// gives when out is empty. The URL is pre-signed, so no token is sent. With
+// a redactor, the CSV is redacted as it streams so personal data never
+// reaches the disk.
+func download(ctx context.Context, client *http.Client, rawURL, out string, redactor *shared.Redactor) (*downloaded, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download returned %s", resp.Status)
+	}
+
+	if out == "" {
+		out = downloadName(resp.Header.Get("Content-Disposition"), rawURL)
+	}
+	if redactor != nil && !strings.EqualFold(path.Ext(out), ".csv") {
+		return nil, fmt.Errorf("--redact only works with CSV exports, not %s", out)
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return nil, err
+	}
+	result := &downloaded{Path: out}
+	if redactor != nil {
+		counter := &countingWriter{w: f}
+		result.Redacted, err = redactor.CSV(resp.Body, counter)
+		result.Bytes = counter.n
+	} else {
+		result.Bytes, err = io.Copy(f, resp.Body)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		// Do not leave a partly written file behind
+		os.Remove(out)
+		return nil, err
+	}
+	return result, nil
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// downloadName picks a local file name from the Content-Disposition header
+// or the URL path, never a path outside the current directory
+func downloadName(disposition, rawURL string) string {
+	if _, params, err := mime.ParseMediaType(disposition); err == nil {
+		if name := path.Base(strings.ReplaceAll(params["filename"], `\`, "/")); name != "." && name != "/" && name != ".." {
+			return name
+		}
+	}
+	if u, err := url.Parse(rawURL); err == nil {
+		if name := path.Base(u.Path); name != "." && name != "/" && name != ".." {
+			return name
+		}
+	}
+	return "export"
+}
@@ -0,0 +1,121 @@
+package exports
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+func TestExportObjectType(t *testing.T) {
+	assert.Equal(t, "CONTACT", exportObjectType("contacts"))
+	assert.Equal(t, "DEAL", exportObjectType("Deals"))
+	assert.Equal(t, "2-123456", exportObjectType("2-123456"))
+}
+
+func TestDownloadName(t *testing.T) {
+	assert.Equal(t, "mqls.csv", downloadName(`attachment; filename="mqls.csv"`, "https://files.example.com/x/y.zip"))
+	assert.Equal(t, "passwd", downloadName(`attachment; filename="../../etc/passwd"`, ""))
+	assert.Equal(t, "evil.csv", downloadName(`attachment; filename="..\\evil.csv"`, ""))
+	assert.Equal(t, "y.zip", downloadName("", "https://files.example.com/x/y.zip?sig=abc"))
+	assert.Equal(t, "export", downloadName("", "https://files.example.com/"))
+}
+
+func TestWaitForExport(t *testing.T) {
+	statuses := []string{"PENDING", "PROCESSING", "COMPLETE"}
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/exports/export/async/tasks/5566/status", r.URL.Path)
+		w.Write([]byte(`{"status": "` + statuses[calls] + `", "result": "https://files.example.com/a.csv"}`))
+		calls++
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	var slept []time.Duration
+	sleep := func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	status, err := waitForExport(context.Background(), client, "5566", time.Second, sleep)
+	require.NoError(t, err)
+	assert.Equal(t, api.ExportStatusComplete, status.Status)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, slept)
+}
+
+func TestWaitForExport_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "PROCESSING"}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := waitForExport(ctx, client, "5566", time.Second, api.SleepContext)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		if r.URL.Path == "/gone.csv" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Disposition", `attachment; filename="contacts.csv"`)
+		w.Write([]byte("email\na@example.com\n"))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "mine.csv")
+
+	file, err := download(context.Background(), server.Client(), server.URL+"/export.csv", out, nil)
+	require.NoError(t, err)
+	assert.Equal(t, out, file.Path)
+	assert.Equal(t, int64(20), file.Bytes)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "email\na@example.com\n", string(data))
+
+	_, err = download(context.Background(), server.Client(), server.URL+"/gone.csv", out, nil)
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestDownload_Redacted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Record ID,Email,Phone Number\n1,a@example.com,+1 555 010 9988\n"))
+	}))
+	defer server.Close()
+
+	redactor, err := shared.NewRedactor([]string{"emails", "phones"}, nil, []byte("test-key"))
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	out := filepath.Join(dir, "shareable.csv")
+	file, err := download(context.Background(), server.Client(), server.URL+"/export.csv", out, redactor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Email", "Phone Number"}, file.Redacted)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "a@example.com")
+	assert.Contains(t, string(data), "+* *** *** **88")
+	assert.Equal(t, int64(len(data)), file.Bytes)
+
+	_, err = download(context.Background(), server.Client(), server.URL+"/export.zip", filepath.Join(dir, "export.zip"), redactor)
+	assert.ErrorContains(t, err, "only works with CSV")
+}
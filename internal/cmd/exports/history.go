@@ -0,0 +1,95 @@
+package exports
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+const (
+	historyFileName = "exports.json"
+	historyFileMode = 0600
+	// maxHistory is how many exports the history file remembers
+	maxHistory = 100
+)
+
+// exportRecord is an export started by hspt. HubSpot has no API to list
+// exports, so "hspt exports list" reads these instead.
+type exportRecord struct {
+	TaskID      string    `json:"taskId"`
+	Name        string    `json:"name"`
+	ObjectType  string    `json:"objectType"`
+	ExportType  string    `json:"exportType"`
+	Format      string    `json:"format"`
+	Profile     string    `json:"profile"`
+	RequestedAt time.Time `json:"requestedAt"`
+}
+
+// historyPath returns the location of the export history file
+func historyPath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, historyFileName), nil
+}
+
+// loadHistory reads the export history, newest first. A missing file is an
+// empty history.
+func loadHistory(path string) ([]exportRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read export history: %w", err)
+	}
+
+	var records []exportRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse export history: %w", err)
+	}
+	return records, nil
+}
+
+// recordExport adds an export to the front of the history, dropping the
+// oldest beyond maxHistory
+func recordExport(path string, rec exportRecord) error {
+	records, err := loadHistory(path)
+	if err != nil {
+		return err
+	}
+
+	records = append([]exportRecord{rec}, records...)
+	if len(records) > maxHistory {
+		records = records[:maxHistory]
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal export history: %w", err)
+	}
+	if err := os.WriteFile(path, data, historyFileMode); err != nil {
+		return fmt.Errorf("failed to write export history: %w", err)
+	}
+	return nil
+}
+
+// forProfile returns the records of exports started with a profile
+func forProfile(records []exportRecord, profile string) []exportRecord {
+	var matched []exportRecord
+	for _, r := range records {
+		if r.Profile == profile {
+			matched = append(matched, r)
+		}
+	}
+	return matched
+}
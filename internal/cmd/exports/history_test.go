@@ -0,0 +1,57 @@
+package exports
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hspt", historyFileName)
+
+	records, err := loadHistory(path)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, recordExport(path, exportRecord{TaskID: "1", Profile: "default", RequestedAt: at}))
+	require.NoError(t, recordExport(path, exportRecord{TaskID: "2", Profile: "sandbox", RequestedAt: at}))
+	require.NoError(t, recordExport(path, exportRecord{TaskID: "3", Profile: "default", RequestedAt: at}))
+
+	records, err = loadHistory(path)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "3", records[0].TaskID, "newest first")
+	assert.Equal(t, at, records[0].RequestedAt)
+
+	mine := forProfile(records, "default")
+	require.Len(t, mine, 2)
+	assert.Equal(t, "3", mine[0].TaskID)
+	assert.Equal(t, "1", mine[1].TaskID)
+}
+
+func TestHistory_Capped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), historyFileName)
+
+	for i := 0; i < maxHistory+5; i++ {
+		require.NoError(t, recordExport(path, exportRecord{TaskID: fmt.Sprint(i)}))
+	}
+
+	records, err := loadHistory(path)
+	require.NoError(t, err)
+	require.Len(t, records, maxHistory)
+	assert.Equal(t, fmt.Sprint(maxHistory+4), records[0].TaskID)
+}
+
+func TestHistory_Corrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), historyFileName)
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+
+	_, err := loadHistory(path)
+	assert.ErrorContains(t, err, "failed to parse export history")
+}
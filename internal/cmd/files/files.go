@@ -7,6 +7,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the files command and subcommands
@@ -14,13 +15,14 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "files",
 		Short: "Manage HubSpot files",
-		Long:  "Commands for listing and viewing files in the HubSpot File Manager.",
+		Long:  "Commands for managing files and folders in the HubSpot File Manager.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newFoldersCmd(opts))
+	cmd.AddCommand(newSyncCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -28,6 +30,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -46,10 +49,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListFiles(api.ListOptions{
+			result, err := client.ListFiles(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -86,6 +89,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of files to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -106,7 +111,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			file, err := client.GetFile(id)
+			file, err := client.GetFile(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("File %s not found", id)
@@ -126,8 +131,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Access", file.AccessLevel},
 				{"URL", file.URL},
 				{"Archived", formatBool(file.Archived)},
-				{"Created", file.CreatedAt},
-				{"Updated", file.UpdatedAt},
+				{"Created", v.Time(file.CreatedAt)},
+				{"Updated", v.Time(file.UpdatedAt)},
 			}
 
 			return v.Render(headers, rows, file)
@@ -162,7 +167,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteFile(id); err != nil {
+			if err := client.DeleteFile(cmd.Context(), id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("File %s not found", id)
 					return nil
@@ -180,66 +185,6 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func newFoldersCmd(opts *root.Options) *cobra.Command {
-	var limit int
-	var after string
-
-	cmd := &cobra.Command{
-		Use:   "folders",
-		Short: "List folders",
-		Long:  "List folders from the HubSpot File Manager.",
-		Example: `  # List folders
-  hspt files folders`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			result, err := client.ListFolders(api.ListOptions{
-				Limit: limit,
-				After: after,
-			})
-			if err != nil {
-				return err
-			}
-
-			if len(result.Results) == 0 {
-				v.Info("No folders found")
-				return nil
-			}
-
-			headers := []string{"ID", "NAME", "PATH", "ARCHIVED"}
-			rows := make([][]string, 0, len(result.Results))
-			for _, folder := range result.Results {
-				rows = append(rows, []string{
-					folder.ID,
-					folder.Name,
-					folder.Path,
-					formatBool(folder.Archived),
-				})
-			}
-
-			if err := v.Render(headers, rows, result); err != nil {
-				return err
-			}
-
-			if result.Paging != nil && result.Paging.Next != nil {
-				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
-			}
-
-			return nil
-		},
-	}
-
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of folders to return")
-	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
-
-	return cmd
-}
-
 func formatSize(bytes int64) string {
 	const (
 		KB = 1024
@@ -0,0 +1,301 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// movePollInterval is how often folders move checks on the update
+const movePollInterval = 2 * time.Second
+
+func newFoldersCmd(opts *root.Options) *cobra.Command {
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "folders",
+		Short: "List and manage folders",
+		Long: `List folders from the HubSpot File Manager, or create, delete, and move
+them with the subcommands.`,
+		Example: `  # List folders
+  hspt files folders
+
+  # Create a folder, with any missing parents
+  hspt files folders create /assets/img`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListFolders(cmd.Context(), pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No folders found")
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "PATH", "ARCHIVED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, folder := range result.Results {
+				rows = append(rows, []string{
+					folder.ID,
+					folder.Name,
+					folder.Path,
+					formatBool(folder.Archived),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of folders to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	cmd.AddCommand(newFoldersCreateCmd(opts))
+	cmd.AddCommand(newFoldersDeleteCmd(opts))
+	cmd.AddCommand(newFoldersMoveCmd(opts))
+
+	return cmd
+}
+
+// cleanPath normalizes a file manager path to /a/b form; the top level is
+// the empty string
+func cleanPath(p string) string {
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == "" {
+		return ""
+	}
+	return "/" + p
+}
+
+// isFolderID reports whether ref is a folder ID rather than a path
+func isFolderID(ref string) bool {
+	if ref == "" {
+		return false
+	}
+	for _, r := range ref {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveFolder finds a folder by ID or path
+func resolveFolder(ctx context.Context, client *api.Client, ref string) (*api.Folder, error) {
+	if isFolderID(ref) {
+		return &api.Folder{ID: ref}, nil
+	}
+	stat, err := client.StatPath(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if stat.Folder == nil {
+		return nil, fmt.Errorf("%s is a file, not a folder", ref)
+	}
+	return stat.Folder, nil
+}
+
+func newFoldersCreateCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create <path>",
+		Short: "Create a folder",
+		Long: `Create a folder at a path such as /assets/img. Missing parent folders are
+created too.`,
+		Example: `  # Create a top-level folder
+  hspt files folders create /assets
+
+  # Create a nested folder
+  hspt files folders create /assets/img/icons`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			p := cleanPath(args[0])
+			if p == "" {
+				return fmt.Errorf("folder path is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			folder, err := client.CreateFolder(cmd.Context(), path.Base(p), path.Dir(p))
+			if err != nil {
+				return fmt.Errorf("failed to create folder: %w", err)
+			}
+
+			v.Success("Folder %s created with ID %s", p, folder.ID)
+			return nil
+		},
+	}
+}
+
+func newFoldersDeleteCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id|path>",
+		Short: "Delete a folder",
+		Long:  "Delete a folder and everything in it from the HubSpot File Manager.",
+		Example: `  # Delete a folder by path
+  hspt files folders delete /assets/old --force
+
+  # Delete a folder by ID
+  hspt files folders delete 12345678 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ref := args[0]
+
+			if !force {
+				v.Warning("This will permanently delete folder %s and all files in it. Use --force to confirm.", ref)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			folder, err := resolveFolder(cmd.Context(), client, ref)
+			if err == nil {
+				err = client.DeleteFolder(cmd.Context(), folder.ID)
+			}
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Folder %s not found", ref)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Folder %s deleted", ref)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func newFoldersMoveCmd(opts *root.Options) *cobra.Command {
+	var name string
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "move <id|path> [<destination>]",
+		Short: "Move or rename a folder",
+		Long: `Move a folder into another folder, given by ID or path, and/or rename it
+with --name. HubSpot updates the paths of everything in the folder in the
+background; the command waits until it has finished, for up to --wait-timeout.`,
+		Example: `  # Move a folder into /archive
+  hspt files folders move /assets/2023 /archive
+
+  # Rename a folder in place
+  hspt files folders move /assets/img --name images`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ref := args[0]
+
+			if len(args) < 2 && name == "" {
+				return fmt.Errorf("give a destination folder, --name, or both")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			folder, err := resolveFolder(cmd.Context(), client, ref)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Folder %s not found", ref)
+					return nil
+				}
+				return err
+			}
+
+			update := api.FolderUpdate{ID: folder.ID, Name: name}
+			if len(args) == 2 {
+				dest, err := resolveFolder(cmd.Context(), client, args[1])
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("Folder %s not found", args[1])
+						return nil
+					}
+					return err
+				}
+				if dest.ID == folder.ID {
+					return fmt.Errorf("cannot move a folder into itself")
+				}
+				update.ParentFolderID = dest.ID
+			}
+
+			task, err := client.StartFolderUpdate(cmd.Context(), update)
+			if err != nil {
+				return fmt.Errorf("failed to move folder: %w", err)
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			for !task.Done() {
+				if err := api.SleepContext(ctx, movePollInterval); err != nil {
+					return fmt.Errorf("folder move %s still running: %w", task.ID, err)
+				}
+				if task, err = client.GetFolderUpdateStatus(ctx, task.ID); err != nil {
+					return fmt.Errorf("failed to check folder move: %w", err)
+				}
+			}
+			if task.Status != api.FolderUpdateComplete {
+				return fmt.Errorf("folder move %s ended with status %s", task.ID, task.Status)
+			}
+
+			if task.Result != nil && task.Result.Path != "" {
+				v.Success("Folder %s moved to %s", ref, task.Result.Path)
+			} else {
+				v.Success("Folder %s moved", ref)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "New folder name")
+	cmd.Flags().DurationVar(&timeout, "wait-timeout", 5*time.Minute, "How long to wait for the move to finish")
+
+	return cmd
+}
@@ -0,0 +1,334 @@
+package files
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Sync actions
+const (
+	syncUpload = "upload"
+	syncUpdate = "update"
+	syncDelete = "delete"
+)
+
+// localFile is a file under the directory being synced
+type localFile struct {
+	// Rel is the slash-separated path relative to the synced directory
+	Rel  string
+	Path string
+	Size int64
+}
+
+// syncAction is one change sync makes to the file manager
+type syncAction struct {
+	Action string     `json:"action"`
+	Path   string     `json:"path"`
+	Size   int64      `json:"size"`
+	Local  *localFile `json:"-"`
+	Remote *api.File  `json:"-"`
+}
+
+// syncPlan is the JSON output of sync
+type syncPlan struct {
+	Actions   []syncAction `json:"actions"`
+	Unchanged int          `json:"unchanged"`
+	DryRun    bool         `json:"dryRun"`
+}
+
+// walkLocal lists the regular files under dir, skipping dotfiles and
+// dot-directories
+func walkLocal(dir string) ([]localFile, error) {
+	var files []localFile
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, localFile{Rel: filepath.ToSlash(rel), Path: p, Size: info.Size()})
+		return nil
+	})
+	return files, err
+}
+
+// remoteUnder returns the files under the remote folder keyed by their
+// lowercased path relative to it; the file manager ignores case in paths
+func remoteUnder(files []api.File, remoteRoot string) map[string]*api.File {
+	prefix := strings.ToLower(remoteRoot) + "/"
+	under := make(map[string]*api.File)
+	for i := range files {
+		p := strings.ToLower(files[i].Path)
+		if strings.HasPrefix(p, prefix) {
+			under[strings.TrimPrefix(p, prefix)] = &files[i]
+		}
+	}
+	return under
+}
+
+// planSync compares local files with the remote files under remoteRoot.
+// Files missing remotely are uploaded, and files whose size differs are
+// updated; for files of equal size, same decides whether the content
+// matches. With deleteExtraneous, remote files missing locally are deleted.
+func planSync(local []localFile, remote []api.File, remoteRoot string, deleteExtraneous bool, same func(localFile, *api.File) (bool, error)) (*syncPlan, error) {
+	under := remoteUnder(remote, remoteRoot)
+	plan := &syncPlan{Actions: []syncAction{}}
+
+	seen := make(map[string]bool, len(local))
+	for i := range local {
+		lf := &local[i]
+		key := strings.ToLower(lf.Rel)
+		seen[key] = true
+		target := remoteRoot + "/" + lf.Rel
+
+		rf := under[key]
+		switch {
+		case rf == nil:
+			plan.Actions = append(plan.Actions, syncAction{Action: syncUpload, Path: target, Size: lf.Size, Local: lf})
+			continue
+		case rf.Size == lf.Size:
+			ok, err := same(*lf, rf)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compare %s: %w", lf.Rel, err)
+			}
+			if ok {
+				plan.Unchanged++
+				continue
+			}
+		}
+		plan.Actions = append(plan.Actions, syncAction{Action: syncUpdate, Path: target, Size: lf.Size, Local: lf, Remote: rf})
+	}
+
+	if deleteExtraneous {
+		keys := make([]string, 0, len(under))
+		for key := range under {
+			if !seen[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			rf := under[key]
+			plan.Actions = append(plan.Actions, syncAction{Action: syncDelete, Path: rf.Path, Size: rf.Size, Remote: rf})
+		}
+	}
+
+	return plan, nil
+}
+
+// hashReader returns the SHA-256 of everything r yields
+func hashReader(r io.Reader) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// checksumMatcher compares a local file with a remote one by downloading
+// the remote file through a signed URL and hashing both
+func checksumMatcher(ctx context.Context, client *api.Client, httpClient *http.Client) func(localFile, *api.File) (bool, error) {
+	return func(lf localFile, rf *api.File) (bool, error) {
+		f, err := os.Open(lf.Path)
+		if err != nil {
+			return false, err
+		}
+		defer f.Close()
+		localSum, err := hashReader(f)
+		if err != nil {
+			return false, err
+		}
+
+		signed, err := client.GetFileSignedURL(ctx, rf.ID)
+		if err != nil {
+			return false, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, signed, nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return false, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return false, fmt.Errorf("download returned %s", resp.Status)
+		}
+		remoteSum, err := hashReader(resp.Body)
+		if err != nil {
+			return false, err
+		}
+
+		return string(localSum) == string(remoteSum), nil
+	}
+}
+
+// upload uploads a local file to its place under remoteRoot, replacing any
+// file already there
+func upload(ctx context.Context, client *api.Client, remoteRoot, access string, lf *localFile) error {
+	f, err := os.Open(lf.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = client.UploadFile(ctx, api.FileUpload{
+		Name:       path.Base(lf.Rel),
+		FolderPath: path.Dir(remoteRoot + "/" + lf.Rel),
+		Access:     access,
+		Overwrite:  true,
+		Content:    f,
+	})
+	return err
+}
+
+func newSyncCmd(opts *root.Options) *cobra.Command {
+	var dryRun, deleteExtraneous, sizeOnly bool
+	var access string
+
+	cmd := &cobra.Command{
+		Use:   "sync <localDir> <remotePath>",
+		Short: "Upload a local directory to a file manager folder",
+		Long: `Mirror a local directory into a file manager folder, uploading only new
+and changed files. Missing folders are created as files are uploaded.
+
+Files whose sizes differ count as changed. Files of equal size are downloaded
+and compared by SHA-256 checksum; --size-only skips that and treats them as
+unchanged. Dotfiles and dot-directories are skipped.
+
+--delete-extraneous also deletes remote files under the folder that do not
+exist locally. Preview the changes with --dry-run first.`,
+		Example: `  # Preview what would change
+  hspt files sync ./dist/img /assets/img --dry-run
+
+  # Upload new and changed files
+  hspt files sync ./dist/img /assets/img
+
+  # Make the folder an exact mirror of the directory
+  hspt files sync ./dist/img /assets/img --delete-extraneous`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			localDir := args[0]
+			remoteRoot := cleanPath(args[1])
+			if remoteRoot == "" {
+				return fmt.Errorf("remote path must be a folder, not the top level")
+			}
+
+			info, err := os.Stat(localDir)
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", localDir)
+			}
+
+			local, err := walkLocal(localDir)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", localDir, err)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+			// The comparison must see the file manager as it is now
+			client.Cache = nil
+
+			remote, err := client.ListFiles(cmd.Context(), api.ListOptions{All: true})
+			if err != nil {
+				return fmt.Errorf("failed to list files: %w", err)
+			}
+
+			same := func(localFile, *api.File) (bool, error) { return true, nil }
+			if !sizeOnly {
+				httpClient, err := opts.HTTPClient()
+				if err != nil {
+					return err
+				}
+				same = checksumMatcher(cmd.Context(), client, httpClient)
+			}
+
+			plan, err := planSync(local, remote.Results, remoteRoot, deleteExtraneous, same)
+			if err != nil {
+				return err
+			}
+			plan.DryRun = dryRun
+
+			if len(plan.Actions) == 0 {
+				v.Info("Already in sync: %d file(s) unchanged", plan.Unchanged)
+				return nil
+			}
+
+			if !dryRun {
+				for i, a := range plan.Actions {
+					switch a.Action {
+					case syncUpload, syncUpdate:
+						err = upload(cmd.Context(), client, remoteRoot, access, a.Local)
+					case syncDelete:
+						err = client.DeleteFile(cmd.Context(), a.Remote.ID)
+					}
+					if err != nil {
+						if i > 0 {
+							v.Warning("Stopped after %d of %d change(s)", i, len(plan.Actions))
+						}
+						return fmt.Errorf("failed to %s %s: %w", a.Action, a.Path, err)
+					}
+				}
+			}
+
+			headers := []string{"ACTION", "PATH", "SIZE"}
+			rows := make([][]string, 0, len(plan.Actions))
+			for _, a := range plan.Actions {
+				rows = append(rows, []string{a.Action, a.Path, formatSize(a.Size)})
+			}
+			if err := v.Render(headers, rows, plan); err != nil {
+				return err
+			}
+
+			if dryRun {
+				v.Info("Dry run: %d change(s) planned, %d file(s) unchanged", len(plan.Actions), plan.Unchanged)
+			} else {
+				v.Success("Synced %s: %d change(s), %d file(s) unchanged", remoteRoot, len(plan.Actions), plan.Unchanged)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the changes without making them")
+	cmd.Flags().BoolVar(&deleteExtraneous, "delete-extraneous", false, "Delete remote files that do not exist locally")
+	cmd.Flags().BoolVar(&sizeOnly, "size-only", false, "Compare files by size only, without downloading")
+	cmd.Flags().StringVar(&access, "access", api.FileAccessPublicNotIndexable, "Access level for uploaded files (PUBLIC_INDEXABLE, PUBLIC_NOT_INDEXABLE, PRIVATE)")
+
+	return cmd
+}
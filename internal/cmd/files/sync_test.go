@@ -0,0 +1,83 @@
+package files
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestCleanPath(t *testing.T) {
+	assert.Equal(t, "/assets/img", cleanPath("assets/img/"))
+	assert.Equal(t, "/assets/img", cleanPath("//assets//img"))
+	assert.Equal(t, "", cleanPath("/"))
+	assert.Equal(t, "", cleanPath(""))
+}
+
+func TestIsFolderID(t *testing.T) {
+	assert.True(t, isFolderID("12345"))
+	assert.False(t, isFolderID("/assets"))
+	assert.False(t, isFolderID("2024-archive"))
+	assert.False(t, isFolderID(""))
+}
+
+func TestWalkLocal(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "icons"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logo.png"), []byte("PNG"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "icons", "a.svg"), []byte("<svg/>"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".DS_Store"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("x"), 0o644))
+
+	files, err := walkLocal(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Equal(t, "icons/a.svg", files[0].Rel)
+	assert.Equal(t, int64(6), files[0].Size)
+	assert.Equal(t, "logo.png", files[1].Rel)
+}
+
+func TestPlanSync(t *testing.T) {
+	local := []localFile{
+		{Rel: "logo.png", Size: 3},
+		{Rel: "icons/A.svg", Size: 6},
+		{Rel: "banner.jpg", Size: 10},
+		{Rel: "new.txt", Size: 1},
+	}
+	remote := []api.File{
+		{ID: "1", Path: "/assets/img/logo.png", Size: 3},
+		{ID: "2", Path: "/assets/img/icons/a.svg", Size: 6},
+		{ID: "3", Path: "/assets/img/banner.jpg", Size: 12},
+		{ID: "4", Path: "/assets/img/old.gif", Size: 5},
+		{ID: "5", Path: "/assets/other/logo.png", Size: 3},
+	}
+	// logo.png matches; icons/A.svg has the same size but other content
+	same := func(lf localFile, rf *api.File) (bool, error) { return rf.ID == "1", nil }
+
+	plan, err := planSync(local, remote, "/assets/img", false, same)
+	require.NoError(t, err)
+	assert.Equal(t, 1, plan.Unchanged)
+	require.Len(t, plan.Actions, 3)
+	assert.Equal(t, syncUpdate, plan.Actions[0].Action)
+	assert.Equal(t, "/assets/img/icons/A.svg", plan.Actions[0].Path)
+	assert.Equal(t, syncUpdate, plan.Actions[1].Action)
+	assert.Equal(t, "/assets/img/banner.jpg", plan.Actions[1].Path)
+	assert.Equal(t, syncUpload, plan.Actions[2].Action)
+	assert.Equal(t, "/assets/img/new.txt", plan.Actions[2].Path)
+
+	plan, err = planSync(local, remote, "/assets/img", true, same)
+	require.NoError(t, err)
+	require.Len(t, plan.Actions, 4)
+	assert.Equal(t, syncAction{Action: syncDelete, Path: "/assets/img/old.gif", Size: 5, Remote: &remote[3]}, plan.Actions[3])
+
+	_, err = planSync(local, remote, "/assets/img", false, func(localFile, *api.File) (bool, error) {
+		return false, errors.New("download failed")
+	})
+	assert.ErrorContains(t, err, "failed to compare logo.png: download failed")
+}
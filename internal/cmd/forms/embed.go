@@ -0,0 +1,253 @@
+package forms
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// embedTemplate is HubSpot's standard v2 embed snippet
+const embedTemplate = `<script charset="utf-8" type="text/javascript" src="//%s/forms/embed/v2.js"></script>
+<script>
+  hbspt.forms.create({
+    region: %q,
+    portalId: %q,
+    formId: %q
+  });
+</script>
+`
+
+// embedCode returns the embed snippet for a form. Accounts hosted outside
+// na1 load the embed script from a regional host.
+func embedCode(portalID, region, formID string) string {
+	if region == "" {
+		region = "na1"
+	}
+	host := "js.hsforms.net"
+	if region != "na1" {
+		host = "js-" + region + ".hsforms.net"
+	}
+	return fmt.Sprintf(embedTemplate, host, region, portalID, formID)
+}
+
+// resolvePortal returns the portal ID and region for the active profile,
+// asking the account info API when they were not recorded by "hspt init"
+func resolvePortal(ctx context.Context, client *api.Client) (string, string, error) {
+	var portalID int64
+	var region string
+	if cfg, err := config.Load(); err == nil {
+		if p := cfg.ActiveProfile(); p != nil {
+			portalID, region = p.PortalID, p.Region
+		}
+	}
+
+	if portalID == 0 {
+		details, err := client.GetAccountDetails(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to look up portal ID (pass --portal-id or run hspt init): %w", err)
+		}
+		portalID = details.PortalID
+		if region == "" {
+			region = details.DataHostingLocation
+		}
+	}
+	if region == "" {
+		region = config.RegionFromToken(client.AccessToken)
+	}
+
+	return strconv.FormatInt(portalID, 10), region, nil
+}
+
+func newEmbedCmd(opts *root.Options) *cobra.Command {
+	var portalID string
+	var region string
+
+	cmd := &cobra.Command{
+		Use:   "embed <form-id>",
+		Short: "Print the embed code for a form",
+		Long: `Print the HTML snippet that embeds a form on a web page.
+
+The portal ID and region come from the active profile, or from the account
+info API when "hspt init" did not record them.`,
+		Example: `  # Print the snippet
+  hspt forms embed abc123-def456
+
+  # Add it to a page template
+  hspt forms embed abc123-def456 >> landing.html`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			formID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.GetForm(cmd.Context(), formID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Form %s not found", formID)
+					return nil
+				}
+				return err
+			}
+
+			if portalID == "" {
+				var detected string
+				portalID, detected, err = resolvePortal(cmd.Context(), client)
+				if err != nil {
+					return err
+				}
+				if region == "" {
+					region = detected
+				}
+			}
+			if region == "" {
+				region = config.RegionFromToken(client.AccessToken)
+			}
+
+			code := embedCode(portalID, region, formID)
+			if v.Format == view.FormatJSON {
+				return v.JSON(map[string]string{
+					"formId":   formID,
+					"portalId": portalID,
+					"region":   region,
+					"html":     code,
+				})
+			}
+
+			_, err = fmt.Fprint(opts.Stdout, code)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&portalID, "portal-id", "", "HubSpot portal (account) ID (default: from the active profile)")
+	cmd.Flags().StringVar(&region, "region", "", "Data hosting region, e.g. na1 or eu1 (default: from the active profile)")
+
+	return cmd
+}
+
+// parseFields converts --field name=value flags into submission fields
+func parseFields(raw []string) ([]api.FormSubmitField, error) {
+	fields := make([]api.FormSubmitField, 0, len(raw))
+	for _, r := range raw {
+		parts := strings.SplitN(r, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid field %q: expected name=value", r)
+		}
+		fields = append(fields, api.FormSubmitField{Name: strings.TrimSpace(parts[0]), Value: parts[1]})
+	}
+	return fields, nil
+}
+
+// formFieldNames returns the names of every field defined on a form
+func formFieldNames(form *api.Form) map[string]bool {
+	names := make(map[string]bool)
+	for _, group := range form.FieldGroups {
+		for _, field := range group.Fields {
+			names[field.Name] = true
+		}
+	}
+	return names
+}
+
+func newSubmitTestCmd(opts *root.Options) *cobra.Command {
+	var rawFields []string
+	var portalID string
+	var pageURI string
+	var pageName string
+
+	cmd := &cobra.Command{
+		Use:   "submit-test <form-id>",
+		Short: "Send a test submission to a form",
+		Long: `Send a submission to a form through the Forms submission API.
+
+The submission is processed like one from a real visitor: it creates or
+updates the contact and triggers any workflows and notifications attached to
+the form, so use a test email address. Fields not defined on the form are
+reported as warnings; HubSpot rejects submissions missing required fields.`,
+		Example: `  # Verify the form's follow-up workflow fires
+  hspt forms submit-test abc123-def456 --field email=test@example.com --field firstname=Test
+
+  # Record the page the submission came from
+  hspt forms submit-test abc123-def456 --field email=test@example.com --page-uri https://example.com/signup`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			formID := args[0]
+
+			fields, err := parseFields(rawFields)
+			if err != nil {
+				return err
+			}
+			if len(fields) == 0 {
+				return fmt.Errorf("at least one --field is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			form, err := client.GetForm(cmd.Context(), formID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Form %s not found", formID)
+					return nil
+				}
+				return err
+			}
+			known := formFieldNames(form)
+			for _, f := range fields {
+				if !known[f.Name] {
+					v.Warning("Field %q is not on form %s", f.Name, form.Name)
+				}
+			}
+
+			if portalID == "" {
+				portalID, _, err = resolvePortal(cmd.Context(), client)
+				if err != nil {
+					return err
+				}
+			}
+
+			req := api.FormSubmitRequest{Fields: fields}
+			if pageURI != "" || pageName != "" {
+				req.Context = &api.FormSubmitContext{PageURI: pageURI, PageName: pageName}
+			}
+
+			result, err := client.SubmitForm(cmd.Context(), portalID, formID, req)
+			if err != nil {
+				return fmt.Errorf("submission rejected: %w", err)
+			}
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(result)
+			}
+
+			v.Success("Test submission accepted by form %s", form.Name)
+			if result.RedirectURI != "" {
+				v.Info("Redirect: %s", result.RedirectURI)
+			}
+			if result.InlineMessage != "" {
+				v.Info("Message: %s", result.InlineMessage)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&rawFields, "field", nil, "Field value as name=value (repeatable)")
+	cmd.Flags().StringVar(&portalID, "portal-id", "", "HubSpot portal (account) ID (default: from the active profile)")
+	cmd.Flags().StringVar(&pageURI, "page-uri", "", "URL of the page the submission is attributed to")
+	cmd.Flags().StringVar(&pageName, "page-name", "hspt forms submit-test", "Name of the page the submission is attributed to")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package forms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestEmbedCode(t *testing.T) {
+	code := embedCode("123456", "na1", "form-1")
+	assert.Contains(t, code, `src="//js.hsforms.net/forms/embed/v2.js"`)
+	assert.Contains(t, code, `portalId: "123456"`)
+	assert.Contains(t, code, `formId: "form-1"`)
+	assert.Contains(t, code, `region: "na1"`)
+
+	eu := embedCode("123456", "eu1", "form-1")
+	assert.Contains(t, eu, `src="//js-eu1.hsforms.net/forms/embed/v2.js"`)
+	assert.Contains(t, eu, `region: "eu1"`)
+
+	assert.Equal(t, code, embedCode("123456", "", "form-1"))
+}
+
+func TestParseFields(t *testing.T) {
+	fields, err := parseFields([]string{"email=test@example.com", "message=a=b, c"})
+	require.NoError(t, err)
+	assert.Equal(t, []api.FormSubmitField{
+		{Name: "email", Value: "test@example.com"},
+		{Name: "message", Value: "a=b, c"},
+	}, fields)
+
+	_, err = parseFields([]string{"email"})
+	assert.ErrorContains(t, err, "expected name=value")
+
+	_, err = parseFields([]string{"=value"})
+	assert.Error(t, err)
+}
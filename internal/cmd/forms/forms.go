@@ -7,6 +7,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the forms command and subcommands
@@ -14,12 +15,14 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "forms",
 		Short: "Manage HubSpot forms",
-		Long:  "Commands for listing and viewing forms and their submissions in HubSpot.",
+		Long:  "Commands for listing and viewing forms and their submissions, printing embed code, and sending test submissions.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
 	cmd.AddCommand(newSubmissionsCmd(opts))
+	cmd.AddCommand(newEmbedCmd(opts))
+	cmd.AddCommand(newSubmitTestCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -27,6 +30,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -45,10 +49,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListForms(api.ListOptions{
+			result, err := client.ListForms(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -89,6 +93,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of forms to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -109,7 +115,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			form, err := client.GetForm(id)
+			form, err := client.GetForm(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Form %s not found", id)
@@ -124,8 +130,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Name", form.Name},
 				{"Type", form.FormType},
 				{"Archived", formatBool(form.Archived)},
-				{"Created", form.CreatedAt},
-				{"Updated", form.UpdatedAt},
+				{"Created", v.Time(form.CreatedAt)},
+				{"Updated", v.Time(form.UpdatedAt)},
 			}
 
 			// Add field count
@@ -165,77 +171,6 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 	}
 }
 
-func newSubmissionsCmd(opts *root.Options) *cobra.Command {
-	var limit int
-	var after string
-
-	cmd := &cobra.Command{
-		Use:   "submissions <form-id>",
-		Short: "List form submissions",
-		Long:  "List submissions for a specific form.",
-		Example: `  # List submissions for a form
-  hspt forms submissions abc123-def456
-
-  # With pagination
-  hspt forms submissions abc123-def456 --limit 50`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-			formID := args[0]
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			result, err := client.GetFormSubmissions(formID, api.ListOptions{
-				Limit: limit,
-				After: after,
-			})
-			if err != nil {
-				if api.IsNotFound(err) {
-					v.Error("Form %s not found", formID)
-					return nil
-				}
-				return err
-			}
-
-			if len(result.Results) == 0 {
-				v.Info("No submissions found for form %s", formID)
-				return nil
-			}
-
-			// For submissions, we show a summary since values vary per form
-			headers := []string{"ID", "SUBMITTED AT", "FIELD COUNT"}
-			rows := make([][]string, 0, len(result.Results))
-			for _, sub := range result.Results {
-				rows = append(rows, []string{
-					sub.ID,
-					sub.SubmittedAt,
-					fmt.Sprintf("%d", len(sub.Values)),
-				})
-			}
-
-			v.Info("Found %d submission(s)", len(result.Results))
-
-			if err := v.Render(headers, rows, result); err != nil {
-				return err
-			}
-
-			if result.Paging != nil && result.Paging.Next != nil {
-				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
-			}
-
-			return nil
-		},
-	}
-
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of submissions to return")
-	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
-
-	return cmd
-}
-
 func formatBool(b bool) string {
 	if b {
 		return "Yes"
@@ -0,0 +1,163 @@
+package forms
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// submissionColumns returns the value columns for a form's submissions: the
+// form's fields in the order they appear on the form, then any other
+// submitted values (from fields since removed) sorted by name
+func submissionColumns(form *api.Form, submissions []api.FormSubmission) []string {
+	var columns []string
+	seen := make(map[string]bool)
+	for _, group := range form.FieldGroups {
+		for _, field := range group.Fields {
+			if field.Name != "" && !seen[field.Name] {
+				seen[field.Name] = true
+				columns = append(columns, field.Name)
+			}
+		}
+	}
+
+	var extra []string
+	for _, sub := range submissions {
+		for name := range sub.Values {
+			if !seen[name] {
+				seen[name] = true
+				extra = append(extra, name)
+			}
+		}
+	}
+	sort.Strings(extra)
+
+	return append(columns, extra...)
+}
+
+// submissionValue formats a submitted value for a cell; multiple selections
+// are joined with semicolons, as HubSpot does for checkbox properties
+func submissionValue(value interface{}) string {
+	switch val := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case []interface{}:
+		parts := make([]string, 0, len(val))
+		for _, item := range val {
+			parts = append(parts, submissionValue(item))
+		}
+		return strings.Join(parts, ";")
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// submissionTable lays out submissions with one column per form field
+func submissionTable(v *view.View, columns []string, submissions []api.FormSubmission) ([]string, [][]string) {
+	headers := []string{"ID", "SUBMITTED AT"}
+	for _, c := range columns {
+		headers = append(headers, strings.ToUpper(c))
+	}
+
+	rows := make([][]string, 0, len(submissions))
+	for _, sub := range submissions {
+		row := []string{sub.ID, v.Time(sub.SubmittedAt)}
+		for _, c := range columns {
+			row = append(row, submissionValue(sub.Values[c]))
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+func newSubmissionsCmd(opts *root.Options) *cobra.Command {
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "submissions <form-id>",
+		Short: "List form submissions",
+		Long: `List submissions for a specific form, with one column per form field in
+the order the fields appear on the form. Values submitted for fields that
+have since been removed from the form follow in extra columns, and multiple
+selections are joined with semicolons.
+
+Use --all -o csv for a raw dump of every submission.`,
+		Example: `  # List submissions for a form
+  hspt forms submissions abc123-def456
+
+  # With pagination
+  hspt forms submissions abc123-def456 --limit 50
+
+  # Every submission as CSV
+  hspt forms submissions abc123-def456 --all -o csv > submissions.csv`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			formID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			form, err := client.GetForm(cmd.Context(), formID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Form %s not found", formID)
+					return nil
+				}
+				return err
+			}
+
+			result, err := client.GetFormSubmissions(cmd.Context(), formID, pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No submissions found for form %s", formID)
+				return nil
+			}
+
+			headers, rows := submissionTable(v, submissionColumns(form, result.Results), result.Results)
+
+			v.Info("Found %d submission(s)", len(result.Results))
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of submissions to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
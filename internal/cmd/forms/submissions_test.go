@@ -0,0 +1,47 @@
+package forms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+func TestSubmissionColumns(t *testing.T) {
+	form := &api.Form{FieldGroups: []api.FormFieldGroup{
+		{Fields: []api.FormField{{Name: "firstname"}, {Name: "email"}}},
+		{Fields: []api.FormField{{Name: "interests"}, {Name: "email"}}},
+	}}
+	subs := []api.FormSubmission{
+		{Values: map[string]interface{}{"email": "a@example.com", "old_field": "x"}},
+		{Values: map[string]interface{}{"budget": "10k"}},
+	}
+
+	assert.Equal(t, []string{"firstname", "email", "interests", "budget", "old_field"}, submissionColumns(form, subs))
+}
+
+func TestSubmissionValue(t *testing.T) {
+	assert.Equal(t, "", submissionValue(nil))
+	assert.Equal(t, "Jane", submissionValue("Jane"))
+	assert.Equal(t, "42", submissionValue(float64(42)))
+	assert.Equal(t, "1.5", submissionValue(1.5))
+	assert.Equal(t, "true", submissionValue(true))
+	assert.Equal(t, "CRM;Email", submissionValue([]interface{}{"CRM", "Email"}))
+}
+
+func TestSubmissionTable(t *testing.T) {
+	v := view.New("csv", true)
+	v.Location = time.UTC
+	subs := []api.FormSubmission{
+		{ID: "s1", SubmittedAt: "2026-03-01T10:00:00Z", Values: map[string]interface{}{"email": "a@example.com", "interests": []interface{}{"CRM", "Email"}}},
+		{ID: "s2", Values: map[string]interface{}{"firstname": "Bo"}},
+	}
+
+	headers, rows := submissionTable(v, []string{"firstname", "email", "interests"}, subs)
+	assert.Equal(t, []string{"ID", "SUBMITTED AT", "FIRSTNAME", "EMAIL", "INTERESTS"}, headers)
+	assert.Equal(t, []string{"s1", v.Time("2026-03-01T10:00:00Z"), "", "a@example.com", "CRM;Email"}, rows[0])
+	assert.Equal(t, []string{"s2", "", "Bo", "", ""}, rows[1])
+}
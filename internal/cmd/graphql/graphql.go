@@ -28,6 +28,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 
 	cmd.AddCommand(newQueryCmd(opts))
 	cmd.AddCommand(newExploreCmd(opts))
+	cmd.AddCommand(newRunDirCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -35,6 +36,8 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newQueryCmd(opts *root.Options) *cobra.Command {
 	var queryFile string
 	var queryString string
+	var maxCost int
+	var estimate bool
 
 	cmd := &cobra.Command{
 		Use:   "query",
@@ -42,7 +45,14 @@ func newQueryCmd(opts *root.Options) *cobra.Command {
 		Long: `Execute a GraphQL query against HubSpot's unified API.
 
 The query can be provided via --file (path to a .graphql file) or
---query (inline query string). If both are provided, --file takes precedence.`,
+--query (inline query string). If both are provided, --file takes precedence.
+
+Before sending, the query's complexity is estimated from HubSpot's point
+model: each object returned costs 1 point, and a _collection field returns
+up to its limit (default 10) objects, multiplying the cost of everything
+nested beneath it. Queries estimated above HubSpot's 30,000 point limit
+print a warning; use --max-cost to refuse anything above a lower budget,
+or --estimate to print the breakdown without running the query.`,
 		Example: `  # Execute query from file
   hspt graphql query --file query.graphql
 
@@ -66,7 +76,13 @@ The query can be provided via --file (path to a .graphql file) or
         }
       }
     }
-  }'`,
+  }'
+
+  # Show the estimated cost without running the query
+  hspt graphql query --file query.graphql --estimate
+
+  # Refuse queries estimated above 5,000 points
+  hspt graphql query --file query.graphql --max-cost 5000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
@@ -84,12 +100,34 @@ The query can be provided via --file (path to a .graphql file) or
 				return fmt.Errorf("either --file or --query is required")
 			}
 
+			cost, err := api.EstimateGraphQLCost(query)
+			if err != nil {
+				if estimate || maxCost > 0 {
+					return err
+				}
+				// Leave malformed queries for HubSpot to report
+				v.Warning("Could not estimate query cost: %v", err)
+			}
+
+			if estimate {
+				return renderCost(v, cost)
+			}
+
+			if cost != nil {
+				if maxCost > 0 && cost.Total > maxCost {
+					return fmt.Errorf("estimated query cost %d exceeds --max-cost %d (see --estimate for a breakdown)", cost.Total, maxCost)
+				}
+				if cost.Total > api.GraphQLComplexityLimit {
+					v.Warning("Estimated query cost %d exceeds HubSpot's limit of %d points; the query will likely be rejected", cost.Total, api.GraphQLComplexityLimit)
+				}
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
 			}
 
-			result, err := client.ExecuteGraphQL(query, nil)
+			result, err := client.ExecuteGraphQL(cmd.Context(), query, nil)
 			if err != nil {
 				return err
 			}
@@ -116,7 +154,7 @@ The query can be provided via --file (path to a .graphql file) or
 					return fmt.Errorf("failed to format response: %w", err)
 				}
 
-				fmt.Println(string(formatted))
+				fmt.Fprintln(v.Out, string(formatted))
 			}
 
 			return nil
@@ -125,10 +163,27 @@ The query can be provided via --file (path to a .graphql file) or
 
 	cmd.Flags().StringVarP(&queryFile, "file", "f", "", "Path to a GraphQL query file")
 	cmd.Flags().StringVarP(&queryString, "query", "q", "", "Inline GraphQL query string")
+	cmd.Flags().IntVar(&maxCost, "max-cost", 0, "Refuse to run queries estimated above this many points")
+	cmd.Flags().BoolVar(&estimate, "estimate", false, "Print the estimated query cost without running it")
 
 	return cmd
 }
 
+// renderCost prints the estimated cost of each object and collection field
+func renderCost(v tableRenderer, cost *api.GraphQLCost) error {
+	headers := []string{"FIELD", "ITEMS", "POINTS"}
+	rows := make([][]string, 0, len(cost.Fields))
+	for _, f := range cost.Fields {
+		rows = append(rows, []string{f.Path, fmt.Sprintf("%d", f.Items), fmt.Sprintf("%d", f.Points)})
+	}
+	if err := v.Render(headers, rows, cost); err != nil {
+		return err
+	}
+
+	v.Info("\nEstimated cost: %d points (HubSpot limit: %d)", cost.Total, api.GraphQLComplexityLimit)
+	return nil
+}
+
 func newExploreCmd(opts *root.Options) *cobra.Command {
 	var typeName string
 	var fieldName string
@@ -159,7 +214,7 @@ specific type, and --field to show details of a specific field.`,
 				return err
 			}
 
-			schema, err := client.IntrospectSchema()
+			schema, err := client.IntrospectSchema(cmd.Context())
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// runResult is the outcome of one query file
+type runResult struct {
+	File   string `json:"file"`
+	Output string `json:"output,omitempty"`
+	Status string `json:"status"`
+	Cost   int    `json:"cost"`
+	Error  string `json:"error,omitempty"`
+}
+
+func newRunDirCmd(opts *root.Options) *cobra.Command {
+	var varsFile, outDir string
+	var maxCost int
+	var delay time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "run-dir <directory>",
+		Short: "Run every GraphQL query in a directory",
+		Long: `Execute every .graphql (or .gql) file in a directory, one at a time in
+name order, and write each response to a JSON file of the same name in
+--out (e.g. deals.graphql becomes deals.json).
+
+Variables from --vars are passed to every query. Rate-limited queries are
+retried with backoff; use --delay to space queries out further. A query
+that fails is reported and the run continues; the command exits non-zero
+if any query failed.`,
+		Example: `  # Nightly pull into results/
+  hspt graphql run-dir ./queries --vars common.json --out results/
+
+  # Skip expensive queries and pause between the rest
+  hspt graphql run-dir ./queries --out results/ --max-cost 5000 --delay 2s`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			dir := args[0]
+
+			files, err := queryFiles(dir)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				v.Info("No .graphql files found in %s", dir)
+				return nil
+			}
+
+			var variables map[string]interface{}
+			if varsFile != "" {
+				data, err := os.ReadFile(varsFile)
+				if err != nil {
+					return fmt.Errorf("failed to read vars file: %w", err)
+				}
+				if err := json.Unmarshal(data, &variables); err != nil {
+					return fmt.Errorf("invalid JSON in vars file: %w", err)
+				}
+			}
+
+			if outDir == "" {
+				outDir = dir
+			}
+			if err := os.MkdirAll(outDir, 0755); err != nil {
+				return fmt.Errorf("failed to create output directory: %w", err)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			results := make([]runResult, 0, len(files))
+			failed := 0
+			for i, file := range files {
+				// Stop at the first file after Ctrl-C rather than failing
+				// every remaining one
+				if err := cmd.Context().Err(); err != nil {
+					return err
+				}
+				if i > 0 && delay > 0 {
+					time.Sleep(delay)
+				}
+
+				res := runQueryFile(cmd.Context(), client, filepath.Join(dir, file), filepath.Join(outDir, outputName(file)), variables, maxCost)
+				res.File = file
+				if res.Status != "ok" {
+					failed++
+				}
+				results = append(results, res)
+			}
+
+			headers := []string{"FILE", "STATUS", "COST", "OUTPUT"}
+			rows := make([][]string, 0, len(results))
+			for _, r := range results {
+				status := r.Status
+				if r.Error != "" {
+					status = fmt.Sprintf("%s: %s", r.Status, r.Error)
+				}
+				rows = append(rows, []string{r.File, status, fmt.Sprintf("%d", r.Cost), r.Output})
+			}
+			if err := v.Render(headers, rows, results); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d queries failed", failed, len(results))
+			}
+			v.Success("Ran %d queries; results written to %s", len(results), outDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&varsFile, "vars", "", "JSON file of variables passed to every query")
+	cmd.Flags().StringVar(&outDir, "out", "", "Directory for result files (default: the query directory)")
+	cmd.Flags().IntVar(&maxCost, "max-cost", 0, "Skip queries estimated above this many points")
+	cmd.Flags().DurationVar(&delay, "delay", 0, "Pause between queries (e.g. 500ms, 2s)")
+
+	return cmd
+}
+
+// runQueryFile executes one query file and writes its response to out
+func runQueryFile(ctx context.Context, client *api.Client, path, out string, variables map[string]interface{}, maxCost int) runResult {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return runResult{Status: "failed", Error: err.Error()}
+	}
+	query := string(data)
+
+	res := runResult{}
+	if cost, err := api.EstimateGraphQLCost(query); err == nil {
+		res.Cost = cost.Total
+		if maxCost > 0 && cost.Total > maxCost {
+			res.Status = "skipped"
+			res.Error = fmt.Sprintf("estimated cost exceeds --max-cost %d", maxCost)
+			return res
+		}
+	}
+
+	// Rate-limited queries are retried by the client
+	result, err := client.ExecuteGraphQL(ctx, query, variables)
+	if err != nil {
+		res.Status = "failed"
+		res.Error = err.Error()
+		return res
+	}
+
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		res.Status = "failed"
+		res.Error = fmt.Sprintf("failed to format response: %v", err)
+		return res
+	}
+	if err := os.WriteFile(out, append(formatted, '\n'), 0644); err != nil {
+		res.Status = "failed"
+		res.Error = fmt.Sprintf("failed to write result: %v", err)
+		return res
+	}
+	res.Output = out
+
+	// The response is still written so partial data is kept
+	if result.HasErrors() {
+		res.Status = "errors"
+		res.Error = result.ErrorMessages()
+		return res
+	}
+
+	res.Status = "ok"
+	return res
+}
+
+// queryFiles returns the names of the GraphQL files in dir, sorted
+func queryFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read query directory: %w", err)
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".graphql", ".gql":
+			files = append(files, e.Name())
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// outputName maps a query file name to its result file name
+func outputName(file string) string {
+	return strings.TrimSuffix(file, filepath.Ext(file)) + ".json"
+}
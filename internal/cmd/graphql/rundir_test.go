@@ -0,0 +1,30 @@
+package graphql
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.graphql", "a.GQL", "notes.txt", "c.json"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.graphql"), 0755))
+
+	files, err := queryFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.GQL", "b.graphql"}, files)
+
+	_, err = queryFiles(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestOutputName(t *testing.T) {
+	assert.Equal(t, "deals.json", outputName("deals.graphql"))
+	assert.Equal(t, "daily.contacts.json", outputName("daily.contacts.gql"))
+}
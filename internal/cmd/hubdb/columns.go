@@ -0,0 +1,434 @@
+package hubdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// columnTypes are the HubDB column types that can be created from flags
+var columnTypes = map[string]bool{
+	"TEXT":        true,
+	"RICHTEXT":    true,
+	"NUMBER":      true,
+	"CURRENCY":    true,
+	"BOOLEAN":     true,
+	"DATE":        true,
+	"DATETIME":    true,
+	"URL":         true,
+	"IMAGE":       true,
+	"VIDEO":       true,
+	"FILE":        true,
+	"LOCATION":    true,
+	"SELECT":      true,
+	"MULTISELECT": true,
+	"FOREIGN_ID":  true,
+	"HUBSPOT_ID":  true,
+}
+
+// columnFlags holds the flags shared by "columns add" and "columns update"
+type columnFlags struct {
+	name        string
+	label       string
+	colType     string
+	description string
+	options     []string
+}
+
+func (f *columnFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.name, "name", "", "Column name (required)")
+	cmd.Flags().StringVar(&f.label, "label", "", "Column label shown in the HubDB editor")
+	cmd.Flags().StringVar(&f.colType, "type", "", "Column type (TEXT, NUMBER, SELECT, DATE, ...)")
+	cmd.Flags().StringVar(&f.description, "description", "", "Column description")
+	cmd.Flags().StringSliceVar(&f.options, "option", nil, "Choice for SELECT and MULTISELECT columns (comma-separated or repeatable)")
+}
+
+// parseColumnType validates a --type value, ignoring case
+func parseColumnType(t string) (string, error) {
+	upper := strings.ToUpper(strings.TrimSpace(t))
+	if !columnTypes[upper] {
+		types := make([]string, 0, len(columnTypes))
+		for ct := range columnTypes {
+			types = append(types, ct)
+		}
+		sort.Strings(types)
+		return "", fmt.Errorf("invalid column type %q (use one of %s)", t, strings.Join(types, ", "))
+	}
+	return upper, nil
+}
+
+// columnOptions turns --option values into select options, in order
+func columnOptions(names []string) []api.HubDBOption {
+	var options []api.HubDBOption
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		options = append(options, api.HubDBOption{Name: name, Label: name, Order: len(options)})
+	}
+	return options
+}
+
+// findColumn returns the index of the named column, or -1
+func findColumn(columns []api.HubDBColumn, name string) int {
+	for i, c := range columns {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// countColumnValues counts the rows holding a non-empty value in a column
+func countColumnValues(rows []api.HubDBRow, name string) int {
+	n := 0
+	for _, row := range rows {
+		switch v := row.Values[name].(type) {
+		case nil:
+		case string:
+			if v != "" {
+				n++
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				n++
+			}
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// rowsWithValues counts the draft rows of a table holding a value in a column
+func rowsWithValues(ctx context.Context, client *api.Client, table, column string) (int, error) {
+	rows, err := client.ListHubDBDraftRows(ctx, table, api.ListOptions{All: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list table rows: %w", err)
+	}
+	return countColumnValues(rows.Results, column), nil
+}
+
+func newColumnsCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "columns",
+		Short: "Manage HubDB table columns",
+		Long: `Commands for listing, adding, updating, and removing the columns of a HubDB
+table without re-posting the whole table definition.
+
+Column changes are made to the table draft. Publish the table to make them
+live.`,
+	}
+
+	cmd.AddCommand(newColumnsListCmd(opts))
+	cmd.AddCommand(newColumnsAddCmd(opts))
+	cmd.AddCommand(newColumnsUpdateCmd(opts))
+	cmd.AddCommand(newColumnsRemoveCmd(opts))
+
+	return cmd
+}
+
+func newColumnsListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <tableIdOrName>",
+		Short: "List the columns of a HubDB table",
+		Long:  "List the columns of a HubDB table draft, including unpublished changes.",
+		Example: `  # List columns
+  hspt hubdb columns list my_table`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			table, err := client.GetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			if len(table.Columns) == 0 {
+				v.Info("Table %s has no columns", tableIDOrName)
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "LABEL", "TYPE", "OPTIONS"}
+			rows := make([][]string, 0, len(table.Columns))
+			for _, c := range table.Columns {
+				names := make([]string, 0, len(c.Options))
+				for _, o := range c.Options {
+					names = append(names, o.Name)
+				}
+				rows = append(rows, []string{
+					c.ID,
+					c.Name,
+					c.Label,
+					c.Type,
+					truncate(strings.Join(names, ", "), 40),
+				})
+			}
+
+			return v.Render(headers, rows, table.Columns)
+		},
+	}
+}
+
+func newColumnsAddCmd(opts *root.Options) *cobra.Command {
+	var flags columnFlags
+
+	cmd := &cobra.Command{
+		Use:   "add <tableIdOrName>",
+		Short: "Add a column to a HubDB table",
+		Long:  "Add a column to a HubDB table draft. Remember to publish the table to make changes live.",
+		Example: `  # Add a number column
+  hspt hubdb columns add my_table --name price --type NUMBER --label Price
+
+  # Add a select column with choices
+  hspt hubdb columns add my_table --name size --type SELECT --option small,medium,large`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			if flags.name == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if flags.colType == "" {
+				return fmt.Errorf("--type is required")
+			}
+			colType, err := parseColumnType(flags.colType)
+			if err != nil {
+				return err
+			}
+
+			column := api.HubDBColumn{
+				Name:        flags.name,
+				Label:       flags.label,
+				Type:        colType,
+				Description: flags.description,
+				Options:     columnOptions(flags.options),
+			}
+			if column.Label == "" {
+				column.Label = flags.name
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			table, err := client.GetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			if findColumn(table.Columns, flags.name) >= 0 {
+				return fmt.Errorf("table %s already has a column named %s", tableIDOrName, flags.name)
+			}
+			table.Columns = append(table.Columns, column)
+
+			if _, err := client.UpdateHubDBTableDraft(cmd.Context(), tableIDOrName, table); err != nil {
+				return fmt.Errorf("failed to add column: %w", err)
+			}
+
+			v.Success("Column %s (%s) added to table %s", flags.name, colType, tableIDOrName)
+			v.Info("Note: Changes are in draft mode. Use 'hspt hubdb tables publish %s' to publish.", tableIDOrName)
+			return nil
+		},
+	}
+
+	flags.register(cmd)
+
+	return cmd
+}
+
+func newColumnsUpdateCmd(opts *root.Options) *cobra.Command {
+	var flags columnFlags
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "update <tableIdOrName>",
+		Short: "Update a column of a HubDB table",
+		Long: `Change the label, type, description, or choices of a HubDB table column in
+the table draft. Remember to publish the table to make changes live.
+
+Changing the type of a column that holds data can lose that data, so it
+needs --force.`,
+		Example: `  # Relabel a column
+  hspt hubdb columns update my_table --name price --label "Price (USD)"
+
+  # Change a column's type, even though rows have values
+  hspt hubdb columns update my_table --name price --type CURRENCY --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			if flags.name == "" {
+				return fmt.Errorf("--name is required")
+			}
+			var colType string
+			if flags.colType != "" {
+				var err error
+				if colType, err = parseColumnType(flags.colType); err != nil {
+					return err
+				}
+			}
+			if flags.label == "" && colType == "" && flags.description == "" && len(flags.options) == 0 {
+				return fmt.Errorf("nothing to update: pass --label, --type, --description, or --option")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			table, err := client.GetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			i := findColumn(table.Columns, flags.name)
+			if i < 0 {
+				v.Error("Table %s has no column named %s", tableIDOrName, flags.name)
+				return nil
+			}
+			column := &table.Columns[i]
+
+			if colType != "" && colType != column.Type && !force {
+				n, err := rowsWithValues(cmd.Context(), client, tableIDOrName, column.Name)
+				if err != nil {
+					return err
+				}
+				if n > 0 {
+					v.Warning("%d rows of table %s have a value in column %s; changing its type from %s to %s can lose them. Use --force to confirm.",
+						n, tableIDOrName, column.Name, column.Type, colType)
+					return nil
+				}
+			}
+
+			if flags.label != "" {
+				column.Label = flags.label
+			}
+			if colType != "" {
+				column.Type = colType
+			}
+			if flags.description != "" {
+				column.Description = flags.description
+			}
+			if len(flags.options) > 0 {
+				column.Options = columnOptions(flags.options)
+			}
+
+			if _, err := client.UpdateHubDBTableDraft(cmd.Context(), tableIDOrName, table); err != nil {
+				return fmt.Errorf("failed to update column: %w", err)
+			}
+
+			v.Success("Column %s of table %s updated", flags.name, tableIDOrName)
+			v.Info("Note: Changes are in draft mode. Use 'hspt hubdb tables publish %s' to publish.", tableIDOrName)
+			return nil
+		},
+	}
+
+	flags.register(cmd)
+	cmd.Flags().BoolVar(&force, "force", false, "Change the type even if rows have values in the column")
+
+	return cmd
+}
+
+func newColumnsRemoveCmd(opts *root.Options) *cobra.Command {
+	var name string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "remove <tableIdOrName>",
+		Short: "Remove a column from a HubDB table",
+		Long: `Remove a column from a HubDB table draft. Remember to publish the table to
+make changes live.
+
+Removing a column deletes its values from every row, so a column that holds
+data is only removed with --force.`,
+		Example: `  # Remove an empty column
+  hspt hubdb columns remove my_table --name legacy_code
+
+  # Remove a column and its data
+  hspt hubdb columns remove my_table --name legacy_code --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			if name == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			table, err := client.GetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			i := findColumn(table.Columns, name)
+			if i < 0 {
+				v.Error("Table %s has no column named %s", tableIDOrName, name)
+				return nil
+			}
+
+			if !force {
+				n, err := rowsWithValues(cmd.Context(), client, tableIDOrName, name)
+				if err != nil {
+					return err
+				}
+				if n > 0 {
+					v.Warning("%d rows of table %s have a value in column %s; removing it deletes them. Use --force to confirm.", n, tableIDOrName, name)
+					return nil
+				}
+			}
+
+			table.Columns = append(table.Columns[:i], table.Columns[i+1:]...)
+
+			if _, err := client.UpdateHubDBTableDraft(cmd.Context(), tableIDOrName, table); err != nil {
+				return fmt.Errorf("failed to remove column: %w", err)
+			}
+
+			v.Success("Column %s removed from table %s", name, tableIDOrName)
+			v.Info("Note: Changes are in draft mode. Use 'hspt hubdb tables publish %s' to publish.", tableIDOrName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Column name (required)")
+	cmd.Flags().BoolVar(&force, "force", false, "Remove the column even if rows have values in it")
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package hubdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseColumnType(t *testing.T) {
+	ct, err := parseColumnType("number")
+	require.NoError(t, err)
+	assert.Equal(t, "NUMBER", ct)
+
+	_, err = parseColumnType("DECIMAL")
+	assert.ErrorContains(t, err, "use one of BOOLEAN, CURRENCY")
+}
+
+func TestColumnOptions(t *testing.T) {
+	assert.Equal(t, []api.HubDBOption{
+		{Name: "small", Label: "small", Order: 0},
+		{Name: "large", Label: "large", Order: 1},
+	}, columnOptions([]string{"small", " ", "large"}))
+	assert.Nil(t, columnOptions(nil))
+}
+
+func TestFindColumn(t *testing.T) {
+	columns := []api.HubDBColumn{{Name: "name"}, {Name: "price"}}
+	assert.Equal(t, 1, findColumn(columns, "price"))
+	assert.Equal(t, -1, findColumn(columns, "size"))
+}
+
+func TestCountColumnValues(t *testing.T) {
+	rows := []api.HubDBRow{
+		{Values: map[string]interface{}{"price": 10.0}},
+		{Values: map[string]interface{}{"price": ""}},
+		{Values: map[string]interface{}{"price": nil}},
+		{Values: map[string]interface{}{"tags": []interface{}{}}},
+		{Values: map[string]interface{}{"tags": []interface{}{"a"}, "price": false}},
+		{},
+	}
+	assert.Equal(t, 2, countColumnValues(rows, "price"))
+	assert.Equal(t, 1, countColumnValues(rows, "tags"))
+	assert.Equal(t, 0, countColumnValues(rows, "size"))
+}
@@ -0,0 +1,240 @@
+package hubdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// rowChange is one difference between the published rows of a table and
+// its draft
+type rowChange struct {
+	Change string `json:"change"`
+	RowID  string `json:"rowId"`
+	// Field is the changed column, or hs_path or hs_name; it is empty for
+	// added and removed rows
+	Field     string `json:"field,omitempty"`
+	Published string `json:"published,omitempty"`
+	Draft     string `json:"draft,omitempty"`
+}
+
+// draftDiff is the JSON output of tables draft-diff
+type draftDiff struct {
+	Table   string         `json:"table"`
+	Rows    []rowChange    `json:"rows"`
+	Columns []schemaChange `json:"columns"`
+}
+
+// rowLabel names a row for added and removed rows
+func rowLabel(r api.HubDBRow) string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.Path
+}
+
+// diffColumns returns the live columns of the draft followed by live
+// columns only the published table has, so values of columns removed in
+// the draft show as changes
+func diffColumns(published, draft *api.HubDBTable) []api.HubDBColumn {
+	var columns []api.HubDBColumn
+	seen := make(map[string]bool)
+	for _, t := range []*api.HubDBTable{draft, published} {
+		for _, c := range t.Columns {
+			if !c.Archived && !seen[c.Name] {
+				seen[c.Name] = true
+				columns = append(columns, c)
+			}
+		}
+	}
+	return columns
+}
+
+// diffRows compares rows by ID. Rows appear in published order, then rows
+// only the draft has. Values are compared as 'rows export' writes them, so
+// unchanged option and file objects are equal even if HubDB returns them
+// with different metadata.
+func diffRows(columns []api.HubDBColumn, published, draft []api.HubDBRow) []rowChange {
+	changes := []rowChange{}
+
+	draftByID := make(map[string]api.HubDBRow, len(draft))
+	for _, r := range draft {
+		draftByID[r.ID] = r
+	}
+	seen := make(map[string]bool, len(published))
+	for _, p := range published {
+		seen[p.ID] = true
+		d, ok := draftByID[p.ID]
+		if !ok {
+			changes = append(changes, rowChange{Change: schemaRemoved, RowID: p.ID, Published: rowLabel(p)})
+			continue
+		}
+		fields := [][3]string{
+			{rowPathHeader, p.Path, d.Path},
+			{rowNameHeader, p.Name, d.Name},
+		}
+		for _, c := range columns {
+			fields = append(fields, [3]string{c.Name, csvCell(c.Type, p.Values[c.Name]), csvCell(c.Type, d.Values[c.Name])})
+		}
+		for _, f := range fields {
+			if f[1] != f[2] {
+				changes = append(changes, rowChange{Change: schemaChanged, RowID: p.ID, Field: f[0], Published: f[1], Draft: f[2]})
+			}
+		}
+	}
+	for _, d := range draft {
+		if !seen[d.ID] {
+			changes = append(changes, rowChange{Change: schemaAdded, RowID: d.ID, Draft: rowLabel(d)})
+		}
+	}
+
+	return changes
+}
+
+// compareDraft reads a table and its rows, published and draft, and
+// compares them
+func compareDraft(ctx context.Context, client *api.Client, tableIDOrName string) (*draftDiff, error) {
+	published, err := client.GetHubDBTable(ctx, tableIDOrName)
+	if err != nil {
+		return nil, err
+	}
+	draft, err := client.GetHubDBTableDraft(ctx, tableIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedRows, err := client.ListHubDBRows(ctx, tableIDOrName, api.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published rows: %w", err)
+	}
+	draftRows, err := client.ListHubDBDraftRows(ctx, tableIDOrName, api.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft rows: %w", err)
+	}
+
+	return &draftDiff{
+		Table:   tableIDOrName,
+		Rows:    diffRows(diffColumns(published, draft), publishedRows.Results, draftRows.Results),
+		Columns: diffSchemas(published, draft),
+	}, nil
+}
+
+func newTablesDraftDiffCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "draft-diff <tableIdOrName>",
+		Short: "Show the unpublished changes in a HubDB table draft",
+		Long: `Compare a HubDB table's draft with the published table row by row and
+report the rows the draft adds and removes, and the changed path, name, and
+column values of rows in both. Values are shown as 'hubdb rows export' writes
+them.
+
+Column and setting changes in the draft are counted, and listed with
+-o json.`,
+		Example: `  # Review the draft before publishing
+  hspt hubdb tables draft-diff products
+
+  # As JSON, with column changes
+  hspt hubdb tables draft-diff products -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			d, err := compareDraft(cmd.Context(), client, tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(d)
+			}
+			if len(d.Columns) > 0 {
+				v.Info("The draft also has %d column or setting change(s); use -o json to list them", len(d.Columns))
+			}
+			if len(d.Rows) == 0 {
+				v.Info("The rows of the %s draft match the published table", tableIDOrName)
+				return nil
+			}
+
+			headers := []string{"CHANGE", "ROW ID", "FIELD", "PUBLISHED", "DRAFT"}
+			rows := make([][]string, 0, len(d.Rows))
+			for _, c := range d.Rows {
+				rows = append(rows, []string{c.Change, c.RowID, c.Field, c.Published, c.Draft})
+			}
+			return v.Render(headers, rows, d)
+		},
+	}
+}
+
+func newTablesRevertCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "revert <tableIdOrName>",
+		Short: "Discard the unpublished changes in a HubDB table draft",
+		Long: `Reset a HubDB table's draft to the published table, discarding unpublished
+row, column, and setting changes.
+
+If the draft has changes, revert lists how many and needs --force to discard
+them.`,
+		Example: `  # Check what would be lost, then revert
+  hspt hubdb tables draft-diff products
+  hspt hubdb tables revert products --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				d, err := compareDraft(cmd.Context(), client, tableIDOrName)
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("HubDB table %s not found", tableIDOrName)
+						return nil
+					}
+					return err
+				}
+				if len(d.Rows) > 0 || len(d.Columns) > 0 {
+					v.Warning("The %s draft has %d row change(s) and %d column or setting change(s); reverting discards them. Use --force to confirm.",
+						tableIDOrName, len(d.Rows), len(d.Columns))
+					return nil
+				}
+			}
+
+			table, err := client.ResetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return fmt.Errorf("failed to revert draft: %w", err)
+			}
+
+			v.Success("Draft of table %s reset to the published version", table.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Discard draft changes without confirmation")
+
+	return cmd
+}
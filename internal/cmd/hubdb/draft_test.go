@@ -0,0 +1,53 @@
+package hubdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestDiffColumns(t *testing.T) {
+	published := &api.HubDBTable{Columns: []api.HubDBColumn{{Name: "name"}, {Name: "legacy"}}}
+	draft := &api.HubDBTable{Columns: []api.HubDBColumn{{Name: "name"}, {Name: "price"}, {Name: "old", Archived: true}}}
+
+	var names []string
+	for _, c := range diffColumns(published, draft) {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"name", "price", "legacy"}, names)
+}
+
+func TestDiffRows(t *testing.T) {
+	columns := []api.HubDBColumn{
+		{Name: "title", Type: "TEXT"},
+		{Name: "size", Type: "SELECT"},
+		{Name: "launch", Type: "DATE"},
+	}
+	published := []api.HubDBRow{
+		{ID: "1", Path: "widget", Name: "Widget", Values: map[string]interface{}{
+			"title":  "Widget",
+			"size":   map[string]interface{}{"id": "1", "name": "small", "order": float64(0)},
+			"launch": float64(1704067200000),
+		}},
+		{ID: "2", Name: "Gadget", Values: map[string]interface{}{"title": "Gadget"}},
+	}
+	draft := []api.HubDBRow{
+		{ID: "1", Path: "widget-2", Name: "Widget", Values: map[string]interface{}{
+			"title":  "Widget",
+			"size":   map[string]interface{}{"id": "1", "name": "small", "order": float64(3)},
+			"launch": float64(1706745600000),
+		}},
+		{ID: "3", Path: "gizmo", Values: map[string]interface{}{"title": "Gizmo"}},
+	}
+
+	assert.Equal(t, []rowChange{
+		{Change: schemaChanged, RowID: "1", Field: "hs_path", Published: "widget", Draft: "widget-2"},
+		{Change: schemaChanged, RowID: "1", Field: "launch", Published: "2024-01-01", Draft: "2024-02-01"},
+		{Change: schemaRemoved, RowID: "2", Published: "Gadget"},
+		{Change: schemaAdded, RowID: "3", Draft: "gizmo"},
+	}, diffRows(columns, published, draft))
+
+	assert.Empty(t, diffRows(columns, published, published))
+}
@@ -9,17 +9,19 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the hubdb command and subcommands
 func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "hubdb",
-		Short: "Manage HubDB tables and rows",
+		Short: "Manage HubDB tables, columns, and rows",
 		Long:  "Commands for managing HubDB tables and rows. HubDB uses a draft/publish workflow.",
 	}
 
 	cmd.AddCommand(newTablesCmd(opts))
+	cmd.AddCommand(newColumnsCmd(opts))
 	cmd.AddCommand(newRowsCmd(opts))
 
 	parent.AddCommand(cmd)
@@ -29,7 +31,7 @@ func newTablesCmd(opts *root.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tables",
 		Short: "Manage HubDB tables",
-		Long:  "Commands for listing, viewing, creating, publishing, and deleting HubDB tables.",
+		Long:  "Commands for listing, viewing, creating, cloning, comparing, publishing, and deleting HubDB tables.",
 	}
 
 	cmd.AddCommand(newTablesListCmd(opts))
@@ -37,6 +39,10 @@ func newTablesCmd(opts *root.Options) *cobra.Command {
 	cmd.AddCommand(newTablesCreateCmd(opts))
 	cmd.AddCommand(newTablesDeleteCmd(opts))
 	cmd.AddCommand(newTablesPublishCmd(opts))
+	cmd.AddCommand(newTablesCloneCmd(opts))
+	cmd.AddCommand(newTablesDiffCmd(opts))
+	cmd.AddCommand(newTablesDraftDiffCmd(opts))
+	cmd.AddCommand(newTablesRevertCmd(opts))
 
 	return cmd
 }
@@ -44,6 +50,7 @@ func newTablesCmd(opts *root.Options) *cobra.Command {
 func newTablesListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -62,10 +69,10 @@ func newTablesListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListHubDBTables(api.ListOptions{
+			result, err := client.ListHubDBTables(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -102,6 +109,8 @@ func newTablesListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of tables to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -125,7 +134,7 @@ func newTablesGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			table, err := client.GetHubDBTable(tableIDOrName)
+			table, err := client.GetHubDBTable(cmd.Context(), tableIDOrName)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("HubDB table %s not found", tableIDOrName)
@@ -142,8 +151,8 @@ func newTablesGetCmd(opts *root.Options) *cobra.Command {
 				{"Row Count", fmt.Sprintf("%d", table.RowCount)},
 				{"Published", formatBool(table.Published)},
 				{"Public API Access", formatBool(table.AllowPublicAPIAccess)},
-				{"Created", table.CreatedAt},
-				{"Updated", table.UpdatedAt},
+				{"Created", v.Time(table.CreatedAt)},
+				{"Updated", v.Time(table.UpdatedAt)},
 			}
 
 			if table.PublishedAt != "" {
@@ -190,7 +199,7 @@ func newTablesCreateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			table, err := client.CreateHubDBTable(tableData)
+			table, err := client.CreateHubDBTable(cmd.Context(), tableData)
 			if err != nil {
 				return err
 			}
@@ -226,7 +235,7 @@ func newTablesDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteHubDBTable(tableIDOrName)
+			err = client.DeleteHubDBTable(cmd.Context(), tableIDOrName)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("HubDB table %s not found", tableIDOrName)
@@ -241,46 +250,11 @@ func newTablesDeleteCmd(opts *root.Options) *cobra.Command {
 	}
 }
 
-func newTablesPublishCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
-		Use:   "publish <tableIdOrName>",
-		Short: "Publish a HubDB table",
-		Long:  "Publish a HubDB table draft to make changes live.",
-		Example: `  # Publish table by ID
-  hspt hubdb tables publish 12345
-
-  # Publish table by name
-  hspt hubdb tables publish my_table`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-			tableIDOrName := args[0]
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			table, err := client.PublishHubDBTable(tableIDOrName)
-			if err != nil {
-				if api.IsNotFound(err) {
-					v.Error("HubDB table %s not found", tableIDOrName)
-					return nil
-				}
-				return err
-			}
-
-			v.Success("HubDB table %s published", table.ID)
-			return nil
-		},
-	}
-}
-
 func newRowsCmd(opts *root.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rows",
 		Short: "Manage HubDB table rows",
-		Long:  "Commands for listing, viewing, creating, updating, and deleting HubDB table rows.",
+		Long:  "Commands for listing, viewing, creating, updating, and deleting HubDB table rows, and for importing and exporting them as CSV.",
 	}
 
 	cmd.AddCommand(newRowsListCmd(opts))
@@ -288,6 +262,8 @@ func newRowsCmd(opts *root.Options) *cobra.Command {
 	cmd.AddCommand(newRowsCreateCmd(opts))
 	cmd.AddCommand(newRowsUpdateCmd(opts))
 	cmd.AddCommand(newRowsDeleteCmd(opts))
+	cmd.AddCommand(newRowsImportCmd(opts))
+	cmd.AddCommand(newRowsExportCmd(opts))
 
 	return cmd
 }
@@ -295,30 +271,56 @@ func newRowsCmd(opts *root.Options) *cobra.Command {
 func newRowsListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var filterArgs []string
+	var sortArgs []string
+	var draft bool
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list <tableIdOrName>",
 		Short: "List rows in a HubDB table",
-		Long:  "List rows from a HubDB table.",
+		Long: `List rows from a HubDB table.
+
+--filter and --sort are passed to HubDB as query parameters, so only the
+matching rows are fetched. A filter is column=value (or !=, <, <=, >, >=),
+column:operator:value with a HubDB operator such as contains, icontains,
+startswith, in, or not_in, or column:is_null / column:not_null. --draft lists
+the draft table, including rows not yet published.`,
 		Example: `  # List rows in a table
   hspt hubdb rows list my_table
 
   # List with pagination
-  hspt hubdb rows list my_table --limit 50`,
+  hspt hubdb rows list my_table --limit 50
+
+  # Find a row by column value
+  hspt hubdb rows list my_table --filter "sku=WID-100"
+
+  # Cheapest in-stock widgets first, from the unpublished draft
+  hspt hubdb rows list my_table --filter "name:icontains:widget" --filter "stock>0" --sort price --draft`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 			tableIDOrName := args[0]
 
+			filters, err := parseRowFilters(filterArgs)
+			if err != nil {
+				return err
+			}
+			sorts, err := parseRowSort(sortArgs)
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
 			}
 
-			result, err := client.ListHubDBRows(tableIDOrName, api.ListOptions{
+			query := api.HubDBRowQuery{Filters: filters, Sort: sorts, Draft: draft}
+			result, err := client.QueryHubDBRows(cmd.Context(), tableIDOrName, query, pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("HubDB table %s not found", tableIDOrName)
@@ -357,6 +359,11 @@ func newRowsListCmd(opts *root.Options) *cobra.Command {
 
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of rows to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+	cmd.Flags().StringArrayVar(&filterArgs, "filter", nil, "Filter condition (e.g. column=value, column>=value, or column:operator:value); repeatable")
+	cmd.Flags().StringArrayVar(&sortArgs, "sort", nil, "Column to sort by (column or -column for descending, or column:desc); repeatable")
+	cmd.Flags().BoolVar(&draft, "draft", false, "List rows from the table draft, including unpublished rows")
+
+	shared.AddAllPagesFlags(cmd, &pages)
 
 	return cmd
 }
@@ -379,7 +386,7 @@ func newRowsGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			row, err := client.GetHubDBRow(tableIDOrName, rowID)
+			row, err := client.GetHubDBRow(cmd.Context(), tableIDOrName, rowID)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Row %s not found in table %s", rowID, tableIDOrName)
@@ -393,8 +400,8 @@ func newRowsGetCmd(opts *root.Options) *cobra.Command {
 				{"ID", row.ID},
 				{"Path", row.Path},
 				{"Name", row.Name},
-				{"Created", row.CreatedAt},
-				{"Updated", row.UpdatedAt},
+				{"Created", v.Time(row.CreatedAt)},
+				{"Updated", v.Time(row.UpdatedAt)},
 			}
 
 			// Add row values
@@ -441,7 +448,7 @@ func newRowsCreateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			row, err := client.CreateHubDBRow(tableIDOrName, rowData)
+			row, err := client.CreateHubDBRow(cmd.Context(), tableIDOrName, rowData)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("HubDB table %s not found", tableIDOrName)
@@ -495,7 +502,7 @@ func newRowsUpdateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			row, err := client.UpdateHubDBRow(tableIDOrName, rowID, updates)
+			row, err := client.UpdateHubDBRow(cmd.Context(), tableIDOrName, rowID, updates)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Row %s not found in table %s", rowID, tableIDOrName)
@@ -533,7 +540,7 @@ func newRowsDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteHubDBRow(tableIDOrName, rowID)
+			err = client.DeleteHubDBRow(cmd.Context(), tableIDOrName, rowID)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Row %s not found in table %s", rowID, tableIDOrName)
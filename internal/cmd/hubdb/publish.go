@@ -0,0 +1,169 @@
+package hubdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// pollInterval is how often --wait checks the dynamic pages of a table
+const pollInterval = 5 * time.Second
+
+// dynamicPage is a page built from a HubDB table
+type dynamicPage struct {
+	Type api.PageType
+	Page api.Page
+}
+
+// dependentPages returns the site and landing pages that use a HubDB table
+// as their dynamic data source
+func dependentPages(ctx context.Context, client *api.Client, tableID string) ([]dynamicPage, error) {
+	var pages []dynamicPage
+	for _, pageType := range []api.PageType{api.PageTypeSite, api.PageTypeLanding} {
+		list, err := client.ListPages(ctx, pageType, api.ListOptions{All: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", pageType, err)
+		}
+		for _, p := range list.Results {
+			if p.UsesHubDBTable(tableID) {
+				pages = append(pages, dynamicPage{Type: pageType, Page: p})
+			}
+		}
+	}
+	return pages, nil
+}
+
+// updatedSince reports whether a page was rebuilt at or after t
+func updatedSince(p api.Page, t time.Time) bool {
+	updated, err := time.Parse(time.RFC3339, p.UpdatedAt)
+	if err != nil {
+		return false
+	}
+	return !updated.Before(t)
+}
+
+// waitForPages checks pages every interval until each was updated at or
+// after since. It returns the pages still pending when ctx ends, with the
+// context's error.
+func waitForPages(ctx context.Context, client *api.Client, pages []dynamicPage, since time.Time, interval time.Duration, sleep func(context.Context, time.Duration) error) ([]dynamicPage, error) {
+	pending := pages
+	for {
+		var still []dynamicPage
+		for _, p := range pending {
+			page, err := client.GetPage(ctx, p.Type, p.Page.ID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return pending, ctx.Err()
+				}
+				return pending, fmt.Errorf("failed to check page %s: %w", p.Page.ID, err)
+			}
+			if !updatedSince(*page, since) {
+				still = append(still, dynamicPage{Type: p.Type, Page: *page})
+			}
+		}
+		pending = still
+		if len(pending) == 0 {
+			return nil, nil
+		}
+		if err := sleep(ctx, interval); err != nil {
+			return pending, err
+		}
+	}
+}
+
+func newTablesPublishCmd(opts *root.Options) *cobra.Command {
+	var wait bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "publish <tableIdOrName>",
+		Short: "Publish a HubDB table",
+		Long: `Publish a HubDB table draft to make changes live.
+
+Dynamic pages built from the table are rebuilt after publishing. With --wait,
+hspt finds the site and landing pages that use the table as their data source
+and checks every few seconds until each has been updated, so you know when
+the new content is actually live.`,
+		Example: `  # Publish table by ID
+  hspt hubdb tables publish 12345
+
+  # Publish table by name
+  hspt hubdb tables publish my_table
+
+  # Publish and wait for the table's dynamic pages to rebuild
+  hspt hubdb tables publish my_table --wait --wait-timeout 10m`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			started := time.Now()
+			table, err := client.PublishHubDBTable(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("HubDB table %s published", table.ID)
+			if !wait {
+				return nil
+			}
+			// Polling must see each page's latest state, not a cached one
+			client.Cache = nil
+
+			// Compare against HubSpot's publish time when it is given, so
+			// clock skew does not hide or invent rebuilds
+			since := started
+			if published, err := time.Parse(time.RFC3339, table.PublishedAt); err == nil {
+				since = published
+			}
+
+			pages, err := dependentPages(cmd.Context(), client, table.ID)
+			if err != nil {
+				return err
+			}
+			if len(pages) == 0 {
+				v.Info("No pages use table %s as a data source; the changes are live", table.ID)
+				return nil
+			}
+
+			v.Info("Waiting for %d dynamic page(s) to rebuild...", len(pages))
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			pending, err := waitForPages(ctx, client, pages, since, pollInterval, api.SleepContext)
+			if err != nil {
+				for _, p := range pending {
+					v.Warning("Page %s (%s) has not rebuilt yet", p.Page.ID, p.Page.Name)
+				}
+				if errors.Is(err, context.DeadlineExceeded) && cmd.Context().Err() == nil {
+					return fmt.Errorf("%d of %d page(s) had not rebuilt after %s", len(pending), len(pages), timeout)
+				}
+				return err
+			}
+
+			v.Success("%d dynamic page(s) rebuilt; the changes are live (%s)", len(pages), time.Since(started).Round(time.Second))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait until the table's dynamic pages have rebuilt")
+	cmd.Flags().DurationVar(&timeout, "wait-timeout", 5*time.Minute, "How long --wait waits for pages to rebuild")
+
+	return cmd
+}
@@ -0,0 +1,88 @@
+package hubdb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestDependentPages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cms/v3/pages/site-pages":
+			w.Write([]byte(`{"results": [
+				{"id": "1", "name": "Products", "dynamicPageDataSourceType": 1, "dynamicPageDataSourceId": "123"},
+				{"id": "2", "name": "About"}
+			]}`))
+		case "/cms/v3/pages/landing-pages":
+			w.Write([]byte(`{"results": [{"id": "3", "name": "Offers", "dynamicPageHubDbTableId": "123"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	pages, err := dependentPages(context.Background(), client, "123")
+	require.NoError(t, err)
+	require.Len(t, pages, 2)
+	assert.Equal(t, api.PageTypeSite, pages[0].Type)
+	assert.Equal(t, "1", pages[0].Page.ID)
+	assert.Equal(t, api.PageTypeLanding, pages[1].Type)
+	assert.Equal(t, "3", pages[1].Page.ID)
+}
+
+func TestWaitForPages(t *testing.T) {
+	since := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cms/v3/pages/site-pages/1", r.URL.Path)
+		calls++
+		updated := "2026-06-01T11:59:00Z"
+		if calls >= 3 {
+			updated = "2026-06-01T12:00:05.250Z"
+		}
+		w.Write([]byte(`{"id": "1", "updated": "` + updated + `"}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	var slept []time.Duration
+	sleep := func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	pages := []dynamicPage{{Type: api.PageTypeSite, Page: api.Page{ID: "1"}}}
+	pending, err := waitForPages(context.Background(), client, pages, since, time.Second, sleep)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, slept)
+}
+
+func TestWaitForPages_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "1", "name": "Products", "updated": "2026-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	sleep := func(context.Context, time.Duration) error { return context.DeadlineExceeded }
+
+	pages := []dynamicPage{{Type: api.PageTypeSite, Page: api.Page{ID: "1"}}}
+	pending, err := waitForPages(context.Background(), client, pages, time.Now(), time.Second, sleep)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "Products", pending[0].Page.Name)
+}
@@ -0,0 +1,155 @@
+package hubdb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// rowOperators are the filter operators the HubDB rows API accepts, and
+// whether each takes a value
+var rowOperators = map[string]bool{
+	"eq":         true,
+	"ne":         true,
+	"lt":         true,
+	"lte":        true,
+	"gt":         true,
+	"gte":        true,
+	"contains":   true,
+	"icontains":  true,
+	"startswith": true,
+	"like":       true,
+	"not_like":   true,
+	"in":         true,
+	"not_in":     true,
+	"is_null":    false,
+	"not_null":   false,
+}
+
+// rowShorthands maps comparison tokens to HubDB operators. Longer tokens come
+// first so ">=" is not read as ">".
+var rowShorthands = []struct {
+	token    string
+	operator string
+}{
+	{">=", "gte"},
+	{"<=", "lte"},
+	{"!=", "ne"},
+	{"=", "eq"},
+	{">", "gt"},
+	{"<", "lt"},
+}
+
+// parseRowFilters converts --filter values into HubDB row filters.
+//
+// Supported forms:
+//
+//	column=value           eq (also !=, <, <=, >, >=)
+//	column:operator:value  any HubDB operator (e.g. name:contains:widget)
+//	column:operator        operators that take no value (is_null, not_null)
+//	column__operator=value HubDB's own query parameter syntax
+func parseRowFilters(raw []string) ([]api.HubDBRowFilter, error) {
+	var filters []api.HubDBRowFilter
+	for _, r := range raw {
+		f, err := parseRowFilter(r)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func parseRowFilter(raw string) (api.HubDBRowFilter, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return api.HubDBRowFilter{}, fmt.Errorf("empty filter")
+	}
+
+	// HubDB syntax: column__operator=value
+	if key, value, ok := strings.Cut(trimmed, "="); ok {
+		if column, op, ok := strings.Cut(key, "__"); ok {
+			return rowFilter(column, op, value, true)
+		}
+	}
+
+	// Explicit form: column:operator[:value]. Only treated as such when the
+	// second segment is a known operator, so values with colons still work
+	// with the shorthand forms.
+	if column, rest, ok := strings.Cut(trimmed, ":"); ok {
+		op, value, hasValue := strings.Cut(rest, ":")
+		if _, known := rowOperators[strings.ToLower(op)]; known {
+			return rowFilter(column, op, value, hasValue)
+		}
+	}
+
+	for _, sh := range rowShorthands {
+		if idx := strings.Index(trimmed, sh.token); idx >= 0 {
+			return rowFilter(trimmed[:idx], sh.operator, trimmed[idx+len(sh.token):], true)
+		}
+	}
+
+	return api.HubDBRowFilter{}, fmt.Errorf("invalid filter %q: expected column=value or column:operator:value", raw)
+}
+
+func rowFilter(column, op, value string, hasValue bool) (api.HubDBRowFilter, error) {
+	column = strings.TrimSpace(column)
+	op = strings.ToLower(strings.TrimSpace(op))
+	value = strings.TrimSpace(value)
+
+	if column == "" {
+		return api.HubDBRowFilter{}, fmt.Errorf("filter is missing a column name")
+	}
+	takesValue, known := rowOperators[op]
+	if !known {
+		return api.HubDBRowFilter{}, fmt.Errorf("unknown HubDB filter operator %q (use one of %s)", op, rowOperatorList())
+	}
+	if takesValue && !hasValue {
+		return api.HubDBRowFilter{}, fmt.Errorf("operator %s requires a value (use %s:%s:value)", op, column, op)
+	}
+	if !takesValue {
+		value = ""
+	}
+
+	return api.HubDBRowFilter{Column: column, Operator: op, Value: value}, nil
+}
+
+func rowOperatorList() string {
+	ops := make([]string, 0, len(rowOperators))
+	for op := range rowOperators {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return strings.Join(ops, ", ")
+}
+
+// parseRowSort converts --sort values (column, -column, column:asc, or
+// column:desc) into HubDB sort parameters
+func parseRowSort(raw []string) ([]string, error) {
+	var sorts []string
+	for _, r := range raw {
+		column, dir, hasDir := strings.Cut(strings.TrimSpace(r), ":")
+		column = strings.TrimSpace(column)
+		desc := strings.HasPrefix(column, "-")
+		column = strings.TrimPrefix(column, "-")
+		if column == "" {
+			return nil, fmt.Errorf("invalid sort %q: missing column name", r)
+		}
+		if hasDir {
+			switch strings.ToLower(strings.TrimSpace(dir)) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, fmt.Errorf("invalid sort direction %q (use asc or desc)", dir)
+			}
+		}
+		if desc {
+			column = "-" + column
+		}
+		sorts = append(sorts, column)
+	}
+	return sorts, nil
+}
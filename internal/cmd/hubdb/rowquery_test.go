@@ -0,0 +1,60 @@
+package hubdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseRowFilters(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want api.HubDBRowFilter
+	}{
+		{"sku=WID-100", api.HubDBRowFilter{Column: "sku", Operator: "eq", Value: "WID-100"}},
+		{"stock>0", api.HubDBRowFilter{Column: "stock", Operator: "gt", Value: "0"}},
+		{"price <= 20", api.HubDBRowFilter{Column: "price", Operator: "lte", Value: "20"}},
+		{"status!=retired", api.HubDBRowFilter{Column: "status", Operator: "ne", Value: "retired"}},
+		{"name:icontains:widget", api.HubDBRowFilter{Column: "name", Operator: "icontains", Value: "widget"}},
+		{"size:IN:small,large", api.HubDBRowFilter{Column: "size", Operator: "in", Value: "small,large"}},
+		{"image:is_null", api.HubDBRowFilter{Column: "image", Operator: "is_null"}},
+		{"name__startswith=Wid", api.HubDBRowFilter{Column: "name", Operator: "startswith", Value: "Wid"}},
+		{"url=https://example.com/a", api.HubDBRowFilter{Column: "url", Operator: "eq", Value: "https://example.com/a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := parseRowFilters([]string{tt.raw})
+			require.NoError(t, err)
+			assert.Equal(t, []api.HubDBRowFilter{tt.want}, got)
+		})
+	}
+}
+
+func TestParseRowFilters_Invalid(t *testing.T) {
+	_, err := parseRowFilters([]string{"name__fuzzy=x"})
+	assert.ErrorContains(t, err, `unknown HubDB filter operator "fuzzy"`)
+
+	_, err = parseRowFilters([]string{"name:contains"})
+	assert.ErrorContains(t, err, "requires a value")
+
+	_, err = parseRowFilters([]string{"=x"})
+	assert.ErrorContains(t, err, "missing a column name")
+
+	_, err = parseRowFilters([]string{"name"})
+	assert.ErrorContains(t, err, "invalid filter")
+}
+
+func TestParseRowSort(t *testing.T) {
+	sorts, err := parseRowSort([]string{"price", "-name", "stock:desc", "sku:asc"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"price", "-name", "-stock", "sku"}, sorts)
+
+	_, err = parseRowSort([]string{"price:down"})
+	assert.ErrorContains(t, err, "invalid sort direction")
+
+	_, err = parseRowSort([]string{"-"})
+	assert.ErrorContains(t, err, "missing column name")
+}
@@ -0,0 +1,574 @@
+package hubdb
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// CSV columns for the row fields that are not table columns. An export
+// writes them first so the file can be edited and imported back.
+const (
+	rowIDHeader   = "hs_id"
+	rowPathHeader = "hs_path"
+	rowNameHeader = "hs_name"
+)
+
+// multiValueSeparator joins the values of MULTISELECT and FOREIGN_ID cells
+const multiValueSeparator = ";"
+
+// resolveCSVColumns matches CSV headers to table columns by name, then by
+// label ignoring case, then by column ID. hs_path and hs_name match
+// themselves. Headers that match nothing are returned as unknown.
+func resolveCSVColumns(headers []string, columns []api.HubDBColumn) (map[string]api.HubDBColumn, []string) {
+	resolved := make(map[string]api.HubDBColumn, len(headers))
+	var unknown []string
+	for _, h := range headers {
+		if h == rowPathHeader || h == rowNameHeader {
+			resolved[h] = api.HubDBColumn{Name: h, Type: "TEXT"}
+			continue
+		}
+		if col, ok := matchColumn(h, columns); ok {
+			resolved[h] = col
+			continue
+		}
+		unknown = append(unknown, h)
+	}
+	sort.Strings(unknown)
+	return resolved, unknown
+}
+
+func matchColumn(header string, columns []api.HubDBColumn) (api.HubDBColumn, bool) {
+	for _, c := range columns {
+		if c.Name == header {
+			return c, true
+		}
+	}
+	for _, c := range columns {
+		if c.Label != "" && strings.EqualFold(c.Label, header) {
+			return c, true
+		}
+	}
+	for _, c := range columns {
+		if c.ID != "" && c.ID == header {
+			return c, true
+		}
+	}
+	return api.HubDBColumn{}, false
+}
+
+// cellValue converts a CSV cell into the value HubDB expects for a column of
+// the given type
+func cellValue(colType, cell string) (interface{}, error) {
+	switch colType {
+	case "NUMBER", "CURRENCY":
+		n, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", cell)
+		}
+		return n, nil
+	case "BOOLEAN":
+		switch strings.ToLower(cell) {
+		case "true", "yes", "y", "1":
+			return true, nil
+		case "false", "no", "n", "0":
+			return false, nil
+		}
+		return nil, fmt.Errorf("%q is not true or false", cell)
+	case "DATE", "DATETIME":
+		return parseCellTime(cell)
+	case "SELECT":
+		return map[string]interface{}{"name": cell, "type": "option"}, nil
+	case "MULTISELECT":
+		var options []map[string]interface{}
+		for _, name := range splitMultiValue(cell) {
+			options = append(options, map[string]interface{}{"name": name, "type": "option"})
+		}
+		return options, nil
+	case "FOREIGN_ID":
+		var ids []map[string]interface{}
+		for _, id := range splitMultiValue(cell) {
+			ids = append(ids, map[string]interface{}{"id": id, "type": "foreignid"})
+		}
+		return ids, nil
+	case "IMAGE":
+		return map[string]interface{}{"url": cell, "type": "image"}, nil
+	case "LOCATION":
+		lat, long, ok := strings.Cut(cell, ",")
+		latN, latErr := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+		longN, longErr := strconv.ParseFloat(strings.TrimSpace(long), 64)
+		if !ok || latErr != nil || longErr != nil {
+			return nil, fmt.Errorf("%q is not a latitude,longitude pair", cell)
+		}
+		return map[string]interface{}{"lat": latN, "long": longN, "type": "location"}, nil
+	}
+	return cell, nil
+}
+
+// parseCellTime reads a date as milliseconds since the epoch, YYYY-MM-DD, or
+// RFC 3339, and returns it in milliseconds as HubDB stores it
+func parseCellTime(cell string) (int64, error) {
+	if ms, err := strconv.ParseInt(cell, 10, 64); err == nil {
+		return ms, nil
+	}
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, cell); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("%q is not a date (use YYYY-MM-DD, RFC 3339, or epoch milliseconds)", cell)
+}
+
+func splitMultiValue(cell string) []string {
+	var values []string
+	for _, v := range strings.Split(cell, multiValueSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// csvCell formats a HubDB row value for a column of the given type, the
+// inverse of cellValue
+func csvCell(colType string, value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		switch colType {
+		case "DATE":
+			return time.UnixMilli(int64(v)).UTC().Format("2006-01-02")
+		case "DATETIME":
+			return time.UnixMilli(int64(v)).UTC().Format(time.RFC3339)
+		case "BOOLEAN":
+			return strconv.FormatBool(v != 0)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]interface{}:
+		if colType == "LOCATION" {
+			return fmt.Sprintf("%s,%s", csvCell("NUMBER", v["lat"]), csvCell("NUMBER", v["long"]))
+		}
+		return objectCell(colType, v)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			if m, ok := item.(map[string]interface{}); ok {
+				parts = append(parts, objectCell(colType, m))
+			} else {
+				parts = append(parts, csvCell(colType, item))
+			}
+		}
+		return strings.Join(parts, multiValueSeparator)
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// objectCell picks the part of an object value a spreadsheet shows: the ID
+// of a foreign row, the name of an option, or the URL of a file or image
+func objectCell(colType string, v map[string]interface{}) string {
+	keys := []string{"url", "name", "id"}
+	if colType == "FOREIGN_ID" {
+		keys = []string{"id", "name"}
+	}
+	for _, k := range keys {
+		if val, ok := v[k]; ok && val != nil {
+			return csvCell("", val)
+		}
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// rowsCSV writes rows as CSV: hs_id, hs_path, and hs_name, then a column per
+// table column, headed by its name or, with labels, its label
+func rowsCSV(columns []api.HubDBColumn, rows []api.HubDBRow, labels bool) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{rowIDHeader, rowPathHeader, rowNameHeader}
+	for _, c := range columns {
+		if labels && c.Label != "" {
+			header = append(header, c.Label)
+		} else {
+			header = append(header, c.Name)
+		}
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		record := []string{row.ID, row.Path, row.Name}
+		for _, c := range columns {
+			record = append(record, csvCell(c.Type, row.Values[c.Name]))
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// rowInput turns the cells of one CSV record into a batch input
+func rowInput(id string, cells map[string]interface{}, columns map[string]api.HubDBColumn) (api.HubDBRowInput, error) {
+	input := api.HubDBRowInput{ID: id, Values: make(map[string]interface{}, len(cells))}
+	for header, raw := range cells {
+		cell := raw.(string)
+		switch header {
+		case rowPathHeader:
+			input.Path = cell
+			continue
+		case rowNameHeader:
+			input.Name = cell
+			continue
+		}
+		col := columns[header]
+		value, err := cellValue(col.Type, cell)
+		if err != nil {
+			return input, fmt.Errorf("column %s: %w", col.Name, err)
+		}
+		input.Values[col.Name] = value
+	}
+	return input, nil
+}
+
+func newRowsImportCmd(opts *root.Options) *cobra.Command {
+	var file, idColumn string
+	var mappings []string
+	var batchSize int
+
+	cmd := &cobra.Command{
+		Use:   "import <tableIdOrName>",
+		Short: "Import rows into a HubDB table from CSV",
+		Long: `Import the rows of a CSV file into a HubDB table draft, in batches.
+
+CSV headers are matched to table columns by name, then by label, then by
+column ID; --map maps other headers. hs_path and hs_name set the row's path
+and name for dynamic pages. Rows with a value in the ID column (hs_id by
+default, as written by 'hubdb rows export') update that row; other rows are
+created. Empty cells are skipped so they do not clear existing values.
+
+Cells are converted by column type: numbers, true/false for BOOLEAN,
+YYYY-MM-DD or RFC 3339 for DATE and DATETIME, option names for SELECT,
+semicolon-separated option names or row IDs for MULTISELECT and FOREIGN_ID,
+a URL for IMAGE, and latitude,longitude for LOCATION.
+
+The draft must be published to make imported rows live.`,
+		Example: `  # Import rows
+  hspt hubdb rows import products --file rows.csv
+
+  # Round-trip: export, edit in a spreadsheet, import the changes
+  hspt hubdb rows export products --draft --out rows.csv
+  hspt hubdb rows import products --file rows.csv
+  hspt hubdb tables publish products
+
+  # Map spreadsheet headers to column names
+  hspt hubdb rows import products --file rows.csv --map "sku=Item Code,price=Cost"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			if batchSize <= 0 || batchSize > api.MaxBatchSize {
+				return fmt.Errorf("--batch-size must be between 1 and %d", api.MaxBatchSize)
+			}
+
+			columnMap, err := shared.ParseColumnMap(mappings)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return fmt.Errorf("failed to open file: %w", err)
+			}
+			defer f.Close()
+
+			reader := csv.NewReader(f)
+			reader.FieldsPerRecord = -1
+
+			header, err := reader.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return fmt.Errorf("CSV file is empty")
+				}
+				return fmt.Errorf("failed to read CSV header: %w", err)
+			}
+
+			// Files from 'hubdb rows export' carry row IDs in hs_id
+			if idColumn == "" && !cmd.Flags().Changed("id-column") {
+				for _, h := range header {
+					if strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")) == rowIDHeader {
+						idColumn = rowIDHeader
+					}
+				}
+			}
+
+			plan, err := shared.NewImportPlan(header, columnMap, idColumn)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			table, err := client.GetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			columns, unknown := resolveCSVColumns(plan.Properties(), table.Columns)
+			if len(unknown) > 0 {
+				return fmt.Errorf("no column in table %s matches %s (use --map column=Header)", tableIDOrName, strings.Join(unknown, ", "))
+			}
+
+			summary := &shared.ImportSummary{ObjectType: "hubdb:" + table.Name}
+			var creates, updates []api.HubDBRowInput
+			var createRows, updateRows []int
+
+			flush := func(inputs *[]api.HubDBRowInput, rows *[]int, update bool) error {
+				if len(*inputs) == 0 {
+					return nil
+				}
+				defer func() { *inputs, *rows = nil, nil }()
+
+				var result *api.HubDBRowBatchResult
+				var err error
+				if update {
+					result, err = client.BatchUpdateHubDBRows(cmd.Context(), table.ID, *inputs)
+				} else {
+					result, err = client.BatchCreateHubDBRows(cmd.Context(), table.ID, *inputs)
+				}
+
+				first, last := (*rows)[0], (*rows)[len(*rows)-1]
+				if err != nil {
+					if api.IsBudgetExhausted(err) {
+						return err
+					}
+					summary.Failed += len(*inputs)
+					summary.Errors = append(summary.Errors, shared.ImportError{FirstRow: first, LastRow: last, Message: err.Error()})
+					return nil
+				}
+
+				if update {
+					summary.Updated += len(result.Results)
+				} else {
+					summary.Created += len(result.Results)
+				}
+				if failed := len(*inputs) - len(result.Results); failed > 0 {
+					summary.Failed += failed
+					for _, e := range result.Errors {
+						summary.Errors = append(summary.Errors, shared.ImportError{FirstRow: first, LastRow: last, Message: e.Message})
+					}
+				}
+				return nil
+			}
+
+			// Row 1 is the header, so data rows start at 2 to match what users
+			// see in a spreadsheet.
+			row := 1
+			for {
+				record, err := reader.Read()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				row++
+				if err != nil {
+					return fmt.Errorf("failed to read CSV row %d: %w", row, err)
+				}
+
+				id, cells := plan.Record(record)
+				if len(cells) == 0 {
+					continue
+				}
+				summary.Rows++
+
+				input, err := rowInput(id, cells, columns)
+				if err != nil {
+					summary.Failed++
+					summary.Errors = append(summary.Errors, shared.ImportError{FirstRow: row, LastRow: row, Message: err.Error()})
+					continue
+				}
+
+				if id != "" {
+					updates = append(updates, input)
+					updateRows = append(updateRows, row)
+					if len(updates) >= batchSize {
+						if err := flush(&updates, &updateRows, true); err != nil {
+							return err
+						}
+					}
+				} else {
+					creates = append(creates, input)
+					createRows = append(createRows, row)
+					if len(creates) >= batchSize {
+						if err := flush(&creates, &createRows, false); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			if err := flush(&creates, &createRows, false); err != nil {
+				return err
+			}
+			if err := flush(&updates, &updateRows, true); err != nil {
+				return err
+			}
+
+			if summary.Rows == 0 {
+				v.Info("No rows to import")
+				return nil
+			}
+
+			if summary.Failed == 0 {
+				v.Success("Imported %d row(s) into %s", summary.Rows, table.Name)
+			} else {
+				v.Warning("Imported %d of %d row(s) into %s; %d failed", summary.Created+summary.Updated, summary.Rows, table.Name, summary.Failed)
+			}
+
+			headers := []string{"CREATED", "UPDATED", "FAILED"}
+			rows := [][]string{
+				{strconv.Itoa(summary.Created), strconv.Itoa(summary.Updated), strconv.Itoa(summary.Failed)},
+			}
+			if err := v.Render(headers, rows, summary); err != nil {
+				return err
+			}
+
+			for _, e := range summary.Errors {
+				if e.FirstRow == e.LastRow {
+					v.Error("Row %d: %s", e.FirstRow, e.Message)
+				} else {
+					v.Error("Rows %d-%d: %s", e.FirstRow, e.LastRow, e.Message)
+				}
+			}
+
+			if summary.Created+summary.Updated > 0 {
+				v.Info("Note: Rows are in draft mode. Use 'hspt hubdb tables publish %s' to publish.", tableIDOrName)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "Path to the CSV file to import (required)")
+	cmd.Flags().StringArrayVar(&mappings, "map", nil, "Header mapping as column=Header (comma-separated or repeatable); defaults to matching headers to column names and labels")
+	cmd.Flags().StringVar(&idColumn, "id-column", "", "CSV column holding row IDs; rows with an ID are updated instead of created (default: hs_id when present)")
+	cmd.Flags().IntVar(&batchSize, "batch-size", api.MaxBatchSize, "Number of rows sent per batch request")
+
+	return cmd
+}
+
+func newRowsExportCmd(opts *root.Options) *cobra.Command {
+	var out string
+	var draft, labels bool
+
+	cmd := &cobra.Command{
+		Use:   "export <tableIdOrName>",
+		Short: "Export the rows of a HubDB table to CSV",
+		Long: `Export every row of a HubDB table to CSV, one column per table column, in
+the table's column order. The first three columns are the row's hs_id,
+hs_path, and hs_name, so the file can be edited and imported back with
+'hubdb rows import'.
+
+Values are written the way import reads them: dates as YYYY-MM-DD or RFC 3339
+in UTC, option names for SELECT columns, semicolon-separated lists for
+MULTISELECT and FOREIGN_ID, and URLs for images and files.`,
+		Example: `  # Export the published rows
+  hspt hubdb rows export products --out rows.csv
+
+  # Export the draft, with column labels as headers
+  hspt hubdb rows export products --draft --labels --out rows.csv
+
+  # Write to stdout
+  hspt hubdb rows export products`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var table *api.HubDBTable
+			if draft {
+				table, err = client.GetHubDBTableDraft(cmd.Context(), tableIDOrName)
+			} else {
+				table, err = client.GetHubDBTable(cmd.Context(), tableIDOrName)
+			}
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			result, err := client.QueryHubDBRows(cmd.Context(), tableIDOrName, api.HubDBRowQuery{Draft: draft}, api.ListOptions{All: true})
+			if err != nil {
+				return fmt.Errorf("failed to list rows: %w", err)
+			}
+
+			var columns []api.HubDBColumn
+			for _, c := range table.Columns {
+				if !c.Archived {
+					columns = append(columns, c)
+				}
+			}
+
+			data, err := rowsCSV(columns, result.Results, labels)
+			if err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+
+			if out == "" {
+				_, err = v.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(out, data, 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", out, err)
+			}
+
+			v.Success("Exported %d row(s) from %s to %s", len(result.Results), table.Name, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File to write the CSV to (default: stdout)")
+	cmd.Flags().BoolVar(&draft, "draft", false, "Export the table draft, including unpublished rows")
+	cmd.Flags().BoolVar(&labels, "labels", false, "Head columns with their labels instead of their names")
+
+	return cmd
+}
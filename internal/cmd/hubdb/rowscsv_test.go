@@ -0,0 +1,111 @@
+package hubdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+var csvTestColumns = []api.HubDBColumn{
+	{ID: "1", Name: "sku", Label: "SKU", Type: "TEXT"},
+	{ID: "2", Name: "price", Label: "Price", Type: "CURRENCY"},
+	{ID: "3", Name: "launched", Label: "Launch Date", Type: "DATE"},
+	{ID: "4", Name: "tags", Label: "Tags", Type: "MULTISELECT"},
+}
+
+func TestResolveCSVColumns(t *testing.T) {
+	resolved, unknown := resolveCSVColumns([]string{"sku", "launch date", "4", "hs_path", "colour"}, csvTestColumns)
+
+	assert.Equal(t, []string{"colour"}, unknown)
+	assert.Equal(t, "sku", resolved["sku"].Name)
+	assert.Equal(t, "launched", resolved["launch date"].Name)
+	assert.Equal(t, "tags", resolved["4"].Name)
+	assert.Equal(t, "hs_path", resolved["hs_path"].Name)
+}
+
+func TestCellValue(t *testing.T) {
+	tests := []struct {
+		colType string
+		cell    string
+		want    interface{}
+	}{
+		{"TEXT", "Widget", "Widget"},
+		{"NUMBER", "12.5", 12.5},
+		{"BOOLEAN", "Yes", true},
+		{"BOOLEAN", "0", false},
+		{"DATE", "2024-03-01", int64(1709251200000)},
+		{"DATETIME", "1709251200000", int64(1709251200000)},
+		{"SELECT", "Red", map[string]interface{}{"name": "Red", "type": "option"}},
+		{"MULTISELECT", "a; b", []map[string]interface{}{{"name": "a", "type": "option"}, {"name": "b", "type": "option"}}},
+		{"FOREIGN_ID", "101", []map[string]interface{}{{"id": "101", "type": "foreignid"}}},
+		{"LOCATION", "51.5, -0.12", map[string]interface{}{"lat": 51.5, "long": -0.12, "type": "location"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.colType+" "+tt.cell, func(t *testing.T) {
+			got, err := cellValue(tt.colType, tt.cell)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := cellValue("NUMBER", "cheap")
+	assert.ErrorContains(t, err, "not a number")
+	_, err = cellValue("DATE", "March")
+	assert.ErrorContains(t, err, "not a date")
+	_, err = cellValue("LOCATION", "51.5")
+	assert.ErrorContains(t, err, "latitude,longitude")
+}
+
+func TestCSVCell(t *testing.T) {
+	assert.Equal(t, "", csvCell("TEXT", nil))
+	assert.Equal(t, "9.99", csvCell("CURRENCY", 9.99))
+	assert.Equal(t, "2024-03-01", csvCell("DATE", float64(1709251200000)))
+	assert.Equal(t, "2024-03-01T00:00:00Z", csvCell("DATETIME", float64(1709251200000)))
+	assert.Equal(t, "true", csvCell("BOOLEAN", float64(1)))
+	assert.Equal(t, "Red", csvCell("SELECT", map[string]interface{}{"id": "1", "name": "Red", "type": "option"}))
+	assert.Equal(t, "a;b", csvCell("MULTISELECT", []interface{}{
+		map[string]interface{}{"id": "1", "name": "a"},
+		map[string]interface{}{"id": "2", "name": "b"},
+	}))
+	assert.Equal(t, "101", csvCell("FOREIGN_ID", []interface{}{map[string]interface{}{"id": "101", "name": "Other"}}))
+	assert.Equal(t, "https://cdn.example.com/a.png", csvCell("IMAGE", map[string]interface{}{"url": "https://cdn.example.com/a.png", "width": float64(10)}))
+	assert.Equal(t, "51.5,-0.12", csvCell("LOCATION", map[string]interface{}{"lat": 51.5, "long": -0.12}))
+}
+
+func TestRowsCSV(t *testing.T) {
+	rows := []api.HubDBRow{{
+		ID:   "r1",
+		Path: "widget",
+		Values: map[string]interface{}{
+			"sku":      "WID-100",
+			"price":    9.5,
+			"launched": float64(1709251200000),
+		},
+	}}
+
+	data, err := rowsCSV(csvTestColumns, rows, false)
+	require.NoError(t, err)
+	assert.Equal(t, "hs_id,hs_path,hs_name,sku,price,launched,tags\nr1,widget,,WID-100,9.5,2024-03-01,\n", string(data))
+
+	data, err = rowsCSV(csvTestColumns[:1], nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, "hs_id,hs_path,hs_name,SKU\n", string(data))
+}
+
+func TestRowInput(t *testing.T) {
+	columns, _ := resolveCSVColumns([]string{"hs_path", "SKU", "price"}, csvTestColumns)
+
+	input, err := rowInput("r1", map[string]interface{}{"hs_path": "widget", "SKU": "WID-100", "price": "9.5"}, columns)
+	require.NoError(t, err)
+	assert.Equal(t, api.HubDBRowInput{
+		ID:     "r1",
+		Path:   "widget",
+		Values: map[string]interface{}{"sku": "WID-100", "price": 9.5},
+	}, input)
+
+	_, err = rowInput("", map[string]interface{}{"price": "free"}, columns)
+	assert.ErrorContains(t, err, "column price")
+}
@@ -0,0 +1,231 @@
+package hubdb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// Schema changes between two tables
+const (
+	schemaAdded   = "added"
+	schemaRemoved = "removed"
+	schemaChanged = "changed"
+)
+
+// schemaChange is one difference between two table schemas, from the first
+// table to the second
+type schemaChange struct {
+	Change string `json:"change"`
+	// Column is empty for table settings
+	Column  string `json:"column,omitempty"`
+	Setting string `json:"setting,omitempty"`
+	A       string `json:"a"`
+	B       string `json:"b"`
+}
+
+// schemaDiff is the JSON output of tables diff
+type schemaDiff struct {
+	A       string         `json:"a"`
+	B       string         `json:"b"`
+	Changes []schemaChange `json:"changes"`
+}
+
+// optionNames lists a select column's options in order
+func optionNames(c api.HubDBColumn) string {
+	names := make([]string, 0, len(c.Options))
+	for _, o := range c.Options {
+		names = append(names, o.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// diffSchemas compares the columns and settings of two tables by column
+// name. Columns appear in the first table's order, then columns only the
+// second has; archived columns are ignored.
+func diffSchemas(a, b *api.HubDBTable) []schemaChange {
+	changes := []schemaChange{}
+
+	settings := []struct {
+		name string
+		a, b bool
+	}{
+		{"allowPublicApiAccess", a.AllowPublicAPIAccess, b.AllowPublicAPIAccess},
+		{"allowChildTables", a.AllowChildTables, b.AllowChildTables},
+		{"enableChildTablePages", a.EnableChildTablePages, b.EnableChildTablePages},
+	}
+	for _, s := range settings {
+		if s.a != s.b {
+			changes = append(changes, schemaChange{Change: schemaChanged, Setting: s.name, A: formatBool(s.a), B: formatBool(s.b)})
+		}
+	}
+
+	columnsB := make(map[string]api.HubDBColumn, len(b.Columns))
+	for _, c := range b.Columns {
+		if !c.Archived {
+			columnsB[c.Name] = c
+		}
+	}
+	seen := make(map[string]bool, len(a.Columns))
+	for _, ca := range a.Columns {
+		if ca.Archived {
+			continue
+		}
+		seen[ca.Name] = true
+		cb, ok := columnsB[ca.Name]
+		if !ok {
+			changes = append(changes, schemaChange{Change: schemaRemoved, Column: ca.Name, A: ca.Type})
+			continue
+		}
+		fields := []struct {
+			setting string
+			a, b    string
+		}{
+			{"type", ca.Type, cb.Type},
+			{"label", ca.Label, cb.Label},
+			{"description", ca.Description, cb.Description},
+			{"options", optionNames(ca), optionNames(cb)},
+		}
+		for _, f := range fields {
+			if f.a != f.b {
+				changes = append(changes, schemaChange{Change: schemaChanged, Column: ca.Name, Setting: f.setting, A: f.a, B: f.b})
+			}
+		}
+	}
+	for _, cb := range b.Columns {
+		if !cb.Archived && !seen[cb.Name] {
+			changes = append(changes, schemaChange{Change: schemaAdded, Column: cb.Name, B: cb.Type})
+		}
+	}
+
+	return changes
+}
+
+func newTablesCloneCmd(opts *root.Options) *cobra.Command {
+	var newName, newLabel string
+	var copyRows bool
+
+	cmd := &cobra.Command{
+		Use:   "clone <tableIdOrName>",
+		Short: "Clone a HubDB table",
+		Long: `Copy a HubDB table's columns and settings, and with --copy-rows its rows, to
+a new table. The copy is made from the table draft and is not published.`,
+		Example: `  # Copy a table's structure
+  hspt hubdb tables clone products --new-name products_v2
+
+  # Copy it with its rows and a new label
+  hspt hubdb tables clone products --new-name products_backup --new-label "Products (backup)" --copy-rows`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			tableIDOrName := args[0]
+
+			if newName == "" {
+				return fmt.Errorf("--new-name is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			table, err := client.CloneHubDBTable(cmd.Context(), tableIDOrName, api.HubDBCloneRequest{
+				NewName:  newName,
+				NewLabel: newLabel,
+				CopyRows: copyRows,
+			})
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("HubDB table %s not found", tableIDOrName)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Cloned %s to table %s (ID: %s)", tableIDOrName, table.Name, table.ID)
+			v.Info("Note: Table is in draft mode. Use 'hspt hubdb tables publish %s' to publish.", table.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&newName, "new-name", "", "Name of the new table (required)")
+	cmd.Flags().StringVar(&newLabel, "new-label", "", "Label of the new table (default: HubDB's)")
+	cmd.Flags().BoolVar(&copyRows, "copy-rows", false, "Copy the table's rows as well as its columns")
+
+	return cmd
+}
+
+func newTablesDiffCmd(opts *root.Options) *cobra.Command {
+	var otherProfile string
+	var draft bool
+
+	cmd := &cobra.Command{
+		Use:   "diff <tableA> <tableB>",
+		Short: "Compare the schemas of two HubDB tables",
+		Long: `Compare two HubDB tables' columns by name and report the columns added and
+removed going from the first table to the second, and changes to column
+types, labels, descriptions, and select options, and to table settings.
+
+With --other-profile the second table is read from another configured
+portal, to check a table before promoting it from a sandbox to production.`,
+		Example: `  # Compare two tables in this portal
+  hspt hubdb tables diff products products_v2
+
+  # Compare the sandbox draft with production
+  hspt hubdb tables diff products products --profile sandbox --other-profile production --draft`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			clientA, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+			clientB := clientA
+			if otherProfile != "" {
+				if clientB, err = opts.ProfileClient(otherProfile); err != nil {
+					return err
+				}
+			}
+
+			tables := make([]*api.HubDBTable, 2)
+			for i, client := range []*api.Client{clientA, clientB} {
+				if draft {
+					tables[i], err = client.GetHubDBTableDraft(cmd.Context(), args[i])
+				} else {
+					tables[i], err = client.GetHubDBTable(cmd.Context(), args[i])
+				}
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("HubDB table %s not found", args[i])
+						return nil
+					}
+					return err
+				}
+			}
+
+			d := schemaDiff{A: args[0], B: args[1], Changes: diffSchemas(tables[0], tables[1])}
+			if len(d.Changes) == 0 && v.Format != view.FormatJSON {
+				v.Info("Tables %s and %s have the same schema", args[0], args[1])
+				return nil
+			}
+
+			headers := []string{"CHANGE", "COLUMN", "SETTING", "FIRST", "SECOND"}
+			rows := make([][]string, 0, len(d.Changes))
+			for _, c := range d.Changes {
+				rows = append(rows, []string{c.Change, c.Column, c.Setting, c.A, c.B})
+			}
+			return v.Render(headers, rows, d)
+		},
+	}
+
+	cmd.Flags().StringVar(&otherProfile, "other-profile", "", "Read the second table from this configured profile")
+	cmd.Flags().BoolVar(&draft, "draft", false, "Compare the table drafts instead of the published tables")
+
+	return cmd
+}
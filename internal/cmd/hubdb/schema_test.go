@@ -0,0 +1,41 @@
+package hubdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	a := &api.HubDBTable{
+		AllowPublicAPIAccess: true,
+		Columns: []api.HubDBColumn{
+			{Name: "sku", Label: "SKU", Type: "TEXT"},
+			{Name: "price", Label: "Price", Type: "NUMBER"},
+			{Name: "color", Label: "Color", Type: "SELECT", Options: []api.HubDBOption{{Name: "red"}, {Name: "blue"}}},
+			{Name: "legacy", Type: "TEXT"},
+			{Name: "old", Type: "TEXT", Archived: true},
+		},
+	}
+	b := &api.HubDBTable{
+		Columns: []api.HubDBColumn{
+			{Name: "sku", Label: "SKU", Type: "TEXT"},
+			{Name: "price", Label: "Price (USD)", Type: "CURRENCY"},
+			{Name: "color", Label: "Color", Type: "SELECT", Options: []api.HubDBOption{{Name: "red"}, {Name: "green"}}},
+			{Name: "stock", Type: "NUMBER"},
+		},
+	}
+
+	assert.Equal(t, []schemaChange{
+		{Change: schemaChanged, Setting: "allowPublicApiAccess", A: "Yes", B: "No"},
+		{Change: schemaChanged, Column: "price", Setting: "type", A: "NUMBER", B: "CURRENCY"},
+		{Change: schemaChanged, Column: "price", Setting: "label", A: "Price", B: "Price (USD)"},
+		{Change: schemaChanged, Column: "color", Setting: "options", A: "red, blue", B: "red, green"},
+		{Change: schemaRemoved, Column: "legacy", A: "TEXT"},
+		{Change: schemaAdded, Column: "stock", B: "NUMBER"},
+	}, diffSchemas(a, b))
+
+	assert.Empty(t, diffSchemas(b, b))
+}
@@ -0,0 +1,328 @@
+package imports
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the imports command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "imports",
+		Short: "Manage CRM imports",
+		Long: `Commands for HubSpot's native CRM imports.
+
+An import uploads whole files that HubSpot processes in the background, so
+it suits very large datasets better than the batch APIs behind
+"hspt contacts import" and friends, and it counts as one API request.`,
+	}
+
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newCancelCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// importRequestFiles is the part of an import request that names its files
+type importRequestFiles struct {
+	Files []struct {
+		FileName string `json:"fileName"`
+	} `json:"files"`
+}
+
+// checkFileNames makes sure each file to upload is named in the import
+// request, which HubSpot otherwise rejects only after the upload
+func checkFileNames(config []byte, paths []string) error {
+	var req importRequestFiles
+	if err := json.Unmarshal(config, &req); err != nil {
+		return fmt.Errorf("invalid import config: %w", err)
+	}
+
+	named := make(map[string]bool, len(req.Files))
+	for _, f := range req.Files {
+		named[f.FileName] = true
+	}
+	for _, p := range paths {
+		name := filepath.Base(p)
+		if !named[name] {
+			return fmt.Errorf("import config has no files entry with \"fileName\": %q", name)
+		}
+	}
+	return nil
+}
+
+// counter returns an import counter, or "-" before HubSpot reports it
+func counter(imp api.Import, name string) string {
+	n, ok := imp.Metadata.Counters[name]
+	if !ok {
+		return "-"
+	}
+	return strconv.Itoa(n)
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var files []string
+	var configFile string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Start an import",
+		Long: `Upload one or more files and start an import.
+
+--config is an import request in HubSpot's format: the import name and, for
+each file, its fileName, format, and column mappings. Every --file must be
+named in it by its base name. The import runs in the background; follow it
+with "hspt imports get".`,
+		Example: `  # Import contacts from a CSV
+  hspt imports create --file contacts.csv --config import-config.json
+
+  # import-config.json
+  {
+    "name": "Q3 leads",
+    "files": [{
+      "fileName": "contacts.csv",
+      "fileFormat": "CSV",
+      "fileImportPage": {
+        "hasHeader": true,
+        "columnMappings": [
+          {"columnName": "Email", "propertyName": "email", "columnObjectTypeId": "0-1", "idColumnType": "HUBSPOT_ALTERNATE_ID"},
+          {"columnName": "First Name", "propertyName": "firstname", "columnObjectTypeId": "0-1"}
+        ]
+      }
+    }]
+  }`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if len(files) == 0 {
+				return fmt.Errorf("--file is required")
+			}
+			if configFile == "" {
+				return fmt.Errorf("--config is required")
+			}
+
+			config, err := os.ReadFile(configFile)
+			if err != nil {
+				return fmt.Errorf("failed to read config: %w", err)
+			}
+			if err := checkFileNames(config, files); err != nil {
+				return err
+			}
+
+			uploads := make([]api.ImportFile, 0, len(files))
+			for _, path := range files {
+				f, err := os.Open(path)
+				if err != nil {
+					return fmt.Errorf("failed to open file: %w", err)
+				}
+				defer f.Close()
+				uploads = append(uploads, api.ImportFile{Name: filepath.Base(path), Content: f})
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			imp, err := client.CreateImport(cmd.Context(), config, uploads)
+			if err != nil {
+				return fmt.Errorf("failed to create import: %w", err)
+			}
+
+			v.Success("Import started with ID: %s", imp.ID)
+			v.Info("Follow its progress with: hspt imports get %s", imp.ID)
+			return v.Render([]string{"ID", "NAME", "STATE"}, [][]string{{imp.ID, imp.Name, imp.State}}, imp)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&files, "file", nil, "File to import (repeatable)")
+	cmd.Flags().StringVar(&configFile, "config", "", "JSON file with the import request (required)")
+
+	return cmd
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List imports",
+		Long:  "List recent imports, newest first.",
+		Example: `  # List imports
+  hspt imports list
+
+  # Find imports that failed
+  hspt imports list --all -o json | jq '.results[] | select(.state == "FAILED")'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListImports(cmd.Context(), pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No imports found")
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "STATE", "ROWS", "CREATED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, imp := range result.Results {
+				rows = append(rows, []string{
+					imp.ID,
+					imp.Name,
+					imp.State,
+					counter(imp, "TOTAL_ROWS"),
+					v.Time(imp.CreatedAt),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of imports to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination offset for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <importId>",
+		Short: "Get an import",
+		Long:  "Show an import's state and row counts.",
+		Example: `  # Check on an import
+  hspt imports get 881`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			importID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			imp, err := client.GetImport(cmd.Context(), importID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Import %s not found", importID)
+					return nil
+				}
+				return err
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", imp.ID},
+				{"Name", imp.Name},
+				{"State", imp.State},
+				{"Source", imp.Source},
+				{"Created", v.Time(imp.CreatedAt)},
+				{"Updated", v.Time(imp.UpdatedAt)},
+			}
+
+			names := make([]string, 0, len(imp.Metadata.Counters))
+			for name := range imp.Metadata.Counters {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				rows = append(rows, []string{formatCounter(name), strconv.Itoa(imp.Metadata.Counters[name])})
+			}
+
+			for _, list := range imp.Metadata.ObjectLists {
+				rows = append(rows, []string{"List (" + strings.ToLower(list.ObjectType) + ")", list.ListID})
+			}
+
+			return v.Render(headers, rows, imp)
+		},
+	}
+}
+
+// formatCounter turns a counter name such as CREATED_OBJECTS into
+// "Created objects"
+func formatCounter(name string) string {
+	s := strings.ToLower(strings.ReplaceAll(name, "_", " "))
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func newCancelCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "cancel <importId>",
+		Short: "Cancel an import",
+		Long:  "Stop an import that is still running. Rows already imported are kept.",
+		Example: `  # Cancel an import
+  hspt imports cancel 881 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			importID := args[0]
+
+			if !force {
+				v.Warning("This will cancel import %s. Use --force to confirm.", importID)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.CancelImport(cmd.Context(), importID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Import %s not found", importID)
+					return nil
+				}
+				return fmt.Errorf("failed to cancel import: %w", err)
+			}
+
+			v.Success("Import %s canceled", importID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm cancellation without prompt")
+
+	return cmd
+}
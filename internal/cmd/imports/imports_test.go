@@ -0,0 +1,34 @@
+package imports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestCheckFileNames(t *testing.T) {
+	config := []byte(`{"name": "Q3", "files": [{"fileName": "contacts.csv"}, {"fileName": "companies.csv"}]}`)
+
+	assert.NoError(t, checkFileNames(config, []string{"data/contacts.csv"}))
+	assert.NoError(t, checkFileNames(config, []string{"contacts.csv", "/tmp/companies.csv"}))
+
+	err := checkFileNames(config, []string{"deals.csv"})
+	assert.ErrorContains(t, err, `"deals.csv"`)
+
+	err = checkFileNames([]byte(`{"files": `), []string{"contacts.csv"})
+	assert.ErrorContains(t, err, "invalid import config")
+}
+
+func TestCounter(t *testing.T) {
+	imp := api.Import{Metadata: api.ImportMetadata{Counters: map[string]int{"TOTAL_ROWS": 12}}}
+	assert.Equal(t, "12", counter(imp, "TOTAL_ROWS"))
+	assert.Equal(t, "-", counter(imp, "CREATED_OBJECTS"))
+}
+
+func TestFormatCounter(t *testing.T) {
+	assert.Equal(t, "Created objects", formatCounter("CREATED_OBJECTS"))
+	assert.Equal(t, "Errors", formatCounter("ERRORS"))
+	assert.Equal(t, "", formatCounter(""))
+}
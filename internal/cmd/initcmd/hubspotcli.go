@@ -0,0 +1,223 @@
+package initcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// hubspotCLIConfig is the config file of the HubSpot CLI for Node. Older
+// versions call accounts portals; both spellings are read.
+type hubspotCLIConfig struct {
+	DefaultAccount string              `yaml:"defaultAccount"`
+	DefaultPortal  string              `yaml:"defaultPortal"`
+	Accounts       []hubspotCLIAccount `yaml:"accounts"`
+	Portals        []hubspotCLIAccount `yaml:"portals"`
+}
+
+// hubspotCLIAccount is one account of a HubSpot CLI config
+type hubspotCLIAccount struct {
+	Name              string `yaml:"name"`
+	AccountID         int64  `yaml:"accountId"`
+	PortalID          int64  `yaml:"portalId"`
+	Env               string `yaml:"env"`
+	AuthType          string `yaml:"authType"`
+	PersonalAccessKey string `yaml:"personalAccessKey"`
+}
+
+func (a hubspotCLIAccount) id() int64 {
+	if a.AccountID != 0 {
+		return a.AccountID
+	}
+	return a.PortalID
+}
+
+// accountImport is what importing one HubSpot CLI account does: create
+// profile Name, or skip the account for Reason
+type accountImport struct {
+	Name     string `json:"profile"`
+	PortalID int64  `json:"portalId"`
+	Default  bool   `json:"default,omitempty"`
+	Reason   string `json:"skipped,omitempty"`
+	key      string
+}
+
+// profileNamePattern matches characters that are awkward in a --profile
+// value
+var profileNamePattern = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// profileName turns a HubSpot CLI account name into a profile name, falling
+// back to the account ID
+func profileName(a hubspotCLIAccount) string {
+	name := strings.Trim(profileNamePattern.ReplaceAllString(strings.TrimSpace(a.Name), "-"), "-")
+	if name == "" {
+		return "portal-" + strconv.FormatInt(a.id(), 10)
+	}
+	return name
+}
+
+// readHubSpotConfig reads a HubSpot CLI config file; a leading ~ is the home
+// directory
+func readHubSpotConfig(path string) (*hubspotCLIConfig, error) {
+	if rest, ok := strings.CutPrefix(path, "~"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HubSpot CLI config: %w", err)
+	}
+	var hs hubspotCLIConfig
+	if err := yaml.Unmarshal(data, &hs); err != nil {
+		return nil, fmt.Errorf("failed to parse HubSpot CLI config %s: %w", path, err)
+	}
+	return &hs, nil
+}
+
+// planImport decides what to do with each account of a HubSpot CLI config.
+// Only production accounts authenticated with a personal access key can be
+// imported; profiles that already exist are kept unless overwrite is set.
+func planImport(hs *hubspotCLIConfig, existing map[string]*config.Profile, overwrite bool) []accountImport {
+	accounts := hs.Accounts
+	if len(accounts) == 0 {
+		accounts = hs.Portals
+	}
+	defaultAccount := hs.DefaultAccount
+	if defaultAccount == "" {
+		defaultAccount = hs.DefaultPortal
+	}
+
+	plan := make([]accountImport, 0, len(accounts))
+	seen := make(map[string]bool)
+	for _, a := range accounts {
+		imp := accountImport{
+			Name:     profileName(a),
+			PortalID: a.id(),
+			Default:  defaultAccount != "" && (defaultAccount == a.Name || defaultAccount == strconv.FormatInt(a.id(), 10)),
+			key:      strings.TrimSpace(a.PersonalAccessKey),
+		}
+		authType := strings.ToLower(a.AuthType)
+		switch {
+		case seen[imp.Name]:
+			imp.Reason = "duplicate account name"
+		case strings.EqualFold(a.Env, "qa"):
+			imp.Reason = "QA environment accounts are not supported"
+		case authType != "" && authType != "personalaccesskey":
+			imp.Reason = fmt.Sprintf("%s accounts cannot be imported; create a private app token and run 'hspt init --profile %s'", a.AuthType, imp.Name)
+		case imp.key == "":
+			imp.Reason = "no personal access key"
+		case existing[imp.Name] != nil && !overwrite:
+			imp.Reason = "profile already exists (use --force to replace it)"
+		}
+		seen[imp.Name] = true
+		plan = append(plan, imp)
+	}
+	return plan
+}
+
+// runImport creates a profile for every importable account of the HubSpot
+// CLI config at path. Unless noVerify is set each key is exchanged for a
+// token first, and the portal details are looked up as in the wizard.
+func runImport(ctx context.Context, opts *root.Options, path string, noVerify, overwrite bool) error {
+	v := opts.View()
+
+	hs, err := readHubSpotConfig(path)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	plan := planImport(hs, cfg.Profiles, overwrite)
+	if len(plan) == 0 {
+		v.Info("No accounts found in %s", path)
+		return nil
+	}
+
+	imported := 0
+	for i := range plan {
+		imp := &plan[i]
+		if imp.Reason != "" {
+			continue
+		}
+
+		profile := &config.Profile{PersonalAccessKey: imp.key, PortalID: imp.PortalID}
+		if existing := cfg.Profiles[imp.Name]; existing != nil {
+			profile.Context = existing.Context
+		}
+
+		if !noVerify {
+			clientCfg := opts.ClientConfig()
+			clientCfg.AccessToken = ""
+			clientCfg.PersonalAccessKey = imp.key
+			client, err := api.New(clientCfg)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+			token, err := client.ExchangePersonalAccessKey(ctx, imp.key)
+			if err != nil {
+				imp.Reason = fmt.Sprintf("personal access key rejected: %v", err)
+				continue
+			}
+			if token.PortalID != 0 {
+				profile.PortalID = token.PortalID
+			}
+			if details, err := client.GetAccountDetails(ctx); err == nil {
+				profile.Region = details.DataHostingLocation
+				profile.TimeZone = details.TimeZone
+				profile.Currency = details.CompanyCurrency
+			}
+		}
+
+		cfg.SetProfile(imp.Name, profile)
+		imp.PortalID = profile.PortalID
+		imported++
+		if imp.Default && cfg.CurrentProfile == "" {
+			cfg.CurrentProfile = imp.Name
+		}
+	}
+
+	if imported > 0 {
+		if err := config.Save(cfg); err != nil {
+			return fmt.Errorf("failed to save configuration: %w", err)
+		}
+	}
+
+	headers := []string{"PROFILE", "PORTAL", "RESULT"}
+	rows := make([][]string, 0, len(plan))
+	for _, imp := range plan {
+		result := "imported"
+		if imp.Reason != "" {
+			result = "skipped: " + imp.Reason
+		} else if imp.Name == cfg.CurrentProfile {
+			result = "imported (current profile)"
+		}
+		rows = append(rows, []string{imp.Name, strconv.FormatInt(imp.PortalID, 10), result})
+	}
+	if err := v.Render(headers, rows, plan); err != nil {
+		return err
+	}
+
+	if imported > 0 {
+		v.Success("Imported %d of %d account(s) into %s", imported, len(plan), config.Path())
+		v.Info("Use a profile with --profile NAME, or switch with 'hspt config profile use NAME'")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package initcmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+const hubspotCLIYAML = `defaultAccount: 123
+accounts:
+  - name: Acme Production
+    accountId: 123
+    env: prod
+    authType: personalaccesskey
+    personalAccessKey: >-
+      pak-prod
+  - name: sandbox
+    accountId: 456
+    authType: personalaccesskey
+    personalAccessKey: pak-sandbox
+  - name: qa-test
+    accountId: 789
+    env: qa
+    authType: personalaccesskey
+    personalAccessKey: pak-qa
+  - name: legacy
+    accountId: 111
+    authType: apikey
+    apiKey: abc
+`
+
+func writeHubSpotConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestReadHubSpotConfig(t *testing.T) {
+	hs, err := readHubSpotConfig(writeHubSpotConfig(t, hubspotCLIYAML))
+	require.NoError(t, err)
+	assert.Equal(t, "123", hs.DefaultAccount)
+	require.Len(t, hs.Accounts, 4)
+	assert.Equal(t, "pak-prod", hs.Accounts[0].PersonalAccessKey)
+	assert.Equal(t, int64(123), hs.Accounts[0].id())
+
+	legacy, err := readHubSpotConfig(writeHubSpotConfig(t, "defaultPortal: old\nportals:\n  - name: old\n    portalId: 42\n    personalAccessKey: pak-old\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "old", legacy.DefaultPortal)
+	require.Len(t, legacy.Portals, 1)
+	assert.Equal(t, int64(42), legacy.Portals[0].id())
+
+	_, err = readHubSpotConfig(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.Error(t, err)
+}
+
+func TestProfileName(t *testing.T) {
+	assert.Equal(t, "Acme-Production", profileName(hubspotCLIAccount{Name: " Acme Production "}))
+	assert.Equal(t, "client_a.dev", profileName(hubspotCLIAccount{Name: "client_a.dev"}))
+	assert.Equal(t, "portal-42", profileName(hubspotCLIAccount{Name: "!!!", PortalID: 42}))
+}
+
+func TestPlanImport(t *testing.T) {
+	hs, err := readHubSpotConfig(writeHubSpotConfig(t, hubspotCLIYAML))
+	require.NoError(t, err)
+
+	existing := map[string]*config.Profile{"sandbox": {AccessToken: "pat-na1-abc"}}
+
+	plan := planImport(hs, existing, false)
+	require.Len(t, plan, 4)
+
+	assert.Equal(t, "Acme-Production", plan[0].Name)
+	assert.True(t, plan[0].Default)
+	assert.Empty(t, plan[0].Reason)
+	assert.Equal(t, "pak-prod", plan[0].key)
+
+	assert.Contains(t, plan[1].Reason, "already exists")
+	assert.Contains(t, plan[2].Reason, "QA")
+	assert.Contains(t, plan[3].Reason, "apikey accounts cannot be imported")
+
+	plan = planImport(hs, existing, true)
+	assert.Empty(t, plan[1].Reason)
+	assert.False(t, plan[1].Default)
+}
@@ -1,8 +1,9 @@
 package initcmd
 
 import (
+	"context"
 	"fmt"
-	"os"
+	"time"
 
 	"github.com/charmbracelet/huh"
 	"github.com/spf13/cobra"
@@ -14,52 +15,77 @@ import (
 
 // Register registers the init command
 func Register(parent *cobra.Command, opts *root.Options) {
-	var token string
-	var noVerify bool
+	var token, hubspotConfig string
+	var noVerify, force bool
 
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize hspt with guided setup",
 		Long: `Interactive setup wizard for configuring hspt.
 
-Prompts for your HubSpot access token, then optionally verifies
-the connection before saving the configuration.
+Prompts for your HubSpot access token and verifies it against the
+owners endpoint. On success the wizard detects the portal ID and data
+hosting region, then offers a default output format and response
+caching before writing a commented config file.
 
-Get your access token from: HubSpot Settings > Integrations > Private Apps`,
+The token is stored in the active profile (see --profile); other
+profiles in the config file are left untouched.
+
+Get your access token from: HubSpot Settings > Integrations > Private Apps
+
+With --import-hubspot-config, init instead creates a profile for each
+account in the config file of the HubSpot CLI for Node (usually
+~/.hscli/config.yml or hubspot.config.yml), authenticated with the same
+personal access key. The key is exchanged for short-lived access tokens as
+needed. Existing profiles are kept unless --force is given.`,
 		Example: `  # Interactive setup
   hspt init
 
+  # Set up a second portal under its own profile
+  hspt init --profile client-a
+
   # Non-interactive setup
   hspt init --token YOUR_ACCESS_TOKEN
 
   # Skip connection verification
-  hspt init --no-verify`,
+  hspt init --no-verify
+
+  # Reuse the accounts of the HubSpot CLI for Node
+  hspt init --import-hubspot-config ~/.hscli/config.yml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runInit(opts, token, noVerify)
+			if hubspotConfig != "" {
+				return runImport(cmd.Context(), opts, hubspotConfig, noVerify, force)
+			}
+			return runInit(cmd.Context(), opts, token, noVerify)
 		},
 	}
 
 	cmd.Flags().StringVar(&token, "token", "", "HubSpot access token")
 	cmd.Flags().BoolVar(&noVerify, "no-verify", false, "Skip connection verification")
+	cmd.Flags().StringVar(&hubspotConfig, "import-hubspot-config", "", "Create profiles from the accounts in a HubSpot CLI (Node) config file, e.g. ~/.hscli/config.yml")
+	cmd.Flags().BoolVar(&force, "force", false, "With --import-hubspot-config, replace profiles that already exist")
+	cmd.MarkFlagsMutuallyExclusive("token", "import-hubspot-config")
 
 	parent.AddCommand(cmd)
 }
 
-func runInit(opts *root.Options, prefillToken string, noVerify bool) error {
+func runInit(ctx context.Context, opts *root.Options, prefillToken string, noVerify bool) error {
 	configPath := config.Path()
 
 	// Load existing config for pre-population
-	existingCfg, _ := config.Load()
-	if existingCfg == nil {
-		existingCfg = &config.Config{}
+	cfg, _ := config.Load()
+	if cfg == nil {
+		cfg = &config.Config{}
 	}
+	profileName := cfg.ActiveProfileName()
 
-	// Check if config already exists
-	if _, err := os.Stat(configPath); err == nil {
+	// Check if the profile is already configured
+	existing := cfg.ActiveProfile()
+	if existing != nil {
 		var overwrite bool
 		err := huh.NewConfirm().
-			Title("Configuration already exists").
-			Description(fmt.Sprintf("Overwrite %s?", configPath)).
+			Title(fmt.Sprintf("Profile %q already exists", profileName)).
+			Description(fmt.Sprintf("Overwrite it in %s?", configPath)).
 			Value(&overwrite).
 			Run()
 		if err != nil {
@@ -69,16 +95,24 @@ func runInit(opts *root.Options, prefillToken string, noVerify bool) error {
 			fmt.Println("Initialization cancelled.")
 			return nil
 		}
+	} else {
+		existing = &config.Profile{}
 	}
 
-	cfg := &config.Config{}
+	profile := &config.Profile{
+		PortalID: existing.PortalID,
+		Region:   existing.Region,
+	}
 
 	// Pre-fill from existing config, then override with CLI flags
 	// Priority: CLI flag > existing config value
 	if prefillToken != "" {
-		cfg.AccessToken = prefillToken
-	} else if existingCfg.AccessToken != "" {
-		cfg.AccessToken = existingCfg.AccessToken
+		profile.AccessToken = prefillToken
+	} else if existing.AccessToken != "" {
+		profile.AccessToken = existing.AccessToken
+	}
+	if cfg.Output == "" {
+		cfg.Output = "table"
 	}
 
 	// Build the form
@@ -88,7 +122,7 @@ func runInit(opts *root.Options, prefillToken string, noVerify bool) error {
 				Title("Access Token").
 				Description("Get one from: HubSpot Settings > Integrations > Private Apps").
 				EchoMode(huh.EchoModePassword).
-				Value(&cfg.AccessToken).
+				Value(&profile.AccessToken).
 				Validate(func(s string) error {
 					if s == "" {
 						return fmt.Errorf("access token is required")
@@ -105,16 +139,15 @@ func runInit(opts *root.Options, prefillToken string, noVerify bool) error {
 	// Verify connection unless --no-verify
 	if !noVerify {
 		fmt.Print("Verifying connection... ")
-		client, err := api.New(api.ClientConfig{
-			AccessToken: cfg.AccessToken,
-			Verbose:     opts.Verbose,
-		})
+		clientCfg := opts.ClientConfig()
+		clientCfg.AccessToken = profile.AccessToken
+		client, err := api.New(clientCfg)
 		if err != nil {
 			fmt.Println("failed!")
 			return fmt.Errorf("failed to create client: %w", err)
 		}
 
-		owners, err := client.GetOwners()
+		owners, err := client.GetOwners(ctx)
 		if err != nil {
 			fmt.Println("failed!")
 			fmt.Println()
@@ -132,6 +165,19 @@ func runInit(opts *root.Options, prefillToken string, noVerify bool) error {
 		if len(owners) > 0 {
 			fmt.Printf("First owner: %s (%s)\n", owners[0].FullName(), owners[0].Email)
 		}
+
+		detectPortal(ctx, client, profile)
+	} else if region := config.RegionFromToken(profile.AccessToken); region != "" {
+		profile.Region = region
+	}
+
+	if err := askPreferences(cfg); err != nil {
+		return err
+	}
+
+	cfg.SetProfile(profileName, profile)
+	if cfg.CurrentProfile == "" {
+		cfg.CurrentProfile = profileName
 	}
 
 	// Save configuration
@@ -139,9 +185,96 @@ func runInit(opts *root.Options, prefillToken string, noVerify bool) error {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
-	fmt.Printf("\nConfiguration saved to %s\n", configPath)
+	fmt.Printf("\nProfile %q saved to %s\n", profileName, configPath)
 	fmt.Println("\nYou're all set! Try running:")
 	fmt.Println("  hspt config show")
 
 	return nil
 }
+
+// detectPortal fills in the portal ID, region, time zone, and currency from
+// the account info API.
+// Tokens without the account-info scope fall back to the region encoded in
+// the token prefix.
+func detectPortal(ctx context.Context, client *api.Client, profile *config.Profile) {
+	details, err := client.GetAccountDetails(ctx)
+	if err != nil {
+		profile.Region = config.RegionFromToken(profile.AccessToken)
+		fmt.Println("Could not detect portal details (account-info scope missing?)")
+		return
+	}
+
+	profile.PortalID = details.PortalID
+	profile.Region = details.DataHostingLocation
+	if profile.Region == "" {
+		profile.Region = config.RegionFromToken(profile.AccessToken)
+	}
+	profile.TimeZone = details.TimeZone
+	profile.Currency = details.CompanyCurrency
+
+	fmt.Printf("Portal: %d", profile.PortalID)
+	if profile.Region != "" {
+		fmt.Printf(" (region %s)", profile.Region)
+	}
+	fmt.Println()
+	if profile.TimeZone != "" || profile.Currency != "" {
+		fmt.Printf("Time zone: %s  Currency: %s\n", orNone(profile.TimeZone), orNone(profile.Currency))
+	}
+}
+
+// askPreferences asks for the default output format and whether read
+// commands should cache responses
+func askPreferences(cfg *config.Config) error {
+	enableCache := cfg.CacheTTL != ""
+	if cfg.CacheTTL == "" {
+		cfg.CacheTTL = "5m"
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Default output format").
+				Description("Used when --output is not given").
+				Options(
+					huh.NewOption("table", "table"),
+					huh.NewOption("json", "json"),
+					huh.NewOption("plain", "plain"),
+					huh.NewOption("csv", "csv"),
+				).
+				Value(&cfg.Output),
+			huh.NewConfirm().
+				Title("Enable response caching?").
+				Description("Read commands reuse recent responses instead of calling HubSpot again").
+				Value(&enableCache),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Cache lifetime").
+				Description("How long cached responses stay fresh, e.g. 30s, 5m, 1h").
+				Value(&cfg.CacheTTL).
+				Validate(func(s string) error {
+					if _, err := time.ParseDuration(s); err != nil {
+						return fmt.Errorf("invalid duration: %s", s)
+					}
+					return nil
+				}),
+		).WithHideFunc(func() bool {
+			return !enableCache
+		}),
+	)
+
+	if err := form.Run(); err != nil {
+		return err
+	}
+	if !enableCache {
+		cfg.CacheTTL = ""
+	}
+	return nil
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
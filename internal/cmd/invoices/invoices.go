@@ -0,0 +1,71 @@
+package invoices
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// DefaultProperties are the default properties to fetch for invoices
+var DefaultProperties = []string{"hs_number", "hs_invoice_status", "hs_currency", "hs_amount_billed", "hs_balance_due", "hs_invoice_date", "hs_due_date"}
+
+var config = shared.ObjectCmdConfig{
+	ObjectType: api.ObjectTypeInvoices,
+	Noun:       "invoice",
+	ListExample: `  # List first 10 invoices
+  hspt invoices list
+
+  # Every invoice, for reconciliation
+  hspt invoices list --all -o csv > invoices.csv
+
+  # List with custom properties
+  hspt invoices list --properties hs_number,hs_invoice_status,hs_payment_date`,
+	GetExample: `  # Get invoice by ID
+  hspt invoices get 12345
+
+  # When was it marked paid?
+  hspt invoices get 12345 --with-history hs_invoice_status`,
+	DefaultProperties: DefaultProperties,
+	Headers:           []string{"ID", "NUMBER", "STATUS", "CURRENCY", "BILLED", "BALANCE DUE", "DUE DATE"},
+	Row: func(v *view.View, obj api.CRMObject) []string {
+		currency := obj.GetProperty("hs_currency")
+		return []string{
+			obj.ID,
+			obj.GetProperty("hs_number"),
+			obj.GetProperty("hs_invoice_status"),
+			currency,
+			v.MoneyIn(obj.GetProperty("hs_amount_billed"), currency),
+			v.MoneyIn(obj.GetProperty("hs_balance_due"), currency),
+			v.Time(obj.GetProperty("hs_due_date")),
+		}
+	},
+	Details: func(v *view.View, obj api.CRMObject) [][]string {
+		currency := obj.GetProperty("hs_currency")
+		return [][]string{
+			{"Number", obj.GetProperty("hs_number")},
+			{"Status", obj.GetProperty("hs_invoice_status")},
+			{"Currency", currency},
+			{"Amount Billed", v.MoneyIn(obj.GetProperty("hs_amount_billed"), currency)},
+			{"Balance Due", v.MoneyIn(obj.GetProperty("hs_balance_due"), currency)},
+			{"Invoice Date", v.Time(obj.GetProperty("hs_invoice_date"))},
+			{"Due Date", v.Time(obj.GetProperty("hs_due_date"))},
+		}
+	},
+}
+
+// Register registers the invoices command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "invoices",
+		Short: "View HubSpot invoices",
+		Long:  "Commands for listing and viewing invoices in HubSpot commerce.",
+	}
+
+	cmd.AddCommand(shared.NewListCmd(opts, config))
+	cmd.AddCommand(shared.NewGetCmd(opts, config))
+
+	parent.AddCommand(cmd)
+}
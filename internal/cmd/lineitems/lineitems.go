@@ -8,10 +8,11 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // DefaultProperties are the default properties to fetch for line items
-var DefaultProperties = []string{"name", "quantity", "price", "hs_product_id", "amount"}
+var DefaultProperties = []string{"name", "quantity", "price", "hs_product_id", "amount", "hs_line_item_currency_code"}
 
 // Register registers the line-items command and subcommands
 func Register(parent *cobra.Command, opts *root.Options) {
@@ -35,6 +36,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -60,11 +62,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeLineItems, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeLineItems, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -81,8 +83,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 					obj.ID,
 					obj.GetProperty("name"),
 					obj.GetProperty("quantity"),
-					obj.GetProperty("price"),
-					obj.GetProperty("amount"),
+					v.MoneyIn(obj.GetProperty("price"), obj.GetProperty("hs_line_item_currency_code")),
+					v.MoneyIn(obj.GetProperty("amount"), obj.GetProperty("hs_line_item_currency_code")),
 					obj.GetProperty("hs_product_id"),
 				})
 			}
@@ -103,11 +105,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -132,7 +137,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeLineItems, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeLineItems, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Line item %s not found", id)
@@ -146,18 +151,23 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"ID", obj.ID},
 				{"Name", obj.GetProperty("name")},
 				{"Quantity", obj.GetProperty("quantity")},
-				{"Price", obj.GetProperty("price")},
-				{"Amount", obj.GetProperty("amount")},
+				{"Price", v.MoneyIn(obj.GetProperty("price"), obj.GetProperty("hs_line_item_currency_code"))},
+				{"Amount", v.MoneyIn(obj.GetProperty("amount"), obj.GetProperty("hs_line_item_currency_code"))},
 				{"Product ID", obj.GetProperty("hs_product_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
@@ -209,7 +219,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeLineItems, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeLineItems, properties)
 			if err != nil {
 				return err
 			}
@@ -221,7 +231,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				{"ID", obj.ID},
 				{"Name", obj.GetProperty("name")},
 				{"Quantity", obj.GetProperty("quantity")},
-				{"Price", obj.GetProperty("price")},
+				{"Price", v.MoneyIn(obj.GetProperty("price"), obj.GetProperty("hs_line_item_currency_code"))},
 			}
 
 			return v.Render(headers, rows, obj)
@@ -286,7 +296,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeLineItems, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeLineItems, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Line item %s not found", id)
@@ -336,7 +346,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeLineItems, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeLineItems, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Line item %s not found", id)
 					return nil
@@ -0,0 +1,458 @@
+package lists
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// objectTypeIDs maps object type names to the IDs the lists API expects
+var objectTypeIDs = map[string]string{
+	"contacts":  "0-1",
+	"companies": "0-2",
+	"deals":     "0-3",
+	"tickets":   "0-5",
+}
+
+// processingTypes maps --type values to list processing types
+var processingTypes = map[string]string{
+	"static":   api.ListProcessingManual,
+	"active":   api.ListProcessingDynamic,
+	"snapshot": api.ListProcessingSnapshot,
+}
+
+// Register registers the lists command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "lists",
+		Short: "Manage HubSpot lists",
+		Long: `Commands for managing static and active CRM lists.
+
+Static lists change only when records are added or removed; active lists
+update automatically from their filters.`,
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newMembershipsCmd(opts))
+	cmd.AddCommand(newMembersCmd(opts, true))
+	cmd.AddCommand(newMembersCmd(opts, false))
+	cmd.AddCommand(newSnapshotCmd(opts))
+	cmd.AddCommand(newDiffSnapshotsCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var query string
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List lists",
+		Long:  "List CRM lists, optionally filtered by name.",
+		Example: `  # List lists
+  hspt lists list
+
+  # Find lists by name
+  hspt lists list --query newsletter
+
+  # Get every list
+  hspt lists list --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListLists(cmd.Context(), query, pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No lists found")
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "TYPE", "OBJECT", "SIZE", "UPDATED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, list := range result.Results {
+				rows = append(rows, []string{
+					list.ListID,
+					list.Name,
+					formatProcessingType(list.ProcessingType),
+					formatObjectType(list.ObjectTypeID),
+					fmt.Sprintf("%d", list.MemberCount()),
+					list.UpdatedAt,
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&query, "query", "", "Only show lists whose name contains this text")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of lists to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination offset for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <listId>",
+		Short: "Get a list",
+		Long:  "Retrieve a single list by ID.",
+		Example: `  # Get a list
+  hspt lists get 123`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			listID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.GetList(cmd.Context(), listID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("List %s not found", listID)
+					return nil
+				}
+				return err
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", list.ListID},
+				{"Name", list.Name},
+				{"Type", formatProcessingType(list.ProcessingType)},
+				{"Object", formatObjectType(list.ObjectTypeID)},
+				{"Size", fmt.Sprintf("%d", list.MemberCount())},
+				{"Status", list.ProcessingStatus},
+				{"Version", fmt.Sprintf("%d", list.ListVersion)},
+				{"Created", v.Time(list.CreatedAt)},
+				{"Updated", v.Time(list.UpdatedAt)},
+			}
+
+			if list.FiltersUpdatedAt != "" {
+				rows = append(rows, []string{"Filters Updated", list.FiltersUpdatedAt})
+			}
+
+			return v.Render(headers, rows, list)
+		},
+	}
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var name, objectType, listType, filterFile string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a list",
+		Long: `Create a static, active, or snapshot list.
+
+Active and snapshot lists need a filter branch, given as a JSON file in
+HubSpot's filterBranch format.`,
+		Example: `  # Create a static contact list
+  hspt lists create --name "Webinar attendees"
+
+  # Create an active company list from filters
+  hspt lists create --name "Enterprise" --object-type companies --type active --filter-file filters.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if name == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			processingType, ok := processingTypes[strings.ToLower(listType)]
+			if !ok {
+				return fmt.Errorf("invalid --type %q (expected static, active, or snapshot)", listType)
+			}
+
+			objectTypeID, err := resolveObjectTypeID(objectType)
+			if err != nil {
+				return err
+			}
+
+			req := api.CreateListRequest{
+				Name:           name,
+				ObjectTypeID:   objectTypeID,
+				ProcessingType: processingType,
+			}
+
+			if filterFile != "" {
+				data, err := os.ReadFile(filterFile)
+				if err != nil {
+					return fmt.Errorf("failed to read file: %w", err)
+				}
+				if err := json.Unmarshal(data, &req.FilterBranch); err != nil {
+					return fmt.Errorf("invalid JSON: %w", err)
+				}
+			}
+			if processingType != api.ListProcessingManual && req.FilterBranch == nil {
+				return fmt.Errorf("--filter-file is required for %s lists", listType)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.CreateList(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			v.Success("List created with ID: %s (name: %s)", list.ListID, list.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "List name (required)")
+	cmd.Flags().StringVar(&objectType, "object-type", "contacts", "Object type (contacts, companies, deals, tickets, or a type ID)")
+	cmd.Flags().StringVar(&listType, "type", "static", "List type: static, active, or snapshot")
+	cmd.Flags().StringVar(&filterFile, "filter-file", "", "JSON file containing the list's filterBranch")
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <listId>",
+		Short: "Delete a list",
+		Long:  "Delete a list by ID. HubSpot keeps deleted lists restorable for 90 days.",
+		Example: `  # Delete a list
+  hspt lists delete 123 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			listID := args[0]
+
+			if !force {
+				v.Warning("This will delete list %s. Use --force to confirm.", listID)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteList(cmd.Context(), listID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("List %s not found", listID)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("List %s deleted", listID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func newMembershipsCmd(opts *root.Options) *cobra.Command {
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "memberships <listId>",
+		Short: "List the records in a list",
+		Long:  "List the IDs of records that belong to a list.",
+		Example: `  # List members
+  hspt lists memberships 123
+
+  # Export every member ID
+  hspt lists memberships 123 --all -o csv`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			listID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListListMemberships(cmd.Context(), listID, pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("List %s not found", listID)
+					return nil
+				}
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No members found")
+				return nil
+			}
+
+			headers := []string{"RECORD ID", "ADDED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, m := range result.Results {
+				rows = append(rows, []string{m.RecordID, m.MembershipTimestamp})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 100, "Maximum number of members to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+// newMembersCmd builds "add" (add true) or "remove"
+func newMembersCmd(opts *root.Options, add bool) *cobra.Command {
+	var contactIDs []string
+
+	use, short, verb := "remove <listId>", "Remove records from a static list", "removed from"
+	if add {
+		use, short, verb = "add <listId>", "Add records to a static list", "added to"
+	}
+	action := strings.Fields(use)[0]
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: fmt.Sprintf(`%s. Active lists are managed by their filters
+and cannot be changed directly.`, short),
+		Example: fmt.Sprintf(`  # %s contacts
+  hspt lists %s 123 --contact-ids 101,102,103`, strings.ToUpper(action[:1])+action[1:], action),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			listID := args[0]
+
+			if len(contactIDs) == 0 {
+				return fmt.Errorf("--contact-ids is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var result *api.ListMembershipChange
+			if add {
+				result, err = client.AddListMembers(cmd.Context(), listID, contactIDs)
+			} else {
+				result, err = client.RemoveListMembers(cmd.Context(), listID, contactIDs)
+			}
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("List %s not found", listID)
+					return nil
+				}
+				return err
+			}
+
+			changed := len(result.RecordIDsRemoved)
+			if add {
+				changed = len(result.RecordIDsAdded)
+			}
+			v.Success("%d record(s) %s list %s", changed, verb, listID)
+
+			if unchanged := len(contactIDs) - changed - len(result.RecordIDsMissing); unchanged > 0 {
+				v.Info("%d record(s) were already up to date", unchanged)
+			}
+			if len(result.RecordIDsMissing) > 0 {
+				v.Warning("Records not found: %s", strings.Join(result.RecordIDsMissing, ", "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&contactIDs, "contact-ids", nil, "Record IDs to "+action+" (comma-separated)")
+
+	return cmd
+}
+
+// resolveObjectTypeID accepts an object type name or a raw type ID like 2-123
+func resolveObjectTypeID(objectType string) (string, error) {
+	if id, ok := objectTypeIDs[strings.ToLower(objectType)]; ok {
+		return id, nil
+	}
+	if strings.Contains(objectType, "-") {
+		return objectType, nil
+	}
+	return "", fmt.Errorf("unknown object type %q (expected contacts, companies, deals, tickets, or a type ID like 2-123)", objectType)
+}
+
+func formatObjectType(objectTypeID string) string {
+	for name, id := range objectTypeIDs {
+		if id == objectTypeID {
+			return name
+		}
+	}
+	if objectTypeID == "" {
+		return "-"
+	}
+	return objectTypeID
+}
+
+func formatProcessingType(processingType string) string {
+	for name, t := range processingTypes {
+		if t == processingType {
+			return name
+		}
+	}
+	if processingType == "" {
+		return "-"
+	}
+	return strings.ToLower(processingType)
+}
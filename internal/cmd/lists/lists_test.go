@@ -0,0 +1,75 @@
+package lists
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestResolveObjectTypeID(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"contacts", "0-1"},
+		{"Companies", "0-2"},
+		{"deals", "0-3"},
+		{"tickets", "0-5"},
+		{"2-123456", "2-123456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := resolveObjectTypeID(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := resolveObjectTypeID("widgets")
+	assert.Error(t, err)
+}
+
+func TestFormatObjectType(t *testing.T) {
+	assert.Equal(t, "contacts", formatObjectType("0-1"))
+	assert.Equal(t, "2-123", formatObjectType("2-123"))
+	assert.Equal(t, "-", formatObjectType(""))
+}
+
+func TestFormatProcessingType(t *testing.T) {
+	assert.Equal(t, "static", formatProcessingType("MANUAL"))
+	assert.Equal(t, "active", formatProcessingType("DYNAMIC"))
+	assert.Equal(t, "snapshot", formatProcessingType("SNAPSHOT"))
+	assert.Equal(t, "-", formatProcessingType(""))
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	list := &api.List{ListID: "123", Name: "MQLs"}
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, writeSnapshot(&buf, list, []string{"30", "10", "20"}, at))
+	assert.Equal(t, "# list 123: MQLs\n# captured 2024-06-01T12:00:00Z, 3 members\n10\n20\n30\n", buf.String())
+
+	ids, err := readSnapshot(strings.NewReader(buf.String() + "\n  40 \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10", "20", "30", "40"}, ids)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	diff := diffSnapshots([]string{"1", "2", "3", "3"}, []string{"2", "3", "5", "4"})
+	assert.Equal(t, []string{"4", "5"}, diff.Added)
+	assert.Equal(t, []string{"1"}, diff.Removed)
+	assert.Equal(t, 2, diff.Unchanged)
+
+	empty := diffSnapshots(nil, nil)
+	assert.Empty(t, empty.Added)
+	assert.Empty(t, empty.Removed)
+	assert.Zero(t, empty.Unchanged)
+}
@@ -0,0 +1,219 @@
+package lists
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// snapshotDiff is the change in membership between two snapshots
+type snapshotDiff struct {
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Unchanged int      `json:"unchanged"`
+}
+
+// writeSnapshot writes record IDs one per line, sorted, below a comment
+// header describing the list and when it was captured
+func writeSnapshot(w io.Writer, list *api.List, recordIDs []string, at time.Time) error {
+	ids := append([]string(nil), recordIDs...)
+	sort.Strings(ids)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# list %s: %s\n", list.ListID, list.Name)
+	fmt.Fprintf(bw, "# captured %s, %d members\n", at.UTC().Format(time.RFC3339), len(ids))
+	for _, id := range ids {
+		fmt.Fprintln(bw, id)
+	}
+	return bw.Flush()
+}
+
+// readSnapshot reads the record IDs from a snapshot, skipping blank lines
+// and # comments, so hand-made ID lists can be compared too
+func readSnapshot(r io.Reader) ([]string, error) {
+	var ids []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, line)
+	}
+	return ids, scanner.Err()
+}
+
+// readSnapshotFile reads the record IDs from the snapshot at path
+func readSnapshotFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	ids, err := readSnapshot(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+	return ids, nil
+}
+
+// diffSnapshots compares two sets of record IDs. Duplicates are ignored and
+// the results are sorted.
+func diffSnapshots(older, newer []string) snapshotDiff {
+	before := make(map[string]bool, len(older))
+	for _, id := range older {
+		before[id] = true
+	}
+	after := make(map[string]bool, len(newer))
+	for _, id := range newer {
+		after[id] = true
+	}
+
+	diff := snapshotDiff{Added: []string{}, Removed: []string{}}
+	for id := range after {
+		if before[id] {
+			diff.Unchanged++
+		} else {
+			diff.Added = append(diff.Added, id)
+		}
+	}
+	for id := range before {
+		if !after[id] {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+
+	return diff
+}
+
+func newSnapshotCmd(opts *root.Options) *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "snapshot <listId>",
+		Short: "Save the current members of a list to a file",
+		Long: `Save the record IDs currently in a list, one per line, so membership can be
+compared over time with "hspt lists diff-snapshots".
+
+Every page of members is read. The file starts with # comment lines naming
+the list and the capture time.`,
+		Example: `  # Capture today's members
+  hspt lists snapshot 123 --out members-2024-06-01.txt
+
+  # Write to stdout
+  hspt lists snapshot 123 > members.txt`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			listID := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.GetList(cmd.Context(), listID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("List %s not found", listID)
+					return nil
+				}
+				return err
+			}
+
+			members, err := client.ListListMemberships(cmd.Context(), listID, api.ListOptions{All: true})
+			if err != nil {
+				return err
+			}
+
+			ids := make([]string, 0, len(members.Results))
+			for _, m := range members.Results {
+				ids = append(ids, m.RecordID)
+			}
+
+			if out == "" {
+				return writeSnapshot(opts.Stdout, list, ids, time.Now())
+			}
+
+			f, err := os.Create(out)
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot: %w", err)
+			}
+			if err := writeSnapshot(f, list, ids, time.Now()); err != nil {
+				f.Close()
+				return fmt.Errorf("failed to write snapshot: %w", err)
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write snapshot: %w", err)
+			}
+
+			v.Success("Saved %d member(s) of list %s to %s", len(ids), listID, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File to write the snapshot to (default: stdout)")
+
+	return cmd
+}
+
+func newDiffSnapshotsCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff-snapshots <old> <new>",
+		Short: "Compare two list snapshots",
+		Long: `Compare two files written by "hspt lists snapshot" and show which records
+joined and left the list between them. Any file with one record ID per line
+can be compared.`,
+		Example: `  # What changed this month?
+  hspt lists diff-snapshots members-2024-06-01.txt members-2024-07-01.txt
+
+  # Only the record IDs that left, for scripting
+  hspt lists diff-snapshots old.txt new.txt -o json | jq -r '.removed[]'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			older, err := readSnapshotFile(args[0])
+			if err != nil {
+				return err
+			}
+			newer, err := readSnapshotFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff := diffSnapshots(older, newer)
+
+			headers := []string{"CHANGE", "RECORD ID"}
+			rows := make([][]string, 0, len(diff.Added)+len(diff.Removed))
+			for _, id := range diff.Added {
+				rows = append(rows, []string{"added", id})
+			}
+			for _, id := range diff.Removed {
+				rows = append(rows, []string{"removed", id})
+			}
+
+			if len(rows) > 0 || v.Format == view.FormatJSON {
+				if err := v.Render(headers, rows, diff); err != nil {
+					return err
+				}
+			}
+
+			v.Info("%d added, %d removed, %d unchanged", len(diff.Added), len(diff.Removed), diff.Unchanged)
+			return nil
+		},
+	}
+}
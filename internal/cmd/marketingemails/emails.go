@@ -3,11 +3,13 @@ package marketingemails
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the marketing emails command and subcommands
@@ -16,7 +18,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 		Use:     "marketing-emails",
 		Aliases: []string{"emails", "me"},
 		Short:   "Manage HubSpot marketing emails",
-		Long:    "Commands for listing, viewing, creating, updating, and deleting marketing emails.",
+		Long:    "Commands for listing, viewing, creating, updating, deleting, and previewing marketing emails, and for their send statistics.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
@@ -24,6 +26,8 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newCreateCmd(opts))
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newPreviewCmd(opts))
+	cmd.AddCommand(newStatsCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -31,6 +35,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -49,10 +54,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListMarketingEmails(api.ListOptions{
+			result, err := client.ListMarketingEmails(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -89,6 +94,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of emails to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -109,7 +116,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			email, err := client.GetMarketingEmail(id)
+			email, err := client.GetMarketingEmail(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Marketing email %s not found", id)
@@ -129,8 +136,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Reply To", email.ReplyTo},
 				{"Campaign ID", email.CampaignID},
 				{"Archived", formatBool(email.Archived)},
-				{"Created", email.CreatedAt},
-				{"Updated", email.UpdatedAt},
+				{"Created", v.Time(email.CreatedAt)},
+				{"Updated", v.Time(email.UpdatedAt)},
 			}
 
 			if email.PublishDate != "" {
@@ -179,7 +186,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				data["type"] = emailType
 			}
 
-			email, err := client.CreateMarketingEmail(data)
+			email, err := client.CreateMarketingEmail(cmd.Context(), data)
 			if err != nil {
 				return err
 			}
@@ -247,7 +254,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("no updates specified; use --name, --subject, --from-name, --reply-to, or --json")
 			}
 
-			email, err := client.UpdateMarketingEmail(id, updates)
+			email, err := client.UpdateMarketingEmail(cmd.Context(), id, updates)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Marketing email %s not found", id)
@@ -287,7 +294,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteMarketingEmail(id)
+			err = client.DeleteMarketingEmail(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Marketing email %s not found", id)
@@ -315,3 +322,62 @@ func formatBool(b bool) string {
 	}
 	return "No"
 }
+
+func newPreviewCmd(opts *root.Options) *cobra.Command {
+	var out, contact string
+
+	cmd := &cobra.Command{
+		Use:   "preview <id>",
+		Short: "Fetch the rendered HTML of a marketing email",
+		Long: `Fetch the rendered HTML of a marketing email as a recipient would see it.
+
+Use --portal-contact to resolve personalization tokens for a specific
+contact; without it HubSpot's default token values are used. The HTML is
+written to --out, or to stdout when --out is not given, so renders can be
+diffed across edits.`,
+		Example: `  # Save the render for a contact
+  hspt marketing-emails preview 12345 --out preview.html --portal-contact a@b.com
+
+  # Compare against an earlier render
+  hspt marketing-emails preview 12345 --portal-contact a@b.com | diff before.html -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			html, err := client.PreviewMarketingEmail(cmd.Context(), id, contact)
+			if err != nil {
+				if api.IsNotFound(err) {
+					if contact != "" {
+						v.Error("Marketing email %s or contact %s not found", id, contact)
+					} else {
+						v.Error("Marketing email %s not found", id)
+					}
+					return nil
+				}
+				return err
+			}
+
+			if out == "" {
+				_, err := opts.Stdout.Write(html)
+				return err
+			}
+
+			if err := os.WriteFile(out, html, 0644); err != nil {
+				return fmt.Errorf("failed to write preview: %w", err)
+			}
+			v.Success("Preview of marketing email %s written to %s", id, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File to write the HTML to (default: stdout)")
+	cmd.Flags().StringVar(&contact, "portal-contact", "", "Email of the contact to personalize the render for")
+
+	return cmd
+}
@@ -0,0 +1,123 @@
+package marketingemails
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// emailStats is the summary printed by "marketing-emails stats"
+type emailStats struct {
+	EmailID string `json:"emailId"`
+	Start   string `json:"start,omitempty"`
+	End     string `json:"end,omitempty"`
+	*api.EmailStatistics
+}
+
+// statsTimestamp converts a --start or --end value, a date (YYYY-MM-DD) or
+// an RFC 3339 time, to the ISO 8601 timestamp HubSpot expects. A date given
+// as the end of the range includes that whole day.
+func statsTimestamp(flag, value string, end bool) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return "", fmt.Errorf("invalid --%s %q: use YYYY-MM-DD or an RFC 3339 time", flag, value)
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t.Format(time.RFC3339), nil
+}
+
+// statsRows lays out the statistics as metric/value rows, with the rate
+// beside each count
+func statsRows(s *api.EmailStatistics) [][]string {
+	c, r := s.Counters, s.Ratios
+	withRate := func(count int, rate float64) string {
+		return fmt.Sprintf("%d (%s%%)", count, strconv.FormatFloat(rate, 'f', 2, 64))
+	}
+	return [][]string{
+		{"Sent", strconv.Itoa(c.Sent)},
+		{"Delivered", withRate(c.Delivered, r.DeliveredRatio)},
+		{"Opens", withRate(c.Open, r.OpenRatio)},
+		{"Clicks", withRate(c.Click, r.ClickRatio)},
+		{"Bounces", withRate(c.Bounce, r.BounceRatio)},
+		{"Hard bounces", strconv.Itoa(c.HardBounced)},
+		{"Soft bounces", strconv.Itoa(c.SoftBounced)},
+		{"Unsubscribes", withRate(c.Unsubscribed, r.UnsubscribedRatio)},
+		{"Spam reports", withRate(c.SpamReport, r.SpamReportRatio)},
+	}
+}
+
+func newStatsCmd(opts *root.Options) *cobra.Command {
+	var start, end string
+
+	cmd := &cobra.Command{
+		Use:   "stats <id>",
+		Short: "Show a marketing email's send statistics",
+		Long: `Show how a marketing email performed: sends, deliveries, opens, clicks,
+bounces, unsubscribes, and spam reports, each with its rate.
+
+--start and --end limit the statistics to sends in a range; a date given as
+--end includes that whole day (UTC). Use -o json to feed the numbers to a
+dashboard.`,
+		Example: `  # All-time statistics
+  hspt marketing-emails stats 12345
+
+  # Statistics for March, as JSON
+  hspt marketing-emails stats 12345 --start 2026-03-01 --end 2026-03-31 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			var r api.EmailStatisticsRange
+			var err error
+			if r.StartTimestamp, err = statsTimestamp("start", start, false); err != nil {
+				return err
+			}
+			if r.EndTimestamp, err = statsTimestamp("end", end, true); err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			stats, err := client.GetMarketingEmailStatistics(cmd.Context(), id, r)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Marketing email %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to get email statistics: %w", err)
+			}
+
+			headers := []string{"METRIC", "VALUE"}
+			return v.Render(headers, statsRows(stats), emailStats{
+				EmailID:         id,
+				Start:           r.StartTimestamp,
+				End:             r.EndTimestamp,
+				EmailStatistics: stats,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&start, "start", "", "Only count sends from this date or time (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&end, "end", "", "Only count sends up to this date or time (YYYY-MM-DD or RFC 3339)")
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package marketingemails
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestStatsTimestamp(t *testing.T) {
+	ts, err := statsTimestamp("start", "2026-03-01", false)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01T00:00:00Z", ts)
+
+	ts, err = statsTimestamp("end", "2026-03-31", true)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-04-01T00:00:00Z", ts)
+
+	ts, err = statsTimestamp("end", "2026-03-31T12:00:00+02:00", true)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-31T10:00:00Z", ts)
+
+	ts, err = statsTimestamp("start", "", false)
+	require.NoError(t, err)
+	assert.Empty(t, ts)
+
+	_, err = statsTimestamp("start", "March 1", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--start")
+}
+
+func TestStatsRows(t *testing.T) {
+	rows := statsRows(&api.EmailStatistics{
+		Counters: api.EmailStatisticsCounters{Sent: 1000, Delivered: 980, Open: 420, Bounce: 20, HardBounced: 5},
+		Ratios:   api.EmailStatisticsRatios{DeliveredRatio: 98, OpenRatio: 42.857, BounceRatio: 2},
+	})
+
+	assert.Equal(t, []string{"Sent", "1000"}, rows[0])
+	assert.Equal(t, []string{"Delivered", "980 (98.00%)"}, rows[1])
+	assert.Equal(t, []string{"Opens", "420 (42.86%)"}, rows[2])
+	assert.Equal(t, []string{"Hard bounces", "5"}, rows[5])
+}
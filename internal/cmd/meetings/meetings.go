@@ -8,6 +8,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // DefaultProperties are the default properties to fetch for meetings
@@ -34,6 +35,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -56,11 +58,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeMeetings, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeMeetings, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -98,11 +100,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -124,7 +129,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeMeetings, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeMeetings, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Meeting %s not found", id)
@@ -142,22 +147,27 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"End Time", obj.GetProperty("hs_meeting_end_time")},
 				{"Outcome", obj.GetProperty("hs_meeting_outcome")},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
 	var title, body, startTime, endTime, outcome, ownerID string
-	var props []string
+	var props, associate []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -167,10 +177,23 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   hspt meetings create --title "Sales Demo" --start-time 1704067200000 --end-time 1704070800000
 
   # Create with outcome
-  hspt meetings create --title "Discovery Call" --outcome SCHEDULED`,
+  hspt meetings create --title "Discovery Call" --outcome SCHEDULED
+
+  # Attach to records
+  hspt meetings create --title "Kickoff" --associate contacts:123 --associate companies:789`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			values, fromContext := shared.WithRecordContext(cmd, associate)
+			values, err := shared.ResolveAssociateValues(cmd, opts, values)
+			if err != nil {
+				return err
+			}
+			associations, err := shared.ParseAssociations(api.ObjectTypeMeetings, values)
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -207,12 +230,15 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeMeetings, properties)
+			obj, err := client.CreateObjectWithAssociations(cmd.Context(), api.ObjectTypeMeetings, properties, associations)
 			if err != nil {
 				return err
 			}
 
 			v.Success("Meeting created with ID: %s", obj.ID)
+			if len(fromContext) > 0 {
+				v.Info("Associated from context: %s", strings.Join(fromContext, ", "))
+			}
 
 			headers := []string{"PROPERTY", "VALUE"}
 			rows := [][]string{
@@ -232,6 +258,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&outcome, "outcome", "", "Meeting outcome (SCHEDULED, COMPLETED, RESCHEDULED, etc.)")
 	cmd.Flags().StringVar(&ownerID, "owner-id", "", "HubSpot owner ID")
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
+	shared.AddAssociateFlag(cmd, &associate)
 
 	return cmd
 }
@@ -287,7 +314,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeMeetings, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeMeetings, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Meeting %s not found", id)
@@ -339,7 +366,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeMeetings, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeMeetings, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Meeting %s not found", id)
 					return nil
@@ -8,6 +8,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // DefaultProperties are the default properties to fetch for notes
@@ -34,6 +35,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -56,11 +58,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeNotes, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeNotes, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -76,7 +78,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				rows = append(rows, []string{
 					obj.ID,
 					truncate(obj.GetProperty("hs_note_body"), 50),
-					obj.GetProperty("hs_timestamp"),
+					v.Time(obj.GetProperty("hs_timestamp")),
 					obj.GetProperty("hubspot_owner_id"),
 				})
 			}
@@ -97,11 +99,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -123,7 +128,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeNotes, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeNotes, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Note %s not found", id)
@@ -136,24 +141,29 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			rows := [][]string{
 				{"ID", obj.ID},
 				{"Body", obj.GetProperty("hs_note_body")},
-				{"Timestamp", obj.GetProperty("hs_timestamp")},
+				{"Timestamp", v.Time(obj.GetProperty("hs_timestamp"))},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
 	var body, timestamp, ownerID string
-	var props []string
+	var props, associate []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -163,10 +173,23 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   hspt notes create --body "Meeting notes from today's call"
 
   # Create with owner
-  hspt notes create --body "Follow-up required" --owner-id 12345`,
+  hspt notes create --body "Follow-up required" --owner-id 12345
+
+  # Attach to records
+  hspt notes create --body "Discussed renewal" --associate contacts:123 --associate deals:456`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			values, fromContext := shared.WithRecordContext(cmd, associate)
+			values, err := shared.ResolveAssociateValues(cmd, opts, values)
+			if err != nil {
+				return err
+			}
+			associations, err := shared.ParseAssociations(api.ObjectTypeNotes, values)
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -194,12 +217,15 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required (--body is recommended)")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeNotes, properties)
+			obj, err := client.CreateObjectWithAssociations(cmd.Context(), api.ObjectTypeNotes, properties, associations)
 			if err != nil {
 				return err
 			}
 
 			v.Success("Note created with ID: %s", obj.ID)
+			if len(fromContext) > 0 {
+				v.Info("Associated from context: %s", strings.Join(fromContext, ", "))
+			}
 
 			headers := []string{"PROPERTY", "VALUE"}
 			rows := [][]string{
@@ -215,6 +241,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&timestamp, "timestamp", "", "Note timestamp (Unix milliseconds)")
 	cmd.Flags().StringVar(&ownerID, "owner-id", "", "HubSpot owner ID")
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
+	shared.AddAssociateFlag(cmd, &associate)
 
 	return cmd
 }
@@ -261,7 +288,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeNotes, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeNotes, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Note %s not found", id)
@@ -310,7 +337,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeNotes, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeNotes, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Note %s not found", id)
 					return nil
@@ -1,6 +1,8 @@
 package owners
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/hubspot-cli/api"
@@ -17,6 +19,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newReassignCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -36,7 +39,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			owners, err := client.GetOwners()
+			owners, err := client.GetOwners(cmd.Context())
 			if err != nil {
 				return err
 			}
@@ -67,26 +70,43 @@ func newListCmd(opts *root.Options) *cobra.Command {
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
-		Use:   "get <id>",
-		Short: "Get an owner by ID",
-		Long:  "Retrieve a single owner by their ID.",
+	var email string
+
+	cmd := &cobra.Command{
+		Use:   "get [id]",
+		Short: "Get an owner by ID or email",
+		Long:  "Retrieve a single owner by their ID, or by their email address with --email.",
 		Example: `  # Get owner by ID
-  hspt owners get 12345`,
-		Args: cobra.ExactArgs(1),
+  hspt owners get 12345
+
+  # Find the owner ID for a colleague
+  hspt owners get --email jane@example.com`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
-			id := args[0]
+
+			if (len(args) == 1) == (email != "") {
+				return fmt.Errorf("specify either an owner ID or --email")
+			}
 
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
 			}
 
-			owner, err := client.GetOwner(id)
+			var owner *api.Owner
+			if email != "" {
+				owner, err = client.GetOwnerByEmail(cmd.Context(), email)
+			} else {
+				owner, err = client.GetOwner(cmd.Context(), args[0])
+			}
 			if err != nil {
 				if api.IsNotFound(err) {
-					v.Error("Owner %s not found", id)
+					if email != "" {
+						v.Error("No owner has email %s", email)
+					} else {
+						v.Error("Owner %s not found", args[0])
+					}
 					return nil
 				}
 				return err
@@ -115,6 +135,10 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			return v.Render(headers, rows, owner)
 		},
 	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Find the owner with this email address")
+
+	return cmd
 }
 
 func formatBool(b bool) string {
@@ -0,0 +1,330 @@
+package owners
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// reassignable are the object types owners can be moved between, with the
+// property that names a record and the filter that selects open records
+// (nil when the type has no open or closed state)
+var reassignable = map[api.ObjectType]struct {
+	nameProperty string
+	open         []api.SearchFilter
+}{
+	api.ObjectTypeContacts:  {nameProperty: "email"},
+	api.ObjectTypeCompanies: {nameProperty: "name"},
+	api.ObjectTypeDeals: {nameProperty: "dealname", open: []api.SearchFilter{
+		{PropertyName: "hs_is_closed", Operator: "EQ", Value: "false"},
+	}},
+	api.ObjectTypeTickets: {nameProperty: "subject", open: []api.SearchFilter{
+		{PropertyName: "closed_date", Operator: "NOT_HAS_PROPERTY"},
+	}},
+	api.ObjectTypeTasks: {nameProperty: "hs_task_subject", open: []api.SearchFilter{
+		{PropertyName: "hs_task_status", Operator: "NEQ", Value: "COMPLETED"},
+	}},
+}
+
+// reassignment is one record in the change report
+type reassignment struct {
+	ObjectType string `json:"objectType"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	FromOwner  string `json:"fromOwner"`
+	ToOwner    string `json:"toOwner"`
+	// Status is "planned" on a dry run, then "reassigned" or "failed"
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// parseObjectTypes validates the --objects list
+func parseObjectTypes(values []string) ([]api.ObjectType, error) {
+	var types []api.ObjectType
+	seen := make(map[api.ObjectType]bool)
+	for _, value := range values {
+		t := api.ObjectType(strings.ToLower(strings.TrimSpace(value)))
+		if t == "" || seen[t] {
+			continue
+		}
+		if _, ok := reassignable[t]; !ok {
+			return nil, fmt.Errorf("cannot reassign %q (use contacts, companies, deals, tickets, or tasks)", value)
+		}
+		seen[t] = true
+		types = append(types, t)
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("--objects needs at least one object type")
+	}
+	return types, nil
+}
+
+// resolveOwnerID returns the owner ID for an owner ID or email. Departed
+// users are usually archived owners, so those are searched too.
+func resolveOwnerID(ctx context.Context, client *api.Client, value string) (string, error) {
+	if !strings.Contains(value, "@") {
+		return value, nil
+	}
+
+	owner, err := client.GetOwnerByEmail(ctx, value)
+	if api.IsNotFound(err) {
+		owner, err = client.GetArchivedOwnerByEmail(ctx, value)
+	}
+	if err != nil {
+		if api.IsNotFound(err) {
+			return "", fmt.Errorf("no HubSpot user has email %s (see hspt owners list)", value)
+		}
+		return "", fmt.Errorf("failed to look up owner %s: %w", value, err)
+	}
+	return owner.ID, nil
+}
+
+// ownedRecords returns up to shared.MaxSearchResults records of objectType owned by
+// ownerID, and whether more were left behind
+func ownedRecords(ctx context.Context, client *api.Client, objectType api.ObjectType, ownerID string, openOnly bool) ([]api.CRMObject, bool, error) {
+	spec := reassignable[objectType]
+	filters := []api.SearchFilter{{PropertyName: "hubspot_owner_id", Operator: "EQ", Value: ownerID}}
+	if openOnly {
+		filters = append(filters, spec.open...)
+	}
+
+	req := api.SearchRequest{
+		FilterGroups: []api.SearchFilterGroup{{Filters: filters}},
+		Properties:   []string{spec.nameProperty},
+		Sorts:        []api.SearchSort{{PropertyName: "hs_object_id", Direction: "ASCENDING"}},
+		Limit:        api.DefaultPageSize,
+	}
+
+	var records []api.CRMObject
+	for {
+		page, err := client.SearchObjects(ctx, objectType, req)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to search %s: %w", objectType, err)
+		}
+		records = append(records, page.Results...)
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			return records, false, nil
+		}
+		if len(records) >= shared.MaxSearchResults {
+			return records, true, nil
+		}
+		req.After = page.Paging.Next.After
+	}
+}
+
+// unseenRecords returns the records whose IDs are not in seen, and adds
+// their IDs to it
+func unseenRecords(records []api.CRMObject, seen map[string]bool) []api.CRMObject {
+	unseen := make([]api.CRMObject, 0, len(records))
+	for _, r := range records {
+		if seen[r.ID] {
+			continue
+		}
+		seen[r.ID] = true
+		unseen = append(unseen, r)
+	}
+	return unseen
+}
+
+// reassignBatch moves up to api.MaxBatchSize records to toOwner and marks
+// each entry reassigned or failed
+func reassignBatch(ctx context.Context, client *api.Client, objectType api.ObjectType, toOwner string, entries []reassignment) {
+	inputs := make([]api.BatchInput, 0, len(entries))
+	for _, e := range entries {
+		inputs = append(inputs, api.BatchInput{
+			ID:         e.ID,
+			Properties: map[string]interface{}{"hubspot_owner_id": toOwner},
+		})
+	}
+
+	result, err := client.BatchUpdateObjects(ctx, objectType, inputs)
+	if err != nil {
+		for i := range entries {
+			entries[i].Status = "failed"
+			entries[i].Error = err.Error()
+		}
+		return
+	}
+
+	updated := make(map[string]bool, len(result.Results))
+	for _, obj := range result.Results {
+		updated[obj.ID] = true
+	}
+	failed := make(map[string]string)
+	for _, e := range result.Errors {
+		for _, id := range e.Context["ids"] {
+			failed[id] = e.Message
+		}
+	}
+
+	for i := range entries {
+		switch {
+		case updated[entries[i].ID]:
+			entries[i].Status = "reassigned"
+		case failed[entries[i].ID] != "":
+			entries[i].Status = "failed"
+			entries[i].Error = failed[entries[i].ID]
+		default:
+			entries[i].Status = "failed"
+			entries[i].Error = "not in the batch response"
+		}
+	}
+}
+
+func newReassignCmd(opts *root.Options) *cobra.Command {
+	var from, to string
+	var objects []string
+	var openOnly, dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "reassign",
+		Short: "Move every record a user owns to another user",
+		Long: `Find the records owned by one user and transfer them to another, in batches,
+printing a report of every record changed. This is the usual offboarding
+step when someone leaves.
+
+--from and --to take an owner ID or email; users who have been removed from
+the portal are found among archived owners. --open-only leaves closed deals,
+closed tickets, and completed tasks with their original owner (contacts and
+companies have no closed state and are always moved). Use --dry-run to see
+the report without changing anything, and -o csv to keep it.`,
+		Example: `  # Preview what would move
+  hspt owners reassign --from old@example.com --to new@example.com --dry-run
+
+  # Move open deals and tickets, plus contacts, and keep the report
+  hspt owners reassign --from old@example.com --to new@example.com \
+    --objects contacts,deals,tickets --open-only -o csv > reassigned.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			if from == "" || to == "" {
+				return fmt.Errorf("--from and --to are required")
+			}
+			types, err := parseObjectTypes(objects)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			fromID, err := resolveOwnerID(ctx, client, from)
+			if err != nil {
+				return err
+			}
+			toID, err := resolveOwnerID(ctx, client, to)
+			if err != nil {
+				return err
+			}
+			if fromID == toID {
+				return fmt.Errorf("--from and --to are the same owner (%s)", fromID)
+			}
+
+			var report []reassignment
+			reassigned, failed := 0, 0
+			for _, objectType := range types {
+				seen := make(map[string]bool)
+				for {
+					records, more, err := ownedRecords(ctx, client, objectType, fromID, openOnly)
+					if err != nil {
+						return err
+					}
+
+					// Records that failed in an earlier pass are still owned
+					// by --from and come back; they are reported once
+					records = unseenRecords(records, seen)
+					if len(records) == 0 {
+						break
+					}
+
+					entries := make([]reassignment, 0, len(records))
+					for _, r := range records {
+						entries = append(entries, reassignment{
+							ObjectType: string(objectType),
+							ID:         r.ID,
+							Name:       r.GetProperty(reassignable[objectType].nameProperty),
+							FromOwner:  fromID,
+							ToOwner:    toID,
+							Status:     "planned",
+						})
+					}
+
+					if !dryRun {
+						for start := 0; start < len(entries); start += api.MaxBatchSize {
+							end := min(start+api.MaxBatchSize, len(entries))
+							reassignBatch(ctx, client, objectType, toID, entries[start:end])
+						}
+					}
+
+					batchReassigned := 0
+					for _, e := range entries {
+						switch e.Status {
+						case "reassigned":
+							batchReassigned++
+						case "failed":
+							failed++
+						}
+					}
+					reassigned += batchReassigned
+					report = append(report, entries...)
+
+					// Reassigned records drop out of the search, so the next
+					// search starts on the ones left behind. Stop when a pass
+					// moves nothing to avoid retrying the same failures.
+					if !more {
+						break
+					}
+					if dryRun {
+						v.Warning("Only the first %d %s are listed; more are owned by %s", shared.MaxSearchResults, objectType, from)
+						break
+					}
+					if batchReassigned == 0 {
+						v.Warning("Stopped after a pass moved no %s; more are owned by %s", objectType, from)
+						break
+					}
+				}
+			}
+
+			if len(report) == 0 {
+				v.Info("%s owns no matching records", from)
+				return nil
+			}
+
+			headers := []string{"OBJECT", "ID", "NAME", "STATUS", "ERROR"}
+			rows := make([][]string, 0, len(report))
+			for _, e := range report {
+				rows = append(rows, []string{e.ObjectType, e.ID, e.Name, e.Status, e.Error})
+			}
+			if err := v.Render(headers, rows, report); err != nil {
+				return err
+			}
+
+			if dryRun {
+				v.Info("Dry run: %d record(s) would move from %s to %s", len(report), from, to)
+				return nil
+			}
+			v.Success("Reassigned %d record(s) from %s to %s", reassigned, from, to)
+			if failed > 0 {
+				return fmt.Errorf("%d record(s) could not be reassigned", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Owner ID or email of the current owner (required)")
+	cmd.Flags().StringVar(&to, "to", "", "Owner ID or email of the new owner (required)")
+	cmd.Flags().StringSliceVar(&objects, "objects", []string{"contacts", "companies", "deals", "tickets"}, "Object types to reassign (contacts, companies, deals, tickets, tasks)")
+	cmd.Flags().BoolVar(&openOnly, "open-only", false, "Only move open deals and tickets and incomplete tasks")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the records that would move without changing them")
+
+	return cmd
+}
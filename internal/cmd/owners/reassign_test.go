@@ -0,0 +1,120 @@
+package owners
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseObjectTypes(t *testing.T) {
+	types, err := parseObjectTypes([]string{"Contacts", " deals", "contacts", ""})
+	require.NoError(t, err)
+	assert.Equal(t, []api.ObjectType{api.ObjectTypeContacts, api.ObjectTypeDeals}, types)
+
+	_, err = parseObjectTypes([]string{"quotes"})
+	assert.ErrorContains(t, err, `cannot reassign "quotes"`)
+
+	_, err = parseObjectTypes(nil)
+	assert.ErrorContains(t, err, "at least one object type")
+}
+
+func TestResolveOwnerID_Archived(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("archived") == "true" {
+			w.Write([]byte(`{"results": [{"id": "777", "email": "old@example.com"}]}`))
+			return
+		}
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	id, err := resolveOwnerID(context.Background(), client, "old@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "777", id)
+
+	id, err = resolveOwnerID(context.Background(), client, "12345")
+	require.NoError(t, err)
+	assert.Equal(t, "12345", id)
+}
+
+func TestOwnedRecords_OpenOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+
+		var req api.SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []api.SearchFilter{
+			{PropertyName: "hubspot_owner_id", Operator: "EQ", Value: "777"},
+			{PropertyName: "hs_is_closed", Operator: "EQ", Value: "false"},
+		}, req.FilterGroups[0].Filters)
+
+		if req.After == "" {
+			w.Write([]byte(`{"results": [{"id": "1"}], "paging": {"next": {"after": "1"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "2"}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	records, more, err := ownedRecords(context.Background(), client, api.ObjectTypeDeals, "777", true)
+	require.NoError(t, err)
+	assert.False(t, more)
+	require.Len(t, records, 2)
+	assert.Equal(t, "2", records[1].ID)
+}
+
+func TestUnseenRecords(t *testing.T) {
+	seen := make(map[string]bool)
+
+	first := unseenRecords([]api.CRMObject{{ID: "1"}, {ID: "2"}}, seen)
+	assert.Len(t, first, 2)
+
+	// "2" failed in the first pass and is found again
+	second := unseenRecords([]api.CRMObject{{ID: "2"}, {ID: "3"}}, seen)
+	require.Len(t, second, 1)
+	assert.Equal(t, "3", second[0].ID)
+
+	assert.Empty(t, unseenRecords([]api.CRMObject{{ID: "2"}, {ID: "3"}}, seen))
+}
+
+func TestReassignBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/batch/update", r.URL.Path)
+
+		var body struct {
+			Inputs []api.BatchInput `json:"inputs"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Inputs, 3)
+		assert.Equal(t, "888", body.Inputs[0].Properties["hubspot_owner_id"])
+
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{
+			"status": "COMPLETE",
+			"results": [{"id": "1"}],
+			"errors": [{"status": "error", "message": "Object not found", "context": {"ids": ["2"]}}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	entries := []reassignment{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	reassignBatch(context.Background(), client, api.ObjectTypeDeals, "888", entries)
+
+	assert.Equal(t, "reassigned", entries[0].Status)
+	assert.Equal(t, "failed", entries[1].Status)
+	assert.Equal(t, "Object not found", entries[1].Error)
+	assert.Equal(t, "failed", entries[2].Status)
+}
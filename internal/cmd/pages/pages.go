@@ -9,6 +9,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // Register registers the pages command and subcommands
@@ -16,7 +17,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "pages",
 		Short: "Manage HubSpot CMS pages",
-		Long:  "Commands for listing, viewing, creating, updating, and deleting site and landing pages.",
+		Long:  "Commands for listing, viewing, creating, updating, deleting, and publishing site and landing pages.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
@@ -25,6 +26,9 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newCloneCmd(opts))
+	cmd.AddCommand(newPublishCmd(opts))
+	cmd.AddCommand(newScheduleCmd(opts))
+	cmd.AddCommand(newResetDraftCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -40,6 +44,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var pageType string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -62,10 +67,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 			}
 
 			pt := parsePageType(pageType)
-			result, err := client.ListPages(pt, api.ListOptions{
+			result, err := client.ListPages(cmd.Context(), pt, pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -103,6 +108,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringVar(&pageType, "type", "site", "Page type: site or landing")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -129,7 +136,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			}
 
 			pt := parsePageType(pageType)
-			page, err := client.GetPage(pt, id)
+			page, err := client.GetPage(cmd.Context(), pt, id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Page %s not found", id)
@@ -149,8 +156,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Domain", page.Domain},
 				{"Author", page.AuthorName},
 				{"Archived", formatBool(page.Archived)},
-				{"Created", page.CreatedAt},
-				{"Updated", page.UpdatedAt},
+				{"Created", v.Time(page.CreatedAt)},
+				{"Updated", v.Time(page.UpdatedAt)},
 			}
 
 			if page.PublishDate != "" {
@@ -202,7 +209,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 			}
 
 			pt := parsePageType(pageType)
-			page, err := client.CreatePage(pt, pageData)
+			page, err := client.CreatePage(cmd.Context(), pt, pageData)
 			if err != nil {
 				return err
 			}
@@ -256,7 +263,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 			}
 
 			pt := parsePageType(pageType)
-			page, err := client.UpdatePage(pt, id, updates)
+			page, err := client.UpdatePage(cmd.Context(), pt, id, updates)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Page %s not found", id)
@@ -299,7 +306,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 			}
 
 			pt := parsePageType(pageType)
-			err = client.DeletePage(pt, id)
+			err = client.DeletePage(cmd.Context(), pt, id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Page %s not found", id)
@@ -341,7 +348,7 @@ func newCloneCmd(opts *root.Options) *cobra.Command {
 			}
 
 			pt := parsePageType(pageType)
-			page, err := client.ClonePage(pt, id)
+			page, err := client.ClonePage(cmd.Context(), pt, id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Page %s not found", id)
@@ -0,0 +1,195 @@
+package pages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// scheduleLayouts are the wall-clock layouts --at accepts, read in the
+// portal time zone
+var scheduleLayouts = []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"}
+
+// parseScheduleTime reads a publish time: a duration from now such as 2h or
+// 3d, a date and time in loc such as 2025-07-01 09:00, or an RFC 3339 time.
+// The time must be in the future.
+func parseScheduleTime(s string, now time.Time, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	t, ok := scheduleTime(s, now, loc)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid --at %q: use a time from now such as 2h or 3d, a time such as \"2025-07-01 09:00\", or an RFC 3339 time", s)
+	}
+	if !t.After(now) {
+		return time.Time{}, fmt.Errorf("--at %q is in the past", s)
+	}
+	return t, nil
+}
+
+func scheduleTime(s string, now time.Time, loc *time.Location) (time.Time, bool) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil {
+			return now.AddDate(0, 0, n), true
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(d), true
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true
+	}
+	for _, layout := range scheduleLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func newPublishCmd(opts *root.Options) *cobra.Command {
+	var pageType string
+
+	cmd := &cobra.Command{
+		Use:   "publish <id>",
+		Short: "Publish a page's draft",
+		Long:  "Push a page's draft live, replacing the published version.",
+		Example: `  # Publish a site page's draft
+  hspt pages publish 12345
+
+  # Publish a landing page's draft
+  hspt pages publish 12345 --type landing`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.PushPageDraftLive(cmd.Context(), parsePageType(pageType), id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Page %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to publish page: %w", err)
+			}
+
+			v.Success("Page %s published", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pageType, "type", "site", "Page type: site or landing")
+
+	return cmd
+}
+
+func newScheduleCmd(opts *root.Options) *cobra.Command {
+	var pageType, at string
+
+	cmd := &cobra.Command{
+		Use:   "schedule <id>",
+		Short: "Schedule a page to publish",
+		Long: `Schedule a page to be published at a later time.
+
+--at takes a time from now such as 2h or 3d, a date and time in the portal
+time zone such as "2025-07-01 09:00", or an RFC 3339 time.`,
+		Example: `  # Publish tomorrow morning, portal time
+  hspt pages schedule 12345 --at "2025-07-01 09:00"
+
+  # Publish a landing page in two hours
+  hspt pages schedule 12345 --type landing --at 2h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if at == "" {
+				return fmt.Errorf("--at is required")
+			}
+			loc := v.Location
+			if loc == nil {
+				loc = time.Local
+			}
+			when, err := parseScheduleTime(at, time.Now(), loc)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.SchedulePage(cmd.Context(), parsePageType(pageType), id, when); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Page %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to schedule page: %w", err)
+			}
+
+			v.Success("Page %s scheduled to publish at %s", id, v.Time(when.Format(time.RFC3339)))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pageType, "type", "site", "Page type: site or landing")
+	cmd.Flags().StringVar(&at, "at", "", "When to publish (required)")
+
+	return cmd
+}
+
+func newResetDraftCmd(opts *root.Options) *cobra.Command {
+	var pageType string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "reset-draft <id>",
+		Short: "Discard a page's draft changes",
+		Long:  "Reset a page's draft to the published version, discarding unpublished edits.",
+		Example: `  # Throw away the draft of a site page
+  hspt pages reset-draft 12345 --force
+
+  # Throw away the draft of a landing page
+  hspt pages reset-draft 12345 --type landing --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !force {
+				v.Warning("Resetting the draft of page %s discards its unpublished edits. Use --force to confirm.", id)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.ResetPageDraft(cmd.Context(), parsePageType(pageType), id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Page %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to reset page draft: %w", err)
+			}
+
+			v.Success("Draft of page %s reset to the published version", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pageType, "type", "site", "Page type: site or landing")
+	cmd.Flags().BoolVar(&force, "force", false, "Discard the draft without confirmation")
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package pages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheduleTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2h", now.Add(2 * time.Hour)},
+		{"90m", now.Add(90 * time.Minute)},
+		{"3d", now.AddDate(0, 0, 3)},
+		{"2025-07-01 09:00", time.Date(2025, 7, 1, 7, 0, 0, 0, time.UTC)},
+		{"2025-07-01T09:00", time.Date(2025, 7, 1, 7, 0, 0, 0, time.UTC)},
+		{"2025-07-02", time.Date(2025, 7, 1, 22, 0, 0, 0, time.UTC)},
+		{"2025-07-01T09:00:00Z", time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseScheduleTime(tt.in, now, berlin)
+		require.NoError(t, err, tt.in)
+		assert.True(t, tt.want.Equal(got), "%s: got %s, want %s", tt.in, got, tt.want)
+	}
+
+	_, err = parseScheduleTime("2025-06-01", now, berlin)
+	assert.ErrorContains(t, err, "in the past")
+
+	_, err = parseScheduleTime("-2h", now, berlin)
+	assert.ErrorContains(t, err, "in the past")
+
+	_, err = parseScheduleTime("next tuesday", now, berlin)
+	assert.ErrorContains(t, err, "invalid --at")
+}
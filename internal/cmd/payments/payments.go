@@ -0,0 +1,72 @@
+package payments
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// DefaultProperties are the default properties to fetch for payments
+var DefaultProperties = []string{"hs_reference_number", "hs_latest_status", "hs_currency_code", "hs_initial_amount", "hs_payment_method_type", "hs_customer_email", "hs_initiated_date"}
+
+var config = shared.ObjectCmdConfig{
+	ObjectType: api.ObjectTypePayments,
+	Noun:       "payment",
+	ListExample: `  # List first 10 payments
+  hspt payments list
+
+  # Every payment, for reconciliation
+  hspt payments list --all -o csv > payments.csv
+
+  # List with custom properties
+  hspt payments list --properties hs_reference_number,hs_latest_status,hs_refunds_amount`,
+	GetExample: `  # Get payment by ID
+  hspt payments get 12345
+
+  # How did its status change?
+  hspt payments get 12345 --with-history hs_latest_status`,
+	DefaultProperties: DefaultProperties,
+	Headers:           []string{"ID", "REFERENCE", "STATUS", "CURRENCY", "AMOUNT", "METHOD", "CUSTOMER", "INITIATED"},
+	Row: func(v *view.View, obj api.CRMObject) []string {
+		currency := obj.GetProperty("hs_currency_code")
+		return []string{
+			obj.ID,
+			obj.GetProperty("hs_reference_number"),
+			obj.GetProperty("hs_latest_status"),
+			currency,
+			v.MoneyIn(obj.GetProperty("hs_initial_amount"), currency),
+			obj.GetProperty("hs_payment_method_type"),
+			obj.GetProperty("hs_customer_email"),
+			v.Time(obj.GetProperty("hs_initiated_date")),
+		}
+	},
+	Details: func(v *view.View, obj api.CRMObject) [][]string {
+		currency := obj.GetProperty("hs_currency_code")
+		return [][]string{
+			{"Reference", obj.GetProperty("hs_reference_number")},
+			{"Status", obj.GetProperty("hs_latest_status")},
+			{"Currency", currency},
+			{"Amount", v.MoneyIn(obj.GetProperty("hs_initial_amount"), currency)},
+			{"Payment Method", obj.GetProperty("hs_payment_method_type")},
+			{"Customer Email", obj.GetProperty("hs_customer_email")},
+			{"Initiated", v.Time(obj.GetProperty("hs_initiated_date"))},
+		}
+	},
+}
+
+// Register registers the payments command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "payments",
+		Short: "View HubSpot payments",
+		Long:  "Commands for listing and viewing payments collected through HubSpot commerce.",
+	}
+
+	cmd.AddCommand(shared.NewListCmd(opts, config))
+	cmd.AddCommand(shared.NewGetCmd(opts, config))
+
+	parent.AddCommand(cmd)
+}
@@ -1,12 +1,15 @@
 package pipelines
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // Register registers the pipelines command and subcommands
@@ -14,12 +17,16 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "pipelines",
 		Short: "Manage HubSpot pipelines",
-		Long:  "Commands for listing and viewing pipelines and their stages.",
+		Long:  "Commands for listing, viewing, and managing pipelines and their stages.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newUpdateCmd(opts))
+	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newStagesCmd(opts))
+	cmd.AddCommand(newUsageCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -48,7 +55,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListPipelines(api.ObjectType(objectType))
+			result, err := client.ListPipelines(cmd.Context(), api.ObjectType(objectType))
 			if err != nil {
 				return err
 			}
@@ -102,7 +109,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			pipeline, err := client.GetPipeline(api.ObjectType(objectType), pipelineID)
+			pipeline, err := client.GetPipeline(cmd.Context(), api.ObjectType(objectType), pipelineID)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Pipeline %s not found for %s", pipelineID, objectType)
@@ -111,26 +118,244 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			headers := []string{"PROPERTY", "VALUE"}
-			rows := [][]string{
-				{"ID", pipeline.ID},
-				{"Label", pipeline.Label},
-				{"Display Order", fmt.Sprintf("%d", pipeline.DisplayOrder)},
-				{"Archived", formatBool(pipeline.Archived)},
-				{"Created", pipeline.CreatedAt},
-				{"Updated", pipeline.UpdatedAt},
+			return v.Render([]string{"PROPERTY", "VALUE"}, pipelineRows(v, pipeline), pipeline)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+
+	return cmd
+}
+
+// pipelineRows returns the property/value rows describing a pipeline
+func pipelineRows(v *view.View, pipeline *api.Pipeline) [][]string {
+	rows := [][]string{
+		{"ID", pipeline.ID},
+		{"Label", pipeline.Label},
+		{"Display Order", fmt.Sprintf("%d", pipeline.DisplayOrder)},
+		{"Archived", formatBool(pipeline.Archived)},
+		{"Created", v.Time(pipeline.CreatedAt)},
+		{"Updated", v.Time(pipeline.UpdatedAt)},
+	}
+
+	if len(pipeline.Stages) > 0 {
+		rows = append(rows, []string{"Stages", fmt.Sprintf("%d stages", len(pipeline.Stages))})
+	}
+
+	return rows
+}
+
+// readPipelineFile reads a pipeline definition. Output of
+// "hspt pipelines get -o json" can be used directly; IDs and timestamps are
+// ignored.
+func readPipelineFile(path string) (api.PipelineInput, error) {
+	var input api.PipelineInput
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return input, fmt.Errorf("failed to read file: %w", err)
+	}
+	if err := json.Unmarshal(data, &input); err != nil {
+		return input, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return input, nil
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var objectType, label, file string
+	var displayOrder int
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a pipeline",
+		Long: `Create a pipeline from a JSON definition file containing its label and
+stages. Deal stages need a "probability" and ticket stages a "ticketState"
+(OPEN or CLOSED) in their metadata. --label and --display-order override
+values from the file.`,
+		Example: `  # Create a deal pipeline from a definition kept in git
+  hspt pipelines create --object-type deals --file pipelines/renewals.json
+
+  # Copy an existing pipeline under a new name
+  hspt pipelines get --object-type deals --id default -o json > sales.json
+  hspt pipelines create --object-type deals --file sales.json --label "Sales (EMEA)"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || file == "" {
+				return fmt.Errorf("--object-type and --file are required")
+			}
+
+			input, err := readPipelineFile(file)
+			if err != nil {
+				return err
+			}
+			if label != "" {
+				input.Label = label
+			}
+			if cmd.Flags().Changed("display-order") {
+				input.DisplayOrder = displayOrder
+			}
+			if input.Label == "" {
+				return fmt.Errorf("pipeline label is required (in --label or --file)")
+			}
+			if len(input.Stages) == 0 {
+				return fmt.Errorf("a pipeline needs at least one stage")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			pipeline, err := client.CreatePipeline(cmd.Context(), api.ObjectType(objectType), input)
+			if err != nil {
+				return err
+			}
+
+			v.Success("Pipeline %s created with ID %s", pipeline.Label, pipeline.ID)
+
+			return v.Render([]string{"PROPERTY", "VALUE"}, pipelineRows(v, pipeline), pipeline)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&file, "file", "", "JSON file containing the pipeline definition")
+	cmd.Flags().StringVar(&label, "label", "", "Pipeline label")
+	cmd.Flags().IntVar(&displayOrder, "display-order", 0, "Position of the pipeline in the HubSpot UI")
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options) *cobra.Command {
+	var objectType, pipelineID, label, file string
+	var displayOrder int
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a pipeline",
+		Long: `Update a pipeline's label or display order.
+
+With --file the whole pipeline, including all of its stages, is replaced by
+the definition in the file. Stages missing from the file are removed, and
+HubSpot rejects the change while records are still in them.`,
+		Example: `  # Rename a pipeline
+  hspt pipelines update --object-type deals --id 123 --label "Renewals"
+
+  # Apply the definition kept in git
+  hspt pipelines update --object-type deals --id 123 --file pipelines/renewals.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || pipelineID == "" {
+				return fmt.Errorf("--object-type and --id are required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
 			}
 
-			if len(pipeline.Stages) > 0 {
-				rows = append(rows, []string{"Stages", fmt.Sprintf("%d stages", len(pipeline.Stages))})
+			var pipeline *api.Pipeline
+			if file != "" {
+				input, err := readPipelineFile(file)
+				if err != nil {
+					return err
+				}
+				if label != "" {
+					input.Label = label
+				}
+				if cmd.Flags().Changed("display-order") {
+					input.DisplayOrder = displayOrder
+				}
+				pipeline, err = client.ReplacePipeline(cmd.Context(), api.ObjectType(objectType), pipelineID, input)
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("Pipeline %s not found for %s", pipelineID, objectType)
+						return nil
+					}
+					return err
+				}
+			} else {
+				updates := make(map[string]interface{})
+				if label != "" {
+					updates["label"] = label
+				}
+				if cmd.Flags().Changed("display-order") {
+					updates["displayOrder"] = displayOrder
+				}
+				if len(updates) == 0 {
+					return fmt.Errorf("nothing to update: use --label, --display-order, or --file")
+				}
+				pipeline, err = client.UpdatePipeline(cmd.Context(), api.ObjectType(objectType), pipelineID, updates)
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("Pipeline %s not found for %s", pipelineID, objectType)
+						return nil
+					}
+					return err
+				}
 			}
 
-			return v.Render(headers, rows, pipeline)
+			v.Success("Pipeline %s updated", pipeline.ID)
+
+			return v.Render([]string{"PROPERTY", "VALUE"}, pipelineRows(v, pipeline), pipeline)
 		},
 	}
 
 	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
 	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+	cmd.Flags().StringVar(&label, "label", "", "New pipeline label")
+	cmd.Flags().IntVar(&displayOrder, "display-order", 0, "New position of the pipeline in the HubSpot UI")
+	cmd.Flags().StringVar(&file, "file", "", "JSON file with a definition that replaces the pipeline and its stages")
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	var objectType, pipelineID string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a pipeline",
+		Long:  "Archive a pipeline. HubSpot rejects the request while records are still in the pipeline.",
+		Example: `  # Delete a pipeline
+  hspt pipelines delete --object-type deals --id 123 --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || pipelineID == "" {
+				return fmt.Errorf("--object-type and --id are required")
+			}
+
+			if !force {
+				v.Warning("This will archive pipeline %s for %s. Use --force to confirm.", pipelineID, objectType)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeletePipeline(cmd.Context(), api.ObjectType(objectType), pipelineID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Pipeline %s not found for %s", pipelineID, objectType)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Pipeline %s archived for %s", pipelineID, objectType)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
 
 	return cmd
 }
@@ -140,8 +365,9 @@ func newStagesCmd(opts *root.Options) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "stages",
-		Short: "List pipeline stages",
-		Long:  "List all stages for a specific pipeline.",
+		Short: "List and manage pipeline stages",
+		Long: `List all stages for a specific pipeline. Subcommands add, update, delete,
+and reorder stages.`,
 		Example: `  # List stages for default deal pipeline
   hspt pipelines stages --object-type deals --id default
 
@@ -159,7 +385,7 @@ func newStagesCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			stages, err := client.GetPipelineStages(api.ObjectType(objectType), pipelineID)
+			stages, err := client.GetPipelineStages(cmd.Context(), api.ObjectType(objectType), pipelineID)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Pipeline %s not found for %s", pipelineID, objectType)
@@ -191,6 +417,11 @@ func newStagesCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
 	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
 
+	cmd.AddCommand(newStageAddCmd(opts))
+	cmd.AddCommand(newStageUpdateCmd(opts))
+	cmd.AddCommand(newStageDeleteCmd(opts))
+	cmd.AddCommand(newStageReorderCmd(opts))
+
 	return cmd
 }
 
@@ -0,0 +1,309 @@
+package pipelines
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// stageMetadata builds stage metadata from the --probability and
+// --ticket-state flags, leaving out the ones that were not given
+func stageMetadata(probability, ticketState string) map[string]string {
+	metadata := make(map[string]string)
+	if probability != "" {
+		metadata["probability"] = probability
+	}
+	if ticketState != "" {
+		metadata["ticketState"] = strings.ToUpper(ticketState)
+	}
+	return metadata
+}
+
+// reorderStages assigns display orders so the stages named in order come
+// first, in that order, followed by the remaining stages in their current
+// order. Only stages whose display order changes are returned.
+func reorderStages(stages []api.PipelineStage, order []string) ([]api.PipelineStage, error) {
+	byID := make(map[string]api.PipelineStage, len(stages))
+	for _, s := range stages {
+		byID[s.ID] = s
+	}
+
+	placed := make(map[string]bool, len(order))
+	sorted := make([]api.PipelineStage, 0, len(stages))
+	for _, id := range order {
+		s, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("stage %s is not in this pipeline", id)
+		}
+		if placed[id] {
+			return nil, fmt.Errorf("stage %s is listed more than once", id)
+		}
+		placed[id] = true
+		sorted = append(sorted, s)
+	}
+
+	rest := make([]api.PipelineStage, 0, len(stages)-len(sorted))
+	for _, s := range stages {
+		if !placed[s.ID] {
+			rest = append(rest, s)
+		}
+	}
+	sort.SliceStable(rest, func(i, j int) bool { return rest[i].DisplayOrder < rest[j].DisplayOrder })
+	sorted = append(sorted, rest...)
+
+	var changed []api.PipelineStage
+	for i, s := range sorted {
+		if s.DisplayOrder != i {
+			s.DisplayOrder = i
+			changed = append(changed, s)
+		}
+	}
+	return changed, nil
+}
+
+func newStageAddCmd(opts *root.Options) *cobra.Command {
+	var objectType, pipelineID, label, probability, ticketState string
+	var displayOrder int
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a stage to a pipeline",
+		Long: `Add a stage to a pipeline. Deal stages need --probability (0.0 to 1.0) and
+ticket stages need --ticket-state (OPEN or CLOSED).`,
+		Example: `  # Add a deal stage
+  hspt pipelines stages add --object-type deals --id default --label "Negotiation" --probability 0.6 --display-order 3
+
+  # Add a closing ticket stage
+  hspt pipelines stages add --object-type tickets --id 0 --label "Resolved" --ticket-state CLOSED`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || pipelineID == "" || label == "" {
+				return fmt.Errorf("--object-type, --id, and --label are required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			stage, err := client.CreatePipelineStage(cmd.Context(), api.ObjectType(objectType), pipelineID, api.PipelineStageInput{
+				Label:        label,
+				DisplayOrder: displayOrder,
+				Metadata:     stageMetadata(probability, ticketState),
+			})
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Pipeline %s not found for %s", pipelineID, objectType)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Stage %s added with ID %s", stage.Label, stage.ID)
+
+			headers := []string{"ID", "LABEL", "DISPLAY ORDER"}
+			rows := [][]string{{stage.ID, stage.Label, fmt.Sprintf("%d", stage.DisplayOrder)}}
+
+			return v.Render(headers, rows, stage)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+	cmd.Flags().StringVar(&label, "label", "", "Stage label")
+	cmd.Flags().IntVar(&displayOrder, "display-order", 0, "Position of the stage in the pipeline")
+	cmd.Flags().StringVar(&probability, "probability", "", "Deal win probability for the stage, 0.0 to 1.0 (deals)")
+	cmd.Flags().StringVar(&ticketState, "ticket-state", "", "Ticket state for the stage, OPEN or CLOSED (tickets)")
+
+	return cmd
+}
+
+func newStageUpdateCmd(opts *root.Options) *cobra.Command {
+	var objectType, pipelineID, stageID, label, probability, ticketState string
+	var displayOrder int
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a pipeline stage",
+		Long:  "Update a stage's label, display order, probability, or ticket state. Only the given fields change.",
+		Example: `  # Rename a stage
+  hspt pipelines stages update --object-type deals --id default --stage-id appointmentscheduled --label "Meeting booked"
+
+  # Change a deal stage's probability
+  hspt pipelines stages update --object-type deals --id default --stage-id qualifiedtobuy --probability 0.3`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || pipelineID == "" || stageID == "" {
+				return fmt.Errorf("--object-type, --id, and --stage-id are required")
+			}
+
+			updates := make(map[string]interface{})
+			if label != "" {
+				updates["label"] = label
+			}
+			if cmd.Flags().Changed("display-order") {
+				updates["displayOrder"] = displayOrder
+			}
+			if metadata := stageMetadata(probability, ticketState); len(metadata) > 0 {
+				updates["metadata"] = metadata
+			}
+			if len(updates) == 0 {
+				return fmt.Errorf("nothing to update: use --label, --display-order, --probability, or --ticket-state")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			stage, err := client.UpdatePipelineStage(cmd.Context(), api.ObjectType(objectType), pipelineID, stageID, updates)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Stage %s not found in pipeline %s", stageID, pipelineID)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Stage %s updated", stage.ID)
+
+			headers := []string{"ID", "LABEL", "DISPLAY ORDER"}
+			rows := [][]string{{stage.ID, stage.Label, fmt.Sprintf("%d", stage.DisplayOrder)}}
+
+			return v.Render(headers, rows, stage)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+	cmd.Flags().StringVar(&stageID, "stage-id", "", "Stage ID")
+	cmd.Flags().StringVar(&label, "label", "", "New stage label")
+	cmd.Flags().IntVar(&displayOrder, "display-order", 0, "New position of the stage in the pipeline")
+	cmd.Flags().StringVar(&probability, "probability", "", "New deal win probability, 0.0 to 1.0 (deals)")
+	cmd.Flags().StringVar(&ticketState, "ticket-state", "", "New ticket state, OPEN or CLOSED (tickets)")
+
+	return cmd
+}
+
+func newStageDeleteCmd(opts *root.Options) *cobra.Command {
+	var objectType, pipelineID, stageID string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a pipeline stage",
+		Long:  "Archive a stage. Move records out of the stage first; HubSpot rejects the request while records are still in it.",
+		Example: `  # Delete a stage
+  hspt pipelines stages delete --object-type deals --id default --stage-id 98765 --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || pipelineID == "" || stageID == "" {
+				return fmt.Errorf("--object-type, --id, and --stage-id are required")
+			}
+
+			if !force {
+				v.Warning("This will archive stage %s of pipeline %s. Use --force to confirm.", stageID, pipelineID)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeletePipelineStage(cmd.Context(), api.ObjectType(objectType), pipelineID, stageID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Stage %s not found in pipeline %s", stageID, pipelineID)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Stage %s archived from pipeline %s", stageID, pipelineID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+	cmd.Flags().StringVar(&stageID, "stage-id", "", "Stage ID")
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func newStageReorderCmd(opts *root.Options) *cobra.Command {
+	var objectType, pipelineID string
+	var order []string
+
+	cmd := &cobra.Command{
+		Use:   "reorder",
+		Short: "Reorder pipeline stages",
+		Long: `Set the order of a pipeline's stages. The stages given with --order come
+first, in that order; stages left out keep their relative order after them.
+Only stages whose position changes are updated.`,
+		Example: `  # Put two stages first
+  hspt pipelines stages reorder --object-type deals --id default --order qualifiedtobuy,appointmentscheduled`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || pipelineID == "" || len(order) == 0 {
+				return fmt.Errorf("--object-type, --id, and --order are required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			stages, err := client.GetPipelineStages(cmd.Context(), api.ObjectType(objectType), pipelineID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Pipeline %s not found for %s", pipelineID, objectType)
+					return nil
+				}
+				return err
+			}
+
+			changed, err := reorderStages(stages, order)
+			if err != nil {
+				return err
+			}
+			if len(changed) == 0 {
+				v.Info("Stages are already in that order")
+				return nil
+			}
+
+			for _, stage := range changed {
+				updates := map[string]interface{}{"displayOrder": stage.DisplayOrder}
+				if _, err := client.UpdatePipelineStage(cmd.Context(), api.ObjectType(objectType), pipelineID, stage.ID, updates); err != nil {
+					return fmt.Errorf("failed to move stage %s: %w", stage.ID, err)
+				}
+			}
+
+			v.Success("Reordered %d stage(s) in pipeline %s", len(changed), pipelineID)
+
+			headers := []string{"ID", "LABEL", "DISPLAY ORDER"}
+			rows := make([][]string, 0, len(changed))
+			for _, stage := range changed {
+				rows = append(rows, []string{stage.ID, stage.Label, fmt.Sprintf("%d", stage.DisplayOrder)})
+			}
+
+			return v.Render(headers, rows, changed)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (deals or tickets)")
+	cmd.Flags().StringVar(&pipelineID, "id", "", "Pipeline ID")
+	cmd.Flags().StringSliceVar(&order, "order", nil, "Stage IDs in their new order (comma-separated)")
+
+	return cmd
+}
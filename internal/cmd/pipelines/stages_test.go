@@ -0,0 +1,51 @@
+package pipelines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestReorderStages(t *testing.T) {
+	stages := []api.PipelineStage{
+		{ID: "a", DisplayOrder: 0},
+		{ID: "b", DisplayOrder: 1},
+		{ID: "c", DisplayOrder: 2},
+		{ID: "d", DisplayOrder: 3},
+	}
+
+	t.Run("listed stages move first, the rest keep their order", func(t *testing.T) {
+		changed, err := reorderStages(stages, []string{"c"})
+		require.NoError(t, err)
+		assert.Equal(t, []api.PipelineStage{
+			{ID: "c", DisplayOrder: 0},
+			{ID: "a", DisplayOrder: 1},
+			{ID: "b", DisplayOrder: 2},
+		}, changed)
+	})
+
+	t.Run("current order changes nothing", func(t *testing.T) {
+		changed, err := reorderStages(stages, []string{"a", "b"})
+		require.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("unknown stage", func(t *testing.T) {
+		_, err := reorderStages(stages, []string{"x"})
+		assert.ErrorContains(t, err, "stage x is not in this pipeline")
+	})
+
+	t.Run("duplicate stage", func(t *testing.T) {
+		_, err := reorderStages(stages, []string{"a", "a"})
+		assert.ErrorContains(t, err, "listed more than once")
+	})
+}
+
+func TestStageMetadata(t *testing.T) {
+	assert.Empty(t, stageMetadata("", ""))
+	assert.Equal(t, map[string]string{"probability": "0.4"}, stageMetadata("0.4", ""))
+	assert.Equal(t, map[string]string{"ticketState": "CLOSED"}, stageMetadata("", "closed"))
+}
@@ -0,0 +1,156 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// stageUsage is the number of records in one pipeline stage
+type stageUsage struct {
+	ID      string `json:"id"`
+	Label   string `json:"label"`
+	Records int    `json:"records"`
+	Unused  bool   `json:"unused"`
+}
+
+// usageReport is the record count of every stage of a pipeline
+type usageReport struct {
+	ObjectType string       `json:"objectType"`
+	PipelineID string       `json:"pipelineId"`
+	Label      string       `json:"label"`
+	Records    int          `json:"records"`
+	Stages     []stageUsage `json:"stages"`
+	// OtherStages counts records in the pipeline whose stage is not one of
+	// its current stages
+	OtherStages int `json:"otherStages"`
+}
+
+// unusedStages returns the stages that hold no records
+func (u usageReport) unusedStages() []stageUsage {
+	var unused []stageUsage
+	for _, s := range u.Stages {
+		if s.Unused {
+			unused = append(unused, s)
+		}
+	}
+	return unused
+}
+
+// stageProperties returns the properties holding a record's pipeline and
+// stage. Deals use their own names; tickets and other objects share
+// hs_pipeline and hs_pipeline_stage.
+func stageProperties(objectType api.ObjectType) (pipeline, stage string) {
+	if objectType == api.ObjectTypeDeals {
+		return "pipeline", "dealstage"
+	}
+	return "hs_pipeline", "hs_pipeline_stage"
+}
+
+// countStageUsage counts the records in each stage of a pipeline with one
+// search per stage
+func countStageUsage(ctx context.Context, client *api.Client, objectType api.ObjectType, pipeline *api.Pipeline) (*usageReport, error) {
+	pipelineProp, stageProp := stageProperties(objectType)
+	inPipeline := api.SearchFilter{PropertyName: pipelineProp, Operator: "EQ", Value: pipeline.ID}
+
+	total, err := client.CountObjects(ctx, objectType, []api.SearchFilter{inPipeline})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s in pipeline: %w", objectType, err)
+	}
+
+	usage := &usageReport{
+		ObjectType: string(objectType),
+		PipelineID: pipeline.ID,
+		Label:      pipeline.Label,
+		Records:    total,
+		Stages:     make([]stageUsage, 0, len(pipeline.Stages)),
+	}
+
+	staged := 0
+	for _, stage := range pipeline.Stages {
+		n, err := client.CountObjects(ctx, objectType, []api.SearchFilter{
+			inPipeline,
+			{PropertyName: stageProp, Operator: "EQ", Value: stage.ID},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s in stage %s: %w", objectType, stage.Label, err)
+		}
+		staged += n
+		usage.Stages = append(usage.Stages, stageUsage{ID: stage.ID, Label: stage.Label, Records: n, Unused: n == 0})
+	}
+
+	if total > staged {
+		usage.OtherStages = total - staged
+	}
+	return usage, nil
+}
+
+func newUsageCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "usage <objectType> <pipelineId>",
+		Short: "Count the records in each stage of a pipeline",
+		Long: `Count the records in each stage of a pipeline and flag the stages no record
+uses, which are candidates for removal before restructuring the pipeline.
+
+Archived records are not counted. Each stage costs one search request.`,
+		Example: `  # Audit the default deal pipeline
+  hspt pipelines usage deals default
+
+  # List just the unused stages of a ticket pipeline
+  hspt pipelines usage tickets 12345 -o json | jq '.stages[] | select(.unused)'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			objectType, pipelineID := api.ObjectType(args[0]), args[1]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			pipeline, err := client.GetPipeline(cmd.Context(), objectType, pipelineID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Pipeline %s not found for %s", pipelineID, objectType)
+					return nil
+				}
+				return err
+			}
+
+			usage, err := countStageUsage(cmd.Context(), client, objectType, pipeline)
+			if err != nil {
+				return err
+			}
+
+			headers := []string{"ID", "LABEL", "RECORDS", "UNUSED"}
+			rows := make([][]string, 0, len(usage.Stages))
+			for _, s := range usage.Stages {
+				rows = append(rows, []string{s.ID, s.Label, strconv.Itoa(s.Records), formatBool(s.Unused)})
+			}
+			if err := v.Render(headers, rows, usage); err != nil {
+				return err
+			}
+
+			if usage.OtherStages > 0 {
+				v.Warning("%d record(s) are in stages no longer in this pipeline", usage.OtherStages)
+			}
+			unused := usage.unusedStages()
+			if len(unused) == 0 {
+				v.Info("All %d stages are in use (%d records)", len(usage.Stages), usage.Records)
+				return nil
+			}
+			labels := make([]string, 0, len(unused))
+			for _, s := range unused {
+				labels = append(labels, s.Label)
+			}
+			v.Info("%d of %d stages have no records and could be removed: %s", len(unused), len(usage.Stages), strings.Join(labels, ", "))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package pipelines
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestStageProperties(t *testing.T) {
+	pipeline, stage := stageProperties(api.ObjectTypeDeals)
+	assert.Equal(t, "pipeline", pipeline)
+	assert.Equal(t, "dealstage", stage)
+
+	pipeline, stage = stageProperties(api.ObjectTypeTickets)
+	assert.Equal(t, "hs_pipeline", pipeline)
+	assert.Equal(t, "hs_pipeline_stage", stage)
+}
+
+func TestCountStageUsage(t *testing.T) {
+	counts := map[string]int{"": 12, "appointmentscheduled": 7, "closedwon": 3, "closedlost": 0}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+
+		var req api.SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		filters := req.FilterGroups[0].Filters
+		assert.Equal(t, api.SearchFilter{PropertyName: "pipeline", Operator: "EQ", Value: "default"}, filters[0])
+
+		stage := ""
+		if len(filters) > 1 {
+			assert.Equal(t, "dealstage", filters[1].PropertyName)
+			stage = filters[1].Value
+		}
+		fmt.Fprintf(w, `{"total": %d, "results": []}`, counts[stage])
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	pipeline := &api.Pipeline{ID: "default", Label: "Sales", Stages: []api.PipelineStage{
+		{ID: "appointmentscheduled", Label: "Appointment Scheduled"},
+		{ID: "closedwon", Label: "Closed Won"},
+		{ID: "closedlost", Label: "Closed Lost"},
+	}}
+
+	usage, err := countStageUsage(context.Background(), client, api.ObjectTypeDeals, pipeline)
+	require.NoError(t, err)
+
+	assert.Equal(t, 12, usage.Records)
+	assert.Equal(t, 2, usage.OtherStages)
+	require.Len(t, usage.Stages, 3)
+	assert.Equal(t, 7, usage.Stages[0].Records)
+	assert.False(t, usage.Stages[0].Unused)
+	assert.True(t, usage.Stages[2].Unused)
+
+	unused := usage.unusedStages()
+	require.Len(t, unused, 1)
+	assert.Equal(t, "closedlost", unused[0].ID)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // DefaultProperties are the default properties to fetch for products
@@ -34,6 +35,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -59,11 +61,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeProducts, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeProducts, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -79,7 +81,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				rows = append(rows, []string{
 					obj.ID,
 					obj.GetProperty("name"),
-					obj.GetProperty("price"),
+					v.Money(obj.GetProperty("price")),
 					obj.GetProperty("hs_sku"),
 					truncate(obj.GetProperty("description"), 40),
 				})
@@ -101,11 +103,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -130,7 +135,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeProducts, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeProducts, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Product %s not found", id)
@@ -143,18 +148,23 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			rows := [][]string{
 				{"ID", obj.ID},
 				{"Name", obj.GetProperty("name")},
-				{"Price", obj.GetProperty("price")},
+				{"Price", v.Money(obj.GetProperty("price"))},
 				{"SKU", obj.GetProperty("hs_sku")},
 				{"Description", obj.GetProperty("description")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
@@ -206,7 +216,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeProducts, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeProducts, properties)
 			if err != nil {
 				return err
 			}
@@ -217,7 +227,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 			rows := [][]string{
 				{"ID", obj.ID},
 				{"Name", obj.GetProperty("name")},
-				{"Price", obj.GetProperty("price")},
+				{"Price", v.Money(obj.GetProperty("price"))},
 				{"SKU", obj.GetProperty("hs_sku")},
 			}
 
@@ -283,7 +293,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeProducts, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeProducts, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Product %s not found", id)
@@ -333,7 +343,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeProducts, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeProducts, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Product %s not found", id)
 					return nil
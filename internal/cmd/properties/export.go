@@ -0,0 +1,244 @@
+package properties
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// dictionaryGroup is a property group and its properties, in the order the
+// data dictionary lists them
+type dictionaryGroup struct {
+	Name       string
+	Label      string
+	Properties []api.Property
+}
+
+// groupProperties sorts properties into their groups. Groups follow their
+// HubSpot display order, then label; properties are sorted by label. Groups
+// without properties are left out, and properties of an unknown group get a
+// group named after it.
+func groupProperties(props []api.Property, groups []api.PropertyGroup) []dictionaryGroup {
+	byName := make(map[string]*dictionaryGroup)
+	order := make(map[string]int)
+	for _, g := range groups {
+		byName[g.Name] = &dictionaryGroup{Name: g.Name, Label: g.Label}
+		order[g.Name] = g.DisplayOrder
+	}
+
+	for _, p := range props {
+		g, ok := byName[p.GroupName]
+		if !ok {
+			g = &dictionaryGroup{Name: p.GroupName, Label: p.GroupName}
+			byName[p.GroupName] = g
+			order[p.GroupName] = len(groups) + 1
+		}
+		g.Properties = append(g.Properties, p)
+	}
+
+	result := make([]dictionaryGroup, 0, len(byName))
+	for _, g := range byName {
+		if len(g.Properties) == 0 {
+			continue
+		}
+		sort.SliceStable(g.Properties, func(i, j int) bool {
+			return strings.ToLower(g.Properties[i].Label) < strings.ToLower(g.Properties[j].Label)
+		})
+		result = append(result, *g)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if order[result[i].Name] != order[result[j].Name] {
+			return order[result[i].Name] < order[result[j].Name]
+		}
+		return strings.ToLower(result[i].Label) < strings.ToLower(result[j].Label)
+	})
+	return result
+}
+
+// optionList describes an enumeration property's visible options as
+// "Label (value)" entries
+func optionList(p api.Property) []string {
+	var options []string
+	for _, o := range p.Options {
+		if o.Hidden {
+			continue
+		}
+		if o.Label == o.Value || o.Label == "" {
+			options = append(options, o.Value)
+		} else {
+			options = append(options, fmt.Sprintf("%s (%s)", o.Label, o.Value))
+		}
+	}
+	return options
+}
+
+// propertyType describes a property's type with its field type when that
+// adds anything, e.g. "enumeration (select)"
+func propertyType(p api.Property) string {
+	if p.FieldType == "" || p.FieldType == p.Type {
+		return p.Type
+	}
+	return fmt.Sprintf("%s (%s)", p.Type, p.FieldType)
+}
+
+// markdownCell escapes a value for a Markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	s = strings.ReplaceAll(s, "\n", "<br>")
+	return strings.TrimSpace(s)
+}
+
+// dictionaryMarkdown renders a data dictionary as Markdown, one table per
+// property group
+func dictionaryMarkdown(objectType string, groups []dictionaryGroup) []byte {
+	var b bytes.Buffer
+
+	total := 0
+	for _, g := range groups {
+		total += len(g.Properties)
+	}
+
+	fmt.Fprintf(&b, "# %s data dictionary\n\n", objectType)
+	fmt.Fprintf(&b, "%d properties in %d groups.\n", total, len(groups))
+
+	for _, g := range groups {
+		fmt.Fprintf(&b, "\n## %s\n\n", markdownCell(g.Label))
+		fmt.Fprintf(&b, "Group `%s`\n\n", g.Name)
+		b.WriteString("| Name | Label | Type | Options | Description |\n")
+		b.WriteString("|------|-------|------|---------|-------------|\n")
+		for _, p := range g.Properties {
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n",
+				p.Name,
+				markdownCell(p.Label),
+				markdownCell(propertyType(p)),
+				markdownCell(strings.Join(optionList(p), "<br>")),
+				markdownCell(p.Description),
+			)
+		}
+	}
+
+	return b.Bytes()
+}
+
+// dictionaryCSV renders a data dictionary as CSV, one row per property
+func dictionaryCSV(groups []dictionaryGroup) ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+
+	records := [][]string{{"name", "label", "type", "field_type", "group", "group_label", "options", "description", "hubspot_defined"}}
+	for _, g := range groups {
+		for _, p := range g.Properties {
+			records = append(records, []string{
+				p.Name,
+				p.Label,
+				p.Type,
+				p.FieldType,
+				g.Name,
+				g.Label,
+				strings.Join(optionList(p), "; "),
+				p.Description,
+				strconv.FormatBool(p.HubspotDefined),
+			})
+		}
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+func newExportCmd(opts *root.Options) *cobra.Command {
+	var objectType string
+	var format string
+	var out string
+	var includeHidden bool
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export properties as a data dictionary",
+		Long: `Export an object type's properties as a data dictionary for documentation:
+name, label, type, options, description, and group.
+
+--format markdown (the default) writes one table per property group, ready to
+paste into a documentation portal; --format csv writes one row per property
+for spreadsheets. Hidden properties are left out unless --include-hidden is
+set.`,
+		Example: `  # Contact properties as a Markdown data dictionary
+  hspt properties export --object-type contacts --out dictionary.md
+
+  # Deal properties as CSV
+  hspt properties export --object-type deals --format csv --out deals.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" {
+				return fmt.Errorf("--object-type is required")
+			}
+			format = strings.ToLower(format)
+			if format != "markdown" && format != "csv" {
+				return fmt.Errorf("invalid --format %q (use markdown or csv)", format)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			props, err := client.ListProperties(cmd.Context(), api.ObjectType(objectType))
+			if err != nil {
+				return fmt.Errorf("failed to list properties: %w", err)
+			}
+			groupList, err := client.ListPropertyGroups(cmd.Context(), api.ObjectType(objectType))
+			if err != nil {
+				return fmt.Errorf("failed to list property groups: %w", err)
+			}
+
+			var selected []api.Property
+			for _, p := range props.Results {
+				if p.Hidden && !includeHidden {
+					continue
+				}
+				selected = append(selected, p)
+			}
+			groups := groupProperties(selected, groupList.Results)
+
+			var data []byte
+			if format == "csv" {
+				if data, err = dictionaryCSV(groups); err != nil {
+					return err
+				}
+			} else {
+				data = dictionaryMarkdown(objectType, groups)
+			}
+
+			if out == "" {
+				_, err = v.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(out, data, 0644); err != nil {
+				return fmt.Errorf("failed to write data dictionary: %w", err)
+			}
+
+			v.Success("Exported %d %s properties in %d groups to %s", len(selected), objectType, len(groups), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (contacts, companies, deals, tickets, etc.)")
+	cmd.Flags().StringVar(&format, "format", "markdown", "Dictionary format: markdown or csv")
+	cmd.Flags().StringVar(&out, "out", "", "File to write the dictionary to (default: stdout)")
+	cmd.Flags().BoolVar(&includeHidden, "include-hidden", false, "Include hidden properties")
+
+	return cmd
+}
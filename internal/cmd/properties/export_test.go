@@ -0,0 +1,61 @@
+package properties
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func testDictionary() []dictionaryGroup {
+	props := []api.Property{
+		{Name: "lifecyclestage", Label: "Lifecycle stage", Type: "enumeration", FieldType: "radio", GroupName: "contactinformation",
+			Description: "The stage | status of the contact",
+			Options: []api.PropertyOption{
+				{Label: "Lead", Value: "lead"},
+				{Label: "Customer", Value: "customer"},
+				{Label: "Legacy", Value: "legacy", Hidden: true},
+			}},
+		{Name: "email", Label: "Email", Type: "string", FieldType: "text", GroupName: "contactinformation", HubspotDefined: true},
+		{Name: "score", Label: "Score", Type: "number", FieldType: "number", GroupName: "custom_scoring", Description: "Line one\nLine two"},
+	}
+	groups := []api.PropertyGroup{
+		{Name: "emailinformation", Label: "Email information", DisplayOrder: 0},
+		{Name: "contactinformation", Label: "Contact information", DisplayOrder: 1},
+	}
+	return groupProperties(props, groups)
+}
+
+func TestGroupProperties(t *testing.T) {
+	groups := testDictionary()
+
+	require.Len(t, groups, 2, "empty groups are left out")
+	assert.Equal(t, "Contact information", groups[0].Label)
+	assert.Equal(t, "email", groups[0].Properties[0].Name)
+	assert.Equal(t, "lifecyclestage", groups[0].Properties[1].Name)
+	assert.Equal(t, "custom_scoring", groups[1].Label, "unknown groups are named after the group")
+}
+
+func TestDictionaryMarkdown(t *testing.T) {
+	md := string(dictionaryMarkdown("contacts", testDictionary()))
+
+	assert.Contains(t, md, "# contacts data dictionary\n\n3 properties in 2 groups.\n")
+	assert.Contains(t, md, "## Contact information\n\nGroup `contactinformation`\n")
+	assert.Contains(t, md, "| `lifecyclestage` | Lifecycle stage | enumeration (radio) | Lead (lead)<br>Customer (customer) | The stage \\| status of the contact |")
+	assert.Contains(t, md, "| `score` | Score | number |  | Line one<br>Line two |")
+	assert.NotContains(t, md, "legacy")
+}
+
+func TestDictionaryCSV(t *testing.T) {
+	data, err := dictionaryCSV(testDictionary())
+	require.NoError(t, err)
+
+	assert.Equal(t, `name,label,type,field_type,group,group_label,options,description,hubspot_defined
+email,Email,string,text,contactinformation,Contact information,,,true
+lifecyclestage,Lifecycle stage,enumeration,radio,contactinformation,Contact information,Lead (lead); Customer (customer),The stage | status of the contact,false
+score,Score,number,number,custom_scoring,custom_scoring,,"Line one
+Line two",false
+`, string(data))
+}
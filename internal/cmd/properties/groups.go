@@ -0,0 +1,175 @@
+package properties
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+func newGroupsCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "property-groups",
+		Aliases: []string{"prop-groups"},
+		Short:   "Manage HubSpot property groups",
+		Long:    "Commands for listing, creating, and deleting the groups that organize object properties.",
+	}
+
+	cmd.AddCommand(newGroupsListCmd(opts))
+	cmd.AddCommand(newGroupsCreateCmd(opts))
+	cmd.AddCommand(newGroupsDeleteCmd(opts))
+
+	return cmd
+}
+
+func newGroupsListCmd(opts *root.Options) *cobra.Command {
+	var objectType string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List property groups",
+		Long:  "List all property groups for an object type.",
+		Example: `  # List contact property groups
+  hspt property-groups list --object-type contacts`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" {
+				return fmt.Errorf("--object-type is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListPropertyGroups(cmd.Context(), api.ObjectType(objectType))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No property groups found")
+				return nil
+			}
+
+			headers := []string{"NAME", "LABEL", "ORDER"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, group := range result.Results {
+				rows = append(rows, []string{
+					group.Name,
+					group.Label,
+					strconv.Itoa(group.DisplayOrder),
+				})
+			}
+
+			return v.Render(headers, rows, result)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (contacts, companies, deals, tickets, etc.)")
+
+	return cmd
+}
+
+func newGroupsCreateCmd(opts *root.Options) *cobra.Command {
+	var objectType, name, label string
+	var displayOrder int
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a property group",
+		Long:  "Create a new property group for an object type.",
+		Example: `  # Create a group for lead scoring properties
+  hspt property-groups create --object-type contacts --name scoring --label "Lead scoring"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || name == "" || label == "" {
+				return fmt.Errorf("--object-type, --name, and --label are required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := client.CreatePropertyGroup(cmd.Context(), api.ObjectType(objectType), api.PropertyGroup{
+				Name:         name,
+				Label:        label,
+				DisplayOrder: displayOrder,
+			})
+			if err != nil {
+				return err
+			}
+
+			v.Success("Property group %s created for %s", group.Name, objectType)
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"Name", group.Name},
+				{"Label", group.Label},
+				{"Order", strconv.Itoa(group.DisplayOrder)},
+			}
+
+			return v.Render(headers, rows, group)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (contacts, companies, deals, tickets, etc.)")
+	cmd.Flags().StringVar(&name, "name", "", "Group name (internal identifier)")
+	cmd.Flags().StringVar(&label, "label", "", "Group label (display name)")
+	cmd.Flags().IntVar(&displayOrder, "display-order", 0, "Position of the group in the HubSpot UI")
+
+	return cmd
+}
+
+func newGroupsDeleteCmd(opts *root.Options) *cobra.Command {
+	var objectType, name string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a property group",
+		Long:  "Archive a property group. Move or delete its properties first; HubSpot refuses to archive a group that still has properties.",
+		Example: `  # Delete a property group
+  hspt property-groups delete --object-type contacts --name scoring --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || name == "" {
+				return fmt.Errorf("--object-type and --name are required")
+			}
+
+			if !force {
+				v.Warning("This will archive property group %s for %s. Use --force to confirm.", name, objectType)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeletePropertyGroup(cmd.Context(), api.ObjectType(objectType), name); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Property group %s not found for %s", name, objectType)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Property group %s archived for %s", name, objectType)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (contacts, companies, deals, tickets, etc.)")
+	cmd.Flags().StringVar(&name, "name", "", "Group name")
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
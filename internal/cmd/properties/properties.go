@@ -1,7 +1,9 @@
 package properties
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -15,15 +17,18 @@ func Register(parent *cobra.Command, opts *root.Options) {
 		Use:     "properties",
 		Aliases: []string{"props"},
 		Short:   "Manage HubSpot properties",
-		Long:    "Commands for listing, viewing, creating, and deleting object properties.",
+		Long:    "Commands for listing, viewing, creating, updating, and deleting object properties.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
 	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newExportCmd(opts))
 
 	parent.AddCommand(cmd)
+	parent.AddCommand(newGroupsCmd(opts))
 }
 
 func newListCmd(opts *root.Options) *cobra.Command {
@@ -50,7 +55,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListProperties(api.ObjectType(objectType))
+			result, err := client.ListProperties(cmd.Context(), api.ObjectType(objectType))
 			if err != nil {
 				return err
 			}
@@ -106,7 +111,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			prop, err := client.GetProperty(api.ObjectType(objectType), name)
+			prop, err := client.GetProperty(cmd.Context(), api.ObjectType(objectType), name)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Property %s not found for %s", name, objectType)
@@ -126,8 +131,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"HubSpot Defined", formatBool(prop.HubspotDefined)},
 				{"Calculated", formatBool(prop.Calculated)},
 				{"Hidden", formatBool(prop.Hidden)},
-				{"Created", prop.CreatedAt},
-				{"Updated", prop.UpdatedAt},
+				{"Created", v.Time(prop.CreatedAt)},
+				{"Updated", v.Time(prop.UpdatedAt)},
 			}
 
 			if len(prop.Options) > 0 {
@@ -145,47 +150,69 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
-	var objectType, name, label, propType, fieldType, groupName, description string
+	var objectType, name, label, propType, fieldType, groupName, description, file string
 
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a property",
-		Long:  "Create a new custom property for an object type.",
+		Long: `Create a new custom property for an object type.
+
+The definition can be given with flags, read from a JSON file with --file
+(for example, to include enumeration options), or both; flags override values
+from the file.`,
 		Example: `  # Create a text property
   hspt properties create --object-type contacts --name my_field --label "My Field" --type string --field-type text
 
   # Create a number property
-  hspt properties create --object-type deals --name custom_amount --label "Custom Amount" --type number --field-type number`,
+  hspt properties create --object-type deals --name custom_amount --label "Custom Amount" --type number --field-type number
+
+  # Create a dropdown from a definition file
+  hspt properties create --object-type contacts --file prop.json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
-			if objectType == "" || name == "" || label == "" || propType == "" || fieldType == "" {
-				return fmt.Errorf("--object-type, --name, --label, --type, and --field-type are required")
-			}
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
+			var req api.CreatePropertyRequest
+			if file != "" {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read file: %w", err)
+				}
+				if err := json.Unmarshal(data, &req); err != nil {
+					return fmt.Errorf("invalid JSON: %w", err)
+				}
 			}
 
-			req := api.CreatePropertyRequest{
-				Name:      name,
-				Label:     label,
-				Type:      propType,
-				FieldType: fieldType,
-				GroupName: groupName,
+			for _, f := range []struct {
+				value string
+				dest  *string
+			}{
+				{name, &req.Name},
+				{label, &req.Label},
+				{propType, &req.Type},
+				{fieldType, &req.FieldType},
+				{groupName, &req.GroupName},
+				{description, &req.Description},
+			} {
+				if f.value != "" {
+					*f.dest = f.value
+				}
 			}
 
-			if description != "" {
-				req.Description = description
+			if objectType == "" || req.Name == "" || req.Label == "" || req.Type == "" || req.FieldType == "" {
+				return fmt.Errorf("--object-type, --name, --label, --type, and --field-type are required (in flags or --file)")
 			}
 
-			if groupName == "" {
+			if req.GroupName == "" {
 				// Default group name based on object type
 				req.GroupName = objectType + "information"
 			}
 
-			prop, err := client.CreateProperty(api.ObjectType(objectType), req)
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			prop, err := client.CreateProperty(cmd.Context(), api.ObjectType(objectType), req)
 			if err != nil {
 				return err
 			}
@@ -212,6 +239,93 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&fieldType, "field-type", "", "Field type (text, textarea, number, date, select, checkbox, etc.)")
 	cmd.Flags().StringVar(&groupName, "group", "", "Property group name")
 	cmd.Flags().StringVar(&description, "description", "", "Property description")
+	cmd.Flags().StringVar(&file, "file", "", "JSON file containing the property definition")
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options) *cobra.Command {
+	var objectType, name, label, groupName, description, file string
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a property",
+		Long: `Update the definition of a property. Only the given fields change.
+
+Fields can be given with flags or in a JSON file with --file, which is needed
+to change enumeration options; flags override values from the file.`,
+		Example: `  # Rename a property's label
+  hspt properties update --object-type contacts --name my_field --label "New Label"
+
+  # Replace dropdown options from a file
+  hspt properties update --object-type contacts --name favorite_color --file options.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectType == "" || name == "" {
+				return fmt.Errorf("--object-type and --name are required")
+			}
+
+			updates := make(map[string]interface{})
+			if file != "" {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read file: %w", err)
+				}
+				if err := json.Unmarshal(data, &updates); err != nil {
+					return fmt.Errorf("invalid JSON: %w", err)
+				}
+			}
+			if label != "" {
+				updates["label"] = label
+			}
+			if groupName != "" {
+				updates["groupName"] = groupName
+			}
+			if description != "" {
+				updates["description"] = description
+			}
+			delete(updates, "name")
+
+			if len(updates) == 0 {
+				return fmt.Errorf("nothing to update: use --label, --group, --description, or --file")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			prop, err := client.UpdateProperty(cmd.Context(), api.ObjectType(objectType), name, updates)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Property %s not found for %s", name, objectType)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Property %s updated for %s", prop.Name, objectType)
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"Name", prop.Name},
+				{"Label", prop.Label},
+				{"Type", prop.Type},
+				{"Field Type", prop.FieldType},
+				{"Group", prop.GroupName},
+			}
+
+			return v.Render(headers, rows, prop)
+		},
+	}
+
+	cmd.Flags().StringVar(&objectType, "object-type", "", "Object type (contacts, companies, deals, tickets, etc.)")
+	cmd.Flags().StringVar(&name, "name", "", "Property name")
+	cmd.Flags().StringVar(&label, "label", "", "New property label")
+	cmd.Flags().StringVar(&groupName, "group", "", "New property group name")
+	cmd.Flags().StringVar(&description, "description", "", "New property description")
+	cmd.Flags().StringVar(&file, "file", "", "JSON file containing the fields to update")
 
 	return cmd
 }
@@ -243,7 +357,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteProperty(api.ObjectType(objectType), name); err != nil {
+			if err := client.DeleteProperty(cmd.Context(), api.ObjectType(objectType), name); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Property %s not found for %s", name, objectType)
 					return nil
@@ -0,0 +1,307 @@
+package quotes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// lineItemCopyProperties are the line item properties compose copies from a
+// deal's line items to the quote's
+var lineItemCopyProperties = []string{
+	"name", "description", "hs_product_id", "hs_sku", "quantity", "price",
+	"discount", "hs_discount_percentage", "recurringbillingfrequency",
+	"hs_recurring_billing_period", "hs_recurring_billing_start_date",
+	"hs_billing_period_start_date", "hs_term_in_months",
+}
+
+// copyLineItem returns the properties of a new line item like item
+func copyLineItem(item api.CRMObject) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for _, name := range lineItemCopyProperties {
+		if value := item.GetProperty(name); value != "" {
+			properties[name] = value
+		}
+	}
+	return properties
+}
+
+// pickTemplate returns the portal's only quote template, or an error naming
+// the templates to choose from
+func pickTemplate(templates []api.CRMObject) (string, error) {
+	switch len(templates) {
+	case 0:
+		return "", fmt.Errorf("the portal has no quote templates: create one under Sales > Quotes > Quote templates")
+	case 1:
+		return templates[0].ID, nil
+	}
+
+	names := make([]string, 0, len(templates))
+	for _, t := range templates {
+		names = append(names, fmt.Sprintf("%s (%s)", t.ID, t.GetProperty("hs_name")))
+	}
+	return "", fmt.Errorf("the portal has %d quote templates: choose one with --template: %s", len(templates), strings.Join(names, ", "))
+}
+
+// associatedIDs returns the IDs of the toType records associated with a
+// record
+func associatedIDs(ctx context.Context, client *api.Client, fromType api.ObjectType, id string, toType api.ObjectType) ([]string, error) {
+	result, err := client.ListAssociations(ctx, fromType, id, toType, api.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the %s of %s %s: %w", toType, fromType, id, err)
+	}
+	ids := make([]string, 0, len(result.Results))
+	for _, a := range result.Results {
+		ids = append(ids, a.ToObjectID.String())
+	}
+	return ids, nil
+}
+
+// readLineItems reads line items by ID, a batch at a time
+func readLineItems(ctx context.Context, client *api.Client, ids []string) ([]api.CRMObject, error) {
+	var items []api.CRMObject
+	for start := 0; start < len(ids); start += api.MaxBatchSize {
+		end := min(start+api.MaxBatchSize, len(ids))
+		result, err := client.BatchReadObjects(ctx, api.ObjectTypeLineItems, ids[start:end], lineItemCopyProperties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line items: %w", err)
+		}
+		items = append(items, result.Results...)
+	}
+	return items, nil
+}
+
+// createLineItems creates copies of items for a quote. Line items belong to
+// one deal or quote, so the deal's own are left where they are. Copies
+// created before an error are archived again.
+func createLineItems(ctx context.Context, client *api.Client, items []api.CRMObject) ([]string, error) {
+	inputs := make([]api.BatchInput, 0, len(items))
+	for _, item := range items {
+		inputs = append(inputs, api.BatchInput{Properties: copyLineItem(item)})
+	}
+
+	var ids []string
+	for start := 0; start < len(inputs); start += api.MaxBatchSize {
+		end := min(start+api.MaxBatchSize, len(inputs))
+		result, err := client.BatchCreateObjects(ctx, api.ObjectTypeLineItems, inputs[start:end])
+		if err == nil && result.NumErrors > 0 {
+			err = fmt.Errorf("%d line item(s) failed: %s", result.NumErrors, result.Errors[0].Message)
+		}
+		if result != nil {
+			for _, obj := range result.Results {
+				ids = append(ids, obj.ID)
+			}
+		}
+		if err != nil {
+			archiveLineItems(ctx, client, ids)
+			return nil, fmt.Errorf("failed to create line items: %w", err)
+		}
+	}
+	return ids, nil
+}
+
+// archiveLineItems archives line items compose created for a quote it could
+// not finish, as far as it can
+func archiveLineItems(ctx context.Context, client *api.Client, ids []string) {
+	for _, id := range ids {
+		_ = client.DeleteObject(ctx, api.ObjectTypeLineItems, id)
+	}
+}
+
+// quoteAssociations returns the associations a composed quote is created
+// with
+func quoteAssociations(targets quoteTargets) []api.ObjectAssociation {
+	spec := func(toType api.ObjectType) api.AssociationSpec {
+		s, _ := api.DefaultAssociationType(api.ObjectTypeQuotes, toType)
+		return s
+	}
+	signer := api.AssociationSpec{Category: api.AssociationCategoryHubSpot, TypeID: api.AssociationTypeQuoteSigner}
+
+	var associations []api.ObjectAssociation
+	add := func(toType api.ObjectType, ids []string, extra ...api.AssociationSpec) {
+		for _, id := range ids {
+			associations = append(associations, api.NewObjectAssociation(id, append([]api.AssociationSpec{spec(toType)}, extra...)...))
+		}
+	}
+	add(api.ObjectTypeDeals, targets.deals)
+	add(api.ObjectTypeLineItems, targets.lineItems)
+	add(api.ObjectTypeQuoteTemplates, targets.templates)
+	add(api.ObjectTypeCompanies, targets.companies)
+
+	// A signer is a contact the quote is addressed to as well
+	signers := make(map[string]bool, len(targets.signers))
+	for _, id := range targets.signers {
+		signers[id] = true
+	}
+	for _, id := range targets.contacts {
+		if !signers[id] {
+			add(api.ObjectTypeContacts, []string{id})
+		}
+	}
+	add(api.ObjectTypeContacts, targets.signers, signer)
+
+	return associations
+}
+
+func newComposeCmd(opts *root.Options) *cobra.Command {
+	var deal, title, expires, template string
+	var contacts, signers []string
+	var publish, esign bool
+
+	cmd := &cobra.Command{
+		Use:   "compose",
+		Short: "Build a quote from a deal's line items",
+		Long: `Build a quote from a deal in one step: copy the deal's line items, create
+the quote with them, and associate it with the deal, the deal's contacts and
+company, and a template. With --publish the quote is published too.
+
+The quote gets its own copies of the line items, since a line item belongs
+to one deal or quote. The template defaults to the portal's only one.`,
+		Example: `  # Draft a quote for a deal
+  hspt quotes compose --deal 678
+
+  # Title, expiry, and template, published straight away
+  hspt quotes compose --deal 678 --title "Acme renewal 2025" --expiration-date 2025-03-31 --template 900 --publish
+
+  # For e-signature by one of the deal's contacts
+  hspt quotes compose --deal 678 --publish --esign --signer 555`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+
+			if deal == "" {
+				return fmt.Errorf("--deal is required")
+			}
+			if esign && len(signers) == 0 {
+				return fmt.Errorf("--esign needs at least one --signer")
+			}
+			if expires == "" {
+				expires = time.Now().AddDate(0, 0, 30).Format("2006-01-02")
+			} else if _, err := time.Parse("2006-01-02", expires); err != nil {
+				return fmt.Errorf("invalid --expiration-date %q: use YYYY-MM-DD", expires)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			dealObj, err := client.GetObject(ctx, api.ObjectTypeDeals, deal, []string{"dealname", "deal_currency_code"})
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Deal %s not found", deal)
+					return nil
+				}
+				return fmt.Errorf("failed to get deal: %w", err)
+			}
+
+			lineItemIDs, err := associatedIDs(ctx, client, api.ObjectTypeDeals, deal, api.ObjectTypeLineItems)
+			if err != nil {
+				return err
+			}
+			if len(lineItemIDs) == 0 {
+				return fmt.Errorf("deal %s has no line items to quote: add some with line-items create", deal)
+			}
+			items, err := readLineItems(ctx, client, lineItemIDs)
+			if err != nil {
+				return err
+			}
+
+			targets := quoteTargets{deals: []string{deal}, contacts: contacts, signers: signers}
+			if !cmd.Flags().Changed("contact") {
+				if targets.contacts, err = associatedIDs(ctx, client, api.ObjectTypeDeals, deal, api.ObjectTypeContacts); err != nil {
+					return err
+				}
+			}
+			companies, err := associatedIDs(ctx, client, api.ObjectTypeDeals, deal, api.ObjectTypeCompanies)
+			if err != nil {
+				return err
+			}
+			if len(companies) > 0 {
+				targets.companies = companies[:1]
+			}
+
+			if template == "" {
+				templates, err := client.ListObjects(ctx, api.ObjectTypeQuoteTemplates, api.ListOptions{All: true, Limit: api.DefaultPageSize, Properties: []string{"hs_name"}})
+				if err != nil {
+					return fmt.Errorf("failed to list quote templates: %w", err)
+				}
+				if template, err = pickTemplate(templates.Results); err != nil {
+					return err
+				}
+			}
+			targets.templates = []string{template}
+
+			if title == "" {
+				title = strings.TrimSpace(dealObj.GetProperty("dealname") + " quote")
+			}
+			properties := map[string]interface{}{
+				"hs_title":           title,
+				"hs_expiration_date": expires,
+				"hs_status":          "DRAFT",
+			}
+			if currency := dealObj.GetProperty("deal_currency_code"); currency != "" {
+				properties["hs_currency"] = currency
+			}
+			if esign {
+				properties["hs_esign_enabled"] = "true"
+			}
+
+			if targets.lineItems, err = createLineItems(ctx, client, items); err != nil {
+				return err
+			}
+
+			quote, err := client.CreateObjectWithAssociations(ctx, api.ObjectTypeQuotes, properties, quoteAssociations(targets))
+			if err != nil {
+				archiveLineItems(ctx, client, targets.lineItems)
+				return fmt.Errorf("failed to create quote: %w", err)
+			}
+
+			v.Success("Quote %s created from deal %s with %d line item(s)", quote.ID, deal, len(targets.lineItems))
+
+			status := "DRAFT"
+			if publish {
+				if _, err := client.UpdateObject(ctx, api.ObjectTypeQuotes, quote.ID, map[string]interface{}{"hs_status": statusPublished}); err != nil {
+					return fmt.Errorf("quote %s was created but not published: %w", quote.ID, err)
+				}
+				status = statusPublished
+				if read, err := client.GetObject(ctx, api.ObjectTypeQuotes, quote.ID, publishProperties); err == nil {
+					quote = read
+				}
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", quote.ID},
+				{"Title", title},
+				{"Status", status},
+				{"Expiration Date", expires},
+				{"Deal", deal},
+				{"Line Items", strconv.Itoa(len(targets.lineItems))},
+				{"Contacts", strings.Join(targets.contacts, ", ")},
+				{"Template", template},
+				{"Link", quote.GetProperty("hs_quote_link")},
+			}
+
+			return v.Render(headers, rows, quote)
+		},
+	}
+
+	cmd.Flags().StringVar(&deal, "deal", "", "Deal to quote (required)")
+	cmd.Flags().StringVar(&title, "title", "", "Quote title (default: the deal name and \"quote\")")
+	cmd.Flags().StringVar(&expires, "expiration-date", "", "Quote expiration date, YYYY-MM-DD (default: 30 days from now)")
+	cmd.Flags().StringVar(&template, "template", "", "Quote template ID (default: the portal's only template)")
+	cmd.Flags().StringSliceVar(&contacts, "contact", nil, "Contact IDs the quote is addressed to (default: the deal's contacts)")
+	cmd.Flags().StringSliceVar(&signers, "signer", nil, "Contact IDs who sign the quote")
+	cmd.Flags().BoolVar(&publish, "publish", false, "Publish the quote once it is created")
+	cmd.Flags().BoolVar(&esign, "esign", false, "Have the buyer sign the quote electronically")
+
+	return cmd
+}
@@ -0,0 +1,275 @@
+package quotes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Quote statuses a quote is published with: straight away, or once a
+// reviewer approves it
+const (
+	statusPublished       = "APPROVAL_NOT_NEEDED"
+	statusPendingApproval = "PENDING_APPROVAL"
+)
+
+// publishProperties are the quote properties publish checks and reports
+var publishProperties = []string{"hs_title", "hs_status", "hs_expiration_date", "hs_esign_enabled", "hs_quote_link"}
+
+// quoteLinks counts a quote's associations publishing depends on
+type quoteLinks struct {
+	templates, deals, signers int
+}
+
+// loadQuoteLinks counts the quote's templates, deals, and signers
+func loadQuoteLinks(ctx context.Context, client *api.Client, id string) (quoteLinks, error) {
+	var links quoteLinks
+
+	templates, err := client.ListAssociations(ctx, api.ObjectTypeQuotes, id, api.ObjectTypeQuoteTemplates, api.ListOptions{All: true})
+	if err != nil {
+		return links, fmt.Errorf("failed to list quote templates: %w", err)
+	}
+	links.templates = len(templates.Results)
+
+	deals, err := client.ListAssociations(ctx, api.ObjectTypeQuotes, id, api.ObjectTypeDeals, api.ListOptions{All: true})
+	if err != nil {
+		return links, fmt.Errorf("failed to list quote deals: %w", err)
+	}
+	links.deals = len(deals.Results)
+
+	contacts, err := client.ListAssociations(ctx, api.ObjectTypeQuotes, id, api.ObjectTypeContacts, api.ListOptions{All: true})
+	if err != nil {
+		return links, fmt.Errorf("failed to list quote contacts: %w", err)
+	}
+	for _, a := range contacts.Results {
+		for _, t := range a.AssociationTypes {
+			if t.TypeID == api.AssociationTypeQuoteSigner {
+				links.signers++
+				break
+			}
+		}
+	}
+
+	return links, nil
+}
+
+// publishProblems lists what keeps a quote from being published: HubSpot
+// needs a title, an expiration date, a template, and a deal, and signers
+// for e-signature
+func publishProblems(quote *api.CRMObject, links quoteLinks, esign bool) []string {
+	var problems []string
+	if quote.GetProperty("hs_title") == "" {
+		problems = append(problems, "it has no title (set --title with quotes update)")
+	}
+	if quote.GetProperty("hs_expiration_date") == "" {
+		problems = append(problems, "it has no expiration date (set --expiration-date with quotes update)")
+	}
+	if links.templates == 0 {
+		problems = append(problems, "it has no template (add one with quotes associate --template)")
+	}
+	if links.deals == 0 {
+		problems = append(problems, "it is not associated with a deal (add one with quotes associate --deal)")
+	}
+	if esign && links.signers == 0 {
+		problems = append(problems, "e-signature needs at least one signer (add with --signer)")
+	}
+	return problems
+}
+
+// quoteTargets are the records a quote is associated with
+type quoteTargets struct {
+	deals, lineItems, contacts, companies, templates, signers []string
+}
+
+func (t quoteTargets) count() int {
+	return len(t.deals) + len(t.lineItems) + len(t.contacts) + len(t.companies) + len(t.templates) + len(t.signers)
+}
+
+// associateQuote associates a quote with each given record using HubSpot's
+// default association types; signers are contacts with the signer type too
+func associateQuote(ctx context.Context, client *api.Client, quoteID string, targets quoteTargets) error {
+	signer := api.AssociationSpec{Category: api.AssociationCategoryHubSpot, TypeID: api.AssociationTypeQuoteSigner}
+	groups := []struct {
+		toType api.ObjectType
+		ids    []string
+		extra  []api.AssociationSpec
+	}{
+		{api.ObjectTypeDeals, targets.deals, nil},
+		{api.ObjectTypeLineItems, targets.lineItems, nil},
+		{api.ObjectTypeContacts, targets.contacts, nil},
+		{api.ObjectTypeCompanies, targets.companies, nil},
+		{api.ObjectTypeQuoteTemplates, targets.templates, nil},
+		{api.ObjectTypeContacts, targets.signers, []api.AssociationSpec{signer}},
+	}
+
+	for _, g := range groups {
+		spec, _ := api.DefaultAssociationType(api.ObjectTypeQuotes, g.toType)
+		types := append([]api.AssociationSpec{spec}, g.extra...)
+		for _, id := range g.ids {
+			if err := client.CreateAssociationWithTypes(ctx, api.ObjectTypeQuotes, quoteID, g.toType, id, types); err != nil {
+				return fmt.Errorf("failed to associate %s %s: %w", g.toType, id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func newAssociateCmd(opts *root.Options) *cobra.Command {
+	var targets quoteTargets
+	var deal, company, template string
+
+	cmd := &cobra.Command{
+		Use:   "associate <id>",
+		Short: "Associate a quote with its deal, line items, and buyers",
+		Long: `Associate a quote with the records it needs: the deal it is for, its line
+items, the contacts and company it is addressed to, its template, and the
+contacts who sign it.`,
+		Example: `  # Attach a quote to its deal and line items
+  hspt quotes associate 12345 --deal 678 --line-item 111,112
+
+  # Set the template and the contact who signs
+  hspt quotes associate 12345 --template 900 --signer 555`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if deal != "" {
+				targets.deals = []string{deal}
+			}
+			if company != "" {
+				targets.companies = []string{company}
+			}
+			if template != "" {
+				targets.templates = []string{template}
+			}
+			if targets.count() == 0 {
+				return fmt.Errorf("nothing to associate: set at least one of --deal, --line-item, --contact, --company, --template, or --signer")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := associateQuote(cmd.Context(), client, id, targets); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Quote %s or a record to associate not found", id)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Quote %s associated with %d record(s)", id, targets.count())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&deal, "deal", "", "Deal the quote is for")
+	cmd.Flags().StringSliceVar(&targets.lineItems, "line-item", nil, "Line item IDs (comma-separated or repeated)")
+	cmd.Flags().StringSliceVar(&targets.contacts, "contact", nil, "Contact IDs the quote is addressed to")
+	cmd.Flags().StringVar(&company, "company", "", "Company the quote is addressed to")
+	cmd.Flags().StringVar(&template, "template", "", "Quote template ID")
+	cmd.Flags().StringSliceVar(&targets.signers, "signer", nil, "Contact IDs who sign the quote")
+
+	return cmd
+}
+
+func newPublishCmd(opts *root.Options) *cobra.Command {
+	var approval, esign bool
+	var signers []string
+
+	cmd := &cobra.Command{
+		Use:   "publish <id>",
+		Short: "Publish a quote",
+		Long: `Publish a draft quote so it can be shared with the buyer, after checking it
+has what HubSpot needs: a title, an expiration date, a template, and a deal.
+
+With --request-approval the quote waits for a reviewer instead. With --esign
+the buyer signs it electronically; add the contacts who sign with --signer.`,
+		Example: `  # Publish and print the link to share
+  hspt quotes publish 12345
+
+  # Publish for e-signature
+  hspt quotes publish 12345 --esign --signer 555
+
+  # Send for approval first
+  hspt quotes publish 12345 --request-approval`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ctx := cmd.Context()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			quote, err := client.GetObject(ctx, api.ObjectTypeQuotes, id, publishProperties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Quote %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to get quote: %w", err)
+			}
+
+			if len(signers) > 0 {
+				if err := associateQuote(ctx, client, id, quoteTargets{signers: signers}); err != nil {
+					return err
+				}
+			}
+
+			links, err := loadQuoteLinks(ctx, client, id)
+			if err != nil {
+				return err
+			}
+			if problems := publishProblems(quote, links, esign); len(problems) > 0 {
+				return fmt.Errorf("quote %s cannot be published:\n  - %s", id, strings.Join(problems, "\n  - "))
+			}
+
+			status := statusPublished
+			if approval {
+				status = statusPendingApproval
+			}
+			properties := map[string]interface{}{"hs_status": status}
+			if esign {
+				properties["hs_esign_enabled"] = "true"
+			}
+
+			updated, err := client.UpdateObject(ctx, api.ObjectTypeQuotes, id, properties)
+			if err != nil {
+				return fmt.Errorf("failed to publish quote: %w", err)
+			}
+
+			if approval {
+				v.Success("Quote %s sent for approval", id)
+			} else {
+				v.Success("Quote %s published", id)
+			}
+
+			// The link is set once the quote is published, so read it back
+			if updated.GetProperty("hs_quote_link") == "" {
+				if read, err := client.GetObject(ctx, api.ObjectTypeQuotes, id, publishProperties); err == nil {
+					updated = read
+				}
+			}
+			if link := updated.GetProperty("hs_quote_link"); link != "" {
+				v.Info("Link: %s", link)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&approval, "request-approval", false, "Send the quote for approval instead of publishing it straight away")
+	cmd.Flags().BoolVar(&esign, "esign", false, "Have the buyer sign the quote electronically")
+	cmd.Flags().StringSliceVar(&signers, "signer", nil, "Contact IDs who sign the quote (comma-separated or repeated)")
+
+	return cmd
+}
@@ -8,17 +8,18 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
 )
 
 // DefaultProperties are the default properties to fetch for quotes
-var DefaultProperties = []string{"hs_title", "hs_expiration_date", "hs_status", "hs_quote_amount"}
+var DefaultProperties = []string{"hs_title", "hs_expiration_date", "hs_status", "hs_quote_amount", "hs_currency"}
 
 // Register registers the quotes command and subcommands
 func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "quotes",
 		Short: "Manage HubSpot quotes",
-		Long:  "Commands for listing, viewing, creating, updating, and deleting quotes in HubSpot CRM.",
+		Long:  "Commands for listing, viewing, creating, updating, deleting, composing, and publishing quotes in HubSpot CRM.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
@@ -26,6 +27,9 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newCreateCmd(opts))
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newAssociateCmd(opts))
+	cmd.AddCommand(newPublishCmd(opts))
+	cmd.AddCommand(newComposeCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -34,6 +38,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -59,11 +64,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeQuotes, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeQuotes, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -80,7 +85,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 					obj.ID,
 					obj.GetProperty("hs_title"),
 					obj.GetProperty("hs_status"),
-					obj.GetProperty("hs_quote_amount"),
+					v.MoneyIn(obj.GetProperty("hs_quote_amount"), obj.GetProperty("hs_currency")),
 					obj.GetProperty("hs_expiration_date"),
 				})
 			}
@@ -101,11 +106,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -130,7 +138,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeQuotes, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeQuotes, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Quote %s not found", id)
@@ -144,17 +152,22 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"ID", obj.ID},
 				{"Title", obj.GetProperty("hs_title")},
 				{"Status", obj.GetProperty("hs_status")},
-				{"Amount", obj.GetProperty("hs_quote_amount")},
+				{"Amount", v.MoneyIn(obj.GetProperty("hs_quote_amount"), obj.GetProperty("hs_currency"))},
 				{"Expiration Date", obj.GetProperty("hs_expiration_date")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
@@ -203,7 +216,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeQuotes, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeQuotes, properties)
 			if err != nil {
 				return err
 			}
@@ -276,7 +289,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeQuotes, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeQuotes, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Quote %s not found", id)
@@ -325,7 +338,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeQuotes, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeQuotes, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Quote %s not found", id)
 					return nil
@@ -0,0 +1,71 @@
+package quotes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestPublishProblems(t *testing.T) {
+	ready := &api.CRMObject{ID: "1", Properties: map[string]interface{}{"hs_title": "Renewal", "hs_expiration_date": "2025-03-31"}}
+	assert.Empty(t, publishProblems(ready, quoteLinks{templates: 1, deals: 1}, false))
+
+	problems := publishProblems(&api.CRMObject{ID: "1"}, quoteLinks{}, true)
+	require.Len(t, problems, 5)
+	assert.Contains(t, problems[0], "no title")
+	assert.Contains(t, problems[4], "signer")
+
+	assert.Len(t, publishProblems(ready, quoteLinks{templates: 1, deals: 1, signers: 1}, true), 0)
+}
+
+func TestCopyLineItem(t *testing.T) {
+	item := api.CRMObject{ID: "9", Properties: map[string]interface{}{
+		"name":          "Seats",
+		"quantity":      "10",
+		"price":         "50",
+		"hs_product_id": "300",
+		"amount":        "500",
+		"discount":      nil,
+	}}
+	assert.Equal(t, map[string]interface{}{"name": "Seats", "quantity": "10", "price": "50", "hs_product_id": "300"}, copyLineItem(item))
+}
+
+func TestPickTemplate(t *testing.T) {
+	_, err := pickTemplate(nil)
+	assert.ErrorContains(t, err, "no quote templates")
+
+	id, err := pickTemplate([]api.CRMObject{{ID: "900"}})
+	require.NoError(t, err)
+	assert.Equal(t, "900", id)
+
+	_, err = pickTemplate([]api.CRMObject{
+		{ID: "900", Properties: map[string]interface{}{"hs_name": "Basic"}},
+		{ID: "901", Properties: map[string]interface{}{"hs_name": "Modern"}},
+	})
+	assert.EqualError(t, err, "the portal has 2 quote templates: choose one with --template: 900 (Basic), 901 (Modern)")
+}
+
+func TestQuoteAssociations(t *testing.T) {
+	hubspot := func(id int) api.AssociationSpec {
+		return api.AssociationSpec{Category: api.AssociationCategoryHubSpot, TypeID: id}
+	}
+
+	associations := quoteAssociations(quoteTargets{
+		deals:     []string{"678"},
+		lineItems: []string{"11"},
+		templates: []string{"900"},
+		contacts:  []string{"555", "556"},
+		signers:   []string{"555"},
+	})
+
+	assert.Equal(t, []api.ObjectAssociation{
+		api.NewObjectAssociation("678", hubspot(64)),
+		api.NewObjectAssociation("11", hubspot(67)),
+		api.NewObjectAssociation("900", hubspot(286)),
+		api.NewObjectAssociation("556", hubspot(69)),
+		api.NewObjectAssociation("555", hubspot(69), hubspot(api.AssociationTypeQuoteSigner)),
+	}, associations)
+}
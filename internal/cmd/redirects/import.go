@@ -0,0 +1,248 @@
+package redirects
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// styleColumn is the optional CSV column giving a row its own redirect style
+const styleColumn = "style"
+
+// redirectRow is one redirect of an import file and what became of it
+type redirectRow struct {
+	Row    int    `json:"row"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Style  int    `json:"style"`
+	ID     string `json:"id,omitempty"`
+	Result string `json:"result"`
+}
+
+// readRedirectRows reads from and to pairs from a CSV with a header row.
+// Rows without a style column value use style; rows with a blank from or
+// to are skipped.
+func readRedirectRows(r io.Reader, fromColumn, toColumn string, style int) ([]redirectRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("CSV file is empty")
+		}
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	fromIdx, toIdx, styleIdx := -1, -1, -1
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		switch {
+		case strings.EqualFold(h, fromColumn):
+			fromIdx = i
+		case strings.EqualFold(h, toColumn):
+			toIdx = i
+		case strings.EqualFold(h, styleColumn):
+			styleIdx = i
+		}
+	}
+	if fromIdx < 0 || toIdx < 0 {
+		return nil, fmt.Errorf("CSV header must include %q and %q columns", fromColumn, toColumn)
+	}
+
+	cell := func(record []string, i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []redirectRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV row %d: %w", line, err)
+		}
+
+		row := redirectRow{Row: line, From: cell(record, fromIdx), To: cell(record, toIdx), Style: style}
+		if row.From == "" || row.To == "" {
+			continue
+		}
+		if s := cell(record, styleIdx); s != "" {
+			n, err := strconv.Atoi(s)
+			if err == nil {
+				err = checkStyle(n)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid redirect style %q: use 301, 302, or 305", line, s)
+			}
+			row.Style = n
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// planRedirects marks the rows that need no new redirect: those whose from
+// already has a redirect in the portal, and repeats of an earlier row
+func planRedirects(rows []redirectRow, existing []api.URLRedirect) {
+	ids := make(map[string]string, len(existing))
+	for _, r := range existing {
+		ids[r.RoutePrefix] = r.ID
+	}
+
+	first := make(map[string]int, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		if id, ok := ids[row.From]; ok {
+			row.ID = id
+			row.Result = "exists"
+			continue
+		}
+		if n, ok := first[row.From]; ok {
+			row.Result = fmt.Sprintf("duplicate of row %d", n)
+			continue
+		}
+		first[row.From] = row.Row
+	}
+}
+
+func newImportCmd(opts *root.Options) *cobra.Command {
+	var file, fromColumn, toColumn string
+	var style int
+	var onlyAfterNotFound, dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create URL redirects from a CSV file",
+		Long: `Create a URL redirect for every row of a CSV file of from and to pairs,
+such as the redirect map of a site migration.
+
+The file needs a header row with a from column and a to column; an
+optional style column sets a row's redirect style. Paths that already
+have a redirect, and repeats within the file, are skipped, so an import
+that stopped part way can be run again.`,
+		Example: `  # Preview, then create the redirects of a migration
+  hspt redirects import --file redirects.csv --dry-run
+  hspt redirects import --file redirects.csv
+
+  # Read other columns, as temporary redirects
+  hspt redirects import --file map.csv --from-column "Old URL" --to-column "New URL" --style 302`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			if err := checkStyle(style); err != nil {
+				return err
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return fmt.Errorf("failed to open file: %w", err)
+			}
+			defer f.Close()
+
+			rows, err := readRedirectRows(f, fromColumn, toColumn, style)
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				v.Info("No redirects found in %s", file)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListURLRedirects(cmd.Context(), api.ListOptions{All: true})
+			if err != nil {
+				return fmt.Errorf("failed to list redirects: %w", err)
+			}
+			planRedirects(rows, existing.Results)
+
+			created, failed := 0, 0
+			for i := range rows {
+				row := &rows[i]
+				if row.Result != "" {
+					continue
+				}
+				if dryRun {
+					row.Result = "would create"
+					continue
+				}
+
+				r, err := client.CreateURLRedirect(cmd.Context(), map[string]interface{}{
+					"routePrefix":         row.From,
+					"destination":         row.To,
+					"redirectStyle":       row.Style,
+					"isOnlyAfterNotFound": onlyAfterNotFound,
+				})
+				if err != nil {
+					if api.IsBudgetExhausted(err) {
+						break
+					}
+					row.Result = "failed: " + err.Error()
+					failed++
+					continue
+				}
+				row.ID = r.ID
+				row.Result = "created"
+				created++
+			}
+			for i := range rows {
+				if rows[i].Result == "" {
+					rows[i].Result = "not attempted"
+				}
+			}
+
+			headers := []string{"ROW", "FROM", "TO", "STYLE", "ID", "RESULT"}
+			tableRows := make([][]string, 0, len(rows))
+			for _, row := range rows {
+				tableRows = append(tableRows, []string{
+					strconv.Itoa(row.Row), row.From, row.To, strconv.Itoa(row.Style), row.ID, row.Result,
+				})
+			}
+			if err := v.Render(headers, tableRows, rows); err != nil {
+				return err
+			}
+
+			switch {
+			case dryRun:
+				v.Info("Dry run: no redirects were created")
+			case failed > 0:
+				v.Warning("Created %d redirect(s); %d failed", created, failed)
+			default:
+				v.Success("Created %d redirect(s)", created)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "CSV file of from and to pairs (required)")
+	cmd.Flags().StringVar(&fromColumn, "from-column", "from", "CSV column holding the paths or URLs to redirect from")
+	cmd.Flags().StringVar(&toColumn, "to-column", "to", "CSV column holding the paths or URLs to redirect to")
+	cmd.Flags().IntVar(&style, "style", 301, "Redirect style for rows without a style column: 301, 302, or 305")
+	cmd.Flags().BoolVar(&onlyAfterNotFound, "only-after-not-found", false, "Redirect only when the page does not exist")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be created without creating anything")
+
+	return cmd
+}
@@ -0,0 +1,363 @@
+package redirects
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the redirects command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "redirects",
+		Short: "Manage CMS URL redirects",
+		Long:  "Commands for listing, viewing, creating, updating, deleting, and importing URL redirects.",
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newUpdateCmd(opts))
+	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newImportCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// redirectStyles are the redirect styles HubSpot supports: permanent,
+// temporary, and proxy
+var redirectStyles = map[int]bool{301: true, 302: true, 305: true}
+
+func checkStyle(style int) error {
+	if !redirectStyles[style] {
+		return fmt.Errorf("invalid redirect style %d: use 301 (permanent), 302 (temporary), or 305 (proxy)", style)
+	}
+	return nil
+}
+
+// redirectFlags are the redirect fields create and update take as flags
+type redirectFlags struct {
+	from, to              string
+	style, precedence     int
+	pattern               bool
+	onlyAfterNotFound     bool
+	matchFullURL          bool
+	matchQueryString      bool
+	trailingSlashOptional bool
+}
+
+func (f *redirectFlags) add(flags *pflag.FlagSet) {
+	flags.StringVar(&f.from, "from", "", "Path or URL to redirect from")
+	flags.StringVar(&f.to, "to", "", "Path or URL to redirect to")
+	flags.IntVar(&f.style, "style", 301, "Redirect style: 301 (permanent), 302 (temporary), or 305 (proxy)")
+	flags.IntVar(&f.precedence, "precedence", 0, "Order among redirects matching the same URL; lower runs first")
+	flags.BoolVar(&f.pattern, "pattern", false, "Treat --from as a pattern with wildcards or :params")
+	flags.BoolVar(&f.onlyAfterNotFound, "only-after-not-found", false, "Redirect only when the page does not exist")
+	flags.BoolVar(&f.matchFullURL, "match-full-url", false, "Match --from against the full URL including the domain")
+	flags.BoolVar(&f.matchQueryString, "match-query-string", false, "Match the query string as well as the path")
+	flags.BoolVar(&f.trailingSlashOptional, "trailing-slash-optional", false, "Match with or without a trailing slash")
+}
+
+// fields returns the redirect fields of the flags that were set, or of every
+// flag when all is set
+func (f *redirectFlags) fields(flags *pflag.FlagSet, all bool) (map[string]interface{}, error) {
+	set := func(name string) bool { return all || flags.Changed(name) }
+
+	fields := make(map[string]interface{})
+	if set("from") {
+		fields["routePrefix"] = f.from
+	}
+	if set("to") {
+		fields["destination"] = f.to
+	}
+	if set("style") {
+		if err := checkStyle(f.style); err != nil {
+			return nil, err
+		}
+		fields["redirectStyle"] = f.style
+	}
+	if flags.Changed("precedence") {
+		fields["precedence"] = f.precedence
+	}
+	if set("pattern") {
+		fields["isPattern"] = f.pattern
+	}
+	if set("only-after-not-found") {
+		fields["isOnlyAfterNotFound"] = f.onlyAfterNotFound
+	}
+	if set("match-full-url") {
+		fields["isMatchFullUrl"] = f.matchFullURL
+	}
+	if set("match-query-string") {
+		fields["isMatchQueryString"] = f.matchQueryString
+	}
+	if set("trailing-slash-optional") {
+		fields["isTrailingSlashOptional"] = f.trailingSlashOptional
+	}
+	return fields, nil
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List URL redirects",
+		Long:  "List the URL redirects of the portal's CMS.",
+		Example: `  # List redirects
+  hspt redirects list
+
+  # Every redirect, for a migration review
+  hspt redirects list --all -o csv > redirects.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListURLRedirects(cmd.Context(), pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No redirects found")
+				return nil
+			}
+
+			headers := []string{"ID", "FROM", "TO", "STYLE", "PATTERN", "ONLY IF 404"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, r := range result.Results {
+				rows = append(rows, []string{
+					r.ID,
+					r.RoutePrefix,
+					r.Destination,
+					strconv.Itoa(r.RedirectStyle),
+					formatBool(r.IsPattern),
+					formatBool(r.IsOnlyAfterNotFound),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of redirects to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a URL redirect by ID",
+		Long:  "Retrieve a single URL redirect by its ID.",
+		Example: `  # Get redirect by ID
+  hspt redirects get 12345`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := client.GetURLRedirect(cmd.Context(), id)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Redirect %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", r.ID},
+				{"From", r.RoutePrefix},
+				{"To", r.Destination},
+				{"Style", strconv.Itoa(r.RedirectStyle)},
+				{"Precedence", strconv.Itoa(r.Precedence)},
+				{"Pattern", formatBool(r.IsPattern)},
+				{"Only After Not Found", formatBool(r.IsOnlyAfterNotFound)},
+				{"Match Full URL", formatBool(r.IsMatchFullURL)},
+				{"Match Query String", formatBool(r.IsMatchQueryString)},
+				{"Trailing Slash Optional", formatBool(r.IsTrailingSlashOptional)},
+				{"Protocol Agnostic", formatBool(r.IsProtocolAgnostic)},
+				{"Created", v.Time(r.CreatedAt)},
+				{"Updated", v.Time(r.UpdatedAt)},
+			}
+
+			return v.Render(headers, rows, r)
+		},
+	}
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var f redirectFlags
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a URL redirect",
+		Long:  "Create a URL redirect from a path or URL to another.",
+		Example: `  # Permanently redirect an old path
+  hspt redirects create --from /old-pricing --to /pricing
+
+  # Temporary redirect, only when the page is missing
+  hspt redirects create --from /promo --to /offers --style 302 --only-after-not-found
+
+  # Redirect a whole section with a pattern
+  hspt redirects create --from "/blog/2019/:slug" --to "/blog/:slug" --pattern`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if f.from == "" || f.to == "" {
+				return fmt.Errorf("--from and --to are required")
+			}
+			fields, err := f.fields(cmd.Flags(), true)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := client.CreateURLRedirect(cmd.Context(), fields)
+			if err != nil {
+				return fmt.Errorf("failed to create redirect: %w", err)
+			}
+
+			v.Success("Redirect created with ID: %s", r.ID)
+			return nil
+		},
+	}
+
+	f.add(cmd.Flags())
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options) *cobra.Command {
+	var f redirectFlags
+
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update a URL redirect",
+		Long:  "Update the fields of a URL redirect given as flags; the others are left as they are.",
+		Example: `  # Point a redirect somewhere else
+  hspt redirects update 12345 --to /pricing-2025
+
+  # Make a redirect temporary
+  hspt redirects update 12345 --style 302`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			fields, err := f.fields(cmd.Flags(), false)
+			if err != nil {
+				return err
+			}
+			if len(fields) == 0 {
+				return fmt.Errorf("nothing to update: set at least one of --from, --to, --style, or the match flags")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.UpdateURLRedirect(cmd.Context(), id, fields); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Redirect %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to update redirect: %w", err)
+			}
+
+			v.Success("Redirect %s updated", id)
+			return nil
+		},
+	}
+
+	f.add(cmd.Flags())
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a URL redirect",
+		Long:  "Permanently delete a URL redirect.",
+		Example: `  # Delete a redirect
+  hspt redirects delete 12345 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !force {
+				v.Warning("This will permanently delete redirect %s. Use --force to confirm.", id)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteURLRedirect(cmd.Context(), id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Redirect %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to delete redirect: %w", err)
+			}
+
+			v.Success("Redirect %s deleted", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
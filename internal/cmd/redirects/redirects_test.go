@@ -0,0 +1,85 @@
+package redirects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestRedirectFlagsFields(t *testing.T) {
+	newFlags := func(args ...string) (*redirectFlags, *cobra.Command) {
+		var f redirectFlags
+		cmd := &cobra.Command{Use: "test"}
+		f.add(cmd.Flags())
+		require.NoError(t, cmd.Flags().Parse(args))
+		return &f, cmd
+	}
+
+	f, cmd := newFlags("--from", "/old", "--to", "/new")
+	fields, err := f.fields(cmd.Flags(), true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"routePrefix":             "/old",
+		"destination":             "/new",
+		"redirectStyle":           301,
+		"isPattern":               false,
+		"isOnlyAfterNotFound":     false,
+		"isMatchFullUrl":          false,
+		"isMatchQueryString":      false,
+		"isTrailingSlashOptional": false,
+	}, fields)
+
+	f, cmd = newFlags("--to", "/newer", "--precedence", "5")
+	fields, err = f.fields(cmd.Flags(), false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"destination": "/newer", "precedence": 5}, fields)
+
+	f, cmd = newFlags("--style", "303")
+	_, err = f.fields(cmd.Flags(), false)
+	assert.ErrorContains(t, err, "invalid redirect style 303")
+}
+
+func TestReadRedirectRows(t *testing.T) {
+	csv := "\ufefffrom,to,Style\n/a,/b,\n/c,/d,302\n,/e,\n/f,,\n/g,/h,301\n"
+	rows, err := readRedirectRows(strings.NewReader(csv), "from", "to", 301)
+	require.NoError(t, err)
+	assert.Equal(t, []redirectRow{
+		{Row: 2, From: "/a", To: "/b", Style: 301},
+		{Row: 3, From: "/c", To: "/d", Style: 302},
+		{Row: 6, From: "/g", To: "/h", Style: 301},
+	}, rows)
+
+	rows, err = readRedirectRows(strings.NewReader("Old URL,New URL\n/x,/y\n"), "old url", "new url", 302)
+	require.NoError(t, err)
+	assert.Equal(t, []redirectRow{{Row: 2, From: "/x", To: "/y", Style: 302}}, rows)
+
+	_, err = readRedirectRows(strings.NewReader("from,to,style\n/a,/b,308\n"), "from", "to", 301)
+	assert.EqualError(t, err, `row 2: invalid redirect style "308": use 301, 302, or 305`)
+
+	_, err = readRedirectRows(strings.NewReader("source,target\n/a,/b\n"), "from", "to", 301)
+	assert.EqualError(t, err, `CSV header must include "from" and "to" columns`)
+
+	_, err = readRedirectRows(strings.NewReader(""), "from", "to", 301)
+	assert.EqualError(t, err, "CSV file is empty")
+}
+
+func TestPlanRedirects(t *testing.T) {
+	rows := []redirectRow{
+		{Row: 2, From: "/a", To: "/b"},
+		{Row: 3, From: "/old", To: "/new"},
+		{Row: 4, From: "/a", To: "/c"},
+		{Row: 5, From: "/d", To: "/e"},
+	}
+	planRedirects(rows, []api.URLRedirect{{ID: "77", RoutePrefix: "/old"}})
+
+	assert.Equal(t, "", rows[0].Result)
+	assert.Equal(t, "exists", rows[1].Result)
+	assert.Equal(t, "77", rows[1].ID)
+	assert.Equal(t, "duplicate of row 2", rows[2].Result)
+	assert.Equal(t, "", rows[3].Result)
+}
@@ -0,0 +1,92 @@
+package root
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// commandKey returns the config key for cmd: its path without the root
+// command name, e.g. "contacts list"
+func commandKey(cmd *cobra.Command) string {
+	path := cmd.CommandPath()
+	if i := strings.IndexByte(path, ' '); i >= 0 {
+		return path[i+1:]
+	}
+	return ""
+}
+
+// explicit reports whether flag name was set on the command line or in cmd's
+// config defaults, either of which wins over the profile-wide setting
+func explicit(cmd *cobra.Command, name string) bool {
+	if cmd.Flags().Changed(name) {
+		return true
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return false
+	}
+	_, ok := cfg.Defaults[commandKey(cmd)][name]
+	return ok
+}
+
+// applyDefaults sets the flag defaults configured for cmd under
+// defaults."<command path>". Flags given on the command line always win.
+func applyDefaults(cmd *cobra.Command, defaults map[string]map[string]interface{}) error {
+	key := commandKey(cmd)
+	values, ok := defaults[key]
+	if !ok {
+		return nil
+	}
+
+	for name, value := range values {
+		configKey := fmt.Sprintf("defaults.%s.%s", key, name)
+
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			return &config.KeyError{Key: configKey, Reason: fmt.Sprintf("%q has no --%s flag", key, name)}
+		}
+		if flag.Changed {
+			continue
+		}
+
+		// Set the value without marking the flag as changed, so commands
+		// that check Changed still treat it as a default
+		if err := setFlagValue(flag, value); err != nil {
+			return &config.KeyError{Key: configKey, Reason: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+func setFlagValue(flag *pflag.Flag, value interface{}) error {
+	if list, ok := value.([]interface{}); ok {
+		items := make([]string, 0, len(list))
+		for _, item := range list {
+			items = append(items, formatValue(item))
+		}
+		if sv, ok := flag.Value.(pflag.SliceValue); ok {
+			return sv.Replace(items)
+		}
+		return flag.Value.Set(strings.Join(items, ","))
+	}
+
+	return flag.Value.Set(formatValue(value))
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
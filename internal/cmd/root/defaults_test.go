@@ -0,0 +1,90 @@
+package root
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newListCmd builds "hspt contacts list" with a few representative flags
+func newListCmd(t *testing.T) (*cobra.Command, *int, *[]string, *bool) {
+	t.Helper()
+	var limit int
+	var properties []string
+	var archived bool
+
+	rootCmd := &cobra.Command{Use: "hspt"}
+	contacts := &cobra.Command{Use: "contacts"}
+	list := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+	list.Flags().IntVar(&limit, "limit", 10, "")
+	list.Flags().StringSliceVar(&properties, "properties", nil, "")
+	list.Flags().BoolVar(&archived, "archived", false, "")
+	contacts.AddCommand(list)
+	rootCmd.AddCommand(contacts)
+
+	return list, &limit, &properties, &archived
+}
+
+func TestApplyDefaults(t *testing.T) {
+	defaults := map[string]map[string]interface{}{
+		"contacts list": {
+			"limit":      float64(50),
+			"properties": []interface{}{"email", "lifecyclestage"},
+			"archived":   true,
+		},
+	}
+
+	t.Run("fills unset flags", func(t *testing.T) {
+		cmd, limit, properties, archived := newListCmd(t)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		require.NoError(t, applyDefaults(cmd, defaults))
+		assert.Equal(t, 50, *limit)
+		assert.Equal(t, []string{"email", "lifecyclestage"}, *properties)
+		assert.True(t, *archived)
+		assert.False(t, cmd.Flags().Changed("limit"), "defaults must not mark flags as changed")
+	})
+
+	t.Run("command line wins", func(t *testing.T) {
+		cmd, limit, properties, _ := newListCmd(t)
+		require.NoError(t, cmd.ParseFlags([]string{"--limit", "5", "--properties", "firstname"}))
+
+		require.NoError(t, applyDefaults(cmd, defaults))
+		assert.Equal(t, 5, *limit)
+		assert.Equal(t, []string{"firstname"}, *properties)
+	})
+
+	t.Run("unknown flag names the key", func(t *testing.T) {
+		cmd, _, _, _ := newListCmd(t)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		err := applyDefaults(cmd, map[string]map[string]interface{}{
+			"contacts list": {"limt": float64(50)},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `config key "defaults.contacts list.limt"`)
+	})
+
+	t.Run("bad value names the key", func(t *testing.T) {
+		cmd, _, _, _ := newListCmd(t)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		err := applyDefaults(cmd, map[string]map[string]interface{}{
+			"contacts list": {"limit": "lots"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `config key "defaults.contacts list.limit"`)
+	})
+
+	t.Run("other commands are untouched", func(t *testing.T) {
+		cmd, limit, _, _ := newListCmd(t)
+		require.NoError(t, cmd.ParseFlags(nil))
+
+		require.NoError(t, applyDefaults(cmd, map[string]map[string]interface{}{
+			"deals list": {"limit": float64(50)},
+		}))
+		assert.Equal(t, 10, *limit)
+	})
+}
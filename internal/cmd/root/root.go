@@ -1,8 +1,13 @@
 package root
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,33 +19,213 @@ import (
 
 // Options contains global options for commands
 type Options struct {
-	Output  string
-	NoColor bool
-	Verbose bool
-	Stdin   io.Reader
-	Stdout  io.Writer
-	Stderr  io.Writer
+	Output     string
+	NoColor    bool
+	NoHeader   bool
+	Verbose    bool
+	Profile    string
+	CABundle   string
+	ClientCert string
+	ClientKey  string
+	Cache      time.Duration
+	MaxRetries int
+	Timeout    time.Duration
+	JQ         string
+	Template   string
+	UTC        bool
+	RawAmounts bool
+	APIBudget  int
+	Stdin      io.Reader
+	Stdout     io.Writer
+	Stderr     io.Writer
+
+	// OTelEndpoint is the OTLP/HTTP collector traces are exported to
+	OTelEndpoint string
+	// ReadOnly refuses API requests that change data
+	ReadOnly bool
+	// Plain limits output to uncolored ASCII without symbols
+	Plain bool
+
+	// budget is shared by every client of the command so --api-budget
+	// caps the whole run
+	budget *api.RequestBudget
+	// tracer is shared by every client of the command so the run is one
+	// trace
+	tracer *api.Tracer
 }
 
 // View returns a configured View instance
 func (o *Options) View() *view.View {
 	v := view.New(o.Output, o.NoColor)
+	v.NoHeader = o.NoHeader
+	v.JQ = o.JQ
+	v.Template = o.Template
 	v.Out = o.Stdout
 	v.Err = o.Stderr
+	v.Location, v.Currency = o.portalFormat()
+	if o.Plain {
+		v.UseASCII()
+	}
 	return v
 }
 
+// portalFormat returns the time zone and currency recorded for the active
+// profile, honoring --utc and --currency-raw
+func (o *Options) portalFormat() (*time.Location, string) {
+	var loc *time.Location
+	var currency string
+	if cfg, err := config.Load(); err == nil {
+		if p := cfg.ActiveProfile(); p != nil {
+			if p.TimeZone != "" {
+				loc, _ = time.LoadLocation(p.TimeZone)
+			}
+			currency = p.Currency
+		}
+	}
+	if o.UTC {
+		loc = time.UTC
+	}
+	if o.RawAmounts {
+		currency = ""
+	}
+	return loc, currency
+}
+
 // GetAccessToken returns the access token from config or environment
 func (o *Options) GetAccessToken() string {
 	return config.GetAccessToken()
 }
 
-// APIClient creates a new HubSpot API client from the current configuration
-func (o *Options) APIClient() (*api.Client, error) {
-	return api.New(api.ClientConfig{
+// ClientConfig returns the API client configuration derived from the global
+// flags and the stored access token or personal access key
+func (o *Options) ClientConfig() api.ClientConfig {
+	return api.ClientConfig{
 		AccessToken: config.GetAccessToken(),
 		Verbose:     o.Verbose,
-	})
+		CABundle:    o.CABundle,
+		ClientCert:  o.ClientCert,
+		ClientKey:   o.ClientKey,
+		CacheTTL:    o.Cache,
+		CacheDir:    config.CacheDir(),
+		MaxRetries:  o.MaxRetries,
+		Timeout:     o.Timeout,
+		Budget:      o.requestBudget(),
+		Tracer:      o.requestTracer(),
+		ReadOnly:    o.ReadOnly,
+		ASCII:       o.Plain,
+
+		PersonalAccessKey: config.GetPersonalAccessKey(),
+	}
+}
+
+// requestBudget returns the budget for --api-budget, or nil without one
+func (o *Options) requestBudget() *api.RequestBudget {
+	if o.APIBudget <= 0 {
+		return nil
+	}
+	if o.budget == nil {
+		o.budget = api.NewRequestBudget(o.APIBudget)
+	}
+	return o.budget
+}
+
+// BudgetExhausted reports whether the command stopped early because it
+// sent every request --api-budget allowed
+func (o *Options) BudgetExhausted() bool {
+	return o.budget.Exhausted()
+}
+
+// requestTracer returns the tracer for --otel-endpoint, or nil without one
+func (o *Options) requestTracer() *api.Tracer {
+	if o.OTelEndpoint == "" {
+		return nil
+	}
+	if o.tracer == nil {
+		o.tracer = api.NewTracer(o.OTelEndpoint, "hspt", version.Info())
+	}
+	return o.tracer
+}
+
+// ExportTrace sends the trace of the run to --otel-endpoint, with a span
+// for the command from start. Export problems are reported as warnings so
+// they never fail the command.
+func (o *Options) ExportTrace(cmd *cobra.Command, start time.Time, cmdErr error) {
+	tracer := o.requestTracer()
+	if tracer == nil {
+		return
+	}
+
+	v := o.View()
+	headers, err := api.ParseOTLPHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+	if err != nil {
+		v.Warning("Trace not exported: %v", err)
+		return
+	}
+	tracer.Headers = headers
+	tracer.HTTPClient, err = o.HTTPClient()
+	if err != nil {
+		v.Warning("Trace not exported: %v", err)
+		return
+	}
+
+	// The run's context may already be canceled by Ctrl-C, and an
+	// interrupted run is worth tracing too
+	ctx, cancel := context.WithTimeout(context.Background(), traceExportTimeout)
+	defer cancel()
+
+	name := "hspt"
+	if cmd != nil {
+		name = cmd.CommandPath()
+	}
+	if err := tracer.Export(ctx, name, start, cmdErr); err != nil {
+		v.Warning("Trace not exported: %v", err)
+	}
+}
+
+// traceExportTimeout bounds the trace export at the end of a run
+const traceExportTimeout = 10 * time.Second
+
+// APIClient creates a new HubSpot API client from the current configuration
+func (o *Options) APIClient() (*api.Client, error) {
+	cfg := o.ClientConfig()
+	if cfg.AccessToken == "" && cfg.PersonalAccessKey == "" {
+		// A broken config file or unknown profile reads as "no token";
+		// report why instead
+		stored, err := config.Load()
+		if err != nil {
+			return nil, err
+		}
+		if stored.ActiveProfile() == nil && len(stored.Profiles) > 0 {
+			return nil, fmt.Errorf("profile %q is not configured (available: %s)",
+				stored.ActiveProfileName(), strings.Join(stored.ProfileNames(), ", "))
+		}
+	}
+	return api.New(cfg)
+}
+
+// ProfileClient creates an API client for another configured profile, with
+// the same settings as APIClient, for commands that compare portals
+func (o *Options) ProfileClient(name string) (*api.Client, error) {
+	stored, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	p := stored.Profiles[name]
+	if p == nil || (p.AccessToken == "" && p.PersonalAccessKey == "") {
+		return nil, fmt.Errorf("profile %q is not configured (available: %s)",
+			name, strings.Join(stored.ProfileNames(), ", "))
+	}
+
+	cfg := o.ClientConfig()
+	cfg.AccessToken = p.AccessToken
+	cfg.PersonalAccessKey = p.PersonalAccessKey
+	return api.New(cfg)
+}
+
+// HTTPClient returns an HTTP client for hosts other than the HubSpot API,
+// honoring --ca-bundle, --client-cert, --timeout, and proxy settings
+func (o *Options) HTTPClient() (*http.Client, error) {
+	return api.NewHTTPClient(o.ClientConfig())
 }
 
 // NewCmd creates the root command and returns the options struct
@@ -56,17 +241,54 @@ func NewCmd() (*cobra.Command, *Options) {
 		Short:   "A CLI for HubSpot",
 		Long:    "hspt is a command-line interface for HubSpot CRM.",
 		Version: version.Info(),
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
-			// Setup is done in flag binding
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Setup is done in flag binding; config only fills in what the
+			// user did not pass explicitly
+			config.UseProfile(opts.Profile)
+
+			// Per-command defaults go first, so --jq and --template still
+			// switch a defaulted output format to JSON. A broken config
+			// file is reported by APIClient and "config validate"; it must
+			// not block those commands here.
+			if cfg, err := config.Load(); err == nil {
+				if err := applyDefaults(cmd, cfg.Defaults); err != nil {
+					return err
+				}
+			}
+
+			opts.Output = resolveOutput(cmd, opts.Output)
+			if err := resolveQuery(cmd, opts); err != nil {
+				return err
+			}
+			opts.Cache = resolveCache(cmd, opts.Cache)
+			opts.MaxRetries = resolveMaxRetries(cmd, opts.MaxRetries)
+			opts.ReadOnly = resolveReadOnly(cmd, opts.ReadOnly)
+			return nil
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
 
 	// Global flags - bound to opts struct
-	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "Output format: table, json, plain")
+	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "Output format: table, json, plain, csv")
+	cmd.PersistentFlags().StringVar(&opts.Template, "template", "", "Format JSON output with a Go template, applied to each record of a list, e.g. '{{.id}} {{.properties.email}}'")
+	cmd.PersistentFlags().StringVar(&opts.JQ, "jq", "", "Filter JSON output with a jq expression, e.g. '.results[].id'")
 	cmd.PersistentFlags().BoolVar(&opts.NoColor, "no-color", false, "Disable colored output")
+	cmd.PersistentFlags().BoolVar(&opts.Plain, "plain", false, "ASCII-only output without colors or symbols, for legacy terminals and log shippers")
+	cmd.PersistentFlags().BoolVar(&opts.NoHeader, "no-header", false, "Omit the header row from table and csv output")
+	cmd.PersistentFlags().BoolVar(&opts.UTC, "utc", false, "Show times in UTC instead of the portal time zone")
+	cmd.PersistentFlags().BoolVar(&opts.RawAmounts, "currency-raw", false, "Show amounts as plain numbers instead of in the portal currency")
 	cmd.PersistentFlags().BoolVarP(&opts.Verbose, "verbose", "v", false, "Enable verbose output")
+	cmd.PersistentFlags().StringVar(&opts.Profile, "profile", os.Getenv("HUBSPOT_PROFILE"), "Config profile to use (env: HUBSPOT_PROFILE)")
+	cmd.PersistentFlags().StringVar(&opts.CABundle, "ca-bundle", os.Getenv("HUBSPOT_CA_BUNDLE"), "PEM file of additional CA certificates to trust (env: HUBSPOT_CA_BUNDLE)")
+	cmd.PersistentFlags().StringVar(&opts.ClientCert, "client-cert", os.Getenv("HUBSPOT_CLIENT_CERT"), "PEM client certificate for mutual TLS (env: HUBSPOT_CLIENT_CERT)")
+	cmd.PersistentFlags().StringVar(&opts.ClientKey, "client-key", os.Getenv("HUBSPOT_CLIENT_KEY"), "PEM private key for --client-cert (env: HUBSPOT_CLIENT_KEY)")
+	cmd.PersistentFlags().DurationVar(&opts.Cache, "cache", 0, "Serve repeated read requests from a local cache for this long, e.g. 5m (default from config cache_ttl; 0 disables)")
+	cmd.PersistentFlags().DurationVar(&opts.Timeout, "timeout", api.DefaultTimeout, "Timeout for each HTTP request, e.g. 90s (0 disables)")
+	cmd.PersistentFlags().IntVar(&opts.APIBudget, "api-budget", 0, "Stop after this many API requests, leaving the rest of the portal's daily limit to other integrations (0 for no limit)")
+	cmd.PersistentFlags().BoolVar(&opts.ReadOnly, "read-only", false, "Refuse API requests that change data; searches and other reads still work (default from config read_only)")
+	cmd.PersistentFlags().StringVar(&opts.OTelEndpoint, "otel-endpoint", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"), "Export a trace of the run's API requests to this OpenTelemetry OTLP/HTTP collector, e.g. http://localhost:4318 (env: OTEL_EXPORTER_OTLP_ENDPOINT)")
+	cmd.PersistentFlags().IntVar(&opts.MaxRetries, "max-retries", api.DefaultMaxRetries, "Retry rate-limited (429) requests, and failed (5xx) reads, updates, and deletes, this many times with backoff (default from config max_retries; 0 disables)")
 
 	return cmd, opts
 }
@@ -81,15 +303,137 @@ func RegisterCommands(root *cobra.Command, opts *Options, registrars ...func(*co
 // GetOptions extracts Options from a root command
 func GetOptions(cmd *cobra.Command) *Options {
 	output, _ := cmd.Root().PersistentFlags().GetString("output")
+	output = resolveOutput(cmd, output)
 	noColor, _ := cmd.Root().PersistentFlags().GetBool("no-color")
+	plain, _ := cmd.Root().PersistentFlags().GetBool("plain")
+	noHeader, _ := cmd.Root().PersistentFlags().GetBool("no-header")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+	profile, _ := cmd.Root().PersistentFlags().GetString("profile")
+	config.UseProfile(profile)
+	caBundle, _ := cmd.Root().PersistentFlags().GetString("ca-bundle")
+	clientCert, _ := cmd.Root().PersistentFlags().GetString("client-cert")
+	clientKey, _ := cmd.Root().PersistentFlags().GetString("client-key")
+	cache, _ := cmd.Root().PersistentFlags().GetDuration("cache")
+	cache = resolveCache(cmd, cache)
+	maxRetries, _ := cmd.Root().PersistentFlags().GetInt("max-retries")
+	maxRetries = resolveMaxRetries(cmd, maxRetries)
+	timeout, _ := cmd.Root().PersistentFlags().GetDuration("timeout")
+	jq, _ := cmd.Root().PersistentFlags().GetString("jq")
+	tmpl, _ := cmd.Root().PersistentFlags().GetString("template")
+	utc, _ := cmd.Root().PersistentFlags().GetBool("utc")
+	rawAmounts, _ := cmd.Root().PersistentFlags().GetBool("currency-raw")
+	apiBudget, _ := cmd.Root().PersistentFlags().GetInt("api-budget")
+	otelEndpoint, _ := cmd.Root().PersistentFlags().GetString("otel-endpoint")
+	readOnly, _ := cmd.Root().PersistentFlags().GetBool("read-only")
+	readOnly = resolveReadOnly(cmd, readOnly)
+	if jq != "" || tmpl != "" {
+		output = string(view.FormatJSON)
+	}
 
 	return &Options{
-		Output:  output,
-		NoColor: noColor,
-		Verbose: verbose,
-		Stdin:   os.Stdin,
-		Stdout:  os.Stdout,
-		Stderr:  os.Stderr,
+		Output:     output,
+		NoColor:    noColor,
+		NoHeader:   noHeader,
+		Verbose:    verbose,
+		Profile:    profile,
+		CABundle:   caBundle,
+		ClientCert: clientCert,
+		ClientKey:  clientKey,
+		Cache:      cache,
+		MaxRetries: maxRetries,
+		Timeout:    timeout,
+		JQ:         jq,
+		Template:   tmpl,
+		UTC:        utc,
+		RawAmounts: rawAmounts,
+		APIBudget:  apiBudget,
+		Stdin:      os.Stdin,
+		Stdout:     os.Stdout,
+		Stderr:     os.Stderr,
+
+		OTelEndpoint: otelEndpoint,
+		ReadOnly:     readOnly,
+		Plain:        plain,
+	}
+}
+
+// resolveOutput returns the configured default output format when --output
+// was not set on the command line or for the command
+func resolveOutput(cmd *cobra.Command, output string) string {
+	if explicit(cmd, "output") {
+		return output
+	}
+	cfg, err := config.Load()
+	if err != nil || cfg.Output == "" {
+		return output
+	}
+	return cfg.Output
+}
+
+// resolveQuery validates --jq and --template and switches output to JSON,
+// which both of them shape
+func resolveQuery(cmd *cobra.Command, opts *Options) error {
+	if opts.JQ == "" && opts.Template == "" {
+		return nil
+	}
+	if opts.JQ != "" && opts.Template != "" {
+		return fmt.Errorf("--jq and --template cannot be used together")
+	}
+	if cmd.Flags().Changed("output") && opts.Output != string(view.FormatJSON) {
+		return fmt.Errorf("--jq and --template work on JSON output and cannot be combined with --output %s", opts.Output)
+	}
+
+	if opts.JQ != "" {
+		if err := view.CheckJQ(opts.JQ); err != nil {
+			return err
+		}
+	} else if err := view.CheckTemplate(opts.Template); err != nil {
+		return err
+	}
+
+	opts.Output = string(view.FormatJSON)
+	return nil
+}
+
+// resolveCache returns the configured cache_ttl when --cache was not set on
+// the command line or for the command
+func resolveCache(cmd *cobra.Command, ttl time.Duration) time.Duration {
+	if explicit(cmd, "cache") {
+		return ttl
+	}
+	cfg, err := config.Load()
+	if err != nil || cfg.CacheTTL == "" {
+		return ttl
+	}
+	d, err := time.ParseDuration(cfg.CacheTTL)
+	if err != nil {
+		return ttl
+	}
+	return d
+}
+
+// resolveMaxRetries returns the configured max_retries when --max-retries was
+// not set on the command line or for the command
+func resolveMaxRetries(cmd *cobra.Command, retries int) int {
+	if explicit(cmd, "max-retries") {
+		return retries
+	}
+	cfg, err := config.Load()
+	if err != nil || cfg.MaxRetries == nil {
+		return retries
+	}
+	return *cfg.MaxRetries
+}
+
+// resolveReadOnly returns the configured read_only when --read-only was not
+// set on the command line or for the command
+func resolveReadOnly(cmd *cobra.Command, readOnly bool) bool {
+	if explicit(cmd, "read-only") {
+		return readOnly
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return readOnly
 	}
+	return cfg.ReadOnly
 }
@@ -0,0 +1,50 @@
+package root
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+func TestNewCmd_TemplateDoesNotCollideWithLocalFormat(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	rootCmd, opts := NewCmd()
+	var format string
+	export := &cobra.Command{Use: "export", Run: func(*cobra.Command, []string) {}}
+	export.Flags().StringVar(&format, "format", "markdown", "")
+	rootCmd.AddCommand(export)
+
+	rootCmd.SetArgs([]string{"export", "--format", "csv", "--template", "{{.id}}"})
+	require.NoError(t, rootCmd.Execute())
+
+	assert.Equal(t, "csv", format)
+	assert.Equal(t, "{{.id}}", opts.Template)
+	assert.Equal(t, "json", opts.Output)
+}
+
+func TestNewCmd_DefaultsBeforeOutputResolution(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, config.Save(&config.Config{
+		Output:   "plain",
+		Defaults: map[string]map[string]interface{}{"export": {"output": "csv"}},
+	}))
+
+	run := func(args ...string) *Options {
+		rootCmd, opts := NewCmd()
+		rootCmd.AddCommand(&cobra.Command{Use: "export", Run: func(*cobra.Command, []string) {}})
+		rootCmd.SetArgs(args)
+		require.NoError(t, rootCmd.Execute())
+		return opts
+	}
+
+	assert.Equal(t, "csv", run("export").Output, "the command default beats the profile-wide output")
+	assert.Equal(t, "json", run("export", "--jq", ".").Output, "--jq still switches a defaulted output to JSON")
+	assert.Equal(t, "table", run("export", "-o", "table").Output)
+}
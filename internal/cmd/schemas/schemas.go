@@ -32,6 +32,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -50,10 +51,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListSchemas(api.ListOptions{
+			result, err := client.ListSchemas(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -93,6 +94,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of schemas to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -113,7 +116,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			schema, err := client.GetSchema(fqn)
+			schema, err := client.GetSchema(cmd.Context(), fqn)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Schema %s not found", fqn)
@@ -131,8 +134,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Plural Label", schema.Labels.Plural},
 				{"Primary Display Property", schema.PrimaryDisplayProperty},
 				{"Archived", shared.FormatBool(schema.Archived)},
-				{"Created", schema.CreatedAt},
-				{"Updated", schema.UpdatedAt},
+				{"Created", v.Time(schema.CreatedAt)},
+				{"Updated", v.Time(schema.UpdatedAt)},
 			}
 
 			if len(schema.Properties) > 0 {
@@ -183,7 +186,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			schema, err := client.CreateSchema(schemaData)
+			schema, err := client.CreateSchema(cmd.Context(), schemaData)
 			if err != nil {
 				return err
 			}
@@ -225,7 +228,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteSchema(fqn)
+			err = client.DeleteSchema(cmd.Context(), fqn)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Schema %s not found", fqn)
@@ -0,0 +1,33 @@
+package search
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the top-level search command
+func Register(parent *cobra.Command, opts *root.Options) {
+	parent.AddCommand(shared.NewSearchCmd(opts, shared.SearchCmdConfig{
+		Short: "Search records of any CRM object type",
+		Long: `Search records of any CRM object type, including custom objects, with
+property filters, sorting, and paging.
+
+Filters take the forms prop=value, prop>=value, prop:OPERATOR:value, or
+'prop OPERATOR value', with the operators EQ, NEQ, GT, GTE, LT, LTE, BETWEEN,
+IN, NOT_IN, HAS_PROPERTY, NOT_HAS_PROPERTY, CONTAINS_TOKEN, and
+NOT_CONTAINS_TOKEN. Repeated --filter flags must all match.
+
+Without --properties the table shows the properties HubSpot returns by
+default for the object type.`,
+		Example: `  # Open deals over 10k, largest first
+  hspt search --type deals --filter "amount GT 10000" --filter "hs_is_closed EQ false" --sort amount:desc
+
+  # Contacts with no owner
+  hspt search --type contacts --filter "hubspot_owner_id NOT_HAS_PROPERTY" --properties email,createdate
+
+  # Next page of results
+  hspt search --type deals --filter "amount GT 10000" --after 10`,
+	}))
+}
@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// associateTypes maps the object names accepted by --associate, singular or
+// plural, to their object types
+var associateTypes = map[string]api.ObjectType{
+	"contact":   api.ObjectTypeContacts,
+	"contacts":  api.ObjectTypeContacts,
+	"company":   api.ObjectTypeCompanies,
+	"companies": api.ObjectTypeCompanies,
+	"deal":      api.ObjectTypeDeals,
+	"deals":     api.ObjectTypeDeals,
+	"ticket":    api.ObjectTypeTickets,
+	"tickets":   api.ObjectTypeTickets,
+}
+
+// AssociateType returns the object type for a record type name accepted by
+// --associate and "hspt context", singular or plural
+func AssociateType(name string) (api.ObjectType, bool) {
+	t, ok := associateTypes[strings.ToLower(strings.TrimSpace(name))]
+	return t, ok
+}
+
+// AddAssociateFlag registers the repeatable --associate flag, and
+// --no-context to skip the record context, on an engagement create command
+func AddAssociateFlag(cmd *cobra.Command, values *[]string) {
+	cmd.Flags().StringArrayVar(values, "associate", nil, "Record to associate as type:id or type:name, e.g. contacts:123 or contacts:jane@example.com (repeatable)")
+	cmd.Flags().Bool("no-context", false, `Do not associate the records set with "hspt context set"`)
+}
+
+// WithRecordContext adds the records of the active profile's context (see
+// "hspt context") to --associate values, unless --no-context was given. It
+// returns all values and, separately, the ones taken from the context.
+func WithRecordContext(cmd *cobra.Command, values []string) ([]string, []string) {
+	if skip, _ := cmd.Flags().GetBool("no-context"); skip {
+		return values, nil
+	}
+	// A broken config file is reported when the API client is created
+	cfg, err := config.Load()
+	if err != nil {
+		return values, nil
+	}
+	p := cfg.ActiveProfile()
+	if p == nil {
+		return values, nil
+	}
+	return mergeContext(values, p.Context)
+}
+
+// mergeContext appends a type:id value for each context record whose type
+// is not already among values
+func mergeContext(values []string, context map[string]string) ([]string, []string) {
+	given := make(map[api.ObjectType]bool)
+	for _, raw := range values {
+		for _, value := range strings.Split(raw, ",") {
+			name, _, _ := strings.Cut(value, ":")
+			if t, ok := AssociateType(name); ok {
+				given[t] = true
+			}
+		}
+	}
+
+	types := make([]string, 0, len(context))
+	for name := range context {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+
+	all := append([]string{}, values...)
+	var added []string
+	for _, name := range types {
+		t, ok := AssociateType(name)
+		if !ok || given[t] || context[name] == "" {
+			continue
+		}
+		value := string(t) + ":" + context[name]
+		all = append(all, value)
+		added = append(added, value)
+	}
+	return all, added
+}
+
+// ParseAssociations turns --associate values of the form type:id into the
+// associations block of a create request for an engagement of type from.
+// Several values may be joined with commas (e.g. "contacts:1,deals:2").
+func ParseAssociations(from api.ObjectType, values []string) ([]api.ObjectAssociation, error) {
+	var associations []api.ObjectAssociation
+
+	for _, raw := range values {
+		for _, value := range strings.Split(raw, ",") {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+
+			parts := strings.SplitN(value, ":", 2)
+			if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+				return nil, fmt.Errorf("invalid --associate %q: expected type:id, e.g. contacts:123", value)
+			}
+
+			to, ok := AssociateType(parts[0])
+			if !ok {
+				return nil, fmt.Errorf("invalid --associate %q: type must be contacts, companies, deals, or tickets", value)
+			}
+
+			spec, ok := api.DefaultAssociationType(from, to)
+			if !ok {
+				return nil, fmt.Errorf("no default association from %s to %s", from, to)
+			}
+
+			associations = append(associations, api.NewObjectAssociation(strings.TrimSpace(parts[1]), spec))
+		}
+	}
+
+	return associations, nil
+}
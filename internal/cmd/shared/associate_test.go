@@ -0,0 +1,71 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseAssociations(t *testing.T) {
+	t.Run("type IDs per engagement", func(t *testing.T) {
+		got, err := ParseAssociations(api.ObjectTypeNotes, []string{"contacts:123", "deal:456,Tickets:789"})
+		require.NoError(t, err)
+
+		hubspot := api.AssociationCategoryHubSpot
+		assert.Equal(t, []api.ObjectAssociation{
+			api.NewObjectAssociation("123", api.AssociationSpec{Category: hubspot, TypeID: 202}),
+			api.NewObjectAssociation("456", api.AssociationSpec{Category: hubspot, TypeID: 214}),
+			api.NewObjectAssociation("789", api.AssociationSpec{Category: hubspot, TypeID: 228}),
+		}, got)
+
+		got, err = ParseAssociations(api.ObjectTypeTasks, []string{"companies:1"})
+		require.NoError(t, err)
+		assert.Equal(t, 192, got[0].Types[0].TypeID)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		got, err := ParseAssociations(api.ObjectTypeCalls, nil)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, value := range []string{"contacts", "contacts:", "widgets:1"} {
+			_, err := ParseAssociations(api.ObjectTypeMeetings, []string{value})
+			assert.Error(t, err, value)
+		}
+
+		_, err := ParseAssociations(api.ObjectTypeProducts, []string{"contacts:1"})
+		assert.Error(t, err)
+	})
+}
+
+func TestMergeContext(t *testing.T) {
+	context := map[string]string{"deals": "123", "contacts": "456"}
+
+	t.Run("adds context records", func(t *testing.T) {
+		all, added := mergeContext(nil, context)
+		assert.Equal(t, []string{"contacts:456", "deals:123"}, all)
+		assert.Equal(t, []string{"contacts:456", "deals:123"}, added)
+	})
+
+	t.Run("explicit type wins", func(t *testing.T) {
+		all, added := mergeContext([]string{"contact:9", "companies:1"}, context)
+		assert.Equal(t, []string{"contact:9", "companies:1", "deals:123"}, all)
+		assert.Equal(t, []string{"deals:123"}, added)
+	})
+
+	t.Run("comma-joined values", func(t *testing.T) {
+		_, added := mergeContext([]string{"companies:1,deals:2"}, context)
+		assert.Equal(t, []string{"contacts:456"}, added)
+	})
+
+	t.Run("no context", func(t *testing.T) {
+		all, added := mergeContext([]string{"deals:1"}, nil)
+		assert.Equal(t, []string{"deals:1"}, all)
+		assert.Nil(t, added)
+	})
+}
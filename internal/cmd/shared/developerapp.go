@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// DeveloperApp holds the --app-id and --developer-key flag values of
+// commands that manage a public app through its developer account
+type DeveloperApp struct {
+	ID  string
+	Key string
+}
+
+// AddDeveloperAppFlags registers --app-id and --developer-key as persistent
+// flags, defaulting to HUBSPOT_APP_ID and HUBSPOT_DEVELOPER_API_KEY
+func AddDeveloperAppFlags(cmd *cobra.Command, a *DeveloperApp) {
+	cmd.PersistentFlags().StringVar(&a.ID, "app-id", os.Getenv("HUBSPOT_APP_ID"), "Public app ID (env: HUBSPOT_APP_ID)")
+	cmd.PersistentFlags().StringVar(&a.Key, "developer-key", os.Getenv("HUBSPOT_DEVELOPER_API_KEY"), "Developer API key (env: HUBSPOT_DEVELOPER_API_KEY)")
+}
+
+// Client returns an API client authenticated with the developer API key.
+// App APIs belong to the developer account, not a portal, so the profile's
+// access token is not used.
+func (a *DeveloperApp) Client(opts *root.Options) (*api.Client, error) {
+	if a.ID == "" {
+		return nil, fmt.Errorf("--app-id is required (or set HUBSPOT_APP_ID)")
+	}
+	if a.Key == "" {
+		return nil, fmt.Errorf("a developer API key is required: use --developer-key or set HUBSPOT_DEVELOPER_API_KEY")
+	}
+
+	cfg := opts.ClientConfig()
+	cfg.AccessToken = ""
+	cfg.DeveloperAPIKey = a.Key
+	return api.New(cfg)
+}
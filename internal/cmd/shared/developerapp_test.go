@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+func TestAddDeveloperAppFlags(t *testing.T) {
+	t.Setenv("HUBSPOT_APP_ID", "123456")
+	t.Setenv("HUBSPOT_DEVELOPER_API_KEY", "env-key")
+
+	var a DeveloperApp
+	cmd := &cobra.Command{Use: "test"}
+	AddDeveloperAppFlags(cmd, &a)
+	assert.Equal(t, DeveloperApp{ID: "123456", Key: "env-key"}, a)
+
+	require.NoError(t, cmd.PersistentFlags().Parse([]string{"--developer-key", "flag-key"}))
+	assert.Equal(t, "flag-key", a.Key)
+}
+
+func TestDeveloperApp_Client(t *testing.T) {
+	t.Setenv("HUBSPOT_ACCESS_TOKEN", "portal-token")
+	opts := &root.Options{}
+
+	_, err := (&DeveloperApp{Key: "dev-key"}).Client(opts)
+	assert.ErrorContains(t, err, "--app-id is required")
+
+	_, err = (&DeveloperApp{ID: "123456"}).Client(opts)
+	assert.ErrorContains(t, err, "developer API key is required")
+
+	client, err := (&DeveloperApp{ID: "123456", Key: "dev-key"}).Client(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "dev-key", client.DeveloperAPIKey)
+	assert.Empty(t, client.AccessToken, "the portal token is not sent to developer APIs")
+}
@@ -0,0 +1,186 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// DiffCmdConfig describes the object-specific pieces of a `diff`
+// subcommand. Fetching both records and comparing them are shared across
+// object types.
+type DiffCmdConfig struct {
+	// ObjectType is the HubSpot CRM object type to compare.
+	ObjectType api.ObjectType
+	// Noun is the singular human-readable object name (e.g. "contact") used
+	// in messages.
+	Noun string
+	// Properties are compared when --properties is not given.
+	Properties []string
+	// Example is the cobra command example text.
+	Example string
+}
+
+// Property diff states
+const (
+	diffSame    = "same"
+	diffChanged = "differs"
+	diffOnlyA   = "only first"
+	diffOnlyB   = "only second"
+)
+
+// propertyDiff is one property of a record diff
+type propertyDiff struct {
+	Name   string `json:"name"`
+	Type   string `json:"-"`
+	A      string `json:"a"`
+	B      string `json:"b"`
+	Status string `json:"status"`
+}
+
+// recordDiff is the JSON output of diff
+type recordDiff struct {
+	A           string         `json:"a"`
+	B           string         `json:"b"`
+	Compared    int            `json:"compared"`
+	Differences int            `json:"differences"`
+	Properties  []propertyDiff `json:"properties"`
+}
+
+// diffRecords compares the named properties of two records, skipping
+// properties neither has a value for. Identical values are only included
+// with all. types maps property names to their types, when known.
+func diffRecords(names []string, types map[string]string, a, b *api.CRMObject, all bool) recordDiff {
+	d := recordDiff{A: a.ID, B: b.ID, Properties: []propertyDiff{}}
+	for _, name := range names {
+		va, vb := a.GetProperty(name), b.GetProperty(name)
+		if va == "" && vb == "" {
+			continue
+		}
+		d.Compared++
+
+		status := diffSame
+		switch {
+		case va == vb:
+		case vb == "":
+			status = diffOnlyA
+		case va == "":
+			status = diffOnlyB
+		default:
+			status = diffChanged
+		}
+		if status != diffSame {
+			d.Differences++
+		} else if !all {
+			continue
+		}
+		d.Properties = append(d.Properties, propertyDiff{Name: name, Type: types[name], A: va, B: vb, Status: status})
+	}
+	return d
+}
+
+// NewDiffCmd builds a `diff <id> <id>` subcommand that compares two records
+// property by property
+func NewDiffCmd(opts *root.Options, cfg DiffCmdConfig) *cobra.Command {
+	var properties []string
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "diff <id> <id>",
+		Short: "Compare two " + string(cfg.ObjectType) + " property by property",
+		Long: `Fetch two ` + string(cfg.ObjectType) + ` and show the properties whose values differ, to decide
+which way to merge duplicates or to debug sync discrepancies.
+
+--properties all compares every property, including read-only and
+calculated ones. Properties neither record has a value for are skipped, and
+--all also lists the properties whose values match.`,
+		Example: cfg.Example,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			idA, idB := args[0], args[1]
+			if idA == idB {
+				return fmt.Errorf("compare two different %s", cfg.ObjectType)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			names := properties
+			types := make(map[string]string)
+			if len(names) == 0 {
+				names = cfg.Properties
+			} else if len(names) == 1 && strings.EqualFold(names[0], "all") {
+				defs, err := client.ListProperties(cmd.Context(), cfg.ObjectType)
+				if err != nil {
+					return fmt.Errorf("failed to list properties: %w", err)
+				}
+				names = make([]string, 0, len(defs.Results))
+				for _, p := range defs.Results {
+					names = append(names, p.Name)
+					types[p.Name] = p.Type
+				}
+				sort.Strings(names)
+			}
+
+			batch, err := client.BatchReadObjects(cmd.Context(), cfg.ObjectType, []string{idA, idB}, names)
+			if err != nil {
+				return fmt.Errorf("failed to read %ss: %w", cfg.Noun, err)
+			}
+			records := make(map[string]*api.CRMObject, len(batch.Results))
+			for i := range batch.Results {
+				records[batch.Results[i].ID] = &batch.Results[i]
+			}
+			for _, id := range []string{idA, idB} {
+				if records[id] == nil {
+					v.Error("%s %s not found", capitalize(cfg.Noun), id)
+					return nil
+				}
+			}
+
+			d := diffRecords(names, types, records[idA], records[idB], all)
+
+			headers := []string{"PROPERTY", strings.ToUpper(cfg.Noun) + " " + idA, strings.ToUpper(cfg.Noun) + " " + idB, "STATUS"}
+			rows := make([][]string, 0, len(d.Properties))
+			for _, p := range d.Properties {
+				rows = append(rows, []string{p.Name, diffValue(v, p.Type, p.A), diffValue(v, p.Type, p.B), p.Status})
+			}
+
+			if len(rows) > 0 || v.Format == view.FormatJSON {
+				if err := v.Render(headers, rows, d); err != nil {
+					return err
+				}
+			}
+			if d.Differences == 0 {
+				v.Info("No differences in %d compared properties", d.Compared)
+			} else {
+				v.Info("%d of %d compared properties differ", d.Differences, d.Compared)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, `Properties to compare (comma-separated), or "all"`)
+	cmd.Flags().BoolVar(&all, "all", false, "Also list properties whose values match")
+
+	return cmd
+}
+
+// diffValue formats a value for the diff table
+func diffValue(v *view.View, typ, value string) string {
+	if typ == "datetime" {
+		return v.Time(value)
+	}
+	if len(value) > previewWidth {
+		return value[:previewWidth-3] + "..."
+	}
+	return value
+}
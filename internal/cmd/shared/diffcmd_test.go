@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+func TestDiffRecords(t *testing.T) {
+	a := &api.CRMObject{ID: "111", Properties: map[string]interface{}{
+		"email": "jane@example.com", "firstname": "Jane", "phone": "555-0100", "city": "Berlin",
+	}}
+	b := &api.CRMObject{ID: "222", Properties: map[string]interface{}{
+		"email": "jane.doe@example.com", "firstname": "Jane", "company": "Acme", "city": "Berlin",
+	}}
+	names := []string{"email", "firstname", "lastname", "phone", "company", "city"}
+
+	d := diffRecords(names, nil, a, b, false)
+	assert.Equal(t, "111", d.A)
+	assert.Equal(t, "222", d.B)
+	assert.Equal(t, 5, d.Compared)
+	assert.Equal(t, 3, d.Differences)
+	assert.Equal(t, []propertyDiff{
+		{Name: "email", A: "jane@example.com", B: "jane.doe@example.com", Status: diffChanged},
+		{Name: "phone", A: "555-0100", Status: diffOnlyA},
+		{Name: "company", B: "Acme", Status: diffOnlyB},
+	}, d.Properties)
+
+	all := diffRecords(names, nil, a, b, true)
+	assert.Len(t, all.Properties, 5)
+	assert.Equal(t, diffSame, all.Properties[1].Status)
+}
+
+func TestDiffValue(t *testing.T) {
+	v := view.New("table", true)
+	v.Location = time.UTC
+
+	assert.Equal(t, "2024-01-15 10:00 UTC", diffValue(v, "datetime", "2024-01-15T10:00:00Z"))
+	assert.Equal(t, "2024-01-15T10:00:00Z", diffValue(v, "", "2024-01-15T10:00:00Z"))
+
+	long := strings.Repeat("x", 60)
+	assert.Equal(t, strings.Repeat("x", previewWidth-3)+"...", diffValue(v, "string", long))
+}
@@ -1,5 +1,11 @@
 package shared
 
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
 // FormatBool returns "Yes" or "No" for boolean values.
 // Used for human-readable output in table views.
 func FormatBool(b bool) string {
@@ -8,3 +14,11 @@ func FormatBool(b bool) string {
 	}
 	return "No"
 }
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// PlainText turns an HTML body, such as a note's, into one line of text.
+func PlainText(s string) string {
+	s = htmlTag.ReplaceAllString(s, " ")
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
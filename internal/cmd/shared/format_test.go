@@ -21,3 +21,20 @@ func TestFormatBool(t *testing.T) {
 		})
 	}
 }
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"<div><p>Hello</p>\n<p>world &amp; more</p></div>", "Hello world & more"},
+		{"Plain  text\n", "Plain text"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := PlainText(tt.input); got != tt.want {
+			t.Errorf("PlainText(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// PropertyHistory holds the --with-history flag value shared by get commands
+type PropertyHistory struct {
+	Properties []string
+}
+
+// AddHistoryFlag registers --with-history on a get command
+func AddHistoryFlag(cmd *cobra.Command, h *PropertyHistory) {
+	cmd.Flags().StringSliceVar(&h.Properties, "with-history", nil, "Show the change log of these properties: when each value was set and by what (comma-separated)")
+}
+
+// HistoryRows returns a property's change log as table rows, newest first:
+// when, the value, the kind of source, and the source (its label, falling
+// back to its ID, such as the user or workflow that made the change)
+func HistoryRows(v *view.View, history []api.PropertyHistoryEntry) [][]string {
+	rows := make([][]string, 0, len(history))
+	for _, h := range history {
+		source := h.SourceLabel
+		if source == "" {
+			source = h.SourceID
+		}
+		rows = append(rows, []string{v.Time(h.Timestamp), h.Value, h.SourceType, source})
+	}
+	return rows
+}
+
+// Render prints the change log of each --with-history property after a get
+// command's table. JSON output has nothing to add: the history is already
+// in the record's propertiesWithHistory.
+func (h PropertyHistory) Render(v *view.View, obj *api.CRMObject) error {
+	if len(h.Properties) == 0 || v.Format == view.FormatJSON {
+		return nil
+	}
+
+	for _, name := range h.Properties {
+		v.PrintlnStatus("")
+		history := obj.PropertiesWithHistory[name]
+		if len(history) == 0 {
+			v.Info("%s has no recorded changes", name)
+			continue
+		}
+		v.Info("%s history:", name)
+		if err := v.Render([]string{"CHANGED", "VALUE", "SOURCE TYPE", "SOURCE"}, HistoryRows(v, history), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
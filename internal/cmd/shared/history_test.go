@@ -0,0 +1,51 @@
+package shared
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+func TestHistoryRows(t *testing.T) {
+	v := &view.View{}
+	rows := HistoryRows(v, []api.PropertyHistoryEntry{
+		{Value: "closedwon", Timestamp: "2025-03-02T10:00:00Z", SourceType: "CRM_UI", SourceID: "userId:42", SourceLabel: "Ada Lovelace"},
+		{Value: "qualifiedtobuy", Timestamp: "2025-02-01T09:00:00Z", SourceType: "AUTOMATION_PLATFORM", SourceID: "enrollmentId:9"},
+	})
+
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{v.Time("2025-03-02T10:00:00Z"), "closedwon", "CRM_UI", "Ada Lovelace"}, rows[0])
+	assert.Equal(t, []string{v.Time("2025-02-01T09:00:00Z"), "qualifiedtobuy", "AUTOMATION_PLATFORM", "enrollmentId:9"}, rows[1])
+}
+
+func TestPropertyHistory_Render(t *testing.T) {
+	obj := &api.CRMObject{
+		ID: "1",
+		PropertiesWithHistory: map[string][]api.PropertyHistoryEntry{
+			"dealstage": {{Value: "closedwon", Timestamp: "2025-03-02T10:00:00Z", SourceType: "CRM_UI"}},
+		},
+	}
+
+	var out, errOut bytes.Buffer
+	v := &view.View{Out: &out, Err: &errOut, Format: view.FormatTable}
+	require.NoError(t, PropertyHistory{Properties: []string{"dealstage", "amount"}}.Render(v, obj))
+	assert.Contains(t, errOut.String(), "dealstage history:")
+	assert.Contains(t, errOut.String(), "amount has no recorded changes")
+	assert.Contains(t, out.String(), "closedwon")
+	assert.Contains(t, out.String(), "SOURCE TYPE")
+
+	out.Reset()
+	errOut.Reset()
+	v.Format = view.FormatJSON
+	require.NoError(t, PropertyHistory{Properties: []string{"dealstage"}}.Render(v, obj))
+	assert.Empty(t, out.String(), "JSON output already has the history")
+
+	v.Format = view.FormatTable
+	require.NoError(t, PropertyHistory{}.Render(v, obj))
+	assert.Empty(t, out.String())
+}
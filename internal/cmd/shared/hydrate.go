@@ -0,0 +1,150 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// hydrateAssociations is the --hydrate value that adds association columns
+const hydrateAssociations = "associations"
+
+// hydrateColumn is a column added by --hydrate associations: the names of
+// associated records when NameProperty is set, otherwise how many there are
+type hydrateColumn struct {
+	Header string
+	api.AssociationQuery
+}
+
+// hydrateColumns are the association columns of each list command
+var hydrateColumns = map[api.ObjectType][]hydrateColumn{
+	api.ObjectTypeContacts: {
+		{Header: "COMPANIES", AssociationQuery: api.AssociationQuery{ToType: api.ObjectTypeCompanies, NameProperty: "name"}},
+		{Header: "DEALS", AssociationQuery: api.AssociationQuery{ToType: api.ObjectTypeDeals}},
+	},
+	api.ObjectTypeCompanies: {
+		{Header: "CONTACTS", AssociationQuery: api.AssociationQuery{ToType: api.ObjectTypeContacts}},
+		{Header: "DEALS", AssociationQuery: api.AssociationQuery{ToType: api.ObjectTypeDeals}},
+	},
+	api.ObjectTypeDeals: {
+		{Header: "COMPANIES", AssociationQuery: api.AssociationQuery{ToType: api.ObjectTypeCompanies, NameProperty: "name"}},
+		{Header: "CONTACTS", AssociationQuery: api.AssociationQuery{ToType: api.ObjectTypeContacts}},
+	},
+}
+
+// Hydration holds the --hydrate flag value shared by list commands
+type Hydration struct {
+	Value string
+}
+
+// AddHydrateFlag registers --hydrate on a list command
+func AddHydrateFlag(cmd *cobra.Command, h *Hydration) {
+	cmd.Flags().StringVar(&h.Value, "hydrate", "", `Decorate rows with related data ("associations" adds associated record names and counts)`)
+}
+
+// Enabled reports whether rows are to be decorated, rejecting unknown
+// values before anything is fetched
+func (h Hydration) Enabled() (bool, error) {
+	switch h.Value {
+	case "":
+		return false, nil
+	case hydrateAssociations:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown --hydrate value %q (supported: %s)", h.Value, hydrateAssociations)
+	}
+}
+
+// HydratedRecord is a record with its association summaries, for JSON output
+type HydratedRecord struct {
+	api.CRMObject
+	Associations map[api.ObjectType]api.AssociationSummary `json:"associationSummary"`
+}
+
+// HydratedList is a list of records with association summaries
+type HydratedList struct {
+	Results []HydratedRecord `json:"results"`
+	Paging  *api.Paging      `json:"paging,omitempty"`
+}
+
+// Apply appends the association columns of objectType to a list table and
+// returns the headers, rows, and JSON data to render. Summaries are fetched
+// with one GraphQL query per 100 records; when GraphQL is unavailable, such
+// as for tokens without its scope, they are fetched with the REST
+// associations API instead, one request per record.
+func (h Hydration) Apply(ctx context.Context, v *view.View, client *api.Client, objectType api.ObjectType, result *api.CRMObjectList, headers []string, rows [][]string) ([]string, [][]string, interface{}, error) {
+	columns := hydrateColumns[objectType]
+	queries := make([]api.AssociationQuery, 0, len(columns))
+	for _, c := range columns {
+		queries = append(queries, c.AssociationQuery)
+	}
+
+	ids := make([]string, 0, len(result.Results))
+	for _, obj := range result.Results {
+		ids = append(ids, obj.ID)
+	}
+
+	summaries := make(api.AssociationSummaries, len(ids))
+	rest := false
+	for start := 0; start < len(ids); start += api.MaxBatchSize {
+		end := start + api.MaxBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+
+		var page api.AssociationSummaries
+		var err error
+		if !rest {
+			page, err = client.SummarizeAssociations(ctx, objectType, chunk, queries)
+			if err != nil && ctx.Err() == nil {
+				v.Warning("GraphQL unavailable (%v); fetching associations with one request per record", err)
+				rest = true
+			}
+		}
+		if rest {
+			page, err = client.SummarizeAssociationsREST(ctx, objectType, chunk, queries)
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to fetch associations: %w", err)
+		}
+		for id, s := range page {
+			summaries[id] = s
+		}
+	}
+
+	for _, c := range columns {
+		headers = append(headers, c.Header)
+	}
+	data := HydratedList{Results: make([]HydratedRecord, 0, len(result.Results)), Paging: result.Paging}
+	for i, obj := range result.Results {
+		for _, c := range columns {
+			rows[i] = append(rows[i], summaryCell(c, summaries[obj.ID][c.ToType]))
+		}
+		data.Results = append(data.Results, HydratedRecord{CRMObject: obj, Associations: summaries[obj.ID]})
+	}
+
+	return headers, rows, data, nil
+}
+
+// summaryCell formats an association summary for a column: names, with a
+// count of the ones left out, or just the count
+func summaryCell(c hydrateColumn, s api.AssociationSummary) string {
+	if c.NameProperty == "" {
+		return strconv.Itoa(s.Total)
+	}
+	cell := strings.Join(s.Names, ", ")
+	if more := s.Total - len(s.Names); more > 0 {
+		if cell == "" {
+			return fmt.Sprintf("(%d)", more)
+		}
+		cell += fmt.Sprintf(" (+%d)", more)
+	}
+	return cell
+}
@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+func TestHydrationEnabled(t *testing.T) {
+	on, err := Hydration{}.Enabled()
+	require.NoError(t, err)
+	assert.False(t, on)
+
+	on, err = Hydration{Value: "associations"}.Enabled()
+	require.NoError(t, err)
+	assert.True(t, on)
+
+	_, err = Hydration{Value: "owners"}.Enabled()
+	assert.ErrorContains(t, err, `unknown --hydrate value "owners"`)
+}
+
+func TestSummaryCell(t *testing.T) {
+	names := hydrateColumn{AssociationQuery: api.AssociationQuery{NameProperty: "name"}}
+	count := hydrateColumn{}
+
+	assert.Equal(t, "3", summaryCell(count, api.AssociationSummary{Total: 3}))
+	assert.Equal(t, "0", summaryCell(count, api.AssociationSummary{}))
+	assert.Equal(t, "Acme, Globex", summaryCell(names, api.AssociationSummary{Total: 2, Names: []string{"Acme", "Globex"}}))
+	assert.Equal(t, "Acme (+6)", summaryCell(names, api.AssociationSummary{Total: 7, Names: []string{"Acme"}}))
+	assert.Equal(t, "(2)", summaryCell(names, api.AssociationSummary{Total: 2}))
+	assert.Equal(t, "", summaryCell(names, api.AssociationSummary{}))
+}
+
+func TestHydrationApply_RESTFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/collector/graphql":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"status": "error", "message": "missing scope collector.graphql_query.execute"}`))
+		case "/crm/v4/objects/companies/9001/associations/contacts":
+			w.Write([]byte(`{"results": [{"toObjectId": 1}, {"toObjectId": 2}]}`))
+		case "/crm/v4/objects/companies/9001/associations/deals":
+			w.Write([]byte(`{"results": [{"toObjectId": 7}]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	v := view.New("table", true)
+	var errOut bytes.Buffer
+	v.Err = &errOut
+
+	result := &api.CRMObjectList{Results: []api.CRMObject{{ID: "9001"}}}
+	headers, rows, data, err := Hydration{Value: "associations"}.Apply(context.Background(), v, client, api.ObjectTypeCompanies,
+		result, []string{"ID"}, [][]string{{"9001"}})
+	require.NoError(t, err)
+	assert.Contains(t, errOut.String(), "GraphQL unavailable")
+	assert.Equal(t, []string{"ID", "CONTACTS", "DEALS"}, headers)
+	assert.Equal(t, [][]string{{"9001", "2", "1"}}, rows)
+
+	list := data.(HydratedList)
+	require.Len(t, list.Results, 1)
+	assert.Equal(t, 2, list.Results[0].Associations[api.ObjectTypeContacts].Total)
+}
@@ -0,0 +1,160 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseColumnMap converts raw --map flag values into a lookup from CSV column
+// header to HubSpot property name.
+//
+// Each value has the form property=Column, and several pairs may be joined
+// with commas (e.g. "email=Email,firstname=First Name").
+func ParseColumnMap(raw []string) (map[string]string, error) {
+	columns := make(map[string]string)
+
+	for _, r := range raw {
+		for _, pair := range strings.Split(r, ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("invalid mapping %q: expected property=Column", pair)
+			}
+
+			prop := strings.TrimSpace(parts[0])
+			column := strings.TrimSpace(parts[1])
+			if prop == "" || column == "" {
+				return nil, fmt.Errorf("invalid mapping %q: property and column are both required", pair)
+			}
+			if existing, ok := columns[column]; ok && existing != prop {
+				return nil, fmt.Errorf("column %q is mapped to both %s and %s", column, existing, prop)
+			}
+
+			columns[column] = prop
+		}
+	}
+
+	return columns, nil
+}
+
+// ImportPlan resolves the columns of a CSV header row to HubSpot property
+// names so each subsequent record can be turned into a batch input.
+type ImportPlan struct {
+	// columns maps a CSV column index to the property it populates.
+	columns map[int]string
+	// idColumn is the index of the column holding record IDs, or -1.
+	idColumn int
+}
+
+// NewImportPlan builds an ImportPlan from a CSV header row.
+//
+// When columnMap is empty every header is used verbatim as a property name.
+// Otherwise only mapped columns are imported and every mapped column must be
+// present in the header. idColumn, if set, names the column whose non-empty
+// values mark a row as an update of an existing record.
+func NewImportPlan(header []string, columnMap map[string]string, idColumn string) (*ImportPlan, error) {
+	plan := &ImportPlan{
+		columns:  make(map[int]string),
+		idColumn: -1,
+	}
+
+	index := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		if h == "" {
+			continue
+		}
+		index[h] = i
+	}
+
+	if idColumn != "" {
+		i, ok := index[idColumn]
+		if !ok {
+			return nil, fmt.Errorf("ID column %q not found in CSV header", idColumn)
+		}
+		plan.idColumn = i
+	}
+
+	if len(columnMap) == 0 {
+		for h, i := range index {
+			if i == plan.idColumn {
+				continue
+			}
+			plan.columns[i] = h
+		}
+	} else {
+		var missing []string
+		for column, prop := range columnMap {
+			i, ok := index[column]
+			if !ok {
+				missing = append(missing, column)
+				continue
+			}
+			plan.columns[i] = prop
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return nil, fmt.Errorf("mapped column(s) not found in CSV header: %s", strings.Join(missing, ", "))
+		}
+	}
+
+	if len(plan.columns) == 0 {
+		return nil, fmt.Errorf("no columns to import")
+	}
+
+	return plan, nil
+}
+
+// Properties returns the sorted, de-duplicated property names the plan writes
+func (p *ImportPlan) Properties() []string {
+	seen := make(map[string]bool, len(p.columns))
+	props := make([]string, 0, len(p.columns))
+	for _, prop := range p.columns {
+		if !seen[prop] {
+			seen[prop] = true
+			props = append(props, prop)
+		}
+	}
+	sort.Strings(props)
+	return props
+}
+
+// Record converts a CSV record into a record ID (empty for new records) and a
+// property map. Empty cells are skipped so they do not clear existing values.
+func (p *ImportPlan) Record(record []string) (string, map[string]interface{}) {
+	var id string
+	if p.idColumn >= 0 && p.idColumn < len(record) {
+		id = strings.TrimSpace(record[p.idColumn])
+	}
+
+	props := make(map[string]interface{}, len(p.columns))
+	for i, prop := range p.columns {
+		if i >= len(record) {
+			continue
+		}
+		value := strings.TrimSpace(record[i])
+		if value == "" {
+			continue
+		}
+		props[prop] = value
+	}
+
+	return id, props
+}
+
+// UnknownProperties returns the names in want that are not in known, sorted
+func UnknownProperties(want []string, known map[string]bool) []string {
+	var unknown []string
+	for _, name := range want {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
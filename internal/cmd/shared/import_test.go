@@ -0,0 +1,165 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseColumnMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "comma-separated pairs",
+			input: []string{"email=Email,firstname=First"},
+			want:  map[string]string{"Email": "email", "First": "firstname"},
+		},
+		{
+			name:  "repeated flags and spaces in column names",
+			input: []string{"email=Email Address", "lastname = Last Name"},
+			want:  map[string]string{"Email Address": "email", "Last Name": "lastname"},
+		},
+		{
+			name:  "empty input",
+			input: nil,
+			want:  map[string]string{},
+		},
+		{
+			name:    "missing equals",
+			input:   []string{"email"},
+			wantErr: true,
+		},
+		{
+			name:    "missing column",
+			input:   []string{"email="},
+			wantErr: true,
+		},
+		{
+			name:    "column mapped twice",
+			input:   []string{"email=Email,work_email=Email"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseColumnMap(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseColumnMap(%v) expected error, got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseColumnMap(%v) unexpected error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseColumnMap(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportPlan(t *testing.T) {
+	t.Run("headers used as property names", func(t *testing.T) {
+		plan, err := NewImportPlan([]string{"\ufeffemail", "firstname"}, nil, "")
+		if err != nil {
+			t.Fatalf("NewImportPlan unexpected error: %v", err)
+		}
+
+		if got, want := plan.Properties(), []string{"email", "firstname"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Properties() = %v, want %v", got, want)
+		}
+
+		id, props := plan.Record([]string{"a@example.com", ""})
+		if id != "" {
+			t.Errorf("Record() id = %q, want empty", id)
+		}
+		if want := map[string]interface{}{"email": "a@example.com"}; !reflect.DeepEqual(props, want) {
+			t.Errorf("Record() props = %v, want %v", props, want)
+		}
+	})
+
+	t.Run("mapped columns and ID column", func(t *testing.T) {
+		header := []string{"Record ID", "Email", "First", "Ignored"}
+		plan, err := NewImportPlan(header, map[string]string{"Email": "email", "First": "firstname"}, "Record ID")
+		if err != nil {
+			t.Fatalf("NewImportPlan unexpected error: %v", err)
+		}
+
+		id, props := plan.Record([]string{"42", "b@example.com", "Bea", "skip me"})
+		if id != "42" {
+			t.Errorf("Record() id = %q, want 42", id)
+		}
+		want := map[string]interface{}{"email": "b@example.com", "firstname": "Bea"}
+		if !reflect.DeepEqual(props, want) {
+			t.Errorf("Record() props = %v, want %v", props, want)
+		}
+	})
+
+	t.Run("short record", func(t *testing.T) {
+		plan, err := NewImportPlan([]string{"email", "firstname"}, nil, "")
+		if err != nil {
+			t.Fatalf("NewImportPlan unexpected error: %v", err)
+		}
+
+		_, props := plan.Record([]string{"c@example.com"})
+		if want := map[string]interface{}{"email": "c@example.com"}; !reflect.DeepEqual(props, want) {
+			t.Errorf("Record() props = %v, want %v", props, want)
+		}
+	})
+
+	t.Run("mapped column missing from header", func(t *testing.T) {
+		_, err := NewImportPlan([]string{"Email"}, map[string]string{"Phone": "phone"}, "")
+		if err == nil {
+			t.Fatal("NewImportPlan expected error, got nil")
+		}
+	})
+
+	t.Run("ID column missing from header", func(t *testing.T) {
+		_, err := NewImportPlan([]string{"email"}, nil, "id")
+		if err == nil {
+			t.Fatal("NewImportPlan expected error, got nil")
+		}
+	})
+}
+
+func TestUnknownProperties(t *testing.T) {
+	known := map[string]bool{"email": true, "firstname": true}
+
+	got := UnknownProperties([]string{"jobtitle", "email", "favorite_color"}, known)
+	want := []string{"favorite_color", "jobtitle"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnknownProperties() = %v, want %v", got, want)
+	}
+
+	if got := UnknownProperties([]string{"email"}, known); got != nil {
+		t.Errorf("UnknownProperties() = %v, want nil", got)
+	}
+}
+
+func TestNotSent(t *testing.T) {
+	summary := &ImportSummary{}
+	var batch pendingBatch
+	if n := notSent(summary, &batch); n != 0 || len(summary.Errors) != 0 {
+		t.Fatalf("notSent(empty) = %d with %d errors, want 0 and none", n, len(summary.Errors))
+	}
+
+	batch.add(4, api.BatchInput{})
+	batch.add(7, api.BatchInput{})
+	if n := notSent(summary, &batch); n != 2 {
+		t.Errorf("notSent() = %d, want 2", n)
+	}
+	want := []ImportError{{FirstRow: 4, LastRow: 7, Message: "not sent: API request budget exhausted"}}
+	if !reflect.DeepEqual(summary.Errors, want) {
+		t.Errorf("Errors = %v, want %v", summary.Errors, want)
+	}
+	if len(batch.rows) != 0 {
+		t.Errorf("batch not reset: %v", batch.rows)
+	}
+}
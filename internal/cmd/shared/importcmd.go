@@ -0,0 +1,300 @@
+package shared
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// ImportCmdConfig describes the object-specific pieces of an `import`
+// subcommand. CSV parsing, header validation, batching, and the summary are
+// shared across object types.
+type ImportCmdConfig struct {
+	// ObjectType is the HubSpot CRM object type to import into. When empty the
+	// command exposes a --type flag so the user chooses the object type.
+	ObjectType api.ObjectType
+	// Short and Long are the cobra command descriptions.
+	Short string
+	Long  string
+	// Example is the cobra command example text.
+	Example string
+}
+
+// ImportSummary reports the outcome of an import
+type ImportSummary struct {
+	ObjectType string        `json:"objectType"`
+	Rows       int           `json:"rows"`
+	Created    int           `json:"created"`
+	Updated    int           `json:"updated"`
+	Failed     int           `json:"failed"`
+	Errors     []ImportError `json:"errors,omitempty"`
+	// ResumeRow is the first CSV row not read when the API request budget
+	// ran out; pass it to --start-row to continue the import
+	ResumeRow int `json:"resumeRow,omitempty"`
+}
+
+// ImportError describes a failure affecting one batch of CSV rows
+type ImportError struct {
+	FirstRow int    `json:"firstRow"`
+	LastRow  int    `json:"lastRow"`
+	Message  string `json:"message"`
+}
+
+// pendingBatch accumulates batch inputs together with their CSV row numbers
+type pendingBatch struct {
+	inputs []api.BatchInput
+	rows   []int
+}
+
+func (b *pendingBatch) add(row int, input api.BatchInput) {
+	b.inputs = append(b.inputs, input)
+	b.rows = append(b.rows, row)
+}
+
+func (b *pendingBatch) reset() {
+	b.inputs = nil
+	b.rows = nil
+}
+
+// NewImportCmd builds an `import` subcommand that streams a CSV file into
+// HubSpot using the batch create and update endpoints.
+func NewImportCmd(opts *root.Options, cfg ImportCmdConfig) *cobra.Command {
+	var file string
+	var mappings []string
+	var idColumn string
+	var objectType string
+	var batchSize int
+	var startRow int
+
+	cmd := &cobra.Command{
+		Use:     "import",
+		Short:   cfg.Short,
+		Long:    cfg.Long,
+		Example: cfg.Example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			ot := cfg.ObjectType
+			if ot == "" {
+				if objectType == "" {
+					return fmt.Errorf("--type is required")
+				}
+				ot = api.ObjectType(objectType)
+			}
+
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			if batchSize <= 0 || batchSize > api.MaxBatchSize {
+				return fmt.Errorf("--batch-size must be between 1 and %d", api.MaxBatchSize)
+			}
+			if startRow != 0 && startRow < 2 {
+				return fmt.Errorf("--start-row must be 2 or more; row 1 is the header")
+			}
+
+			columnMap, err := ParseColumnMap(mappings)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return fmt.Errorf("failed to open file: %w", err)
+			}
+			defer f.Close()
+
+			reader := csv.NewReader(f)
+			reader.FieldsPerRecord = -1
+
+			header, err := reader.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return fmt.Errorf("CSV file is empty")
+				}
+				return fmt.Errorf("failed to read CSV header: %w", err)
+			}
+
+			plan, err := NewImportPlan(header, columnMap, idColumn)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			known, err := client.ListProperties(cmd.Context(), ot)
+			if err != nil {
+				return fmt.Errorf("failed to load %s properties: %w", ot, err)
+			}
+			names := make(map[string]bool, len(known.Results))
+			for _, p := range known.Results {
+				names[p.Name] = true
+			}
+			if unknown := UnknownProperties(plan.Properties(), names); len(unknown) > 0 {
+				return fmt.Errorf("unknown %s properties: %s", ot, strings.Join(unknown, ", "))
+			}
+
+			summary := &ImportSummary{ObjectType: string(ot)}
+			var creates, updates pendingBatch
+			var outOfBudget bool
+
+			flush := func(batch *pendingBatch, update bool) {
+				if len(batch.inputs) == 0 {
+					return
+				}
+				defer batch.reset()
+
+				var result *api.BatchResult
+				var err error
+				if update {
+					result, err = client.BatchUpdateObjects(cmd.Context(), ot, batch.inputs)
+				} else {
+					result, err = client.BatchCreateObjects(cmd.Context(), ot, batch.inputs)
+				}
+
+				first, last := batch.rows[0], batch.rows[len(batch.rows)-1]
+				if err != nil {
+					outOfBudget = outOfBudget || api.IsBudgetExhausted(err)
+					summary.Failed += len(batch.inputs)
+					summary.Errors = append(summary.Errors, ImportError{FirstRow: first, LastRow: last, Message: err.Error()})
+					return
+				}
+
+				if update {
+					summary.Updated += len(result.Results)
+				} else {
+					summary.Created += len(result.Results)
+				}
+
+				if failed := len(batch.inputs) - len(result.Results); failed > 0 {
+					summary.Failed += failed
+					for _, e := range result.Errors {
+						summary.Errors = append(summary.Errors, ImportError{FirstRow: first, LastRow: last, Message: e.Message})
+					}
+				}
+			}
+
+			// Row 1 is the header, so data rows start at 2 to match what users
+			// see in a spreadsheet.
+			row := 1
+			for {
+				record, err := reader.Read()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				row++
+				if err != nil {
+					return fmt.Errorf("failed to read CSV row %d: %w", row, err)
+				}
+
+				if row < startRow {
+					continue
+				}
+
+				id, props := plan.Record(record)
+				if len(props) == 0 {
+					continue
+				}
+				summary.Rows++
+
+				if id != "" {
+					updates.add(row, api.BatchInput{ID: id, Properties: props})
+					if len(updates.inputs) >= batchSize {
+						flush(&updates, true)
+					}
+				} else {
+					creates.add(row, api.BatchInput{Properties: props})
+					if len(creates.inputs) >= batchSize {
+						flush(&creates, false)
+					}
+				}
+
+				// Stop reading once the budget is spent; rows already read
+				// but not sent are reported so nothing is imported twice
+				// when the import is resumed after this row
+				if outOfBudget {
+					summary.ResumeRow = row + 1
+					summary.Failed += notSent(summary, &creates) + notSent(summary, &updates)
+					break
+				}
+			}
+
+			flush(&creates, false)
+			flush(&updates, true)
+
+			if summary.Rows == 0 {
+				v.Info("No rows to import")
+				return nil
+			}
+
+			if summary.Failed == 0 {
+				v.Success("Imported %d %s row(s)", summary.Rows, ot)
+			} else {
+				v.Warning("Imported %d of %d %s row(s); %d failed", summary.Created+summary.Updated, summary.Rows, ot, summary.Failed)
+			}
+
+			headers := []string{"CREATED", "UPDATED", "FAILED"}
+			rows := [][]string{
+				{fmt.Sprintf("%d", summary.Created), fmt.Sprintf("%d", summary.Updated), fmt.Sprintf("%d", summary.Failed)},
+			}
+			if err := v.Render(headers, rows, summary); err != nil {
+				return err
+			}
+
+			for _, e := range summary.Errors {
+				v.Error("Rows %d-%d: %s", e.FirstRow, e.LastRow, e.Message)
+			}
+
+			if summary.ResumeRow > 0 {
+				v.Warning("Stopped at the API request budget; rows from %d on were not read", summary.ResumeRow)
+				v.Info("Resume with --start-row %d, and retry the failed rows above separately", summary.ResumeRow)
+			}
+
+			if summary.Failed > 0 {
+				// Exhausting the budget keeps its own exit status
+				if summary.ResumeRow > 0 {
+					return fmt.Errorf("%d row(s) could not be imported: %w", summary.Failed, api.ErrBudgetExhausted)
+				}
+				return fmt.Errorf("%d row(s) could not be imported", summary.Failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "Path to the CSV file to import (required)")
+	cmd.Flags().StringArrayVar(&mappings, "map", nil, "Column mapping as property=Column (comma-separated or repeatable); defaults to using headers as property names")
+	cmd.Flags().StringVar(&idColumn, "id-column", "", "CSV column holding record IDs; rows with an ID are updated instead of created")
+	cmd.Flags().IntVar(&batchSize, "batch-size", api.MaxBatchSize, "Number of rows sent per batch request")
+	cmd.Flags().IntVar(&startRow, "start-row", 0, "Skip CSV rows before this row number (the header is row 1), e.g. to resume an import")
+	if cfg.ObjectType == "" {
+		cmd.Flags().StringVar(&objectType, "type", "", "Object type to import into (contacts, companies, deals, tickets, etc.)")
+	}
+
+	return cmd
+}
+
+// notSent records the rows of a batch that was never sent as failed and
+// returns how many there were
+func notSent(summary *ImportSummary, batch *pendingBatch) int {
+	n := len(batch.rows)
+	if n == 0 {
+		return 0
+	}
+	summary.Errors = append(summary.Errors, ImportError{
+		FirstRow: batch.rows[0],
+		LastRow:  batch.rows[n-1],
+		Message:  "not sent: " + api.ErrBudgetExhausted.Error(),
+	})
+	batch.reset()
+	return n
+}
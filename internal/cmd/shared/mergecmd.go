@@ -0,0 +1,135 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// MergeCmdConfig describes the object-specific pieces of a `merge`
+// subcommand. Fetching both records, the preview, and the merge request are
+// shared across object types.
+type MergeCmdConfig struct {
+	// ObjectType is the HubSpot CRM object type to merge.
+	ObjectType api.ObjectType
+	// Noun is the singular human-readable object name (e.g. "contact") used
+	// in messages.
+	Noun string
+	// Properties are compared in the preview.
+	Properties []string
+	// Example is the cobra command example text.
+	Example string
+}
+
+// mergePreview is the JSON output of a merge that was not confirmed
+type mergePreview struct {
+	Primary *api.CRMObject `json:"primary"`
+	Merge   *api.CRMObject `json:"merge"`
+}
+
+// NewMergeCmd builds a `merge <primaryId> <mergeId>` subcommand. Without
+// --force it only shows both records side by side.
+func NewMergeCmd(opts *root.Options, cfg MergeCmdConfig) *cobra.Command {
+	var force, preview bool
+
+	cmd := &cobra.Command{
+		Use:   "merge <primaryId> <mergeId>",
+		Short: "Merge two " + string(cfg.ObjectType) + " into one",
+		Long: `Merge the second ` + cfg.Noun + ` into the first. The primary record keeps its ID and
+its values where both records have one; the other record's associations and
+activity move to it and the other record is archived. Merges cannot be undone.
+
+Without --force, both records are shown side by side and nothing is changed.
+--preview compares every property either record has a value for, marks the
+conflicting ones, and shows which value the merged record keeps.`,
+		Example: cfg.Example,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			primaryID, mergeID := args[0], args[1]
+
+			if preview && force {
+				return fmt.Errorf("--preview cannot be combined with --force")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if preview {
+				return runMergePreview(cmd.Context(), v, client, cfg, primaryID, mergeID)
+			}
+
+			records := make([]*api.CRMObject, 0, 2)
+			for _, id := range []string{primaryID, mergeID} {
+				obj, err := client.GetObject(cmd.Context(), cfg.ObjectType, id, cfg.Properties)
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("%s %s not found", capitalize(cfg.Noun), id)
+						return nil
+					}
+					return err
+				}
+				records = append(records, obj)
+			}
+
+			if !force {
+				preview := mergePreview{Primary: records[0], Merge: records[1]}
+				if err := v.Render(mergeHeaders(primaryID, mergeID), mergeRows(v, cfg.Properties, records[0], records[1]), preview); err != nil {
+					return err
+				}
+				v.Warning("This will merge %s %s into %s and archive %s. Use --force to confirm.", cfg.Noun, mergeID, primaryID, mergeID)
+				return nil
+			}
+
+			merged, err := client.MergeObjects(cmd.Context(), cfg.ObjectType, primaryID, mergeID)
+			if err != nil {
+				return err
+			}
+
+			v.Success("Merged %s %s into %s", cfg.Noun, mergeID, primaryID)
+
+			rows := [][]string{{"ID", merged.ID}}
+			for _, p := range cfg.Properties {
+				rows = append(rows, []string{p, merged.GetProperty(p)})
+			}
+			return v.Render([]string{"PROPERTY", "VALUE"}, rows, merged)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm the merge")
+	cmd.Flags().BoolVar(&preview, "preview", false, "Compare all properties and show which values the merge keeps")
+
+	return cmd
+}
+
+func mergeHeaders(primaryID, mergeID string) []string {
+	return []string{"PROPERTY", "PRIMARY " + primaryID, "MERGE " + mergeID}
+}
+
+// mergeRows lines up the properties of both records, skipping properties
+// neither record has
+func mergeRows(v *view.View, properties []string, primary, merge *api.CRMObject) [][]string {
+	rows := [][]string{{"Created", v.Time(primary.CreatedAt), v.Time(merge.CreatedAt)}}
+	for _, p := range properties {
+		a, b := primary.GetProperty(p), merge.GetProperty(p)
+		if a == "" && b == "" {
+			continue
+		}
+		rows = append(rows, []string{p, a, b})
+	}
+	return rows
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+func TestMergeRows(t *testing.T) {
+	v := view.New("table", true)
+	v.Location = time.UTC
+
+	primary := &api.CRMObject{
+		ID:         "100",
+		CreatedAt:  "2024-01-15T10:00:00Z",
+		Properties: map[string]interface{}{"email": "jane@example.com", "firstname": "Jane"},
+	}
+	merge := &api.CRMObject{
+		ID:         "200",
+		CreatedAt:  "2024-02-01T09:30:00Z",
+		Properties: map[string]interface{}{"email": "jane.doe@example.com", "phone": "555-0100"},
+	}
+
+	rows := mergeRows(v, []string{"email", "firstname", "lastname", "phone"}, primary, merge)
+	assert.Equal(t, [][]string{
+		{"Created", "2024-01-15 10:00 UTC", "2024-02-01 09:30 UTC"},
+		{"email", "jane@example.com", "jane.doe@example.com"},
+		{"firstname", "Jane", ""},
+		{"phone", "", "555-0100"},
+	}, rows)
+
+	assert.Equal(t, []string{"PROPERTY", "PRIMARY 100", "MERGE 200"}, mergeHeaders("100", "200"))
+}
+
+func TestMergeableProperties(t *testing.T) {
+	props := []api.Property{
+		{Name: "phone", Label: "Phone Number"},
+		{Name: "email", Label: "Email"},
+		{Name: "hs_lead_score", Label: "Score", Calculated: true},
+		{Name: "hs_object_id", Label: "Record ID", ModificationMetadata: &api.ModificationMeta{ReadOnlyValue: true}},
+		{Name: "old", Label: "Old", Archived: true},
+	}
+
+	got := mergeableProperties(props)
+	require.Len(t, got, 2)
+	assert.Equal(t, "email", got[0].Name)
+	assert.Equal(t, "phone", got[1].Name)
+}
+
+func TestCompareRecords(t *testing.T) {
+	props := []api.Property{
+		{Name: "email", Label: "Email"},
+		{Name: "firstname", Label: "First Name"},
+		{Name: "lastname", Label: "Last Name"},
+		{Name: "phone", Label: "Phone"},
+		{Name: "city", Label: "City"},
+	}
+	primary := &api.CRMObject{Properties: map[string]interface{}{
+		"email": "jane@example.com", "firstname": "Jane", "lastname": "Doe",
+	}}
+	merge := &api.CRMObject{Properties: map[string]interface{}{
+		"email": "jane.doe@example.com", "firstname": "Jane", "phone": "555-0100",
+	}}
+
+	got := compareRecords(props, primary, merge)
+	assert.Equal(t, []propertyComparison{
+		{Name: "email", Label: "Email", Primary: "jane@example.com", Merge: "jane.doe@example.com", Kept: "jane@example.com", Conflict: true},
+		{Name: "firstname", Label: "First Name", Primary: "Jane", Merge: "Jane", Kept: "Jane"},
+		{Name: "lastname", Label: "Last Name", Primary: "Doe", Kept: "Doe"},
+		{Name: "phone", Label: "Phone", Merge: "555-0100", Kept: "555-0100"},
+	}, got)
+}
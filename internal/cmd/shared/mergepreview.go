@@ -0,0 +1,145 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// previewWidth is the widest value shown in a merge preview table
+const previewWidth = 40
+
+// propertyComparison is one property of a merge preview
+type propertyComparison struct {
+	Name     string `json:"name"`
+	Label    string `json:"label"`
+	Type     string `json:"-"`
+	Primary  string `json:"primary"`
+	Merge    string `json:"merge"`
+	Kept     string `json:"kept"`
+	Conflict bool   `json:"conflict"`
+}
+
+// mergePreviewReport is the JSON output of merge --preview
+type mergePreviewReport struct {
+	PrimaryID  string               `json:"primaryId"`
+	MergeID    string               `json:"mergeId"`
+	Conflicts  int                  `json:"conflicts"`
+	Properties []propertyComparison `json:"properties"`
+}
+
+// mergeableProperties returns the properties a merge decides between: those
+// users can set, sorted by label
+func mergeableProperties(props []api.Property) []api.Property {
+	var out []api.Property
+	for _, p := range props {
+		if p.Calculated || p.Hidden || p.Archived {
+			continue
+		}
+		if p.ModificationMetadata != nil && p.ModificationMetadata.ReadOnlyValue {
+			continue
+		}
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Label < out[j].Label })
+	return out
+}
+
+// compareRecords lines up the properties either record has a value for and
+// the value the merged record keeps: the primary record's value when it has
+// one, otherwise the merged record's
+func compareRecords(props []api.Property, primary, merge *api.CRMObject) []propertyComparison {
+	var out []propertyComparison
+	for _, p := range props {
+		a, b := primary.GetProperty(p.Name), merge.GetProperty(p.Name)
+		if a == "" && b == "" {
+			continue
+		}
+		kept := a
+		if kept == "" {
+			kept = b
+		}
+		out = append(out, propertyComparison{
+			Name:     p.Name,
+			Label:    p.Label,
+			Type:     p.Type,
+			Primary:  a,
+			Merge:    b,
+			Kept:     kept,
+			Conflict: a != "" && b != "" && a != b,
+		})
+	}
+	return out
+}
+
+// runMergePreview compares every settable property of the two records and
+// shows which value the merge keeps
+func runMergePreview(ctx context.Context, v *view.View, client *api.Client, cfg MergeCmdConfig, primaryID, mergeID string) error {
+	props, err := client.ListProperties(ctx, cfg.ObjectType)
+	if err != nil {
+		return fmt.Errorf("failed to list properties: %w", err)
+	}
+	mergeable := mergeableProperties(props.Results)
+	names := make([]string, 0, len(mergeable))
+	for _, p := range mergeable {
+		names = append(names, p.Name)
+	}
+
+	batch, err := client.BatchReadObjects(ctx, cfg.ObjectType, []string{primaryID, mergeID}, names)
+	if err != nil {
+		return fmt.Errorf("failed to read %ss: %w", cfg.Noun, err)
+	}
+	records := make(map[string]*api.CRMObject, len(batch.Results))
+	for i := range batch.Results {
+		records[batch.Results[i].ID] = &batch.Results[i]
+	}
+	for _, id := range []string{primaryID, mergeID} {
+		if records[id] == nil {
+			v.Error("%s %s not found", capitalize(cfg.Noun), id)
+			return nil
+		}
+	}
+
+	report := mergePreviewReport{
+		PrimaryID:  primaryID,
+		MergeID:    mergeID,
+		Properties: compareRecords(mergeable, records[primaryID], records[mergeID]),
+	}
+
+	display := func(c propertyComparison, value string) string {
+		if c.Type == "datetime" {
+			return v.Time(value)
+		}
+		if len(value) > previewWidth {
+			return value[:previewWidth-3] + "..."
+		}
+		return value
+	}
+
+	headers := []string{"PROPERTY", "PRIMARY " + primaryID, "MERGE " + mergeID, "KEPT", "CONFLICT"}
+	rows := make([][]string, 0, len(report.Properties))
+	for _, c := range report.Properties {
+		conflict := ""
+		if c.Conflict {
+			report.Conflicts++
+			conflict = "yes"
+		}
+		rows = append(rows, []string{c.Label, display(c, c.Primary), display(c, c.Merge), display(c, c.Kept), conflict})
+	}
+
+	if err := v.Render(headers, rows, report); err != nil {
+		return err
+	}
+
+	if report.Conflicts > 0 {
+		v.Warning("%d properties conflict; %s %s's value is kept for each", report.Conflicts, cfg.Noun, primaryID)
+		v.Info("To keep %s %s's values instead, make it the primary: merge %s %s", cfg.Noun, mergeID, mergeID, primaryID)
+	} else {
+		v.Info("No conflicting values")
+	}
+	v.Info("Run again with --force instead of --preview to merge")
+	return nil
+}
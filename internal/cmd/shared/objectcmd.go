@@ -0,0 +1,164 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// ObjectCmdConfig describes the object-specific pieces of the `list` and
+// `get` subcommands of a view-only object type, such as invoices. Fetching,
+// pagination, property history, and rendering are shared across object
+// types.
+type ObjectCmdConfig struct {
+	// ObjectType is the HubSpot CRM object type listed and fetched.
+	ObjectType api.ObjectType
+	// Noun is the singular human-readable object name (e.g. "invoice") used
+	// in descriptions and messages.
+	Noun string
+	// ListExample and GetExample are the cobra command example texts.
+	ListExample string
+	GetExample  string
+	// DefaultProperties are fetched when the user does not pass --properties.
+	DefaultProperties []string
+	// Headers are the list table column headers.
+	Headers []string
+	// Row maps a listed object to a table row aligned with Headers; v formats
+	// times and amounts.
+	Row func(v *view.View, obj api.CRMObject) []string
+	// Details are the rows `get` shows between the ID and the created and
+	// updated times.
+	Details func(v *view.View, obj api.CRMObject) [][]string
+}
+
+// NewListCmd builds a `list` subcommand for a CRM object type, with cursor
+// pagination and --all
+func NewListCmd(opts *root.Options, cfg ObjectCmdConfig) *cobra.Command {
+	var limit int
+	var after string
+	var properties []string
+	var pages AllPages
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List " + plural(cfg.Noun),
+		Long:    "List " + plural(cfg.Noun) + " with pagination support.",
+		Example: cfg.ListExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if len(properties) == 0 {
+				properties = cfg.DefaultProperties
+			}
+
+			result, err := client.ListObjects(cmd.Context(), cfg.ObjectType, pages.Apply(cmd, api.ListOptions{
+				Limit:      limit,
+				After:      after,
+				Properties: properties,
+			}))
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No %s found", plural(cfg.Noun))
+				return nil
+			}
+
+			rows := make([][]string, 0, len(result.Results))
+			for _, obj := range result.Results {
+				rows = append(rows, cfg.Row(v, obj))
+			}
+
+			if err := v.Render(cfg.Headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of "+plural(cfg.Noun)+" to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+
+	AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+// NewGetCmd builds a `get <id>` subcommand for a CRM object type, with
+// --with-history
+func NewGetCmd(opts *root.Options, cfg ObjectCmdConfig) *cobra.Command {
+	var properties []string
+	var history PropertyHistory
+
+	cmd := &cobra.Command{
+		Use:     "get <id>",
+		Short:   "Get " + withArticle(cfg.Noun) + " by ID",
+		Long:    "Retrieve a single " + cfg.Noun + " by its ID.",
+		Example: cfg.GetExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if len(properties) == 0 {
+				properties = cfg.DefaultProperties
+			}
+
+			obj, err := client.GetObjectWithHistory(cmd.Context(), cfg.ObjectType, id, properties, history.Properties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("%s %s not found", capitalize(cfg.Noun), id)
+					return nil
+				}
+				return err
+			}
+
+			rows := [][]string{{"ID", obj.ID}}
+			rows = append(rows, cfg.Details(v, *obj)...)
+			rows = append(rows,
+				[]string{"Created", v.Time(obj.CreatedAt)},
+				[]string{"Updated", v.Time(obj.UpdatedAt)},
+			)
+
+			if err := v.Render([]string{"PROPERTY", "VALUE"}, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	AddHistoryFlag(cmd, &history)
+
+	return cmd
+}
+
+// withArticle prefixes a noun with "a" or "an"
+func withArticle(noun string) string {
+	if noun != "" && strings.ContainsRune("aeiou", rune(noun[0])) {
+		return "an " + noun
+	}
+	return "a " + noun
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+func TestNewObjectCmds(t *testing.T) {
+	cfg := ObjectCmdConfig{ObjectType: api.ObjectTypeInvoices, Noun: "invoice"}
+
+	list := NewListCmd(&root.Options{}, cfg)
+	assert.Equal(t, "List invoices", list.Short)
+	assert.Equal(t, "Maximum number of invoices to return", list.Flags().Lookup("limit").Usage)
+	assert.NotNil(t, list.Flags().Lookup("all"))
+
+	get := NewGetCmd(&root.Options{}, cfg)
+	assert.Equal(t, "Get an invoice by ID", get.Short)
+	assert.NotNil(t, get.Flags().Lookup("with-history"))
+}
+
+func TestWithArticle(t *testing.T) {
+	assert.Equal(t, "an invoice", withArticle("invoice"))
+	assert.Equal(t, "a payment", withArticle("payment"))
+	assert.Equal(t, "a commerce subscription", withArticle("commerce subscription"))
+}
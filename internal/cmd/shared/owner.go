@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// AddOwnerFlags registers --owner and --owner-email on a create or update
+// command
+func AddOwnerFlags(cmd *cobra.Command, ownerID, ownerEmail *string) {
+	cmd.Flags().StringVar(ownerID, "owner", "", "Owner ID")
+	cmd.Flags().StringVar(ownerEmail, "owner-email", "", "Owner email address, resolved to an owner ID")
+}
+
+// ResolveOwner returns the owner ID given with --owner, or the ID of the
+// owner whose email was given with --owner-email. It returns "" when
+// neither flag was set.
+func ResolveOwner(ctx context.Context, client *api.Client, ownerID, ownerEmail string) (string, error) {
+	if ownerEmail == "" {
+		return ownerID, nil
+	}
+	if ownerID != "" {
+		return "", fmt.Errorf("--owner and --owner-email cannot be used together")
+	}
+
+	owner, err := client.GetOwnerByEmail(ctx, ownerEmail)
+	if err != nil {
+		if api.IsNotFound(err) {
+			return "", fmt.Errorf("no HubSpot user has email %s (see hspt owners list)", ownerEmail)
+		}
+		return "", fmt.Errorf("failed to look up owner: %w", err)
+	}
+	return owner.ID, nil
+}
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestResolveOwner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("email") == "jane@example.com" {
+			w.Write([]byte(`{"results": [{"id": "555", "email": "jane@example.com"}]}`))
+			return
+		}
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	ctx := context.Background()
+
+	id, err := ResolveOwner(ctx, client, "", "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "555", id)
+
+	id, err = ResolveOwner(ctx, client, "42", "")
+	require.NoError(t, err)
+	assert.Equal(t, "42", id)
+
+	id, err = ResolveOwner(ctx, client, "", "")
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	_, err = ResolveOwner(ctx, client, "", "nobody@example.com")
+	assert.ErrorContains(t, err, "no HubSpot user has email nobody@example.com")
+
+	_, err = ResolveOwner(ctx, client, "42", "jane@example.com")
+	assert.ErrorContains(t, err, "cannot be used together")
+}
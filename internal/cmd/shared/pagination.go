@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// AllPages holds the --all and --max flag values shared by list commands
+type AllPages struct {
+	All bool
+	Max int
+}
+
+// AddAllPagesFlags registers --all and --max on a list command
+func AddAllPagesFlags(cmd *cobra.Command, p *AllPages) {
+	cmd.Flags().BoolVar(&p.All, "all", false, "Fetch every page by following pagination cursors")
+	cmd.Flags().IntVar(&p.Max, "max", 0, "Fetch pages until this many results, implying --all (0 for no cap)")
+}
+
+// Apply copies the flag values onto opts; --max implies --all. With --all and
+// no explicit --limit, pages are fetched at api.DefaultPageSize rather than
+// the command's default.
+func (p AllPages) Apply(cmd *cobra.Command, opts api.ListOptions) api.ListOptions {
+	opts.All = p.All || p.Max > 0
+	opts.Max = p.Max
+	if opts.All && !cmd.Flags().Changed("limit") {
+		opts.Limit = api.DefaultPageSize
+	}
+	return opts
+}
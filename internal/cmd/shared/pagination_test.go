@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestAllPages_Apply(t *testing.T) {
+	newCmd := func(args ...string) (*cobra.Command, *AllPages) {
+		var pages AllPages
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().Int("limit", 10, "")
+		AddAllPagesFlags(cmd, &pages)
+		require.NoError(t, cmd.ParseFlags(args))
+		return cmd, &pages
+	}
+
+	t.Run("single page", func(t *testing.T) {
+		cmd, pages := newCmd()
+		opts := pages.Apply(cmd, api.ListOptions{Limit: 10})
+		assert.False(t, opts.All)
+		assert.Equal(t, 10, opts.Limit)
+	})
+
+	t.Run("all pages at the API maximum", func(t *testing.T) {
+		cmd, pages := newCmd("--all")
+		opts := pages.Apply(cmd, api.ListOptions{Limit: 10})
+		assert.True(t, opts.All)
+		assert.Equal(t, api.DefaultPageSize, opts.Limit)
+	})
+
+	t.Run("max implies all", func(t *testing.T) {
+		cmd, pages := newCmd("--max", "500")
+		opts := pages.Apply(cmd, api.ListOptions{Limit: 10})
+		assert.True(t, opts.All)
+		assert.Equal(t, 500, opts.Max)
+		assert.Equal(t, api.DefaultPageSize, opts.Limit)
+	})
+
+	t.Run("explicit limit wins", func(t *testing.T) {
+		cmd, pages := newCmd("--max", "500", "--limit", "25")
+		opts := pages.Apply(cmd, api.ListOptions{Limit: 25})
+		assert.Equal(t, 25, opts.Limit)
+	})
+}
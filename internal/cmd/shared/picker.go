@@ -0,0 +1,108 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/huh"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// PickOption is one choice offered by a Picker
+type PickOption struct {
+	ID    string
+	Label string
+}
+
+// Picker asks the user to choose one of options and returns its ID
+type Picker func(title string, options []PickOption) (string, error)
+
+// TerminalPicker returns a Picker that shows a filterable list on the
+// terminal, or nil when stdin or stderr is not a terminal so that scripts get
+// an error instead of a prompt. The list is drawn on stderr to keep stdout
+// clean for piping.
+func TerminalPicker(opts *root.Options) Picker {
+	if !isTerminal(opts.Stdin) || !isTerminal(opts.Stderr) {
+		return nil
+	}
+
+	return func(title string, options []PickOption) (string, error) {
+		choices := make([]huh.Option[string], 0, len(options))
+		for _, o := range options {
+			choices = append(choices, huh.NewOption(o.Label, o.ID))
+		}
+
+		var id string
+		sel := huh.NewSelect[string]().
+			Title(title).
+			Description("Type / to filter, enter to choose, esc to cancel").
+			Options(choices...).
+			Height(min(len(choices)+2, 12)).
+			Value(&id)
+		err := huh.NewForm(huh.NewGroup(sel)).
+			WithInput(opts.Stdin).
+			WithOutput(opts.Stderr).
+			Run()
+		if errors.Is(err, huh.ErrUserAborted) {
+			return "", fmt.Errorf("no record chosen")
+		}
+		if err != nil {
+			return "", err
+		}
+		return id, nil
+	}
+}
+
+// isTerminal reports whether f is an interactive terminal
+func isTerminal(f interface{}) bool {
+	file, ok := f.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// FuzzyScore rates how well s matches query the way skim and fzf do: every
+// query character must appear in s in order, and consecutive characters and
+// characters at the start of words score higher. It returns -1 when s does
+// not contain query as a subsequence. Case is ignored.
+func FuzzyScore(query, s string) int {
+	q := []rune(strings.ToLower(query))
+	text := []rune(strings.ToLower(s))
+	if len(q) == 0 {
+		return 0
+	}
+
+	score := 0
+	qi := 0
+	prev := -2
+	for i, r := range text {
+		if qi == len(q) {
+			break
+		}
+		if r != q[qi] {
+			continue
+		}
+		score++
+		if i == prev+1 {
+			score += 2
+		}
+		if i == 0 || !unicode.IsLetter(text[i-1]) && !unicode.IsDigit(text[i-1]) {
+			score += 3
+		}
+		prev = i
+		qi++
+	}
+	if qi < len(q) {
+		return -1
+	}
+	return score
+}
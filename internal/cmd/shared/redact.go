@@ -0,0 +1,293 @@
+package shared
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// Redaction modes
+const (
+	// RedactHash replaces a value with a short HMAC-SHA256 digest keyed
+	// with the profile's redaction key, so redacted values can still be
+	// joined and counted but not recovered by hashing candidate values
+	RedactHash = "hash"
+	// RedactMask keeps the shape of a value (an email's domain, a phone
+	// number's last digits) and hides the rest
+	RedactMask = "mask"
+)
+
+// redactionProfiles are the built-in --redact profiles. Each lists property
+// names and the English column labels HubSpot uses for them in export files.
+var redactionProfiles = map[string]config.RedactionRule{
+	"emails": {
+		Mode: RedactHash,
+		Properties: []string{
+			"email", "hs_additional_emails", "work_email",
+			"Email", "Additional email addresses", "Work email",
+		},
+	},
+	"phones": {
+		Mode: RedactMask,
+		Properties: []string{
+			"phone", "mobilephone", "fax", "hs_whatsapp_phone_number",
+			"Phone Number", "Mobile Phone Number", "Fax Number", "WhatsApp Phone Number",
+		},
+	},
+	"names": {
+		Mode: RedactMask,
+		Properties: []string{
+			"firstname", "lastname",
+			"First Name", "Last Name",
+		},
+	},
+}
+
+// Redactor hashes or masks the values of personal data properties on their
+// way out of an export
+type Redactor struct {
+	// modes maps a normalized property name or column label to its mode
+	modes map[string]string
+	// key is the HMAC key of hashed values
+	key []byte
+}
+
+// NewRedactor builds a redactor from --redact profile names. Profiles in
+// configured (the redaction config key) replace built-in ones of the same
+// name. key is required when a profile hashes.
+func NewRedactor(names []string, configured map[string]*config.RedactionRule, key []byte) (*Redactor, error) {
+	r := &Redactor{modes: make(map[string]string), key: key}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		rule, ok := redactionProfiles[name]
+		if c, found := configured[name]; found && c != nil {
+			rule, ok = *c, true
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown --redact profile %q (available: %s)", name, strings.Join(redactionProfileNames(configured), ", "))
+		}
+		mode := rule.Mode
+		if mode == "" {
+			mode = RedactHash
+		}
+		if mode == RedactHash && len(key) == 0 {
+			return nil, fmt.Errorf("--redact %s hashes values and needs a redaction key: set HUBSPOT_REDACTION_KEY or use a configured profile", name)
+		}
+		for _, p := range rule.Properties {
+			r.modes[normalizeColumn(p)] = mode
+		}
+	}
+	return r, nil
+}
+
+// LoadRedactor builds a redactor from --redact values and the config file,
+// or returns nil when no profile was given
+func LoadRedactor(names []string) (*Redactor, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	key, err := redactionKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewRedactor(names, cfg.Redaction, key)
+}
+
+// redactionKey returns the key hashes are keyed with: HUBSPOT_REDACTION_KEY,
+// or the active profile's, generated and saved on first use. Without either
+// it returns nil.
+func redactionKey(cfg *config.Config) ([]byte, error) {
+	if key := os.Getenv("HUBSPOT_REDACTION_KEY"); key != "" {
+		return []byte(key), nil
+	}
+	profile := cfg.ActiveProfile()
+	if profile == nil {
+		return nil, nil
+	}
+	if profile.RedactionKey == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("failed to generate a redaction key: %w", err)
+		}
+		profile.RedactionKey = hex.EncodeToString(b)
+		if err := config.Save(cfg); err != nil {
+			return nil, fmt.Errorf("failed to save the redaction key: %w", err)
+		}
+	}
+	return []byte(profile.RedactionKey), nil
+}
+
+// AddRedactFlag registers --redact on an export command
+func AddRedactFlag(cmd *cobra.Command, names *[]string) {
+	cmd.Flags().StringSliceVar(names, "redact", nil, "Hash or mask personal data on the way out: emails, phones, names, or a profile from the redaction config key (comma-separated). Hashes are keyed with the profile's redaction_key; anyone holding the key can test guesses against them")
+}
+
+// redactionProfileNames lists the built-in and configured profile names
+func redactionProfileNames(configured map[string]*config.RedactionRule) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for name := range redactionProfiles {
+		seen[name] = true
+		names = append(names, name)
+	}
+	for name := range configured {
+		if !seen[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// normalizeColumn makes property names and column labels comparable,
+// ignoring case, surrounding space, and a UTF-8 byte order mark
+func normalizeColumn(s string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(s, "\ufeff")))
+}
+
+// Covers reports whether column, a property name or export column label,
+// is redacted
+func (r *Redactor) Covers(column string) bool {
+	_, ok := r.modes[normalizeColumn(column)]
+	return ok
+}
+
+// Value redacts value when column is covered and returns it unchanged
+// otherwise. Empty values stay empty.
+func (r *Redactor) Value(column, value string) string {
+	mode, ok := r.modes[normalizeColumn(column)]
+	if !ok || value == "" {
+		return value
+	}
+	if mode == RedactMask {
+		return maskValue(value)
+	}
+	return hashValue(r.key, value)
+}
+
+// Properties redacts covered properties of a record in place and returns
+// how many were changed
+func (r *Redactor) Properties(props map[string]interface{}) int {
+	n := 0
+	for name, value := range props {
+		s, ok := value.(string)
+		if !ok || s == "" || !r.Covers(name) {
+			continue
+		}
+		props[name] = r.Value(name, s)
+		n++
+	}
+	return n
+}
+
+// CSV copies a CSV file from in to out, redacting the covered columns, and
+// returns the names of the columns it redacted
+func (r *Redactor) CSV(in io.Reader, out io.Writer) ([]string, error) {
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	writer := csv.NewWriter(out)
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	var redacted []string
+	for _, h := range header {
+		if r.Covers(h) {
+			redacted = append(redacted, strings.TrimPrefix(h, "\ufeff"))
+		}
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		for i, value := range record {
+			if i < len(header) {
+				record[i] = r.Value(header[i], value)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return redacted, writer.Error()
+}
+
+// hashValue returns a short HMAC of value under key. Case and surrounding
+// space are ignored so that the same address always hashes the same.
+func hashValue(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(value))))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// maskValue hides most of value: an email keeps its first character and
+// domain, a phone number its last two digits, and anything else its first
+// character
+func maskValue(value string) string {
+	if local, domain, ok := strings.Cut(value, "@"); ok && local != "" {
+		return firstRune(local) + "***@" + domain
+	}
+
+	digits := 0
+	for _, c := range value {
+		if unicode.IsDigit(c) {
+			digits++
+		}
+	}
+	if digits >= 5 {
+		var b strings.Builder
+		seen := 0
+		for _, c := range value {
+			if unicode.IsDigit(c) {
+				seen++
+				if seen <= digits-2 {
+					c = '*'
+				}
+			}
+			b.WriteRune(c)
+		}
+		return b.String()
+	}
+
+	return firstRune(value) + "***"
+}
+
+func firstRune(s string) string {
+	for _, c := range s {
+		return string(c)
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+package shared
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+var testKey = []byte("test-redaction-key")
+
+func TestNewRedactor(t *testing.T) {
+	r, err := NewRedactor([]string{"emails", " Phones "}, nil, testKey)
+	require.NoError(t, err)
+	assert.True(t, r.Covers("email"))
+	assert.True(t, r.Covers("Email"))
+	assert.True(t, r.Covers("\ufeffEmail"))
+	assert.True(t, r.Covers("mobilephone"))
+	assert.False(t, r.Covers("firstname"))
+
+	_, err = NewRedactor([]string{"ssn"}, nil, testKey)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "emails, names, phones")
+}
+
+func TestNewRedactor_Configured(t *testing.T) {
+	configured := map[string]*config.RedactionRule{
+		"names": {Properties: []string{"firstname"}, Mode: RedactHash},
+		"ids":   {Properties: []string{"ssn"}},
+	}
+	r, err := NewRedactor([]string{"names", "ids"}, configured, testKey)
+	require.NoError(t, err)
+
+	// A configured profile replaces the built-in one
+	assert.True(t, r.Covers("firstname"))
+	assert.False(t, r.Covers("lastname"))
+	assert.Equal(t, hashValue(testKey, "Jane"), r.Value("firstname", "Jane"))
+	// Mode defaults to hash
+	assert.Equal(t, hashValue(testKey, "123-45-6789"), r.Value("ssn", "123-45-6789"))
+}
+
+func TestRedactorValue(t *testing.T) {
+	r, err := NewRedactor([]string{"emails", "phones", "names"}, nil, testKey)
+	require.NoError(t, err)
+
+	assert.Equal(t, hashValue(testKey, "jane@example.com"), r.Value("email", "Jane@Example.com "))
+	assert.True(t, strings.HasPrefix(r.Value("email", "jane@example.com"), "hmac:"))
+	assert.Equal(t, "(***) ***-**67", r.Value("phone", "(555) 123-4567"))
+	assert.Equal(t, "J***", r.Value("firstname", "Jane"))
+	assert.Equal(t, "", r.Value("email", ""))
+	assert.Equal(t, "Acme", r.Value("company", "Acme"))
+}
+
+func TestNewRedactor_Key(t *testing.T) {
+	_, err := NewRedactor([]string{"emails"}, nil, nil)
+	assert.ErrorContains(t, err, "needs a redaction key")
+
+	// Masking needs no key
+	_, err = NewRedactor([]string{"phones", "names"}, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestHashValue(t *testing.T) {
+	assert.Equal(t, hashValue(testKey, "jane@example.com"), hashValue(testKey, " Jane@Example.com"))
+	assert.NotEqual(t, hashValue(testKey, "jane@example.com"), hashValue([]byte("other-key"), "jane@example.com"),
+		"hashes depend on the key, so they cannot be matched against a list of known addresses")
+}
+
+func TestRedactionKey(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("HUBSPOT_REDACTION_KEY", "")
+
+	cfg := &config.Config{}
+	key, err := redactionKey(cfg)
+	require.NoError(t, err)
+	assert.Nil(t, key, "no profile to keep a key in")
+
+	cfg.SetProfile(config.DefaultProfile, &config.Profile{AccessToken: "pat-na1-test"})
+	key, err = redactionKey(cfg)
+	require.NoError(t, err)
+	assert.Len(t, key, 64)
+
+	saved, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, string(key), saved.ActiveProfile().RedactionKey, "the generated key is saved for the next export")
+
+	again, err := redactionKey(saved)
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	t.Setenv("HUBSPOT_REDACTION_KEY", "from-env")
+	key, err = redactionKey(saved)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("from-env"), key)
+}
+
+func TestMaskValue(t *testing.T) {
+	assert.Equal(t, "j***@example.com", maskValue("jane@example.com"))
+	assert.Equal(t, "+** *** ***-**99", maskValue("+44 207 946-0999"))
+	assert.Equal(t, "É***", maskValue("Émile"))
+	assert.Equal(t, "1***", maskValue("12"))
+}
+
+func TestRedactorProperties(t *testing.T) {
+	r, err := NewRedactor([]string{"emails"}, nil, testKey)
+	require.NoError(t, err)
+
+	props := map[string]interface{}{"email": "jane@example.com", "work_email": "", "firstname": "Jane"}
+	assert.Equal(t, 1, r.Properties(props))
+	assert.Equal(t, hashValue(testKey, "jane@example.com"), props["email"])
+	assert.Equal(t, "Jane", props["firstname"])
+}
+
+func TestRedactorCSV(t *testing.T) {
+	r, err := NewRedactor([]string{"emails", "names"}, nil, testKey)
+	require.NoError(t, err)
+
+	in := "\ufeffEmail,First Name,Lifecycle Stage\njane@example.com,Jane,lead\n,\"O, Brien\",customer\n"
+	var out bytes.Buffer
+	redacted, err := r.CSV(strings.NewReader(in), &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Email", "First Name"}, redacted)
+	assert.Equal(t, "\ufeffEmail,First Name,Lifecycle Stage\n"+
+		hashValue(testKey, "jane@example.com")+",J***,lead\n"+
+		",O***,customer\n", out.String())
+}
@@ -0,0 +1,198 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// maxCandidates is how many search results are offered when an identifier
+// matches several records
+const maxCandidates = 50
+
+// recordLabels are the properties that name a record of each type, most
+// identifying first. An identifier that equals one of them exactly wins over
+// other matches.
+var recordLabels = map[api.ObjectType][]string{
+	api.ObjectTypeContacts:  {"email", "firstname", "lastname"},
+	api.ObjectTypeCompanies: {"name", "domain"},
+	api.ObjectTypeDeals:     {"dealname", "amount"},
+	api.ObjectTypeTickets:   {"subject"},
+}
+
+// RecordResolver turns human-entered record identifiers (an email, a name, a
+// domain) into record IDs. When an identifier matches several records it asks
+// Pick to choose; with a nil Pick that is an error listing the matches.
+type RecordResolver struct {
+	Client *api.Client
+	Pick   Picker
+}
+
+// NewRecordResolver returns a resolver using the command's API client, with a
+// terminal picker when running interactively
+func NewRecordResolver(opts *root.Options) (*RecordResolver, error) {
+	client, err := opts.APIClient()
+	if err != nil {
+		return nil, err
+	}
+	return &RecordResolver{Client: client, Pick: TerminalPicker(opts)}, nil
+}
+
+// IsRecordID reports whether value is a record ID rather than a name, email,
+// or other identifier to resolve
+func IsRecordID(value string) bool {
+	return isAllDigits(strings.TrimSpace(value))
+}
+
+// Resolve returns the ID of the record of objectType identified by value.
+// Numeric values are taken as IDs without a request.
+func (r *RecordResolver) Resolve(ctx context.Context, objectType api.ObjectType, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if IsRecordID(value) {
+		return value, nil
+	}
+
+	result, err := r.Client.SearchObjects(ctx, objectType, api.SearchRequest{
+		Query:      value,
+		Properties: recordLabels[objectType],
+		Limit:      maxCandidates,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to search %s for %q: %w", objectType, value, err)
+	}
+
+	switch len(result.Results) {
+	case 0:
+		return "", fmt.Errorf("no %s match %q", objectType, value)
+	case 1:
+		return result.Results[0].ID, nil
+	}
+
+	if id, ok := exactMatch(objectType, result.Results, value); ok {
+		return id, nil
+	}
+
+	options := candidateOptions(objectType, result.Results, value)
+	if r.Pick == nil {
+		labels := make([]string, 0, len(options))
+		for _, o := range options {
+			labels = append(labels, o.Label)
+		}
+		return "", fmt.Errorf("%q matches %d %s: %s; use an ID instead",
+			value, len(options), objectType, strings.Join(labels, "; "))
+	}
+	return r.Pick(fmt.Sprintf("Several %s match %q", objectType, value), options)
+}
+
+// exactMatch returns the only record whose label properties include value,
+// ignoring case
+func exactMatch(objectType api.ObjectType, records []api.CRMObject, value string) (string, bool) {
+	var id string
+	matches := 0
+	for _, obj := range records {
+		for _, p := range recordLabels[objectType] {
+			if strings.EqualFold(obj.GetProperty(p), value) {
+				id = obj.ID
+				matches++
+				break
+			}
+		}
+	}
+	return id, matches == 1
+}
+
+// candidateOptions labels records for the picker, best fuzzy match to value
+// first
+func candidateOptions(objectType api.ObjectType, records []api.CRMObject, value string) []PickOption {
+	options := make([]PickOption, 0, len(records))
+	scores := make(map[string]int, len(records))
+	for _, obj := range records {
+		label := obj.ID + "  " + recordLabel(objectType, obj)
+		options = append(options, PickOption{ID: obj.ID, Label: label})
+		scores[obj.ID] = FuzzyScore(value, label)
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		return scores[options[i].ID] > scores[options[j].ID]
+	})
+	return options
+}
+
+// recordLabel describes a record for a person choosing between matches
+func recordLabel(objectType api.ObjectType, obj api.CRMObject) string {
+	switch objectType {
+	case api.ObjectTypeContacts:
+		name := strings.TrimSpace(obj.GetProperty("firstname") + " " + obj.GetProperty("lastname"))
+		if email := obj.GetProperty("email"); email != "" {
+			return strings.TrimSpace(name + " <" + email + ">")
+		}
+		return name
+	case api.ObjectTypeCompanies:
+		if domain := obj.GetProperty("domain"); domain != "" {
+			return obj.GetProperty("name") + " (" + domain + ")"
+		}
+		return obj.GetProperty("name")
+	}
+
+	var props []string
+	if known, ok := recordLabels[objectType]; ok {
+		props = known
+	} else {
+		for p := range obj.Properties {
+			switch p {
+			case "hs_object_id", "createdate", "hs_createdate", "lastmodifieddate", "hs_lastmodifieddate":
+				continue
+			}
+			props = append(props, p)
+		}
+		sort.Strings(props)
+	}
+
+	var parts []string
+	for _, p := range props {
+		if v := obj.GetProperty(p); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ResolveAssociateValues rewrites --associate values whose target is not an
+// ID, such as contacts:jane@example.com or companies:Acme, to type:id. The
+// API client is only created when a value needs resolving.
+func ResolveAssociateValues(cmd *cobra.Command, opts *root.Options, values []string) ([]string, error) {
+	var resolver *RecordResolver
+	resolved := make([]string, 0, len(values))
+
+	for _, raw := range values {
+		parts := strings.Split(raw, ",")
+		for i, value := range parts {
+			name, target, ok := strings.Cut(strings.TrimSpace(value), ":")
+			t, known := AssociateType(name)
+			target = strings.TrimSpace(target)
+			if !ok || !known || target == "" || IsRecordID(target) {
+				continue
+			}
+
+			if resolver == nil {
+				var err error
+				if resolver, err = NewRecordResolver(opts); err != nil {
+					return nil, err
+				}
+			}
+			id, err := resolver.Resolve(cmd.Context(), t, target)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --associate %q: %w", value, err)
+			}
+			parts[i] = name + ":" + id
+		}
+		resolved = append(resolved, strings.Join(parts, ","))
+	}
+
+	return resolved, nil
+}
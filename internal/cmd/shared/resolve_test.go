@@ -0,0 +1,112 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// searchServer answers contact searches with results and records each query
+func searchServer(t *testing.T, results string, queries *[]string) *api.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/contacts/search", r.URL.Path)
+		var req api.SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		*queries = append(*queries, req.Query)
+		w.Write([]byte(`{"results": ` + results + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+}
+
+const twoJanes = `[
+	{"id": "101", "properties": {"firstname": "Jane", "lastname": "Smith", "email": "jane@smith.example"}},
+	{"id": "102", "properties": {"firstname": "Jane", "lastname": "Doe", "email": "jane.doe@example.com"}}
+]`
+
+func TestRecordResolver(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("IDs are not looked up", func(t *testing.T) {
+		var queries []string
+		r := &RecordResolver{Client: searchServer(t, `[]`, &queries)}
+		id, err := r.Resolve(ctx, api.ObjectTypeContacts, " 123 ")
+		require.NoError(t, err)
+		assert.Equal(t, "123", id)
+		assert.Empty(t, queries)
+	})
+
+	t.Run("single match", func(t *testing.T) {
+		var queries []string
+		r := &RecordResolver{Client: searchServer(t, `[{"id": "7", "properties": {}}]`, &queries)}
+		id, err := r.Resolve(ctx, api.ObjectTypeContacts, "jane@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "7", id)
+		assert.Equal(t, []string{"jane@example.com"}, queries)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		var queries []string
+		r := &RecordResolver{Client: searchServer(t, `[]`, &queries)}
+		_, err := r.Resolve(ctx, api.ObjectTypeContacts, "nobody")
+		assert.ErrorContains(t, err, `no contacts match "nobody"`)
+	})
+
+	t.Run("exact email wins", func(t *testing.T) {
+		var queries []string
+		r := &RecordResolver{Client: searchServer(t, twoJanes, &queries)}
+		id, err := r.Resolve(ctx, api.ObjectTypeContacts, "Jane.Doe@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "102", id)
+	})
+
+	t.Run("ambiguous without a picker", func(t *testing.T) {
+		var queries []string
+		r := &RecordResolver{Client: searchServer(t, twoJanes, &queries)}
+		_, err := r.Resolve(ctx, api.ObjectTypeContacts, "Jane")
+		assert.ErrorContains(t, err, `"Jane" matches 2 contacts`)
+		assert.ErrorContains(t, err, "101  Jane Smith <jane@smith.example>")
+	})
+
+	t.Run("ambiguous with a picker", func(t *testing.T) {
+		var queries []string
+		var offered []PickOption
+		r := &RecordResolver{
+			Client: searchServer(t, twoJanes, &queries),
+			Pick: func(title string, options []PickOption) (string, error) {
+				assert.Equal(t, `Several contacts match "jane doe"`, title)
+				offered = options
+				return options[0].ID, nil
+			},
+		}
+		id, err := r.Resolve(ctx, api.ObjectTypeContacts, "jane doe")
+		require.NoError(t, err)
+		assert.Equal(t, "102", id, "best fuzzy match is offered first")
+		assert.Len(t, offered, 2)
+	})
+}
+
+func TestRecordLabel(t *testing.T) {
+	company := api.CRMObject{ID: "1", Properties: map[string]interface{}{"name": "Acme", "domain": "acme.com"}}
+	assert.Equal(t, "Acme (acme.com)", recordLabel(api.ObjectTypeCompanies, company))
+
+	deal := api.CRMObject{ID: "2", Properties: map[string]interface{}{"dealname": "Renewal", "amount": "5000"}}
+	assert.Equal(t, "Renewal, 5000", recordLabel(api.ObjectTypeDeals, deal))
+
+	car := api.CRMObject{ID: "3", Properties: map[string]interface{}{"hs_object_id": "3", "model": "Y", "make": "Tesla"}}
+	assert.Equal(t, "Tesla, Y", recordLabel(api.ObjectType("p123_cars"), car))
+}
+
+func TestFuzzyScore(t *testing.T) {
+	assert.Equal(t, -1, FuzzyScore("xyz", "Jane Doe"))
+	assert.Equal(t, 0, FuzzyScore("", "Jane Doe"))
+	assert.Greater(t, FuzzyScore("jd", "Jane Doe"), FuzzyScore("jd", "Jared"), "word starts score higher")
+	assert.Greater(t, FuzzyScore("doe", "Jane Doe"), FuzzyScore("doe", "Dan O'Neil Evans"), "consecutive matches score higher")
+}
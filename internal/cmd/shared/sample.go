@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// MaxSearchResults is how many records one CRM search can page through
+const MaxSearchResults = 10000
+
+// pageOffset returns the search cursor for a 1-based page of size limit.
+// Search cursors are record offsets, so any page can be reached directly.
+func pageOffset(page, limit int) (string, error) {
+	if page < 1 {
+		return "", fmt.Errorf("--page must be 1 or more")
+	}
+	if limit < 1 {
+		return "", fmt.Errorf("--limit must be 1 or more")
+	}
+	offset := (page - 1) * limit
+	if offset >= MaxSearchResults {
+		return "", fmt.Errorf("--page %d starts past the %d results search can page through", page, MaxSearchResults)
+	}
+	return strconv.Itoa(offset), nil
+}
+
+// samplePages picks the offsets of enough distinct random pages of size
+// to hold want records, among the first total results. Offsets are sorted.
+func samplePages(rng *rand.Rand, total, size, want int) []int {
+	if total > MaxSearchResults {
+		total = MaxSearchResults
+	}
+	if total <= 0 || size <= 0 || want <= 0 {
+		return nil
+	}
+
+	available := (total + size - 1) / size
+	pages := (want + size - 1) / size
+	if pages > available {
+		pages = available
+	}
+
+	offsets := make([]int, 0, pages)
+	for _, p := range rng.Perm(available)[:pages] {
+		offsets = append(offsets, p*size)
+	}
+	sort.Ints(offsets)
+	return offsets
+}
+
+// thin keeps want records chosen at random, in their original order
+func thin(rng *rand.Rand, records []api.CRMObject, want int) []api.CRMObject {
+	if len(records) <= want {
+		return records
+	}
+	keep := rng.Perm(len(records))[:want]
+	sort.Ints(keep)
+	out := make([]api.CRMObject, 0, want)
+	for _, i := range keep {
+		out = append(out, records[i])
+	}
+	return out
+}
+
+// sampleSearch returns about want records spread across the matches of req
+// by fetching random pages of req.Limit records, along with the number of
+// matches. The page size trades requests for spread: larger pages need
+// fewer requests, smaller pages sample more evenly.
+func sampleSearch(ctx context.Context, client *api.Client, objectType api.ObjectType, req api.SearchRequest, want int) (*api.CRMObjectList, error) {
+	probe := req
+	probe.Limit = 1
+	probe.After = ""
+	first, err := client.SearchObjects(ctx, objectType, probe)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var records []api.CRMObject
+	for _, offset := range samplePages(rng, first.Total, req.Limit, want) {
+		page := req
+		page.After = strconv.Itoa(offset)
+		result, err := client.SearchObjects(ctx, objectType, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch page at offset %d: %w", offset, err)
+		}
+		records = append(records, result.Results...)
+	}
+
+	return &api.CRMObjectList{Results: thin(rng, records, want), Total: first.Total}, nil
+}
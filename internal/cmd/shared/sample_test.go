@@ -0,0 +1,70 @@
+package shared
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestPageOffset(t *testing.T) {
+	after, err := pageOffset(1, 25)
+	require.NoError(t, err)
+	assert.Equal(t, "0", after)
+
+	after, err = pageOffset(5, 25)
+	require.NoError(t, err)
+	assert.Equal(t, "100", after)
+
+	_, err = pageOffset(0, 25)
+	assert.ErrorContains(t, err, "--page must be 1 or more")
+
+	_, err = pageOffset(101, 100)
+	assert.ErrorContains(t, err, "past the 10000 results")
+}
+
+func TestSamplePages(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	offsets := samplePages(rng, 5000, 10, 100)
+	require.Len(t, offsets, 10)
+	assert.True(t, sort.IntsAreSorted(offsets))
+	seen := make(map[int]bool)
+	for _, o := range offsets {
+		assert.Zero(t, o%10)
+		assert.Less(t, o, 5000)
+		assert.False(t, seen[o])
+		seen[o] = true
+	}
+
+	// Only the first 10,000 results can be reached
+	for _, o := range samplePages(rng, 50000, 200, 2000) {
+		assert.Less(t, o, MaxSearchResults)
+	}
+
+	// A sample larger than the result set takes every page
+	assert.Equal(t, []int{0, 10, 20}, samplePages(rng, 25, 10, 100))
+	assert.Nil(t, samplePages(rng, 0, 10, 100))
+}
+
+func TestThin(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	records := make([]api.CRMObject, 20)
+	for i := range records {
+		records[i].ID = strconv.Itoa(i)
+	}
+
+	kept := thin(rng, records, 5)
+	require.Len(t, kept, 5)
+	for i := 1; i < len(kept); i++ {
+		a, _ := strconv.Atoi(kept[i-1].ID)
+		b, _ := strconv.Atoi(kept[i].ID)
+		assert.Less(t, a, b)
+	}
+	assert.Len(t, thin(rng, records[:3], 5), 3)
+}
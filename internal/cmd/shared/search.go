@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -23,6 +24,23 @@ var shorthandOperators = []struct {
 	{"<", "LT"},
 }
 
+// searchOperators are the operators the HubSpot search API accepts
+var searchOperators = map[string]bool{
+	"EQ":                 true,
+	"NEQ":                true,
+	"LT":                 true,
+	"LTE":                true,
+	"GT":                 true,
+	"GTE":                true,
+	"BETWEEN":            true,
+	"IN":                 true,
+	"NOT_IN":             true,
+	"HAS_PROPERTY":       true,
+	"NOT_HAS_PROPERTY":   true,
+	"CONTAINS_TOKEN":     true,
+	"NOT_CONTAINS_TOKEN": true,
+}
+
 // dateProperties are HubSpot properties whose values are stored as Unix
 // millisecond timestamps. ISO-8601 dates supplied for these properties are
 // converted automatically so users can write human-readable dates.
@@ -52,9 +70,11 @@ var dateProperties = map[string]bool{
 //	prop:OPERATOR:value operators that take a value (e.g. CONTAINS_TOKEN, EQ)
 //	prop:BETWEEN:lo:hi  range query (inclusive low and high values)
 //	prop:IN:a,b,c       set membership (also NOT_IN)
+//	prop OPERATOR value the explicit forms written with spaces (e.g.
+//	                    "lifecyclestage EQ mql", "amount BETWEEN 10 20")
 //
-// ISO-8601 date values for known date properties are converted to Unix
-// milliseconds automatically.
+// OPERATOR must be one of searchOperators. ISO-8601 date values for known
+// date properties are converted to Unix milliseconds automatically.
 func ParseFilters(raw []string) ([]api.SearchFilter, error) {
 	var filters []api.SearchFilter
 
@@ -75,6 +95,20 @@ func parseFilter(raw string) (api.SearchFilter, error) {
 		return api.SearchFilter{}, fmt.Errorf("empty filter")
 	}
 
+	// Explicit operator form written with spaces: prop OPERATOR [value...]
+	if fields := strings.Fields(trimmed); len(fields) >= 2 && isOperatorToken(fields[1]) && !strings.ContainsAny(fields[0], "=<>!:") {
+		prop, op := fields[0], fields[1]
+		if len(fields) == 2 {
+			return parseExplicitFilter(prop, op, op, -1)
+		}
+		rest := trimmed[len(prop):]
+		value := strings.TrimSpace(rest[strings.Index(rest, op)+len(op):])
+		if op == "BETWEEN" && len(fields) == 4 {
+			value = fields[2] + ":" + fields[3]
+		}
+		return parseExplicitFilter(prop, op, op+":"+value, len(op))
+	}
+
 	// Explicit operator form uses a colon separator: prop:OPERATOR[:value...].
 	// Operators must be written in uppercase (the HubSpot convention). We only
 	// treat the segment after the first colon as an operator when it is all
@@ -116,6 +150,9 @@ func parseExplicitFilter(prop, operator, rest string, opEnd int) (api.SearchFilt
 	if prop == "" {
 		return api.SearchFilter{}, fmt.Errorf("filter is missing a property name")
 	}
+	if !searchOperators[operator] {
+		return api.SearchFilter{}, fmt.Errorf("unknown search operator %s (use one of %s)", operator, operatorList())
+	}
 
 	// Operators that take no value.
 	if opEnd < 0 {
@@ -162,6 +199,16 @@ func parseExplicitFilter(prop, operator, rest string, opEnd int) (api.SearchFilt
 	}
 }
 
+// operatorList returns the supported operators, sorted, for error messages
+func operatorList() string {
+	ops := make([]string, 0, len(searchOperators))
+	for op := range searchOperators {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return strings.Join(ops, ", ")
+}
+
 // isOperatorToken reports whether s looks like a HubSpot operator name
 // (uppercase letters and underscores, at least two characters). This keeps
 // the explicit-form detection from swallowing shorthand values.
@@ -156,6 +156,42 @@ func TestParseFilters(t *testing.T) {
 			input:   []string{""},
 			wantErr: true,
 		},
+		{
+			name:  "spaced explicit form",
+			input: []string{"lifecyclestage EQ mql"},
+			want: []api.SearchFilter{
+				{PropertyName: "lifecyclestage", Operator: "EQ", Value: "mql"},
+			},
+		},
+		{
+			name:  "spaced form keeps spaces in the value",
+			input: []string{"dealname CONTAINS_TOKEN Big Deal"},
+			want: []api.SearchFilter{
+				{PropertyName: "dealname", Operator: "CONTAINS_TOKEN", Value: "Big Deal"},
+			},
+		},
+		{
+			name:  "spaced BETWEEN and IN",
+			input: []string{"amount BETWEEN 10 20", "lifecyclestage IN lead,mql"},
+			want: []api.SearchFilter{
+				{PropertyName: "amount", Operator: "BETWEEN", Value: "10", HighValue: "20"},
+				{PropertyName: "lifecyclestage", Operator: "IN", Values: []string{"lead", "mql"}},
+			},
+		},
+		{
+			name:  "spaced operator without value",
+			input: []string{"email HAS_PROPERTY"},
+			want: []api.SearchFilter{
+				{PropertyName: "email", Operator: "HAS_PROPERTY"},
+			},
+		},
+		{
+			name:  "shorthand value with an uppercase word is not the spaced form",
+			input: []string{"dealname=Big DEAL"},
+			want: []api.SearchFilter{
+				{PropertyName: "dealname", Operator: "EQ", Value: "Big DEAL"},
+			},
+		},
 		{
 			name:    "no operator is an error",
 			input:   []string{"hs_task_status"},
@@ -176,6 +212,23 @@ func TestParseFilters(t *testing.T) {
 			input:   []string{"hs_email_subject:CONTAINS_TOKEN"},
 			wantErr: true,
 		},
+		{
+			name:    "unknown operator is an error",
+			input:   []string{"amount:GREATER:10"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown operator in spaced form is an error",
+			input:   []string{"amount LIKE 10"},
+			wantErr: true,
+		},
+		{
+			name:  "NOT_CONTAINS_TOKEN",
+			input: []string{"email NOT_CONTAINS_TOKEN example.com"},
+			want: []api.SearchFilter{
+				{PropertyName: "email", Operator: "NOT_CONTAINS_TOKEN", Value: "example.com"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,17 +1,23 @@
 package shared
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // SearchCmdConfig describes the object-specific pieces of a `search` subcommand.
 // Everything else (flag wiring, filter/sort parsing, the search request,
 // pagination, and rendering) is shared across object types.
 type SearchCmdConfig struct {
-	// ObjectType is the HubSpot CRM object type to search.
+	// ObjectType is the HubSpot CRM object type to search. When empty the
+	// command exposes a --type flag so the user chooses the object type.
 	ObjectType api.ObjectType
 	// Noun is the singular human-readable object name (e.g. "task", "email")
 	// used in the empty-result and result-count messages.
@@ -21,13 +27,28 @@ type SearchCmdConfig struct {
 	Long  string
 	// Example is the cobra command example text.
 	Example string
+	// Shorthands are object-specific flags that each add one filter, such as
+	// --email for contacts. They combine with --filter.
+	Shorthands []SearchShorthand
 	// DefaultProperties are fetched when the user does not pass --properties.
 	DefaultProperties []string
 	// Headers are the table column headers.
 	Headers []string
 	// Row maps a result object to a table row. It is called once per result and
-	// must return values aligned with Headers.
-	Row func(obj api.CRMObject) []string
+	// must return values aligned with Headers; v formats times and amounts.
+	// When nil the table shows the ID and each property.
+	Row func(v *view.View, obj api.CRMObject) []string
+	// Summary, when set, is called with the results after they are
+	// rendered, e.g. to print totals
+	Summary func(v *view.View, results []api.CRMObject)
+}
+
+// SearchShorthand is a flag that filters one property with a fixed operator
+type SearchShorthand struct {
+	Flag     string
+	Property string
+	Operator string
+	Usage    string
 }
 
 // NewSearchCmd builds a `search` subcommand for a CRM object type. The
@@ -39,7 +60,10 @@ func NewSearchCmd(opts *root.Options, cfg SearchCmdConfig) *cobra.Command {
 	var sortArgs []string
 	var limit int
 	var after string
+	var page, sample int
 	var properties []string
+	var objectType string
+	shorthands := make([]string, len(cfg.Shorthands))
 
 	cmd := &cobra.Command{
 		Use:     "search",
@@ -49,6 +73,16 @@ func NewSearchCmd(opts *root.Options, cfg SearchCmdConfig) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			ot := cfg.ObjectType
+			noun := cfg.Noun
+			if ot == "" {
+				if objectType == "" {
+					return fmt.Errorf("--type is required")
+				}
+				ot = api.ObjectType(objectType)
+				noun = "record"
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -58,16 +92,27 @@ func NewSearchCmd(opts *root.Options, cfg SearchCmdConfig) *cobra.Command {
 				properties = cfg.DefaultProperties
 			}
 
-			filters, err := ParseFilters(filterArgs)
+			filters := shorthandFilters(cfg.Shorthands, shorthands)
+			parsed, err := ParseFilters(filterArgs)
 			if err != nil {
 				return err
 			}
+			filters = append(filters, parsed...)
 
 			sorts, err := ParseSort(sortArgs)
 			if err != nil {
 				return err
 			}
 
+			if page != 0 {
+				if after, err = pageOffset(page, limit); err != nil {
+					return err
+				}
+			}
+			if sample < 0 {
+				return fmt.Errorf("--sample must be 1 or more")
+			}
+
 			req := api.SearchRequest{
 				Properties: properties,
 				Limit:      limit,
@@ -80,39 +125,138 @@ func NewSearchCmd(opts *root.Options, cfg SearchCmdConfig) *cobra.Command {
 				}
 			}
 
-			result, err := client.SearchObjects(cfg.ObjectType, req)
+			var result *api.CRMObjectList
+			if sample > 0 {
+				result, err = sampleSearch(cmd.Context(), client, ot, req, sample)
+			} else {
+				result, err = client.SearchObjects(cmd.Context(), ot, req)
+			}
 			if err != nil {
 				return err
 			}
 
 			if len(result.Results) == 0 {
-				v.Info("No %ss found matching criteria", cfg.Noun)
+				v.Info("No %s found matching criteria", plural(noun))
 				return nil
 			}
 
-			rows := make([][]string, 0, len(result.Results))
-			for _, obj := range result.Results {
-				rows = append(rows, cfg.Row(obj))
+			headers := cfg.Headers
+			var rows [][]string
+			if cfg.Row != nil {
+				rows = make([][]string, 0, len(result.Results))
+				for _, obj := range result.Results {
+					rows = append(rows, cfg.Row(v, obj))
+				}
+			} else {
+				headers, rows = propertyTable(result.Results, properties)
 			}
 
-			v.Info("Found %d %s(s)", len(result.Results), cfg.Noun)
-			if err := v.Render(cfg.Headers, rows, result); err != nil {
+			if sample > 0 {
+				v.Info("Sampled %d of %d %s", len(result.Results), result.Total, countNoun(noun))
+			} else {
+				v.Info("Found %d %s", len(result.Results), countNoun(noun))
+			}
+			if err := v.Render(headers, rows, result); err != nil {
 				return err
 			}
+			if cfg.Summary != nil {
+				cfg.Summary(v, result.Results)
+			}
 
 			if result.Paging != nil && result.Paging.Next != nil {
-				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+				if page != 0 {
+					v.Info("\nMore results available. Use --page %d to get the next page.", page+1)
+				} else {
+					v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+				}
 			}
 
 			return nil
 		},
 	}
 
-	cmd.Flags().StringArrayVar(&filterArgs, "filter", nil, "Filter condition (e.g. prop=value, prop>=value, prop:OPERATOR:value); repeatable")
-	cmd.Flags().StringArrayVar(&sortArgs, "sort", nil, "Sort condition (e.g. hs_timestamp:asc or hs_timestamp:desc); repeatable")
+	for i, sh := range cfg.Shorthands {
+		cmd.Flags().StringVar(&shorthands[i], sh.Flag, "", sh.Usage)
+	}
+	cmd.Flags().StringArrayVar(&filterArgs, "filter", nil, "Filter condition (e.g. prop=value, prop>=value, prop:OPERATOR:value, or 'prop OPERATOR value'); repeatable")
+	cmd.Flags().StringArrayVar(&sortArgs, "sort", nil, "Sort condition (e.g. createdate:asc or createdate:desc); repeatable")
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+	cmd.Flags().IntVar(&page, "page", 0, "Jump to this page of --limit results (1-based)")
+	cmd.Flags().IntVar(&sample, "sample", 0, "Return this many records from random pages of --limit results across all matches")
+	cmd.MarkFlagsMutuallyExclusive("after", "page", "sample")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	if cfg.ObjectType == "" {
+		cmd.Flags().StringVar(&objectType, "type", "", "Object type to search (contacts, companies, deals, tickets, etc.)")
+	}
 
 	return cmd
 }
+
+// shorthandFilters builds a filter for each shorthand flag that has a value.
+// values are aligned with shorthands.
+func shorthandFilters(shorthands []SearchShorthand, values []string) []api.SearchFilter {
+	var filters []api.SearchFilter
+	for i, sh := range shorthands {
+		if values[i] == "" {
+			continue
+		}
+		filters = append(filters, api.SearchFilter{
+			PropertyName: sh.Property,
+			Operator:     sh.Operator,
+			Value:        values[i],
+		})
+	}
+	return filters
+}
+
+// propertyTable lays out results as an ID column plus one column per
+// property. Without requested properties it uses every property HubSpot
+// returned, sorted by name.
+func propertyTable(results []api.CRMObject, properties []string) ([]string, [][]string) {
+	if len(properties) == 0 {
+		seen := make(map[string]bool)
+		for _, obj := range results {
+			for p := range obj.Properties {
+				if p != "hs_object_id" && !seen[p] {
+					seen[p] = true
+					properties = append(properties, p)
+				}
+			}
+		}
+		sort.Strings(properties)
+	}
+
+	headers := []string{"ID"}
+	for _, p := range properties {
+		headers = append(headers, strings.ToUpper(p))
+	}
+
+	rows := make([][]string, 0, len(results))
+	for _, obj := range results {
+		row := []string{obj.ID}
+		for _, p := range properties {
+			row = append(row, obj.GetProperty(p))
+		}
+		rows = append(rows, row)
+	}
+
+	return headers, rows
+}
+
+// plural returns the plural of an object noun ("deal" -> "deals",
+// "company" -> "companies")
+func plural(noun string) string {
+	if strings.HasSuffix(noun, "y") {
+		return strings.TrimSuffix(noun, "y") + "ies"
+	}
+	return noun + "s"
+}
+
+// countNoun returns a noun for use after a count ("deal(s)", "company(ies)")
+func countNoun(noun string) string {
+	if strings.HasSuffix(noun, "y") {
+		return noun + "(ies)"
+	}
+	return noun + "(s)"
+}
@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestShorthandFilters(t *testing.T) {
+	shorthands := []SearchShorthand{
+		{Flag: "name", Property: "dealname", Operator: "CONTAINS_TOKEN"},
+		{Flag: "stage", Property: "dealstage", Operator: "EQ"},
+	}
+
+	assert.Empty(t, shorthandFilters(shorthands, []string{"", ""}))
+	assert.Equal(t, []api.SearchFilter{
+		{PropertyName: "dealstage", Operator: "EQ", Value: "closedwon"},
+	}, shorthandFilters(shorthands, []string{"", "closedwon"}))
+}
+
+func TestPropertyTable(t *testing.T) {
+	results := []api.CRMObject{
+		{ID: "1", Properties: map[string]interface{}{"hs_object_id": "1", "dealname": "Big", "amount": "100"}},
+		{ID: "2", Properties: map[string]interface{}{"hs_object_id": "2", "dealname": "Small", "closedate": "2026-01-01"}},
+	}
+
+	headers, rows := propertyTable(results, []string{"dealname"})
+	assert.Equal(t, []string{"ID", "DEALNAME"}, headers)
+	assert.Equal(t, [][]string{{"1", "Big"}, {"2", "Small"}}, rows)
+
+	headers, rows = propertyTable(results, nil)
+	assert.Equal(t, []string{"ID", "AMOUNT", "CLOSEDATE", "DEALNAME"}, headers)
+	assert.Equal(t, []string{"2", "", "2026-01-01", "Small"}, rows[1])
+}
+
+func TestPluralNouns(t *testing.T) {
+	assert.Equal(t, "deals", plural("deal"))
+	assert.Equal(t, "companies", plural("company"))
+	assert.Equal(t, "deal(s)", countNoun("deal"))
+	assert.Equal(t, "company(ies)", countNoun("company"))
+}
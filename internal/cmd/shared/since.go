@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var sincePattern = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// ParseSince reads a --since value: a number of days (90d) or weeks (12w),
+// a Go duration (36h), or a date (2024-01-31), relative to now
+func ParseSince(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if m := sincePattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		return now.AddDate(0, 0, -n), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q: use days (90d), weeks (12w), a duration (36h), or a date (2024-01-31)", s)
+}
@@ -0,0 +1,33 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"90d", time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)},
+		{"2w", time.Date(2024, 6, 16, 12, 0, 0, 0, time.UTC)},
+		{"36h", time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)},
+		{"2024-01-31", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSince(tt.in, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := ParseSince("last quarter", now)
+	assert.ErrorContains(t, err, "invalid --since")
+}
@@ -0,0 +1,217 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+// timelineSource describes how one engagement type appears in a timeline
+type timelineSource struct {
+	// Kind is the singular name shown in the TYPE column and accepted by
+	// --types
+	Kind       string
+	ObjectType api.ObjectType
+	Properties []string
+	// BodyProperties hold the full content and details of an engagement, read
+	// only when exporting activity
+	BodyProperties []string
+	// Summary describes an engagement in one line
+	Summary func(obj *api.CRMObject) string
+}
+
+var timelineSources = []timelineSource{
+	{
+		Kind:           "note",
+		ObjectType:     api.ObjectTypeNotes,
+		Properties:     []string{"hs_timestamp", "hs_note_body", "hubspot_owner_id"},
+		BodyProperties: []string{"hs_attachment_ids", "hs_createdate", "hs_lastmodifieddate"},
+		Summary: func(obj *api.CRMObject) string {
+			return PlainText(obj.GetProperty("hs_note_body"))
+		},
+	},
+	{
+		Kind:           "call",
+		ObjectType:     api.ObjectTypeCalls,
+		Properties:     []string{"hs_timestamp", "hs_call_title", "hs_call_body", "hs_call_direction", "hs_call_status", "hubspot_owner_id"},
+		BodyProperties: []string{"hs_call_duration", "hs_call_disposition", "hs_call_from_number", "hs_call_to_number", "hs_call_recording_url", "hs_attachment_ids"},
+		Summary: func(obj *api.CRMObject) string {
+			text := obj.GetProperty("hs_call_title")
+			if text == "" {
+				text = PlainText(obj.GetProperty("hs_call_body"))
+			}
+			return withDetail(text, obj.GetProperty("hs_call_direction"), obj.GetProperty("hs_call_status"))
+		},
+	},
+	{
+		Kind:           "email",
+		ObjectType:     api.ObjectTypeEmails,
+		Properties:     []string{"hs_timestamp", "hs_email_subject", "hs_email_direction", "hubspot_owner_id"},
+		BodyProperties: []string{"hs_email_text", "hs_email_html", "hs_email_status", "hs_email_headers", "hs_email_from_email", "hs_email_to_email", "hs_email_cc_email", "hs_attachment_ids"},
+		Summary: func(obj *api.CRMObject) string {
+			return withDetail(obj.GetProperty("hs_email_subject"), obj.GetProperty("hs_email_direction"))
+		},
+	},
+	{
+		Kind:           "meeting",
+		ObjectType:     api.ObjectTypeMeetings,
+		Properties:     []string{"hs_timestamp", "hs_meeting_title", "hs_meeting_outcome", "hubspot_owner_id"},
+		BodyProperties: []string{"hs_meeting_body", "hs_internal_meeting_notes", "hs_meeting_start_time", "hs_meeting_end_time", "hs_meeting_location", "hs_attachment_ids"},
+		Summary: func(obj *api.CRMObject) string {
+			return withDetail(obj.GetProperty("hs_meeting_title"), obj.GetProperty("hs_meeting_outcome"))
+		},
+	},
+	{
+		Kind:           "task",
+		ObjectType:     api.ObjectTypeTasks,
+		Properties:     []string{"hs_timestamp", "hs_task_subject", "hs_task_status", "hubspot_owner_id"},
+		BodyProperties: []string{"hs_task_body", "hs_task_priority", "hs_task_type", "hs_task_completion_date", "hs_attachment_ids"},
+		Summary: func(obj *api.CRMObject) string {
+			return withDetail(obj.GetProperty("hs_task_subject"), obj.GetProperty("hs_task_status"))
+		},
+	},
+}
+
+// TimelineEntry is one engagement in a record's timeline
+type TimelineEntry struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Timestamp  string                 `json:"timestamp"`
+	Summary    string                 `json:"summary"`
+	OwnerID    string                 `json:"ownerId,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+
+	at time.Time
+}
+
+// Timeline is the activity feed of one record
+type Timeline struct {
+	ObjectType string          `json:"objectType"`
+	ID         string          `json:"id"`
+	Total      int             `json:"total"`
+	Entries    []TimelineEntry `json:"entries"`
+}
+
+// selectTimelineSources returns the sources for the given kinds (singular
+// or plural), or every source when none are given
+func selectTimelineSources(kinds []string) ([]timelineSource, error) {
+	if len(kinds) == 0 {
+		return timelineSources, nil
+	}
+
+	want := make(map[string]bool, len(kinds))
+	for _, k := range kinds {
+		k = strings.ToLower(strings.TrimSpace(k))
+		found := false
+		for _, s := range timelineSources {
+			if k == s.Kind || k == string(s.ObjectType) {
+				want[s.Kind] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid type %q: must be note, call, email, meeting, or task", k)
+		}
+	}
+
+	var out []timelineSource
+	for _, s := range timelineSources {
+		if want[s.Kind] {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+// fetchTimeline collects the engagements associated with a record, newest
+// first. Each engagement type costs one associations listing plus one batch
+// read per 100 engagements.
+func fetchTimeline(ctx context.Context, client *api.Client, objectType api.ObjectType, id string, sources []timelineSource) ([]TimelineEntry, error) {
+	var entries []TimelineEntry
+	for _, src := range sources {
+		assocs, err := client.ListAssociations(ctx, objectType, id, src.ObjectType, api.ListOptions{All: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", src.ObjectType, err)
+		}
+
+		ids := make([]string, 0, len(assocs.Results))
+		for _, a := range assocs.Results {
+			ids = append(ids, a.ToObjectID.String())
+		}
+
+		for start := 0; start < len(ids); start += api.MaxBatchSize {
+			end := min(start+api.MaxBatchSize, len(ids))
+			batch, err := client.BatchReadObjects(ctx, src.ObjectType, ids[start:end], src.Properties)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", src.ObjectType, err)
+			}
+			for i := range batch.Results {
+				entries = append(entries, newTimelineEntry(src, &batch.Results[i]))
+			}
+		}
+	}
+
+	sortTimeline(entries)
+	return entries, nil
+}
+
+// FetchActivity collects every engagement associated with a record, newest
+// first, with the properties holding their full content
+func FetchActivity(ctx context.Context, client *api.Client, objectType api.ObjectType, id string) ([]TimelineEntry, error) {
+	sources := make([]timelineSource, 0, len(timelineSources))
+	for _, src := range timelineSources {
+		src.Properties = append(append([]string{}, src.Properties...), src.BodyProperties...)
+		sources = append(sources, src)
+	}
+	return fetchTimeline(ctx, client, objectType, id, sources)
+}
+
+func newTimelineEntry(src timelineSource, obj *api.CRMObject) TimelineEntry {
+	ts := obj.GetProperty("hs_timestamp")
+	if ts == "" {
+		ts = obj.CreatedAt
+	}
+	at, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		if ms, err := strconv.ParseInt(ts, 10, 64); err == nil {
+			at = time.UnixMilli(ms)
+		}
+	}
+
+	return TimelineEntry{
+		Type:       src.Kind,
+		ID:         obj.ID,
+		Timestamp:  ts,
+		Summary:    src.Summary(obj),
+		OwnerID:    obj.GetProperty("hubspot_owner_id"),
+		Properties: obj.Properties,
+		at:         at,
+	}
+}
+
+// sortTimeline orders entries newest first; entries at the same time keep
+// the order of their engagement types
+func sortTimeline(entries []TimelineEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].at.After(entries[j].at)
+	})
+}
+
+// withDetail appends the non-empty details to text in parentheses
+func withDetail(text string, details ...string) string {
+	var parts []string
+	for _, d := range details {
+		if d != "" {
+			parts = append(parts, strings.ToLower(d))
+		}
+	}
+	if len(parts) == 0 {
+		return text
+	}
+	return strings.TrimSpace(text + " (" + strings.Join(parts, ", ") + ")")
+}
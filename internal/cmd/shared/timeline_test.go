@@ -0,0 +1,111 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestFetchTimeline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v4/objects/deals/303/associations/notes":
+			w.Write([]byte(`{"results": [{"toObjectId": 1}, {"toObjectId": 2}]}`))
+		case "/crm/v4/objects/deals/303/associations/calls":
+			w.Write([]byte(`{"results": [{"toObjectId": 3}]}`))
+		case "/crm/v3/objects/notes/batch/read":
+			w.Write([]byte(`{"status": "COMPLETE", "results": [
+				{"id": "1", "properties": {"hs_timestamp": "2024-03-01T09:00:00Z", "hs_note_body": "<p>Sent &amp; signed</p>"}},
+				{"id": "2", "properties": {"hs_timestamp": "2024-01-10T09:00:00Z", "hs_note_body": "Kickoff"}}
+			]}`))
+		case "/crm/v3/objects/calls/batch/read":
+			w.Write([]byte(`{"status": "COMPLETE", "results": [
+				{"id": "3", "properties": {"hs_timestamp": "1707987600000", "hs_call_title": "Pricing call", "hs_call_direction": "OUTBOUND", "hubspot_owner_id": "77"}}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+	sources, err := selectTimelineSources([]string{"notes", "call"})
+	require.NoError(t, err)
+
+	entries, err := fetchTimeline(context.Background(), client, api.ObjectTypeDeals, "303", sources)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "1", entries[0].ID)
+	assert.Equal(t, "Sent & signed", entries[0].Summary)
+	assert.Equal(t, "3", entries[1].ID, "epoch millisecond timestamps sort with RFC 3339 ones")
+	assert.Equal(t, "call", entries[1].Type)
+	assert.Equal(t, "Pricing call (outbound)", entries[1].Summary)
+	assert.Equal(t, "77", entries[1].OwnerID)
+	assert.Equal(t, "2", entries[2].ID)
+}
+
+func TestSelectTimelineSources(t *testing.T) {
+	all, err := selectTimelineSources(nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 5)
+
+	got, err := selectTimelineSources([]string{"tasks", "Email"})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "email", got[0].Kind)
+	assert.Equal(t, "task", got[1].Kind)
+
+	_, err = selectTimelineSources([]string{"sms"})
+	assert.ErrorContains(t, err, `invalid type "sms"`)
+}
+
+func TestWithDetail(t *testing.T) {
+	assert.Equal(t, "Follow up (not_started)", withDetail("Follow up", "NOT_STARTED"))
+	assert.Equal(t, "Demo (inbound, completed)", withDetail("Demo", "", "INBOUND", "COMPLETED"))
+	assert.Equal(t, "Demo", withDetail("Demo", ""))
+	assert.Equal(t, "(outgoing_email)", withDetail("", "OUTGOING_EMAIL"))
+}
+
+func TestFetchActivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v4/objects/contacts/101/associations/emails":
+			w.Write([]byte(`{"results": [{"toObjectId": 7}]}`))
+		case "/crm/v3/objects/emails/batch/read":
+			var body struct {
+				Properties []string `json:"properties"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Contains(t, body.Properties, "hs_email_subject")
+			assert.Contains(t, body.Properties, "hs_email_html")
+			w.Write([]byte(`{"status": "COMPLETE", "results": [
+				{"id": "7", "properties": {"hs_timestamp": "2024-03-01T09:00:00Z", "hs_email_subject": "Renewal", "hs_email_html": "<p>Full body</p>"}}
+			]}`))
+		default:
+			w.Write([]byte(`{"results": []}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	entries, err := FetchActivity(context.Background(), client, api.ObjectTypeContacts, "101")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "email", entries[0].Type)
+	assert.Equal(t, "<p>Full body</p>", entries[0].Properties["hs_email_html"])
+
+	// the shared sources keep their short property lists
+	for _, src := range timelineSources {
+		assert.NotContains(t, src.Properties, "hs_email_html")
+	}
+}
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// summaryWidth is the widest summary shown in a timeline table
+const summaryWidth = 60
+
+// TimelineCmdConfig describes the object-specific pieces of a `timeline`
+// subcommand. Collecting, merging, and rendering the engagements are shared
+// across object types.
+type TimelineCmdConfig struct {
+	// ObjectType is the HubSpot CRM object type whose activity is shown.
+	ObjectType api.ObjectType
+	// Noun is the singular human-readable object name (e.g. "contact") used
+	// in messages.
+	Noun string
+	// Example is the cobra command example text.
+	Example string
+}
+
+// NewTimelineCmd builds a `timeline <id>` subcommand that shows the notes,
+// calls, emails, meetings, and tasks associated with a record as one feed,
+// newest first.
+func NewTimelineCmd(opts *root.Options, cfg TimelineCmdConfig) *cobra.Command {
+	var types []string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "timeline <id>",
+		Short: "Show all activity on a " + cfg.Noun,
+		Long: `Show the notes, calls, emails, meetings, and tasks associated with a ` + cfg.Noun + `
+as one activity feed, newest first.
+
+Each engagement type takes its own associations request and batch read, so
+--types narrows the feed and saves requests.`,
+		Example: cfg.Example,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if limit < 0 {
+				return fmt.Errorf("--limit must be 0 or more")
+			}
+			sources, err := selectTimelineSources(types)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.GetObject(cmd.Context(), cfg.ObjectType, id, nil); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("%s %s not found", capitalize(cfg.Noun), id)
+					return nil
+				}
+				return fmt.Errorf("failed to get %s: %w", cfg.Noun, err)
+			}
+
+			entries, err := fetchTimeline(cmd.Context(), client, cfg.ObjectType, id, sources)
+			if err != nil {
+				return err
+			}
+
+			if len(entries) == 0 {
+				v.Info("No activity found for %s %s", cfg.Noun, id)
+				return nil
+			}
+
+			timeline := Timeline{ObjectType: string(cfg.ObjectType), ID: id, Total: len(entries), Entries: entries}
+			if limit > 0 && len(entries) > limit {
+				timeline.Entries = entries[:limit]
+			}
+
+			headers := []string{"TIME", "TYPE", "ID", "SUMMARY", "OWNER ID"}
+			rows := make([][]string, 0, len(timeline.Entries))
+			for _, e := range timeline.Entries {
+				summary := e.Summary
+				if len(summary) > summaryWidth {
+					summary = summary[:summaryWidth-3] + "..."
+				}
+				rows = append(rows, []string{v.Time(e.Timestamp), e.Type, e.ID, summary, e.OwnerID})
+			}
+
+			if err := v.Render(headers, rows, timeline); err != nil {
+				return err
+			}
+
+			if len(timeline.Entries) < timeline.Total {
+				v.Info("\nShowing %d of %d entries. Use --limit 0 to show all.", len(timeline.Entries), timeline.Total)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&types, "types", nil, "Engagement types to include: note, call, email, meeting, task (comma-separated; default all)")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of entries to show (0 for all)")
+
+	return cmd
+}
@@ -0,0 +1,164 @@
+package stats
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+	"github.com/open-cli-collective/hubspot-cli/internal/usage"
+)
+
+// Register registers the stats command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	var by string
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show local command usage",
+		Long: `Show which commands and object types you use most, to help decide
+which automations to build.
+
+Recording is opt-in and local only: counts and durations are written to
+stats.json next to the config file and are never sent anywhere. Turn it
+on with "hspt stats enable".`,
+		Example: `  # Most used commands
+  hspt stats
+
+  # Most used object types
+  hspt stats --by type
+
+  # Start recording
+  hspt stats enable`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if by != "command" && by != "type" {
+				return fmt.Errorf("invalid --by %q (expected command or type)", by)
+			}
+
+			s, err := usage.Load()
+			if err != nil {
+				return err
+			}
+
+			if len(s.Commands) == 0 {
+				if usage.Enabled() {
+					v.Info("No usage recorded yet")
+				} else {
+					v.Info("Usage stats are off. Enable with: hspt stats enable")
+					v.Info("Data stays on this machine and is never sent anywhere.")
+				}
+				return nil
+			}
+
+			if by == "type" {
+				headers := []string{"OBJECT TYPE", "RUNS"}
+				rows := make([][]string, 0, len(s.ObjectTypes))
+				for _, name := range s.SortedObjectTypes() {
+					rows = append(rows, []string{name, strconv.Itoa(s.ObjectTypes[name])})
+				}
+				if err := v.Render(headers, rows, s.ObjectTypes); err != nil {
+					return err
+				}
+			} else {
+				headers := []string{"COMMAND", "RUNS", "ERRORS", "AVG TIME", "LAST RUN"}
+				rows := make([][]string, 0, len(s.Commands))
+				for _, e := range s.SortedCommands() {
+					rows = append(rows, []string{
+						e.Name,
+						strconv.Itoa(e.Runs),
+						strconv.Itoa(e.Errors),
+						e.Average().Round(time.Millisecond).String(),
+						e.LastRun.Local().Format("2006-01-02 15:04"),
+					})
+				}
+				if err := v.Render(headers, rows, s); err != nil {
+					return err
+				}
+			}
+
+			if !s.Since.IsZero() {
+				v.Info("\nRecorded since %s", s.Since.Local().Format("2006-01-02"))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&by, "by", "command", "Group by: command or type")
+
+	cmd.AddCommand(newToggleCmd(opts, true))
+	cmd.AddCommand(newToggleCmd(opts, false))
+	cmd.AddCommand(newResetCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func newToggleCmd(opts *root.Options, enable bool) *cobra.Command {
+	use, short := "disable", "Stop recording usage stats"
+	if enable {
+		use, short = "enable", "Start recording usage stats locally"
+	}
+
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			cfg.Stats = enable
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save configuration: %w", err)
+			}
+
+			if enable {
+				v.Success("Usage stats enabled")
+				if path, err := usage.Path(); err == nil {
+					v.Info("Recorded locally in %s", path)
+				}
+			} else {
+				v.Success("Usage stats disabled")
+				v.Info("Existing data is kept; remove it with: hspt stats reset --force")
+			}
+			return nil
+		},
+	}
+}
+
+func newResetCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Delete recorded usage stats",
+		Example: `  # Delete the stats file
+  hspt stats reset --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if !force {
+				v.Warning("This will delete all recorded usage stats. Use --force to confirm.")
+				return nil
+			}
+
+			if err := usage.Reset(); err != nil {
+				return err
+			}
+
+			v.Success("Usage stats deleted")
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
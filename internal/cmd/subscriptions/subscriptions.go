@@ -0,0 +1,261 @@
+package subscriptions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the subscriptions command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "subscriptions",
+		Short: "Manage email subscription preferences",
+		Long:  "Commands for listing subscription types and viewing or changing which ones an email address receives.",
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newStatusCmd(opts))
+	cmd.AddCommand(newChangeCmd(opts, "subscribe"))
+	cmd.AddCommand(newChangeCmd(opts, "unsubscribe"))
+
+	parent.AddCommand(cmd)
+}
+
+// findDefinition returns the subscription type whose ID or name (ignoring
+// case) is value
+func findDefinition(defs []api.SubscriptionDefinition, value string) (api.SubscriptionDefinition, error) {
+	value = strings.TrimSpace(value)
+	for _, d := range defs {
+		if d.ID == value || strings.EqualFold(d.Name, value) {
+			return d, nil
+		}
+	}
+
+	names := make([]string, 0, len(defs))
+	for _, d := range defs {
+		names = append(names, fmt.Sprintf("%s (%s)", d.Name, d.ID))
+	}
+	return api.SubscriptionDefinition{}, fmt.Errorf("unknown subscription type %q (use one of %s)", value, strings.Join(names, ", "))
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List subscription types",
+		Long:  "List the portal's email subscription types. Inactive types are hidden unless --all is set.",
+		Example: `  # List active subscription types
+  hspt subscriptions list
+
+  # Include inactive types
+  hspt subscriptions list --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			defs, err := client.ListSubscriptionDefinitions(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list subscription types: %w", err)
+			}
+
+			if !all {
+				active := defs[:0]
+				for _, d := range defs {
+					if d.IsActive {
+						active = append(active, d)
+					}
+				}
+				defs = active
+			}
+
+			if len(defs) == 0 {
+				v.Info("No subscription types found")
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "PURPOSE", "METHOD", "ACTIVE", "DEFAULT"}
+			rows := make([][]string, 0, len(defs))
+			for _, d := range defs {
+				rows = append(rows, []string{
+					d.ID,
+					d.Name,
+					orDash(d.Purpose),
+					orDash(d.CommunicationMethod),
+					yesNo(d.IsActive),
+					yesNo(d.IsDefault),
+				})
+			}
+
+			return v.Render(headers, rows, defs)
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Include inactive subscription types")
+
+	return cmd
+}
+
+func newStatusCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status <email>",
+		Short: "Show an email address's subscription status",
+		Long:  "Show whether an email address is subscribed to each subscription type, and why.",
+		Example: `  # Check what jane@example.com receives
+  hspt subscriptions status jane@example.com`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			email := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			statuses, err := client.GetSubscriptionStatuses(cmd.Context(), email)
+			if err != nil {
+				return fmt.Errorf("failed to get subscription status: %w", err)
+			}
+
+			if len(statuses.SubscriptionStatuses) == 0 {
+				v.Info("No subscription status recorded for %s", email)
+				return nil
+			}
+
+			headers := []string{"ID", "SUBSCRIPTION", "STATUS", "SOURCE", "LEGAL BASIS"}
+			rows := make([][]string, 0, len(statuses.SubscriptionStatuses))
+			for _, s := range statuses.SubscriptionStatuses {
+				rows = append(rows, []string{
+					s.ID,
+					s.Name,
+					s.Status,
+					orDash(s.SourceOfStatus),
+					orDash(s.LegalBasis),
+				})
+			}
+
+			return v.Render(headers, rows, statuses)
+		},
+	}
+}
+
+// newChangeCmd builds the subscribe and unsubscribe commands, which differ
+// only in the endpoint they call and their wording
+func newChangeCmd(opts *root.Options, action string) *cobra.Command {
+	var subscriptionType string
+	var legalBasis string
+	var explanation string
+
+	verb, preposition := "Subscribe", "to"
+	if action == "unsubscribe" {
+		verb, preposition = "Unsubscribe", "from"
+	}
+
+	cmd := &cobra.Command{
+		Use:   action + " <email>",
+		Short: fmt.Sprintf("%s an email address %s a subscription type", verb, preposition),
+		Long: fmt.Sprintf(`%s an email address %s one subscription type. --type takes the type's
+ID or name, as shown by "hspt subscriptions list".
+
+Portals with GDPR features enabled need a --legal-basis (such as
+CONSENT_WITH_NOTICE or LEGITIMATE_INTEREST_CLIENT) and an --explanation.`, verb, preposition),
+		Example: fmt.Sprintf(`  # %s by subscription type ID
+  hspt subscriptions %s jane@example.com --type 123456
+
+  # By name, recording the legal basis
+  hspt subscriptions %s jane@example.com --type Newsletter --legal-basis CONSENT_WITH_NOTICE --explanation "Requested by email"`, verb, action, action),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			email := args[0]
+
+			if subscriptionType == "" {
+				return fmt.Errorf("--type is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			id := subscriptionType
+			name := subscriptionType
+			if !isNumeric(subscriptionType) {
+				defs, err := client.ListSubscriptionDefinitions(cmd.Context())
+				if err != nil {
+					return fmt.Errorf("failed to list subscription types: %w", err)
+				}
+				def, err := findDefinition(defs, subscriptionType)
+				if err != nil {
+					return err
+				}
+				id, name = def.ID, def.Name
+			}
+
+			change := api.SubscriptionChange{
+				EmailAddress:          email,
+				SubscriptionID:        id,
+				LegalBasis:            legalBasis,
+				LegalBasisExplanation: explanation,
+			}
+
+			var status *api.SubscriptionStatus
+			if action == "unsubscribe" {
+				status, err = client.Unsubscribe(cmd.Context(), change)
+			} else {
+				status, err = client.Subscribe(cmd.Context(), change)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to %s %s: %w", action, email, err)
+			}
+
+			if status.Name != "" {
+				name = status.Name
+			}
+			v.Success("%sd %s %s %s", verb, email, preposition, name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&subscriptionType, "type", "", "Subscription type ID or name (required)")
+	cmd.Flags().StringVar(&legalBasis, "legal-basis", "", "Legal basis for the change (e.g. CONSENT_WITH_NOTICE, LEGITIMATE_INTEREST_CLIENT)")
+	cmd.Flags().StringVar(&explanation, "explanation", "", "Explanation of the legal basis")
+
+	return cmd
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
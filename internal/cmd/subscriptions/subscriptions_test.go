@@ -0,0 +1,34 @@
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestFindDefinition(t *testing.T) {
+	defs := []api.SubscriptionDefinition{
+		{ID: "123", Name: "Newsletter"},
+		{ID: "456", Name: "Product Updates"},
+	}
+
+	d, err := findDefinition(defs, "456")
+	require.NoError(t, err)
+	assert.Equal(t, "Product Updates", d.Name)
+
+	d, err = findDefinition(defs, "newsletter")
+	require.NoError(t, err)
+	assert.Equal(t, "123", d.ID)
+
+	_, err = findDefinition(defs, "Offers")
+	assert.ErrorContains(t, err, `unknown subscription type "Offers" (use one of Newsletter (123), Product Updates (456))`)
+}
+
+func TestIsNumeric(t *testing.T) {
+	assert.True(t, isNumeric("123"))
+	assert.False(t, isNumeric("Newsletter"))
+	assert.False(t, isNumeric(""))
+}
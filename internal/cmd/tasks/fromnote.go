@@ -0,0 +1,174 @@
+package tasks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// subjectWidth is the longest subject taken from a note's text
+const subjectWidth = 60
+
+// noteRecordTypes are the record types whose associations are copied from
+// the note to the task
+var noteRecordTypes = []api.ObjectType{
+	api.ObjectTypeContacts,
+	api.ObjectTypeCompanies,
+	api.ObjectTypeDeals,
+	api.ObjectTypeTickets,
+}
+
+var relativeDue = regexp.MustCompile(`^(\d+)([hdw])$`)
+
+func newFromNoteCmd(opts *root.Options) *cobra.Command {
+	var due, subject, priority, ownerID, ownerEmail string
+
+	cmd := &cobra.Command{
+		Use:   "from-note <noteId>",
+		Short: "Create a follow-up task from a note",
+		Long: `Create a task that follows up on a note. The task body is the note's text,
+and the task is associated with the same contacts, companies, deals, and
+tickets as the note.
+
+The subject defaults to "Follow up: " and the start of the note, and the
+owner to the note's owner.`,
+		Example: `  # Follow up in three days
+  hspt tasks from-note 12345 --due 3d
+
+  # Assign the follow-up to a teammate, due on a date
+  hspt tasks from-note 12345 --due 2025-07-01 --owner-email jane@example.com
+
+  # Choose the subject and priority
+  hspt tasks from-note 12345 --subject "Send revised quote" --priority HIGH`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			noteID := args[0]
+
+			dueAt, err := parseDue(due, time.Now())
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			note, err := client.GetObject(cmd.Context(), api.ObjectTypeNotes, noteID, []string{"hs_note_body", "hubspot_owner_id"})
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Note %s not found", noteID)
+					return nil
+				}
+				return fmt.Errorf("failed to get note: %w", err)
+			}
+
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner == "" {
+				owner = note.GetProperty("hubspot_owner_id")
+			}
+
+			var records []string
+			for _, t := range noteRecordTypes {
+				assocs, err := client.ListAssociations(cmd.Context(), api.ObjectTypeNotes, noteID, t, api.ListOptions{All: true})
+				if err != nil {
+					return fmt.Errorf("failed to list the note's %s: %w", t, err)
+				}
+				for _, a := range assocs.Results {
+					records = append(records, string(t)+":"+a.ToObjectID.String())
+				}
+			}
+			associations, err := shared.ParseAssociations(api.ObjectTypeTasks, records)
+			if err != nil {
+				return err
+			}
+
+			body := note.GetProperty("hs_note_body")
+			if subject == "" {
+				subject = followUpSubject(body)
+			}
+
+			properties := map[string]interface{}{
+				"hs_task_subject": subject,
+				"hs_task_body":    body,
+				"hs_task_status":  "NOT_STARTED",
+				"hs_timestamp":    strconv.FormatInt(dueAt.UnixMilli(), 10),
+			}
+			if priority != "" {
+				properties["hs_task_priority"] = priority
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
+			}
+
+			obj, err := client.CreateObjectWithAssociations(cmd.Context(), api.ObjectTypeTasks, properties, associations)
+			if err != nil {
+				return fmt.Errorf("failed to create task: %w", err)
+			}
+
+			v.Success("Task created with ID: %s from note %s", obj.ID, noteID)
+			if len(records) > 0 {
+				v.Info("Associated with: %s", strings.Join(records, ", "))
+			}
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", obj.ID},
+				{"Subject", obj.GetProperty("hs_task_subject")},
+				{"Due", v.Time(obj.GetProperty("hs_timestamp"))},
+				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
+			}
+
+			return v.Render(headers, rows, obj)
+		},
+	}
+
+	cmd.Flags().StringVar(&due, "due", "1d", "When the task is due: a time from now (4h, 3d, 2w), a date (2025-07-01), or an RFC 3339 time")
+	cmd.Flags().StringVar(&subject, "subject", "", "Task subject (default: \"Follow up: \" and the start of the note)")
+	cmd.Flags().StringVar(&priority, "priority", "", "Task priority (LOW, MEDIUM, HIGH)")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
+
+	return cmd
+}
+
+// parseDue reads a due time relative to now (4h, 3d, 2w), a date in now's
+// location, or an RFC 3339 time
+func parseDue(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if m := relativeDue.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		unit := map[string]time.Duration{"h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}[m[2]]
+		return now.Add(time.Duration(n) * unit), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --due %q: use a time from now such as 3d, a date such as 2025-07-01, or an RFC 3339 time", s)
+}
+
+// followUpSubject names a task after the start of a note's text
+func followUpSubject(body string) string {
+	text := shared.PlainText(body)
+	if text == "" {
+		return "Follow up"
+	}
+	if len(text) > subjectWidth {
+		text = strings.TrimSpace(text[:subjectWidth-3]) + "..."
+	}
+	return "Follow up: " + text
+}
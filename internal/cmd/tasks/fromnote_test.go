@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDue(t *testing.T) {
+	now := time.Date(2025, 6, 10, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"4h", now.Add(4 * time.Hour)},
+		{"3d", time.Date(2025, 6, 13, 14, 30, 0, 0, time.UTC)},
+		{"2w", time.Date(2025, 6, 24, 14, 30, 0, 0, time.UTC)},
+		{"2025-07-01", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-07-01T09:00:00Z", time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseDue(tt.input, now)
+		require.NoError(t, err, tt.input)
+		assert.True(t, tt.want.Equal(got), "parseDue(%q) = %v, want %v", tt.input, got, tt.want)
+	}
+
+	for _, bad := range []string{"", "3", "3m", "tomorrow", "07/01/2025"} {
+		_, err := parseDue(bad, now)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestFollowUpSubject(t *testing.T) {
+	assert.Equal(t, "Follow up: Call back about pricing", followUpSubject("<p>Call back about <b>pricing</b></p>"))
+	assert.Equal(t, "Follow up", followUpSubject(""))
+
+	long := followUpSubject(strings.Repeat("word ", 30))
+	assert.True(t, strings.HasSuffix(long, "..."))
+	assert.LessOrEqual(t, len(long), len("Follow up: ")+subjectWidth)
+}
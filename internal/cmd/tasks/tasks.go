@@ -9,6 +9,7 @@ import (
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for tasks
@@ -28,6 +29,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newSearchCmd(opts))
+	cmd.AddCommand(newFromNoteCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -36,6 +38,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -58,11 +61,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeTasks, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeTasks, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -80,7 +83,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 					truncate(obj.GetProperty("hs_task_subject"), 40),
 					obj.GetProperty("hs_task_status"),
 					obj.GetProperty("hs_task_priority"),
-					obj.GetProperty("hs_timestamp"),
+					v.Time(obj.GetProperty("hs_timestamp")),
 				})
 			}
 
@@ -100,11 +103,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -126,7 +132,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeTasks, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeTasks, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Task %s not found", id)
@@ -142,24 +148,29 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Body", truncate(obj.GetProperty("hs_task_body"), 100)},
 				{"Status", obj.GetProperty("hs_task_status")},
 				{"Priority", obj.GetProperty("hs_task_priority")},
-				{"Timestamp", obj.GetProperty("hs_timestamp")},
+				{"Timestamp", v.Time(obj.GetProperty("hs_timestamp"))},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
 	var subject, body, status, priority, timestamp, ownerID string
-	var props []string
+	var props, associate []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -169,10 +180,23 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   hspt tasks create --subject "Follow up with client" --status NOT_STARTED --priority HIGH
 
   # Create with body
-  hspt tasks create --subject "Review proposal" --body "Check pricing section"`,
+  hspt tasks create --subject "Review proposal" --body "Check pricing section"
+
+  # Attach to records
+  hspt tasks create --subject "Send contract" --associate deals:456`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
+			values, fromContext := shared.WithRecordContext(cmd, associate)
+			values, err := shared.ResolveAssociateValues(cmd, opts, values)
+			if err != nil {
+				return err
+			}
+			associations, err := shared.ParseAssociations(api.ObjectTypeTasks, values)
+			if err != nil {
+				return err
+			}
+
 			client, err := opts.APIClient()
 			if err != nil {
 				return err
@@ -209,12 +233,15 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required (--subject is recommended)")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeTasks, properties)
+			obj, err := client.CreateObjectWithAssociations(cmd.Context(), api.ObjectTypeTasks, properties, associations)
 			if err != nil {
 				return err
 			}
 
 			v.Success("Task created with ID: %s", obj.ID)
+			if len(fromContext) > 0 {
+				v.Info("Associated from context: %s", strings.Join(fromContext, ", "))
+			}
 
 			headers := []string{"PROPERTY", "VALUE"}
 			rows := [][]string{
@@ -235,6 +262,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&timestamp, "timestamp", "", "Task due date (Unix milliseconds)")
 	cmd.Flags().StringVar(&ownerID, "owner-id", "", "HubSpot owner ID")
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
+	shared.AddAssociateFlag(cmd, &associate)
 
 	return cmd
 }
@@ -293,7 +321,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeTasks, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeTasks, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Task %s not found", id)
@@ -345,7 +373,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeTasks, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeTasks, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Task %s not found", id)
 					return nil
@@ -379,13 +407,13 @@ func newSearchCmd(opts *root.Options) *cobra.Command {
   hspt tasks search --filter "hs_task_subject:CONTAINS_TOKEN:renewal" --limit 25`,
 		DefaultProperties: DefaultProperties,
 		Headers:           []string{"ID", "SUBJECT", "STATUS", "PRIORITY", "TIMESTAMP"},
-		Row: func(obj api.CRMObject) []string {
+		Row: func(v *view.View, obj api.CRMObject) []string {
 			return []string{
 				obj.ID,
 				truncate(obj.GetProperty("hs_task_subject"), 40),
 				obj.GetProperty("hs_task_status"),
 				obj.GetProperty("hs_task_priority"),
-				obj.GetProperty("hs_timestamp"),
+				v.Time(obj.GetProperty("hs_timestamp")),
 			}
 		},
 	})
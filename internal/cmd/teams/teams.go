@@ -0,0 +1,81 @@
+package teams
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the teams command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "teams",
+		Short: "View portal teams",
+		Long:  "Commands for viewing the portal's teams. Assign users to teams with hspt users create and update.",
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// sortTeams orders teams by name, then ID
+func sortTeams(teams []api.Team) {
+	sort.SliceStable(teams, func(i, j int) bool {
+		if teams[i].Name != teams[j].Name {
+			return teams[i].Name < teams[j].Name
+		}
+		return teams[i].ID < teams[j].ID
+	})
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List teams",
+		Long:  "List the portal's teams with how many users have each as their primary or an additional team.",
+		Example: `  # List teams
+  hspt teams list
+
+  # With member IDs
+  hspt teams list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			teams, err := client.ListTeams(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list teams: %w", err)
+			}
+
+			if len(teams) == 0 {
+				v.Info("No teams found")
+				return nil
+			}
+
+			sortTeams(teams)
+
+			headers := []string{"ID", "NAME", "PRIMARY USERS", "SECONDARY USERS"}
+			rows := make([][]string, 0, len(teams))
+			for _, t := range teams {
+				rows = append(rows, []string{
+					t.ID,
+					t.Name,
+					strconv.Itoa(len(t.UserIDs)),
+					strconv.Itoa(len(t.SecondaryUserIDs)),
+				})
+			}
+
+			return v.Render(headers, rows, teams)
+		},
+	}
+}
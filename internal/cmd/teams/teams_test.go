@@ -0,0 +1,15 @@
+package teams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestSortTeams(t *testing.T) {
+	teams := []api.Team{{ID: "3", Name: "Sales"}, {ID: "2", Name: "Marketing"}, {ID: "1", Name: "Sales"}}
+	sortTeams(teams)
+	assert.Equal(t, []api.Team{{ID: "2", Name: "Marketing"}, {ID: "1", Name: "Sales"}, {ID: "3", Name: "Sales"}}, teams)
+}
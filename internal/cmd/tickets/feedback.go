@@ -0,0 +1,145 @@
+package tickets
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// feedbackProperties are the feedback submission properties shown with a ticket
+var feedbackProperties = []string{
+	"hs_survey_name",
+	"hs_survey_type",
+	"hs_survey_channel",
+	"hs_response_value",
+	"hs_sentiment",
+	"hs_content",
+	"hs_submission_timestamp",
+}
+
+// ticketFeedback is the JSON output of feedback
+type ticketFeedback struct {
+	Ticket   *api.CRMObject  `json:"ticket"`
+	Feedback []api.CRMObject `json:"feedback"`
+}
+
+// sortFeedback orders submissions oldest first, falling back to creation
+// time when a submission has no timestamp
+func sortFeedback(subs []api.CRMObject) {
+	submitted := func(o api.CRMObject) string {
+		if ts := o.GetProperty("hs_submission_timestamp"); ts != "" {
+			return ts
+		}
+		return o.CreatedAt
+	}
+	sort.SliceStable(subs, func(i, j int) bool { return submitted(subs[i]) < submitted(subs[j]) })
+}
+
+func newFeedbackCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feedback <ticketId>",
+		Short: "Show a ticket with its feedback survey responses",
+		Long: `Show a ticket's details together with the feedback submissions (CSAT, NPS,
+and customer effort survey responses) associated with it, including each
+score, sentiment, and comment.`,
+		Example: `  # Spot-check a closed ticket's CSAT response
+  hspt tickets feedback 12345
+
+  # Scores only, for scripting
+  hspt tickets feedback 12345 --jq '.feedback[].properties.hs_response_value'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			ticket, err := client.GetObject(cmd.Context(), api.ObjectTypeTickets, id,
+				append(append([]string{}, DefaultProperties...), "closed_date"))
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Ticket %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			assocs, err := client.ListAssociations(cmd.Context(), api.ObjectTypeTickets, id, api.ObjectTypeFeedbackSubmissions, api.ListOptions{All: true})
+			if err != nil {
+				return fmt.Errorf("failed to list feedback submissions: %w", err)
+			}
+
+			result := ticketFeedback{Ticket: ticket, Feedback: []api.CRMObject{}}
+			for start := 0; start < len(assocs.Results); start += api.MaxBatchSize {
+				end := min(start+api.MaxBatchSize, len(assocs.Results))
+				ids := make([]string, 0, end-start)
+				for _, a := range assocs.Results[start:end] {
+					ids = append(ids, a.ToObjectID.String())
+				}
+
+				batch, err := client.BatchReadObjects(cmd.Context(), api.ObjectTypeFeedbackSubmissions, ids, feedbackProperties)
+				if err != nil {
+					return fmt.Errorf("failed to read feedback submissions: %w", err)
+				}
+				result.Feedback = append(result.Feedback, batch.Results...)
+			}
+			sortFeedback(result.Feedback)
+
+			if v.Format == view.FormatJSON {
+				return v.JSON(result)
+			}
+
+			v.Info("Ticket %s: %s", ticket.ID, ticket.GetProperty("subject"))
+			v.Info("Stage: %s  Priority: %s  Owner: %s", orDash(ticket.GetProperty("hs_pipeline_stage")),
+				orDash(ticket.GetProperty("hs_ticket_priority")), orDash(ticket.GetProperty("hubspot_owner_id")))
+			if closed := ticket.GetProperty("closed_date"); closed != "" {
+				v.Info("Closed: %s", v.Time(closed))
+			}
+
+			if len(result.Feedback) == 0 {
+				v.Info("No feedback submissions are associated with this ticket")
+				return nil
+			}
+
+			headers := []string{"ID", "SURVEY", "TYPE", "SCORE", "SENTIMENT", "SUBMITTED", "COMMENT"}
+			rows := make([][]string, 0, len(result.Feedback))
+			for _, f := range result.Feedback {
+				rows = append(rows, []string{
+					f.ID,
+					f.GetProperty("hs_survey_name"),
+					f.GetProperty("hs_survey_type"),
+					orDash(f.GetProperty("hs_response_value")),
+					orDash(f.GetProperty("hs_sentiment")),
+					orDash(v.Time(f.GetProperty("hs_submission_timestamp"))),
+					orDash(truncate(f.GetProperty("hs_content"), 60)),
+				})
+			}
+
+			return v.Render(headers, rows, result)
+		},
+	}
+
+	return cmd
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
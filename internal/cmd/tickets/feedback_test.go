@@ -0,0 +1,25 @@
+package tickets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestSortFeedback(t *testing.T) {
+	subs := []api.CRMObject{
+		{ID: "3", Properties: map[string]interface{}{"hs_submission_timestamp": "2024-06-03T10:00:00Z"}},
+		{ID: "1", CreatedAt: "2024-06-01T09:00:00Z"},
+		{ID: "2", Properties: map[string]interface{}{"hs_submission_timestamp": "2024-06-02T10:00:00Z"}},
+	}
+
+	sortFeedback(subs)
+
+	ids := make([]string, len(subs))
+	for i, s := range subs {
+		ids[i] = s.ID
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
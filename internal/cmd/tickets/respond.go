@@ -0,0 +1,412 @@
+package tickets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+// cannedDirName is the directory next to the config file holding canned
+// response templates, one file per response
+const cannedDirName = "canned"
+
+// cannedExtensions are the file names tried for a canned response, in order
+var cannedExtensions = []string{"", ".txt", ".md"}
+
+// contactProperties are the contact properties available to templates
+var contactProperties = []string{"firstname", "lastname", "email", "company"}
+
+// cannedResponse is a named reply template and where it was found
+type cannedResponse struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	Text   string `json:"text"`
+}
+
+// cannedDir returns the directory of canned response files
+func cannedDir() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cannedDirName), nil
+}
+
+// loadCanned finds a canned response in dir, then in the canned_responses
+// config key
+func loadCanned(dir string, configured map[string]string, name string) (*cannedResponse, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return nil, fmt.Errorf("invalid canned response name %q", name)
+	}
+
+	if dir != "" {
+		for _, ext := range cannedExtensions {
+			path := filepath.Join(dir, name+ext)
+			data, err := os.ReadFile(path)
+			if err == nil {
+				return &cannedResponse{Name: name, Source: path, Text: string(data)}, nil
+			}
+			if !errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("failed to read canned response: %w", err)
+			}
+		}
+	}
+
+	if text, ok := configured[name]; ok {
+		return &cannedResponse{Name: name, Source: "config", Text: text}, nil
+	}
+
+	names := cannedNames(dir, configured)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("canned response %q not found: add it to canned_responses in the config file or as a file in %s", name, dir)
+	}
+	return nil, fmt.Errorf("canned response %q not found (available: %s)", name, strings.Join(names, ", "))
+}
+
+// cannedNames lists the canned responses in dir and config, sorted
+func cannedNames(dir string, configured map[string]string) []string {
+	seen := make(map[string]bool)
+	if entries, err := os.ReadDir(dir); err == nil {
+		for _, e := range entries {
+			if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+				continue
+			}
+			name := e.Name()
+			for _, ext := range cannedExtensions[1:] {
+				name = strings.TrimSuffix(name, ext)
+			}
+			seen[name] = true
+		}
+	}
+	for name := range configured {
+		seen[name] = true
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// renderCanned fills in a canned response. Templates use Go template syntax
+// with .ticket, .contact, .agent, and .var, e.g. {{.contact.firstname}};
+// values that are not set render as empty text.
+func renderCanned(text string, data map[string]map[string]string) (string, error) {
+	tmpl, err := template.New("canned").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid canned response: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to fill in canned response: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// recordValues returns an object's ID and properties for a template
+func recordValues(obj *api.CRMObject) map[string]string {
+	values := map[string]string{}
+	if obj == nil {
+		return values
+	}
+	values["id"] = obj.ID
+	for name := range obj.Properties {
+		values[name] = obj.GetProperty(name)
+	}
+	return values
+}
+
+// parseVars parses --var key=value pairs
+func parseVars(raw []string) (map[string]string, error) {
+	vars := make(map[string]string, len(raw))
+	for _, r := range raw {
+		key, value, ok := strings.Cut(r, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --var %q (expected key=value)", r)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// latestThread picks the most recently updated thread
+func latestThread(threads []api.Thread) api.Thread {
+	latest := threads[0]
+	for _, t := range threads[1:] {
+		if t.UpdatedAt > latest.UpdatedAt {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// replyTo returns the latest incoming message of a thread, which the reply
+// goes back through
+func replyTo(messages []api.Message) *api.Message {
+	for i := len(messages) - 1; i >= 0; i-- {
+		m := messages[i]
+		if m.Type == "MESSAGE" && m.Direction == "INCOMING" {
+			return &m
+		}
+	}
+	return nil
+}
+
+// replyRecipients addresses a reply to the senders of a message
+func replyRecipients(msg *api.Message) []api.MessageRecipient {
+	var recipients []api.MessageRecipient
+	for _, s := range msg.Senders {
+		if s.DeliveryIdentifier == nil {
+			continue
+		}
+		recipients = append(recipients, api.MessageRecipient{
+			ActorID:            s.ActorID,
+			RecipientField:     "TO",
+			DeliveryIdentifier: s.DeliveryIdentifier,
+		})
+	}
+	return recipients
+}
+
+// ticketOwner returns the owner of a ticket, or nil when it has none or the
+// owner cannot be found
+func ticketOwner(ctx context.Context, client *api.Client, ticket *api.CRMObject) *api.Owner {
+	ownerID := ticket.GetProperty("hubspot_owner_id")
+	if ownerID == "" {
+		return nil
+	}
+	owner, err := client.GetOwner(ctx, ownerID)
+	if err != nil {
+		return nil
+	}
+	return owner
+}
+
+func newRespondCmd(opts *root.Options) *cobra.Command {
+	var canned, threadID, senderID string
+	var vars []string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "respond <ticketId>",
+		Short: "Reply to a ticket's conversation with a canned response",
+		Long: `Reply to the conversation thread of a ticket with a canned response.
+
+Canned responses are files in the "canned" directory next to the config file
+(thanks-resolved, thanks-resolved.txt, or thanks-resolved.md) or entries of
+canned_responses in the config file. They are Go templates that can use:
+
+  {{.ticket.subject}}, {{.ticket.id}}, or any other ticket property
+  {{.contact.firstname}}, {{.contact.email}}, ... of the thread's contact
+  {{.agent.firstname}}, {{.agent.lastname}}, {{.agent.email}} of the ticket owner
+  {{.var.name}} for each --var name=value
+
+The reply goes out through the channel of the customer's latest message,
+sent as the ticket owner unless --sender-id is given.`,
+		Example: `  # Preview a reply
+  hspt tickets respond 12345 --canned thanks-resolved --dry-run
+
+  # Send it
+  hspt tickets respond 12345 --canned thanks-resolved
+
+  # Fill in extra values
+  hspt tickets respond 12345 --canned refund-issued --var amount=49.00
+
+  # List canned responses
+  hspt tickets canned`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			ticketID := args[0]
+			ctx := cmd.Context()
+
+			if canned == "" {
+				return fmt.Errorf("--canned is required")
+			}
+			values, err := parseVars(vars)
+			if err != nil {
+				return err
+			}
+
+			dir, err := cannedDir()
+			if err != nil {
+				return err
+			}
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+			response, err := loadCanned(dir, cfg.CannedResponses, canned)
+			if err != nil {
+				return err
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			ticket, err := client.GetObject(ctx, api.ObjectTypeTickets, ticketID, DefaultProperties)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Ticket %s not found", ticketID)
+					return nil
+				}
+				return err
+			}
+
+			if threadID == "" {
+				threads, err := client.ListTicketThreads(ctx, ticketID)
+				if err != nil {
+					return fmt.Errorf("failed to find the ticket's conversation: %w", err)
+				}
+				if len(threads) == 0 {
+					return fmt.Errorf("ticket %s has no conversation thread to reply to", ticketID)
+				}
+				thread := latestThread(threads)
+				threadID = thread.ID
+				if len(threads) > 1 {
+					v.Info("Ticket has %d threads; replying to the latest, %s (use --thread-id to choose)", len(threads), threadID)
+				}
+			}
+			thread, err := client.GetThread(ctx, threadID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Thread %s not found", threadID)
+					return nil
+				}
+				return err
+			}
+
+			data := map[string]map[string]string{
+				"ticket":  recordValues(ticket),
+				"contact": {},
+				"agent":   {},
+				"var":     values,
+			}
+			if thread.AssociatedContactID != "" {
+				contact, err := client.GetObject(ctx, api.ObjectTypeContacts, thread.AssociatedContactID, contactProperties)
+				if err != nil && !api.IsNotFound(err) {
+					return fmt.Errorf("failed to get contact: %w", err)
+				}
+				data["contact"] = recordValues(contact)
+			}
+			owner := ticketOwner(ctx, client, ticket)
+			if owner != nil {
+				data["agent"] = map[string]string{
+					"id":        owner.ID,
+					"firstname": owner.FirstName,
+					"lastname":  owner.LastName,
+					"email":     owner.Email,
+				}
+			}
+
+			text, err := renderCanned(response.Text, data)
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				fmt.Fprintln(v.Out, text)
+				v.Info("Dry run: reply to thread %s not sent", threadID)
+				return nil
+			}
+
+			if senderID == "" {
+				if owner == nil || owner.UserID == 0 {
+					return fmt.Errorf("--sender-id is required when the ticket has no owner with a HubSpot user")
+				}
+				senderID = fmt.Sprintf("A-%d", owner.UserID)
+			}
+
+			messages, err := client.ListMessages(ctx, threadID, api.ListOptions{All: true, Limit: api.DefaultPageSize})
+			if err != nil {
+				return fmt.Errorf("failed to get thread messages: %w", err)
+			}
+			incoming := replyTo(messages.Results)
+			if incoming == nil {
+				return fmt.Errorf("thread %s has no incoming message to reply to", threadID)
+			}
+
+			msg, err := client.SendMessage(ctx, threadID, api.SendMessageRequest{
+				Type:             "MESSAGE",
+				Text:             text,
+				SenderID:         senderID,
+				ChannelID:        incoming.ChannelID,
+				ChannelAccountID: incoming.ChannelAccountID,
+				Recipients:       replyRecipients(incoming),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send reply: %w", err)
+			}
+
+			v.Success("Sent %q to thread %s (message %s)", response.Name, threadID, msg.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&canned, "canned", "", "Name of the canned response to send (required)")
+	cmd.Flags().StringArrayVar(&vars, "var", nil, "Template value in name=value format, used as {{.var.name}} (repeatable)")
+	cmd.Flags().StringVar(&threadID, "thread-id", "", "Conversation thread to reply to (default: the ticket's latest thread)")
+	cmd.Flags().StringVar(&senderID, "sender-id", "", "Actor ID to send as, e.g. A-12345 (default: the ticket owner)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the filled-in reply without sending it")
+
+	return cmd
+}
+
+func newCannedCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "canned",
+		Short: "List canned responses",
+		Long:  `List the canned responses "tickets respond --canned" can send.`,
+		Example: `  # List canned responses
+  hspt tickets canned`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			dir, err := cannedDir()
+			if err != nil {
+				return err
+			}
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			names := cannedNames(dir, cfg.CannedResponses)
+			if len(names) == 0 {
+				v.Info("No canned responses. Add them to canned_responses in the config file or as files in %s", dir)
+				return nil
+			}
+
+			responses := make([]*cannedResponse, 0, len(names))
+			rows := make([][]string, 0, len(names))
+			for _, name := range names {
+				r, err := loadCanned(dir, cfg.CannedResponses, name)
+				if err != nil {
+					return err
+				}
+				responses = append(responses, r)
+				first, _, _ := strings.Cut(strings.TrimSpace(r.Text), "\n")
+				rows = append(rows, []string{r.Name, r.Source, first})
+			}
+
+			return v.Render([]string{"NAME", "SOURCE", "FIRST LINE"}, rows, responses)
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package tickets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestLoadCanned(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "thanks-resolved.txt"), []byte("Hi {{.contact.firstname}}"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "escalated"), []byte("Escalated"), 0600))
+	configured := map[string]string{"thanks-resolved": "from config", "follow-up": "Any update?"}
+
+	r, err := loadCanned(dir, configured, "thanks-resolved")
+	require.NoError(t, err)
+	assert.Equal(t, "Hi {{.contact.firstname}}", r.Text, "files win over config")
+	assert.Equal(t, filepath.Join(dir, "thanks-resolved.txt"), r.Source)
+
+	r, err = loadCanned(dir, configured, "escalated")
+	require.NoError(t, err)
+	assert.Equal(t, "Escalated", r.Text)
+
+	r, err = loadCanned(dir, configured, "follow-up")
+	require.NoError(t, err)
+	assert.Equal(t, "config", r.Source)
+
+	_, err = loadCanned(dir, configured, "missing")
+	assert.ErrorContains(t, err, "available: escalated, follow-up, thanks-resolved")
+
+	_, err = loadCanned(dir, configured, "../secrets")
+	assert.ErrorContains(t, err, "invalid canned response name")
+
+	_, err = loadCanned(filepath.Join(dir, "none"), nil, "thanks")
+	assert.ErrorContains(t, err, "canned_responses")
+}
+
+func TestCannedNames(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.md"), nil, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".DS_Store"), nil, 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "drafts"), 0700))
+
+	assert.Equal(t, []string{"a", "b"}, cannedNames(dir, map[string]string{"b": "", "a": ""}))
+}
+
+func TestRenderCanned(t *testing.T) {
+	data := map[string]map[string]string{
+		"ticket":  {"id": "12345", "subject": "Login broken"},
+		"contact": {"firstname": "Ann"},
+		"agent":   {},
+		"var":     {"amount": "49.00"},
+	}
+
+	text, err := renderCanned("Hi {{.contact.firstname}},\n\nYour ticket \"{{.ticket.subject}}\" (#{{.ticket.id}}) is resolved; refund {{.var.amount}}.\n{{.agent.firstname}}\n", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Hi Ann,\n\nYour ticket \"Login broken\" (#12345) is resolved; refund 49.00.", text)
+
+	text, err = renderCanned("Hi {{.contact.lastname}}!", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Hi !", text, "unset values render empty")
+
+	_, err = renderCanned("Hi {{.contact.firstname", data)
+	assert.ErrorContains(t, err, "invalid canned response")
+}
+
+func TestParseVars(t *testing.T) {
+	vars, err := parseVars([]string{"amount=49.00", "note=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"amount": "49.00", "note": "a=b"}, vars)
+
+	_, err = parseVars([]string{"amount"})
+	assert.ErrorContains(t, err, "invalid --var")
+}
+
+func TestLatestThread(t *testing.T) {
+	threads := []api.Thread{
+		{ID: "1", UpdatedAt: "2024-01-01T00:00:00Z"},
+		{ID: "2", UpdatedAt: "2024-03-01T00:00:00Z"},
+		{ID: "3", UpdatedAt: "2024-02-01T00:00:00Z"},
+	}
+	assert.Equal(t, "2", latestThread(threads).ID)
+}
+
+func TestReplyTo(t *testing.T) {
+	email := &api.DeliveryIdentifier{Type: "HS_EMAIL_ADDRESS", Value: "ann@example.com"}
+	messages := []api.Message{
+		{ID: "1", Type: "MESSAGE", Direction: "INCOMING", ChannelID: "1002"},
+		{ID: "2", Type: "MESSAGE", Direction: "INCOMING", ChannelID: "1002", ChannelAccountID: "44",
+			Senders: []api.MessageSender{{ActorID: "V-9", DeliveryIdentifier: email}, {ActorID: "S-1"}}},
+		{ID: "3", Type: "MESSAGE", Direction: "OUTGOING"},
+		{ID: "4", Type: "THREAD_STATUS_CHANGE"},
+	}
+
+	msg := replyTo(messages)
+	require.NotNil(t, msg)
+	assert.Equal(t, "2", msg.ID)
+
+	recipients := replyRecipients(msg)
+	require.Len(t, recipients, 1)
+	assert.Equal(t, "TO", recipients[0].RecipientField)
+	assert.Equal(t, email, recipients[0].DeliveryIdentifier)
+
+	assert.Nil(t, replyTo(messages[2:]))
+}
+
+func TestRecordValues(t *testing.T) {
+	assert.Empty(t, recordValues(nil))
+
+	values := recordValues(&api.CRMObject{ID: "7", Properties: map[string]interface{}{"subject": "Help", "hs_ticket_priority": nil}})
+	assert.Equal(t, "7", values["id"])
+	assert.Equal(t, "Help", values["subject"])
+	assert.Equal(t, "", values["hs_ticket_priority"])
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/open-cli-collective/hubspot-cli/api"
 	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
 )
 
 // DefaultProperties are the default properties to fetch for tickets
@@ -27,6 +29,18 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newSearchCmd(opts))
+	cmd.AddCommand(newFeedbackCmd(opts))
+	cmd.AddCommand(newRespondCmd(opts))
+	cmd.AddCommand(newCannedCmd(opts))
+	cmd.AddCommand(shared.NewTimelineCmd(opts, shared.TimelineCmdConfig{
+		ObjectType: api.ObjectTypeTickets,
+		Noun:       "ticket",
+		Example: `  # Recent activity on a ticket
+  hspt tickets timeline 404
+
+  # Every entry, only emails and calls
+  hspt tickets timeline 404 --types email,call --limit 0`,
+	}))
 
 	parent.AddCommand(cmd)
 }
@@ -35,6 +49,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
 	var properties []string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -60,11 +75,11 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			result, err := client.ListObjects(api.ObjectTypeTickets, api.ListOptions{
+			result, err := client.ListObjects(cmd.Context(), api.ObjectTypeTickets, pages.Apply(cmd, api.ListOptions{
 				Limit:      limit,
 				After:      after,
 				Properties: properties,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -102,11 +117,14 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var properties []string
+	var history shared.PropertyHistory
 
 	cmd := &cobra.Command{
 		Use:   "get <id>",
@@ -128,7 +146,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				properties = DefaultProperties
 			}
 
-			obj, err := client.GetObject(api.ObjectTypeTickets, id, properties)
+			obj, err := client.GetObjectWithHistory(cmd.Context(), api.ObjectTypeTickets, id, properties, history.Properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Ticket %s not found", id)
@@ -146,21 +164,26 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Stage", obj.GetProperty("hs_pipeline_stage")},
 				{"Priority", obj.GetProperty("hs_ticket_priority")},
 				{"Owner ID", obj.GetProperty("hubspot_owner_id")},
-				{"Created", obj.CreatedAt},
-				{"Updated", obj.UpdatedAt},
+				{"Created", v.Time(obj.CreatedAt)},
+				{"Updated", v.Time(obj.UpdatedAt)},
 			}
 
-			return v.Render(headers, rows, obj)
+			if err := v.Render(headers, rows, obj); err != nil {
+				return err
+			}
+
+			return history.Render(v, obj)
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
+	shared.AddHistoryFlag(cmd, &history)
 
 	return cmd
 }
 
 func newCreateCmd(opts *root.Options) *cobra.Command {
-	var subject, content, pipeline, stage, priority, ownerID string
+	var subject, content, pipeline, stage, priority, ownerID, ownerEmail string
 	var props []string
 
 	cmd := &cobra.Command{
@@ -196,8 +219,12 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 			if priority != "" {
 				properties["hs_ticket_priority"] = priority
 			}
-			if ownerID != "" {
-				properties["hubspot_owner_id"] = ownerID
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
 			}
 
 			for _, p := range props {
@@ -211,7 +238,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property is required")
 			}
 
-			obj, err := client.CreateObject(api.ObjectTypeTickets, properties)
+			obj, err := client.CreateObject(cmd.Context(), api.ObjectTypeTickets, properties)
 			if err != nil {
 				return err
 			}
@@ -235,14 +262,14 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&pipeline, "pipeline", "", "Pipeline ID")
 	cmd.Flags().StringVar(&stage, "stage", "", "Pipeline stage")
 	cmd.Flags().StringVar(&priority, "priority", "", "Priority (LOW, MEDIUM, HIGH)")
-	cmd.Flags().StringVar(&ownerID, "owner", "", "Owner ID")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
 
 	return cmd
 }
 
 func newUpdateCmd(opts *root.Options) *cobra.Command {
-	var subject, content, pipeline, stage, priority, ownerID string
+	var subject, content, pipeline, stage, priority, ownerID, ownerEmail string
 	var props []string
 
 	cmd := &cobra.Command{
@@ -275,8 +302,12 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 			if priority != "" {
 				properties["hs_ticket_priority"] = priority
 			}
-			if ownerID != "" {
-				properties["hubspot_owner_id"] = ownerID
+			owner, err := shared.ResolveOwner(cmd.Context(), client, ownerID, ownerEmail)
+			if err != nil {
+				return err
+			}
+			if owner != "" {
+				properties["hubspot_owner_id"] = owner
 			}
 
 			for _, p := range props {
@@ -290,7 +321,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return fmt.Errorf("at least one property to update is required")
 			}
 
-			obj, err := client.UpdateObject(api.ObjectTypeTickets, id, properties)
+			obj, err := client.UpdateObject(cmd.Context(), api.ObjectTypeTickets, id, properties)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Ticket %s not found", id)
@@ -309,7 +340,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().StringVar(&pipeline, "pipeline", "", "Pipeline ID")
 	cmd.Flags().StringVar(&stage, "stage", "", "Pipeline stage")
 	cmd.Flags().StringVar(&priority, "priority", "", "Priority (LOW, MEDIUM, HIGH)")
-	cmd.Flags().StringVar(&ownerID, "owner", "", "Owner ID")
+	shared.AddOwnerFlags(cmd, &ownerID, &ownerEmail)
 	cmd.Flags().StringArrayVar(&props, "prop", nil, "Custom property in key=value format")
 
 	return cmd
@@ -337,7 +368,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			if err := client.DeleteObject(api.ObjectTypeTickets, id); err != nil {
+			if err := client.DeleteObject(cmd.Context(), api.ObjectTypeTickets, id); err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Ticket %s not found", id)
 					return nil
@@ -356,106 +387,38 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 }
 
 func newSearchCmd(opts *root.Options) *cobra.Command {
-	var subject, stage, priority, pipeline string
-	var limit int
-	var properties []string
-
-	cmd := &cobra.Command{
-		Use:   "search",
-		Short: "Search tickets",
-		Long:  "Search for tickets using filters.",
+	return shared.NewSearchCmd(opts, shared.SearchCmdConfig{
+		ObjectType: api.ObjectTypeTickets,
+		Noun:       "ticket",
+		Short:      "Search tickets",
+		Long: `Search for tickets using filters.
+
+The --subject, --stage, --priority, and --pipeline flags are shortcuts for
+common filters; --filter accepts any property and search operator.`,
 		Example: `  # Search by subject
   hspt tickets search --subject "login"
 
   # Search by priority
-  hspt tickets search --priority HIGH`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			if len(properties) == 0 {
-				properties = DefaultProperties
-			}
-
-			var filters []api.SearchFilter
-
-			if subject != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "subject",
-					Operator:     "CONTAINS_TOKEN",
-					Value:        subject,
-				})
-			}
-			if stage != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "hs_pipeline_stage",
-					Operator:     "EQ",
-					Value:        stage,
-				})
-			}
-			if priority != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "hs_ticket_priority",
-					Operator:     "EQ",
-					Value:        priority,
-				})
-			}
-			if pipeline != "" {
-				filters = append(filters, api.SearchFilter{
-					PropertyName: "hs_pipeline",
-					Operator:     "EQ",
-					Value:        pipeline,
-				})
-			}
-
-			req := api.SearchRequest{
-				Properties: properties,
-				Limit:      limit,
-			}
-
-			if len(filters) > 0 {
-				req.FilterGroups = []api.SearchFilterGroup{
-					{Filters: filters},
-				}
-			}
-
-			result, err := client.SearchObjects(api.ObjectTypeTickets, req)
-			if err != nil {
-				return err
-			}
-
-			if len(result.Results) == 0 {
-				v.Info("No tickets found matching criteria")
-				return nil
-			}
-
-			headers := []string{"ID", "SUBJECT", "STAGE", "PRIORITY", "PIPELINE"}
-			rows := make([][]string, 0, len(result.Results))
-			for _, obj := range result.Results {
-				rows = append(rows, []string{
-					obj.ID,
-					obj.GetProperty("subject"),
-					obj.GetProperty("hs_pipeline_stage"),
-					obj.GetProperty("hs_ticket_priority"),
-					obj.GetProperty("hs_pipeline"),
-				})
+  hspt tickets search --priority HIGH
+
+  # Unassigned tickets, oldest first
+  hspt tickets search --filter "hubspot_owner_id NOT_HAS_PROPERTY" --sort createdate:asc`,
+		Shorthands: []shared.SearchShorthand{
+			{Flag: "subject", Property: "subject", Operator: "CONTAINS_TOKEN", Usage: "Search by subject (contains)"},
+			{Flag: "stage", Property: "hs_pipeline_stage", Operator: "EQ", Usage: "Search by pipeline stage"},
+			{Flag: "priority", Property: "hs_ticket_priority", Operator: "EQ", Usage: "Search by priority (LOW, MEDIUM, HIGH)"},
+			{Flag: "pipeline", Property: "hs_pipeline", Operator: "EQ", Usage: "Search by pipeline ID"},
+		},
+		DefaultProperties: DefaultProperties,
+		Headers:           []string{"ID", "SUBJECT", "STAGE", "PRIORITY", "PIPELINE"},
+		Row: func(v *view.View, obj api.CRMObject) []string {
+			return []string{
+				obj.ID,
+				obj.GetProperty("subject"),
+				obj.GetProperty("hs_pipeline_stage"),
+				obj.GetProperty("hs_ticket_priority"),
+				obj.GetProperty("hs_pipeline"),
 			}
-
-			v.Info("Found %d ticket(s)", len(result.Results))
-			return v.Render(headers, rows, result)
 		},
-	}
-
-	cmd.Flags().StringVar(&subject, "subject", "", "Search by subject (contains)")
-	cmd.Flags().StringVar(&stage, "stage", "", "Search by pipeline stage")
-	cmd.Flags().StringVar(&priority, "priority", "", "Search by priority (LOW, MEDIUM, HIGH)")
-	cmd.Flags().StringVar(&pipeline, "pipeline", "", "Search by pipeline ID")
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
-	cmd.Flags().StringSliceVar(&properties, "properties", nil, "Properties to include (comma-separated)")
-
-	return cmd
+	})
 }
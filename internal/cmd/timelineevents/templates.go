@@ -0,0 +1,288 @@
+package timelineevents
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+func newTemplatesCmd(opts *root.Options) *cobra.Command {
+	a := &shared.DeveloperApp{}
+
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Manage a public app's timeline event templates",
+		Long: `Commands for the event templates that define an app's timeline cards: the
+record type they appear on, the markdown header and detail they show, and the
+tokens each event fills in with {{token}} placeholders.
+
+Templates authenticate with the developer account's API key. Pass the app ID
+and key with --app-id and --developer-key, or set HUBSPOT_APP_ID and
+HUBSPOT_DEVELOPER_API_KEY.`,
+	}
+
+	shared.AddDeveloperAppFlags(cmd, a)
+
+	cmd.AddCommand(newTemplatesListCmd(opts, a))
+	cmd.AddCommand(newTemplatesCreateCmd(opts, a))
+	cmd.AddCommand(newTemplatesUpdateCmd(opts, a))
+
+	return cmd
+}
+
+// tokenNames lists a template's token names
+func tokenNames(tokens []api.TimelineEventToken) string {
+	names := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// templateRows are the rows of a single template
+func templateRows(t *api.TimelineEventTemplate) [][]string {
+	return [][]string{
+		{"ID", t.ID},
+		{"Name", t.Name},
+		{"Object Type", t.ObjectType},
+		{"Header", t.HeaderTemplate},
+		{"Detail", t.DetailTemplate},
+		{"Tokens", tokenNames(t.Tokens)},
+	}
+}
+
+// templateText returns a template's markdown from a flag or, when file is
+// set, the file it names
+func templateText(value, file string) (string, error) {
+	if file == "" {
+		return value, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return string(data), nil
+}
+
+// mergeTokens adds tokens to a template's, replacing those with the same
+// name, and removes the tokens named in remove
+func mergeTokens(current, tokens []api.TimelineEventToken, remove []string) ([]api.TimelineEventToken, error) {
+	merged := slices.Clone(current)
+	for _, t := range tokens {
+		if i := slices.IndexFunc(merged, func(m api.TimelineEventToken) bool { return m.Name == t.Name }); i >= 0 {
+			merged[i] = t
+		} else {
+			merged = append(merged, t)
+		}
+	}
+
+	for _, name := range remove {
+		i := slices.IndexFunc(merged, func(m api.TimelineEventToken) bool { return m.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("the template has no token %q to remove", name)
+		}
+		merged = slices.Delete(merged, i, i+1)
+	}
+
+	return merged, nil
+}
+
+// parseTokens reads --token flags into token definitions
+func parseTokens(raw []string) ([]api.TimelineEventToken, error) {
+	var tokens []api.TimelineEventToken
+	for _, r := range raw {
+		token, err := parseToken(r)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func newTemplatesListCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List event templates",
+		Long:  "List the app's timeline event templates.",
+		Example: `  # List templates
+  HUBSPOT_DEVELOPER_API_KEY=... hspt timeline-events templates list --app-id 123456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListTimelineEventTemplates(cmd.Context(), a.ID)
+			if err != nil {
+				return fmt.Errorf("failed to list event templates: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No event templates found")
+				return nil
+			}
+
+			headers := []string{"ID", "NAME", "OBJECT TYPE", "TOKENS", "UPDATED"}
+			rows := make([][]string, 0, len(result.Results))
+			for _, t := range result.Results {
+				rows = append(rows, []string{t.ID, t.Name, t.ObjectType, tokenNames(t.Tokens), v.Time(t.UpdatedAt)})
+			}
+
+			return v.Render(headers, rows, result)
+		},
+	}
+}
+
+func newTemplatesCreateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var name, objectType, header, detail, detailFile string
+	var tokens []string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an event template",
+		Long: `Create a timeline event template. The header and detail are markdown with
+{{token}} placeholders; the detail is shown when the card is expanded.
+
+Tokens are name, name:type, or name:enumeration:value1|value2, where type is
+string (the default), number, date, or enumeration.`,
+		Example: `  # A contact card for webinar registrations
+  hspt timeline-events templates create --app-id 123456 --name "Webinar registration" \
+    --header "Registered for {{webinarName}}" --token webinarName --token seats:number
+
+  # A longer detail from a file
+  hspt timeline-events templates create --app-id 123456 --name "Plan change" --object-type companies \
+    --header "Moved to {{plan}}" --detail-file plan-change.md --token "plan:enumeration:starter|pro|enterprise"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if name == "" {
+				return fmt.Errorf("--name is required")
+			}
+			parsed, err := parseTokens(tokens)
+			if err != nil {
+				return err
+			}
+			if detail, err = templateText(detail, detailFile); err != nil {
+				return err
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			template, err := client.CreateTimelineEventTemplate(cmd.Context(), a.ID, api.TimelineEventTemplate{
+				Name:           name,
+				ObjectType:     strings.ToLower(objectType),
+				HeaderTemplate: header,
+				DetailTemplate: detail,
+				Tokens:         parsed,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create event template: %w", err)
+			}
+
+			v.Success("Event template created with ID: %s", template.ID)
+			return v.Render([]string{"PROPERTY", "VALUE"}, templateRows(template), template)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Template name (required)")
+	cmd.Flags().StringVar(&objectType, "object-type", "contacts", "Records the cards appear on: contacts, companies, deals, or tickets")
+	cmd.Flags().StringVar(&header, "header", "", "Markdown header of each card, with {{token}} placeholders")
+	cmd.Flags().StringVar(&detail, "detail", "", "Markdown shown when a card is expanded")
+	cmd.Flags().StringVar(&detailFile, "detail-file", "", "Read the detail markdown from a file")
+	cmd.Flags().StringArrayVar(&tokens, "token", nil, "Token as name, name:type, or name:enumeration:a|b (repeatable)")
+
+	return cmd
+}
+
+func newTemplatesUpdateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var name, header, detail, detailFile string
+	var tokens, remove []string
+
+	cmd := &cobra.Command{
+		Use:   "update <template-id>",
+		Short: "Update an event template",
+		Long: `Update an event template's name, markdown, or tokens. Tokens given with
+--token are added, or replace the token of the same name; --remove-token
+removes one. Whatever is not set is kept as it is.`,
+		Example: `  # Change the header
+  hspt timeline-events templates update 1001 --app-id 123456 --header "Signed up for {{webinarName}}"
+
+  # Add a token and drop another
+  hspt timeline-events templates update 1001 --app-id 123456 --token "attended:enumeration:yes|no" --remove-token seats`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+			flags := cmd.Flags()
+
+			if !flags.Changed("name") && !flags.Changed("header") && !flags.Changed("detail") && detailFile == "" && len(tokens) == 0 && len(remove) == 0 {
+				return fmt.Errorf("nothing to update: set --name, --header, --detail, --detail-file, --token, or --remove-token")
+			}
+			parsed, err := parseTokens(tokens)
+			if err != nil {
+				return err
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			template, err := client.GetTimelineEventTemplate(cmd.Context(), a.ID, id)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Event template %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to get event template: %w", err)
+			}
+
+			if flags.Changed("name") {
+				template.Name = name
+			}
+			if flags.Changed("header") {
+				template.HeaderTemplate = header
+			}
+			if flags.Changed("detail") || detailFile != "" {
+				if template.DetailTemplate, err = templateText(detail, detailFile); err != nil {
+					return err
+				}
+			}
+			if template.Tokens, err = mergeTokens(template.Tokens, parsed, remove); err != nil {
+				return err
+			}
+
+			template.ID = id
+
+			updated, err := client.UpdateTimelineEventTemplate(cmd.Context(), a.ID, *template)
+			if err != nil {
+				return fmt.Errorf("failed to update event template: %w", err)
+			}
+
+			v.Success("Event template %s updated", id)
+			return v.Render([]string{"PROPERTY", "VALUE"}, templateRows(updated), updated)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "New template name")
+	cmd.Flags().StringVar(&header, "header", "", "New markdown header")
+	cmd.Flags().StringVar(&detail, "detail", "", "New markdown detail")
+	cmd.Flags().StringVar(&detailFile, "detail-file", "", "Read the new detail markdown from a file")
+	cmd.Flags().StringArrayVar(&tokens, "token", nil, "Token to add or replace, as name, name:type, or name:enumeration:a|b (repeatable)")
+	cmd.Flags().StringArrayVar(&remove, "remove-token", nil, "Name of a token to remove (repeatable)")
+
+	return cmd
+}
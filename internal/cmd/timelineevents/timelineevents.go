@@ -0,0 +1,167 @@
+package timelineevents
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// Register registers the timeline-events command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "timeline-events",
+		Short: "Add custom timeline cards to CRM records",
+		Long: `Commands for a public app's timeline events: the custom cards an
+integration adds to the timeline of contacts, companies, deals, and tickets.
+
+An app defines the cards with event templates, managed with the developer
+API key, then creates events from a template with the portal's access token.`,
+	}
+
+	cmd.AddCommand(newTemplatesCmd(opts))
+	cmd.AddCommand(newCreateCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// tokenTypes are the types a timeline event token can have
+var tokenTypes = []string{"string", "number", "date", "enumeration"}
+
+// parseToken reads a --token value: name, name:type, or
+// name:enumeration:value1|value2
+func parseToken(raw string) (api.TimelineEventToken, error) {
+	parts := strings.SplitN(raw, ":", 3)
+	name := strings.TrimSpace(parts[0])
+	if name == "" {
+		return api.TimelineEventToken{}, fmt.Errorf("invalid --token %q: use name, name:type, or name:enumeration:a|b", raw)
+	}
+
+	token := api.TimelineEventToken{Name: name, Label: name, Type: "string"}
+	if len(parts) > 1 {
+		token.Type = strings.ToLower(strings.TrimSpace(parts[1]))
+	}
+	if !slices.Contains(tokenTypes, token.Type) {
+		return api.TimelineEventToken{}, fmt.Errorf("invalid --token %q: type must be one of %s", raw, strings.Join(tokenTypes, ", "))
+	}
+
+	if token.Type == "enumeration" {
+		if len(parts) < 3 || strings.TrimSpace(parts[2]) == "" {
+			return api.TimelineEventToken{}, fmt.Errorf("invalid --token %q: list an enumeration's values as name:enumeration:a|b", raw)
+		}
+		for _, value := range strings.Split(parts[2], "|") {
+			if value = strings.TrimSpace(value); value != "" {
+				token.Options = append(token.Options, api.TimelineEventTokenOption{Label: value, Value: value})
+			}
+		}
+	} else if len(parts) == 3 {
+		return api.TimelineEventToken{}, fmt.Errorf("invalid --token %q: only enumerations list values", raw)
+	}
+
+	return token, nil
+}
+
+// parseTokenValues reads --token key=value flags into an event's tokens
+func parseTokenValues(raw []string) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	tokens := make(map[string]string, len(raw))
+	for _, t := range raw {
+		parts := strings.SplitN(t, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid --token %q: use key=value", t)
+		}
+		tokens[strings.TrimSpace(parts[0])] = parts[1]
+	}
+	return tokens, nil
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var templateID, objectID, email, utk, timestamp, id string
+	var tokens []string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a timeline event to a record",
+		Long: `Add a card to a record's timeline from one of the app's event templates,
+filling in the template's tokens.
+
+Give the record with --object-id, or a contact with --email or --utk (the
+visitor's hubspotutk cookie). Timeline events are created with the portal's
+access token, which must be an OAuth token of the app that owns the
+template. Reusing an --id updates that event instead of adding another.`,
+		Example: `  # Add a webinar registration to a contact's timeline
+  hspt timeline-events create --template-id 1001 --email user@example.com --token webinarName="Q3 launch"
+
+  # For a record by ID, backdated
+  hspt timeline-events create --template-id 1002 --object-id 555 --token plan=pro --timestamp 2024-06-01T09:00:00Z`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if templateID == "" {
+				return fmt.Errorf("--template-id is required")
+			}
+			if objectID == "" && email == "" && utk == "" {
+				return fmt.Errorf("set --object-id, --email, or --utk for the record the event is added to")
+			}
+
+			values, err := parseTokenValues(tokens)
+			if err != nil {
+				return err
+			}
+			event := api.TimelineEvent{
+				ID:              id,
+				EventTemplateID: templateID,
+				ObjectID:        objectID,
+				Email:           email,
+				UTK:             utk,
+				Tokens:          values,
+			}
+			if timestamp != "" {
+				at, err := time.Parse(time.RFC3339, timestamp)
+				if err != nil {
+					return fmt.Errorf("invalid --timestamp %q: use RFC 3339, e.g. 2024-06-01T09:00:00Z", timestamp)
+				}
+				event.Timestamp = &at
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			created, err := client.CreateTimelineEvent(cmd.Context(), event)
+			if err != nil {
+				return fmt.Errorf("failed to create timeline event: %w", err)
+			}
+
+			v.Success("Timeline event created with ID: %s", created.ID)
+
+			headers := []string{"PROPERTY", "VALUE"}
+			rows := [][]string{
+				{"ID", created.ID},
+				{"Template", created.EventTemplateID},
+				{"Object Type", created.ObjectType},
+				{"Object ID", created.ObjectID},
+			}
+			return v.Render(headers, rows, created)
+		},
+	}
+
+	cmd.Flags().StringVar(&templateID, "template-id", "", "Event template to create the event from (required)")
+	cmd.Flags().StringVar(&objectID, "object-id", "", "ID of the record the event is added to")
+	cmd.Flags().StringVar(&email, "email", "", "Email of the contact the event is added to")
+	cmd.Flags().StringVar(&utk, "utk", "", "hubspotutk cookie of the contact the event is added to")
+	cmd.Flags().StringArrayVar(&tokens, "token", nil, "Token value in key=value format (repeatable)")
+	cmd.Flags().StringVar(&timestamp, "timestamp", "", "When the event happened, RFC 3339 (default: now)")
+	cmd.Flags().StringVar(&id, "id", "", "Event ID, unique per template; reusing one updates that event")
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+package timelineevents
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func TestParseToken(t *testing.T) {
+	token, err := parseToken("webinarName")
+	require.NoError(t, err)
+	assert.Equal(t, api.TimelineEventToken{Name: "webinarName", Label: "webinarName", Type: "string"}, token)
+
+	token, err = parseToken("seats:NUMBER")
+	require.NoError(t, err)
+	assert.Equal(t, "number", token.Type)
+
+	token, err = parseToken("plan:enumeration:starter|pro")
+	require.NoError(t, err)
+	assert.Equal(t, []api.TimelineEventTokenOption{{Label: "starter", Value: "starter"}, {Label: "pro", Value: "pro"}}, token.Options)
+
+	_, err = parseToken("plan:enumeration")
+	assert.ErrorContains(t, err, "enumeration's values")
+
+	_, err = parseToken("seats:integer")
+	assert.ErrorContains(t, err, "type must be one of")
+
+	_, err = parseToken("seats:number:1|2")
+	assert.ErrorContains(t, err, "only enumerations")
+}
+
+func TestParseTokenValues(t *testing.T) {
+	values, err := parseTokenValues([]string{"webinarName=Q3 launch", "query=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"webinarName": "Q3 launch", "query": "a=b"}, values)
+
+	values, err = parseTokenValues(nil)
+	require.NoError(t, err)
+	assert.Nil(t, values)
+
+	_, err = parseTokenValues([]string{"webinarName"})
+	assert.ErrorContains(t, err, "key=value")
+}
+
+func TestMergeTokens(t *testing.T) {
+	current := []api.TimelineEventToken{
+		{Name: "webinarName", Type: "string"},
+		{Name: "seats", Type: "number"},
+	}
+
+	merged, err := mergeTokens(current, []api.TimelineEventToken{
+		{Name: "seats", Type: "string"},
+		{Name: "attended", Type: "enumeration"},
+	}, []string{"webinarName"})
+	require.NoError(t, err)
+	assert.Equal(t, []api.TimelineEventToken{
+		{Name: "seats", Type: "string"},
+		{Name: "attended", Type: "enumeration"},
+	}, merged)
+	assert.Equal(t, "number", current[1].Type, "the current tokens are left alone")
+
+	_, err = mergeTokens(current, nil, []string{"missing"})
+	assert.ErrorContains(t, err, `no token "missing"`)
+}
+
+func TestTemplateText(t *testing.T) {
+	text, err := templateText("inline", "")
+	require.NoError(t, err)
+	assert.Equal(t, "inline", text)
+
+	path := filepath.Join(t.TempDir(), "detail.md")
+	require.NoError(t, os.WriteFile(path, []byte("**{{plan}}**\n"), 0o600))
+	text, err = templateText("ignored", path)
+	require.NoError(t, err)
+	assert.Equal(t, "**{{plan}}**\n", text)
+
+	_, err = templateText("", filepath.Join(t.TempDir(), "missing.md"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package upgradecmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/upgrade"
+	"github.com/open-cli-collective/hubspot-cli/internal/version"
+)
+
+// upgradeResult is the JSON output of upgrade
+type upgradeResult struct {
+	Current   string `json:"current"`
+	Latest    string `json:"latest"`
+	Channel   string `json:"channel"`
+	URL       string `json:"url,omitempty"`
+	Available bool   `json:"available"`
+	Upgraded  bool   `json:"upgraded"`
+}
+
+// Register registers the upgrade command
+func Register(parent *cobra.Command, opts *root.Options) {
+	var channel string
+	var check, force bool
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade hspt to the latest release",
+		Long: `Check GitHub for the latest hspt release and replace the running binary
+with it.
+
+The download is verified before anything is replaced: the release's
+checksums.txt must be signed by the release signing key built into hspt, and
+the archive must match its checksum there.
+
+Installs managed by Homebrew, snap, Chocolatey, WinGet, or a system package
+manager should be upgraded with that package manager; --force replaces the
+binary anyway.`,
+		Example: `  # See whether a newer release is out
+  hspt upgrade --check
+
+  # Upgrade to the latest stable release
+  hspt upgrade
+
+  # Try the latest prerelease
+  hspt upgrade --channel prerelease`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.HTTPClient()
+			if err != nil {
+				return err
+			}
+			u := &upgrade.Updater{
+				HTTPClient: client,
+				APIURL:     upgrade.DefaultAPIURL,
+				SigningKey: version.SigningKey,
+			}
+
+			release, err := u.Latest(cmd.Context(), channel)
+			if err != nil {
+				return err
+			}
+
+			result := upgradeResult{
+				Current: version.Version,
+				Latest:  release.Version(),
+				Channel: channel,
+				URL:     release.URL,
+			}
+			dev := version.Version == "dev"
+			result.Available = dev || upgrade.Compare(result.Latest, result.Current) > 0
+
+			render := func() error {
+				rows := [][]string{
+					{"Current", result.Current},
+					{"Latest", result.Latest},
+					{"Channel", result.Channel},
+					{"Release notes", result.URL},
+				}
+				return v.Render([]string{"PROPERTY", "VALUE"}, rows, result)
+			}
+
+			if check || (!result.Available && !force) {
+				if err := render(); err != nil {
+					return err
+				}
+				switch {
+				case !result.Available:
+					v.Success("hspt %s is up to date", result.Current)
+				case dev:
+					v.Info("This is a development build; hspt upgrade --force installs %s", result.Latest)
+				default:
+					v.Info("hspt %s is available. Run hspt upgrade to install it.", result.Latest)
+				}
+				return nil
+			}
+			if dev && !force {
+				v.Info("This is a development build; use --force to replace it with %s", result.Latest)
+				return nil
+			}
+
+			exe, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("failed to locate the hspt binary: %w", err)
+			}
+			if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+				exe = resolved
+			}
+			if manager := upgrade.ManagedBy(exe); manager != "" && !force {
+				return fmt.Errorf("hspt at %s was installed with %s; upgrade it there, or use --force to replace it", exe, manager)
+			}
+
+			v.Info("Downloading hspt %s for %s/%s...", result.Latest, runtime.GOOS, runtime.GOARCH)
+			binary, err := u.Fetch(cmd.Context(), release, runtime.GOOS, runtime.GOARCH)
+			if err != nil {
+				return fmt.Errorf("failed to download hspt %s: %w", result.Latest, err)
+			}
+			if err := upgrade.Install(exe, binary); err != nil {
+				return err
+			}
+
+			result.Upgraded = true
+			v.Success("Upgraded hspt %s to %s", result.Current, result.Latest)
+			return render()
+		},
+	}
+
+	cmd.Flags().StringVar(&channel, "channel", upgrade.ChannelStable, "Release channel: stable or prerelease")
+	cmd.Flags().BoolVar(&check, "check", false, "Only report whether a newer release is available")
+	cmd.Flags().BoolVar(&force, "force", false, "Reinstall even when up to date, or replace a development or package-managed binary")
+
+	parent.AddCommand(cmd)
+}
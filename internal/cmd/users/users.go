@@ -0,0 +1,455 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+// Register registers the users command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "Manage portal users",
+		Long: `Commands for listing, viewing, adding, updating, and removing the portal's
+users, and assigning their roles and teams.
+
+Users are given by user ID or email. Roles and teams are given by ID or
+name. Requires the settings.users.read scope, and settings.users.write to
+make changes.`,
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newUpdateCmd(opts))
+	cmd.AddCommand(newDeleteCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// directory names the portal's roles and teams by ID. Either is empty when
+// it cannot be listed, and IDs are shown instead.
+type directory struct {
+	roles map[string]string
+	teams map[string]string
+}
+
+func loadDirectory(ctx context.Context, client *api.Client) directory {
+	d := directory{roles: make(map[string]string), teams: make(map[string]string)}
+	if roles, err := client.ListRoles(ctx); err == nil {
+		for _, role := range roles {
+			d.roles[role.ID] = role.Name
+		}
+	}
+	if teams, err := client.ListTeams(ctx); err == nil {
+		for _, team := range teams {
+			d.teams[team.ID] = team.Name
+		}
+	}
+	return d
+}
+
+// name returns the name of id in names, or id when it has none
+func name(names map[string]string, id string) string {
+	if names[id] != "" {
+		return names[id]
+	}
+	return id
+}
+
+// namesOf returns the names of ids in names, comma-separated
+func namesOf(names map[string]string, ids []string) string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, name(names, id))
+	}
+	return strings.Join(result, ", ")
+}
+
+// roleIDs returns the user's role IDs, including the single roleId older
+// responses carry
+func roleIDs(u *api.User) []string {
+	if len(u.RoleIDs) == 0 && u.RoleID != "" {
+		return []string{u.RoleID}
+	}
+	return u.RoleIDs
+}
+
+// resolveID returns the ID of the role or team given by ID or by name,
+// matched case-insensitively. kind names what is looked up in errors. When
+// the portal's roles or teams could not be listed, value is taken as an ID.
+func resolveID(names map[string]string, value, kind string) (string, error) {
+	value = strings.TrimSpace(value)
+	if _, ok := names[value]; ok || len(names) == 0 {
+		return value, nil
+	}
+
+	var found []string
+	for id, n := range names {
+		if strings.EqualFold(n, value) {
+			found = append(found, id)
+		}
+	}
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		available := make([]string, 0, len(names))
+		for _, n := range names {
+			available = append(available, n)
+		}
+		sort.Strings(available)
+		return "", fmt.Errorf("unknown %s %q: use one of %s", kind, value, strings.Join(available, ", "))
+	default:
+		sort.Strings(found)
+		return "", fmt.Errorf("%d %ss are named %q: give one of their IDs %s", len(found), kind, value, strings.Join(found, ", "))
+	}
+}
+
+// userFlags are the user fields create and update take as flags
+type userFlags struct {
+	firstName, lastName string
+	role, team          string
+	secondaryTeams      []string
+}
+
+func (f *userFlags) add(flags *pflag.FlagSet) {
+	flags.StringVar(&f.firstName, "first-name", "", "First name")
+	flags.StringVar(&f.lastName, "last-name", "", "Last name")
+	flags.StringVar(&f.role, "role", "", "Role, by ID or name")
+	flags.StringVar(&f.team, "team", "", "Primary team, by ID or name")
+	flags.StringSliceVar(&f.secondaryTeams, "secondary-teams", nil, "Additional teams, by ID or name (comma-separated)")
+}
+
+// lookup returns the portal's roles and teams when a flag names one
+func (f *userFlags) lookup(ctx context.Context, client *api.Client, flags *pflag.FlagSet) directory {
+	if flags.Changed("role") || flags.Changed("team") || flags.Changed("secondary-teams") {
+		return loadDirectory(ctx, client)
+	}
+	return directory{}
+}
+
+// fields returns the user fields of the flags that were set, looking up
+// roles and teams given by name in d
+func (f *userFlags) fields(flags *pflag.FlagSet, d directory) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if flags.Changed("first-name") {
+		fields["firstName"] = f.firstName
+	}
+	if flags.Changed("last-name") {
+		fields["lastName"] = f.lastName
+	}
+	if flags.Changed("role") {
+		id, err := resolveID(d.roles, f.role, "role")
+		if err != nil {
+			return nil, err
+		}
+		fields["roleId"] = id
+	}
+	if flags.Changed("team") {
+		id, err := resolveID(d.teams, f.team, "team")
+		if err != nil {
+			return nil, err
+		}
+		fields["primaryTeamId"] = id
+	}
+	if flags.Changed("secondary-teams") {
+		ids := make([]string, 0, len(f.secondaryTeams))
+		for _, team := range f.secondaryTeams {
+			id, err := resolveID(d.teams, team, "team")
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+		fields["secondaryTeamIds"] = ids
+	}
+	return fields, nil
+}
+
+// userRows returns the rows of a user's detail table
+func userRows(u *api.User, d directory) [][]string {
+	return [][]string{
+		{"ID", u.ID},
+		{"Email", u.Email},
+		{"First Name", u.FirstName},
+		{"Last Name", u.LastName},
+		{"Roles", namesOf(d.roles, roleIDs(u))},
+		{"Primary Team", name(d.teams, u.PrimaryTeamID)},
+		{"Secondary Teams", namesOf(d.teams, u.SecondaryTeamIDs)},
+		{"Super Admin", shared.FormatBool(u.SuperAdmin)},
+	}
+}
+
+// renderUser shows a created or updated user: its details for JSON output,
+// for scripts to pick up the ID, and a status line otherwise
+func renderUser(v *view.View, u *api.User, status string, args ...interface{}) error {
+	if v.Format == view.FormatJSON {
+		return v.Render(nil, nil, u)
+	}
+	v.Success(status, args...)
+	return nil
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var limit int
+	var after string
+	var pages shared.AllPages
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+		Long:  "List the portal's users with their roles and primary teams.",
+		Example: `  # List users
+  hspt users list
+
+  # Every user, for an access review
+  hspt users list --all -o csv > users.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListUsers(cmd.Context(), pages.Apply(cmd, api.ListOptions{
+				Limit: limit,
+				After: after,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list users: %w", err)
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No users found")
+				return nil
+			}
+
+			d := loadDirectory(cmd.Context(), client)
+
+			headers := []string{"ID", "EMAIL", "NAME", "ROLES", "PRIMARY TEAM", "SUPER ADMIN"}
+			rows := make([][]string, 0, len(result.Results))
+			for i := range result.Results {
+				u := &result.Results[i]
+				rows = append(rows, []string{
+					u.ID,
+					u.Email,
+					strings.TrimSpace(u.FirstName + " " + u.LastName),
+					namesOf(d.roles, roleIDs(u)),
+					name(d.teams, u.PrimaryTeamID),
+					shared.FormatBool(u.SuperAdmin),
+				})
+			}
+
+			if err := v.Render(headers, rows, result); err != nil {
+				return err
+			}
+
+			if result.Paging != nil && result.Paging.Next != nil {
+				v.Info("\nMore results available. Use --after %s to get the next page.", result.Paging.Next.After)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of users to return")
+	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
+
+	shared.AddAllPagesFlags(cmd, &pages)
+
+	return cmd
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id|email>",
+		Short: "Get a user by ID or email",
+		Long:  "Retrieve a single portal user, with their roles and teams, by user ID or email.",
+		Example: `  # Get user by ID
+  hspt users get 12345
+
+  # Get user by email
+  hspt users get ada@example.com`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			u, err := client.GetUser(cmd.Context(), id)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("User %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to get user: %w", err)
+			}
+
+			d := loadDirectory(cmd.Context(), client)
+			return v.Render([]string{"PROPERTY", "VALUE"}, userRows(u, d), u)
+		},
+	}
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var f userFlags
+	var email string
+	var welcome bool
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a user",
+		Long: `Add a user to the portal, optionally with a role and teams.
+
+HubSpot sends the new user an invitation only with --send-welcome-email.
+With -o json the created user is printed, for scripts to pick up its ID.`,
+		Example: `  # Add a user with a role and team
+  hspt users create --email ada@example.com --first-name Ada --last-name Lovelace --role "Sales Rep" --team "EMEA Sales"
+
+  # Invite them too
+  hspt users create --email ada@example.com --role 12345 --send-welcome-email
+
+  # Provision from a CSV of email,role,team
+  while IFS=, read -r email role team; do
+    hspt users create --email "$email" --role "$role" --team "$team" -o json | jq -r .id
+  done < new-hires.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if email == "" {
+				return fmt.Errorf("--email is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			fields, err := f.fields(cmd.Flags(), f.lookup(cmd.Context(), client, cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			fields["email"] = email
+			fields["sendWelcomeEmail"] = welcome
+
+			u, err := client.CreateUser(cmd.Context(), fields)
+			if err != nil {
+				return fmt.Errorf("failed to create user: %w", err)
+			}
+
+			return renderUser(v, u, "User created with ID: %s", u.ID)
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Email address (required)")
+	cmd.Flags().BoolVar(&welcome, "send-welcome-email", false, "Email the user an invitation to the portal")
+	f.add(cmd.Flags())
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options) *cobra.Command {
+	var f userFlags
+
+	cmd := &cobra.Command{
+		Use:   "update <id|email>",
+		Short: "Update a user",
+		Long:  "Update the name, role, or teams of a user given as flags; the others are left as they are.",
+		Example: `  # Change a user's role
+  hspt users update ada@example.com --role "Sales Manager"
+
+  # Move a user to another team
+  hspt users update 12345 --team "APAC Sales" --secondary-teams "Partners"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			fields, err := f.fields(cmd.Flags(), f.lookup(cmd.Context(), client, cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			if len(fields) == 0 {
+				return fmt.Errorf("nothing to update: set at least one of --first-name, --last-name, --role, --team, or --secondary-teams")
+			}
+
+			u, err := client.UpdateUser(cmd.Context(), id, fields)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("User %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to update user: %w", err)
+			}
+
+			return renderUser(v, u, "User %s updated", id)
+		},
+	}
+
+	f.add(cmd.Flags())
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id|email>",
+		Short: "Remove a user",
+		Long:  "Remove a user from the portal. Records they own keep them as an archived owner.",
+		Example: `  # Remove a user
+  hspt users delete ada@example.com --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !force {
+				v.Warning("This will remove user %s from the portal. Use --force to confirm.", id)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteUser(cmd.Context(), id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("User %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to delete user: %w", err)
+			}
+
+			v.Success("User %s removed", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+var testDirectory = directory{
+	roles: map[string]string{"7": "Sales Rep", "8": "Sales Manager"},
+	teams: map[string]string{"100": "EMEA Sales", "200": "Partners", "300": "Partners"},
+}
+
+func TestResolveID(t *testing.T) {
+	id, err := resolveID(testDirectory.roles, "7", "role")
+	require.NoError(t, err)
+	assert.Equal(t, "7", id)
+
+	id, err = resolveID(testDirectory.roles, " sales manager ", "role")
+	require.NoError(t, err)
+	assert.Equal(t, "8", id)
+
+	_, err = resolveID(testDirectory.roles, "Admin", "role")
+	assert.EqualError(t, err, `unknown role "Admin": use one of Sales Manager, Sales Rep`)
+
+	_, err = resolveID(testDirectory.teams, "Partners", "team")
+	assert.EqualError(t, err, `2 teams are named "Partners": give one of their IDs 200, 300`)
+
+	// Roles that could not be listed are taken as IDs
+	id, err = resolveID(map[string]string{}, "99", "role")
+	require.NoError(t, err)
+	assert.Equal(t, "99", id)
+}
+
+func TestUserFlagsFields(t *testing.T) {
+	var f userFlags
+	cmd := &cobra.Command{}
+	f.add(cmd.Flags())
+	require.NoError(t, cmd.Flags().Parse([]string{"--first-name", "Ada", "--role", "Sales Rep", "--team", "EMEA Sales", "--secondary-teams", "200"}))
+
+	fields, err := f.fields(cmd.Flags(), testDirectory)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"firstName":        "Ada",
+		"roleId":           "7",
+		"primaryTeamId":    "100",
+		"secondaryTeamIds": []string{"200"},
+	}, fields)
+
+	var unset userFlags
+	cmd = &cobra.Command{}
+	unset.add(cmd.Flags())
+	fields, err = unset.fields(cmd.Flags(), testDirectory)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+}
+
+func TestUserRows(t *testing.T) {
+	u := &api.User{ID: "42", Email: "ada@example.com", RoleID: "7", PrimaryTeamID: "100", SecondaryTeamIDs: []string{"200", "999"}}
+	rows := userRows(u, testDirectory)
+	assert.Contains(t, rows, []string{"Roles", "Sales Rep"})
+	assert.Contains(t, rows, []string{"Primary Team", "EMEA Sales"})
+	assert.Contains(t, rows, []string{"Secondary Teams", "Partners, 999"})
+}
@@ -0,0 +1,321 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+const (
+	// maxWebhookBody bounds the body of one webhook request
+	maxWebhookBody = 1 << 20
+	// maxSignatureAge is how old a v3 signature timestamp may be, as
+	// HubSpot recommends
+	maxSignatureAge = 5 * time.Minute
+)
+
+// webhookEvent is one event of a webhook request; HubSpot sends them in
+// batches of up to 100
+type webhookEvent struct {
+	EventID          json.Number `json:"eventId"`
+	SubscriptionID   json.Number `json:"subscriptionId"`
+	PortalID         json.Number `json:"portalId"`
+	AppID            json.Number `json:"appId"`
+	OccurredAt       int64       `json:"occurredAt"`
+	SubscriptionType string      `json:"subscriptionType"`
+	AttemptNumber    int         `json:"attemptNumber"`
+	ObjectID         json.Number `json:"objectId"`
+	PropertyName     string      `json:"propertyName,omitempty"`
+	PropertyValue    string      `json:"propertyValue,omitempty"`
+	ChangeSource     string      `json:"changeSource,omitempty"`
+}
+
+// summary describes an event in one line
+func (e webhookEvent) summary(v *view.View) string {
+	at := v.Time(strconv.FormatInt(e.OccurredAt, 10))
+	line := fmt.Sprintf("%s  %s  object %s", at, e.SubscriptionType, e.ObjectID)
+	if e.PropertyName != "" {
+		line += fmt.Sprintf("  %s=%q", e.PropertyName, e.PropertyValue)
+	}
+	if e.AttemptNumber > 0 {
+		line += fmt.Sprintf("  (retry %d)", e.AttemptNumber)
+	}
+	return line
+}
+
+// listener receives webhooks, checks their signatures, prints them, and
+// optionally forwards them
+type listener struct {
+	view *view.View
+	// secret is the app's client secret; signatures are not checked when
+	// it is empty
+	secret string
+	// publicURL is the URL HubSpot sends to, when the listener is behind a
+	// tunnel; signatures cover it rather than the local address
+	publicURL string
+	// forward is the URL each request is passed on to
+	forward string
+	client  *http.Client
+	now     func() time.Time
+
+	mu sync.Mutex // serializes output
+}
+
+func (l *listener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if l.secret != "" {
+		if err := l.verify(r, body); err != nil {
+			l.mu.Lock()
+			l.view.Error("Rejected webhook: %v", err)
+			l.mu.Unlock()
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	l.print(body)
+
+	status := http.StatusOK
+	if l.forward != "" {
+		status = l.forwardRequest(r, body)
+	}
+	w.WriteHeader(status)
+}
+
+// verify checks the X-HubSpot-Signature-v3 header of a request
+func (l *listener) verify(r *http.Request, body []byte) error {
+	signature := r.Header.Get("X-HubSpot-Signature-v3")
+	timestamp := r.Header.Get("X-HubSpot-Request-Timestamp")
+	if signature == "" || timestamp == "" {
+		return errors.New("missing X-HubSpot-Signature-v3 or X-HubSpot-Request-Timestamp header")
+	}
+
+	ms, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := l.now().Sub(time.UnixMilli(ms)); age > maxSignatureAge {
+		return fmt.Errorf("request timestamp is %s old", age.Round(time.Second))
+	}
+
+	want := signatureV3(l.secret, r.Method, l.requestURL(r), body, timestamp)
+	if !hmac.Equal([]byte(signature), []byte(want)) {
+		return errors.New("signature does not match; check --client-secret and --public-url")
+	}
+	return nil
+}
+
+// requestURL is the URL HubSpot signed: the public URL when set, otherwise
+// the address the request arrived at
+func (l *listener) requestURL(r *http.Request) string {
+	if l.publicURL != "" {
+		return strings.TrimSuffix(l.publicURL, "/") + r.URL.RequestURI()
+	}
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
+
+// uriDecoder undoes the percent-encoding HubSpot decodes before signing
+var uriDecoder = strings.NewReplacer(
+	"%3A", ":", "%2F", "/", "%3F", "?", "%40", "@", "%21", "!", "%24", "$",
+	"%27", "'", "%28", "(", "%29", ")", "%2A", "*", "%2C", ",", "%3B", ";",
+)
+
+// signatureV3 computes a HubSpot v3 request signature: the base64 HMAC
+// SHA-256, keyed with the client secret, of the method, URI, body, and
+// timestamp
+func signatureV3(secret, method, uri string, body []byte, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(method + uriDecoder.Replace(uri)))
+	mac.Write(body)
+	mac.Write([]byte(timestamp))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// print writes each event of a request to the view, one line each or as
+// JSON lines
+func (l *listener) print(body []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var events []webhookEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		l.view.Warning("Received a request that is not a list of webhook events")
+		fmt.Fprintln(l.view.Out, string(body))
+		return
+	}
+
+	for _, e := range events {
+		if l.view.Format == view.FormatJSON {
+			data, _ := json.Marshal(e)
+			fmt.Fprintln(l.view.Out, string(data))
+			continue
+		}
+		fmt.Fprintln(l.view.Out, e.summary(l.view))
+	}
+}
+
+// forwardRequest passes a webhook on with its original headers and returns
+// the status to answer HubSpot with
+func (l *listener) forwardRequest(r *http.Request, body []byte) int {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, l.forward+forwardPath(r), bytes.NewReader(body))
+	if err != nil {
+		l.logForward("failed to build request: %v", err)
+		return http.StatusBadGateway
+	}
+	for _, h := range []string{"Content-Type", "User-Agent", "X-HubSpot-Signature", "X-HubSpot-Signature-Version", "X-HubSpot-Signature-v3", "X-HubSpot-Request-Timestamp"} {
+		if value := r.Header.Get(h); value != "" {
+			req.Header.Set(h, value)
+		}
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		l.logForward("%v", err)
+		return http.StatusBadGateway
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	l.mu.Lock()
+	if resp.StatusCode >= 400 {
+		l.view.Warning("  → %s returned %s", l.forward, resp.Status)
+	} else {
+		l.view.Info("  → %s %s", l.forward, resp.Status)
+	}
+	l.mu.Unlock()
+	return resp.StatusCode
+}
+
+func (l *listener) logForward(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.view.Error("  → forward failed: "+format, args...)
+}
+
+// forwardPath keeps the query of the incoming request; the forward URL
+// decides the path
+func forwardPath(r *http.Request) string {
+	if r.URL.RawQuery == "" {
+		return ""
+	}
+	return "?" + r.URL.RawQuery
+}
+
+func newListenCmd(opts *root.Options) *cobra.Command {
+	var port int
+	var forward, secret, publicURL string
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Receive webhooks locally while developing an app",
+		Long: `Run a local HTTP server that receives webhooks, checks their v3 signatures,
+prints each event, and optionally forwards the request to your app.
+
+HubSpot can only send webhooks to a public URL, so expose the listener with
+a tunnel (such as ngrok or cloudflared) and set the tunnel URL as the app's
+target URL. Signatures cover the URL HubSpot sent to, so pass the tunnel URL
+with --public-url when checking them.
+
+With --client-secret (or HUBSPOT_CLIENT_SECRET), requests with a missing,
+stale, or wrong signature are rejected with 401. Forwarded requests keep
+HubSpot's signature headers, and HubSpot is answered with your app's status.`,
+		Example: `  # Print events sent to port 8080
+  hspt webhooks listen --port 8080
+
+  # Check signatures and pass events on to a local app
+  hspt webhooks listen --port 8080 --forward http://localhost:3000/hooks \
+    --client-secret $HUBSPOT_CLIENT_SECRET --public-url https://abc123.ngrok.app
+
+  # One JSON line per event, for piping into jq
+  hspt webhooks listen -o json | jq .subscriptionType`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := opts.HTTPClient()
+			if err != nil {
+				return err
+			}
+
+			l := &listener{
+				view:      v,
+				secret:    secret,
+				publicURL: publicURL,
+				forward:   forward,
+				client:    client,
+				now:       time.Now,
+			}
+
+			ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+			if err != nil {
+				return fmt.Errorf("failed to listen on port %d: %w", port, err)
+			}
+
+			v.Success("Listening for webhooks on http://%s", ln.Addr())
+			if forward != "" {
+				v.Info("Forwarding to %s", forward)
+			}
+			if secret == "" {
+				v.Warning("No --client-secret: signatures are not checked")
+			}
+			v.Info("Press Ctrl-C to stop")
+
+			return serve(cmd.Context(), ln, l)
+		},
+	}
+
+	cmd.Flags().IntVar(&port, "port", 8080, "Local port to listen on")
+	cmd.Flags().StringVar(&forward, "forward", "", "URL to forward each webhook request to")
+	cmd.Flags().StringVar(&secret, "client-secret", os.Getenv("HUBSPOT_CLIENT_SECRET"), "App client secret for checking signatures (env: HUBSPOT_CLIENT_SECRET)")
+	cmd.Flags().StringVar(&publicURL, "public-url", "", "Public URL HubSpot sends to, such as a tunnel URL")
+
+	return cmd
+}
+
+// serve handles requests until ctx is cancelled, then shuts down
+func serve(ctx context.Context, ln net.Listener, handler http.Handler) error {
+	srv := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
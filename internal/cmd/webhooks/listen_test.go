@@ -0,0 +1,151 @@
+package webhooks
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/view"
+)
+
+const testEvents = `[{"eventId": 100, "subscriptionId": 42, "portalId": 7, "appId": 123, "occurredAt": 1718000000000,
+	"subscriptionType": "contact.propertyChange", "attemptNumber": 0, "objectId": 555,
+	"propertyName": "email", "propertyValue": "jane@example.com", "changeSource": "CRM"}]`
+
+func newTestListener(secret string, now time.Time) (*listener, *bytes.Buffer, *bytes.Buffer) {
+	var out, errOut bytes.Buffer
+	v := view.New("table", true)
+	v.Out = &out
+	v.Err = &errOut
+	v.Location = time.UTC
+	return &listener{view: v, secret: secret, client: http.DefaultClient, now: func() time.Time { return now }}, &out, &errOut
+}
+
+func signedRequest(t *testing.T, secret, target, body string, at time.Time) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	ts := strconv.FormatInt(at.UnixMilli(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-HubSpot-Request-Timestamp", ts)
+	req.Header.Set("X-HubSpot-Signature-v3", signatureV3(secret, http.MethodPost, target, []byte(body), ts))
+	return req
+}
+
+func TestListener_Signatures(t *testing.T) {
+	now := time.Date(2024, 6, 10, 6, 13, 20, 0, time.UTC)
+
+	t.Run("valid signature", func(t *testing.T) {
+		l, out, _ := newTestListener("s3cret", now)
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, signedRequest(t, "s3cret", "http://example.com/hooks", testEvents, now))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "2024-06-10 06:13 UTC  contact.propertyChange  object 555  email=\"jane@example.com\"\n", out.String())
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		l, out, errOut := newTestListener("s3cret", now)
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, signedRequest(t, "other", "http://example.com/hooks", testEvents, now))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Empty(t, out.String())
+		assert.Contains(t, errOut.String(), "signature does not match")
+	})
+
+	t.Run("stale timestamp", func(t *testing.T) {
+		l, _, errOut := newTestListener("s3cret", now)
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, signedRequest(t, "s3cret", "http://example.com/hooks", testEvents, now.Add(-10*time.Minute)))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, errOut.String(), "10m0s old")
+	})
+
+	t.Run("missing headers", func(t *testing.T) {
+		l, _, _ := newTestListener("s3cret", now)
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "http://example.com/hooks", strings.NewReader(testEvents)))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("public URL behind a tunnel", func(t *testing.T) {
+		l, _, _ := newTestListener("s3cret", now)
+		l.publicURL = "https://abc.ngrok.app/"
+		req := signedRequest(t, "s3cret", "https://abc.ngrok.app/hooks?x=1", testEvents, now)
+		req.Host = "localhost:8080"
+
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("no secret accepts everything", func(t *testing.T) {
+		l, out, _ := newTestListener("", now)
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "http://example.com/hooks", strings.NewReader(testEvents)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, out.String(), "contact.propertyChange")
+	})
+}
+
+func TestSignatureV3(t *testing.T) {
+	// Encoded characters HubSpot decodes before signing
+	a := signatureV3("secret", "POST", "https://example.com/hooks?email=jane%40example.com", []byte("[]"), "1")
+	b := signatureV3("secret", "POST", "https://example.com/hooks?email=jane@example.com", []byte("[]"), "1")
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, signatureV3("secret", "POST", "https://example.com/other", []byte("[]"), "1"))
+}
+
+func TestListener_Forward(t *testing.T) {
+	var got []byte
+	var gotSig string
+	app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = io.ReadAll(r.Body)
+		gotSig = r.Header.Get("X-HubSpot-Signature-v3")
+		assert.Equal(t, "x=1", r.URL.RawQuery)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer app.Close()
+
+	now := time.Now()
+	l, _, errOut := newTestListener("s3cret", now)
+	l.forward = app.URL + "/hooks"
+	l.client = app.Client()
+
+	req := signedRequest(t, "s3cret", "http://example.com/hooks?x=1", testEvents, now)
+	rec := httptest.NewRecorder()
+	l.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusAccepted, rec.Code, "HubSpot gets the app's status")
+	assert.Equal(t, testEvents, string(got))
+	assert.Equal(t, req.Header.Get("X-HubSpot-Signature-v3"), gotSig)
+	assert.Contains(t, errOut.String(), "202 Accepted")
+
+	app.Close()
+	rec = httptest.NewRecorder()
+	l.ServeHTTP(rec, signedRequest(t, "s3cret", "http://example.com/hooks?x=1", testEvents, now))
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Contains(t, errOut.String(), "forward failed")
+}
+
+func TestListener_JSONOutput(t *testing.T) {
+	l, out, _ := newTestListener("", time.Now())
+	l.view.Format = view.FormatJSON
+
+	rec := httptest.NewRecorder()
+	l.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "http://example.com/hooks", strings.NewReader(testEvents)))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"subscriptionType":"contact.propertyChange"`)
+	assert.Contains(t, lines[0], `"objectId":555`)
+}
@@ -0,0 +1,338 @@
+package webhooks
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Register registers the webhooks command and subcommands
+func Register(parent *cobra.Command, opts *root.Options) {
+	a := &shared.DeveloperApp{}
+
+	cmd := &cobra.Command{
+		Use:   "webhooks",
+		Short: "Manage webhook subscriptions of a public app",
+		Long: `Commands for managing the webhook subscriptions and settings of a HubSpot
+public app.
+
+Webhook APIs authenticate with the developer account's API key rather than a
+portal access token. Pass the app ID and key with --app-id and
+--developer-key, or set HUBSPOT_APP_ID and HUBSPOT_DEVELOPER_API_KEY (the
+better choice in CI, where flags can end up in logs).`,
+	}
+
+	shared.AddDeveloperAppFlags(cmd, a)
+
+	cmd.AddCommand(newListCmd(opts, a))
+	cmd.AddCommand(newCreateCmd(opts, a))
+	cmd.AddCommand(newUpdateCmd(opts, a))
+	cmd.AddCommand(newDeleteCmd(opts, a))
+	cmd.AddCommand(newSettingsCmd(opts, a))
+	cmd.AddCommand(newListenCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func subscriptionRows(subs []api.WebhookSubscription) [][]string {
+	rows := make([][]string, 0, len(subs))
+	for _, s := range subs {
+		rows = append(rows, []string{s.ID.String(), s.EventType, s.PropertyName, shared.FormatBool(s.Active)})
+	}
+	return rows
+}
+
+var subscriptionHeaders = []string{"ID", "EVENT TYPE", "PROPERTY", "ACTIVE"}
+
+func newListCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List webhook subscriptions",
+		Long:  "List the events the app is subscribed to.",
+		Example: `  # List subscriptions
+  HUBSPOT_DEVELOPER_API_KEY=... hspt webhooks list --app-id 123456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			result, err := client.ListWebhookSubscriptions(cmd.Context(), a.ID)
+			if err != nil {
+				return err
+			}
+
+			if len(result.Results) == 0 {
+				v.Info("No webhook subscriptions found")
+				return nil
+			}
+
+			return v.Render(subscriptionHeaders, subscriptionRows(result.Results), result)
+		},
+	}
+}
+
+func newCreateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var eventType, propertyName string
+	var active bool
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Subscribe the app to an event",
+		Long: `Subscribe the app to an event such as contact.creation or
+deal.propertyChange. Property change events require --property.`,
+		Example: `  # Get a webhook when any contact's email changes
+  hspt webhooks create --app-id 123456 --event-type contact.propertyChange --property email
+
+  # Create a subscription paused, to turn on later
+  hspt webhooks create --app-id 123456 --event-type deal.creation --active=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if eventType == "" {
+				return fmt.Errorf("--event-type is required")
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			sub, err := client.CreateWebhookSubscription(cmd.Context(), a.ID, api.WebhookSubscriptionCreate{
+				EventType:    eventType,
+				PropertyName: propertyName,
+				Active:       active,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create subscription: %w", err)
+			}
+
+			v.Success("Subscription created with ID: %s", sub.ID)
+			return v.Render(subscriptionHeaders, subscriptionRows([]api.WebhookSubscription{*sub}), sub)
+		},
+	}
+
+	cmd.Flags().StringVar(&eventType, "event-type", "", "Event to subscribe to, e.g. contact.creation or deal.propertyChange (required)")
+	cmd.Flags().StringVar(&propertyName, "property", "", "Property to watch, for property change events")
+	cmd.Flags().BoolVar(&active, "active", true, "Start sending webhooks right away")
+
+	return cmd
+}
+
+func newUpdateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var active bool
+
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Pause or resume a subscription",
+		Long:  "Pause or resume a webhook subscription. The event type and property of a subscription cannot be changed; delete it and create a new one instead.",
+		Example: `  # Pause a subscription
+  hspt webhooks update 42 --app-id 123456 --active=false
+
+  # Resume it
+  hspt webhooks update 42 --app-id 123456 --active`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !cmd.Flags().Changed("active") {
+				return fmt.Errorf("--active is required (--active or --active=false)")
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			sub, err := client.UpdateWebhookSubscription(cmd.Context(), a.ID, id, active)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Subscription %s not found", id)
+					return nil
+				}
+				return fmt.Errorf("failed to update subscription: %w", err)
+			}
+
+			if sub.Active {
+				v.Success("Subscription %s resumed", id)
+			} else {
+				v.Success("Subscription %s paused", id)
+			}
+			return v.Render(subscriptionHeaders, subscriptionRows([]api.WebhookSubscription{*sub}), sub)
+		},
+	}
+
+	cmd.Flags().BoolVar(&active, "active", false, "Whether the subscription sends webhooks")
+
+	return cmd
+}
+
+func newDeleteCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a subscription",
+		Long:  "Delete a webhook subscription by ID.",
+		Example: `  # Delete a subscription
+  hspt webhooks delete 42 --app-id 123456 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			if !force {
+				v.Warning("This will delete webhook subscription %s. Use --force to confirm.", id)
+				return nil
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteWebhookSubscription(cmd.Context(), a.ID, id); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Subscription %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			v.Success("Subscription %s deleted", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Confirm deletion without prompt")
+
+	return cmd
+}
+
+func newSettingsCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settings",
+		Short: "View or change where webhooks are sent",
+	}
+
+	cmd.AddCommand(newSettingsGetCmd(opts, a))
+	cmd.AddCommand(newSettingsUpdateCmd(opts, a))
+
+	return cmd
+}
+
+func settingsRows(s *api.WebhookSettings) [][]string {
+	return [][]string{
+		{"Target URL", s.TargetURL},
+		{"Max concurrent requests", strconv.Itoa(s.Throttling.MaxConcurrentRequests)},
+		{"Period", s.Throttling.Period},
+	}
+}
+
+func newSettingsGetCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get",
+		Short: "Show the webhook target URL and throttling",
+		Example: `  # Show settings
+  hspt webhooks settings get --app-id 123456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			settings, err := client.GetWebhookSettings(cmd.Context(), a.ID)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Info("No webhook settings for app %s. Set them with: hspt webhooks settings update --target-url <url>", a.ID)
+					return nil
+				}
+				return err
+			}
+
+			return v.Render([]string{"SETTING", "VALUE"}, settingsRows(settings), settings)
+		},
+	}
+}
+
+func newSettingsUpdateCmd(opts *root.Options, a *shared.DeveloperApp) *cobra.Command {
+	var targetURL, period string
+	var maxConcurrent int
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Change the webhook target URL or throttling",
+		Long: `Change where and how fast webhooks are sent. Settings not given keep their
+current values.`,
+		Example: `  # Point webhooks at a new endpoint
+  hspt webhooks settings update --app-id 123456 --target-url https://example.com/hubspot
+
+  # Allow more concurrent deliveries
+  hspt webhooks settings update --app-id 123456 --max-concurrent 20`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if period != "" && period != api.WebhookPeriodSecondly && period != api.WebhookPeriodRollingMinute {
+				return fmt.Errorf("invalid --period %q: must be %s or %s", period, api.WebhookPeriodSecondly, api.WebhookPeriodRollingMinute)
+			}
+			if cmd.Flags().Changed("max-concurrent") && maxConcurrent < 1 {
+				return fmt.Errorf("--max-concurrent must be at least 1")
+			}
+
+			client, err := a.Client(opts)
+			if err != nil {
+				return err
+			}
+
+			settings := api.WebhookSettings{}
+			current, err := client.GetWebhookSettings(cmd.Context(), a.ID)
+			switch {
+			case err == nil:
+				settings.TargetURL = current.TargetURL
+				settings.Throttling = current.Throttling
+			case !api.IsNotFound(err):
+				return fmt.Errorf("failed to get current settings: %w", err)
+			}
+
+			if targetURL != "" {
+				settings.TargetURL = targetURL
+			}
+			if cmd.Flags().Changed("max-concurrent") {
+				settings.Throttling.MaxConcurrentRequests = maxConcurrent
+			}
+			if period != "" {
+				settings.Throttling.Period = period
+			}
+			if settings.TargetURL == "" {
+				return fmt.Errorf("--target-url is required when the app has no webhook settings yet")
+			}
+			if settings.Throttling.MaxConcurrentRequests == 0 {
+				settings.Throttling.MaxConcurrentRequests = 10
+			}
+
+			updated, err := client.UpdateWebhookSettings(cmd.Context(), a.ID, settings)
+			if err != nil {
+				return fmt.Errorf("failed to update settings: %w", err)
+			}
+
+			v.Success("Webhook settings updated")
+			return v.Render([]string{"SETTING", "VALUE"}, settingsRows(updated), updated)
+		},
+	}
+
+	cmd.Flags().StringVar(&targetURL, "target-url", "", "URL HubSpot sends webhooks to")
+	cmd.Flags().IntVar(&maxConcurrent, "max-concurrent", 0, "Maximum concurrent webhook requests (default 10 for new settings)")
+	cmd.Flags().StringVar(&period, "period", "", "Throttling period: SECONDLY or ROLLING_MINUTE")
+
+	return cmd
+}
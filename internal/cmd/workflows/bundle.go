@@ -0,0 +1,415 @@
+package workflows
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+)
+
+// bundleVersion is the version of the workflow bundle format
+const bundleVersion = 1
+
+// workflowBundle is a workflow definition exported to a file, with the IDs
+// of the portal objects it refers to, so it can be imported into another
+// portal with those IDs remapped
+type workflowBundle struct {
+	Version    int                    `json:"version"`
+	ExportedAt string                 `json:"exportedAt"`
+	WorkflowID string                 `json:"workflowId"`
+	References map[string][]string    `json:"references,omitempty"`
+	Workflow   map[string]interface{} `json:"workflow"`
+}
+
+// localIDKeys are ID fields that refer to parts of the workflow itself, or
+// to HubSpot-wide types, rather than to portal objects; they are never
+// remapped
+var localIDKeys = map[string]bool{
+	"id":            true,
+	"actionId":      true,
+	"nextActionId":  true,
+	"startActionId": true,
+	"actionTypeId":  true,
+	"objectTypeId":  true,
+	"revisionId":    true,
+	"flowId":        true,
+}
+
+// readOnlyWorkflowFields are set by HubSpot and left out of an import
+var readOnlyWorkflowFields = []string{"id", "revisionId", "createdAt", "updatedAt"}
+
+// isIDKey reports whether a definition field holds the IDs of portal
+// objects, such as listId, content_id, or ownerIds
+func isIDKey(key string) bool {
+	if localIDKeys[key] {
+		return false
+	}
+	for _, suffix := range []string{"Id", "Ids", "ID", "IDs", "_id", "_ids"} {
+		if strings.HasSuffix(key, suffix) && len(key) > len(suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// idString returns an ID field's value as a string, for IDs given as
+// strings or numbers
+func idString(v interface{}) (string, bool) {
+	switch id := v.(type) {
+	case string:
+		return id, id != ""
+	case json.Number:
+		return id.String(), true
+	}
+	return "", false
+}
+
+// walkIDs calls fn with every ID in the definition's ID fields. fn returns
+// the value to put in its place.
+func walkIDs(node interface{}, fn func(key string, id interface{}) interface{}) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if !isIDKey(k) {
+				n[k] = walkIDs(v, fn)
+				continue
+			}
+			switch ids := v.(type) {
+			case []interface{}:
+				for i, id := range ids {
+					if _, ok := idString(id); ok {
+						ids[i] = fn(k, id)
+					} else {
+						ids[i] = walkIDs(id, fn)
+					}
+				}
+			default:
+				if _, ok := idString(v); ok {
+					n[k] = fn(k, v)
+				} else {
+					n[k] = walkIDs(v, fn)
+				}
+			}
+		}
+	case []interface{}:
+		for i, v := range n {
+			n[i] = walkIDs(v, fn)
+		}
+	}
+	return node
+}
+
+// collectReferences returns the IDs of portal objects a definition refers
+// to, by field name
+func collectReferences(def map[string]interface{}) map[string][]string {
+	seen := make(map[string]map[string]bool)
+	walkIDs(def, func(key string, id interface{}) interface{} {
+		s, _ := idString(id)
+		if seen[key] == nil {
+			seen[key] = make(map[string]bool)
+		}
+		seen[key][s] = true
+		return id
+	})
+
+	refs := make(map[string][]string, len(seen))
+	for key, ids := range seen {
+		for id := range ids {
+			refs[key] = append(refs[key], id)
+		}
+		sort.Strings(refs[key])
+	}
+	return refs
+}
+
+// remapIDs replaces the IDs in a definition's ID fields that have a new ID
+// in mapping, keeping numbers as numbers, and returns how many it replaced
+func remapIDs(def map[string]interface{}, mapping map[string]string) int {
+	replaced := 0
+	walkIDs(def, func(key string, id interface{}) interface{} {
+		old, _ := idString(id)
+		target, ok := mapping[old]
+		if !ok {
+			return id
+		}
+		replaced++
+		if _, isNumber := id.(json.Number); isNumber {
+			if _, err := json.Number(target).Int64(); err == nil {
+				return json.Number(target)
+			}
+		}
+		return target
+	})
+	return replaced
+}
+
+// parseIDMap reads old=new ID pairs from --map values and a --map-file of a
+// JSON object of old to new IDs
+func parseIDMap(pairs []string, file string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read map file: %w", err)
+		}
+		if err := json.Unmarshal(data, &mapping); err != nil {
+			return nil, fmt.Errorf("invalid map file %s: must be a JSON object of old to new IDs: %w", file, err)
+		}
+	}
+	for _, pair := range pairs {
+		old, target, ok := strings.Cut(pair, "=")
+		old, target = strings.TrimSpace(old), strings.TrimSpace(target)
+		if !ok || old == "" || target == "" {
+			return nil, fmt.Errorf("invalid --map %q: use OLD_ID=NEW_ID", pair)
+		}
+		mapping[old] = target
+	}
+	return mapping, nil
+}
+
+// decodeJSON decodes JSON keeping numbers as json.Number, so large IDs keep
+// every digit
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// readBundle reads a workflow bundle, or a bare workflow definition such as
+// the JSON of workflows get -o json
+func readBundle(data []byte) (*workflowBundle, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	var bundle workflowBundle
+	if _, ok := fields["workflow"]; ok {
+		if err := decodeJSON(data, &bundle); err != nil {
+			return nil, fmt.Errorf("invalid workflow bundle: %w", err)
+		}
+		if bundle.Version > bundleVersion {
+			return nil, fmt.Errorf("workflow bundle version %d is newer than this version of hspt supports (%d); upgrade hspt", bundle.Version, bundleVersion)
+		}
+	} else if err := decodeJSON(data, &bundle.Workflow); err != nil {
+		return nil, fmt.Errorf("invalid workflow definition: %w", err)
+	}
+
+	if len(bundle.Workflow) == 0 {
+		return nil, fmt.Errorf("file has no workflow definition")
+	}
+	return &bundle, nil
+}
+
+// unmappedReferences lists the references of a definition that have no new
+// ID in mapping, as "field id"
+func unmappedReferences(refs map[string][]string, mapping map[string]string) []string {
+	var unmapped []string
+	for key, ids := range refs {
+		for _, id := range ids {
+			if _, ok := mapping[id]; !ok {
+				unmapped = append(unmapped, key+" "+id)
+			}
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+func newExportCmd(opts *root.Options) *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "export <id>",
+		Short: "Export a workflow to a JSON bundle",
+		Long: `Export a workflow's full definition to a JSON bundle that can be kept in
+version control and imported into another portal with workflows import.
+
+The bundle lists the IDs of the lists, emails, owners, and other portal
+objects the workflow refers to, so they can be remapped on import.`,
+		Example: `  # Export a workflow
+  hspt workflows export 12345 --out welcome-series.json
+
+  # To stdout
+  hspt workflows export 12345`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			id := args[0]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			raw, err := client.GetWorkflowDefinition(cmd.Context(), id)
+			if err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Workflow %s not found", id)
+					return nil
+				}
+				return err
+			}
+
+			bundle := workflowBundle{
+				Version:    bundleVersion,
+				ExportedAt: time.Now().UTC().Format(time.RFC3339),
+				WorkflowID: id,
+			}
+			if err := decodeJSON(raw, &bundle.Workflow); err != nil {
+				return fmt.Errorf("failed to parse workflow: %w", err)
+			}
+			bundle.References = collectReferences(bundle.Workflow)
+
+			data, err := json.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return err
+			}
+			data = append(data, '\n')
+
+			if out == "" {
+				_, err = v.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(out, data, 0644); err != nil {
+				return fmt.Errorf("failed to write bundle: %w", err)
+			}
+
+			v.Success("Exported workflow %s to %s", id, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "File to write the bundle to (default: stdout)")
+
+	return cmd
+}
+
+func newImportCmd(opts *root.Options) *cobra.Command {
+	var file, mapFile, name, updateID string
+	var idMap []string
+	var enable, dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a workflow from a JSON bundle",
+		Long: `Create a workflow from a bundle written by workflows export, such as one
+exported from a sandbox, or replace an existing workflow's definition with
+--update.
+
+Lists, emails, owners, and other objects have different IDs in each portal.
+Give the new IDs with --map OLD=NEW or a --map-file of a JSON object of old
+to new IDs; they replace the old IDs in the workflow's ID fields. IDs left
+unmapped are listed, since they must exist in the target portal.
+
+Imported workflows are turned off unless --enable is set, so they can be
+reviewed before they run.`,
+		Example: `  # Preview the definition that would be created
+  hspt workflows import --file welcome-series.json --map 111=911 --dry-run
+
+  # Promote to production, with the production list and email IDs
+  hspt workflows import --file welcome-series.json --map-file prod-ids.json --profile production
+
+  # Replace an existing workflow's definition
+  hspt workflows import --file welcome-series.json --update 67890`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			mapping, err := parseIDMap(idMap, mapFile)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read file: %w", err)
+			}
+			bundle, err := readBundle(data)
+			if err != nil {
+				return err
+			}
+
+			def := bundle.Workflow
+			refs := collectReferences(def)
+			for _, field := range readOnlyWorkflowFields {
+				delete(def, field)
+			}
+			def["isEnabled"] = enable
+			if name != "" {
+				def["name"] = name
+			}
+			replaced := remapIDs(def, mapping)
+
+			if unmapped := unmappedReferences(refs, mapping); len(unmapped) > 0 {
+				v.Warning("%d referenced ID(s) were not remapped and must exist in the target portal: %s", len(unmapped), strings.Join(unmapped, ", "))
+			}
+
+			if dryRun {
+				out, err := json.MarshalIndent(def, "", "  ")
+				if err != nil {
+					return err
+				}
+				if _, err := fmt.Fprintln(v.Out, string(out)); err != nil {
+					return err
+				}
+				v.Info("Dry run: remapped %d ID(s); no workflow was imported", replaced)
+				return nil
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			var workflow *api.Workflow
+			if updateID != "" {
+				current, err := client.GetWorkflow(cmd.Context(), updateID)
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("Workflow %s not found", updateID)
+						return nil
+					}
+					return err
+				}
+				// The update applies to the current revision only
+				def["revisionId"] = current.RevisionID
+				workflow, err = client.UpdateWorkflow(cmd.Context(), updateID, def)
+				if err != nil {
+					return fmt.Errorf("failed to update workflow: %w", err)
+				}
+			} else {
+				workflow, err = client.CreateWorkflow(cmd.Context(), def)
+				if err != nil {
+					return fmt.Errorf("failed to create workflow: %w", err)
+				}
+			}
+
+			v.Success("Workflow imported: %s (ID: %s), %d ID(s) remapped", workflow.Name, workflow.ID, replaced)
+			if !enable {
+				v.Info("The workflow is turned off; review it, then turn it on in HubSpot")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "Workflow bundle, or workflow definition JSON, to import (required)")
+	cmd.Flags().StringArrayVar(&idMap, "map", nil, "Replace an ID of the source portal with one of the target, as OLD=NEW (repeatable)")
+	cmd.Flags().StringVar(&mapFile, "map-file", "", "JSON file mapping source portal IDs to target portal IDs")
+	cmd.Flags().StringVar(&name, "name", "", "Name for the imported workflow (default: the exported name)")
+	cmd.Flags().StringVar(&updateID, "update", "", "Replace the definition of this workflow instead of creating one")
+	cmd.Flags().BoolVar(&enable, "enable", false, "Turn the workflow on once imported")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the definition that would be imported without importing it")
+
+	return cmd
+}
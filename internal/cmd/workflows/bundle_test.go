@@ -0,0 +1,123 @@
+package workflows
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefinition = `{
+	"id": "77",
+	"revisionId": "3",
+	"name": "Welcome series",
+	"isEnabled": true,
+	"objectTypeId": "0-1",
+	"startActionId": "1",
+	"enrollmentCriteria": {"listFilterBranch": {"listId": 111}},
+	"actions": [
+		{"actionId": "1", "actionTypeId": "0-4", "nextActionId": "2", "fields": {"content_id": "5550"}},
+		{"actionId": "2", "actionTypeId": "0-14", "fields": {"owner_ids": ["7", "8"], "user_id": 90071992547409931}}
+	]
+}`
+
+func decodeTestDefinition(t *testing.T) map[string]interface{} {
+	t.Helper()
+	var def map[string]interface{}
+	require.NoError(t, decodeJSON([]byte(testDefinition), &def))
+	return def
+}
+
+func TestIsIDKey(t *testing.T) {
+	for _, key := range []string{"listId", "content_id", "ownerIds", "owner_ids", "emailID"} {
+		assert.True(t, isIDKey(key), key)
+	}
+	for _, key := range []string{"id", "actionId", "nextActionId", "objectTypeId", "revisionId", "paid", "name", "Id"} {
+		assert.False(t, isIDKey(key), key)
+	}
+}
+
+func TestCollectReferences(t *testing.T) {
+	refs := collectReferences(decodeTestDefinition(t))
+
+	assert.Equal(t, map[string][]string{
+		"listId":     {"111"},
+		"content_id": {"5550"},
+		"owner_ids":  {"7", "8"},
+		"user_id":    {"90071992547409931"},
+	}, refs)
+}
+
+func TestRemapIDs(t *testing.T) {
+	def := decodeTestDefinition(t)
+
+	replaced := remapIDs(def, map[string]string{"111": "911", "5550": "6660", "7": "70", "1": "999", "90071992547409931": "90071992547409933"})
+	assert.Equal(t, 4, replaced, "action IDs are not remapped")
+
+	out, err := json.Marshal(def)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "77",
+		"revisionId": "3",
+		"name": "Welcome series",
+		"isEnabled": true,
+		"objectTypeId": "0-1",
+		"startActionId": "1",
+		"enrollmentCriteria": {"listFilterBranch": {"listId": 911}},
+		"actions": [
+			{"actionId": "1", "actionTypeId": "0-4", "nextActionId": "2", "fields": {"content_id": "6660"}},
+			{"actionId": "2", "actionTypeId": "0-14", "fields": {"owner_ids": ["70", "8"], "user_id": 90071992547409933}}
+		]
+	}`, string(out))
+	assert.Contains(t, string(out), "90071992547409933", "large numeric IDs keep every digit")
+}
+
+func TestParseIDMap(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ids.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"111": "911", "7": "70"}`), 0644))
+
+	mapping, err := parseIDMap([]string{"7=71", " 5550 = 6660 "}, file)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"111": "911", "7": "71", "5550": "6660"}, mapping)
+
+	_, err = parseIDMap([]string{"7"}, "")
+	assert.ErrorContains(t, err, "use OLD_ID=NEW_ID")
+
+	require.NoError(t, os.WriteFile(file, []byte(`["111"]`), 0644))
+	_, err = parseIDMap(nil, file)
+	assert.ErrorContains(t, err, "JSON object")
+}
+
+func TestReadBundle(t *testing.T) {
+	t.Run("bundle", func(t *testing.T) {
+		bundle, err := readBundle([]byte(`{"version": 1, "workflowId": "77", "workflow": {"name": "Welcome series", "listId": 111}}`))
+		require.NoError(t, err)
+		assert.Equal(t, "77", bundle.WorkflowID)
+		assert.Equal(t, json.Number("111"), bundle.Workflow["listId"])
+	})
+
+	t.Run("bare definition", func(t *testing.T) {
+		bundle, err := readBundle([]byte(testDefinition))
+		require.NoError(t, err)
+		assert.Equal(t, "Welcome series", bundle.Workflow["name"])
+	})
+
+	t.Run("newer version", func(t *testing.T) {
+		_, err := readBundle([]byte(`{"version": 2, "workflow": {"name": "x"}}`))
+		assert.ErrorContains(t, err, "upgrade hspt")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := readBundle([]byte(`{"version": 1, "workflow": {}}`))
+		assert.EqualError(t, err, "file has no workflow definition")
+	})
+}
+
+func TestUnmappedReferences(t *testing.T) {
+	refs := map[string][]string{"listId": {"111"}, "owner_ids": {"7", "8"}}
+	assert.Equal(t, []string{"listId 111", "owner_ids 8"}, unmappedReferences(refs, map[string]string{"7": "70"}))
+	assert.Empty(t, unmappedReferences(refs, map[string]string{"111": "1", "7": "2", "8": "3"}))
+}
@@ -0,0 +1,265 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// Enrollment statuses in the per-record report
+const (
+	enrollStatusEnrolled = "enrolled"
+	enrollStatusFailed   = "failed"
+	enrollStatusSkipped  = "not sent"
+)
+
+// enrollResult is the outcome of enrolling one record
+type enrollResult struct {
+	ObjectID string `json:"objectId"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// enrollReport is the JSON output of a bulk enrollment
+type enrollReport struct {
+	WorkflowID string         `json:"workflowId"`
+	Enrolled   int            `json:"enrolled"`
+	Failed     int            `json:"failed"`
+	Results    []enrollResult `json:"results"`
+}
+
+func newEnrollCmd(opts *root.Options) *cobra.Command {
+	var objectID, listID, objectType string
+	var filters []string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "enroll <workflowId>",
+		Short: "Enroll objects in a workflow",
+		Long: `Enroll a contact, company, deal, or other object in a workflow.
+
+To enroll many records, select them with --list-id (the members of a list) or
+--filter (a CRM search of --type records). The API enrolls one record per
+request, so records are enrolled one by one, within the rate limit, and a
+per-record report is printed. A failure does not stop the run.`,
+		Example: `  # Enroll a contact in a workflow
+  hspt workflows enroll 12345 --object-id 67890
+
+  # Enroll every member of a list
+  hspt workflows enroll 12345 --list-id 42
+
+  # Enroll every MQL; check who would be enrolled first
+  hspt workflows enroll 12345 --filter "lifecyclestage EQ marketingqualifiedlead" --dry-run
+  hspt workflows enroll 12345 --filter "lifecyclestage EQ marketingqualifiedlead"
+
+  # Deals instead of contacts
+  hspt workflows enroll 12345 --type deals --filter "dealstage=appointmentscheduled"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			workflowID := args[0]
+
+			modes := 0
+			for _, set := range []bool{objectID != "", listID != "", len(filters) > 0} {
+				if set {
+					modes++
+				}
+			}
+			if modes != 1 {
+				return fmt.Errorf("exactly one of --object-id, --list-id, or --filter is required")
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if objectID != "" {
+				err = client.EnrollInWorkflow(cmd.Context(), workflowID, objectID)
+				if err != nil {
+					if api.IsNotFound(err) {
+						v.Error("Workflow %s not found", workflowID)
+						return nil
+					}
+					return err
+				}
+
+				v.Success("Object %s enrolled in workflow %s", objectID, workflowID)
+				return nil
+			}
+
+			var ids []string
+			if listID != "" {
+				ids, err = listMemberIDs(cmd.Context(), client, listID)
+			} else {
+				ids, err = searchIDs(cmd.Context(), client, api.ObjectType(objectType), filters)
+			}
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				v.Info("No records match")
+				return nil
+			}
+			if len(filters) > 0 && len(ids) >= shared.MaxSearchResults {
+				v.Warning("Search returns at most %d records; narrow --filter and run again for the rest", shared.MaxSearchResults)
+			}
+
+			if dryRun {
+				rows := make([][]string, 0, len(ids))
+				for _, id := range ids {
+					rows = append(rows, []string{id})
+				}
+				if err := v.Render([]string{"OBJECT ID"}, rows, ids); err != nil {
+					return err
+				}
+				v.Info("%d record(s) would be enrolled in workflow %s. Run again without --dry-run to enroll them.", len(ids), workflowID)
+				return nil
+			}
+
+			v.Info("Enrolling %d record(s) in workflow %s...", len(ids), workflowID)
+			report := enrollAll(cmd.Context(), client, workflowID, ids)
+
+			headers := []string{"OBJECT ID", "STATUS", "ERROR"}
+			rows := make([][]string, 0, len(report.Results))
+			for _, r := range report.Results {
+				rows = append(rows, []string{r.ObjectID, r.Status, r.Error})
+			}
+			if err := v.Render(headers, rows, report); err != nil {
+				return err
+			}
+
+			if report.Failed == 0 {
+				v.Success("Enrolled %d record(s) in workflow %s", report.Enrolled, workflowID)
+			} else {
+				v.Warning("Enrolled %d of %d record(s) in workflow %s; %d failed", report.Enrolled, len(ids), workflowID, report.Failed)
+			}
+			// Report an interrupted run as interrupted
+			return cmd.Context().Err()
+		},
+	}
+
+	cmd.Flags().StringVar(&objectID, "object-id", "", "ID of the object to enroll")
+	cmd.Flags().StringVar(&listID, "list-id", "", "Enroll every member of this list")
+	cmd.Flags().StringArrayVar(&filters, "filter", nil, `Enroll the records matching a search filter, e.g. "lifecyclestage EQ mql" or lifecyclestage=mql (repeatable; all must match)`)
+	cmd.Flags().StringVar(&objectType, "type", string(api.ObjectTypeContacts), "Object type searched by --filter")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the records that would be enrolled without enrolling them")
+
+	return cmd
+}
+
+func newUnenrollCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unenroll <workflowId> <objectId>",
+		Short: "Unenroll an object from a workflow",
+		Long:  "Remove a contact, company, deal, or other object from a workflow it is enrolled in, so it takes no further actions of the workflow.",
+		Example: `  # Stop a contact going through a workflow
+  hspt workflows unenroll 12345 67890`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+			workflowID, objectID := args[0], args[1]
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			if err := client.UnenrollFromWorkflow(cmd.Context(), workflowID, objectID); err != nil {
+				if api.IsNotFound(err) {
+					v.Error("Workflow %s not found, or object %s is not enrolled in it", workflowID, objectID)
+					return nil
+				}
+				return fmt.Errorf("failed to unenroll object: %w", err)
+			}
+
+			v.Success("Object %s unenrolled from workflow %s", objectID, workflowID)
+			return nil
+		},
+	}
+}
+
+// listMemberIDs returns the record IDs in a list
+func listMemberIDs(ctx context.Context, client *api.Client, listID string) ([]string, error) {
+	members, err := client.ListListMemberships(ctx, listID, api.ListOptions{All: true, Limit: api.DefaultPageSize})
+	if err != nil {
+		if api.IsNotFound(err) {
+			return nil, fmt.Errorf("list %s not found", listID)
+		}
+		return nil, fmt.Errorf("failed to get list members: %w", err)
+	}
+
+	ids := make([]string, 0, len(members.Results))
+	for _, m := range members.Results {
+		ids = append(ids, m.RecordID)
+	}
+	return ids, nil
+}
+
+// searchIDs returns the IDs of the records matching every filter, up to the
+// search API's limit
+func searchIDs(ctx context.Context, client *api.Client, objectType api.ObjectType, raw []string) ([]string, error) {
+	filters, err := shared.ParseFilters(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	req := api.SearchRequest{
+		FilterGroups: []api.SearchFilterGroup{{Filters: filters}},
+		Properties:   []string{"hs_object_id"},
+		Limit:        api.DefaultPageSize,
+	}
+
+	var ids []string
+	for len(ids) < shared.MaxSearchResults {
+		page, err := client.SearchObjects(ctx, objectType, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search %s: %w", objectType, err)
+		}
+		for _, obj := range page.Results {
+			ids = append(ids, obj.ID)
+		}
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			break
+		}
+		req.After = page.Paging.Next.After
+	}
+	return ids, nil
+}
+
+// enrollAll enrolls records one at a time, continuing past failures. Once
+// the API request budget is spent the remaining records are reported as not
+// sent.
+func enrollAll(ctx context.Context, client *api.Client, workflowID string, ids []string) enrollReport {
+	report := enrollReport{WorkflowID: workflowID, Results: make([]enrollResult, 0, len(ids))}
+
+	stopped := ""
+	for _, id := range ids {
+		result := enrollResult{ObjectID: id, Status: enrollStatusEnrolled}
+		if stopped != "" {
+			result.Status = enrollStatusSkipped
+			result.Error = stopped
+		} else if err := client.EnrollInWorkflow(ctx, workflowID, id); err != nil {
+			result.Status = enrollStatusFailed
+			result.Error = strings.TrimSpace(err.Error())
+			if api.IsBudgetExhausted(err) || ctx.Err() != nil {
+				result.Status = enrollStatusSkipped
+				stopped = result.Error
+			}
+		}
+
+		if result.Status == enrollStatusEnrolled {
+			report.Enrolled++
+		} else {
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
@@ -0,0 +1,112 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+)
+
+func newTestClient(server *httptest.Server) *api.Client {
+	return &api.Client{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+}
+
+func TestEnrollAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/automation/v4/flows/77/enrollments/start", r.URL.Path)
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body["objectId"] == "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status": "error", "message": "object not eligible"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	report := enrollAll(context.Background(), newTestClient(server), "77", []string{"1", "2", "3"})
+
+	assert.Equal(t, "77", report.WorkflowID)
+	assert.Equal(t, 2, report.Enrolled)
+	assert.Equal(t, 1, report.Failed)
+	require.Len(t, report.Results, 3)
+	assert.Equal(t, enrollStatusEnrolled, report.Results[0].Status)
+	assert.Equal(t, enrollStatusFailed, report.Results[1].Status)
+	assert.Contains(t, report.Results[1].Error, "object not eligible")
+	assert.Equal(t, enrollStatusEnrolled, report.Results[2].Status)
+}
+
+func TestEnrollAll_BudgetExhausted(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Budget = api.NewRequestBudget(2)
+
+	report := enrollAll(context.Background(), client, "77", []string{"1", "2", "3", "4"})
+
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2, report.Enrolled)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, enrollStatusSkipped, report.Results[2].Status)
+	assert.Equal(t, enrollStatusSkipped, report.Results[3].Status)
+	assert.Equal(t, report.Results[2].Error, report.Results[3].Error)
+}
+
+func TestListMemberIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/42/memberships", r.URL.Path)
+		if r.URL.Query().Get("after") == "" {
+			w.Write([]byte(`{"results": [{"recordId": "1"}, {"recordId": "2"}], "paging": {"next": {"after": "2"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"recordId": "3"}]}`))
+	}))
+	defer server.Close()
+
+	ids, err := listMemberIDs(context.Background(), newTestClient(server), "42")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
+
+func TestSearchIDs(t *testing.T) {
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+
+		var req api.SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Len(t, req.FilterGroups, 1)
+		require.Len(t, req.FilterGroups[0].Filters, 1)
+		assert.Equal(t, "dealstage", req.FilterGroups[0].Filters[0].PropertyName)
+		assert.Equal(t, "EQ", req.FilterGroups[0].Filters[0].Operator)
+		afters = append(afters, req.After)
+
+		if req.After == "" {
+			w.Write([]byte(`{"results": [{"id": "10"}, {"id": "11"}], "paging": {"next": {"after": "2"}}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "12"}]}`))
+	}))
+	defer server.Close()
+
+	ids, err := searchIDs(context.Background(), newTestClient(server), api.ObjectTypeDeals, []string{"dealstage EQ closedwon"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10", "11", "12"}, ids)
+	assert.Equal(t, []string{"", "2"}, afters)
+
+	_, err = searchIDs(context.Background(), newTestClient(server), api.ObjectTypeDeals, []string{"dealstage"})
+	assert.Error(t, err)
+}
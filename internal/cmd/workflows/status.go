@@ -0,0 +1,154 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/api"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/root"
+	"github.com/open-cli-collective/hubspot-cli/internal/cmd/shared"
+)
+
+// objectTypeIDs maps --type values to the object type IDs flows are built on
+var objectTypeIDs = map[string]string{
+	"contacts":   "0-1",
+	"companies":  "0-2",
+	"deals":      "0-3",
+	"tickets":    "0-5",
+	"products":   "0-7",
+	"line_items": "0-8",
+	"quotes":     "0-14",
+}
+
+// objectEnrollment is an object's enrollment in one workflow
+type objectEnrollment struct {
+	WorkflowID   string `json:"workflowId"`
+	WorkflowName string `json:"workflowName"`
+	Enabled      bool   `json:"enabled"`
+	Status       string `json:"status,omitempty"`
+	EnrolledAt   string `json:"enrolledAt,omitempty"`
+	EnrollmentID string `json:"enrollmentId,omitempty"`
+}
+
+// findEnrollments looks through the enrollments of every workflow of
+// objectTypeID for objectID. It returns what it found before an error too,
+// and how many workflows it checked.
+func findEnrollments(ctx context.Context, client *api.Client, objectTypeID, objectID string) ([]objectEnrollment, int, error) {
+	flows, err := client.ListWorkflows(ctx, api.ListOptions{All: true, Limit: api.DefaultPageSize})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	var found []objectEnrollment
+	checked := 0
+	for _, flow := range flows.Results {
+		if flow.ObjectTypeID != objectTypeID {
+			continue
+		}
+
+		enrollments, err := client.ListWorkflowEnrollments(ctx, flow.ID, api.ListOptions{All: true, Limit: api.DefaultPageSize})
+		if err != nil {
+			if api.IsNotFound(err) {
+				continue
+			}
+			return found, checked, fmt.Errorf("failed to list enrollments of workflow %s: %w", flow.ID, err)
+		}
+		checked++
+
+		for _, e := range enrollments.Results {
+			if e.ObjectID != objectID {
+				continue
+			}
+			found = append(found, objectEnrollment{
+				WorkflowID:   flow.ID,
+				WorkflowName: flow.Name,
+				Enabled:      flow.Enabled,
+				Status:       e.Status,
+				EnrolledAt:   e.EnrolledAt,
+				EnrollmentID: e.EnrollmentID,
+			})
+		}
+	}
+
+	// Most recent enrollment first
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].EnrolledAt > found[j].EnrolledAt
+	})
+	return found, checked, nil
+}
+
+func newEnrollmentStatusCmd(opts *root.Options) *cobra.Command {
+	var objectID, objectType string
+
+	cmd := &cobra.Command{
+		Use:   "enrollment-status",
+		Short: "List the workflows an object is enrolled in",
+		Long: `List the workflows a contact, or other object, is enrolled in, to find out
+which workflow sent an email or changed a property.
+
+The API has no per-object enrollment lookup, so the enrollments of every
+workflow of the object's type are checked, one workflow at a time.`,
+		Example: `  # Which workflows is this contact in?
+  hspt workflows enrollment-status --object-id 67890
+
+  # A deal's workflows
+  hspt workflows enrollment-status --object-id 1234 --type deals`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := opts.View()
+
+			if objectID == "" {
+				return fmt.Errorf("--object-id is required")
+			}
+			objectTypeID, ok := objectTypeIDs[objectType]
+			if !ok {
+				return fmt.Errorf("invalid type %q: use contacts, companies, deals, tickets, products, line_items, or quotes", objectType)
+			}
+
+			client, err := opts.APIClient()
+			if err != nil {
+				return err
+			}
+
+			found, checked, findErr := findEnrollments(cmd.Context(), client, objectTypeID, objectID)
+			if findErr != nil && len(found) == 0 {
+				return findErr
+			}
+
+			if len(found) == 0 {
+				v.Info("Object %s is not enrolled in any of %d %s workflow(s)", objectID, checked, objectType)
+				return nil
+			}
+
+			headers := []string{"WORKFLOW ID", "NAME", "ENABLED", "STATUS", "ENROLLED AT"}
+			rows := make([][]string, 0, len(found))
+			for _, e := range found {
+				rows = append(rows, []string{
+					e.WorkflowID,
+					e.WorkflowName,
+					shared.FormatBool(e.Enabled),
+					e.Status,
+					v.Time(e.EnrolledAt),
+				})
+			}
+
+			if err := v.Render(headers, rows, found); err != nil {
+				return err
+			}
+
+			// What was found before an error is shown, but the list may be
+			// incomplete
+			if findErr != nil {
+				return fmt.Errorf("stopped after %d workflow(s): %w", checked, findErr)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&objectID, "object-id", "", "ID of the contact or other object (required)")
+	cmd.Flags().StringVar(&objectType, "type", string(api.ObjectTypeContacts), "Object type: contacts, companies, deals, tickets, products, line_items, or quotes")
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package workflows
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindEnrollments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/automation/v4/flows":
+			w.Write([]byte(`{"results": [
+				{"id": "1", "name": "Welcome series", "isEnabled": true, "objectTypeId": "0-1"},
+				{"id": "2", "name": "Deal follow-up", "isEnabled": true, "objectTypeId": "0-3"},
+				{"id": "3", "name": "Re-engagement", "isEnabled": false, "objectTypeId": "0-1"},
+				{"id": "4", "name": "Deleted meanwhile", "isEnabled": true, "objectTypeId": "0-1"}
+			]}`))
+		case "/automation/v4/flows/1/enrollments":
+			w.Write([]byte(`{"results": [
+				{"objectId": "500", "status": "ACTIVE", "enrolledAt": "2025-03-01T10:00:00Z"},
+				{"objectId": "42", "status": "ACTIVE", "enrolledAt": "2025-03-02T10:00:00Z"}
+			]}`))
+		case "/automation/v4/flows/3/enrollments":
+			w.Write([]byte(`{"results": [{"objectId": "42", "status": "COMPLETED", "enrolledAt": "2025-04-01T10:00:00Z"}]}`))
+		case "/automation/v4/flows/4/enrollments":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not found"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	found, checked, err := findEnrollments(context.Background(), newTestClient(server), "0-1", "42")
+	require.NoError(t, err)
+	assert.Equal(t, 2, checked)
+	require.Len(t, found, 2)
+	assert.Equal(t, "3", found[0].WorkflowID, "most recent first")
+	assert.Equal(t, "Re-engagement", found[0].WorkflowName)
+	assert.False(t, found[0].Enabled)
+	assert.Equal(t, "COMPLETED", found[0].Status)
+	assert.Equal(t, "1", found[1].WorkflowID)
+}
+
+func TestFindEnrollments_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/automation/v4/flows":
+			w.Write([]byte(`{"results": [
+				{"id": "1", "objectTypeId": "0-1"},
+				{"id": "2", "objectTypeId": "0-1"}
+			]}`))
+		case "/automation/v4/flows/1/enrollments":
+			w.Write([]byte(`{"results": [{"objectId": "42", "status": "ACTIVE"}]}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message": "missing scope"}`))
+		}
+	}))
+	defer server.Close()
+
+	found, checked, err := findEnrollments(context.Background(), newTestClient(server), "0-1", "42")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workflow 2")
+	assert.Equal(t, 1, checked)
+	assert.Len(t, found, 1, "enrollments found before the error are returned")
+}
@@ -17,7 +17,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd := &cobra.Command{
 		Use:   "workflows",
 		Short: "Manage HubSpot workflows",
-		Long:  "Commands for listing, viewing, creating, updating, and deleting automation workflows, plus enrollment operations.",
+		Long:  "Commands for listing, viewing, creating, updating, deleting, exporting, and importing automation workflows, plus enrollment operations.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
@@ -25,8 +25,12 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newCreateCmd(opts))
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newExportCmd(opts))
+	cmd.AddCommand(newImportCmd(opts))
 	cmd.AddCommand(newEnrollCmd(opts))
+	cmd.AddCommand(newUnenrollCmd(opts))
 	cmd.AddCommand(newEnrollmentsCmd(opts))
+	cmd.AddCommand(newEnrollmentStatusCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -34,6 +38,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -52,10 +57,10 @@ func newListCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListWorkflows(api.ListOptions{
+			result, err := client.ListWorkflows(cmd.Context(), pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				return err
 			}
@@ -92,6 +97,8 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of workflows to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
 
@@ -112,7 +119,7 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			workflow, err := client.GetWorkflow(id)
+			workflow, err := client.GetWorkflow(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Workflow %s not found", id)
@@ -129,8 +136,8 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 				{"Enabled", shared.FormatBool(workflow.Enabled)},
 				{"Object Type", formatObjectType(workflow.ObjectTypeID)},
 				{"Revision ID", workflow.RevisionID},
-				{"Created", workflow.CreatedAt},
-				{"Updated", workflow.UpdatedAt},
+				{"Created", v.Time(workflow.CreatedAt)},
+				{"Updated", v.Time(workflow.UpdatedAt)},
 			}
 
 			return v.Render(headers, rows, workflow)
@@ -190,7 +197,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			workflow, err := client.CreateWorkflow(workflowData)
+			workflow, err := client.CreateWorkflow(cmd.Context(), workflowData)
 			if err != nil {
 				return err
 			}
@@ -238,7 +245,7 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			workflow, err := client.UpdateWorkflow(id, workflowData)
+			workflow, err := client.UpdateWorkflow(cmd.Context(), id, workflowData)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Workflow %s not found", id)
@@ -284,7 +291,7 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			err = client.DeleteWorkflow(id)
+			err = client.DeleteWorkflow(cmd.Context(), id)
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Workflow %s not found", id)
@@ -303,51 +310,10 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func newEnrollCmd(opts *root.Options) *cobra.Command {
-	var objectID string
-
-	cmd := &cobra.Command{
-		Use:   "enroll <workflowId>",
-		Short: "Enroll an object in a workflow",
-		Long:  "Enroll a contact, company, deal, or other object in a workflow.",
-		Example: `  # Enroll a contact in a workflow
-  hspt workflows enroll 12345 --object-id 67890`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			v := opts.View()
-			workflowID := args[0]
-
-			if objectID == "" {
-				return fmt.Errorf("--object-id is required")
-			}
-
-			client, err := opts.APIClient()
-			if err != nil {
-				return err
-			}
-
-			err = client.EnrollInWorkflow(workflowID, objectID)
-			if err != nil {
-				if api.IsNotFound(err) {
-					v.Error("Workflow %s not found", workflowID)
-					return nil
-				}
-				return err
-			}
-
-			v.Success("Object %s enrolled in workflow %s", objectID, workflowID)
-			return nil
-		},
-	}
-
-	cmd.Flags().StringVar(&objectID, "object-id", "", "ID of the object to enroll (required)")
-
-	return cmd
-}
-
 func newEnrollmentsCmd(opts *root.Options) *cobra.Command {
 	var limit int
 	var after string
+	var pages shared.AllPages
 
 	cmd := &cobra.Command{
 		Use:   "enrollments <workflowId>",
@@ -368,10 +334,10 @@ func newEnrollmentsCmd(opts *root.Options) *cobra.Command {
 				return err
 			}
 
-			result, err := client.ListWorkflowEnrollments(workflowID, api.ListOptions{
+			result, err := client.ListWorkflowEnrollments(cmd.Context(), workflowID, pages.Apply(cmd, api.ListOptions{
 				Limit: limit,
 				After: after,
-			})
+			}))
 			if err != nil {
 				if api.IsNotFound(err) {
 					v.Error("Workflow %s not found", workflowID)
@@ -411,5 +377,7 @@ func newEnrollmentsCmd(opts *root.Options) *cobra.Command {
 	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of enrollments to return")
 	cmd.Flags().StringVar(&after, "after", "", "Pagination cursor for the next page")
 
+	shared.AddAllPagesFlags(cmd, &pages)
+
 	return cmd
 }
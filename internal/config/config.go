@@ -1,11 +1,13 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -16,11 +18,151 @@ const (
 	configDirMode  = 0700
 )
 
+// DefaultProfile is the profile used when none has been selected
+const DefaultProfile = "default"
+
 // Config holds the CLI configuration
 type Config struct {
+	Version        int                 `json:"version"`
+	CurrentProfile string              `json:"current_profile,omitempty"`
+	Profiles       map[string]*Profile `json:"profiles,omitempty"`
+	Output         string              `json:"output,omitempty"`
+	CacheTTL       string              `json:"cache_ttl,omitempty"`
+	MaxRetries     *int                `json:"max_retries,omitempty"`
+	Stats          bool                `json:"stats,omitempty"`
+	ReadOnly       bool                `json:"read_only,omitempty"`
+
+	// Defaults maps a command path such as "contacts list" to flag
+	// defaults for that command, e.g. {"limit": 50}
+	Defaults map[string]map[string]interface{} `json:"defaults,omitempty"`
+
+	// CannedResponses maps a name to a reply template for
+	// "tickets respond --canned"
+	CannedResponses map[string]string `json:"canned_responses,omitempty"`
+
+	// Redaction maps a --redact profile name such as "emails" to the
+	// properties it redacts on export, adding to or replacing the built-in
+	// emails, phones, and names profiles
+	Redaction map[string]*RedactionRule `json:"redaction,omitempty"`
+}
+
+// RedactionRule is a --redact profile: the properties or export column
+// labels it covers and how their values are redacted
+type RedactionRule struct {
+	Properties []string `json:"properties"`
+	// Mode is "hash" (the default) or "mask"
+	Mode string `json:"mode,omitempty"`
+}
+
+// Profile holds the credentials and account details for one HubSpot portal
+type Profile struct {
 	AccessToken string `json:"access_token"`
+	PortalID    int64  `json:"portal_id,omitempty"`
+	Region      string `json:"region,omitempty"`
+	TimeZone    string `json:"time_zone,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+
+	// PersonalAccessKey is a HubSpot CLI personal access key, used when
+	// AccessToken is empty (see "hspt init --import-hubspot-config")
+	PersonalAccessKey string `json:"personal_access_key,omitempty"`
+
+	// Context maps an object type such as "deals" to the record ID new
+	// engagements are associated with (see "hspt context")
+	Context map[string]string `json:"context,omitempty"`
+
+	// RedactionKey is the secret --redact hashes are keyed with, generated
+	// on first use. Sharing it lets another profile produce matching hashes.
+	RedactionKey string `json:"redaction_key,omitempty"`
+}
+
+// profileOverride is the profile selected with --profile, if any
+var profileOverride string
+
+// UseProfile selects the profile for the rest of the process, taking
+// precedence over current_profile in the config file. An empty name clears
+// the override.
+func UseProfile(name string) {
+	profileOverride = name
+}
+
+// ActiveProfileName returns the selected profile name.
+// Precedence: --profile (UseProfile) → current_profile → "default"
+func (c *Config) ActiveProfileName() string {
+	if profileOverride != "" {
+		return profileOverride
+	}
+	if c.CurrentProfile != "" {
+		return c.CurrentProfile
+	}
+	return DefaultProfile
+}
+
+// ActiveProfile returns the selected profile, or nil if it does not exist
+func (c *Config) ActiveProfile() *Profile {
+	return c.Profiles[c.ActiveProfileName()]
+}
+
+// SetProfile stores p under name, creating the profile map if needed
+func (c *Config) SetProfile(name string, p *Profile) {
+	if c.Profiles == nil {
+		c.Profiles = make(map[string]*Profile)
+	}
+	c.Profiles[name] = p
+}
+
+// ProfileNames returns the configured profile names in sorted order
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
+// RegionFromToken extracts the hosting region from a private app token,
+// which HubSpot issues as pat-<region>-<uuid>
+func RegionFromToken(token string) string {
+	parts := strings.SplitN(token, "-", 3)
+	if len(parts) == 3 && parts[0] == "pat" {
+		return parts[1]
+	}
+	return ""
+}
+
+// configHeader is written above the JSON body on Save. Load ignores lines
+// starting with "//", so the file stays hand-editable.
+const configHeader = `// hspt configuration, written by "hspt init".
+//
+//   version          config schema version; older files are migrated on load
+//   current_profile  profile used when --profile is not given
+//   profiles         named accounts, each with:
+//     access_token   private app token (HUBSPOT_ACCESS_TOKEN overrides it)
+//     personal_access_key
+//                    HubSpot CLI personal access key, used instead of
+//                    access_token (see "hspt init --import-hubspot-config")
+//     portal_id      HubSpot account (hub) ID the token belongs to
+//     region         data hosting location of the account, e.g. na1 or eu1
+//     time_zone      account time zone used for dates in tables, e.g. Europe/Berlin
+//     currency       account currency used for amounts in tables, e.g. EUR
+//     context        records new engagements are associated with, e.g.
+//                      "context": {"deals": "123"} (see "hspt context")
+//     redaction_key  secret --redact hashes are keyed with, generated on
+//                      first use (HUBSPOT_REDACTION_KEY overrides it)
+//   output           default for --output: table, json, plain, or csv
+//   cache_ttl        default lifetime for cached read responses, e.g. 5m
+//   max_retries      default for --max-retries on 429 and idempotent 5xx responses
+//   stats            record local command usage for "hspt stats"
+//   read_only        refuse every request that changes data, as --read-only
+//   defaults         flag defaults per command, e.g.
+//                      "defaults": {"contacts list": {"limit": 50}}
+//   redaction        --redact profiles for exports, e.g.
+//                      "redaction": {"ids": {"properties": ["ssn"], "mode": "mask"}}
+//
+// Lines starting with // are comments and are ignored. Run
+// "hspt config validate" after editing by hand.
+`
+
 // configPath returns the path to the config file
 func configPath() (string, error) {
 	configDir, err := os.UserConfigDir()
@@ -30,29 +172,65 @@ func configPath() (string, error) {
 	return filepath.Join(configDir, configDirName, configFileName), nil
 }
 
-// Load loads the configuration from file
+// Load loads the configuration from file. Files written by an older
+// version of hspt are migrated to SchemaVersion and rewritten.
 func Load() (*Config, error) {
 	path, err := configPath()
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := os.ReadFile(path)
+	data, err := readFile(path)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return &Config{}, nil
-		}
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return nil, err
+	}
+	if data == nil {
+		return &Config{}, nil
+	}
+
+	raw, err := parseRaw(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	from, err := migrate(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	if errs := validate(raw); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, errors.Join(errs...))
+	}
+
+	migrated, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
 	var cfg Config
-	if err := json.Unmarshal(data, &cfg); err != nil {
+	if err := json.Unmarshal(migrated, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	if from < SchemaVersion {
+		// Best effort: an unwritable file still loads, it is just migrated
+		// again next time
+		_ = Save(&cfg)
+	}
+
 	return &cfg, nil
 }
 
+// readFile returns the config file contents, or nil if it does not exist
+func readFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return data, nil
+}
+
 // Save saves the configuration to file
 func Save(cfg *Config) error {
 	path, err := configPath()
@@ -65,11 +243,16 @@ func Save(cfg *Config) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	cfg.Version = SchemaVersion
+
 	data, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
 
+	data = append([]byte(configHeader), data...)
+	data = append(data, '\n')
+
 	if err := os.WriteFile(path, data, configFileMode); err != nil {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
@@ -77,6 +260,19 @@ func Save(cfg *Config) error {
 	return nil
 }
 
+// stripComments blanks out whole-line // comments so the remaining bytes are
+// plain JSON. Only lines whose first non-space characters are "//" are
+// treated as comments; values such as URLs are left alone.
+func stripComments(data []byte) []byte {
+	lines := bytes.Split(data, []byte("\n"))
+	for i, line := range lines {
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte("//")) {
+			lines[i] = nil
+		}
+	}
+	return bytes.Join(lines, []byte("\n"))
+}
+
 // Clear removes the configuration file
 func Clear() error {
 	path, err := configPath()
@@ -92,7 +288,7 @@ func Clear() error {
 }
 
 // GetAccessToken returns the access token from config or environment.
-// Precedence: HUBSPOT_ACCESS_TOKEN → config access_token
+// Precedence: HUBSPOT_ACCESS_TOKEN → access_token of the active profile
 func GetAccessToken() string {
 	if v := os.Getenv("HUBSPOT_ACCESS_TOKEN"); v != "" {
 		return strings.TrimSpace(v)
@@ -101,12 +297,32 @@ func GetAccessToken() string {
 	if err != nil {
 		return ""
 	}
-	return cfg.AccessToken
+	if p := cfg.ActiveProfile(); p != nil {
+		return p.AccessToken
+	}
+	return ""
+}
+
+// GetPersonalAccessKey returns the personal access key of the active
+// profile. It is empty when HUBSPOT_ACCESS_TOKEN is set, which takes
+// precedence over the profile's credentials.
+func GetPersonalAccessKey() string {
+	if os.Getenv("HUBSPOT_ACCESS_TOKEN") != "" {
+		return ""
+	}
+	cfg, err := Load()
+	if err != nil {
+		return ""
+	}
+	if p := cfg.ActiveProfile(); p != nil {
+		return p.PersonalAccessKey
+	}
+	return ""
 }
 
 // IsConfigured returns true if all required config values are set
 func IsConfigured() bool {
-	return GetAccessToken() != ""
+	return GetAccessToken() != "" || GetPersonalAccessKey() != ""
 }
 
 // Path returns the path to the config file
@@ -114,3 +330,22 @@ func Path() string {
 	path, _ := configPath()
 	return path
 }
+
+// Dir returns the directory holding the config file and other local state
+func Dir() (string, error) {
+	path, err := configPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(path), nil
+}
+
+// CacheDir returns the directory for cached API responses, or "" when the
+// user cache directory is unknown
+func CacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, configDirName)
+}
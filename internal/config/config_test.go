@@ -0,0 +1,91 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripComments(t *testing.T) {
+	data := []byte(configHeader + `{
+  // inline note
+  "output": "json",
+  "cache_ttl": "https://not-a-comment//x"
+}
+`)
+
+	var cfg Config
+	require.NoError(t, json.Unmarshal(stripComments(data), &cfg))
+	assert.Equal(t, "json", cfg.Output)
+	assert.Equal(t, "https://not-a-comment//x", cfg.CacheTTL)
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	want := &Config{
+		CurrentProfile: "work",
+		Profiles: map[string]*Profile{
+			"work": {AccessToken: "pat-eu1-xyz", PortalID: 42, Region: "eu1"},
+		},
+		Output:   "csv",
+		CacheTTL: "5m",
+	}
+	require.NoError(t, Save(want))
+
+	got, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestActiveProfileName(t *testing.T) {
+	t.Cleanup(func() { UseProfile("") })
+
+	cfg := &Config{}
+	assert.Equal(t, DefaultProfile, cfg.ActiveProfileName())
+
+	cfg.CurrentProfile = "work"
+	assert.Equal(t, "work", cfg.ActiveProfileName())
+
+	UseProfile("client-a")
+	assert.Equal(t, "client-a", cfg.ActiveProfileName())
+	assert.Nil(t, cfg.ActiveProfile())
+}
+
+func TestRegionFromToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		expected string
+	}{
+		{
+			name:     "north america token",
+			token:    "pat-na1-11111111-2222-3333-4444-555555555555",
+			expected: "na1",
+		},
+		{
+			name:     "eu token",
+			token:    "pat-eu1-11111111-2222-3333-4444-555555555555",
+			expected: "eu1",
+		},
+		{
+			name:     "legacy api key",
+			token:    "11111111-2222-3333-4444-555555555555",
+			expected: "",
+		},
+		{
+			name:     "empty token",
+			token:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RegionFromToken(tt.token))
+		})
+	}
+}
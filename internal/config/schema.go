@@ -0,0 +1,357 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SchemaVersion is the config file format written by this build. Files with
+// a lower version are migrated on load; files with a higher one were written
+// by a newer hspt and are rejected rather than silently truncated.
+const SchemaVersion = 2
+
+// KeyError describes a problem with a single config key
+type KeyError struct {
+	Key    string
+	Reason string
+}
+
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("config key %q: %s", e.Key, e.Reason)
+}
+
+// validOutputs are the accepted values for the output key
+var validOutputs = []string{"table", "json", "plain", "csv"}
+
+// profileSchema maps every key of a profile to its value check
+var profileSchema = map[string]func(json.RawMessage) string{
+	"access_token": stringValue(nil),
+	"portal_id":    integerValue,
+	"region":       stringValue(nil),
+	"time_zone": stringValue(func(s string) string {
+		if _, err := time.LoadLocation(s); err != nil {
+			return fmt.Sprintf("must be an IANA time zone such as America/New_York, got %q", s)
+		}
+		return ""
+	}),
+	"currency": stringValue(nil),
+	"context":  stringMapValue,
+
+	"personal_access_key": stringValue(nil),
+	"redaction_key":       stringValue(nil),
+}
+
+// schema maps every known top-level key to its value check. Keys whose value
+// is an object of named entries are listed in nestedSchema instead.
+var schema = map[string]func(json.RawMessage) string{
+	"version":         integerValue,
+	"current_profile": stringValue(nil),
+	"output": stringValue(func(s string) string {
+		for _, o := range validOutputs {
+			if s == o {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %v, got %q", validOutputs, s)
+	}),
+	"cache_ttl": stringValue(func(s string) string {
+		if _, err := time.ParseDuration(s); err != nil {
+			return fmt.Sprintf("must be a duration such as 30s or 5m, got %q", s)
+		}
+		return ""
+	}),
+	"max_retries":      nonNegativeIntegerValue,
+	"stats":            boolValue,
+	"read_only":        boolValue,
+	"canned_responses": stringMapValue,
+}
+
+// validRedactionModes are the accepted values for a redaction rule's mode
+var validRedactionModes = []string{"hash", "mask"}
+
+// redactionSchema maps every key of a redaction rule to its value check
+var redactionSchema = map[string]func(json.RawMessage) string{
+	"properties": stringListValue,
+	"mode": stringValue(func(s string) string {
+		for _, m := range validRedactionModes {
+			if s == m {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %v, got %q", validRedactionModes, s)
+	}),
+}
+
+// anyKey in a schema matches keys that are not listed explicitly
+const anyKey = "*"
+
+// defaultsSchema accepts any flag name; whether the flag exists is checked
+// when the command runs, since the config package does not know commands
+var defaultsSchema = map[string]func(json.RawMessage) string{
+	anyKey: flagValue,
+}
+
+// nestedSchema maps keys holding named sub-objects (e.g. profiles.<name>) to
+// the schema for each entry
+var nestedSchema = map[string]map[string]func(json.RawMessage) string{
+	"profiles":  profileSchema,
+	"defaults":  defaultsSchema,
+	"redaction": redactionSchema,
+}
+
+// migrations upgrade a raw config from the version in the key to the next
+// one. Each step only touches the keys that changed between versions.
+var migrations = map[int]func(raw map[string]json.RawMessage){
+	// Version 0 is the unversioned file written before the schema existed;
+	// its only key, access_token, is unchanged.
+	0: func(raw map[string]json.RawMessage) {},
+	// Version 2 moved the account keys into profiles.default
+	1: func(raw map[string]json.RawMessage) {
+		profile := make(map[string]json.RawMessage)
+		for key := range profileSchema {
+			if v, ok := raw[key]; ok {
+				profile[key] = v
+				delete(raw, key)
+			}
+		}
+		if len(profile) == 0 {
+			return
+		}
+		data, _ := json.Marshal(map[string]map[string]json.RawMessage{DefaultProfile: profile})
+		raw["profiles"] = data
+		raw["current_profile"] = json.RawMessage(`"` + DefaultProfile + `"`)
+	},
+}
+
+// parseRaw decodes a config file into its top-level keys
+func parseRaw(data []byte) (map[string]json.RawMessage, error) {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(stripComments(data), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// rawVersion returns the schema version recorded in raw (0 when absent)
+func rawVersion(raw map[string]json.RawMessage) (int, error) {
+	v, ok := raw["version"]
+	if !ok {
+		return 0, nil
+	}
+	var version int
+	if err := json.Unmarshal(v, &version); err != nil {
+		return 0, &KeyError{Key: "version", Reason: "must be an integer"}
+	}
+	return version, nil
+}
+
+// migrate upgrades raw in place to SchemaVersion and returns the version it
+// started from
+func migrate(raw map[string]json.RawMessage) (int, error) {
+	from, err := rawVersion(raw)
+	if err != nil {
+		return 0, err
+	}
+	if from > SchemaVersion {
+		return from, &KeyError{Key: "version", Reason: fmt.Sprintf("version %d is newer than this hspt supports (%d); upgrade hspt", from, SchemaVersion)}
+	}
+
+	for v := from; v < SchemaVersion; v++ {
+		step, ok := migrations[v]
+		if !ok {
+			return from, &KeyError{Key: "version", Reason: fmt.Sprintf("no migration from version %d", v)}
+		}
+		step(raw)
+	}
+	raw["version"] = json.RawMessage(fmt.Sprint(SchemaVersion))
+
+	return from, nil
+}
+
+// validate checks every key in raw against the schema and returns one error
+// per offending key, sorted by key name
+func validate(raw map[string]json.RawMessage) []error {
+	errs := validateKeys("", raw, schema, nestedSchema)
+
+	if v, ok := raw["current_profile"]; ok {
+		var current string
+		var profiles map[string]json.RawMessage
+		if json.Unmarshal(v, &current) == nil && current != "" {
+			_ = json.Unmarshal(raw["profiles"], &profiles)
+			if _, ok := profiles[current]; !ok {
+				errs = append(errs, &KeyError{Key: "current_profile", Reason: fmt.Sprintf("profile %q is not defined under profiles", current)})
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateKeys checks raw against fields and nested, naming offending keys
+// with their dotted path under prefix
+func validateKeys(prefix string, raw map[string]json.RawMessage, fields map[string]func(json.RawMessage) string, nested map[string]map[string]func(json.RawMessage) string) []error {
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, k := range keys {
+		path := prefix + k
+
+		if entrySchema, ok := nested[k]; ok {
+			var entries map[string]map[string]json.RawMessage
+			if err := json.Unmarshal(raw[k], &entries); err != nil {
+				errs = append(errs, &KeyError{Key: path, Reason: "must be an object of named entries"})
+				continue
+			}
+			names := make([]string, 0, len(entries))
+			for name := range entries {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				errs = append(errs, validateKeys(path+"."+name+".", entries[name], entrySchema, nil)...)
+			}
+			continue
+		}
+
+		check, ok := fields[k]
+		if !ok {
+			check, ok = fields[anyKey]
+		}
+		if !ok {
+			errs = append(errs, &KeyError{Key: path, Reason: "unknown key"})
+			continue
+		}
+		if reason := check(raw[k]); reason != "" {
+			errs = append(errs, &KeyError{Key: path, Reason: reason})
+		}
+	}
+	return errs
+}
+
+func boolValue(v json.RawMessage) string {
+	var b bool
+	if err := json.Unmarshal(v, &b); err != nil {
+		return "must be true or false"
+	}
+	return ""
+}
+
+func integerValue(v json.RawMessage) string {
+	var n int64
+	if err := json.Unmarshal(v, &n); err != nil {
+		return "must be an integer"
+	}
+	return ""
+}
+
+func nonNegativeIntegerValue(v json.RawMessage) string {
+	var n int64
+	if err := json.Unmarshal(v, &n); err != nil || n < 0 {
+		return "must be a non-negative integer"
+	}
+	return ""
+}
+
+// flagValue accepts anything a command-line flag can be set from: a string,
+// number, boolean, or a list of strings and numbers
+func flagValue(v json.RawMessage) string {
+	var value interface{}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return "must be a string, number, boolean, or list"
+	}
+	switch val := value.(type) {
+	case string, float64, bool:
+		return ""
+	case []interface{}:
+		for _, item := range val {
+			switch item.(type) {
+			case string, float64:
+			default:
+				return "list items must be strings or numbers"
+			}
+		}
+		return ""
+	default:
+		return "must be a string, number, boolean, or list"
+	}
+}
+
+// stringMapValue accepts an object whose values are all strings
+func stringMapValue(v json.RawMessage) string {
+	var m map[string]string
+	if err := json.Unmarshal(v, &m); err != nil {
+		return "must be an object of string values"
+	}
+	return ""
+}
+
+// stringListValue accepts a list of strings
+func stringListValue(v json.RawMessage) string {
+	var list []string
+	if err := json.Unmarshal(v, &list); err != nil {
+		return "must be a list of strings"
+	}
+	return ""
+}
+
+func stringValue(check func(string) string) func(json.RawMessage) string {
+	return func(v json.RawMessage) string {
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return "must be a string"
+		}
+		if check == nil || s == "" {
+			return ""
+		}
+		return check(s)
+	}
+}
+
+// ValidationResult reports the state of the config file on disk
+type ValidationResult struct {
+	Path string
+	// Version is the schema version recorded in the file (0 if unversioned)
+	Version int
+	// NeedsMigration is true when the file will be upgraded on next load
+	NeedsMigration bool
+	Errors         []error
+}
+
+// Validate checks the config file against the schema without modifying it.
+// A missing file is valid. The returned error is only set when the file
+// cannot be read at all.
+func Validate() (*ValidationResult, error) {
+	path, err := configPath()
+	if err != nil {
+		return nil, err
+	}
+	result := &ValidationResult{Path: path, Version: SchemaVersion}
+
+	data, err := readFile(path)
+	if err != nil || data == nil {
+		return result, err
+	}
+
+	raw, err := parseRaw(data)
+	if err != nil {
+		result.Errors = []error{fmt.Errorf("invalid JSON: %w", err)}
+		return result, nil
+	}
+
+	from, err := migrate(raw)
+	result.Version = from
+	result.NeedsMigration = from < SchemaVersion
+	if err != nil {
+		result.Errors = []error{err}
+		return result, nil
+	}
+	result.Errors = validate(raw)
+
+	return result, nil
+}
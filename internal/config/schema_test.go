@@ -0,0 +1,196 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfig points the config directory at a temp dir and writes contents
+// to the config file, returning its path
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	path, err := configPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), configDirMode))
+	require.NoError(t, os.WriteFile(path, []byte(contents), configFileMode))
+	return path
+}
+
+func TestLoad_MigratesOldConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{"unversioned", `{"access_token": "pat-na1-abc"}`},
+		{"version 1", `{"version": 1, "access_token": "pat-na1-abc", "portal_id": 42, "output": "json"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfig(t, tt.contents)
+
+			cfg, err := Load()
+			require.NoError(t, err)
+			assert.Equal(t, SchemaVersion, cfg.Version)
+			assert.Equal(t, DefaultProfile, cfg.CurrentProfile)
+			require.NotNil(t, cfg.ActiveProfile())
+			assert.Equal(t, "pat-na1-abc", cfg.ActiveProfile().AccessToken)
+
+			// the migrated file is written back
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Contains(t, string(data), `"version": 2`)
+			assert.Contains(t, string(data), `"profiles"`)
+		})
+	}
+}
+
+func TestLoad_RejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{
+			name:     "unknown key",
+			contents: `{"version": 2, "ouput": "json"}`,
+			wantErr:  `config key "ouput": unknown key`,
+		},
+		{
+			name:     "wrong type",
+			contents: `{"version": 2, "profiles": {"work": {"portal_id": "123"}}}`,
+			wantErr:  `config key "profiles.work.portal_id": must be an integer`,
+		},
+		{
+			name:     "unknown profile key",
+			contents: `{"version": 2, "profiles": {"work": {"acess_token": "x"}}}`,
+			wantErr:  `config key "profiles.work.acess_token": unknown key`,
+		},
+		{
+			name:     "undefined current profile",
+			contents: `{"version": 2, "current_profile": "work", "profiles": {"default": {"access_token": "x"}}}`,
+			wantErr:  `config key "current_profile": profile "work" is not defined`,
+		},
+		{
+			name:     "bad enum",
+			contents: `{"version": 2, "output": "yaml"}`,
+			wantErr:  `config key "output": must be one of`,
+		},
+		{
+			name:     "bad duration",
+			contents: `{"version": 2, "cache_ttl": "five minutes"}`,
+			wantErr:  `config key "cache_ttl": must be a duration`,
+		},
+		{
+			name:     "negative retries",
+			contents: `{"version": 2, "max_retries": -1}`,
+			wantErr:  `config key "max_retries": must be a non-negative integer`,
+		},
+		{
+			name:     "bad time zone",
+			contents: `{"version": 2, "profiles": {"default": {"access_token": "x", "time_zone": "Mars/Olympus"}}}`,
+			wantErr:  `config key "profiles.default.time_zone": must be an IANA time zone`,
+		},
+		{
+			name:     "bad context",
+			contents: `{"version": 2, "profiles": {"default": {"access_token": "x", "context": {"deals": 123}}}}`,
+			wantErr:  `config key "profiles.default.context": must be an object of string values`,
+		},
+		{
+			name:     "bad canned response",
+			contents: `{"version": 2, "canned_responses": {"thanks": ["Thanks!"]}}`,
+			wantErr:  `config key "canned_responses": must be an object of string values`,
+		},
+		{
+			name:     "bad redaction mode",
+			contents: `{"version": 2, "redaction": {"emails": {"properties": ["email"], "mode": "scramble"}}}`,
+			wantErr:  `config key "redaction.emails.mode": must be one of`,
+		},
+		{
+			name:     "bad redaction properties",
+			contents: `{"version": 2, "redaction": {"ids": {"properties": "ssn"}}}`,
+			wantErr:  `config key "redaction.ids.properties": must be a list of strings`,
+		},
+		{
+			name:     "newer version",
+			contents: `{"version": 99}`,
+			wantErr:  `config key "version": version 99 is newer`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeConfig(t, tt.contents)
+
+			_, err := Load()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("missing file is valid", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", dir)
+		t.Setenv("HOME", dir)
+
+		result, err := Validate()
+		require.NoError(t, err)
+		assert.Empty(t, result.Errors)
+	})
+
+	t.Run("reports every bad key without modifying the file", func(t *testing.T) {
+		contents := `{"version": 1, "access_token": 5, "output": "yaml", "extra": true}`
+		path := writeConfig(t, contents)
+
+		result, err := Validate()
+		require.NoError(t, err)
+		assert.True(t, result.NeedsMigration)
+		assert.Equal(t, 1, result.Version)
+		require.Len(t, result.Errors, 3)
+		assert.EqualError(t, result.Errors[0], `config key "extra": unknown key`)
+		assert.Contains(t, result.Errors[1].Error(), `config key "output"`)
+		assert.EqualError(t, result.Errors[2], `config key "profiles.default.access_token": must be a string`)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, contents, string(data))
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		writeConfig(t, `{"access_token": `)
+
+		result, err := Validate()
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Contains(t, result.Errors[0].Error(), "invalid JSON")
+	})
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		writeConfig(t, `{"version": 2, "defaults": {"contacts list": {"limit": 50, "properties": ["email", "lifecyclestage"]}}}`)
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, float64(50), cfg.Defaults["contacts list"]["limit"])
+		assert.Equal(t, []interface{}{"email", "lifecyclestage"}, cfg.Defaults["contacts list"]["properties"])
+	})
+
+	t.Run("nested object rejected", func(t *testing.T) {
+		writeConfig(t, `{"version": 2, "defaults": {"contacts list": {"limit": {"n": 1}}}}`)
+
+		_, err := Load()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `config key "defaults.contacts list.limit"`)
+	})
+}
@@ -1,5 +1,7 @@
 package exitcode
 
+import "errors"
+
 // Exit codes for CLI
 const (
 	Success         = 0
@@ -11,4 +13,31 @@ const (
 	PermissionError = 6
 	RateLimitError  = 7
 	ServerError     = 8
+	BudgetExhausted = 9
+	DeliveryFailed  = 10
+	Timeout         = 11
+	Interrupted     = 130
 )
+
+// codedError is an error that asks for a particular exit code
+type codedError struct {
+	err  error
+	code int
+}
+
+func (e *codedError) Error() string { return e.err.Error() }
+func (e *codedError) Unwrap() error { return e.err }
+
+// WithCode wraps err so that the CLI exits with code when it fails with it
+func WithCode(err error, code int) error {
+	return &codedError{err: err, code: code}
+}
+
+// FromError returns the exit code err asks for, if any
+func FromError(err error) (int, bool) {
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code, true
+	}
+	return 0, false
+}
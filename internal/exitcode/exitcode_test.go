@@ -0,0 +1,23 @@
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromError(t *testing.T) {
+	base := errors.New("message failed")
+	err := fmt.Errorf("delivery: %w", WithCode(base, DeliveryFailed))
+
+	code, ok := FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, DeliveryFailed, code)
+	assert.ErrorIs(t, err, base)
+	assert.Equal(t, "delivery: message failed", err.Error())
+
+	_, ok = FromError(base)
+	assert.False(t, ok)
+}
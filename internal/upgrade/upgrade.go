@@ -0,0 +1,380 @@
+// Package upgrade finds, verifies, and installs hspt releases published on
+// GitHub. A release is only installed when its checksums.txt carries a valid
+// signature from the release signing key built into the binary and the
+// downloaded archive matches its checksum there.
+package upgrade
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Repo is the GitHub repository releases are published to
+	Repo = "open-cli-collective/hubspot-cli"
+	// DefaultAPIURL is the GitHub REST API
+	DefaultAPIURL = "https://api.github.com"
+
+	checksumsFile = "checksums.txt"
+	signatureFile = checksumsFile + ".sig"
+	binaryName    = "hspt"
+
+	// maxDownload bounds any single release asset
+	maxDownload = 200 << 20
+)
+
+// Release channels
+const (
+	ChannelStable     = "stable"
+	ChannelPrerelease = "prerelease"
+)
+
+// ErrNoSigningKey is returned when the binary was built without a release
+// signing key, so no release can be verified
+var ErrNoSigningKey = errors.New("this build has no release signing key; reinstall from a release or with go install")
+
+// Release is a GitHub release
+type Release struct {
+	Tag         string  `json:"tag_name"`
+	Prerelease  bool    `json:"prerelease"`
+	Draft       bool    `json:"draft"`
+	PublishedAt string  `json:"published_at"`
+	URL         string  `json:"html_url"`
+	Assets      []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Version returns the release version without the leading "v"
+func (r *Release) Version() string {
+	return strings.TrimPrefix(r.Tag, "v")
+}
+
+func (r *Release) asset(name string) *Asset {
+	for i := range r.Assets {
+		if r.Assets[i].Name == name {
+			return &r.Assets[i]
+		}
+	}
+	return nil
+}
+
+// Updater looks up and downloads releases
+type Updater struct {
+	HTTPClient *http.Client
+	// APIURL is the GitHub API base URL (DefaultAPIURL)
+	APIURL string
+	// SigningKey is the base64 ed25519 public key releases are signed with
+	SigningKey string
+}
+
+// Latest returns the newest published release on a channel. The stable
+// channel skips prereleases; the prerelease channel includes them.
+func (u *Updater) Latest(ctx context.Context, channel string) (*Release, error) {
+	if channel != ChannelStable && channel != ChannelPrerelease {
+		return nil, fmt.Errorf("invalid channel %q: must be %s or %s", channel, ChannelStable, ChannelPrerelease)
+	}
+
+	body, err := u.download(ctx, u.APIURL+"/repos/"+Repo+"/releases?per_page=30")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+
+	var releases []Release
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, fmt.Errorf("failed to parse releases: %w", err)
+	}
+
+	// GitHub lists the newest release first
+	for i := range releases {
+		r := &releases[i]
+		if r.Draft || (r.Prerelease && channel == ChannelStable) {
+			continue
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("no %s release found", channel)
+}
+
+// Fetch downloads the release archive for a platform, verifies the signature
+// of the release checksums and the archive's checksum, and returns the hspt
+// binary inside it
+func (u *Updater) Fetch(ctx context.Context, r *Release, goos, goarch string) ([]byte, error) {
+	name := ArchiveName(r.Version(), goos, goarch)
+	archive := r.asset(name)
+	if archive == nil {
+		return nil, fmt.Errorf("release %s has no build for %s/%s", r.Tag, goos, goarch)
+	}
+	checksums, signature := r.asset(checksumsFile), r.asset(signatureFile)
+	if checksums == nil || signature == nil {
+		return nil, fmt.Errorf("release %s is not signed", r.Tag)
+	}
+
+	sums, err := u.download(ctx, checksums.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", checksumsFile, err)
+	}
+	sig, err := u.download(ctx, signature.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", signatureFile, err)
+	}
+	if err := VerifySignature(u.SigningKey, sums, sig); err != nil {
+		return nil, err
+	}
+
+	want, err := Checksum(sums, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := u.download(ctx, archive.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", name, err)
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return nil, fmt.Errorf("checksum mismatch for %s: got %s, want %s", name, got, want)
+	}
+
+	return ExtractBinary(data, name)
+}
+
+func (u *Updater) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	client := u.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDownload+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDownload {
+		return nil, fmt.Errorf("%s is larger than %d bytes", url, maxDownload)
+	}
+	return data, nil
+}
+
+// ArchiveName returns the name of the release archive for a platform, as
+// set by name_template in .goreleaser.yml
+func ArchiveName(version, goos, goarch string) string {
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("hspt_%s_%s_%s%s", version, goos, goarch, ext)
+}
+
+// VerifySignature checks an ed25519 signature of message against a base64
+// public key
+func VerifySignature(key string, message, sig []byte) error {
+	if key == "" {
+		return ErrNoSigningKey
+	}
+	pub, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid release signing key")
+	}
+	if !ed25519.Verify(ed25519.PublicKey(pub), message, sig) {
+		return fmt.Errorf("signature verification of %s failed", checksumsFile)
+	}
+	return nil
+}
+
+// Checksum returns the SHA-256 of a file listed in a checksums.txt
+func Checksum(checksums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	return "", fmt.Errorf("%s is not listed in %s", name, checksumsFile)
+}
+
+// ExtractBinary returns the hspt binary from a .tar.gz or .zip release
+// archive
+func ExtractBinary(archive []byte, archiveName string) ([]byte, error) {
+	if strings.HasSuffix(archiveName, ".zip") {
+		return extractZip(archive, binaryName+".exe")
+	}
+	return extractTarGz(archive, binaryName)
+}
+
+func extractTarGz(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Base(hdr.Name) == name {
+			return io.ReadAll(io.LimitReader(tr, maxDownload))
+		}
+	}
+	return nil, fmt.Errorf("%s not found in archive", name)
+}
+
+func extractZip(archive []byte, name string) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if path.Base(f.Name) != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		defer rc.Close()
+		return io.ReadAll(io.LimitReader(rc, maxDownload))
+	}
+	return nil, fmt.Errorf("%s not found in archive", name)
+}
+
+// Install replaces the executable at exe with binary. The new binary is
+// written next to it and renamed into place, so an interrupted upgrade
+// leaves the old binary working. Windows cannot overwrite a running
+// executable, so there the old one is first moved aside to exe.old.
+func Install(exe string, binary []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(exe), ".hspt-upgrade-*")
+	if err != nil {
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+
+	if runtime.GOOS == "windows" {
+		old := exe + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(exe, old); err != nil {
+			return fmt.Errorf("failed to move %s aside: %w", exe, err)
+		}
+		if err := os.Rename(tmp.Name(), exe); err != nil {
+			_ = os.Rename(old, exe)
+			return fmt.Errorf("failed to replace %s: %w", exe, err)
+		}
+		return nil
+	}
+
+	if err := os.Rename(tmp.Name(), exe); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", exe, err)
+	}
+	return nil
+}
+
+// ManagedBy names the package manager that owns the executable at exe, or
+// returns "" when it was installed by hand
+func ManagedBy(exe string) string {
+	p := strings.ToLower(filepath.ToSlash(exe))
+	switch {
+	case strings.Contains(p, "/cellar/"), strings.Contains(p, "/caskroom/"):
+		return "Homebrew"
+	case strings.HasPrefix(p, "/snap/"):
+		return "snap"
+	case strings.Contains(p, "/chocolatey/"):
+		return "Chocolatey"
+	case strings.Contains(p, "/winget/"):
+		return "WinGet"
+	case strings.HasPrefix(p, "/usr/bin/"):
+		return "your system package manager"
+	}
+	return ""
+}
+
+// Compare compares two versions such as 1.4.0 and 1.5.0-rc.1, returning -1,
+// 0, or 1. A prerelease sorts before the release it precedes.
+func Compare(a, b string) int {
+	aCore, aPre, _ := strings.Cut(strings.TrimPrefix(a, "v"), "-")
+	bCore, bPre, _ := strings.Cut(strings.TrimPrefix(b, "v"), "-")
+
+	aParts, bParts := strings.Split(aCore, "."), strings.Split(bCore, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		x, y := versionPart(aParts, i), versionPart(bParts, i)
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	case aPre < bPre:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+	n, _ := strconv.Atoi(parts[i])
+	return n
+}
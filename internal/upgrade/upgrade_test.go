@@ -0,0 +1,226 @@
+package upgrade
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tarGz(t *testing.T, name string, content []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "README.md", Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+	_, _ = tw.Write([]byte("hi"))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+	_, _ = tw.Write(content)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// releaseServer serves a release listing and the assets of v1.5.0, signed
+// with key
+func releaseServer(t *testing.T, key ed25519.PrivateKey, archive []byte, tamper bool) *httptest.Server {
+	t.Helper()
+	name := ArchiveName("1.5.0", "linux", "amd64")
+	sum := sha256.Sum256(archive)
+	checksums := []byte(hex.EncodeToString(sum[:]) + "  " + name + "\n")
+	sig := ed25519.Sign(key, checksums)
+	if tamper {
+		archive = append([]byte{}, archive...)
+		archive[len(archive)-1] ^= 0xff
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/" + Repo + "/releases":
+			_, _ = w.Write([]byte(`[
+				{"tag_name": "v1.6.0-rc.1", "prerelease": true, "assets": []},
+				{"tag_name": "v1.5.0", "assets": [
+					{"name": "` + name + `", "browser_download_url": "` + server.URL + `/dl/archive"},
+					{"name": "checksums.txt", "browser_download_url": "` + server.URL + `/dl/checksums"},
+					{"name": "checksums.txt.sig", "browser_download_url": "` + server.URL + `/dl/sig"}
+				]},
+				{"tag_name": "v1.4.0", "assets": []}
+			]`))
+		case "/dl/archive":
+			_, _ = w.Write(archive)
+		case "/dl/checksums":
+			_, _ = w.Write(checksums)
+		case "/dl/sig":
+			_, _ = w.Write(sig)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestUpdater_Latest(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	server := releaseServer(t, priv, nil, false)
+	defer server.Close()
+
+	u := &Updater{HTTPClient: server.Client(), APIURL: server.URL}
+
+	r, err := u.Latest(context.Background(), ChannelStable)
+	require.NoError(t, err)
+	assert.Equal(t, "1.5.0", r.Version())
+
+	r, err = u.Latest(context.Background(), ChannelPrerelease)
+	require.NoError(t, err)
+	assert.Equal(t, "1.6.0-rc.1", r.Version())
+
+	_, err = u.Latest(context.Background(), "nightly")
+	assert.ErrorContains(t, err, "invalid channel")
+}
+
+func TestUpdater_Fetch(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	key := base64.StdEncoding.EncodeToString(pub)
+	binary := []byte("#!/bin/sh\necho new\n")
+	archive := tarGz(t, "hspt", binary)
+
+	fetch := func(t *testing.T, key string, tamper bool) ([]byte, error) {
+		server := releaseServer(t, priv, archive, tamper)
+		defer server.Close()
+		u := &Updater{HTTPClient: server.Client(), APIURL: server.URL, SigningKey: key}
+		r, err := u.Latest(context.Background(), ChannelStable)
+		require.NoError(t, err)
+		return u.Fetch(context.Background(), r, "linux", "amd64")
+	}
+
+	t.Run("verified binary", func(t *testing.T) {
+		got, err := fetch(t, key, false)
+		require.NoError(t, err)
+		assert.Equal(t, binary, got)
+	})
+
+	t.Run("archive does not match checksum", func(t *testing.T) {
+		_, err := fetch(t, key, true)
+		assert.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("signed with another key", func(t *testing.T) {
+		other, _, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		_, err = fetch(t, base64.StdEncoding.EncodeToString(other), false)
+		assert.ErrorContains(t, err, "signature verification")
+	})
+
+	t.Run("build without signing key", func(t *testing.T) {
+		_, err := fetch(t, "", false)
+		assert.ErrorIs(t, err, ErrNoSigningKey)
+	})
+
+	t.Run("no build for platform", func(t *testing.T) {
+		server := releaseServer(t, priv, archive, false)
+		defer server.Close()
+		u := &Updater{HTTPClient: server.Client(), APIURL: server.URL, SigningKey: key}
+		r, err := u.Latest(context.Background(), ChannelStable)
+		require.NoError(t, err)
+		_, err = u.Fetch(context.Background(), r, "plan9", "386")
+		assert.ErrorContains(t, err, "no build for plan9/386")
+	})
+}
+
+func TestChecksum(t *testing.T) {
+	sums := []byte("ABC123  hspt_1.5.0_linux_amd64.tar.gz\ndef456  hspt_1.5.0_darwin_arm64.tar.gz\n")
+
+	got, err := Checksum(sums, "hspt_1.5.0_darwin_arm64.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "def456", got)
+
+	got, err = Checksum(sums, "hspt_1.5.0_linux_amd64.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", got)
+
+	_, err = Checksum(sums, "hspt_1.5.0_windows_amd64.zip")
+	assert.ErrorContains(t, err, "not listed")
+}
+
+func TestExtractBinary(t *testing.T) {
+	t.Run("tar.gz", func(t *testing.T) {
+		got, err := ExtractBinary(tarGz(t, "hspt", []byte("bin")), "hspt_1.0.0_linux_amd64.tar.gz")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("bin"), got)
+
+		_, err = ExtractBinary(tarGz(t, "other", []byte("bin")), "hspt_1.0.0_linux_amd64.tar.gz")
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, err := zw.Create("hspt.exe")
+		require.NoError(t, err)
+		_, _ = w.Write([]byte("exe"))
+		require.NoError(t, zw.Close())
+
+		got, err := ExtractBinary(buf.Bytes(), "hspt_1.0.0_windows_amd64.zip")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("exe"), got)
+	})
+}
+
+func TestInstall(t *testing.T) {
+	exe := filepath.Join(t.TempDir(), "hspt")
+	require.NoError(t, os.WriteFile(exe, []byte("old"), 0755))
+
+	require.NoError(t, Install(exe, []byte("new")))
+
+	got, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(got))
+
+	entries, err := os.ReadDir(filepath.Dir(exe))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary file should be renamed into place")
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.5.0", "1.5.0", 0},
+		{"v1.5.0", "1.5.0", 0},
+		{"1.4.9", "1.5.0", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.0", "1.99.99", 1},
+		{"1.5.0-rc.1", "1.5.0", -1},
+		{"1.5.0", "1.5.0-rc.1", 1},
+		{"1.5.0-rc.1", "1.5.0-rc.2", -1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Compare(tt.a, tt.b), "Compare(%q, %q)", tt.a, tt.b)
+	}
+}
+
+func TestManagedBy(t *testing.T) {
+	assert.Equal(t, "Homebrew", ManagedBy("/opt/homebrew/Caskroom/hubspot-cli/1.5.0/hspt"))
+	assert.Equal(t, "snap", ManagedBy("/snap/hspt/12/bin/hspt"))
+	assert.Equal(t, "your system package manager", ManagedBy("/usr/bin/hspt"))
+	assert.Equal(t, "WinGet", ManagedBy(`C:/Users/me/AppData/Local/Microsoft/WinGet/Packages/hspt/hspt.exe`))
+	assert.Equal(t, "", ManagedBy("/usr/local/bin/hspt"))
+	assert.Equal(t, "", ManagedBy("/home/me/go/bin/hspt"))
+}
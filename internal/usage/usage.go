@@ -0,0 +1,243 @@
+// Package usage records which hspt commands and object types are used
+// locally. It is opt-in (config key "stats") and never leaves the machine:
+// counts are kept in a JSON file next to the config file and read back only
+// by "hspt stats".
+package usage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/hubspot-cli/internal/config"
+)
+
+const (
+	statsFileName = "stats.json"
+	statsFileMode = 0600
+)
+
+// objectCommands are the command groups named after a CRM object type
+var objectCommands = map[string]bool{
+	"contacts":   true,
+	"companies":  true,
+	"deals":      true,
+	"tickets":    true,
+	"products":   true,
+	"line-items": true,
+	"quotes":     true,
+	"notes":      true,
+	"calls":      true,
+	"emails":     true,
+	"meetings":   true,
+	"tasks":      true,
+}
+
+// typeFlagCommands are the command groups whose --type flag names a CRM
+// object type (elsewhere --type means a page, email, or property type)
+var typeFlagCommands = map[string]bool{
+	"crm":            true,
+	"custom-objects": true,
+}
+
+// CommandStats aggregates runs of a single command
+type CommandStats struct {
+	Runs    int       `json:"runs"`
+	Errors  int       `json:"errors"`
+	TotalMS int64     `json:"total_ms"`
+	LastRun time.Time `json:"last_run"`
+}
+
+// Average returns the mean run duration
+func (s CommandStats) Average() time.Duration {
+	if s.Runs == 0 {
+		return 0
+	}
+	return time.Duration(s.TotalMS/int64(s.Runs)) * time.Millisecond
+}
+
+// Stats is the contents of the stats file
+type Stats struct {
+	Since       time.Time                `json:"since"`
+	Commands    map[string]*CommandStats `json:"commands"`
+	ObjectTypes map[string]int           `json:"object_types"`
+}
+
+// Entry is a named CommandStats, used for sorted output
+type Entry struct {
+	Name string
+	CommandStats
+}
+
+// SortedCommands returns commands ordered by run count, most used first
+func (s *Stats) SortedCommands() []Entry {
+	entries := make([]Entry, 0, len(s.Commands))
+	for name, cs := range s.Commands {
+		entries = append(entries, Entry{Name: name, CommandStats: *cs})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Runs != entries[j].Runs {
+			return entries[i].Runs > entries[j].Runs
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// SortedObjectTypes returns object types ordered by use, most used first
+func (s *Stats) SortedObjectTypes() []string {
+	names := make([]string, 0, len(s.ObjectTypes))
+	for name := range s.ObjectTypes {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if s.ObjectTypes[names[i]] != s.ObjectTypes[names[j]] {
+			return s.ObjectTypes[names[i]] > s.ObjectTypes[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// add records one run into s
+func (s *Stats) add(command, objectType string, d time.Duration, failed bool, now time.Time) {
+	if s.Commands == nil {
+		s.Commands = make(map[string]*CommandStats)
+	}
+	if s.ObjectTypes == nil {
+		s.ObjectTypes = make(map[string]int)
+	}
+	if s.Since.IsZero() {
+		s.Since = now
+	}
+
+	cs, ok := s.Commands[command]
+	if !ok {
+		cs = &CommandStats{}
+		s.Commands[command] = cs
+	}
+	cs.Runs++
+	if failed {
+		cs.Errors++
+	}
+	cs.TotalMS += d.Milliseconds()
+	cs.LastRun = now
+
+	if objectType != "" {
+		s.ObjectTypes[objectType]++
+	}
+}
+
+// Path returns the location of the stats file
+func Path() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, statsFileName), nil
+}
+
+// Load reads the stats file. A missing file yields empty stats.
+func Load() (*Stats, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Stats{}, nil
+		}
+		return nil, fmt.Errorf("failed to read stats file: %w", err)
+	}
+
+	var s Stats
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse stats file: %w", err)
+	}
+	return &s, nil
+}
+
+func save(s *Stats) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal stats: %w", err)
+	}
+	if err := os.WriteFile(path, data, statsFileMode); err != nil {
+		return fmt.Errorf("failed to write stats file: %w", err)
+	}
+	return nil
+}
+
+// Reset deletes the stats file
+func Reset() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove stats file: %w", err)
+	}
+	return nil
+}
+
+// Enabled reports whether usage stats are switched on in config
+func Enabled() bool {
+	cfg, err := config.Load()
+	return err == nil && cfg.Stats
+}
+
+// Record adds a run of cmd to the stats file when stats are enabled. It is
+// best effort: failing to record never affects the command's outcome.
+func Record(cmd *cobra.Command, d time.Duration, runErr error) {
+	if cmd == nil || !cmd.HasParent() || !Enabled() {
+		return
+	}
+
+	s, err := Load()
+	if err != nil {
+		return
+	}
+	s.add(CommandKey(cmd), objectType(cmd), d, runErr != nil, time.Now())
+	_ = save(s)
+}
+
+// CommandKey returns cmd's path without the root command name
+func CommandKey(cmd *cobra.Command) string {
+	path := cmd.CommandPath()
+	if i := strings.IndexByte(path, ' '); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
+
+// objectType returns the CRM object type cmd worked on: an explicit --type,
+// or the command group when it is named after an object
+func objectType(cmd *cobra.Command) string {
+	group := strings.SplitN(CommandKey(cmd), " ", 2)[0]
+	if objectCommands[group] {
+		return group
+	}
+	if typeFlagCommands[group] {
+		if f := cmd.Flags().Lookup("type"); f != nil {
+			return f.Value.String()
+		}
+	}
+	return ""
+}
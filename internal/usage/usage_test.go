@@ -0,0 +1,59 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsAdd(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := &Stats{}
+
+	s.add("contacts list", "contacts", 200*time.Millisecond, false, now)
+	s.add("contacts list", "contacts", 400*time.Millisecond, true, now)
+	s.add("deals get", "deals", time.Second, false, now)
+	s.add("config show", "", 10*time.Millisecond, false, now)
+
+	assert.Equal(t, now, s.Since)
+	require.Contains(t, s.Commands, "contacts list")
+	assert.Equal(t, 2, s.Commands["contacts list"].Runs)
+	assert.Equal(t, 1, s.Commands["contacts list"].Errors)
+	assert.Equal(t, 300*time.Millisecond, s.Commands["contacts list"].Average())
+
+	entries := s.SortedCommands()
+	require.Len(t, entries, 3)
+	assert.Equal(t, "contacts list", entries[0].Name)
+	assert.Equal(t, "config show", entries[1].Name)
+
+	assert.Equal(t, []string{"contacts", "deals"}, s.SortedObjectTypes())
+}
+
+func TestObjectType(t *testing.T) {
+	build := func(group string, typeFlag bool) *cobra.Command {
+		rootCmd := &cobra.Command{Use: "hspt"}
+		groupCmd := &cobra.Command{Use: group}
+		sub := &cobra.Command{Use: "list"}
+		if typeFlag {
+			sub.Flags().String("type", "", "")
+		}
+		groupCmd.AddCommand(sub)
+		rootCmd.AddCommand(groupCmd)
+		return sub
+	}
+
+	assert.Equal(t, "contacts", objectType(build("contacts", false)))
+	assert.Equal(t, "", objectType(build("config", false)))
+
+	crm := build("crm", true)
+	require.NoError(t, crm.Flags().Set("type", "deals"))
+	assert.Equal(t, "deals", objectType(crm))
+
+	// --type on other groups is not an object type
+	pages := build("pages", true)
+	require.NoError(t, pages.Flags().Set("type", "landing"))
+	assert.Equal(t, "", objectType(pages))
+}
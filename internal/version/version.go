@@ -5,6 +5,11 @@ var (
 	Version   = "dev"
 	Commit    = "none"
 	BuildDate = "unknown"
+
+	// SigningKey is the base64 ed25519 public key that signs the
+	// checksums.txt of each release, checked by "hspt upgrade". It is empty
+	// in builds from source.
+	SigningKey = ""
 )
 
 // Info returns version information as a string
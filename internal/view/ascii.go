@@ -0,0 +1,138 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiReplacements spells out the symbols the CLI itself prints, and common
+// typographic characters found in portal data, in ASCII
+var asciiReplacements = map[rune]string{
+	'✓':      "ok",
+	'✗':      "x",
+	'⚠':      "!",
+	'→':      "->",
+	'←':      "<-",
+	'↻':      "~",
+	'…':      "...",
+	'—':      "--",
+	'–':      "-",
+	'‘':      "'",
+	'’':      "'",
+	'“':      `"`,
+	'”':      `"`,
+	'•':      "*",
+	'×':      "x",
+	'€':      "EUR",
+	'£':      "GBP",
+	'¥':      "JPY",
+	'₹':      "INR",
+	'ß':      "ss",
+	'æ':      "ae",
+	'Æ':      "AE",
+	'ø':      "o",
+	'Ø':      "O",
+	'\u00a0': " ",
+}
+
+// toASCII spells a rune in ASCII: a known symbol by its replacement, an
+// accented letter without its accent, anything else as ?
+func toASCII(r rune) string {
+	if r < utf8.RuneSelf {
+		return string(r)
+	}
+	if s, ok := asciiReplacements[r]; ok {
+		return s
+	}
+	var b strings.Builder
+	for _, d := range norm.NFD.String(string(r)) {
+		if d < utf8.RuneSelf {
+			b.WriteRune(d)
+		}
+	}
+	if b.Len() == 0 {
+		return "?"
+	}
+	return b.String()
+}
+
+// asciiWriter writes only ASCII to w, replacing every other character with
+// toASCII. A character split across writes is held until it is complete.
+type asciiWriter struct {
+	w       io.Writer
+	pending []byte
+}
+
+// NewASCIIWriter returns a writer that passes only ASCII on to w, spelling
+// every other character as its nearest ASCII equivalent
+func NewASCIIWriter(w io.Writer) io.Writer {
+	if _, ok := w.(*asciiWriter); ok {
+		return w
+	}
+	return &asciiWriter{w: w}
+}
+
+func (a *asciiWriter) Write(p []byte) (int, error) {
+	data := append(a.pending, p...)
+	a.pending = nil
+
+	var b bytes.Buffer
+	for len(data) > 0 {
+		if data[0] < utf8.RuneSelf {
+			b.WriteByte(data[0])
+			data = data[1:]
+			continue
+		}
+		if !utf8.FullRune(data) {
+			a.pending = append([]byte(nil), data...)
+			break
+		}
+		r, size := utf8.DecodeRune(data)
+		b.WriteString(toASCII(r))
+		data = data[size:]
+	}
+
+	if _, err := a.w.Write(b.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// escapeJSON replaces every non-ASCII character of encoded JSON with its \u
+// escape, which keeps the value intact where transliterating would not
+func escapeJSON(data []byte) []byte {
+	var b bytes.Buffer
+	for _, r := range string(data) {
+		switch {
+		case r < utf8.RuneSelf:
+			b.WriteRune(r)
+		case r > 0xFFFF:
+			r -= 0x10000
+			fmt.Fprintf(&b, `\u%04x\u%04x`, 0xD800+(r>>10), 0xDC00+(r&0x3FF))
+		default:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		}
+	}
+	return b.Bytes()
+}
+
+// UseASCII switches the view to ASCII-only output for legacy terminals and
+// log shippers: colors are off, status lines are prefixed with words instead
+// of symbols, JSON escapes non-ASCII characters, and everything else written
+// to Out and Err is transliterated with toASCII.
+func (v *View) UseASCII() {
+	if v.ASCII {
+		return
+	}
+	v.ASCII = true
+	v.NoColor = true
+	color.NoColor = true
+	v.Out = NewASCIIWriter(v.Out)
+	v.Err = NewASCIIWriter(v.Err)
+}
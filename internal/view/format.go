@@ -0,0 +1,123 @@
+package view
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayout is how timestamps appear in tables
+const timeLayout = "2006-01-02 15:04 MST"
+
+// currencySymbols are printed before amounts in these currencies; other
+// currencies are printed as a code after the amount
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"INR": "₹",
+	"AUD": "A$",
+	"CAD": "CA$",
+}
+
+// zeroDecimalCurrencies have no minor unit
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true,
+	"KRW": true,
+}
+
+// Time formats a HubSpot timestamp, either RFC 3339 or epoch milliseconds,
+// in Location (local time when nil). Dates without a time and values that
+// are not timestamps are returned unchanged.
+func (v *View) Time(s string) string {
+	t, ok := parseTimestamp(s)
+	if !ok {
+		return s
+	}
+	loc := v.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	return t.In(loc).Format(timeLayout)
+}
+
+func parseTimestamp(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, true
+	}
+	// Epoch milliseconds: anything from 2001 onwards has at least 12 digits
+	if len(s) >= 12 && strings.Trim(s, "0123456789") == "" {
+		if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.UnixMilli(ms), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Money formats an amount in Currency with thousands separators. Amounts
+// are returned unchanged when no currency is set or they are not numbers.
+func (v *View) Money(s string) string {
+	return v.MoneyIn(s, v.Currency)
+}
+
+// MoneyIn formats an amount in another currency than the portal's, such as
+// a converted amount or a record with its own currency. An empty currency
+// means the portal's. Like Money, it leaves amounts unchanged when no portal
+// currency is set, which includes --currency-raw.
+func (v *View) MoneyIn(s, currency string) string {
+	if v.Currency == "" || s == "" {
+		return s
+	}
+	if currency == "" {
+		currency = v.Currency
+	}
+	amount, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return s
+	}
+
+	decimals := 2
+	if zeroDecimalCurrencies[currency] {
+		decimals = 0
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	digits := strconv.FormatFloat(amount, 'f', decimals, 64)
+	whole, frac, _ := strings.Cut(digits, ".")
+	number := groupThousands(whole)
+	if frac != "" {
+		number += "." + frac
+	}
+
+	if symbol, ok := currencySymbols[currency]; ok {
+		return sign + symbol + number
+	}
+	return sign + number + " " + currency
+}
+
+// groupThousands inserts commas between groups of three digits
+func groupThousands(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		b.WriteString(digits[:lead])
+	}
+	for i := lead; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,82 @@
+package view
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// Catalog translates messages into one language. It maps the English format
+// string a command passes to Error or Info to the translated format, which
+// takes the same arguments in the same order.
+type Catalog map[string]string
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[string]Catalog{}
+)
+
+// RegisterCatalog adds translations for lang, a language such as "de" or a
+// language and region such as "pt-BR". Messages missing from a catalog are
+// printed in English.
+func RegisterCatalog(lang string, c Catalog) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+
+	lang = normalizeLanguage(lang)
+	if catalogs[lang] == nil {
+		catalogs[lang] = Catalog{}
+	}
+	for msg, translated := range c {
+		catalogs[lang][msg] = translated
+	}
+}
+
+// Language returns the user's message language from LC_ALL, LC_MESSAGES, or
+// LANG, in that order, e.g. "de-DE" for de_DE.UTF-8. It is empty for the C
+// and POSIX locales and when none is set.
+func Language() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			return normalizeLanguage(value)
+		}
+	}
+	return ""
+}
+
+// normalizeLanguage turns a POSIX locale such as en_US.UTF-8@euro into a
+// language tag such as en-US
+func normalizeLanguage(locale string) string {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	if locale == "C" || locale == "POSIX" {
+		return ""
+	}
+	return strings.ReplaceAll(locale, "_", "-")
+}
+
+// localize returns the translation of format for the view's language, trying
+// the language and region before the language alone, or format itself when
+// there is none
+func (v *View) localize(format string) string {
+	if v.Lang == "" {
+		return format
+	}
+
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+
+	lang := v.Lang
+	for lang != "" {
+		if translated, ok := catalogs[lang][format]; ok {
+			return translated
+		}
+		i := strings.LastIndex(lang, "-")
+		if i < 0 {
+			break
+		}
+		lang = lang[:i]
+	}
+	return format
+}
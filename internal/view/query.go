@@ -0,0 +1,153 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/itchyny/gojq"
+)
+
+// templateFuncs are available to --template in addition to the
+// text/template builtins
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"join": func(sep string, v interface{}) string {
+		items, ok := v.([]interface{})
+		if !ok {
+			return fmt.Sprint(v)
+		}
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, sep)
+	},
+}
+
+// CheckJQ reports whether expr is a valid jq expression
+func CheckJQ(expr string) error {
+	_, err := compileJQ(expr)
+	return err
+}
+
+// CheckTemplate reports whether text is a valid Go template for --template
+func CheckTemplate(text string) error {
+	_, err := parseTemplate(text)
+	return err
+}
+
+func compileJQ(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	return code, nil
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("template").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// generic converts data to the maps, slices, and json.Numbers it would
+// decode to from its JSON form, so queries see the same field names as
+// --output json
+func generic(data interface{}) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// writeJQ prints each result of the --jq expression: strings as raw lines
+// and everything else as JSON
+func (v *View) writeJQ(data interface{}) error {
+	code, err := compileJQ(v.JQ)
+	if err != nil {
+		return err
+	}
+	input, err := generic(data)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(v.Out)
+	enc.SetIndent("", "  ")
+
+	iter := code.Run(input)
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := result.(error); ok {
+			return fmt.Errorf("--jq: %w", err)
+		}
+		if s, ok := result.(string); ok {
+			fmt.Fprintln(v.Out, s)
+			continue
+		}
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	}
+}
+
+// writeTemplate executes the --template once for each item of a
+// list (a JSON array or an object with a "results" array) or once for a
+// single object, ending each execution with a newline
+func (v *View) writeTemplate(data interface{}) error {
+	tmpl, err := parseTemplate(v.Template)
+	if err != nil {
+		return err
+	}
+	input, err := generic(data)
+	if err != nil {
+		return err
+	}
+
+	items := []interface{}{input}
+	switch val := input.(type) {
+	case []interface{}:
+		items = val
+	case map[string]interface{}:
+		if results, ok := val["results"].([]interface{}); ok {
+			items = results
+		}
+	}
+
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, item); err != nil {
+			return fmt.Errorf("--template: %w", err)
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := v.Out.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
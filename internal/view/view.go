@@ -1,12 +1,15 @@
 package view
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/fatih/color"
 )
@@ -18,6 +21,7 @@ const (
 	FormatTable Format = "table"
 	FormatJSON  Format = "json"
 	FormatPlain Format = "plain"
+	FormatCSV   Format = "csv"
 )
 
 // View handles output formatting.
@@ -28,10 +32,28 @@ const (
 // is only the JSON payload, and in human/plain mode only the rendered result
 // lands on stdout while status chatter goes to stderr.
 type View struct {
-	Format  Format
-	NoColor bool
-	Out     io.Writer
-	Err     io.Writer
+	Format   Format
+	NoColor  bool
+	NoHeader bool
+	Out      io.Writer
+	Err      io.Writer
+
+	// JQ and Template, when set, shape JSON output with a jq expression or
+	// a Go template instead of printing it whole
+	JQ       string
+	Template string
+
+	// Location and Currency are the portal's time zone and currency, used
+	// by Time and Money to format values in human-facing output
+	Location *time.Location
+	Currency string
+
+	// ASCII limits output to ASCII characters without color; set it with
+	// UseASCII
+	ASCII bool
+	// Lang is the language Error and Info messages are translated into
+	// when a catalog for it is registered
+	Lang string
 }
 
 // New creates a new View with the given format
@@ -41,6 +63,7 @@ func New(format string, noColor bool) *View {
 		NoColor: noColor,
 		Out:     os.Stdout,
 		Err:     os.Stderr,
+		Lang:    Language(),
 	}
 
 	if noColor {
@@ -60,11 +83,17 @@ func (v *View) Table(headers []string, rows [][]string) error {
 		return v.Plain(rows)
 	}
 
+	if v.Format == FormatCSV {
+		return v.CSV(headers, rows)
+	}
+
 	w := tabwriter.NewWriter(v.Out, 0, 0, 2, ' ', 0)
 
 	// Print headers
-	headerLine := strings.Join(headers, "\t")
-	fmt.Fprintln(w, color.New(color.Bold).Sprint(headerLine))
+	if !v.NoHeader {
+		headerLine := strings.Join(headers, "\t")
+		fmt.Fprintln(w, color.New(color.Bold).Sprint(headerLine))
+	}
 
 	// Print rows
 	for _, row := range rows {
@@ -74,8 +103,27 @@ func (v *View) Table(headers []string, rows [][]string) error {
 	return w.Flush()
 }
 
-// JSON renders data as JSON
+// JSON renders data as JSON, or through the --jq expression or --template
+// template when one is set
 func (v *View) JSON(data interface{}) error {
+	if v.JQ != "" {
+		return v.writeJQ(data)
+	}
+	if v.Template != "" {
+		return v.writeTemplate(data)
+	}
+
+	if v.ASCII {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(data); err != nil {
+			return err
+		}
+		_, err := v.Out.Write(escapeJSON(buf.Bytes()))
+		return err
+	}
+
 	enc := json.NewEncoder(v.Out)
 	enc.SetIndent("", "  ")
 	return enc.Encode(data)
@@ -89,6 +137,25 @@ func (v *View) Plain(rows [][]string) error {
 	return nil
 }
 
+// CSV renders rows as RFC 4180 comma-separated values, preceded by a header
+// row unless NoHeader is set. Fields containing commas, quotes, or newlines
+// are quoted so the output opens cleanly in spreadsheet tools.
+func (v *View) CSV(headers []string, rows [][]string) error {
+	w := csv.NewWriter(v.Out)
+
+	if !v.NoHeader && len(headers) > 0 {
+		if err := w.Write(headers); err != nil {
+			return err
+		}
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return w.Error()
+}
+
 // Render renders data based on the current format
 func (v *View) Render(headers []string, rows [][]string, jsonData interface{}) error {
 	switch v.Format {
@@ -96,6 +163,8 @@ func (v *View) Render(headers []string, rows [][]string, jsonData interface{}) e
 		return v.JSON(jsonData)
 	case FormatPlain:
 		return v.Plain(rows)
+	case FormatCSV:
+		return v.CSV(headers, rows)
 	default:
 		return v.Table(headers, rows)
 	}
@@ -104,27 +173,37 @@ func (v *View) Render(headers []string, rows [][]string, jsonData interface{}) e
 // Success prints a success status message to stderr.
 func (v *View) Success(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintln(v.Err, color.GreenString("✓ %s", msg))
+	fmt.Fprintln(v.Err, color.GreenString("%s %s", v.marker("✓", "OK:"), msg))
 }
 
-// Error prints an error message to stderr.
+// Error prints an error message to stderr, translated when the view's
+// language has a catalog.
 func (v *View) Error(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintln(v.Err, color.RedString("✗ %s", msg))
+	msg := fmt.Sprintf(v.localize(format), args...)
+	fmt.Fprintln(v.Err, color.RedString("%s %s", v.marker("✗", "ERROR:"), msg))
 }
 
 // Warning prints a warning message to stderr.
 func (v *View) Warning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintln(v.Err, color.YellowString("⚠ %s", msg))
+	fmt.Fprintln(v.Err, color.YellowString("%s %s", v.marker("⚠", "WARNING:"), msg))
 }
 
-// Info prints an informational status message to stderr.
+// Info prints an informational status message to stderr, translated when
+// the view's language has a catalog.
 func (v *View) Info(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
+	msg := fmt.Sprintf(v.localize(format), args...)
 	fmt.Fprintln(v.Err, msg)
 }
 
+// marker returns the symbol a status line starts with, or its ASCII word
+func (v *View) marker(symbol, word string) string {
+	if v.ASCII {
+		return word
+	}
+	return symbol
+}
+
 // PrintStatus prints an unformatted status message to stderr (no trailing newline).
 func (v *View) PrintStatus(format string, args ...interface{}) {
 	fmt.Fprintf(v.Err, format, args...)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -124,3 +125,248 @@ func TestJSONOutputUnaffectedByColorFlag(t *testing.T) {
 	require.NoError(t, v.JSON([]string{"a", "b"}))
 	assert.False(t, strings.Contains(out.String(), "\x1b["), "JSON output must not contain ANSI escapes")
 }
+
+func TestCSVOutput(t *testing.T) {
+	t.Run("header and quoted fields", func(t *testing.T) {
+		v, out, _ := newTestView("csv")
+		require.NoError(t, v.Render(
+			[]string{"ID", "NAME", "NOTE"},
+			[][]string{{"1", "Doe, Jane", `said "hi"`}, {"2", "Line\nBreak", ""}},
+			nil,
+		))
+
+		assert.Equal(t, "ID,NAME,NOTE\n1,\"Doe, Jane\",\"said \"\"hi\"\"\"\n2,\"Line\nBreak\",\n", out.String())
+	})
+
+	t.Run("no header", func(t *testing.T) {
+		v, out, _ := newTestView("csv")
+		v.NoHeader = true
+		require.NoError(t, v.Render([]string{"ID", "NAME"}, [][]string{{"1", "Demo"}}, nil))
+
+		assert.Equal(t, "1,Demo\n", out.String())
+	})
+
+	t.Run("Table delegates to CSV", func(t *testing.T) {
+		v, out, _ := newTestView("csv")
+		require.NoError(t, v.Table([]string{"ID"}, [][]string{{"1"}}))
+
+		assert.Equal(t, "ID\n1\n", out.String())
+	})
+}
+
+func TestTableNoHeader(t *testing.T) {
+	v, out, _ := newTestView("table")
+	v.NoHeader = true
+	require.NoError(t, v.Table([]string{"ID", "NAME"}, [][]string{{"1", "Demo"}}))
+
+	assert.NotContains(t, out.String(), "NAME")
+	assert.Contains(t, out.String(), "Demo")
+}
+
+func TestJSONWithJQ(t *testing.T) {
+	data := map[string]interface{}{
+		"results": []map[string]interface{}{
+			{"id": "1", "properties": map[string]interface{}{"email": "a@example.com"}},
+			{"id": "2", "properties": map[string]interface{}{"email": "b@example.com"}},
+		},
+		"total": 1717200000000,
+	}
+
+	t.Run("strings print raw", func(t *testing.T) {
+		v, out, _ := newTestView("json")
+		v.JQ = ".results[].id"
+		require.NoError(t, v.JSON(data))
+		assert.Equal(t, "1\n2\n", out.String())
+	})
+
+	t.Run("other values print as JSON", func(t *testing.T) {
+		v, out, _ := newTestView("json")
+		v.JQ = "{total, count: (.results | length)}"
+		require.NoError(t, v.JSON(data))
+		assert.JSONEq(t, `{"total": 1717200000000, "count": 2}`, out.String())
+	})
+
+	t.Run("runtime error", func(t *testing.T) {
+		v, _, _ := newTestView("json")
+		v.JQ = ".total | keys"
+		assert.ErrorContains(t, v.JSON(data), "--jq")
+	})
+
+	assert.ErrorContains(t, CheckJQ(".results["), "invalid --jq expression")
+	assert.NoError(t, CheckJQ(".results[].id"))
+}
+
+func TestJSONWithTemplate(t *testing.T) {
+	t.Run("applied to each result", func(t *testing.T) {
+		v, out, _ := newTestView("json")
+		v.Template = "{{.id}} {{.properties.email}}"
+		require.NoError(t, v.JSON(map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"id": "1", "properties": map[string]interface{}{"email": "a@example.com"}},
+				{"id": "2", "properties": map[string]interface{}{"email": "b@example.com"}},
+			},
+		}))
+		assert.Equal(t, "1 a@example.com\n2 b@example.com\n", out.String())
+	})
+
+	t.Run("single object keeps large numbers intact", func(t *testing.T) {
+		v, out, _ := newTestView("json")
+		v.Template = "{{.portalId}}: {{join \",\" .tags}} {{json .meta}}"
+		require.NoError(t, v.JSON(map[string]interface{}{
+			"portalId": 1717200000000,
+			"tags":     []string{"a", "b"},
+			"meta":     map[string]bool{"ok": true},
+		}))
+		assert.Equal(t, "1717200000000: a,b {\"ok\":true}\n", out.String())
+	})
+
+	t.Run("bare array", func(t *testing.T) {
+		v, out, _ := newTestView("json")
+		v.Template = "{{.}}\n"
+		require.NoError(t, v.JSON([]string{"x", "y"}))
+		assert.Equal(t, "x\ny\n", out.String())
+	})
+
+	assert.ErrorContains(t, CheckTemplate("{{.id"), "invalid --template")
+}
+
+func TestTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	v, _, _ := newTestView("table")
+	v.Location = berlin
+
+	assert.Equal(t, "2024-06-01 14:30 CEST", v.Time("2024-06-01T12:30:00Z"))
+	assert.Equal(t, "2024-06-01 14:30 CEST", v.Time("2024-06-01T12:30:00.000Z"))
+	assert.Equal(t, "2024-06-01 02:00 CEST", v.Time("1717200000000"))
+	assert.Equal(t, "2024-03-31", v.Time("2024-03-31"))
+	assert.Equal(t, "42", v.Time("42"))
+	assert.Equal(t, "", v.Time(""))
+
+	v.Location = time.UTC
+	assert.Equal(t, "2024-06-01 12:30 UTC", v.Time("2024-06-01T12:30:00Z"))
+}
+
+func TestMoney(t *testing.T) {
+	tests := []struct {
+		currency string
+		amount   string
+		want     string
+	}{
+		{"USD", "50000", "$50,000.00"},
+		{"USD", "1234567.5", "$1,234,567.50"},
+		{"USD", "-999.999", "-$1,000.00"},
+		{"EUR", "12.3", "€12.30"},
+		{"JPY", "1500000", "¥1,500,000"},
+		{"CHF", "2500", "2,500.00 CHF"},
+		{"USD", "", ""},
+		{"USD", "n/a", "n/a"},
+		{"", "50000", "50000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency+" "+tt.amount, func(t *testing.T) {
+			v, _, _ := newTestView("table")
+			v.Currency = tt.currency
+			assert.Equal(t, tt.want, v.Money(tt.amount))
+		})
+	}
+}
+
+func TestMoneyIn(t *testing.T) {
+	v, _, _ := newTestView("table")
+	v.Currency = "USD"
+	assert.Equal(t, "€1,234.50", v.MoneyIn("1234.5", "EUR"))
+	assert.Equal(t, "¥1,500", v.MoneyIn("1500", "JPY"))
+	assert.Equal(t, "$99.00", v.MoneyIn("99", ""), "no currency means the portal's")
+
+	v.Currency = ""
+	assert.Equal(t, "1234.5", v.MoneyIn("1234.5", "EUR"), "raw amounts stay raw")
+}
+
+func TestUseASCII(t *testing.T) {
+	v, out, errBuf := newTestView("table")
+	v.Currency = "USD"
+	v.UseASCII()
+
+	v.Success("Saved “Café Brûlée” – done")
+	v.Error("boom")
+	v.Warning("careful")
+	v.Info("  → forwarded")
+	require.NoError(t, v.Table([]string{"NAME", "PRICE"}, [][]string{{"Zoë", v.MoneyIn("12", "EUR")}, {"日本", "…"}}))
+
+	assert.Equal(t, "OK: Saved \"Cafe Brulee\" - done\nERROR: boom\nWARNING: careful\n  -> forwarded\n", errBuf.String())
+	assert.Equal(t, "NAME  PRICE\nZoe   EUR12.00\n??    ...\n", out.String())
+}
+
+func TestUseASCII_JSON(t *testing.T) {
+	v, out, _ := newTestView("json")
+	v.UseASCII()
+
+	data := map[string]string{"name": "Zoë 😀"}
+	require.NoError(t, v.JSON(data))
+	assert.Equal(t, "{\n  \"name\": \"Zo\\u00eb \\ud83d\\ude00\"\n}\n", out.String())
+
+	var got map[string]string
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Equal(t, data, got, "escaping keeps the value intact")
+}
+
+func TestASCIIWriter_SplitRune(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewASCIIWriter(&buf)
+	check := []byte("✓é")
+
+	n, err := w.Write(check[:2])
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Empty(t, buf.String(), "an incomplete character is held back")
+
+	_, err = w.Write(check[2:])
+	require.NoError(t, err)
+	assert.Equal(t, "oke", buf.String())
+	assert.Same(t, w, NewASCIIWriter(w), "an ASCII writer is not wrapped twice")
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	assert.Equal(t, "de-DE", normalizeLanguage("de_DE.UTF-8"))
+	assert.Equal(t, "fr-FR", normalizeLanguage("fr_FR@euro"))
+	assert.Equal(t, "pt-BR", normalizeLanguage("pt-BR"))
+	assert.Equal(t, "", normalizeLanguage("C.UTF-8"))
+	assert.Equal(t, "", normalizeLanguage("POSIX"))
+}
+
+func TestLanguage(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "es_MX.UTF-8")
+	assert.Equal(t, "es-MX", Language())
+
+	t.Setenv("LC_MESSAGES", "nl_NL.UTF-8")
+	assert.Equal(t, "nl-NL", Language())
+
+	t.Setenv("LC_ALL", "C")
+	assert.Equal(t, "", Language())
+}
+
+func TestLocalizedMessages(t *testing.T) {
+	RegisterCatalog("xx", Catalog{
+		"Contact %s not found": "Kontakt %s nicht gefunden",
+	})
+	RegisterCatalog("xx-YY", Catalog{
+		"Found %d contact(s)": "%d Kontakt(e) gefunden",
+	})
+
+	v, _, errBuf := newTestView("table")
+	v.Lang = "xx-YY"
+	v.Error("Contact %s not found", "123")
+	v.Info("Found %d contact(s)", 2)
+	v.Info("No translation for %s", "this")
+	assert.Equal(t, "✗ Kontakt 123 nicht gefunden\n2 Kontakt(e) gefunden\nNo translation for this\n", errBuf.String())
+
+	v, _, errBuf = newTestView("table")
+	v.Lang = ""
+	v.Error("Contact %s not found", "123")
+	assert.Equal(t, "✗ Contact 123 not found\n", errBuf.String())
+}